@@ -0,0 +1,70 @@
+// approximation.go
+// description: Solutions of minimization problems with their guarantees
+// details:
+// An approximation algorithm for a minimization problem returns a solution
+// whose cost is within a known factor, its ratio, of the optimum. Most of
+// them prove more than that as they run: the 2-approximation of vertex cover
+// finds a matching, and no cover can be smaller than a matching, so the
+// cover it returns is at most Cost / LowerBound times the optimum, which may
+// well be below 2 for a given input. Every algorithm of this package reports
+// both bounds with its solution, and an exact algorithm has a ratio of 1.
+// see vertexcover.go, setcover.go
+
+// Package approximation solves NP-hard covering problems, exactly on small
+// or special inputs and within a guaranteed factor of the optimum otherwise.
+package approximation
+
+import "errors"
+
+var (
+	// ErrInvalidVertex is returned for an edge with an endpoint outside the
+	// vertices of the graph.
+	ErrInvalidVertex = errors.New("edge endpoint is not a vertex of the graph")
+	// ErrTooLarge is returned when an exact algorithm is given more vertices
+	// than it can handle.
+	ErrTooLarge = errors.New("too many vertices for an exact solution")
+	// ErrNotBipartite is returned when a bipartite graph is expected.
+	ErrNotBipartite = errors.New("graph is not bipartite")
+	// ErrInvalidElement is returned for a set holding an element outside
+	// the universe.
+	ErrInvalidElement = errors.New("set element is not in the universe")
+	// ErrNoCover is returned when the sets do not cover the universe.
+	ErrNoCover = errors.New("sets do not cover every element")
+)
+
+// Approximation is the solution of a minimization problem found by an
+// algorithm, with what the algorithm guarantees about it.
+type Approximation[S any] struct {
+	// Solution is the solution found.
+	Solution S
+	// Cost is the cost of the solution, such as its number of elements.
+	Cost int
+	// Ratio is the factor within which the algorithm guarantees Cost to be
+	// of the optimum on every input, 1 for an exact algorithm.
+	Ratio float64
+	// LowerBound is a lower bound on the optimum proven by the run.
+	LowerBound int
+}
+
+// Exact reports whether the solution is known to be optimal, either because
+// the algorithm is exact or because its cost meets the lower bound.
+func (a Approximation[S]) Exact() bool {
+	return a.Ratio == 1 || a.Cost == a.LowerBound
+}
+
+// Achieved returns the factor within which the solution is proven to be of
+// the optimum for this input, Cost / LowerBound, which is at most Ratio.
+// It is 1 when both are 0.
+func (a Approximation[S]) Achieved() float64 {
+	if a.LowerBound == 0 {
+		if a.Cost == 0 {
+			return 1
+		}
+		return a.Ratio
+	}
+	achieved := float64(a.Cost) / float64(a.LowerBound)
+	if achieved > a.Ratio {
+		return a.Ratio
+	}
+	return achieved
+}
@@ -0,0 +1,98 @@
+// setcover.go
+// description: Greedy set cover
+// details:
+// Given a universe of elements and sets of them, set cover asks for the
+// fewest sets whose union is the universe. The greedy algorithm repeatedly
+// takes the set covering the most elements not yet covered. Charging each
+// newly covered element 1/k when a set covers k of them, the elements of
+// any set S are charged at most 1 + 1/2 + ... + 1/|S| = H(|S|) in total, so
+// the greedy cover is at most H(d) <= ln(d) + 1 times the optimum, for d the
+// size of the largest set, and no polynomial algorithm does much better
+// unless P = NP. The same charges prove that the optimum is at least the
+// greedy cost over H(d), and it is at least the universe size over d.
+// time complexity: O(k N) for k sets chosen and N elements in all the sets
+// space complexity: O(n + m) for n elements and m sets
+// reference: https://en.wikipedia.org/wiki/Set_cover_problem#Greedy_algorithm
+// see setcover_test.go
+
+package approximation
+
+import "math"
+
+// harmonic returns 1 + 1/2 + ... + 1/n.
+func harmonic(n int) float64 {
+	h := 0.0
+	for k := 1; k <= n; k++ {
+		h += 1 / float64(k)
+	}
+	return h
+}
+
+// GreedySetCover returns the indices, in increasing order, of sets whose
+// union holds the elements 0 to universe-1, at most H(d) times as many as
+// needed for the largest set size d. It returns ErrInvalidElement if a set
+// holds an element outside the universe, and ErrNoCover if the sets do not
+// cover it. Repeated elements in a set are counted once.
+func GreedySetCover(universe int, sets [][]int) (Approximation[[]int], error) {
+	largest := 0
+	distinct := make([][]int, len(sets))
+	seen := make([]int, universe)
+	for i, set := range sets {
+		for _, x := range set {
+			if x < 0 || x >= universe {
+				return Approximation[[]int]{}, ErrInvalidElement
+			}
+			if seen[x] != i+1 {
+				seen[x] = i + 1
+				distinct[i] = append(distinct[i], x)
+			}
+		}
+		if len(distinct[i]) > largest {
+			largest = len(distinct[i])
+		}
+	}
+
+	covered := make([]bool, universe)
+	taken := make([]bool, len(sets))
+	left := universe
+	for left > 0 {
+		best, gain := -1, 0
+		for i, set := range distinct {
+			if taken[i] {
+				continue
+			}
+			g := 0
+			for _, x := range set {
+				if !covered[x] {
+					g++
+				}
+			}
+			if g > gain {
+				best, gain = i, g
+			}
+		}
+		if best == -1 {
+			return Approximation[[]int]{}, ErrNoCover
+		}
+		taken[best] = true
+		for _, x := range distinct[best] {
+			covered[x] = true
+		}
+		left -= gain
+	}
+
+	cover := members(taken)
+	ratio := 1.0
+	if largest > 1 {
+		ratio = harmonic(largest)
+	}
+	lower := 0
+	if largest > 0 {
+		lower = (universe + largest - 1) / largest
+		// a small margin keeps rounding errors from raising the bound
+		if charged := int(math.Ceil(float64(len(cover))/ratio - 1e-9)); charged > lower {
+			lower = charged
+		}
+	}
+	return Approximation[[]int]{Solution: cover, Cost: len(cover), Ratio: ratio, LowerBound: lower}, nil
+}
@@ -0,0 +1,75 @@
+package approximation
+
+import (
+	"math/bits"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// bruteSetCover returns the fewest sets covering the universe by trying
+// every subset of the sets.
+func bruteSetCover(universe int, sets [][]int) int {
+	best := len(sets) + 1
+	for chosen := uint(0); chosen < 1<<uint(len(sets)); chosen++ {
+		covered := map[int]bool{}
+		for i, set := range sets {
+			if chosen&(1<<uint(i)) != 0 {
+				for _, x := range set {
+					covered[x] = true
+				}
+			}
+		}
+		if size := bits.OnesCount(chosen); len(covered) == universe && size < best {
+			best = size
+		}
+	}
+	return best
+}
+
+func TestGreedySetCover(t *testing.T) {
+	// greedy takes the largest set first and then needs two more, where
+	// the two halves of the universe alone are optimal
+	sets := [][]int{{0, 1, 2, 3, 4, 5, 6}, {7, 8, 9, 10, 11, 12, 13}, {0, 1, 2, 7, 8, 9, 10, 3}, {4, 5, 11, 12}, {6, 13}}
+	got, err := GreedySetCover(14, sets)
+	if err != nil || !reflect.DeepEqual(got.Solution, []int{2, 3, 4}) {
+		t.Fatalf("GreedySetCover = %+v, %v, want sets 2, 3, 4", got, err)
+	}
+	if got.LowerBound != 2 || got.Exact() || got.Ratio < 2.7 || got.Ratio > 2.8 {
+		t.Errorf("GreedySetCover bounds = %+v, want lower bound 2 and ratio H(8)", got)
+	}
+
+	if _, err := GreedySetCover(3, [][]int{{0, 1}}); err != ErrNoCover {
+		t.Errorf("GreedySetCover without a cover: %v", err)
+	}
+	if _, err := GreedySetCover(3, [][]int{{0, 3}}); err != ErrInvalidElement {
+		t.Errorf("GreedySetCover with an invalid element: %v", err)
+	}
+	if got, err := GreedySetCover(0, nil); err != nil || got.Cost != 0 || !got.Exact() || got.Achieved() != 1 {
+		t.Errorf("GreedySetCover of an empty universe = %+v, %v", got, err)
+	}
+}
+
+func TestGreedySetCoverRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		universe := 1 + rnd.Intn(12)
+		sets := make([][]int, 1+rnd.Intn(10))
+		for j := range sets {
+			for k := rnd.Intn(universe + 1); k > 0; k-- {
+				sets[j] = append(sets[j], rnd.Intn(universe))
+			}
+		}
+		optimum := bruteSetCover(universe, sets)
+		got, err := GreedySetCover(universe, sets)
+		if optimum > len(sets) {
+			if err != ErrNoCover {
+				t.Fatalf("GreedySetCover(%d, %v) = %v, want ErrNoCover", universe, sets, err)
+			}
+			continue
+		}
+		if err != nil || float64(got.Cost) > got.Ratio*float64(optimum)+1e-9 || got.LowerBound > optimum {
+			t.Fatalf("GreedySetCover(%d, %v) = %+v, %v with optimum %d", universe, sets, got, err, optimum)
+		}
+	}
+}
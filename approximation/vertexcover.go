@@ -0,0 +1,260 @@
+// vertexcover.go
+// description: Minimum vertex cover, exact and 2-approximate
+// details:
+// A vertex cover of a graph is a set of vertices touching every edge.
+// Finding a smallest one is NP-hard, but:
+// - the endpoints of a maximal matching, built by taking every edge whose
+// endpoints are both free, form a cover. Every cover holds an endpoint of
+// each matched edge, so it is at most twice as large as the optimum,
+// - on a small graph, branch and bound finds the optimum: for a vertex v
+// with uncovered edges, either v is in the cover, or all its neighbours
+// are. A branch is cut as soon as the cover so far plus a maximal matching
+// of the uncovered edges, which needs as many more vertices, is no smaller
+// than the best cover found,
+// - on a bipartite graph, König's theorem makes the minimum cover as large
+// as a maximum matching, and builds it from the vertices reachable from
+// the unmatched left vertices by alternating paths: the left vertices not
+// reached and the right vertices reached.
+// A self-loop is covered by its vertex, which must then be in every cover.
+// time complexity: O(V + E) for the approximation, O(V E) for bipartite
+// graphs, O(2^k (V + E)) for a cover of k vertices with branch and bound
+// space complexity: O(V + E)
+// reference: https://en.wikipedia.org/wiki/Vertex_cover
+// reference: https://en.wikipedia.org/wiki/K%C5%91nig%27s_theorem_(graph_theory)
+// see vertexcover_test.go
+
+package approximation
+
+import "math/bits"
+
+// MaxExactVertices is the largest number of vertices of a graph given to
+// VertexCoverExact.
+const MaxExactVertices = 64
+
+// checkEdges returns ErrInvalidVertex if an edge has an endpoint outside the
+// vertices 0 to n-1.
+func checkEdges(n int, edges [][2]int) error {
+	for _, e := range edges {
+		if e[0] < 0 || e[0] >= n || e[1] < 0 || e[1] >= n {
+			return ErrInvalidVertex
+		}
+	}
+	return nil
+}
+
+// members returns the vertices marked in set, in increasing order.
+func members(set []bool) []int {
+	vertices := []int{}
+	for v, in := range set {
+		if in {
+			vertices = append(vertices, v)
+		}
+	}
+	return vertices
+}
+
+// VertexCoverApprox returns a vertex cover of the graph of n vertices and
+// the given edges at most twice as large as a minimum one, in increasing
+// order. The lower bound is the size of the matching it is built from.
+func VertexCoverApprox(n int, edges [][2]int) (Approximation[[]int], error) {
+	if err := checkEdges(n, edges); err != nil {
+		return Approximation[[]int]{}, err
+	}
+	in := make([]bool, n)
+	lower := 0
+	for _, e := range edges {
+		if e[0] == e[1] && !in[e[0]] {
+			in[e[0]] = true
+			lower++
+		}
+	}
+	for _, e := range edges {
+		if !in[e[0]] && !in[e[1]] {
+			in[e[0]], in[e[1]] = true, true
+			lower++
+		}
+	}
+	cover := members(in)
+	return Approximation[[]int]{Solution: cover, Cost: len(cover), Ratio: 2, LowerBound: lower}, nil
+}
+
+// coverSearch is the state of the branch and bound search of a minimum
+// vertex cover, with sets of vertices as bit masks.
+type coverSearch struct {
+	adjacent []uint64 // neighbours of every vertex, itself for a self-loop
+	best     uint64
+	bestSize int
+}
+
+// matchingBound returns the size of a maximal matching of the edges not
+// covered by cover, self-loops counting as edges matching their vertex.
+func (s *coverSearch) matchingBound(cover uint64) int {
+	used := cover
+	size := 0
+	for u, neighbours := range s.adjacent {
+		if used&(1<<uint(u)) != 0 {
+			continue
+		}
+		if free := neighbours &^ used; free != 0 {
+			w := bits.TrailingZeros64(free)
+			used |= 1<<uint(u) | 1<<uint(w)
+			size++
+		}
+	}
+	return size
+}
+
+// search extends cover, of size vertices, to covers smaller than the best.
+func (s *coverSearch) search(cover uint64, size int) {
+	if size+s.matchingBound(cover) >= s.bestSize {
+		return
+	}
+	// branch on the vertex with the most uncovered edges
+	v, degree := -1, 0
+	for u, neighbours := range s.adjacent {
+		if cover&(1<<uint(u)) != 0 {
+			continue
+		}
+		if d := bits.OnesCount64(neighbours &^ cover); d > degree {
+			v, degree = u, d
+		}
+	}
+	if v == -1 {
+		s.best, s.bestSize = cover, size
+		return
+	}
+	bit := uint64(1) << uint(v)
+	s.search(cover|bit, size+1)
+	if neighbours := s.adjacent[v] &^ cover; neighbours&bit == 0 {
+		s.search(cover|neighbours, size+bits.OnesCount64(neighbours))
+	}
+}
+
+// VertexCoverExact returns a minimum vertex cover of the graph of n vertices
+// and the given edges, in increasing order, by branch and bound. It returns
+// ErrTooLarge if n is above MaxExactVertices.
+func VertexCoverExact(n int, edges [][2]int) (Approximation[[]int], error) {
+	if n > MaxExactVertices {
+		return Approximation[[]int]{}, ErrTooLarge
+	}
+	approx, err := VertexCoverApprox(n, edges)
+	if err != nil {
+		return approx, err
+	}
+	s := &coverSearch{adjacent: make([]uint64, n), bestSize: approx.Cost}
+	for _, v := range approx.Solution {
+		s.best |= 1 << uint(v)
+	}
+	for _, e := range edges {
+		s.adjacent[e[0]] |= 1 << uint(e[1])
+		s.adjacent[e[1]] |= 1 << uint(e[0])
+	}
+	s.search(0, 0)
+	cover := []int{}
+	for v := 0; v < n; v++ {
+		if s.best&(1<<uint(v)) != 0 {
+			cover = append(cover, v)
+		}
+	}
+	return Approximation[[]int]{Solution: cover, Cost: s.bestSize, Ratio: 1, LowerBound: s.bestSize}, nil
+}
+
+// BipartiteVertexCover returns a minimum vertex cover of the bipartite graph
+// of n vertices and the given edges, in increasing order, as large as a
+// maximum matching. It returns ErrNotBipartite if the graph has an odd
+// cycle or a self-loop.
+func BipartiteVertexCover(n int, edges [][2]int) (Approximation[[]int], error) {
+	if err := checkEdges(n, edges); err != nil {
+		return Approximation[[]int]{}, err
+	}
+	adjacent := make([][]int, n)
+	for _, e := range edges {
+		adjacent[e[0]] = append(adjacent[e[0]], e[1])
+		adjacent[e[1]] = append(adjacent[e[1]], e[0])
+	}
+
+	// two-colour the graph, the vertices of colour 0 forming the left side
+	side := make([]int, n)
+	for i := range side {
+		side[i] = -1
+	}
+	for s := 0; s < n; s++ {
+		if side[s] != -1 {
+			continue
+		}
+		side[s] = 0
+		queue := []int{s}
+		for len(queue) > 0 {
+			u := queue[0]
+			queue = queue[1:]
+			for _, v := range adjacent[u] {
+				if side[v] == -1 {
+					side[v] = 1 - side[u]
+					queue = append(queue, v)
+				} else if side[v] == side[u] {
+					return Approximation[[]int]{}, ErrNotBipartite
+				}
+			}
+		}
+	}
+
+	// maximum matching by augmenting paths from every left vertex
+	match := make([]int, n)
+	for i := range match {
+		match[i] = -1
+	}
+	var visited []bool
+	var augment func(u int) bool
+	augment = func(u int) bool {
+		for _, v := range adjacent[u] {
+			if visited[v] {
+				continue
+			}
+			visited[v] = true
+			if match[v] == -1 || augment(match[v]) {
+				match[u], match[v] = v, u
+				return true
+			}
+		}
+		return false
+	}
+	size := 0
+	for u := 0; u < n; u++ {
+		if side[u] == 0 {
+			visited = make([]bool, n)
+			if augment(u) {
+				size++
+			}
+		}
+	}
+
+	// alternating paths from the unmatched left vertices
+	reached := make([]bool, n)
+	var queue []int
+	for u := 0; u < n; u++ {
+		if side[u] == 0 && match[u] == -1 {
+			reached[u] = true
+			queue = append(queue, u)
+		}
+	}
+	for len(queue) > 0 {
+		u := queue[0]
+		queue = queue[1:]
+		for _, v := range adjacent[u] {
+			if reached[v] {
+				continue
+			}
+			reached[v] = true
+			if w := match[v]; w != -1 && !reached[w] {
+				reached[w] = true
+				queue = append(queue, w)
+			}
+		}
+	}
+	in := make([]bool, n)
+	for v := range in {
+		in[v] = side[v] == 0 && !reached[v] || side[v] == 1 && reached[v]
+	}
+	cover := members(in)
+	return Approximation[[]int]{Solution: cover, Cost: len(cover), Ratio: 1, LowerBound: size}, nil
+}
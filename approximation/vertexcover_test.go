@@ -0,0 +1,117 @@
+package approximation
+
+import (
+	"math/bits"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// isCover reports whether cover touches every edge.
+func isCover(edges [][2]int, cover []int) bool {
+	in := map[int]bool{}
+	for _, v := range cover {
+		in[v] = true
+	}
+	for _, e := range edges {
+		if !in[e[0]] && !in[e[1]] {
+			return false
+		}
+	}
+	return true
+}
+
+// bruteCover returns the size of a minimum vertex cover by trying every
+// subset of the vertices.
+func bruteCover(n int, edges [][2]int) int {
+	best := n
+	for set := uint(0); set < 1<<uint(n); set++ {
+		ok := true
+		for _, e := range edges {
+			if set&(1<<uint(e[0])) == 0 && set&(1<<uint(e[1])) == 0 {
+				ok = false
+				break
+			}
+		}
+		if size := bits.OnesCount(set); ok && size < best {
+			best = size
+		}
+	}
+	return best
+}
+
+// randomEdges returns m random edges between n vertices, between the two
+// halves of the vertices if bipartite is set.
+func randomEdges(rnd *rand.Rand, n, m int, bipartite bool) [][2]int {
+	edges := make([][2]int, m)
+	for i := range edges {
+		if bipartite {
+			edges[i] = [2]int{rnd.Intn(n / 2), n/2 + rnd.Intn(n-n/2)}
+		} else {
+			edges[i] = [2]int{rnd.Intn(n), rnd.Intn(n)}
+		}
+	}
+	return edges
+}
+
+func TestVertexCover(t *testing.T) {
+	// a star is covered by its centre, a matching of 1 edge
+	star := [][2]int{{0, 1}, {0, 2}, {0, 3}}
+	exact, err := VertexCoverExact(4, star)
+	if err != nil || !reflect.DeepEqual(exact.Solution, []int{0}) || !exact.Exact() {
+		t.Errorf("VertexCoverExact(star) = %+v, %v", exact, err)
+	}
+	approx, _ := VertexCoverApprox(4, star)
+	if approx.Cost != 2 || approx.LowerBound != 1 || approx.Ratio != 2 || approx.Achieved() != 2 {
+		t.Errorf("VertexCoverApprox(star) = %+v", approx)
+	}
+	// a self-loop forces its vertex into the cover
+	loop := [][2]int{{1, 1}, {0, 2}}
+	if got, _ := VertexCoverExact(3, loop); got.Cost != 2 || !isCover(loop, got.Solution) {
+		t.Errorf("VertexCoverExact with a self-loop = %+v", got)
+	}
+
+	if _, err := VertexCoverApprox(2, [][2]int{{0, 2}}); err != ErrInvalidVertex {
+		t.Errorf("VertexCoverApprox with an invalid vertex: %v", err)
+	}
+	if _, err := VertexCoverExact(MaxExactVertices+1, nil); err != ErrTooLarge {
+		t.Errorf("VertexCoverExact of a large graph: %v", err)
+	}
+	if _, err := BipartiteVertexCover(3, [][2]int{{0, 1}, {1, 2}, {2, 0}}); err != ErrNotBipartite {
+		t.Errorf("BipartiteVertexCover of a triangle: %v", err)
+	}
+}
+
+func TestVertexCoverRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		n := 1 + rnd.Intn(14)
+		edges := randomEdges(rnd, n, rnd.Intn(3*n), false)
+		optimum := bruteCover(n, edges)
+
+		exact, err := VertexCoverExact(n, edges)
+		if err != nil || exact.Cost != optimum || len(exact.Solution) != optimum || !isCover(edges, exact.Solution) {
+			t.Fatalf("VertexCoverExact(%d, %v) = %+v, %v, want a cover of %d vertices", n, edges, exact, err, optimum)
+		}
+		approx, _ := VertexCoverApprox(n, edges)
+		if !isCover(edges, approx.Solution) || approx.Cost > 2*optimum || approx.LowerBound > optimum {
+			t.Fatalf("VertexCoverApprox(%d, %v) = %+v with optimum %d", n, edges, approx, optimum)
+		}
+
+		if n >= 2 {
+			edges = randomEdges(rnd, n, rnd.Intn(3*n), true)
+			bipartite, err := BipartiteVertexCover(n, edges)
+			if err != nil || bipartite.Cost != bruteCover(n, edges) || bipartite.LowerBound != bipartite.Cost || !isCover(edges, bipartite.Solution) {
+				t.Fatalf("BipartiteVertexCover(%d, %v) = %+v, %v, want %d vertices", n, edges, bipartite, err, bruteCover(n, edges))
+			}
+		}
+	}
+}
+
+func BenchmarkVertexCoverExact(b *testing.B) {
+	rnd := rand.New(rand.NewSource(1))
+	edges := randomEdges(rnd, 40, 80, false)
+	for i := 0; i < b.N; i++ {
+		_, _ = VertexCoverExact(40, edges)
+	}
+}
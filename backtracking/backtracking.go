@@ -0,0 +1,107 @@
+// backtracking.go
+// description: Generic backtracking engine
+// details:
+// Backtracking builds solutions one choice at a time. At every step the
+// candidates extending the current partial solution are tried in turn; a
+// candidate that cannot lead to a solution is rejected right away, pruning the
+// whole subtree below it, and after a subtree has been explored the choice is
+// undone and the next candidate is tried.
+// Search only drives the exploration: the problem supplies the candidates, the
+// pruning and the completion test as callbacks, and optional Push and Pop hooks
+// let it keep incremental state such as occupied rows or used digits in step
+// with the partial solution instead of recomputing it at every node.
+// time complexity: O(number of explored nodes), exponential in the worst case
+// space complexity: O(depth of the search)
+// reference: https://en.wikipedia.org/wiki/Backtracking
+// see backtracking_test.go
+
+// Package backtracking implements a generic backtracking search engine and
+// solvers built on it: N-Queens, Sudoku and subset sum, and a best-first
+// branch and bound engine solving the 0/1 knapsack and the travelling
+// salesman problems.
+package backtracking
+
+// Search describes a backtracking problem whose solutions are sequences of
+// choices of type C. Only Candidates and Complete are required.
+type Search[C any] struct {
+	// Candidates returns the choices that may extend partial.
+	Candidates func(partial []C) []C
+	// Reject reports whether extending partial with candidate cannot lead to
+	// a solution. A nil Reject accepts every candidate.
+	Reject func(partial []C, candidate C) bool
+	// Complete reports whether partial is a solution. A solution is reported
+	// and not extended any further.
+	Complete func(partial []C) bool
+	// Push is called after a choice is appended to the partial solution and
+	// Pop after it is removed again. Both may be nil.
+	Push, Pop func(choice C)
+	// MaxSolutions stops the search after that many solutions; zero or a
+	// negative value means no limit.
+	MaxSolutions int
+}
+
+// Run explores the search space depth first and calls visit with every
+// solution found. The slice passed to visit is reused afterwards and must be
+// copied to be kept. Returning false from visit ends the search early. Run
+// returns the number of solutions visited.
+func (s Search[C]) Run(visit func(solution []C) bool) int {
+	count := 0
+	var partial []C
+	var explore func() bool
+	explore = func() bool {
+		if s.Complete(partial) {
+			count++
+			if !visit(partial) {
+				return false
+			}
+			return s.MaxSolutions <= 0 || count < s.MaxSolutions
+		}
+		for _, c := range s.Candidates(partial) {
+			if s.Reject != nil && s.Reject(partial, c) {
+				continue
+			}
+			partial = append(partial, c)
+			if s.Push != nil {
+				s.Push(c)
+			}
+			more := explore()
+			partial = partial[:len(partial)-1]
+			if s.Pop != nil {
+				s.Pop(c)
+			}
+			if !more {
+				return false
+			}
+		}
+		return true
+	}
+	explore()
+	return count
+}
+
+// All returns copies of every solution, up to MaxSolutions.
+func (s Search[C]) All() [][]C {
+	var solutions [][]C
+	s.Run(func(solution []C) bool {
+		solutions = append(solutions, append(make([]C, 0, len(solution)), solution...))
+		return true
+	})
+	return solutions
+}
+
+// First returns a copy of the first solution found and reports whether
+// there is one.
+func (s Search[C]) First() ([]C, bool) {
+	var first []C
+	found := false
+	s.Run(func(solution []C) bool {
+		first, found = append([]C{}, solution...), true
+		return false
+	})
+	return first, found
+}
+
+// Count returns the number of solutions, up to MaxSolutions.
+func (s Search[C]) Count() int {
+	return s.Run(func([]C) bool { return true })
+}
@@ -0,0 +1,64 @@
+package backtracking_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/backtracking"
+)
+
+// permutations returns the search listing the permutations of 0..n-1.
+func permutations(n int) backtracking.Search[int] {
+	used := make([]bool, n)
+	all := make([]int, n)
+	for i := range all {
+		all[i] = i
+	}
+	return backtracking.Search[int]{
+		Candidates: func([]int) []int { return all },
+		Reject:     func(_ []int, c int) bool { return used[c] },
+		Complete:   func(partial []int) bool { return len(partial) == n },
+		Push:       func(c int) { used[c] = true },
+		Pop:        func(c int) { used[c] = false },
+	}
+}
+
+func TestSearch(t *testing.T) {
+	want := [][]int{{0, 1, 2}, {0, 2, 1}, {1, 0, 2}, {1, 2, 0}, {2, 0, 1}, {2, 1, 0}}
+	if got := permutations(3).All(); !reflect.DeepEqual(got, want) {
+		t.Errorf("All() = %v, want %v", got, want)
+	}
+	if got := permutations(5).Count(); got != 120 {
+		t.Errorf("Count() = %d, want 120", got)
+	}
+
+	first, ok := permutations(3).First()
+	if !ok || !reflect.DeepEqual(first, want[0]) {
+		t.Errorf("First() = %v, %v, want %v, true", first, ok, want[0])
+	}
+
+	limited := permutations(4)
+	limited.MaxSolutions = 5
+	if got := limited.Count(); got != 5 {
+		t.Errorf("Count() with MaxSolutions 5 = %d", got)
+	}
+
+	// Stopping early from the visitor.
+	seen := 0
+	n := permutations(4).Run(func([]int) bool {
+		seen++
+		return seen < 3
+	})
+	if n != 3 || seen != 3 {
+		t.Errorf("Run stopped after %d solutions, reported %d, want 3", seen, n)
+	}
+
+	// A dead end without any solution.
+	none := backtracking.Search[int]{
+		Candidates: func([]int) []int { return nil },
+		Complete:   func([]int) bool { return false },
+	}
+	if _, ok := none.First(); ok {
+		t.Errorf("First() found a solution in an empty search space")
+	}
+}
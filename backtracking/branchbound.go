@@ -0,0 +1,122 @@
+// branchbound.go
+// description: Generic best-first branch and bound engine
+// details:
+// Branch and bound finds a solution of least cost among the leaves of a
+// search tree too large to explore fully. Every state comes with a lower
+// bound on the cost of the solutions below it, and the search keeps the best
+// solution found so far, the incumbent: a state whose bound is not below the
+// cost of the incumbent cannot lead to a better solution, and is pruned with
+// its whole subtree. States are expanded best first, in increasing order of
+// bound, from a heap, so the search ends as soon as the smallest bound left
+// reaches the incumbent, which is then optimal. On equal bounds, the state
+// generated last is expanded first, diving towards complete solutions that
+// give an incumbent early, as a depth-first search would.
+// The tighter the bound, the more is pruned; a bound of 0 everywhere makes
+// it an exhaustive uniform-cost search. Maximization problems minimize the
+// opposite of their objective.
+// time complexity: O(S log S) for S states generated, exponential in the worst case
+// space complexity: O(S)
+// reference: https://en.wikipedia.org/wiki/Branch_and_bound
+// see branchbound_test.go
+
+package backtracking
+
+import (
+	"math"
+
+	"github.com/TheAlgorithms/Go/structure/heap"
+)
+
+// BranchAndBound describes a minimization problem over states of type S,
+// explored from a root state. All three functions are required.
+type BranchAndBound[S any] struct {
+	// Branch returns the states refining s, such as the ways of taking one
+	// more decision.
+	Branch func(s S) []S
+	// Bound returns a lower bound on the cost of every solution reachable
+	// from s, its cost if s is complete.
+	Bound func(s S) float64
+	// Complete reports whether s is a solution, and its cost. A solution is
+	// not branched any further.
+	Complete func(s S) (float64, bool)
+}
+
+// BranchStats is the work done by a branch and bound search.
+type BranchStats struct {
+	// Expanded is the number of states branched.
+	Expanded int
+	// Generated is the number of states returned by Branch.
+	Generated int
+	// Pruned is the number of states discarded for their bound.
+	Pruned int
+	// Incumbents is the number of times a better solution was found.
+	Incumbents int
+}
+
+// BranchResult is the outcome of a branch and bound search.
+type BranchResult[S any] struct {
+	// Solution is a solution of least cost, if one is found.
+	Solution S
+	// Cost is the cost of the solution, +Inf if none is found.
+	Cost float64
+	// Found reports whether there is a solution.
+	Found bool
+	// Stats is the work done by the search.
+	Stats BranchStats
+}
+
+// Solve returns a solution of least cost below root.
+func (b BranchAndBound[S]) Solve(root S) BranchResult[S] {
+	return b.SolveBelow(root, math.Inf(1))
+}
+
+// SolveBelow returns a solution of least cost below root among those costing
+// less than limit, such as the cost of a solution known beforehand, which
+// prunes the search from the start.
+func (b BranchAndBound[S]) SolveBelow(root S, limit float64) BranchResult[S] {
+	type entry struct {
+		state S
+		bound float64
+		order int // the number of states generated before it
+	}
+	result := BranchResult[S]{Cost: limit}
+	open, _ := heap.NewAny(func(x, y entry) bool {
+		return x.bound < y.bound || x.bound == y.bound && x.order > y.order
+	})
+	generated := 0
+	push := func(s S) {
+		bound := b.Bound(s)
+		if bound >= result.Cost {
+			result.Stats.Pruned++
+			return
+		}
+		open.Push(entry{s, bound, generated})
+		generated++
+	}
+	push(root)
+	for !open.Empty() {
+		e := open.Top()
+		open.Pop()
+		if e.bound >= result.Cost {
+			// every state left is bounded at least as high
+			result.Stats.Pruned += 1 + open.Size()
+			break
+		}
+		if cost, ok := b.Complete(e.state); ok {
+			if cost < result.Cost {
+				result.Solution, result.Cost, result.Found = e.state, cost, true
+				result.Stats.Incumbents++
+			}
+			continue
+		}
+		result.Stats.Expanded++
+		for _, next := range b.Branch(e.state) {
+			result.Stats.Generated++
+			push(next)
+		}
+	}
+	if !result.Found {
+		result.Cost = math.Inf(1)
+	}
+	return result
+}
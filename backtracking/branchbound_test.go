@@ -0,0 +1,70 @@
+package backtracking_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/backtracking"
+)
+
+// coins returns the problem of paying amount with as few coins of the given
+// values as possible, a state being the coins paid so far.
+func coins(values []int, amount int) backtracking.BranchAndBound[[]int] {
+	sum := func(s []int) int {
+		total := 0
+		for _, c := range s {
+			total += c
+		}
+		return total
+	}
+	largest := 0
+	for _, v := range values {
+		if v > largest {
+			largest = v
+		}
+	}
+	return backtracking.BranchAndBound[[]int]{
+		Branch: func(s []int) [][]int {
+			var next [][]int
+			for _, v := range values {
+				// coins in non-increasing order, so each multiset is built once
+				if (len(s) == 0 || v <= s[len(s)-1]) && sum(s)+v <= amount {
+					next = append(next, append(append([]int{}, s...), v))
+				}
+			}
+			return next
+		},
+		Bound: func(s []int) float64 {
+			return float64(len(s)) + math.Ceil(float64(amount-sum(s))/float64(largest))
+		},
+		Complete: func(s []int) (float64, bool) {
+			return float64(len(s)), sum(s) == amount
+		},
+	}
+}
+
+func TestBranchAndBound(t *testing.T) {
+	// the greedy choice of 4 + 1 + 1 is beaten by 3 + 3
+	result := coins([]int{1, 3, 4}, 6).Solve(nil)
+	if !result.Found || result.Cost != 2 || !reflect.DeepEqual(result.Solution, []int{3, 3}) {
+		t.Errorf("Solve = %v, %v, %v, want [3 3] of cost 2", result.Solution, result.Cost, result.Found)
+	}
+	if result.Stats.Incumbents < 1 || result.Stats.Expanded < 1 {
+		t.Errorf("Solve reported no work: %+v", result.Stats)
+	}
+	// a limit at the optimum prunes every solution
+	if result := coins([]int{1, 3, 4}, 6).SolveBelow(nil, 2); result.Found || !math.IsInf(result.Cost, 1) {
+		t.Errorf("SolveBelow(2) = %v, %v, %v, want nothing", result.Solution, result.Cost, result.Found)
+	}
+	if result := coins([]int{4, 6}, 7).Solve(nil); result.Found || !math.IsInf(result.Cost, 1) {
+		t.Errorf("Solve without a solution = %v, %v, %v", result.Solution, result.Cost, result.Found)
+	}
+	// the bound prunes states that a bound of 0 expands
+	exhaustive := coins([]int{1, 3, 4}, 30)
+	exhaustive.Bound = func([]int) float64 { return 0 }
+	tight, loose := coins([]int{1, 3, 4}, 30).Solve(nil), exhaustive.Solve(nil)
+	if tight.Cost != 8 || loose.Cost != 8 || tight.Stats.Expanded >= loose.Stats.Expanded {
+		t.Errorf("costs %v and %v, expanded %d with the bound and %d without", tight.Cost, loose.Cost, tight.Stats.Expanded, loose.Stats.Expanded)
+	}
+}
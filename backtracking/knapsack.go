@@ -0,0 +1,97 @@
+// knapsack.go
+// description: 0/1 knapsack by branch and bound
+// details:
+// The items are considered by decreasing value per unit of weight, and a
+// state decides, for the next item, to take it if it fits, or to leave it.
+// The bound is the fractional relaxation: the value of the items taken plus
+// the items left in the same order, greedily, the last one that does not fit
+// being cut to fill the knapsack exactly. No choice of whole items does
+// better, and as the knapsack maximizes value, the search minimizes its
+// opposite. Unlike the dynamic programming solution in package dynamic, the
+// running time does not depend on the capacity.
+// time complexity: O(2^n log 2^n) in the worst case, usually much less
+// space complexity: O(2^n) in the worst case
+// reference: https://en.wikipedia.org/wiki/Knapsack_problem#Solving
+// see knapsack_test.go
+
+package backtracking
+
+import (
+	"errors"
+	"sort"
+)
+
+// ErrNegativeItem is returned when an item of Knapsack has a negative weight
+// or value, or the capacity is negative.
+var ErrNegativeItem = errors.New("weights, values and capacity must not be negative")
+
+// knapsackState is a knapsack holding some of the first items in order.
+type knapsackState struct {
+	next          int // the number of items decided
+	weight, value int
+	taken         []int // the indices of the items taken, in order
+}
+
+// Knapsack returns the largest total value of items of the given weights and
+// values fitting in capacity, and the increasing indices of the items taken,
+// solved by branch and bound. It returns ErrLengthMismatch if the lengths of
+// weights and values differ.
+func Knapsack(weights, values []int, capacity int) (int, []int, error) {
+	if len(weights) != len(values) {
+		return 0, nil, ErrLengthMismatch
+	}
+	if capacity < 0 {
+		return 0, nil, ErrNegativeItem
+	}
+	for i := range weights {
+		if weights[i] < 0 || values[i] < 0 {
+			return 0, nil, ErrNegativeItem
+		}
+	}
+	// order holds the items by decreasing value per unit of weight, those of
+	// no weight first
+	order := make([]int, len(weights))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		i, j := order[a], order[b]
+		if weights[i] == 0 || weights[j] == 0 {
+			return weights[j] != 0
+		}
+		return values[i]*weights[j] > values[j]*weights[i]
+	})
+	bnb := BranchAndBound[knapsackState]{
+		Branch: func(s knapsackState) []knapsackState {
+			item := order[s.next]
+			leave := knapsackState{s.next + 1, s.weight, s.value, s.taken}
+			if s.weight+weights[item] > capacity {
+				return []knapsackState{leave}
+			}
+			taken := append(append(make([]int, 0, len(s.taken)+1), s.taken...), item)
+			take := knapsackState{s.next + 1, s.weight + weights[item], s.value + values[item], taken}
+			// the state generated last is expanded first on equal bounds
+			return []knapsackState{leave, take}
+		},
+		Bound: func(s knapsackState) float64 {
+			bound, room := float64(s.value), capacity-s.weight
+			for _, item := range order[s.next:] {
+				if weights[item] <= room {
+					bound += float64(values[item])
+					room -= weights[item]
+				} else {
+					bound += float64(values[item]) * float64(room) / float64(weights[item])
+					break
+				}
+			}
+			return -bound
+		},
+		Complete: func(s knapsackState) (float64, bool) {
+			return -float64(s.value), s.next == len(order)
+		},
+	}
+	result := bnb.Solve(knapsackState{})
+	taken := result.Solution.taken
+	sort.Ints(taken)
+	return result.Solution.value, taken, nil
+}
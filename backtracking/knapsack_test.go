@@ -0,0 +1,55 @@
+package backtracking_test
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/backtracking"
+	"github.com/TheAlgorithms/Go/dynamic"
+)
+
+func TestKnapsack(t *testing.T) {
+	weights := []int{10, 20, 30}
+	values := []int{60, 100, 120}
+	value, taken, err := backtracking.Knapsack(weights, values, 50)
+	if err != nil || value != 220 || !reflect.DeepEqual(taken, []int{1, 2}) {
+		t.Errorf("Knapsack = %d, %v, %v, want 220, [1 2]", value, taken, err)
+	}
+	if value, taken, err := backtracking.Knapsack(nil, nil, 10); err != nil || value != 0 || len(taken) != 0 {
+		t.Errorf("Knapsack of no item = %d, %v, %v", value, taken, err)
+	}
+	if _, _, err := backtracking.Knapsack([]int{1}, nil, 10); !errors.Is(err, backtracking.ErrLengthMismatch) {
+		t.Errorf("Knapsack with different lengths error = %v", err)
+	}
+	if _, _, err := backtracking.Knapsack([]int{-1}, []int{1}, 10); !errors.Is(err, backtracking.ErrNegativeItem) {
+		t.Errorf("Knapsack with a negative weight error = %v", err)
+	}
+}
+
+func TestKnapsackRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for round := 0; round < 200; round++ {
+		n := rnd.Intn(15)
+		weights := make([]int, n)
+		values := make([]int, n)
+		for i := range weights {
+			weights[i] = rnd.Intn(20)
+			values[i] = rnd.Intn(30)
+		}
+		capacity := rnd.Intn(60)
+		value, taken, err := backtracking.Knapsack(weights, values, capacity)
+		if want := dynamic.Knapsack(capacity, weights, values); err != nil || value != want {
+			t.Fatalf("Knapsack(%v, %v, %d) = %d, %v, want %d", weights, values, capacity, value, err, want)
+		}
+		weight, sum := 0, 0
+		for _, i := range taken {
+			weight += weights[i]
+			sum += values[i]
+		}
+		if weight > capacity || sum != value {
+			t.Fatalf("Knapsack(%v, %v, %d) took %v, of weight %d and value %d", weights, values, capacity, taken, weight, sum)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+// nqueens.go
+// description: N-Queens solver on top of the backtracking engine
+// details:
+// Queens are placed row by row, so a solution is the column of the queen in
+// every row. Occupied columns and diagonals are kept in three boolean slices
+// updated by the Push and Pop hooks, which makes rejecting a square O(1).
+// time complexity: O(n!) in the worst case, far less thanks to pruning
+// space complexity: O(n)
+// reference: https://en.wikipedia.org/wiki/Eight_queens_puzzle
+// see nqueens_test.go
+
+package backtracking
+
+// nQueens returns the search placing n non-attacking queens on an n x n board.
+func nQueens(n int) Search[int] {
+	columns := make([]bool, n)
+	diagonals := make([]bool, 2*n)     // row + column
+	antiDiagonals := make([]bool, 2*n) // row - column + n
+	all := make([]int, n)
+	for i := range all {
+		all[i] = i
+	}
+	row := 0
+	mark := func(column int, occupied bool) {
+		if !occupied {
+			row--
+		}
+		columns[column] = occupied
+		diagonals[row+column] = occupied
+		antiDiagonals[row-column+n] = occupied
+		if occupied {
+			row++
+		}
+	}
+	return Search[int]{
+		Candidates: func([]int) []int { return all },
+		Reject: func(partial []int, column int) bool {
+			r := len(partial)
+			return columns[column] || diagonals[r+column] || antiDiagonals[r-column+n]
+		},
+		Complete: func(partial []int) bool { return len(partial) == n },
+		Push:     func(column int) { mark(column, true) },
+		Pop:      func(column int) { mark(column, false) },
+	}
+}
+
+// NQueens returns up to limit placements of n non-attacking queens on an
+// n x n board, all of them when limit is zero or negative. Solution[r] is
+// the column of the queen in row r. Solutions come in lexicographic order.
+func NQueens(n, limit int) [][]int {
+	if n < 0 {
+		return nil
+	}
+	s := nQueens(n)
+	s.MaxSolutions = limit
+	return s.All()
+}
+
+// CountNQueens returns the number of ways to place n non-attacking queens on
+// an n x n board.
+func CountNQueens(n int) int {
+	if n < 0 {
+		return 0
+	}
+	return nQueens(n).Count()
+}
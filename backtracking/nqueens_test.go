@@ -0,0 +1,46 @@
+package backtracking_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/backtracking"
+)
+
+func TestNQueens(t *testing.T) {
+	want := [][]int{{1, 3, 0, 2}, {2, 0, 3, 1}}
+	if got := backtracking.NQueens(4, 0); !reflect.DeepEqual(got, want) {
+		t.Errorf("NQueens(4) = %v, want %v", got, want)
+	}
+	if got := backtracking.NQueens(3, 0); len(got) != 0 {
+		t.Errorf("NQueens(3) = %v, want no solution", got)
+	}
+	if got := backtracking.NQueens(8, 1); len(got) != 1 || !reflect.DeepEqual(got[0], []int{0, 4, 7, 5, 2, 6, 1, 3}) {
+		t.Errorf("NQueens(8, 1) = %v", got)
+	}
+
+	// https://oeis.org/A000170
+	counts := []int{1, 1, 0, 0, 2, 10, 4, 40, 92, 352, 724}
+	for n, want := range counts {
+		if got := backtracking.CountNQueens(n); got != want {
+			t.Errorf("CountNQueens(%d) = %d, want %d", n, got, want)
+		}
+	}
+
+	for _, solution := range backtracking.NQueens(6, 0) {
+		for r1 := range solution {
+			for r2 := r1 + 1; r2 < len(solution); r2++ {
+				c1, c2 := solution[r1], solution[r2]
+				if c1 == c2 || c1-c2 == r1-r2 || c1-c2 == r2-r1 {
+					t.Fatalf("queens of %v attack each other", solution)
+				}
+			}
+		}
+	}
+}
+
+func BenchmarkCountNQueens(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		backtracking.CountNQueens(10)
+	}
+}
@@ -0,0 +1,59 @@
+// subsetsum.go
+// description: Subset sum solver on top of the backtracking engine
+// details:
+// A choice adds the number at some index after the last one chosen, so every
+// subset is built once, with its indices in increasing order. With the numbers
+// positive, a candidate is rejected when it overshoots the target, and also
+// when even all the numbers from there on cannot reach it, using suffix sums.
+// Unlike the dynamic programming solution in package dynamic, every subset is
+// listed and the target may be arbitrarily large.
+// time complexity: O(2^n) in the worst case
+// space complexity: O(n)
+// reference: https://en.wikipedia.org/wiki/Subset_sum_problem
+// see subsetsum_test.go
+
+package backtracking
+
+import "errors"
+
+// ErrNonPositive is returned when a number given to SubsetSum is not positive.
+var ErrNonPositive = errors.New("numbers must be positive")
+
+// SubsetSum returns up to limit subsets of numbers adding up to target, all
+// of them when limit is zero or negative. Each subset is given as the
+// increasing list of the indices of its numbers, so equal numbers at
+// different indices give different subsets.
+func SubsetSum(numbers []int, target, limit int) ([][]int, error) {
+	for _, v := range numbers {
+		if v <= 0 {
+			return nil, ErrNonPositive
+		}
+	}
+	// suffix[i] is the sum of numbers[i:].
+	suffix := make([]int, len(numbers)+1)
+	for i := len(numbers) - 1; i >= 0; i-- {
+		suffix[i] = suffix[i+1] + numbers[i]
+	}
+	sum := 0
+	s := Search[int]{
+		Candidates: func(partial []int) []int {
+			from := 0
+			if len(partial) > 0 {
+				from = partial[len(partial)-1] + 1
+			}
+			indices := make([]int, 0, len(numbers)-from)
+			for i := from; i < len(numbers); i++ {
+				indices = append(indices, i)
+			}
+			return indices
+		},
+		Reject: func(_ []int, i int) bool {
+			return sum+numbers[i] > target || sum+suffix[i] < target
+		},
+		Complete:     func([]int) bool { return sum == target },
+		Push:         func(i int) { sum += numbers[i] },
+		Pop:          func(i int) { sum -= numbers[i] },
+		MaxSolutions: limit,
+	}
+	return s.All(), nil
+}
@@ -0,0 +1,66 @@
+package backtracking_test
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/backtracking"
+	"github.com/TheAlgorithms/Go/dynamic"
+)
+
+func TestSubsetSum(t *testing.T) {
+	tests := []struct {
+		numbers []int
+		target  int
+		want    [][]int
+	}{
+		{[]int{3, 34, 4, 12, 5, 2}, 9, [][]int{{0, 2, 5}, {2, 4}}},
+		{[]int{1, 1, 2}, 2, [][]int{{0, 1}, {2}}},
+		{[]int{5, 7}, 3, nil},
+		{[]int{5, 7}, 0, [][]int{{}}},
+		{nil, 1, nil},
+	}
+	for _, test := range tests {
+		got, err := backtracking.SubsetSum(test.numbers, test.target, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got) != len(test.want) || (len(got) > 0 && !reflect.DeepEqual(got, test.want)) {
+			t.Errorf("SubsetSum(%v, %d) = %v, want %v", test.numbers, test.target, got, test.want)
+		}
+	}
+
+	got, _ := backtracking.SubsetSum([]int{1, 2, 3, 4, 5, 6}, 7, 2)
+	if len(got) != 2 {
+		t.Errorf("SubsetSum with limit 2 returned %d subsets", len(got))
+	}
+	if _, err := backtracking.SubsetSum([]int{1, 0}, 1, 0); err != backtracking.ErrNonPositive {
+		t.Errorf("SubsetSum with a zero: err = %v, want ErrNonPositive", err)
+	}
+}
+
+func TestSubsetSumAgainstDynamic(t *testing.T) {
+	rnd := rand.New(rand.NewSource(5))
+	for round := 0; round < 100; round++ {
+		numbers := make([]int, rnd.Intn(12))
+		for i := range numbers {
+			numbers[i] = 1 + rnd.Intn(20)
+		}
+		target := rnd.Intn(60)
+		subsets, _ := backtracking.SubsetSum(numbers, target, 0)
+		for _, subset := range subsets {
+			sum := 0
+			for _, i := range subset {
+				sum += numbers[i]
+			}
+			if sum != target {
+				t.Fatalf("subset %v of %v adds up to %d, want %d", subset, numbers, sum, target)
+			}
+		}
+		want, _ := dynamic.IsSubsetSum(numbers, target)
+		if (len(subsets) > 0) != want {
+			t.Fatalf("SubsetSum(%v, %d) found %d subsets, IsSubsetSum says %v", numbers, target, len(subsets), want)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+// sudoku.go
+// description: Sudoku solver on top of the backtracking engine
+// details:
+// A choice fills one empty cell with a digit. Rows, columns and boxes keep a
+// bit mask of the digits they already hold, updated by the Push and Pop hooks.
+// Every step fills the empty cell with the fewest possible digits first, so a
+// cell with a single option is filled at once and dead ends show up early.
+// time complexity: exponential in the number of empty cells in the worst case
+// space complexity: O(1) beyond the 81 cells
+// reference: https://en.wikipedia.org/wiki/Sudoku_solving_algorithms#Backtracking
+// see sudoku_test.go
+
+package backtracking
+
+import (
+	"errors"
+	"math/bits"
+)
+
+// Sudoku is a 9x9 grid of digits from 1 to 9, with 0 marking an empty cell.
+type Sudoku [9][9]int
+
+// ErrInvalidSudoku is returned when a grid holds a value outside 0 to 9 or
+// the same digit twice in a row, column or box.
+var ErrInvalidSudoku = errors.New("invalid sudoku grid")
+
+// move writes digit into the cell at row, column.
+type move struct {
+	row, column, digit int
+}
+
+// sudokuState holds the grid being filled and the digits used in every row,
+// column and box, as bit masks with bit d set for digit d.
+type sudokuState struct {
+	grid                 Sudoku
+	rows, columns, boxes [9]uint16
+	empty                int
+}
+
+func box(row, column int) int {
+	return row/3*3 + column/3
+}
+
+func (st *sudokuState) set(m move, filled bool) {
+	bit := uint16(1) << m.digit
+	st.rows[m.row] ^= bit
+	st.columns[m.column] ^= bit
+	st.boxes[box(m.row, m.column)] ^= bit
+	if filled {
+		st.grid[m.row][m.column] = m.digit
+		st.empty--
+	} else {
+		st.grid[m.row][m.column] = 0
+		st.empty++
+	}
+}
+
+// candidates returns the moves for the empty cell with the fewest options.
+func (st *sudokuState) candidates() []move {
+	bestRow, bestColumn, best := -1, -1, uint16(0)
+	for r := 0; r < 9; r++ {
+		for c := 0; c < 9; c++ {
+			if st.grid[r][c] != 0 {
+				continue
+			}
+			free := ^(st.rows[r] | st.columns[c] | st.boxes[box(r, c)]) & 0x3fe
+			if bestRow < 0 || bits.OnesCount16(free) < bits.OnesCount16(best) {
+				bestRow, bestColumn, best = r, c, free
+				if free == 0 {
+					return nil
+				}
+			}
+		}
+	}
+	var moves []move
+	for d := 1; d <= 9; d++ {
+		if best&(1<<d) != 0 {
+			moves = append(moves, move{bestRow, bestColumn, d})
+		}
+	}
+	return moves
+}
+
+// newSudokuState checks the givens of grid and records them.
+func newSudokuState(grid Sudoku) (*sudokuState, error) {
+	st := &sudokuState{}
+	for r := 0; r < 9; r++ {
+		for c := 0; c < 9; c++ {
+			d := grid[r][c]
+			switch {
+			case d == 0:
+				st.empty++
+			case d < 0 || d > 9:
+				return nil, ErrInvalidSudoku
+			default:
+				bit := uint16(1) << d
+				if (st.rows[r]|st.columns[c]|st.boxes[box(r, c)])&bit != 0 {
+					return nil, ErrInvalidSudoku
+				}
+				st.grid[r][c] = d
+				st.rows[r] |= bit
+				st.columns[c] |= bit
+				st.boxes[box(r, c)] |= bit
+			}
+		}
+	}
+	return st, nil
+}
+
+// sudokuSearch returns the search filling the empty cells of st.
+func sudokuSearch(st *sudokuState) Search[move] {
+	return Search[move]{
+		Candidates: func([]move) []move { return st.candidates() },
+		Complete:   func([]move) bool { return st.empty == 0 },
+		Push:       func(m move) { st.set(m, true) },
+		Pop:        func(m move) { st.set(m, false) },
+	}
+}
+
+// SolveSudoku returns a solution of grid and reports whether one exists.
+func SolveSudoku(grid Sudoku) (Sudoku, bool, error) {
+	st, err := newSudokuState(grid)
+	if err != nil {
+		return Sudoku{}, false, err
+	}
+	var solution Sudoku
+	found := false
+	sudokuSearch(st).Run(func([]move) bool {
+		solution, found = st.grid, true
+		return false
+	})
+	return solution, found, nil
+}
+
+// CountSudokuSolutions returns the number of solutions of grid, counting no
+// further than limit when limit is positive. A limit of 2 is enough to tell
+// whether a puzzle has a unique solution.
+func CountSudokuSolutions(grid Sudoku, limit int) (int, error) {
+	st, err := newSudokuState(grid)
+	if err != nil {
+		return 0, err
+	}
+	s := sudokuSearch(st)
+	s.MaxSolutions = limit
+	return s.Count(), nil
+}
@@ -0,0 +1,121 @@
+package backtracking_test
+
+import (
+	"testing"
+
+	"github.com/TheAlgorithms/Go/backtracking"
+)
+
+var puzzle = backtracking.Sudoku{
+	{5, 3, 0, 0, 7, 0, 0, 0, 0},
+	{6, 0, 0, 1, 9, 5, 0, 0, 0},
+	{0, 9, 8, 0, 0, 0, 0, 6, 0},
+	{8, 0, 0, 0, 6, 0, 0, 0, 3},
+	{4, 0, 0, 8, 0, 3, 0, 0, 1},
+	{7, 0, 0, 0, 2, 0, 0, 0, 6},
+	{0, 6, 0, 0, 0, 0, 2, 8, 0},
+	{0, 0, 0, 4, 1, 9, 0, 0, 5},
+	{0, 0, 0, 0, 8, 0, 0, 7, 9},
+}
+
+var solved = backtracking.Sudoku{
+	{5, 3, 4, 6, 7, 8, 9, 1, 2},
+	{6, 7, 2, 1, 9, 5, 3, 4, 8},
+	{1, 9, 8, 3, 4, 2, 5, 6, 7},
+	{8, 5, 9, 7, 6, 1, 4, 2, 3},
+	{4, 2, 6, 8, 5, 3, 7, 9, 1},
+	{7, 1, 3, 9, 2, 4, 8, 5, 6},
+	{9, 6, 1, 5, 3, 7, 2, 8, 4},
+	{2, 8, 7, 4, 1, 9, 6, 3, 5},
+	{3, 4, 5, 2, 8, 6, 1, 7, 9},
+}
+
+// validSudoku reports whether every row, column and box of g holds 1 to 9.
+func validSudoku(g backtracking.Sudoku) bool {
+	for i := 0; i < 9; i++ {
+		var row, column, box [10]bool
+		for j := 0; j < 9; j++ {
+			r, c := i/3*3+j/3, i%3*3+j%3
+			for _, seen := range []struct {
+				set *[10]bool
+				v   int
+			}{{&row, g[i][j]}, {&column, g[j][i]}, {&box, g[r][c]}} {
+				if seen.v < 1 || seen.v > 9 || seen.set[seen.v] {
+					return false
+				}
+				seen.set[seen.v] = true
+			}
+		}
+	}
+	return true
+}
+
+func TestSolveSudoku(t *testing.T) {
+	got, ok, err := backtracking.SolveSudoku(puzzle)
+	if err != nil || !ok {
+		t.Fatalf("SolveSudoku() = %v, %v", ok, err)
+	}
+	if got != solved {
+		t.Errorf("SolveSudoku() = %v, want %v", got, solved)
+	}
+	if n, _ := backtracking.CountSudokuSolutions(puzzle, 2); n != 1 {
+		t.Errorf("CountSudokuSolutions() = %d, want 1", n)
+	}
+
+	// A puzzle with 17 givens, the fewest possible for a unique solution.
+	hard := backtracking.Sudoku{
+		{0, 0, 0, 0, 0, 0, 0, 1, 0},
+		{4, 0, 0, 0, 0, 0, 0, 0, 0},
+		{0, 2, 0, 0, 0, 0, 0, 0, 0},
+		{0, 0, 0, 0, 5, 0, 4, 0, 7},
+		{0, 0, 8, 0, 0, 0, 3, 0, 0},
+		{0, 0, 1, 0, 9, 0, 0, 0, 0},
+		{3, 0, 0, 4, 0, 0, 2, 0, 0},
+		{0, 5, 0, 1, 0, 0, 0, 0, 0},
+		{0, 0, 0, 8, 0, 6, 0, 0, 0},
+	}
+	got, ok, _ = backtracking.SolveSudoku(hard)
+	if !ok || !validSudoku(got) {
+		t.Errorf("SolveSudoku(hard) = %v, %v", got, ok)
+	}
+	for r := 0; r < 9; r++ {
+		for c := 0; c < 9; c++ {
+			if hard[r][c] != 0 && got[r][c] != hard[r][c] {
+				t.Fatalf("SolveSudoku(hard) changed the given at %d, %d", r, c)
+			}
+		}
+	}
+
+	if n, _ := backtracking.CountSudokuSolutions(backtracking.Sudoku{}, 3); n != 3 {
+		t.Errorf("CountSudokuSolutions(empty grid, 3) = %d, want 3", n)
+	}
+	if got, ok, _ := backtracking.SolveSudoku(solved); !ok || got != solved {
+		t.Errorf("SolveSudoku(solved) should return the grid itself")
+	}
+}
+
+func TestSolveSudokuInvalid(t *testing.T) {
+	clash := puzzle
+	clash[0][2] = 5 // 5 is already in the row
+	if _, _, err := backtracking.SolveSudoku(clash); err != backtracking.ErrInvalidSudoku {
+		t.Errorf("SolveSudoku with a repeated digit: err = %v, want ErrInvalidSudoku", err)
+	}
+	outOfRange := puzzle
+	outOfRange[4][4] = 10
+	if _, err := backtracking.CountSudokuSolutions(outOfRange, 0); err != backtracking.ErrInvalidSudoku {
+		t.Errorf("CountSudokuSolutions with a 10: err = %v, want ErrInvalidSudoku", err)
+	}
+
+	// Consistent givens without any solution: the top left cell can hold
+	// neither 1 to 8, found in its row, nor 9, found in its column.
+	stuck := backtracking.Sudoku{{0, 1, 2, 3, 4, 5, 6, 7, 8}, {9}}
+	if _, ok, err := backtracking.SolveSudoku(stuck); ok || err != nil {
+		t.Errorf("SolveSudoku(stuck) = %v, %v, want false, nil", ok, err)
+	}
+}
+
+func BenchmarkSolveSudoku(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_, _, _ = backtracking.SolveSudoku(puzzle)
+	}
+}
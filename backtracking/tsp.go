@@ -0,0 +1,94 @@
+// tsp.go
+// description: Travelling salesman problem by branch and bound
+// details:
+// A state is a path from city 0 through some of the cities, and branches by
+// going on to each city not yet visited, until the path holds every city and
+// the tour closes back to city 0. Every city still to be left, the last of
+// the path and the unvisited ones, will be left exactly once along the rest
+// of the tour, by an edge no cheaper than the cheapest leaving it, so the
+// cost of the path plus those cheapest edges is a lower bound. Finding the
+// optimum this way is only practical for a few dozen cities at most.
+// time complexity: O(n!) in the worst case
+// space complexity: O(n!) in the worst case
+// reference: https://en.wikipedia.org/wiki/Travelling_salesman_problem#Exact_algorithms
+// see tsp_test.go
+
+package backtracking
+
+import (
+	"errors"
+	"math"
+)
+
+// ErrLengthMismatch is returned when the lengths of slices that must match
+// differ, such as the rows and columns of a distance matrix.
+var ErrLengthMismatch = errors.New("lengths do not match")
+
+// tourState is a path from city 0.
+type tourState struct {
+	path    []int
+	visited []bool
+	cost    float64
+	leaving float64 // the cheapest edges leaving the cities still to be left
+}
+
+// TravellingSalesman returns the length of a shortest tour visiting every
+// city once and coming back, dist[i][j] being the distance from city i to
+// city j, which need not equal dist[j][i], and the cities in the order of the
+// tour, starting from city 0. It returns ErrLengthMismatch if dist is not
+// square.
+func TravellingSalesman(dist [][]float64) (float64, []int, error) {
+	n := len(dist)
+	for _, row := range dist {
+		if len(row) != n {
+			return 0, nil, ErrLengthMismatch
+		}
+	}
+	if n <= 1 {
+		return 0, make([]int, n), nil
+	}
+	cheapest := make([]float64, n)
+	total := 0.0
+	for i := range dist {
+		cheapest[i] = math.Inf(1)
+		for j, d := range dist[i] {
+			if j != i && d < cheapest[i] {
+				cheapest[i] = d
+			}
+		}
+		total += cheapest[i]
+	}
+	bnb := BranchAndBound[tourState]{
+		Branch: func(s tourState) []tourState {
+			last := s.path[len(s.path)-1]
+			var next []tourState
+			for city := 0; city < n; city++ {
+				if s.visited[city] {
+					continue
+				}
+				visited := append([]bool(nil), s.visited...)
+				visited[city] = true
+				next = append(next, tourState{
+					path:    append(append(make([]int, 0, len(s.path)+1), s.path...), city),
+					visited: visited,
+					cost:    s.cost + dist[last][city],
+					leaving: s.leaving - cheapest[last],
+				})
+			}
+			return next
+		},
+		Bound: func(s tourState) float64 {
+			return s.cost + s.leaving
+		},
+		Complete: func(s tourState) (float64, bool) {
+			if len(s.path) < n {
+				return 0, false
+			}
+			return s.cost + dist[s.path[n-1]][0], true
+		},
+	}
+	visited := make([]bool, n)
+	visited[0] = true
+	result := bnb.Solve(tourState{path: []int{0}, visited: visited, leaving: total})
+	return result.Cost, result.Solution.path, nil
+}
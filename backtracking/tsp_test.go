@@ -0,0 +1,115 @@
+package backtracking_test
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/backtracking"
+)
+
+// tourLength returns the length of the closed tour.
+func tourLength(dist [][]float64, tour []int) float64 {
+	length := 0.0
+	for i, city := range tour {
+		length += dist[city][tour[(i+1)%len(tour)]]
+	}
+	return length
+}
+
+// bruteTravellingSalesman tries every tour from city 0.
+func bruteTravellingSalesman(dist [][]float64) float64 {
+	n := len(dist)
+	best := math.Inf(1)
+	tour := []int{0}
+	used := make([]bool, n)
+	used[0] = true
+	var extend func()
+	extend = func() {
+		if len(tour) == n {
+			best = math.Min(best, tourLength(dist, tour))
+			return
+		}
+		for c := 1; c < n; c++ {
+			if !used[c] {
+				used[c] = true
+				tour = append(tour, c)
+				extend()
+				tour = tour[:len(tour)-1]
+				used[c] = false
+			}
+		}
+	}
+	extend()
+	return best
+}
+
+func TestTravellingSalesman(t *testing.T) {
+	dist := [][]float64{
+		{0, 10, 15, 20},
+		{10, 0, 35, 25},
+		{15, 35, 0, 30},
+		{20, 25, 30, 0},
+	}
+	length, tour, err := backtracking.TravellingSalesman(dist)
+	if err != nil || length != 80 || tourLength(dist, tour) != 80 {
+		t.Errorf("TravellingSalesman = %v, %v, %v, want a tour of 80", length, tour, err)
+	}
+	if length, tour, err := backtracking.TravellingSalesman([][]float64{{0}}); err != nil || length != 0 || !reflect.DeepEqual(tour, []int{0}) {
+		t.Errorf("TravellingSalesman of one city = %v, %v, %v", length, tour, err)
+	}
+	if _, _, err := backtracking.TravellingSalesman([][]float64{{0, 1}, {1}}); !errors.Is(err, backtracking.ErrLengthMismatch) {
+		t.Errorf("TravellingSalesman of a ragged matrix error = %v", err)
+	}
+}
+
+func TestTravellingSalesmanRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for round := 0; round < 100; round++ {
+		n := 2 + rnd.Intn(7)
+		dist := make([][]float64, n)
+		for i := range dist {
+			dist[i] = make([]float64, n)
+			for j := range dist[i] {
+				if i != j {
+					dist[i][j] = float64(1 + rnd.Intn(50))
+				}
+			}
+		}
+		length, tour, err := backtracking.TravellingSalesman(dist)
+		if want := bruteTravellingSalesman(dist); err != nil || length != want || tourLength(dist, tour) != want {
+			t.Fatalf("TravellingSalesman(%v) = %v, %v, %v, want %v", dist, length, tour, err, want)
+		}
+		seen := make([]bool, n)
+		for _, c := range tour {
+			seen[c] = true
+		}
+		for c := range seen {
+			if !seen[c] || len(tour) != n || tour[0] != 0 {
+				t.Fatalf("TravellingSalesman(%v) returned %v, which is not a tour from 0", dist, tour)
+			}
+		}
+	}
+}
+
+func BenchmarkTravellingSalesman(b *testing.B) {
+	rnd := rand.New(rand.NewSource(1))
+	const n = 10
+	points := make([][2]float64, n)
+	for i := range points {
+		points[i] = [2]float64{rnd.Float64(), rnd.Float64()}
+	}
+	dist := make([][]float64, n)
+	for i := range dist {
+		dist[i] = make([]float64, n)
+		for j := range dist[i] {
+			dist[i][j] = math.Hypot(points[i][0]-points[j][0], points[i][1]-points[j][1])
+		}
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _, _ = backtracking.TravellingSalesman(dist)
+	}
+}
@@ -0,0 +1,153 @@
+package benchmarks_test
+
+import (
+	"bytes"
+	"flag"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/benchmarks"
+)
+
+const benchmarkSize = 10000
+
+func TestWorkloads(t *testing.T) {
+	if !reflect.DeepEqual(benchmarks.SetWorkloads(100, 1), benchmarks.SetWorkloads(100, 1)) {
+		t.Errorf("workloads with the same seed should be identical")
+	}
+	counts := func(w benchmarks.Workload) [3]int {
+		var c [3]int
+		for _, op := range w.Ops {
+			c[op.Kind]++
+		}
+		return c
+	}
+	if c := counts(benchmarks.InsertHeavy(1000, 1)); c[benchmarks.Insert] < 850 || c[benchmarks.Delete] != 0 {
+		t.Errorf("insert-heavy has %v insertions, lookups and deletions", c)
+	}
+	if w := benchmarks.ReadHeavy(1000, 1); len(w.Setup) != 1000 || counts(w)[benchmarks.Lookup] < 850 {
+		t.Errorf("read-heavy has %d setup keys and %v operations", len(w.Setup), counts(w))
+	}
+	if c := counts(benchmarks.Mixed(3000, 1)); c[0] < 900 || c[1] < 900 || c[2] < 900 {
+		t.Errorf("mixed has %v insertions, lookups and deletions", c)
+	}
+	w := benchmarks.Adversarial(100)
+	for i := 0; i < 100; i++ {
+		if w.Ops[i] != (benchmarks.Op{Kind: benchmarks.Insert, Key: i}) {
+			t.Fatalf("adversarial operation %d is %v", i, w.Ops[i])
+		}
+	}
+	w = benchmarks.Local(1000, 1)
+	hot := map[int]bool{}
+	for _, k := range w.Setup[:16] {
+		hot[k] = true
+	}
+	hits := 0
+	for _, op := range w.Ops {
+		if hot[op.Key] {
+			hits++
+		}
+	}
+	if c := counts(w); c[benchmarks.Lookup] != 1000 || hits < 850 {
+		t.Errorf("local has %v operations, %d of them on hot keys", c, hits)
+	}
+}
+
+// TestStructuresAgree checks that every structure gives the same answers on
+// every workload, so that the comparisons compare correct structures.
+func TestStructuresAgree(t *testing.T) {
+	for _, w := range benchmarks.SetWorkloads(2000, 2) {
+		want := -1
+		for _, s := range benchmarks.Sets() {
+			set := s.New()
+			for _, k := range w.Setup {
+				set.Insert(k)
+			}
+			got := benchmarks.ApplySet(set, w)
+			if want == -1 {
+				want = got
+			} else if got != want {
+				t.Errorf("%s on %s: %d hits, want %d", s.Name, w.Name, got, want)
+			}
+		}
+	}
+	for _, w := range benchmarks.QueueWorkloads(2000, 2) {
+		want := -1
+		for _, q := range benchmarks.Queues() {
+			queue := q.New()
+			for _, k := range w.Setup {
+				queue.Push(k)
+			}
+			got := benchmarks.ApplyQueue(queue, w)
+			if want == -1 {
+				want = got
+			} else if got != want {
+				t.Errorf("%s on %s: popped %d, want %d", q.Name, w.Name, got, want)
+			}
+		}
+	}
+}
+
+func TestWriteTable(t *testing.T) {
+	var buf bytes.Buffer
+	err := benchmarks.WriteTable(&buf, []benchmarks.Result{
+		{Workload: "mixed", Structure: "avl", NsPerOp: 200, BytesPerOp: 16, AllocsPerOp: 0.5},
+		{Workload: "mixed", Structure: "skip-list", NsPerOp: 100, BytesPerOp: 48, AllocsPerOp: 1},
+		{Workload: "empty", Structure: "avl"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 4 {
+		t.Fatalf("table has %d lines:\n%s", len(lines), buf.String())
+	}
+	for i, want := range [][]string{
+		{"workload", "structure", "ns/op", "B/op", "allocs/op", "relative"},
+		{"mixed", "avl", "200.0", "16.0", "0.50", "2.00x"},
+		{"mixed", "skip-list", "100.0", "48.0", "1.00", "1.00x"},
+		{"empty", "avl", "0.0", "0.0", "0.00", "-"},
+	} {
+		if got := strings.Fields(lines[i]); !reflect.DeepEqual(got, want) {
+			t.Errorf("line %d = %q, want %q", i, got, want)
+		}
+	}
+}
+
+func TestCompare(t *testing.T) {
+	// run every benchmark once, to check that the comparison completes
+	benchtime := flag.Lookup("test.benchtime")
+	previous := benchtime.Value.String()
+	if err := flag.Set("test.benchtime", "1x"); err != nil {
+		t.Fatal(err)
+	}
+	defer flag.Set("test.benchtime", previous)
+
+	sets := benchmarks.CompareSets(benchmarks.SetWorkloads(200, 1), benchmarks.Sets())
+	queues := benchmarks.CompareQueues(benchmarks.QueueWorkloads(200, 1), benchmarks.Queues())
+	if len(sets) != 5*len(benchmarks.Sets()) || len(queues) != 4*len(benchmarks.Queues()) {
+		t.Fatalf("got %d set and %d queue results", len(sets), len(queues))
+	}
+	for _, r := range append(sets, queues...) {
+		if r.NsPerOp <= 0 {
+			t.Errorf("%s on %s took %v ns/op", r.Structure, r.Workload, r.NsPerOp)
+		}
+	}
+}
+
+func BenchmarkSets(b *testing.B) {
+	for _, w := range benchmarks.SetWorkloads(benchmarkSize, 1) {
+		for _, s := range benchmarks.Sets() {
+			b.Run(w.Name+"/"+s.Name, benchmarks.SetBenchmark(w, s))
+		}
+	}
+}
+
+func BenchmarkQueues(b *testing.B) {
+	for _, w := range benchmarks.QueueWorkloads(benchmarkSize, 1) {
+		for _, q := range benchmarks.Queues() {
+			b.Run(w.Name+"/"+q.Name, benchmarks.QueueBenchmark(w, q))
+		}
+	}
+}
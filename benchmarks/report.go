@@ -0,0 +1,115 @@
+// report.go
+// description: Running the comparisons and reporting them as a table
+// details:
+// Every run of a benchmark builds a fresh structure, inserts the setup keys
+// with the timer stopped, and then runs the operations of the workload. The
+// results are divided by the number of operations, so that ns/op, B/op and
+// allocs/op are per operation rather than per workload, and can be compared
+// across workloads of different lengths. WriteTable prints them grouped by
+// workload, with the time of each structure relative to the fastest one.
+// see benchmarks_test.go
+
+package benchmarks
+
+import (
+	"fmt"
+	"io"
+	"testing"
+	"text/tabwriter"
+)
+
+// Result is the measure of one structure on one workload.
+type Result struct {
+	Workload    string
+	Structure   string
+	NsPerOp     float64
+	BytesPerOp  float64
+	AllocsPerOp float64
+}
+
+// benchmark returns a benchmark running w on structures built by newS, each
+// loaded with the setup keys by load, and run by apply.
+func benchmark[S any](w Workload, newS func() S, load func(S, int), apply func(S, Workload) int) func(b *testing.B) {
+	return func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			b.StopTimer()
+			s := newS()
+			for _, k := range w.Setup {
+				load(s, k)
+			}
+			b.StartTimer()
+			apply(s, w)
+		}
+	}
+}
+
+// SetBenchmark returns a benchmark running w on the set structure s.
+func SetBenchmark(w Workload, s Structure[Set]) func(b *testing.B) {
+	return benchmark(w, s.New, Set.Insert, ApplySet)
+}
+
+// QueueBenchmark returns a benchmark running w on the priority queue q.
+func QueueBenchmark(w Workload, q Structure[Queue]) func(b *testing.B) {
+	return benchmark(w, q.New, Queue.Push, ApplyQueue)
+}
+
+// result converts the measure of a benchmark of w to a Result.
+func result(w Workload, structure string, r testing.BenchmarkResult) Result {
+	ops := float64(r.N) * float64(len(w.Ops))
+	if ops == 0 {
+		return Result{Workload: w.Name, Structure: structure}
+	}
+	return Result{
+		Workload:    w.Name,
+		Structure:   structure,
+		NsPerOp:     float64(r.T.Nanoseconds()) / ops,
+		BytesPerOp:  float64(r.MemBytes) / ops,
+		AllocsPerOp: float64(r.MemAllocs) / ops,
+	}
+}
+
+// CompareSets measures every set structure on every workload.
+func CompareSets(workloads []Workload, sets []Structure[Set]) []Result {
+	var results []Result
+	for _, w := range workloads {
+		for _, s := range sets {
+			results = append(results, result(w, s.Name, testing.Benchmark(SetBenchmark(w, s))))
+		}
+	}
+	return results
+}
+
+// CompareQueues measures every priority queue on every workload.
+func CompareQueues(workloads []Workload, queues []Structure[Queue]) []Result {
+	var results []Result
+	for _, w := range workloads {
+		for _, q := range queues {
+			results = append(results, result(w, q.Name, testing.Benchmark(QueueBenchmark(w, q))))
+		}
+	}
+	return results
+}
+
+// WriteTable writes results as an aligned table, one row per result, with
+// the time of each structure relative to the fastest one on the same
+// workload.
+func WriteTable(w io.Writer, results []Result) error {
+	fastest := map[string]float64{}
+	for _, r := range results {
+		if f, ok := fastest[r.Workload]; !ok || r.NsPerOp < f {
+			fastest[r.Workload] = r.NsPerOp
+		}
+	}
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "workload\tstructure\tns/op\tB/op\tallocs/op\trelative\t")
+	for _, r := range results {
+		relative := "-"
+		if f := fastest[r.Workload]; f > 0 {
+			relative = fmt.Sprintf("%.2fx", r.NsPerOp/f)
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%.1f\t%.1f\t%.2f\t%s\t\n",
+			r.Workload, r.Structure, r.NsPerOp, r.BytesPerOp, r.AllocsPerOp, relative)
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,152 @@
+// structures.go
+// description: Competing structures of the comparisons
+// details:
+// The sets are the binary search tree, the AVL, Red-Black and splay trees,
+// the B-tree, the treap, the skip list and the van Emde Boas tree; the
+// priority queues are the binary, 4-ary, leftist and weak heaps. Each is
+// wrapped in the small interface its workloads need. Apply runs a workload on a structure and returns a checksum
+// of the answers, which is the same for every correct structure and keeps
+// the compiler from optimizing the operations away.
+// see benchmarks_test.go
+
+package benchmarks
+
+import (
+	"github.com/TheAlgorithms/Go/structure/heap"
+	"github.com/TheAlgorithms/Go/structure/skiplist"
+	"github.com/TheAlgorithms/Go/structure/treap"
+	"github.com/TheAlgorithms/Go/structure/tree"
+	"github.com/TheAlgorithms/Go/structure/veb"
+)
+
+// Set is a set of integer keys.
+type Set interface {
+	Insert(key int)
+	Has(key int) bool
+	Delete(key int)
+}
+
+// Queue is a min-priority queue of integers.
+type Queue interface {
+	Push(x int)
+	Top() int
+	Pop()
+	Empty() bool
+}
+
+// Structure is a named constructor of a competing structure.
+type Structure[S any] struct {
+	Name string
+	New  func() S
+}
+
+// treeSet adapts the binary trees of the tree package.
+type treeSet interface {
+	Push(keys ...int)
+	Has(key int) bool
+	Delete(key int) bool
+}
+
+type treeAdapter struct{ t treeSet }
+
+func (a treeAdapter) Insert(key int)   { a.t.Push(key) }
+func (a treeAdapter) Has(key int) bool { return a.t.Has(key) }
+func (a treeAdapter) Delete(key int)   { a.t.Delete(key) }
+
+type bTreeAdapter struct{ t *tree.BTree[int] }
+
+// Insert skips keys already present, since the B-tree stores duplicates.
+func (a bTreeAdapter) Insert(key int) {
+	if !a.t.Search(key) {
+		a.t.Insert(key)
+	}
+}
+func (a bTreeAdapter) Has(key int) bool { return a.t.Search(key) }
+func (a bTreeAdapter) Delete(key int)   { a.t.Delete(key) }
+
+type treapAdapter struct{ t *treap.Treap[int] }
+
+// Insert skips keys already present, since the treap is a multiset.
+func (a treapAdapter) Insert(key int) {
+	if !a.t.Has(key) {
+		a.t.Insert(key)
+	}
+}
+func (a treapAdapter) Has(key int) bool { return a.t.Has(key) }
+func (a treapAdapter) Delete(key int)   { a.t.Delete(key) }
+
+type skipListAdapter struct{ s *skiplist.SkipList[int] }
+
+func (a skipListAdapter) Insert(key int)   { a.s.Insert(key) }
+func (a skipListAdapter) Has(key int) bool { return a.s.Has(key) }
+func (a skipListAdapter) Delete(key int)   { a.s.Delete(key) }
+
+type vebAdapter struct{ v *veb.VEB }
+
+func (a vebAdapter) Insert(key int)   { a.v.Insert(key) }
+func (a vebAdapter) Has(key int) bool { return a.v.Has(key) }
+func (a vebAdapter) Delete(key int)   { a.v.Delete(key) }
+
+// vebUniverse holds the keys of every set workload.
+const vebUniverse = 1 << 30
+
+// Sets returns the competing set structures.
+func Sets() []Structure[Set] {
+	return []Structure[Set]{
+		{"bst", func() Set { return treeAdapter{tree.NewBinarySearch[int]()} }},
+		{"avl", func() Set { return treeAdapter{tree.NewAVL[int]()} }},
+		{"red-black", func() Set { return treeAdapter{tree.NewRB[int]()} }},
+		{"splay", func() Set { return treeAdapter{tree.NewSplay[int]()} }},
+		{"b-tree", func() Set { return bTreeAdapter{tree.NewBTree[int](16)} }},
+		{"treap", func() Set { return treapAdapter{treap.New[int](1)} }},
+		{"skip-list", func() Set { return skipListAdapter{skiplist.New[int](1)} }},
+		{"veb", func() Set { v, _ := veb.New(vebUniverse); return vebAdapter{v} }},
+	}
+}
+
+// Queues returns the competing priority queues.
+func Queues() []Structure[Queue] {
+	return []Structure[Queue]{
+		{"binary-heap", func() Queue { return heap.New[int]() }},
+		{"4-ary-heap", func() Queue { h, _ := heap.NewDAry[int](4); return h }},
+		{"leftist-heap", func() Queue { return heap.NewLeftist[int]() }},
+		{"weak-heap", func() Queue { return heap.NewWeak[int]() }},
+	}
+}
+
+// ApplySet runs the operations of w on s, which already holds the keys of
+// w.Setup, and returns the number of successful lookups.
+func ApplySet(s Set, w Workload) int {
+	hits := 0
+	for _, op := range w.Ops {
+		switch op.Kind {
+		case Insert:
+			s.Insert(op.Key)
+		case Lookup:
+			if s.Has(op.Key) {
+				hits++
+			}
+		case Delete:
+			s.Delete(op.Key)
+		}
+	}
+	return hits
+}
+
+// ApplyQueue runs the operations of w on q, which already holds the keys of
+// w.Setup, and returns the sum of the popped elements.
+func ApplyQueue(q Queue, w Workload) int {
+	sum := 0
+	for _, op := range w.Ops {
+		switch op.Kind {
+		case Insert:
+			q.Push(op.Key)
+		case Delete:
+			if !q.Empty() {
+				sum += q.Top()
+				q.Pop()
+			}
+		}
+	}
+	return sum
+}
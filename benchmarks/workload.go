@@ -0,0 +1,161 @@
+// workload.go
+// description: Standardized workloads for comparing containers
+// details:
+// A workload is a fixed sequence of operations generated from a seed, so that
+// every structure in a comparison runs exactly the same operations. Set
+// workloads mix insertions, lookups and deletions on integer keys, starting
+// from a set of keys inserted beforehand:
+// - insert-heavy: mostly insertions of random keys into an empty set
+// - read-heavy: mostly lookups in a set of n keys
+// - mixed: as many insertions, lookups and deletions
+// - adversarial: keys inserted in increasing order, the worst case of an
+//   unbalanced binary search tree, then looked up
+// - local: lookups in a set of n keys, nine in ten of them among a few hot
+//   keys, which rewards self-adjusting structures such as the splay tree
+// Queue workloads push and pop integer priorities in the same spirit.
+// see benchmarks_test.go
+
+// Package benchmarks compares competing data structures of the repository on
+// standardized workloads, measuring time and allocations per operation and
+// reporting them as a table, to help choose a structure based on data.
+// Run them with `go test -bench . -benchmem ./benchmarks`, or print a table
+// with Compare and WriteTable.
+package benchmarks
+
+import "math/rand"
+
+// OpKind is the kind of an operation of a workload.
+type OpKind int
+
+const (
+	// Insert adds a key to a set, or pushes a priority onto a queue.
+	Insert OpKind = iota
+	// Lookup asks a set whether it holds a key.
+	Lookup
+	// Delete removes a key from a set, or pops the smallest priority of a
+	// queue, ignoring the key.
+	Delete
+)
+
+// Op is one operation of a workload.
+type Op struct {
+	Kind OpKind
+	Key  int
+}
+
+// Workload is a named sequence of operations. The keys of Setup are
+// inserted before the operations, and are not measured.
+type Workload struct {
+	Name  string
+	Setup []int
+	Ops   []Op
+}
+
+// randomOps returns n operations with keys in [0, keys), the kind of each
+// being drawn with the given weights for Insert, Lookup and Delete.
+func randomOps(rnd *rand.Rand, n, keys int, weights [3]int) []Op {
+	total := weights[0] + weights[1] + weights[2]
+	ops := make([]Op, n)
+	for i := range ops {
+		kind, r := Insert, rnd.Intn(total)
+		for r >= weights[kind] {
+			r -= weights[kind]
+			kind++
+		}
+		ops[i] = Op{Kind: kind, Key: rnd.Intn(keys)}
+	}
+	return ops
+}
+
+// randomKeys returns n random keys in [0, keys).
+func randomKeys(rnd *rand.Rand, n, keys int) []int {
+	k := make([]int, n)
+	for i := range k {
+		k[i] = rnd.Intn(keys)
+	}
+	return k
+}
+
+// InsertHeavy returns n operations on an empty set, 90% of them insertions.
+func InsertHeavy(n int, seed int64) Workload {
+	rnd := rand.New(rand.NewSource(seed))
+	return Workload{Name: "insert-heavy", Ops: randomOps(rnd, n, 4*n, [3]int{9, 1, 0})}
+}
+
+// ReadHeavy returns n operations on a set of n random keys, 90% of them
+// lookups.
+func ReadHeavy(n int, seed int64) Workload {
+	rnd := rand.New(rand.NewSource(seed))
+	return Workload{Name: "read-heavy", Setup: randomKeys(rnd, n, 2*n), Ops: randomOps(rnd, n, 2*n, [3]int{1, 9, 0})}
+}
+
+// Mixed returns n operations on a set of n/2 random keys, equally split
+// between insertions, lookups and deletions.
+func Mixed(n int, seed int64) Workload {
+	rnd := rand.New(rand.NewSource(seed))
+	return Workload{Name: "mixed", Setup: randomKeys(rnd, n/2, n), Ops: randomOps(rnd, n, n, [3]int{1, 1, 1})}
+}
+
+// Adversarial returns n insertions of increasing keys followed by n lookups.
+func Adversarial(n int) Workload {
+	ops := make([]Op, 0, 2*n)
+	for i := 0; i < n; i++ {
+		ops = append(ops, Op{Kind: Insert, Key: i})
+	}
+	for i := 0; i < n; i++ {
+		ops = append(ops, Op{Kind: Lookup, Key: (i * 7919) % n})
+	}
+	return Workload{Name: "adversarial", Ops: ops}
+}
+
+// Local returns n lookups in a set of n random keys, nine in ten of them
+// among 16 hot keys of the set.
+func Local(n int, seed int64) Workload {
+	rnd := rand.New(rand.NewSource(seed))
+	setup := randomKeys(rnd, n, 2*n)
+	hot := setup
+	if len(hot) > 16 {
+		hot = hot[:16]
+	}
+	ops := make([]Op, n)
+	for i := range ops {
+		if len(hot) > 0 && rnd.Intn(10) < 9 {
+			ops[i] = Op{Kind: Lookup, Key: hot[rnd.Intn(len(hot))]}
+		} else {
+			ops[i] = Op{Kind: Lookup, Key: rnd.Intn(2 * n)}
+		}
+	}
+	return Workload{Name: "local", Setup: setup, Ops: ops}
+}
+
+// SetWorkloads returns the standard set workloads of about n operations.
+func SetWorkloads(n int, seed int64) []Workload {
+	return []Workload{InsertHeavy(n, seed), ReadHeavy(n, seed), Mixed(n, seed), Adversarial(n / 2), Local(n, seed)}
+}
+
+// QueueWorkloads returns the standard priority queue workloads of about n
+// operations:
+// - push-heavy: random pushes, one pop every ten
+// - heap-sort: n/2 random pushes, then as many pops
+// - mixed: as many pushes as pops, interleaved, on a queue of n/2 elements
+// - adversarial: decreasing priorities, each of which rises to the top
+func QueueWorkloads(n int, seed int64) []Workload {
+	rnd := rand.New(rand.NewSource(seed))
+	heapSort := make([]Op, 0, n)
+	for i := 0; i < n/2; i++ {
+		heapSort = append(heapSort, Op{Kind: Insert, Key: rnd.Intn(n)})
+	}
+	for i := 0; i < n/2; i++ {
+		heapSort = append(heapSort, Op{Kind: Delete})
+	}
+	decreasing := make([]Op, n)
+	for i := range decreasing {
+		decreasing[i] = Op{Kind: Insert, Key: n - i}
+	}
+	return []Workload{
+		{Name: "push-heavy", Ops: randomOps(rnd, n, n, [3]int{9, 0, 1})},
+		{Name: "heap-sort", Ops: heapSort},
+		{Name: "mixed", Setup: randomKeys(rnd, n/2, n), Ops: randomOps(rnd, n, n, [3]int{1, 0, 1})},
+		{Name: "adversarial", Ops: decreasing},
+	}
+}
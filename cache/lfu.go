@@ -125,3 +125,42 @@ func (c *LFU) eliminate() {
 
 	delete(c.itemMap, obj.key)
 }
+
+// Clone returns an independent copy of the cache with the same capacity and
+// frequencies. Every value is passed through cloneValue, if it is not nil.
+func (c *LFU) Clone(cloneValue func(any) any) LFU {
+	clone := NewLFU(c.cap)
+	clone.len, clone.minFreq = c.len, c.minFreq
+	for freq, l := range c.freqMap {
+		cl := list.New()
+		clone.freqMap[freq] = cl
+		for e := l.Front(); e != nil; e = e.Next() {
+			obj := e.Value.(item)
+			if cloneValue != nil {
+				obj.value = cloneValue(obj.value)
+			}
+			clone.itemMap[obj.key] = cl.PushBack(obj)
+		}
+	}
+	return clone
+}
+
+// Equal reports whether the two caches have the same capacity and hold the
+// same keys with the same frequencies, with values equal according to eq,
+// or to reflect.DeepEqual if eq is nil.
+func (c *LFU) Equal(other *LFU, eq func(a, b any) bool) bool {
+	if c.cap != other.cap || len(c.itemMap) != len(other.itemMap) {
+		return false
+	}
+	for key, e := range c.itemMap {
+		o, ok := other.itemMap[key]
+		if !ok {
+			return false
+		}
+		a, b := e.Value.(item), o.Value.(item)
+		if a.freq != b.freq || !equalValues(a.value, b.value, eq) {
+			return false
+		}
+	}
+	return true
+}
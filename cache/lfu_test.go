@@ -56,3 +56,28 @@ func TestLFU(t *testing.T) {
 
 	})
 }
+
+func TestLFUClone(t *testing.T) {
+	c := cache.NewLFU(2)
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Get("a")
+
+	clone := c.Clone(nil)
+	if !c.Equal(&clone, nil) {
+		t.Fatalf("a clone should equal its original")
+	}
+
+	clone.Put("c", 3)
+	if clone.Get("b") != nil || c.Get("b") != 2 {
+		t.Errorf("evicting from a clone should not affect the original")
+	}
+	if c.Equal(&clone, nil) {
+		t.Errorf("caches with different keys should not be equal")
+	}
+
+	other := cache.NewLFU(3)
+	if empty := cache.NewLFU(3); !other.Equal(&empty, nil) {
+		t.Errorf("empty caches should be equal")
+	}
+}
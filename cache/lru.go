@@ -8,6 +8,8 @@
 package cache
 
 import (
+	"reflect"
+
 	"github.com/TheAlgorithms/Go/structure/linkedlist"
 )
 
@@ -20,17 +22,17 @@ type item struct {
 }
 
 type LRU struct {
-	dl       *linkedlist.Doubly[any]
+	dl       *linkedlist.List[item]
 	size     int
 	capacity int
-	storage  map[string]*linkedlist.Node[any]
+	storage  map[string]*linkedlist.Element[item]
 }
 
 // NewLRU represent initiate lru cache with capacity
 func NewLRU(capacity int) LRU {
 	return LRU{
-		dl:       linkedlist.NewDoubly[any](),
-		storage:  make(map[string]*linkedlist.Node[any], capacity),
+		dl:       linkedlist.NewList[item](),
+		storage:  make(map[string]*linkedlist.Element[item], capacity),
 		size:     0,
 		capacity: capacity,
 	}
@@ -42,7 +44,7 @@ func (c *LRU) Get(key string) any {
 	v, ok := c.storage[key]
 	if ok {
 		c.dl.MoveToBack(v)
-		return v.Val.(item).value
+		return v.Value.value
 	}
 
 	return nil
@@ -52,24 +54,57 @@ func (c *LRU) Get(key string) any {
 func (c *LRU) Put(key string, value any) {
 	e, ok := c.storage[key]
 	if ok {
-		n := e.Val.(item)
-		n.value = value
-		e.Val = n
+		e.Value.value = value
 		c.dl.MoveToBack(e)
 		return
 	}
 
 	if c.size >= c.capacity {
 		e := c.dl.Front()
-		dk := e.Val.(item).key
 		c.dl.Remove(e)
-		delete(c.storage, dk)
+		delete(c.storage, e.Value.key)
 		c.size--
 	}
 
-	n := item{key: key, value: value}
-	c.dl.AddAtEnd(n)
-	ne := c.dl.Back()
-	c.storage[key] = ne
+	c.storage[key] = c.dl.PushBack(item{key: key, value: value})
 	c.size++
 }
+
+// Clone returns an independent copy of the cache with the same capacity and
+// recency order. Every value is passed through cloneValue, if it is not nil.
+func (c *LRU) Clone(cloneValue func(any) any) LRU {
+	clone := NewLRU(c.capacity)
+	for e := c.dl.Front(); e != nil; e = e.Next() {
+		it := e.Value
+		if cloneValue != nil {
+			it.value = cloneValue(it.value)
+		}
+		clone.storage[it.key] = clone.dl.PushBack(it)
+		clone.size++
+	}
+	return clone
+}
+
+// Equal reports whether the two caches have the same capacity and hold the
+// same keys in the same recency order, with values equal according to eq,
+// or to reflect.DeepEqual if eq is nil.
+func (c *LRU) Equal(other *LRU, eq func(a, b any) bool) bool {
+	if c.capacity != other.capacity || c.size != other.size {
+		return false
+	}
+	for a, b := c.dl.Front(), other.dl.Front(); a != nil; a, b = a.Next(), b.Next() {
+		if a.Value.key != b.Value.key || !equalValues(a.Value.value, b.Value.value, eq) {
+			return false
+		}
+	}
+	return true
+}
+
+// equalValues compares two cached values with eq, or with reflect.DeepEqual
+// if eq is nil, so values that are not comparable with == do not panic.
+func equalValues(a, b any, eq func(a, b any) bool) bool {
+	if eq == nil {
+		return reflect.DeepEqual(a, b)
+	}
+	return eq(a, b)
+}
@@ -57,3 +57,48 @@ func TestLRU(t *testing.T) {
 
 	})
 }
+
+func TestLRUClone(t *testing.T) {
+	c := cache.NewLRU(2)
+	c.Put("a", []int{1})
+	c.Put("b", []int{2})
+
+	sameSlice := func(a, b any) bool { return a.([]int)[0] == b.([]int)[0] }
+	clone := c.Clone(func(v any) any { return append([]int(nil), v.([]int)...) })
+	if !c.Equal(&clone, sameSlice) {
+		t.Fatalf("a clone should equal its original")
+	}
+	if !c.Equal(&clone, nil) {
+		t.Fatalf("a clone should equal its original without an eq callback")
+	}
+
+	// the clone evicts on its own
+	clone.Get("a")
+	clone.Put("c", []int{3})
+	if clone.Get("b") != nil || c.Get("b") == nil {
+		t.Errorf("evicting from a clone should not affect the original")
+	}
+
+	// and holds copies of the values
+	c.Get("a").([]int)[0] = 100
+	if got := clone.Get("a").([]int)[0]; got != 1 {
+		t.Errorf("clone value changed to %d with the original", got)
+	}
+	if c.Equal(&clone, sameSlice) {
+		t.Errorf("caches with different keys should not be equal")
+	}
+
+	// recency order matters
+	x, y := cache.NewLRU(2), cache.NewLRU(2)
+	x.Put("a", 1)
+	x.Put("b", 2)
+	y.Put("b", 2)
+	y.Put("a", 1)
+	if x.Equal(&y, nil) {
+		t.Errorf("caches with a different recency order should not be equal")
+	}
+	y.Get("b")
+	if !x.Equal(&y, nil) {
+		t.Errorf("caches with the same entries in the same order should be equal")
+	}
+}
@@ -0,0 +1,80 @@
+// cipher.go
+// description: Uniform interface to the ciphers of this package
+// details:
+// Every cipher of this package turns a key into a Stream that transforms its
+// input piece by piece, so that whole messages (Encrypt, Decrypt) and
+// arbitrarily large streams (NewReader) go through the same code. Byte
+// oriented ciphers transform every byte as it comes; block ciphers hold back
+// an incomplete block until more input or the end of the stream arrives.
+// see cipher_test.go
+
+package cipher
+
+import "errors"
+
+var (
+	// ErrInvalidKey is returned for a key the cipher cannot use, for example
+	// an empty key or a key of the wrong size.
+	ErrInvalidKey = errors.New("invalid key")
+	// ErrInvalidCiphertext is returned when decrypting data that no
+	// encryption can produce, for example data with a wrong padding.
+	ErrInvalidCiphertext = errors.New("invalid ciphertext")
+)
+
+// Stream is the state of a cipher transforming one stream.
+type Stream interface {
+	// Update appends the transformation of the next bytes of the stream to
+	// dst and returns the extended slice.
+	Update(dst, src []byte) []byte
+	// Final appends the rest of the output at the end of the stream to dst.
+	Final(dst []byte) ([]byte, error)
+}
+
+// Cipher encrypts and decrypts with a key, either a whole message at once or
+// a stream through a Stream.
+type Cipher interface {
+	Encrypt(data, key []byte) ([]byte, error)
+	Decrypt(data, key []byte) ([]byte, error)
+	NewEncrypter(key []byte) (Stream, error)
+	NewDecrypter(key []byte) (Stream, error)
+}
+
+// transform runs all of data through the stream returned by newStream.
+func transform(newStream func(key []byte) (Stream, error), data, key []byte) ([]byte, error) {
+	s, err := newStream(key)
+	if err != nil {
+		return nil, err
+	}
+	out, err := s.Final(s.Update(make([]byte, 0, len(data)), data))
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// byteStream transforms every byte independently of the others but maybe not
+// of its position.
+type byteStream func(b byte) byte
+
+func (f byteStream) Update(dst, src []byte) []byte {
+	for _, b := range src {
+		dst = append(dst, f(b))
+	}
+	return dst
+}
+
+func (f byteStream) Final(dst []byte) ([]byte, error) {
+	return dst, nil
+}
+
+// chunkStream transforms every piece of the stream as a whole, for ciphers
+// implemented over whole messages by another package.
+type chunkStream func(src []byte) []byte
+
+func (f chunkStream) Update(dst, src []byte) []byte {
+	return append(dst, f(src)...)
+}
+
+func (f chunkStream) Final(dst []byte) ([]byte, error) {
+	return dst, nil
+}
@@ -1,3 +1,158 @@
-// Empty test file to keep track of all the tests for the algorithms.
-
 package cipher
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"io"
+	"math/rand"
+	"testing"
+	"testing/iotest"
+)
+
+func TestKnownCiphertexts(t *testing.T) {
+	tests := []struct {
+		name       string
+		c          Cipher
+		key        string
+		plaintext  string
+		ciphertext string
+	}{
+		{"Caesar", Caesar{}, "\x03", "Hello, World! xyz", "Khoor, Zruog! abc"},
+		{"Caesar large key", Caesar{}, "\x1d", "abc", "def"},
+		{"Vigenere", Vigenere{}, "LEMON", "ATTACKATDAWN", "LXFOPVEFRNHR"},
+		{"Vigenere mixed case", Vigenere{}, "lemon", "Attack at dawn!", "Lxfopv ef rnhr!"},
+		{"XOR", XOR{}, "\x01\x02", "abcd", "``bf"},
+		{"RC4", RC4{}, "Key", "Plaintext", "\xbb\xf3\x16\xe8\xd9\x40\xaf\x0a\xd3"},
+		{"RC4 Wiki", RC4{}, "Wiki", "pedia", "\x10\x21\xbf\x04\x20"},
+		{"DES", DES{}, "\x13\x34\x57\x79\x9b\xbc\xdf\xf1", "\x01\x23\x45\x67\x89\xab\xcd\xef",
+			"\x85\xe8\x13\x54\x0f\x0a\xb4\x05"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.c.Encrypt([]byte(test.plaintext), []byte(test.key))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if test.name == "DES" {
+				// The published test vector is the first block, the
+				// second one is the padding.
+				got = got[:DESBlockSize]
+			}
+			if string(got) != test.ciphertext {
+				t.Errorf("Encrypt(%q) = %x, want %x", test.plaintext, got, test.ciphertext)
+			}
+		})
+	}
+}
+
+func TestDESRounds(t *testing.T) {
+	block, _ := hex.DecodeString("0123456789abcdef")
+	key, _ := hex.DecodeString("133457799bbcdff1")
+	rounds := DESRounds(*(*[8]byte)(block), *(*[8]byte)(key))
+	for i, want := range map[int]uint64{0: 0xcc00ccff_f0aaf0aa, 1: 0xf0aaf0aa_ef4a6544, 16: 0x43423234_0a4cd995} {
+		if rounds[i] != want {
+			t.Errorf("round %d: got %016x, want %016x", i, rounds[i], want)
+		}
+	}
+	subkeys := DESSubkeys(*(*[8]byte)(key))
+	if subkeys[0] != 0b000110_110000_001011_101111_111111_000111_000001_110010 {
+		t.Errorf("first subkey = %048b", subkeys[0])
+	}
+}
+
+var allCiphers = []struct {
+	name string
+	c    Cipher
+	key  []byte
+}{
+	{"Caesar", Caesar{}, []byte{7}},
+	{"Vigenere", Vigenere{}, []byte("Secret")},
+	{"XOR", XOR{}, []byte("key")},
+	{"RC4", RC4{}, []byte("a secret key")},
+	{"DES", DES{}, []byte("8bytekey")},
+}
+
+func TestRoundTrip(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	inputs := [][]byte{nil, []byte("a"), []byte("exactly8"), []byte("The quick brown fox jumps over the lazy dog.")}
+	for _, n := range []int{7, 8, 9, 100000} {
+		data := make([]byte, n)
+		rnd.Read(data)
+		inputs = append(inputs, data)
+	}
+	for _, test := range allCiphers {
+		for _, data := range inputs {
+			encrypted, err := test.c.Encrypt(data, test.key)
+			if err != nil {
+				t.Fatalf("%s: Encrypt: %v", test.name, err)
+			}
+			decrypted, err := test.c.Decrypt(encrypted, test.key)
+			if err != nil {
+				t.Fatalf("%s: Decrypt: %v", test.name, err)
+			}
+			if !bytes.Equal(decrypted, data) {
+				t.Fatalf("%s: round trip of %d bytes failed", test.name, len(data))
+			}
+		}
+	}
+}
+
+func TestReader(t *testing.T) {
+	data := make([]byte, 3*readerBufferSize+5)
+	rand.New(rand.NewSource(2)).Read(data)
+	for _, test := range allCiphers {
+		want, _ := test.c.Encrypt(data, test.key)
+		enc, _ := test.c.NewEncrypter(test.key)
+		got, err := io.ReadAll(NewReader(iotest.HalfReader(bytes.NewReader(data)), enc))
+		if err != nil || !bytes.Equal(got, want) {
+			t.Fatalf("%s: streaming encryption differs from Encrypt (%v)", test.name, err)
+		}
+		dec, _ := test.c.NewDecrypter(test.key)
+		if err := iotest.TestReader(NewReader(bytes.NewReader(want), dec), data); err != nil {
+			t.Fatalf("%s: streaming decryption: %v", test.name, err)
+		}
+	}
+}
+
+func TestInvalidKeys(t *testing.T) {
+	for _, test := range []struct {
+		c   Cipher
+		key string
+	}{
+		{Caesar{}, ""}, {Caesar{}, "ab"}, {Vigenere{}, ""}, {Vigenere{}, "key1"}, {XOR{}, ""},
+		{RC4{}, ""}, {RC4{}, string(make([]byte, 257))}, {DES{}, "7 bytes"}, {DES{}, "nine byte"},
+	} {
+		if _, err := test.c.Encrypt([]byte("data"), []byte(test.key)); !errors.Is(err, ErrInvalidKey) {
+			t.Errorf("%T with key %q: err = %v, want ErrInvalidKey", test.c, test.key, err)
+		}
+	}
+}
+
+func TestDESInvalidCiphertext(t *testing.T) {
+	key := []byte("8bytekey")
+	encrypted, _ := DES{}.Encrypt([]byte("some text"), key)
+	tampered := append([]byte(nil), encrypted...)
+	tampered[len(tampered)-1] ^= 0x80
+	for _, data := range [][]byte{nil, encrypted[:len(encrypted)-1], tampered} {
+		if got, err := (DES{}).Decrypt(data, key); !errors.Is(err, ErrInvalidCiphertext) {
+			t.Errorf("Decrypt(%x) = %q, %v, want ErrInvalidCiphertext", data, got, err)
+		}
+	}
+	dec, _ := DES{}.NewDecrypter(key)
+	if _, err := io.ReadAll(NewReader(bytes.NewReader(encrypted[:12]), dec)); !errors.Is(err, ErrInvalidCiphertext) {
+		t.Errorf("reading a truncated ciphertext: err = %v, want ErrInvalidCiphertext", err)
+	}
+}
+
+func BenchmarkEncrypt(b *testing.B) {
+	data := make([]byte, 1<<16)
+	for _, test := range allCiphers {
+		b.Run(test.name, func(b *testing.B) {
+			b.SetBytes(int64(len(data)))
+			for i := 0; i < b.N; i++ {
+				_, _ = test.c.Encrypt(data, test.key)
+			}
+		})
+	}
+}
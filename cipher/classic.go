@@ -0,0 +1,125 @@
+// classic.go
+// description: Caesar, Vigenère and repeating-key XOR ciphers over bytes
+// details:
+// Caesar shifts every letter by the same amount, Vigenère shifts the letters
+// by the letters of a key word in turn and XOR combines every byte with the
+// key bytes in turn. Caesar and Vigenère keep the case of the letters and
+// leave the other bytes unchanged; they are broken by frequency analysis, and
+// XOR by anyone knowing part of the plaintext, so they are of historical and
+// educational interest only. Caesar and XOR are the ciphers of the caesar and
+// xor packages behind the Cipher interface.
+// time complexity: O(n)
+// space complexity: O(n) for Encrypt and Decrypt, O(1) for a Stream
+// reference: https://en.wikipedia.org/wiki/Caesar_cipher
+// reference: https://en.wikipedia.org/wiki/Vigen%C3%A8re_cipher
+// reference: https://en.wikipedia.org/wiki/XOR_cipher
+// see cipher_test.go
+
+package cipher
+
+import (
+	"github.com/TheAlgorithms/Go/cipher/caesar"
+	"github.com/TheAlgorithms/Go/cipher/xor"
+)
+
+// shiftLetter shifts b by shift places in the alphabet if it is a letter.
+func shiftLetter(b, shift byte) byte {
+	switch {
+	case 'A' <= b && b <= 'Z':
+		return 'A' + (b-'A'+shift)%26
+	case 'a' <= b && b <= 'z':
+		return 'a' + (b-'a'+shift)%26
+	}
+	return b
+}
+
+func isLetter(b byte) bool {
+	return ('A' <= b && b <= 'Z') || ('a' <= b && b <= 'z')
+}
+
+// Caesar shifts letters by key[0] modulo 26 places; the key has one byte.
+type Caesar struct{}
+
+func (c Caesar) stream(key []byte, decrypt bool) (Stream, error) {
+	if len(key) != 1 {
+		return nil, ErrInvalidKey
+	}
+	shift := int(key[0])
+	if decrypt {
+		return chunkStream(func(src []byte) []byte { return []byte(caesar.Decrypt(string(src), shift)) }), nil
+	}
+	return chunkStream(func(src []byte) []byte { return []byte(caesar.Encrypt(string(src), shift)) }), nil
+}
+
+func (c Caesar) NewEncrypter(key []byte) (Stream, error) { return c.stream(key, false) }
+func (c Caesar) NewDecrypter(key []byte) (Stream, error) { return c.stream(key, true) }
+func (c Caesar) Encrypt(data, key []byte) ([]byte, error) {
+	return transform(c.NewEncrypter, data, key)
+}
+func (c Caesar) Decrypt(data, key []byte) ([]byte, error) {
+	return transform(c.NewDecrypter, data, key)
+}
+
+// Vigenere shifts the letters by the letters of the key in turn, A or a
+// shifting by 0 places; the key is a non-empty word of letters. Other bytes do
+// not use a letter of the key.
+type Vigenere struct{}
+
+func (v Vigenere) stream(key []byte, decrypt bool) (Stream, error) {
+	if len(key) == 0 {
+		return nil, ErrInvalidKey
+	}
+	shifts := make([]byte, len(key))
+	for i, k := range key {
+		if !isLetter(k) {
+			return nil, ErrInvalidKey
+		}
+		shifts[i] = (k | 0x20) - 'a' // lower case
+		if decrypt {
+			shifts[i] = (26 - shifts[i]) % 26
+		}
+	}
+	i := 0
+	return byteStream(func(b byte) byte {
+		if !isLetter(b) {
+			return b
+		}
+		b = shiftLetter(b, shifts[i])
+		i = (i + 1) % len(shifts)
+		return b
+	}), nil
+}
+
+func (v Vigenere) NewEncrypter(key []byte) (Stream, error) { return v.stream(key, false) }
+func (v Vigenere) NewDecrypter(key []byte) (Stream, error) { return v.stream(key, true) }
+func (v Vigenere) Encrypt(data, key []byte) ([]byte, error) {
+	return transform(v.NewEncrypter, data, key)
+}
+func (v Vigenere) Decrypt(data, key []byte) ([]byte, error) {
+	return transform(v.NewDecrypter, data, key)
+}
+
+// XOR combines every byte with the bytes of a non-empty key in turn.
+// Encryption and decryption are the same operation.
+type XOR struct{}
+
+func (x XOR) NewEncrypter(key []byte) (Stream, error) {
+	if len(key) == 0 {
+		return nil, ErrInvalidKey
+	}
+	key = append([]byte(nil), key...)
+	offset := 0
+	return chunkStream(func(src []byte) []byte {
+		out := xor.EncryptRepeating(key, offset, src)
+		offset = (offset + len(src)) % len(key)
+		return out
+	}), nil
+}
+
+func (x XOR) NewDecrypter(key []byte) (Stream, error) { return x.NewEncrypter(key) }
+func (x XOR) Encrypt(data, key []byte) ([]byte, error) {
+	return transform(x.NewEncrypter, data, key)
+}
+func (x XOR) Decrypt(data, key []byte) ([]byte, error) {
+	return transform(x.NewDecrypter, data, key)
+}
@@ -0,0 +1,273 @@
+// des.go
+// description: Data Encryption Standard (DES) block cipher
+// details:
+// DES encrypts blocks of 64 bits with a key of 64 bits, of which 56 are used.
+// After an initial permutation, the block is split into two halves and goes
+// through 16 Feistel rounds: the right half is expanded to 48 bits, XORed with
+// the round key, reduced back to 32 bits by the eight S-boxes, permuted and
+// XORed into the left half, then the halves are swapped. Decryption runs the
+// same rounds with the round keys in reverse order. DESRounds exposes the
+// halves after every round to follow the algorithm step by step.
+// Messages are encrypted block by block (ECB mode) after PKCS#7 padding, so
+// equal plaintext blocks give equal ciphertext blocks; together with the key
+// size, which makes exhaustive search practical, this makes DES unfit to
+// protect data.
+// time complexity: O(n)
+// space complexity: O(n) for Encrypt and Decrypt, O(1) for a Stream
+// reference: https://en.wikipedia.org/wiki/Data_Encryption_Standard
+// reference: https://csrc.nist.gov/pubs/fips/46-3/final
+// see cipher_test.go
+
+package cipher
+
+import "encoding/binary"
+
+// DESBlockSize is the size of a DES block and of a DES key in bytes.
+const DESBlockSize = 8
+
+// The tables number the bits from 1, the most significant one, as FIPS 46-3
+// does.
+var (
+	desInitialPermutation = [64]byte{
+		58, 50, 42, 34, 26, 18, 10, 2, 60, 52, 44, 36, 28, 20, 12, 4,
+		62, 54, 46, 38, 30, 22, 14, 6, 64, 56, 48, 40, 32, 24, 16, 8,
+		57, 49, 41, 33, 25, 17, 9, 1, 59, 51, 43, 35, 27, 19, 11, 3,
+		61, 53, 45, 37, 29, 21, 13, 5, 63, 55, 47, 39, 31, 23, 15, 7,
+	}
+	desFinalPermutation = [64]byte{
+		40, 8, 48, 16, 56, 24, 64, 32, 39, 7, 47, 15, 55, 23, 63, 31,
+		38, 6, 46, 14, 54, 22, 62, 30, 37, 5, 45, 13, 53, 21, 61, 29,
+		36, 4, 44, 12, 52, 20, 60, 28, 35, 3, 43, 11, 51, 19, 59, 27,
+		34, 2, 42, 10, 50, 18, 58, 26, 33, 1, 41, 9, 49, 17, 57, 25,
+	}
+	desExpansion = [48]byte{
+		32, 1, 2, 3, 4, 5, 4, 5, 6, 7, 8, 9,
+		8, 9, 10, 11, 12, 13, 12, 13, 14, 15, 16, 17,
+		16, 17, 18, 19, 20, 21, 20, 21, 22, 23, 24, 25,
+		24, 25, 26, 27, 28, 29, 28, 29, 30, 31, 32, 1,
+	}
+	desPermutation = [32]byte{
+		16, 7, 20, 21, 29, 12, 28, 17, 1, 15, 23, 26, 5, 18, 31, 10,
+		2, 8, 24, 14, 32, 27, 3, 9, 19, 13, 30, 6, 22, 11, 4, 25,
+	}
+	desPermutedChoice1 = [56]byte{
+		57, 49, 41, 33, 25, 17, 9, 1, 58, 50, 42, 34, 26, 18,
+		10, 2, 59, 51, 43, 35, 27, 19, 11, 3, 60, 52, 44, 36,
+		63, 55, 47, 39, 31, 23, 15, 7, 62, 54, 46, 38, 30, 22,
+		14, 6, 61, 53, 45, 37, 29, 21, 13, 5, 28, 20, 12, 4,
+	}
+	desPermutedChoice2 = [48]byte{
+		14, 17, 11, 24, 1, 5, 3, 28, 15, 6, 21, 10,
+		23, 19, 12, 4, 26, 8, 16, 7, 27, 20, 13, 2,
+		41, 52, 31, 37, 47, 55, 30, 40, 51, 45, 33, 48,
+		44, 49, 39, 56, 34, 53, 46, 42, 50, 36, 29, 32,
+	}
+	desRotations = [16]byte{1, 1, 2, 2, 2, 2, 2, 2, 1, 2, 2, 2, 2, 2, 2, 1}
+	// desSBoxes[i][row][column] with the row given by the outer bits of the
+	// 6-bit input and the column by the inner ones.
+	desSBoxes = [8][4][16]byte{
+		{
+			{14, 4, 13, 1, 2, 15, 11, 8, 3, 10, 6, 12, 5, 9, 0, 7},
+			{0, 15, 7, 4, 14, 2, 13, 1, 10, 6, 12, 11, 9, 5, 3, 8},
+			{4, 1, 14, 8, 13, 6, 2, 11, 15, 12, 9, 7, 3, 10, 5, 0},
+			{15, 12, 8, 2, 4, 9, 1, 7, 5, 11, 3, 14, 10, 0, 6, 13},
+		},
+		{
+			{15, 1, 8, 14, 6, 11, 3, 4, 9, 7, 2, 13, 12, 0, 5, 10},
+			{3, 13, 4, 7, 15, 2, 8, 14, 12, 0, 1, 10, 6, 9, 11, 5},
+			{0, 14, 7, 11, 10, 4, 13, 1, 5, 8, 12, 6, 9, 3, 2, 15},
+			{13, 8, 10, 1, 3, 15, 4, 2, 11, 6, 7, 12, 0, 5, 14, 9},
+		},
+		{
+			{10, 0, 9, 14, 6, 3, 15, 5, 1, 13, 12, 7, 11, 4, 2, 8},
+			{13, 7, 0, 9, 3, 4, 6, 10, 2, 8, 5, 14, 12, 11, 15, 1},
+			{13, 6, 4, 9, 8, 15, 3, 0, 11, 1, 2, 12, 5, 10, 14, 7},
+			{1, 10, 13, 0, 6, 9, 8, 7, 4, 15, 14, 3, 11, 5, 2, 12},
+		},
+		{
+			{7, 13, 14, 3, 0, 6, 9, 10, 1, 2, 8, 5, 11, 12, 4, 15},
+			{13, 8, 11, 5, 6, 15, 0, 3, 4, 7, 2, 12, 1, 10, 14, 9},
+			{10, 6, 9, 0, 12, 11, 7, 13, 15, 1, 3, 14, 5, 2, 8, 4},
+			{3, 15, 0, 6, 10, 1, 13, 8, 9, 4, 5, 11, 12, 7, 2, 14},
+		},
+		{
+			{2, 12, 4, 1, 7, 10, 11, 6, 8, 5, 3, 15, 13, 0, 14, 9},
+			{14, 11, 2, 12, 4, 7, 13, 1, 5, 0, 15, 10, 3, 9, 8, 6},
+			{4, 2, 1, 11, 10, 13, 7, 8, 15, 9, 12, 5, 6, 3, 0, 14},
+			{11, 8, 12, 7, 1, 14, 2, 13, 6, 15, 0, 9, 10, 4, 5, 3},
+		},
+		{
+			{12, 1, 10, 15, 9, 2, 6, 8, 0, 13, 3, 4, 14, 7, 5, 11},
+			{10, 15, 4, 2, 7, 12, 9, 5, 6, 1, 13, 14, 0, 11, 3, 8},
+			{9, 14, 15, 5, 2, 8, 12, 3, 7, 0, 4, 10, 1, 13, 11, 6},
+			{4, 3, 2, 12, 9, 5, 15, 10, 11, 14, 1, 7, 6, 0, 8, 13},
+		},
+		{
+			{4, 11, 2, 14, 15, 0, 8, 13, 3, 12, 9, 7, 5, 10, 6, 1},
+			{13, 0, 11, 7, 4, 9, 1, 10, 14, 3, 5, 12, 2, 15, 8, 6},
+			{1, 4, 11, 13, 12, 3, 7, 14, 10, 15, 6, 8, 0, 5, 9, 2},
+			{6, 11, 13, 8, 1, 4, 10, 7, 9, 5, 0, 15, 14, 2, 3, 12},
+		},
+		{
+			{13, 2, 8, 4, 6, 15, 11, 1, 10, 9, 3, 14, 5, 0, 12, 7},
+			{1, 15, 13, 8, 10, 3, 7, 4, 12, 5, 6, 11, 0, 14, 9, 2},
+			{7, 11, 4, 1, 9, 12, 14, 2, 0, 6, 10, 13, 15, 3, 5, 8},
+			{2, 1, 14, 7, 4, 10, 8, 13, 15, 12, 9, 0, 3, 5, 6, 11},
+		},
+	}
+)
+
+// permute returns the bits of the inBits-bit value in in the order given by
+// table.
+func permute(in uint64, inBits int, table []byte) uint64 {
+	var out uint64
+	for _, t := range table {
+		out = out<<1 | (in>>(inBits-int(t)))&1
+	}
+	return out
+}
+
+// DESSubkeys returns the 48-bit round keys of key. The least significant bit
+// of every key byte is a parity bit that DES ignores.
+func DESSubkeys(key [DESBlockSize]byte) [16]uint64 {
+	const mask28 = 1<<28 - 1
+	cd := permute(binary.BigEndian.Uint64(key[:]), 64, desPermutedChoice1[:])
+	c, d := cd>>28, cd&mask28
+	var subkeys [16]uint64
+	for i, r := range desRotations {
+		c = (c<<r | c>>(28-r)) & mask28
+		d = (d<<r | d>>(28-r)) & mask28
+		subkeys[i] = permute(c<<28|d, 56, desPermutedChoice2[:])
+	}
+	return subkeys
+}
+
+// desFeistel is the round function f of DES.
+func desFeistel(right uint32, subkey uint64) uint32 {
+	e := permute(uint64(right), 32, desExpansion[:]) ^ subkey
+	var out uint64
+	for i := range desSBoxes {
+		six := (e >> (42 - 6*i)) & 0x3f
+		row := (six>>4)&2 | six&1
+		column := (six >> 1) & 0xf
+		out = out<<4 | uint64(desSBoxes[i][row][column])
+	}
+	return uint32(permute(out, 32, desPermutation[:]))
+}
+
+// desRounds runs the 16 rounds on the permuted block lr, calling visit after
+// every round if it is not nil, and returns the swapped halves.
+func desRounds(lr uint64, subkeys *[16]uint64, decrypt bool, visit func(lr uint64)) uint64 {
+	l, r := uint32(lr>>32), uint32(lr)
+	for i := 0; i < 16; i++ {
+		k := subkeys[i]
+		if decrypt {
+			k = subkeys[15-i]
+		}
+		l, r = r, l^desFeistel(r, k)
+		if visit != nil {
+			visit(uint64(l)<<32 | uint64(r))
+		}
+	}
+	return uint64(r)<<32 | uint64(l)
+}
+
+// desCrypt encrypts or decrypts a block.
+func desCrypt(block uint64, subkeys *[16]uint64, decrypt bool) uint64 {
+	lr := permute(block, 64, desInitialPermutation[:])
+	return permute(desRounds(lr, subkeys, decrypt, nil), 64, desFinalPermutation[:])
+}
+
+// DESRounds encrypts block with key and returns the halves L and R, as L<<32|R,
+// after the initial permutation (index 0) and after every round (indexes 1 to
+// 16). The ciphertext is the final permutation of the last value with its
+// halves swapped.
+func DESRounds(block, key [DESBlockSize]byte) [17]uint64 {
+	subkeys := DESSubkeys(key)
+	var rounds [17]uint64
+	rounds[0] = permute(binary.BigEndian.Uint64(block[:]), 64, desInitialPermutation[:])
+	i := 1
+	desRounds(rounds[0], &subkeys, false, func(lr uint64) {
+		rounds[i] = lr
+		i++
+	})
+	return rounds
+}
+
+// DES is the DES block cipher in ECB mode with PKCS#7 padding, with keys of
+// DESBlockSize bytes. The ciphertext is 1 to DESBlockSize bytes longer than
+// the plaintext.
+type DES struct{}
+
+// desStream encrypts or decrypts the complete blocks it has and keeps the
+// rest in pending. A decrypting stream keeps its last complete block too,
+// until Final removes its padding.
+type desStream struct {
+	subkeys [16]uint64
+	decrypt bool
+	pending []byte
+}
+
+func (c DES) stream(key []byte, decrypt bool) (Stream, error) {
+	if len(key) != DESBlockSize {
+		return nil, ErrInvalidKey
+	}
+	return &desStream{subkeys: DESSubkeys(*(*[DESBlockSize]byte)(key)), decrypt: decrypt}, nil
+}
+
+func (s *desStream) Update(dst, src []byte) []byte {
+	s.pending = append(s.pending, src...)
+	n := len(s.pending) / DESBlockSize * DESBlockSize
+	if s.decrypt && n > 0 && n == len(s.pending) {
+		n -= DESBlockSize
+	}
+	dst = s.crypt(dst, s.pending[:n])
+	s.pending = s.pending[:copy(s.pending, s.pending[n:])]
+	return dst
+}
+
+// crypt appends the transformation of the complete blocks of src to dst.
+func (s *desStream) crypt(dst, src []byte) []byte {
+	for i := 0; i < len(src); i += DESBlockSize {
+		block := desCrypt(binary.BigEndian.Uint64(src[i:]), &s.subkeys, s.decrypt)
+		dst = binary.BigEndian.AppendUint64(dst, block)
+	}
+	return dst
+}
+
+func (s *desStream) Final(dst []byte) ([]byte, error) {
+	if !s.decrypt {
+		padding := DESBlockSize - len(s.pending)
+		for i := 0; i < padding; i++ {
+			s.pending = append(s.pending, byte(padding))
+		}
+		dst = s.crypt(dst, s.pending)
+		s.pending = s.pending[:0]
+		return dst, nil
+	}
+	if len(s.pending) != DESBlockSize {
+		return dst, ErrInvalidCiphertext
+	}
+	start := len(dst)
+	dst = s.crypt(dst, s.pending)
+	s.pending = s.pending[:0]
+	padding := int(dst[len(dst)-1])
+	if padding == 0 || padding > DESBlockSize {
+		return dst[:start], ErrInvalidCiphertext
+	}
+	for _, b := range dst[len(dst)-padding:] {
+		if int(b) != padding {
+			return dst[:start], ErrInvalidCiphertext
+		}
+	}
+	return dst[:len(dst)-padding], nil
+}
+
+func (c DES) NewEncrypter(key []byte) (Stream, error) { return c.stream(key, false) }
+func (c DES) NewDecrypter(key []byte) (Stream, error) { return c.stream(key, true) }
+func (c DES) Encrypt(data, key []byte) ([]byte, error) {
+	return transform(c.NewEncrypter, data, key)
+}
+func (c DES) Decrypt(data, key []byte) ([]byte, error) {
+	return transform(c.NewDecrypter, data, key)
+}
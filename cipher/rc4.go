@@ -0,0 +1,49 @@
+// rc4.go
+// description: RC4 stream cipher
+// details:
+// RC4 shuffles a permutation of the 256 byte values with the key (the key
+// scheduling algorithm) and then keeps swapping its entries to produce a
+// keystream, which is XORed with the data. Encryption and decryption are the
+// same operation. The first bytes of the keystream leak information about the
+// key and the keystream is biased, so RC4 must not be used to protect data
+// any more; it is here because it is short and was once everywhere.
+// time complexity: O(n + 256)
+// space complexity: O(256) for a Stream
+// reference: https://en.wikipedia.org/wiki/RC4
+// see cipher_test.go
+
+package cipher
+
+// RC4 is the RC4 stream cipher with keys of 1 to 256 bytes.
+type RC4 struct{}
+
+func (c RC4) NewEncrypter(key []byte) (Stream, error) {
+	if len(key) == 0 || len(key) > 256 {
+		return nil, ErrInvalidKey
+	}
+	var s [256]byte
+	for i := range s {
+		s[i] = byte(i)
+	}
+	var j byte
+	for i := range s {
+		j += s[i] + key[i%len(key)]
+		s[i], s[j] = s[j], s[i]
+	}
+	var i byte
+	j = 0
+	return byteStream(func(b byte) byte {
+		i++
+		j += s[i]
+		s[i], s[j] = s[j], s[i]
+		return b ^ s[s[i]+s[j]]
+	}), nil
+}
+
+func (c RC4) NewDecrypter(key []byte) (Stream, error) { return c.NewEncrypter(key) }
+func (c RC4) Encrypt(data, key []byte) ([]byte, error) {
+	return transform(c.NewEncrypter, data, key)
+}
+func (c RC4) Decrypt(data, key []byte) ([]byte, error) {
+	return transform(c.NewDecrypter, data, key)
+}
@@ -0,0 +1,51 @@
+// reader.go
+// description: io.Reader transforming a stream with a cipher
+// details:
+// The reader reads the source in pieces of a fixed size and runs them
+// through a Stream, so a file of any size is processed in constant memory.
+// see cipher_test.go
+
+package cipher
+
+import "io"
+
+const readerBufferSize = 32 * 1024
+
+type reader struct {
+	source io.Reader
+	stream Stream
+	in     []byte
+	out    []byte // output not read yet
+	err    error  // error to return once out is empty
+}
+
+// NewReader returns a reader of the output of s for the input read from r,
+// for example
+//
+//	s, err := cipher.RC4{}.NewEncrypter(key)
+//	...
+//	_, err = io.Copy(w, cipher.NewReader(r, s))
+func NewReader(r io.Reader, s Stream) io.Reader {
+	return &reader{source: r, stream: s, in: make([]byte, readerBufferSize)}
+}
+
+func (r *reader) Read(p []byte) (int, error) {
+	for len(r.out) == 0 && r.err == nil {
+		n, err := r.source.Read(r.in)
+		r.out = r.stream.Update(r.out[:0], r.in[:n])
+		if err == io.EOF {
+			r.out, r.err = r.stream.Final(r.out)
+			if r.err == nil {
+				r.err = io.EOF
+			}
+		} else if err != nil {
+			r.err = err
+		}
+	}
+	n := copy(p, r.out)
+	r.out = r.out[n:]
+	if len(r.out) > 0 {
+		return n, nil
+	}
+	return n, r.err
+}
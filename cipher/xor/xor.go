@@ -27,3 +27,20 @@ func Decrypt(key byte, cipherText []byte) []byte {
 	}
 	return plainText
 }
+
+// EncryptRepeating encrypts with a repeating key: the i-th byte of plaintext is
+// XORed with key[(offset+i) % len(key)]. The offset lets a long message be
+// encrypted piece by piece. The key must not be empty.
+func EncryptRepeating(key []byte, offset int, plaintext []byte) []byte {
+	cipherText := make([]byte, len(plaintext))
+	for i, ch := range plaintext {
+		cipherText[i] = key[(offset+i)%len(key)] ^ ch
+	}
+	return cipherText
+}
+
+// DecryptRepeating decrypts what EncryptRepeating encrypted with the same key
+// and offset.
+func DecryptRepeating(key []byte, offset int, cipherText []byte) []byte {
+	return EncryptRepeating(key, offset, cipherText)
+}
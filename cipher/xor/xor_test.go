@@ -115,3 +115,23 @@ func FuzzXOR(f *testing.F) {
 		}
 	})
 }
+
+func TestXorRepeating(t *testing.T) {
+	key := []byte{1, 2, 3}
+	input := []byte("abcdefg")
+	want := []byte{'a' ^ 1, 'b' ^ 2, 'c' ^ 3, 'd' ^ 1, 'e' ^ 2, 'f' ^ 3, 'g' ^ 1}
+	if got := EncryptRepeating(key, 0, input); !bytes.Equal(got, want) {
+		t.Errorf("EncryptRepeating = %v, want %v", got, want)
+	}
+	// encrypting in two pieces gives the same bytes
+	got := append(EncryptRepeating(key, 0, input[:4]), EncryptRepeating(key, 4, input[4:])...)
+	if !bytes.Equal(got, want) {
+		t.Errorf("EncryptRepeating in pieces = %v, want %v", got, want)
+	}
+	if got := DecryptRepeating(key, 0, want); !bytes.Equal(got, input) {
+		t.Errorf("DecryptRepeating = %q, want %q", got, input)
+	}
+	if got := EncryptRepeating(key[:1], 0, input); !bytes.Equal(got, Encrypt(key[0], input)) {
+		t.Errorf("EncryptRepeating with one byte differs from Encrypt")
+	}
+}
@@ -0,0 +1,142 @@
+// archive.go
+// description: Block archiver chaining BWT, move-to-front, RLE and Huffman coding
+// details:
+// Pack cuts its input into blocks and compresses each of them in the manner
+// of bzip2: the Burrows-Wheeler transform groups similar contexts, move-to-
+// front coding turns the groups into runs of small numbers, run-length coding
+// shortens the runs and canonical Huffman coding packs what is left. Unpack
+// undoes the stages in the opposite order and checks the CRC-32 of every
+// block, so that corrupt archives are detected instead of decoded silently.
+// Archive format: the magic bytes "TAB1", then for every block its length,
+// the primary row of its transform, the CRC-32 of its bytes and the length
+// of its payload, as big-endian uint32, followed by the payload. A block of
+// length 0 ends the archive.
+// time complexity: O(n log b) for n bytes in blocks of b bytes
+// space complexity: O(b)
+// reference: https://en.wikipedia.org/wiki/Bzip2
+// see archive_test.go
+
+package compression
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+
+	"github.com/TheAlgorithms/Go/hashing/crc32"
+)
+
+// ArchiveBlockSize is the number of bytes of the blocks of Pack.
+const ArchiveBlockSize = 1 << 16
+
+// archiveMagic starts every archive.
+var archiveMagic = []byte("TAB1")
+
+// ErrChecksum is returned by Unpack when a block does not match its CRC-32.
+var ErrChecksum = errors.New("compression: checksum mismatch")
+
+// packBlock returns the payload of a block and the primary row of its
+// transform.
+func packBlock(block []byte) ([]byte, int) {
+	last, primary := BWTransform(block)
+	return HuffmanEncodeBytes(RLEncodebytes(MTFEncode(last))), primary
+}
+
+// unpackBlock returns the block of n bytes encoded in payload.
+func unpackBlock(payload []byte, primary, n int) ([]byte, error) {
+	runs, err := HuffmanDecodeBytes(payload)
+	if err != nil {
+		return nil, err
+	}
+	if len(runs)%2 != 0 {
+		return nil, ErrCorrupt
+	}
+	// the runs are checked to add up to n before being expanded
+	total := 0
+	for i := 0; i < len(runs); i += 2 {
+		total += int(runs[i])
+	}
+	if total != n {
+		return nil, ErrCorrupt
+	}
+	return BWTInverse(MTFDecode(RLEdecodebytes(runs)), primary)
+}
+
+// Pack reads r to the end and writes its archive to w.
+func Pack(w io.Writer, r io.Reader) error {
+	out := bufio.NewWriter(w)
+	if _, err := out.Write(archiveMagic); err != nil {
+		return err
+	}
+	block := make([]byte, ArchiveBlockSize)
+	for {
+		n, err := io.ReadFull(r, block)
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			return err
+		}
+		var header [16]byte
+		if n > 0 {
+			payload, primary := packBlock(block[:n])
+			binary.BigEndian.PutUint32(header[0:], uint32(n))
+			binary.BigEndian.PutUint32(header[4:], uint32(primary))
+			binary.BigEndian.PutUint32(header[8:], crc32.ChecksumIEEE(block[:n]))
+			binary.BigEndian.PutUint32(header[12:], uint32(len(payload)))
+			if _, err := out.Write(header[:]); err != nil {
+				return err
+			}
+			if _, err := out.Write(payload); err != nil {
+				return err
+			}
+		}
+		if n < len(block) {
+			// the end of the archive is a header of zeros
+			if _, err := out.Write(make([]byte, len(header))); err != nil {
+				return err
+			}
+			return out.Flush()
+		}
+	}
+}
+
+// Unpack reads an archive written by Pack from r and writes its content to
+// w. It returns ErrCorrupt when the archive is malformed and ErrChecksum when
+// a block was altered.
+func Unpack(w io.Writer, r io.Reader) error {
+	in := bufio.NewReader(r)
+	magic := make([]byte, len(archiveMagic))
+	if _, err := io.ReadFull(in, magic); err != nil || !bytes.Equal(magic, archiveMagic) {
+		return ErrCorrupt
+	}
+	for {
+		var header [16]byte
+		if _, err := io.ReadFull(in, header[:]); err != nil {
+			return ErrCorrupt
+		}
+		n := binary.BigEndian.Uint32(header[0:])
+		primary := binary.BigEndian.Uint32(header[4:])
+		sum := binary.BigEndian.Uint32(header[8:])
+		size := binary.BigEndian.Uint32(header[12:])
+		if n == 0 {
+			return nil
+		}
+		if n > ArchiveBlockSize || size > 2*ArchiveBlockSize+1024 {
+			return ErrCorrupt
+		}
+		payload := make([]byte, size)
+		if _, err := io.ReadFull(in, payload); err != nil {
+			return ErrCorrupt
+		}
+		block, err := unpackBlock(payload, int(primary), int(n))
+		if err != nil {
+			return err
+		}
+		if crc32.ChecksumIEEE(block) != sum {
+			return ErrChecksum
+		}
+		if _, err := w.Write(block); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package compression_test
+
+import (
+	"bytes"
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/compression"
+)
+
+func pack(t *testing.T, data []byte) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := compression.Pack(&buf, bytes.NewReader(data)); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func unpack(archive []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	err := compression.Unpack(&buf, bytes.NewReader(archive))
+	return buf.Bytes(), err
+}
+
+func TestPackRoundTrip(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	// several blocks, the last one partial
+	multiBlock := make([]byte, 2*compression.ArchiveBlockSize+1000)
+	for i := range multiBlock {
+		multiBlock[i] = "abc"[rnd.Intn(3)]
+	}
+	exact := bytes.Repeat([]byte("x"), compression.ArchiveBlockSize)
+	for _, data := range append(roundTripInputs(), multiBlock, exact) {
+		got, err := unpack(pack(t, data))
+		if err != nil || !bytes.Equal(got, data) {
+			t.Errorf("round trip of %d bytes failed: %v", len(data), err)
+		}
+	}
+}
+
+func TestPackCompresses(t *testing.T) {
+	text := bytes.Repeat([]byte("the quick brown fox jumps over the lazy dog. "), 2000)
+	archive := pack(t, text)
+	if len(archive) > len(text)/20 {
+		t.Errorf("archive of %d bytes of text takes %d bytes", len(text), len(archive))
+	}
+	if huffman := compression.HuffmanEncodeBytes(text); len(archive) >= len(huffman) {
+		t.Errorf("archive takes %d bytes, Huffman coding alone %d", len(archive), len(huffman))
+	}
+}
+
+func TestUnpackCorrupt(t *testing.T) {
+	data := bytes.Repeat([]byte("abracadabra "), 500)
+	archive := pack(t, data)
+
+	if _, err := unpack([]byte("not an archive")); !errors.Is(err, compression.ErrCorrupt) {
+		t.Errorf("Unpack of garbage error = %v, want ErrCorrupt", err)
+	}
+	if _, err := unpack(archive[:len(archive)-5]); !errors.Is(err, compression.ErrCorrupt) {
+		t.Errorf("Unpack of a truncated archive error = %v, want ErrCorrupt", err)
+	}
+
+	// changing the stored checksum is detected
+	altered := append([]byte(nil), archive...)
+	altered[4+8] ^= 1
+	if _, err := unpack(altered); !errors.Is(err, compression.ErrChecksum) {
+		t.Errorf("Unpack with a wrong checksum error = %v, want ErrChecksum", err)
+	}
+
+	// any change of the payload is an error, never wrong data
+	for i := 4 + 16; i < len(archive)-16; i++ {
+		altered := append([]byte(nil), archive...)
+		altered[i] ^= 0x40
+		if got, err := unpack(altered); err == nil && !bytes.Equal(got, data) {
+			t.Fatalf("altering byte %d decoded wrong data without error", i)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+// bitio.go
+// description: Bit level reading and writing on top of byte streams
+// details:
+// Bits are packed most significant bit first. The writer pads the last byte
+// with zero bits when flushed.
+// see huffmanstream_test.go, lzw_test.go
+
+package compression
+
+import (
+	"bufio"
+	"io"
+)
+
+// bitWriter writes bits to an underlying writer.
+type bitWriter struct {
+	w     *bufio.Writer
+	acc   byte
+	count uint // number of bits waiting in acc
+}
+
+func newBitWriter(w io.Writer) *bitWriter {
+	return &bitWriter{w: bufio.NewWriter(w)}
+}
+
+// writeBits writes the n lowest bits of value, the most significant first.
+func (b *bitWriter) writeBits(value uint64, n uint) error {
+	for n > 0 {
+		n--
+		b.acc = b.acc<<1 | byte(value>>n&1)
+		b.count++
+		if b.count == 8 {
+			if err := b.w.WriteByte(b.acc); err != nil {
+				return err
+			}
+			b.acc, b.count = 0, 0
+		}
+	}
+	return nil
+}
+
+// flush writes the pending bits, padded with zeros, and flushes the writer.
+func (b *bitWriter) flush() error {
+	if b.count > 0 {
+		if err := b.writeBits(0, 8-b.count); err != nil {
+			return err
+		}
+	}
+	return b.w.Flush()
+}
+
+// bitReader reads bits from an underlying reader.
+type bitReader struct {
+	r     *bufio.Reader
+	acc   byte
+	count uint // number of unread bits left in acc
+}
+
+func newBitReader(r io.Reader) *bitReader {
+	return &bitReader{r: bufio.NewReader(r)}
+}
+
+// readBit returns the next bit, or io.ErrUnexpectedEOF at the end of the input.
+func (b *bitReader) readBit() (uint64, error) {
+	if b.count == 0 {
+		c, err := b.r.ReadByte()
+		if err == io.EOF {
+			return 0, io.ErrUnexpectedEOF
+		}
+		if err != nil {
+			return 0, err
+		}
+		b.acc, b.count = c, 8
+	}
+	b.count--
+	return uint64(b.acc >> b.count & 1), nil
+}
+
+// readBits returns the next n bits, the first one read being the most significant.
+func (b *bitReader) readBits(n uint) (uint64, error) {
+	var v uint64
+	for ; n > 0; n-- {
+		bit, err := b.readBit()
+		if err != nil {
+			return 0, err
+		}
+		v = v<<1 | bit
+	}
+	return v, nil
+}
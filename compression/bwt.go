@@ -0,0 +1,117 @@
+// bwt.go
+// description: Burrows-Wheeler transform and move-to-front coding
+// details:
+// The Burrows-Wheeler transform sorts all the rotations of a block and keeps
+// the last byte of each, along with the row of the block itself. Bytes
+// followed by the same context end up next to each other, so the output has
+// long runs of a few bytes. The rotations are sorted through the suffix array
+// of the block written twice, since comparing two rotations is comparing the
+// first n bytes of the matching suffixes. The transform is inverted with the
+// last-to-first mapping: the k-th occurrence of a byte in the last column is
+// the k-th occurrence of that byte in the first column, which is the sorted
+// block.
+// Move-to-front coding then replaces each byte by its position in a list of
+// all bytes, and moves it to the front of the list, so that runs of a few
+// bytes become runs of small numbers, mostly zeros, which run-length and
+// Huffman coding compress well.
+// time complexity: O(n log n) for the transform, O(n) for its inverse and for
+// move-to-front coding
+// space complexity: O(n)
+// reference: https://en.wikipedia.org/wiki/Burrows%E2%80%93Wheeler_transform
+// reference: https://en.wikipedia.org/wiki/Move-to-front_transform
+// see bwt_test.go
+
+package compression
+
+import "github.com/TheAlgorithms/Go/strings/suffixarray"
+
+// BWTransform returns the last bytes of the sorted rotations of data, and
+// the row of data among them.
+func BWTransform(data []byte) (last []byte, primary int) {
+	n := len(data)
+	if n == 0 {
+		return nil, 0
+	}
+	doubled := make([]byte, 2*n)
+	copy(doubled, data)
+	copy(doubled[n:], data)
+	last = make([]byte, 0, n)
+	for _, i := range suffixarray.New(string(doubled)).Suffixes() {
+		if i >= n {
+			continue
+		}
+		if i == 0 {
+			primary = len(last)
+		}
+		last = append(last, data[(i+n-1)%n])
+	}
+	return last, primary
+}
+
+// BWTInverse returns the data whose transform is last with the given primary
+// row, and ErrCorrupt when the primary row is out of range.
+func BWTInverse(last []byte, primary int) ([]byte, error) {
+	n := len(last)
+	if n == 0 && primary == 0 {
+		return nil, nil
+	}
+	if primary < 0 || primary >= n {
+		return nil, ErrCorrupt
+	}
+	// start[b] is the first row of the sorted block beginning with b
+	var start [256]int
+	for _, b := range last {
+		start[b]++
+	}
+	sum := 0
+	for b, count := range start {
+		start[b] = sum
+		sum += count
+	}
+	// lf[i] is the row of the rotation ending one byte before row i
+	lf := make([]int, n)
+	for i, b := range last {
+		lf[i] = start[b]
+		start[b]++
+	}
+	data := make([]byte, n)
+	for i, k := primary, n-1; k >= 0; i, k = lf[i], k-1 {
+		data[k] = last[i]
+	}
+	return data, nil
+}
+
+// MTFEncode returns the move-to-front coding of data.
+func MTFEncode(data []byte) []byte {
+	var list [256]byte
+	for i := range list {
+		list[i] = byte(i)
+	}
+	out := make([]byte, len(data))
+	for i, b := range data {
+		j := 0
+		for list[j] != b {
+			j++
+		}
+		copy(list[1:j+1], list[:j])
+		list[0] = b
+		out[i] = byte(j)
+	}
+	return out
+}
+
+// MTFDecode returns the data whose move-to-front coding is codes.
+func MTFDecode(codes []byte) []byte {
+	var list [256]byte
+	for i := range list {
+		list[i] = byte(i)
+	}
+	out := make([]byte, len(codes))
+	for i, j := range codes {
+		b := list[j]
+		copy(list[1:int(j)+1], list[:j])
+		list[0] = b
+		out[i] = b
+	}
+	return out
+}
@@ -0,0 +1,38 @@
+package compression_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/compression"
+)
+
+func TestBWTransform(t *testing.T) {
+	last, primary := compression.BWTransform([]byte("banana"))
+	if string(last) != "nnbaaa" || primary != 3 {
+		t.Errorf("BWTransform(banana) = %q, %d, want \"nnbaaa\", 3", last, primary)
+	}
+	if _, err := compression.BWTInverse(last, 6); !errors.Is(err, compression.ErrCorrupt) {
+		t.Errorf("BWTInverse with a primary row out of range error = %v", err)
+	}
+	for _, data := range roundTripInputs() {
+		last, primary := compression.BWTransform(data)
+		got, err := compression.BWTInverse(last, primary)
+		if err != nil || !bytes.Equal(got, data) {
+			t.Errorf("BWT round trip of %d bytes failed: %v", len(data), err)
+		}
+	}
+}
+
+func TestMTF(t *testing.T) {
+	codes := compression.MTFEncode([]byte("bananaaa"))
+	if want := []byte{98, 98, 110, 1, 1, 1, 0, 0}; !bytes.Equal(codes, want) {
+		t.Errorf("MTFEncode(bananaaa) = %v, want %v", codes, want)
+	}
+	for _, data := range roundTripInputs() {
+		if got := compression.MTFDecode(compression.MTFEncode(data)); !bytes.Equal(got, data) {
+			t.Errorf("MTF round trip of %d bytes failed", len(data))
+		}
+	}
+}
@@ -0,0 +1,218 @@
+// huffmanstream.go
+// description: Canonical Huffman coding of byte streams
+// details:
+// The code lengths come from the Huffman tree of the byte frequencies, built
+// with HuffTree. The codes themselves are then made canonical: symbols are
+// sorted by code length and then by value, and consecutive codes are handed
+// out in that order. Canonical codes are fully determined by their lengths,
+// so the encoded stream only needs to carry the 256 code lengths.
+// Stream format: 256 code lengths of one byte each, the number of encoded
+// bytes as a big-endian uint64, then the codes packed most significant bit
+// first. The input is read in full to count the byte frequencies; decoding
+// is streaming.
+// time complexity: O(n + k log k) with k = 256 symbols
+// space complexity: O(n) to encode, O(k) to decode
+// reference: https://en.wikipedia.org/wiki/Canonical_Huffman_code
+// see huffmanstream_test.go
+
+package compression
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"sort"
+)
+
+// ErrCorrupt is returned when decoding input that was not produced by the
+// matching encoder.
+var ErrCorrupt = errors.New("compression: corrupt input")
+
+// maxCodeLength is the longest code a stream may use. Reaching it would take
+// an input of more than Fibonacci(maxCodeLength) bytes.
+const maxCodeLength = 64
+
+// HuffmanLengths returns the length of the Huffman code of every byte given
+// the byte frequencies. Bytes that do not occur get length 0; a byte that
+// occurs alone gets length 1.
+func HuffmanLengths(freq [256]int) [256]uint8 {
+	var lengths [256]uint8
+	var list []SymbolFreq
+	for b, f := range freq {
+		if f > 0 {
+			list = append(list, SymbolFreq{Symbol: rune(b), Freq: f})
+		}
+	}
+	if len(list) == 0 {
+		return lengths
+	}
+	sort.SliceStable(list, func(i, j int) bool { return list[i].Freq < list[j].Freq })
+	tree, _ := HuffTree(list)
+	var walk func(n *Node, depth uint8)
+	walk = func(n *Node, depth uint8) {
+		if n.symbol != -1 {
+			if depth == 0 {
+				depth = 1
+			}
+			lengths[n.symbol] = depth
+			return
+		}
+		walk(n.left, depth+1)
+		walk(n.right, depth+1)
+	}
+	walk(tree, 0)
+	return lengths
+}
+
+// canonicalOrder returns the bytes with a code ordered by length, then value.
+func canonicalOrder(lengths [256]uint8) []int {
+	var symbols []int
+	for b, l := range lengths {
+		if l > 0 {
+			symbols = append(symbols, b)
+		}
+	}
+	sort.SliceStable(symbols, func(i, j int) bool { return lengths[symbols[i]] < lengths[symbols[j]] })
+	return symbols
+}
+
+// CanonicalCodes returns the canonical Huffman code of every byte given the
+// code lengths. The code of byte b is the lengths[b] lowest bits of codes[b].
+func CanonicalCodes(lengths [256]uint8) [256]uint64 {
+	var codes [256]uint64
+	var code uint64
+	var previous uint8
+	for i, b := range canonicalOrder(lengths) {
+		if i > 0 {
+			code++
+		}
+		code <<= lengths[b] - previous
+		previous = lengths[b]
+		codes[b] = code
+	}
+	return codes
+}
+
+// validLengths reports whether the lengths describe a prefix code, using
+// the Kraft inequality.
+func validLengths(lengths [256]uint8) bool {
+	// The sum of 2^-l over the lengths must not exceed 1. It is evaluated
+	// without overflow by carrying counts from each length to the next
+	// shorter one, rounding up, which leaves the sum in units of 1/2.
+	var kraft [maxCodeLength + 1]uint64
+	for _, l := range lengths {
+		if l > maxCodeLength {
+			return false
+		}
+		if l > 0 {
+			kraft[l]++
+		}
+	}
+	for l := maxCodeLength; l > 1; l-- {
+		kraft[l-1] += (kraft[l] + 1) / 2
+	}
+	return kraft[1] <= 2
+}
+
+// HuffmanEncodeStream reads r to the end and writes its canonical Huffman
+// encoding to w.
+func HuffmanEncodeStream(w io.Writer, r io.Reader) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	var freq [256]int
+	for _, b := range data {
+		freq[b]++
+	}
+	lengths := HuffmanLengths(freq)
+	codes := CanonicalCodes(lengths)
+
+	bw := newBitWriter(w)
+	if _, err := bw.w.Write(lengths[:]); err != nil {
+		return err
+	}
+	var size [8]byte
+	binary.BigEndian.PutUint64(size[:], uint64(len(data)))
+	if _, err := bw.w.Write(size[:]); err != nil {
+		return err
+	}
+	for _, b := range data {
+		if err := bw.writeBits(codes[b], uint(lengths[b])); err != nil {
+			return err
+		}
+	}
+	return bw.flush()
+}
+
+// HuffmanDecodeStream reads a stream written by HuffmanEncodeStream from r
+// and writes the decoded bytes to w.
+func HuffmanDecodeStream(w io.Writer, r io.Reader) error {
+	br := newBitReader(r)
+	var lengths [256]uint8
+	var size [8]byte
+	if _, err := io.ReadFull(br.r, lengths[:]); err != nil {
+		return ErrCorrupt
+	}
+	if _, err := io.ReadFull(br.r, size[:]); err != nil {
+		return ErrCorrupt
+	}
+	n := binary.BigEndian.Uint64(size[:])
+	if !validLengths(lengths) {
+		return ErrCorrupt
+	}
+	symbols := canonicalOrder(lengths)
+	if n > 0 && len(symbols) == 0 {
+		return ErrCorrupt
+	}
+	// count[l] is the number of codes of length l.
+	var count [maxCodeLength + 1]uint64
+	for _, b := range symbols {
+		count[lengths[b]]++
+	}
+
+	out := bufio.NewWriter(w)
+	for i := uint64(0); i < n; i++ {
+		// Canonical decoding: codes of length l are consecutive integers
+		// starting at first, and index is their position in symbols.
+		var code, first, index uint64
+		for l := 1; ; l++ {
+			if l > maxCodeLength {
+				return ErrCorrupt
+			}
+			bit, err := br.readBit()
+			if err != nil {
+				return ErrCorrupt
+			}
+			code |= bit
+			if code-first < count[l] {
+				if err := out.WriteByte(byte(symbols[index+code-first])); err != nil {
+					return err
+				}
+				break
+			}
+			index += count[l]
+			first = (first + count[l]) << 1
+			code <<= 1
+		}
+	}
+	return out.Flush()
+}
+
+// HuffmanEncodeBytes returns the canonical Huffman encoding of data.
+func HuffmanEncodeBytes(data []byte) []byte {
+	var buf bytes.Buffer
+	_ = HuffmanEncodeStream(&buf, bytes.NewReader(data))
+	return buf.Bytes()
+}
+
+// HuffmanDecodeBytes decodes data produced by HuffmanEncodeBytes.
+func HuffmanDecodeBytes(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := HuffmanDecodeStream(&buf, bytes.NewReader(data)); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,116 @@
+package compression_test
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/compression"
+)
+
+// roundTripInputs are shared by the Huffman and LZW round trip tests.
+func roundTripInputs() [][]byte {
+	rnd := rand.New(rand.NewSource(1))
+	random := make([]byte, 200000)
+	rnd.Read(random)
+	skewed := make([]byte, 100000)
+	for i := range skewed {
+		// Geometric distribution: long codes for rare bytes.
+		b := 0
+		for b < 40 && rnd.Intn(2) == 0 {
+			b++
+		}
+		skewed[i] = byte(b)
+	}
+	return [][]byte{
+		nil,
+		[]byte("a"),
+		[]byte("aaaaaaaaaaaaaaaa"),
+		[]byte("TOBEORNOTTOBEORTOBEORNOT"),
+		[]byte("abababababababababababab"),
+		bytes.Repeat([]byte("the quick brown fox jumps over the lazy dog "), 500),
+		random,
+		skewed,
+	}
+}
+
+func TestCanonicalCodes(t *testing.T) {
+	var lengths [256]uint8
+	// The example of RFC 1951 section 3.2.2: ABCDEFGH with lengths 3 3 3 3 3 2 4 4.
+	for i, l := range []uint8{3, 3, 3, 3, 3, 2, 4, 4} {
+		lengths['A'+i] = l
+	}
+	codes := compression.CanonicalCodes(lengths)
+	want := []uint64{0b010, 0b011, 0b100, 0b101, 0b110, 0b00, 0b1110, 0b1111}
+	for i, w := range want {
+		if codes['A'+i] != w {
+			t.Errorf("code of %c = %b, want %b", 'A'+i, codes['A'+i], w)
+		}
+	}
+}
+
+func TestHuffmanLengths(t *testing.T) {
+	var freq [256]int
+	freq['a'], freq['b'], freq['c'], freq['d'] = 45, 13, 12, 30
+	lengths := compression.HuffmanLengths(freq)
+	if lengths['a'] != 1 || lengths['d'] != 2 || lengths['b'] != 3 || lengths['c'] != 3 {
+		t.Errorf("lengths = a:%d b:%d c:%d d:%d, want 1 3 3 2", lengths['a'], lengths['b'], lengths['c'], lengths['d'])
+	}
+	var single [256]int
+	single['x'] = 10
+	if l := compression.HuffmanLengths(single); l['x'] != 1 {
+		t.Errorf("length of a lone byte = %d, want 1", l['x'])
+	}
+}
+
+func TestHuffmanRoundTrip(t *testing.T) {
+	for _, data := range roundTripInputs() {
+		encoded := compression.HuffmanEncodeBytes(data)
+		decoded, err := compression.HuffmanDecodeBytes(encoded)
+		if err != nil {
+			t.Fatalf("decoding %d bytes: %v", len(data), err)
+		}
+		if !bytes.Equal(decoded, data) {
+			t.Fatalf("round trip of %d bytes gave %d different bytes", len(data), len(decoded))
+		}
+	}
+
+	text := bytes.Repeat([]byte("aaaaaaabbbc"), 1000)
+	if encoded := compression.HuffmanEncodeBytes(text); len(encoded) >= len(text)/4 {
+		t.Errorf("encoding of a skewed text takes %d bytes for %d", len(encoded), len(text))
+	}
+}
+
+func TestHuffmanCorrupt(t *testing.T) {
+	encoded := compression.HuffmanEncodeBytes([]byte("hello, world"))
+	for _, data := range [][]byte{
+		nil,
+		encoded[:100],
+		encoded[:len(encoded)-1],
+		append(bytes.Repeat([]byte{1}, 256), make([]byte, 8)...), // 256 codes of length 1
+	} {
+		if _, err := compression.HuffmanDecodeBytes(data); err != compression.ErrCorrupt {
+			t.Errorf("decoding %d corrupt bytes: err = %v, want ErrCorrupt", len(data), err)
+		}
+	}
+}
+
+func FuzzHuffmanRoundTrip(f *testing.F) {
+	for _, data := range roundTripInputs()[:6] {
+		f.Add(data)
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		decoded, err := compression.HuffmanDecodeBytes(compression.HuffmanEncodeBytes(data))
+		if err != nil || !bytes.Equal(decoded, data) {
+			t.Fatalf("round trip of %q gave %q, %v", data, decoded, err)
+		}
+	})
+}
+
+func BenchmarkHuffmanEncode(b *testing.B) {
+	data := bytes.Repeat([]byte("the quick brown fox jumps over the lazy dog "), 10000)
+	b.SetBytes(int64(len(data)))
+	for i := 0; i < b.N; i++ {
+		compression.HuffmanEncodeBytes(data)
+	}
+}
@@ -0,0 +1,195 @@
+// lzw.go
+// description: Lempel-Ziv-Welch compression of byte streams
+// details:
+// LZW replaces repeated strings by codes into a dictionary that both sides
+// build on the fly. The dictionary starts with the 256 single bytes. The
+// encoder extends the current string while it is in the dictionary, then
+// emits the code of the string and adds the string followed by the next byte.
+// The decoder rebuilds the same entries one code late, the only tricky case
+// being a code it has not added yet, which must then stand for the previous
+// string followed by its own first byte.
+// Codes are written with a variable width, from 9 bits up to 16 bits, growing
+// as the dictionary does. Code 256 clears the dictionary once it is full and
+// code 257 ends the stream. Both sides derive the width from the size of the
+// encoder's dictionary, so they always agree on it.
+// time complexity: O(n)
+// space complexity: O(2^16) for the dictionary
+// reference: https://en.wikipedia.org/wiki/Lempel%E2%80%93Ziv%E2%80%93Welch
+// see lzw_test.go
+
+package compression
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+)
+
+const (
+	lzwClear    = 256
+	lzwEnd      = 257
+	lzwFirst    = 258 // first code given to a dictionary entry
+	lzwMinWidth = 9
+	lzwMaxWidth = 16
+	lzwLimit    = 1 << lzwMaxWidth
+)
+
+// lzwWidth returns the width of the codes written while the dictionary of
+// the encoder has next codes in use.
+func lzwWidth(next int) uint {
+	width := uint(lzwMinWidth)
+	for 1<<width < next && width < lzwMaxWidth {
+		width++
+	}
+	return width
+}
+
+// lzwKey identifies the dictionary entry made of the entry prefix followed by b.
+type lzwKey struct {
+	prefix int
+	b      byte
+}
+
+// LZWEncodeStream writes the LZW encoding of the bytes read from r to w.
+func LZWEncodeStream(w io.Writer, r io.Reader) error {
+	in := bufio.NewReader(r)
+	bw := newBitWriter(w)
+	dict := make(map[lzwKey]int)
+	next := lzwFirst
+	current := -1 // code of the string being extended, -1 when empty
+	for {
+		b, err := in.ReadByte()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if current < 0 {
+			current = int(b)
+			continue
+		}
+		if code, ok := dict[lzwKey{current, b}]; ok {
+			current = code
+			continue
+		}
+		if err := bw.writeBits(uint64(current), lzwWidth(next)); err != nil {
+			return err
+		}
+		if next < lzwLimit {
+			dict[lzwKey{current, b}] = next
+			next++
+		} else {
+			if err := bw.writeBits(lzwClear, lzwWidth(next)); err != nil {
+				return err
+			}
+			dict = make(map[lzwKey]int)
+			next = lzwFirst
+		}
+		current = int(b)
+	}
+	if current >= 0 {
+		if err := bw.writeBits(uint64(current), lzwWidth(next)); err != nil {
+			return err
+		}
+		// The decoder adds an entry after every code but the first, so it
+		// expects the dictionary to have grown before the end code.
+		if next < lzwLimit {
+			next++
+		}
+	}
+	if err := bw.writeBits(lzwEnd, lzwWidth(next)); err != nil {
+		return err
+	}
+	return bw.flush()
+}
+
+// LZWDecodeStream reads a stream written by LZWEncodeStream from r and
+// writes the decoded bytes to w.
+func LZWDecodeStream(w io.Writer, r io.Reader) error {
+	br := newBitReader(r)
+	out := bufio.NewWriter(w)
+	// Entry i is the entry prefix[i] followed by suffix[i], and first[i]
+	// is its first byte; single bytes have no prefix.
+	prefix := make([]int, lzwLimit)
+	suffix := make([]byte, lzwLimit)
+	first := make([]byte, lzwLimit)
+	for i := 0; i < 256; i++ {
+		prefix[i], suffix[i], first[i] = -1, byte(i), byte(i)
+	}
+	next := lzwFirst
+	previous := -1
+	var buf []byte
+
+	// write appends the bytes of entry code to the output.
+	write := func(code int) error {
+		buf = buf[:0]
+		for c := code; c >= 0; c = prefix[c] {
+			buf = append(buf, suffix[c])
+		}
+		for i := len(buf) - 1; i >= 0; i-- {
+			if err := out.WriteByte(buf[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	for {
+		// The encoder had added the entry this code completes.
+		expected := next
+		if previous >= 0 && next < lzwLimit {
+			expected++
+		}
+		v, err := br.readBits(lzwWidth(expected))
+		if err != nil {
+			return ErrCorrupt
+		}
+		code := int(v)
+		switch {
+		case code == lzwEnd:
+			return out.Flush()
+		case code == lzwClear:
+			next, previous = lzwFirst, -1
+			continue
+		case previous < 0:
+			if code > 255 {
+				return ErrCorrupt
+			}
+		case code > next || (code == next && next == lzwLimit):
+			return ErrCorrupt
+		default:
+			if next < lzwLimit {
+				// The new entry is the previous string followed by the first
+				// byte of the current one, which is its own first byte when
+				// the code is the entry being added.
+				c := first[previous]
+				if code < next {
+					c = first[code]
+				}
+				prefix[next], suffix[next], first[next] = previous, c, first[previous]
+				next++
+			}
+		}
+		if err := write(code); err != nil {
+			return err
+		}
+		previous = code
+	}
+}
+
+// LZWEncodeBytes returns the LZW encoding of data.
+func LZWEncodeBytes(data []byte) []byte {
+	var buf bytes.Buffer
+	_ = LZWEncodeStream(&buf, bytes.NewReader(data))
+	return buf.Bytes()
+}
+
+// LZWDecodeBytes decodes data produced by LZWEncodeBytes.
+func LZWDecodeBytes(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := LZWDecodeStream(&buf, bytes.NewReader(data)); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
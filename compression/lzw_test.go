@@ -0,0 +1,69 @@
+package compression_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/compression"
+)
+
+func TestLZWRoundTrip(t *testing.T) {
+	// Random bytes fill the dictionary several times over.
+	for _, data := range roundTripInputs() {
+		encoded := compression.LZWEncodeBytes(data)
+		decoded, err := compression.LZWDecodeBytes(encoded)
+		if err != nil {
+			t.Fatalf("decoding %d bytes: %v", len(data), err)
+		}
+		if !bytes.Equal(decoded, data) {
+			t.Fatalf("round trip of %d bytes gave %d different bytes", len(data), len(decoded))
+		}
+	}
+
+	text := bytes.Repeat([]byte("the quick brown fox jumps over the lazy dog "), 500)
+	if encoded := compression.LZWEncodeBytes(text); len(encoded) >= len(text)/5 {
+		t.Errorf("encoding of a repetitive text takes %d bytes for %d", len(encoded), len(text))
+	}
+}
+
+func TestLZWCodes(t *testing.T) {
+	// "aaaaa" is a, then twice the entry "aa", the first of which the decoder
+	// has not built yet. 9-bit codes: 97, 258, 258 and the end code 257.
+	want := []byte{0b00110000, 0b11000000, 0b10100000, 0b01010000, 0b00010000}
+	if got := compression.LZWEncodeBytes([]byte("aaaaa")); !bytes.Equal(got, want) {
+		t.Errorf("LZWEncodeBytes(aaaaa) = %08b, want %08b", got, want)
+	}
+}
+
+func TestLZWCorrupt(t *testing.T) {
+	encoded := compression.LZWEncodeBytes([]byte("TOBEORNOTTOBEORTOBEORNOT"))
+	for _, data := range [][]byte{
+		nil,
+		encoded[:len(encoded)-2],
+		{0xff, 0xff, 0xff}, // a code not in the dictionary yet
+	} {
+		if _, err := compression.LZWDecodeBytes(data); err != compression.ErrCorrupt {
+			t.Errorf("decoding %x: err = %v, want ErrCorrupt", data, err)
+		}
+	}
+}
+
+func FuzzLZWRoundTrip(f *testing.F) {
+	for _, data := range roundTripInputs()[:6] {
+		f.Add(data)
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		decoded, err := compression.LZWDecodeBytes(compression.LZWEncodeBytes(data))
+		if err != nil || !bytes.Equal(decoded, data) {
+			t.Fatalf("round trip of %q gave %q, %v", data, decoded, err)
+		}
+	})
+}
+
+func FuzzLZWDecode(f *testing.F) {
+	f.Add(compression.LZWEncodeBytes([]byte("TOBEORNOTTOBEORTOBEORNOT")))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		// Arbitrary input must be rejected or decoded, never crash.
+		_, _ = compression.LZWDecodeBytes(data)
+	})
+}
@@ -46,13 +46,14 @@ func RLEdecode(data string) string {
 	return result
 }
 
-// RLEncodebytes takes a byte slice and returns its run-length encoding as a byte slice
+// RLEncodebytes takes a byte slice and returns its run-length encoding as a byte slice.
+// Runs longer than 255 bytes are split, since their count is stored in a byte.
 func RLEncodebytes(data []byte) []byte {
 	var result []byte
 	var count byte = 1
 
 	for i := 0; i < len(data); i++ {
-		if i+1 < len(data) && data[i] == data[i+1] {
+		if i+1 < len(data) && data[i] == data[i+1] && count < 255 {
 			count++
 			continue
 		}
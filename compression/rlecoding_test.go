@@ -92,6 +92,11 @@ func TestCompressionRLEncodeBytes(t *testing.T) {
 			data: []byte("AAAABBBCCDA"),
 			want: []byte{4, 'A', 3, 'B', 2, 'C', 1, 'D', 1, 'A'},
 		},
+		{
+			name: "long run",
+			data: bytes.Repeat([]byte{'Z'}, 600),
+			want: []byte{255, 'Z', 255, 'Z', 90, 'Z'},
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,66 @@
+// COIN CHANGE: COUNTING AND FEWEST COINS WITH RECONSTRUCTION
+// CoinChangeWays counts the ways of paying an amount when the order of the
+// coins does not matter, considering one denomination at a time so that
+// every combination is counted once.
+// MinCoinsTrace finds the fewest coins adding up to an amount, remembering
+// for every amount the last coin used so that the coins can be listed.
+// time complexity: O(n*amount) where n is the number of denominations
+// space complexity: O(amount)
+// https://en.wikipedia.org/wiki/Change-making_problem
+
+package dynamic
+
+import "sort"
+
+// CoinChangeWays returns the number of ways to pay amount with coins of the
+// given denominations, each available without limit. Unlike CoinChange it
+// counts the ways for amount itself; there is exactly one way to pay 0.
+func CoinChangeWays(coins []int, amount int) int {
+	if amount < 0 {
+		return 0
+	}
+	ways := make([]int, amount+1)
+	ways[0] = 1
+	for _, c := range coins {
+		if c < 1 {
+			continue
+		}
+		for i := c; i <= amount; i++ {
+			ways[i] += ways[i-c]
+		}
+	}
+	return ways[amount]
+}
+
+// MinCoinsTrace returns the fewest coins of the given denominations, each
+// available without limit, adding up to amount, along with the coins used
+// from largest to smallest. It returns -1 and nil when amount cannot be paid.
+func MinCoinsTrace(coins []int, amount int) (int, []int) {
+	if amount < 0 {
+		return -1, nil
+	}
+	const unreachable = -1
+	fewest := make([]int, amount+1)
+	last := make([]int, amount+1)
+	for i := 1; i <= amount; i++ {
+		fewest[i] = unreachable
+		for _, c := range coins {
+			if c < 1 || c > i || fewest[i-c] == unreachable {
+				continue
+			}
+			if fewest[i] == unreachable || fewest[i-c]+1 < fewest[i] {
+				fewest[i], last[i] = fewest[i-c]+1, c
+			}
+		}
+	}
+	if fewest[amount] == unreachable {
+		return -1, nil
+	}
+
+	used := make([]int, 0, fewest[amount])
+	for i := amount; i > 0; i -= last[i] {
+		used = append(used, last[i])
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(used)))
+	return fewest[amount], used
+}
@@ -0,0 +1,49 @@
+package dynamic_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/dynamic"
+)
+
+func TestCoinChangeWays(t *testing.T) {
+	tests := []struct {
+		coins  []int
+		amount int
+		want   int
+	}{
+		{[]int{1, 2, 5}, 5, 4},
+		{[]int{1, 2, 5, 10}, 10, 11},
+		{[]int{2}, 3, 0},
+		{[]int{3, 7}, 0, 1},
+		{nil, 4, 0},
+	}
+	for _, test := range tests {
+		if got := dynamic.CoinChangeWays(test.coins, test.amount); got != test.want {
+			t.Errorf("CoinChangeWays(%v, %d) = %d, want %d", test.coins, test.amount, got, test.want)
+		}
+	}
+}
+
+func TestMinCoinsTrace(t *testing.T) {
+	tests := []struct {
+		coins  []int
+		amount int
+		count  int
+		used   []int
+	}{
+		{[]int{1, 2, 5}, 11, 3, []int{5, 5, 1}},
+		// Greedy would take 4 + 1 + 1.
+		{[]int{1, 3, 4}, 6, 2, []int{3, 3}},
+		{[]int{2}, 3, -1, nil},
+		{[]int{7, 3}, 0, 0, []int{}},
+		{[]int{5, 7}, 24, 4, []int{7, 7, 5, 5}},
+	}
+	for _, test := range tests {
+		count, used := dynamic.MinCoinsTrace(test.coins, test.amount)
+		if count != test.count || !reflect.DeepEqual(used, test.used) {
+			t.Errorf("MinCoinsTrace(%v, %d) = %d, %v, want %d, %v", test.coins, test.amount, count, used, test.count, test.used)
+		}
+	}
+}
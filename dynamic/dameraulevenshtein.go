@@ -0,0 +1,59 @@
+// DAMERAU-LEVENSHTEIN DISTANCE
+// The minimum number of insertions, deletions, substitutions and
+// transpositions of two adjacent characters needed to turn one string into the
+// other. This is the unrestricted variant, in which a substring may be edited
+// again after a transposition, computed with the algorithm of Lowrance and
+// Wagner.
+// time complexity: O(m * n) where m and n are lengths of the strings, first and second respectively.
+// space complexity: O(m * n + s) where s is the size of the alphabet used.
+// https://en.wikipedia.org/wiki/Damerau%E2%80%93Levenshtein_distance
+
+package dynamic
+
+import "github.com/TheAlgorithms/Go/math/min"
+
+// DamerauLevenshtein returns the Damerau-Levenshtein distance between first
+// and second, comparing them rune by rune.
+func DamerauLevenshtein(first string, second string) int {
+	a, m := strToRuneSlice(first)
+	b, n := strToRuneSlice(second)
+	maxDist := m + n
+
+	// dp is shifted by one row and column to hold the maxDist border.
+	dp := make([][]int, m+2)
+	for i := range dp {
+		dp[i] = make([]int, n+2)
+	}
+	dp[0][0] = maxDist
+	for i := 0; i <= m; i++ {
+		dp[i+1][0] = maxDist
+		dp[i+1][1] = i
+	}
+	for j := 0; j <= n; j++ {
+		dp[0][j+1] = maxDist
+		dp[1][j+1] = j
+	}
+
+	// lastRow[r] is the last row of first in which rune r was seen.
+	lastRow := make(map[rune]int)
+	for i := 1; i <= m; i++ {
+		lastCol := 0 // last column of second in this row holding a[i-1]
+		for j := 1; j <= n; j++ {
+			k := lastRow[b[j-1]]
+			l := lastCol
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+				lastCol = j
+			}
+			dp[i+1][j+1] = min.Int(
+				dp[i][j]+cost,              // substitution or match
+				dp[i+1][j]+1,               // insertion
+				dp[i][j+1]+1,               // deletion
+				dp[k][l]+(i-k-1)+1+(j-l-1), // transposition
+			)
+		}
+		lastRow[a[i-1]] = i
+	}
+	return dp[m+1][n+1]
+}
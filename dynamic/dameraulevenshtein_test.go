@@ -0,0 +1,25 @@
+package dynamic
+
+import "testing"
+
+func TestDamerauLevenshtein(t *testing.T) {
+	var testCases = []struct {
+		first    string
+		second   string
+		expected int
+	}{
+		{"", "", 0},
+		{"abc", "", 3},
+		{"ab", "ba", 1},
+		{"ca", "abc", 2},
+		{"abcdef", "badcfe", 3},
+		{"sunday", "saturday", 3},
+		{"a cat", "an act", 2},
+	}
+
+	for _, tc := range testCases {
+		if got := DamerauLevenshtein(tc.first, tc.second); got != tc.expected {
+			t.Errorf("DamerauLevenshtein(%q, %q) = %d, want %d", tc.first, tc.second, got, tc.expected)
+		}
+	}
+}
@@ -6,10 +6,15 @@
 
 package dynamic
 
-import "github.com/TheAlgorithms/Go/math/min"
+import (
+	"github.com/TheAlgorithms/Go/dynamic/memo"
+	"github.com/TheAlgorithms/Go/math/min"
+)
 
-// EditDistanceRecursive is a naive implementation with exponential time complexity.
-func EditDistanceRecursive(first string, second string, pointerFirst int, pointerSecond int) int {
+// editDistanceStep returns the edit distance of first[:pointerFirst] and
+// second[:pointerSecond] from the distances of shorter prefixes, obtained
+// through distance.
+func editDistanceStep(first, second string, pointerFirst, pointerSecond int, distance func(pointerFirst, pointerSecond int) int) int {
 
 	if pointerFirst == 0 {
 		return pointerSecond
@@ -21,13 +26,31 @@ func EditDistanceRecursive(first string, second string, pointerFirst int, pointe
 
 	// Characters match, so we recur for the remaining portions
 	if first[pointerFirst-1] == second[pointerSecond-1] {
-		return EditDistanceRecursive(first, second, pointerFirst-1, pointerSecond-1)
+		return distance(pointerFirst-1, pointerSecond-1)
 	}
 
 	// We have three choices, all with cost of 1 unit
-	return 1 + min.Int(EditDistanceRecursive(first, second, pointerFirst, pointerSecond-1), // Insert
-		EditDistanceRecursive(first, second, pointerFirst-1, pointerSecond),   // Delete
-		EditDistanceRecursive(first, second, pointerFirst-1, pointerSecond-1)) // Replace
+	return 1 + min.Int(distance(pointerFirst, pointerSecond-1), // Insert
+		distance(pointerFirst-1, pointerSecond),   // Delete
+		distance(pointerFirst-1, pointerSecond-1)) // Replace
+}
+
+// EditDistanceRecursive is a naive implementation with exponential time complexity.
+func EditDistanceRecursive(first string, second string, pointerFirst int, pointerSecond int) int {
+	return editDistanceStep(first, second, pointerFirst, pointerSecond, func(i, j int) int {
+		return EditDistanceRecursive(first, second, i, j)
+	})
+}
+
+// EditDistanceMemoized is the recursive implementation with every
+// subproblem solved once, which takes O(m * n) time like EditDistanceDP but
+// only visits the prefixes the recursion needs.
+func EditDistanceMemoized(first string, second string) int {
+	var distance func(i, j int) int
+	distance = memo.Memoize2(func(i, j int) int {
+		return editDistanceStep(first, second, i, j, distance)
+	})
+	return distance(len(first), len(second))
 }
 
 // EditDistanceDP is an optimised implementation which builds on the ideas of the recursive implementation.
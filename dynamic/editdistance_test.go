@@ -30,6 +30,31 @@ func Test_EditDistance(t *testing.T) {
 			if computed != testCases[i].expected {
 				t.Errorf("Word 1: %s, Word 2: %s, Expected: %d, Computed: %d", testCases[i].first, testCases[i].second, testCases[i].expected, computed)
 			}
+
+			computed = EditDistanceMemoized(testCases[i].first, testCases[i].second)
+
+			if computed != testCases[i].expected {
+				t.Errorf("Memoized, Word 1: %s, Word 2: %s, Expected: %d, Computed: %d", testCases[i].first, testCases[i].second, testCases[i].expected, computed)
+			}
 		})
 	}
 }
+
+func Benchmark_EditDistance(b *testing.B) {
+	first, second := "voldemort", "dumbledore"
+	b.Run("Recursive", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			EditDistanceRecursive(first, second, len(first), len(second))
+		}
+	})
+	b.Run("Memoized", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			EditDistanceMemoized(first, second)
+		}
+	})
+	b.Run("DP", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			EditDistanceDP(first, second)
+		}
+	})
+}
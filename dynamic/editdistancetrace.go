@@ -0,0 +1,113 @@
+// EDIT DISTANCE WITH TRACEBACK
+// The DP table of EditDistanceDP is walked back from its last cell to recover
+// one optimal script of edit operations turning first into second.
+// EditDistanceLowMemory keeps only two rows of the table for when the strings
+// are too large for the full table and no script is needed.
+// time complexity: O(m * n) where m and n are lengths of the strings, first and second respectively.
+// space complexity: O(m * n) for EditDistanceTrace, O(min(m, n)) for EditDistanceLowMemory.
+// https://en.wikipedia.org/wiki/Levenshtein_distance
+
+package dynamic
+
+import "github.com/TheAlgorithms/Go/math/min"
+
+// EditKind is the kind of a single edit operation.
+type EditKind int
+
+const (
+	// EditMatch keeps a character that is equal in both strings.
+	EditMatch EditKind = iota
+	// EditSubstitute replaces a character of the first string.
+	EditSubstitute
+	// EditInsert inserts a character of the second string.
+	EditInsert
+	// EditDelete deletes a character of the first string.
+	EditDelete
+)
+
+// EditOperation is one step of an edit script. First and Second are the
+// indices of the runes involved in the first and second string; First is -1
+// for an insertion and Second is -1 for a deletion.
+type EditOperation struct {
+	Kind   EditKind
+	First  int
+	Second int
+}
+
+// EditDistanceTrace returns the edit distance between first and second
+// along with an optimal edit script, in the order the operations apply from
+// left to right. Strings are compared rune by rune. Matches are part of the
+// script but cost nothing.
+func EditDistanceTrace(first string, second string) (int, []EditOperation) {
+	a, m := strToRuneSlice(first)
+	b, n := strToRuneSlice(second)
+
+	dp := make([][]int, m+1)
+	for i := 0; i <= m; i++ {
+		dp[i] = make([]int, n+1)
+		dp[i][0] = i
+	}
+	for j := 0; j <= n; j++ {
+		dp[0][j] = j
+	}
+	for i := 1; i <= m; i++ {
+		for j := 1; j <= n; j++ {
+			if a[i-1] == b[j-1] {
+				dp[i][j] = dp[i-1][j-1]
+			} else {
+				dp[i][j] = 1 + min.Int(dp[i][j-1], dp[i-1][j], dp[i-1][j-1])
+			}
+		}
+	}
+
+	var ops []EditOperation
+	for i, j := m, n; i > 0 || j > 0; {
+		switch {
+		case i > 0 && j > 0 && a[i-1] == b[j-1] && dp[i][j] == dp[i-1][j-1]:
+			ops = append(ops, EditOperation{EditMatch, i - 1, j - 1})
+			i, j = i-1, j-1
+		case i > 0 && j > 0 && dp[i][j] == dp[i-1][j-1]+1:
+			ops = append(ops, EditOperation{EditSubstitute, i - 1, j - 1})
+			i, j = i-1, j-1
+		case i > 0 && dp[i][j] == dp[i-1][j]+1:
+			ops = append(ops, EditOperation{EditDelete, i - 1, -1})
+			i--
+		default:
+			ops = append(ops, EditOperation{EditInsert, -1, j - 1})
+			j--
+		}
+	}
+	for l, r := 0, len(ops)-1; l < r; l, r = l+1, r-1 {
+		ops[l], ops[r] = ops[r], ops[l]
+	}
+	return dp[m][n], ops
+}
+
+// EditDistanceLowMemory computes the same distance as EditDistanceTrace
+// while only keeping two rows of the DP table, each as long as the shorter
+// string.
+func EditDistanceLowMemory(first string, second string) int {
+	a, m := strToRuneSlice(first)
+	b, n := strToRuneSlice(second)
+	if m < n {
+		a, b, m, n = b, a, n, m
+	}
+
+	prev := make([]int, n+1)
+	curr := make([]int, n+1)
+	for j := 0; j <= n; j++ {
+		prev[j] = j
+	}
+	for i := 1; i <= m; i++ {
+		curr[0] = i
+		for j := 1; j <= n; j++ {
+			if a[i-1] == b[j-1] {
+				curr[j] = prev[j-1]
+			} else {
+				curr[j] = 1 + min.Int(curr[j-1], prev[j], prev[j-1])
+			}
+		}
+		prev, curr = curr, prev
+	}
+	return prev[n]
+}
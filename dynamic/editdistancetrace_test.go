@@ -0,0 +1,61 @@
+package dynamic
+
+import "testing"
+
+// applyEdits replays ops on first and returns the resulting string.
+func applyEdits(t *testing.T, first, second string, ops []EditOperation) string {
+	t.Helper()
+	a, b := []rune(first), []rune(second)
+	var out []rune
+	for _, op := range ops {
+		switch op.Kind {
+		case EditMatch:
+			if a[op.First] != b[op.Second] {
+				t.Fatalf("match of different runes %q and %q", a[op.First], b[op.Second])
+			}
+			out = append(out, a[op.First])
+		case EditSubstitute, EditInsert:
+			out = append(out, b[op.Second])
+		}
+	}
+	return string(out)
+}
+
+func TestEditDistanceTrace(t *testing.T) {
+	var testCases = []struct {
+		first    string
+		second   string
+		expected int
+	}{
+		{"", "", 0},
+		{"", "abc", 3},
+		{"abc", "", 3},
+		{"horse", "ros", 3},
+		{"intention", "execution", 5},
+		{"sunday", "saturday", 3},
+		{"voldemort", "dumbledore", 7},
+		{"héllo", "hallo", 1},
+	}
+
+	for _, tc := range testCases {
+		distance, ops := EditDistanceTrace(tc.first, tc.second)
+		if distance != tc.expected {
+			t.Errorf("EditDistanceTrace(%q, %q) = %d, want %d", tc.first, tc.second, distance, tc.expected)
+		}
+		cost := 0
+		for _, op := range ops {
+			if op.Kind != EditMatch {
+				cost++
+			}
+		}
+		if cost != distance {
+			t.Errorf("script for (%q, %q) costs %d, want %d", tc.first, tc.second, cost, distance)
+		}
+		if got := applyEdits(t, tc.first, tc.second, ops); got != tc.second {
+			t.Errorf("script for (%q, %q) produces %q", tc.first, tc.second, got)
+		}
+		if got := EditDistanceLowMemory(tc.first, tc.second); got != tc.expected {
+			t.Errorf("EditDistanceLowMemory(%q, %q) = %d, want %d", tc.first, tc.second, got, tc.expected)
+		}
+	}
+}
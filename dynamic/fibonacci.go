@@ -1,9 +1,11 @@
 // fibonacci.go
 // description: Implementation of the Fibonacci sequence using dynamic programming
 // time complexity: O(n)
-// space complexity: O(1)
+// space complexity: O(1), O(n) for NthFibonacciMemoized
 package dynamic
 
+import "github.com/TheAlgorithms/Go/dynamic/memo"
+
 // https://www.geeksforgeeks.org/program-for-nth-fibonacci-number/
 
 // NthFibonacci returns the nth Fibonacci Number
@@ -23,3 +25,26 @@ func NthFibonacci(n uint) uint {
 
 	return n2
 }
+
+// fibonacciStep returns the nth Fibonacci number from the two previous ones,
+// obtained through fib.
+func fibonacciStep(n uint, fib func(uint) uint) uint {
+	if n < 2 {
+		return n
+	}
+	return fib(n-1) + fib(n-2)
+}
+
+// NthFibonacciRecursive returns the nth Fibonacci number by the plain
+// recursion, which takes exponential time.
+func NthFibonacciRecursive(n uint) uint {
+	return fibonacciStep(n, NthFibonacciRecursive)
+}
+
+// NthFibonacciMemoized returns the nth Fibonacci number by the recursion of
+// NthFibonacciRecursive, memoized so that it takes O(n) time.
+func NthFibonacciMemoized(n uint) uint {
+	var fib func(uint) uint
+	fib = memo.Memoize1(func(n uint) uint { return fibonacciStep(n, fib) })
+	return fib(n)
+}
@@ -37,6 +37,34 @@ func Test_NthFibonacci(t *testing.T) {
 			if result != fibonacciNumbers[i].fibonacci {
 				t.Errorf("Expected the %dth Fibonacci number to be %d, got %d", fibonacciNumbers[i].nth, fibonacciNumbers[i].fibonacci, result)
 			}
+			result = NthFibonacciMemoized(fibonacciNumbers[i].nth)
+			if result != fibonacciNumbers[i].fibonacci {
+				t.Errorf("Memoized: expected the %dth Fibonacci number to be %d, got %d", fibonacciNumbers[i].nth, fibonacciNumbers[i].fibonacci, result)
+			}
+			if fibonacciNumbers[i].nth <= 20 {
+				result = NthFibonacciRecursive(fibonacciNumbers[i].nth)
+				if result != fibonacciNumbers[i].fibonacci {
+					t.Errorf("Recursive: expected the %dth Fibonacci number to be %d, got %d", fibonacciNumbers[i].nth, fibonacciNumbers[i].fibonacci, result)
+				}
+			}
 		})
 	}
 }
+
+func Benchmark_NthFibonacci(b *testing.B) {
+	b.Run("Recursive", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			NthFibonacciRecursive(25)
+		}
+	})
+	b.Run("Memoized", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			NthFibonacciMemoized(25)
+		}
+	})
+	b.Run("Iterative", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			NthFibonacci(25)
+		}
+	})
+}
@@ -0,0 +1,74 @@
+// KNAPSACK WITH RECONSTRUCTION
+// KnapsackTrace solves the 0/1 knapsack problem, where every item may be taken
+// at most once, and walks the DP table back to find which items were taken:
+// item i is in the solution exactly when the best value changes between rows
+// i and i+1 at the remaining capacity.
+// UnboundedKnapsackTrace allows every item to be taken any number of times. A
+// single row suffices, and remembering which item gave the best value at each
+// capacity is enough to rebuild the solution.
+// time complexity: O(n*maxWeight)
+// space complexity: O(n*maxWeight) for KnapsackTrace, O(maxWeight) for UnboundedKnapsackTrace
+// https://en.wikipedia.org/wiki/Knapsack_problem
+
+package dynamic
+
+// KnapsackTrace returns the largest total value of items whose weights add up
+// to at most maxWeight, each item taken at most once, together with the
+// indices of the items taken, in increasing order.
+func KnapsackTrace(maxWeight int, weights, values []int) (int, []int) {
+	if maxWeight < 0 {
+		return 0, nil
+	}
+	n := len(weights)
+	dp := make([][]int, n+1)
+	for i := range dp {
+		dp[i] = make([]int, maxWeight+1)
+	}
+	for i := 0; i < n; i++ {
+		for j := 0; j <= maxWeight; j++ {
+			dp[i+1][j] = dp[i][j]
+			if weights[i] <= j {
+				dp[i+1][j] = Max(dp[i+1][j], dp[i][j-weights[i]]+values[i])
+			}
+		}
+	}
+
+	var items []int
+	for i, j := n, maxWeight; i > 0; i-- {
+		if dp[i][j] != dp[i-1][j] {
+			items = append(items, i-1)
+			j -= weights[i-1]
+		}
+	}
+	for l, r := 0, len(items)-1; l < r; l, r = l+1, r-1 {
+		items[l], items[r] = items[r], items[l]
+	}
+	return dp[n][maxWeight], items
+}
+
+// UnboundedKnapsackTrace returns the largest total value of items whose
+// weights add up to at most maxWeight, each item taken any number of times,
+// together with how many times every item is taken. Items with a weight
+// below 1 are ignored, since they could be taken without end.
+func UnboundedKnapsackTrace(maxWeight int, weights, values []int) (int, []int) {
+	counts := make([]int, len(weights))
+	if maxWeight < 0 {
+		return 0, counts
+	}
+	dp := make([]int, maxWeight+1)
+	// choice[j] is the last item taken to reach dp[j], or -1 if none.
+	choice := make([]int, maxWeight+1)
+	for j := 0; j <= maxWeight; j++ {
+		choice[j] = -1
+		for i, w := range weights {
+			if w >= 1 && w <= j && dp[j-w]+values[i] > dp[j] {
+				dp[j], choice[j] = dp[j-w]+values[i], i
+			}
+		}
+	}
+
+	for j := maxWeight; choice[j] >= 0; j -= weights[choice[j]] {
+		counts[choice[j]]++
+	}
+	return dp[maxWeight], counts
+}
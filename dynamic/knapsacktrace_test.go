@@ -0,0 +1,79 @@
+package dynamic_test
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/dynamic"
+)
+
+func TestKnapsackTrace(t *testing.T) {
+	tests := []struct {
+		maxWeight int
+		weights   []int
+		values    []int
+		value     int
+		items     []int
+	}{
+		{50, []int{10, 20, 30}, []int{60, 100, 120}, 220, []int{1, 2}},
+		{10, []int{1, 2, 3, 4, 5, 6}, []int{1, 1, 1, 1, 1, 5}, 7, []int{0, 1, 5}},
+		{10, []int{1, 2, 3, 4, 5, 6}, []int{-1, 10, -3, -4, 10, 1}, 20, []int{1, 4}},
+		{4, []int{5, 6}, []int{10, 10}, 0, nil},
+		{0, nil, nil, 0, nil},
+	}
+	for _, test := range tests {
+		value, items := dynamic.KnapsackTrace(test.maxWeight, test.weights, test.values)
+		if value != test.value || !reflect.DeepEqual(items, test.items) {
+			t.Errorf("KnapsackTrace(%d, %v, %v) = %d, %v, want %d, %v",
+				test.maxWeight, test.weights, test.values, value, items, test.value, test.items)
+		}
+	}
+}
+
+func TestKnapsackTraceRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for round := 0; round < 200; round++ {
+		n := rnd.Intn(10)
+		weights, values := make([]int, n), make([]int, n)
+		for i := range weights {
+			weights[i], values[i] = 1+rnd.Intn(10), rnd.Intn(20)
+		}
+		maxWeight := rnd.Intn(30)
+		value, items := dynamic.KnapsackTrace(maxWeight, weights, values)
+		if want := dynamic.Knapsack(maxWeight, weights, values); value != want {
+			t.Fatalf("KnapsackTrace value = %d, want %d", value, want)
+		}
+		weight, total := 0, 0
+		for _, i := range items {
+			weight += weights[i]
+			total += values[i]
+		}
+		if weight > maxWeight || total != value {
+			t.Fatalf("items %v weigh %d and are worth %d, want at most %d and %d", items, weight, total, maxWeight, value)
+		}
+	}
+}
+
+func TestUnboundedKnapsackTrace(t *testing.T) {
+	tests := []struct {
+		maxWeight int
+		weights   []int
+		values    []int
+		value     int
+		counts    []int
+	}{
+		{8, []int{1, 3, 4, 5}, []int{10, 40, 50, 70}, 110, []int{0, 1, 0, 1}},
+		{10, []int{5, 10, 15}, []int{10, 30, 20}, 30, []int{0, 1, 0}},
+		{7, []int{2}, []int{3}, 9, []int{3}},
+		{1, []int{2}, []int{3}, 0, []int{0}},
+		{5, []int{0, 2}, []int{100, 1}, 2, []int{0, 2}},
+	}
+	for _, test := range tests {
+		value, counts := dynamic.UnboundedKnapsackTrace(test.maxWeight, test.weights, test.values)
+		if value != test.value || !reflect.DeepEqual(counts, test.counts) {
+			t.Errorf("UnboundedKnapsackTrace(%d, %v, %v) = %d, %v, want %d, %v",
+				test.maxWeight, test.weights, test.values, value, counts, test.value, test.counts)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// LONGEST COMMON INCREASING SUBSEQUENCE
+// The longest common increasing subsequence of two sequences a and b is the
+// longest strictly increasing sequence that is a subsequence of both. For
+// every element of a in turn, best[j] is the length of the longest one found
+// so far ending with b[j]. Scanning b, the best length of a subsequence
+// ending with an element of b smaller than the current element x of a is
+// carried along, so that when b[j] equals x, best[j] can be extended from it
+// in constant time. Each improvement records the subsequence it builds as a
+// node pointing to the node of the subsequence it extends, which is never
+// modified afterwards, so following the nodes back from the best one yields
+// the subsequence itself, even when best changes later.
+// time complexity: O(m*n) where m and n are the lengths of the sequences
+// space complexity: O(m*n) in the worst case, O(n) for the lengths
+// https://en.wikipedia.org/wiki/Longest_common_subsequence#Related_problems
+
+package dynamic
+
+// LongestCommonIncreasingSubsequence returns the length of the longest
+// strictly increasing sequence that is a subsequence of both a and b,
+// together with one such sequence.
+func LongestCommonIncreasingSubsequence(a, b []int) (int, []int) {
+	type node struct {
+		value, previous int // previous is the index of the node extended, or -1
+	}
+	var nodes []node
+	best := make([]int, len(b))
+	ending := make([]int, len(b)) // the node of the subsequence ending with b[j]
+	for _, x := range a {
+		// the best subsequence ending with an element of b smaller than x, and
+		// its node
+		length, last := 0, -1
+		for j, y := range b {
+			switch {
+			case y == x && length+1 > best[j]:
+				best[j] = length + 1
+				nodes = append(nodes, node{y, last})
+				ending[j] = len(nodes) - 1
+			case y < x && best[j] > length:
+				length, last = best[j], ending[j]
+			}
+		}
+	}
+
+	length, last := 0, -1
+	for j := range b {
+		if best[j] > length {
+			length, last = best[j], ending[j]
+		}
+	}
+	result := make([]int, length)
+	for k := length - 1; k >= 0; k-- {
+		result[k] = nodes[last].value
+		last = nodes[last].previous
+	}
+	return length, result
+}
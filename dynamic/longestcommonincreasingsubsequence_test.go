@@ -0,0 +1,92 @@
+package dynamic_test
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/dynamic"
+)
+
+// isIntSubsequence reports whether s is a subsequence of of.
+func isIntSubsequence(s, of []int) bool {
+	i := 0
+	for _, x := range s {
+		for i < len(of) && of[i] != x {
+			i++
+		}
+		if i == len(of) {
+			return false
+		}
+		i++
+	}
+	return true
+}
+
+// bruteLCIS returns the length of the longest common increasing subsequence
+// by trying every subsequence of a.
+func bruteLCIS(a, b []int) int {
+	best := 0
+	for mask := 0; mask < 1<<len(a); mask++ {
+		var s []int
+		increasing := true
+		for i := range a {
+			if mask&(1<<i) != 0 {
+				if len(s) > 0 && s[len(s)-1] >= a[i] {
+					increasing = false
+					break
+				}
+				s = append(s, a[i])
+			}
+		}
+		if increasing && len(s) > best && isIntSubsequence(s, b) {
+			best = len(s)
+		}
+	}
+	return best
+}
+
+func TestLongestCommonIncreasingSubsequence(t *testing.T) {
+	tests := []struct {
+		a, b []int
+		want []int
+	}{
+		{[]int{2, 3, 1, 6, 5, 4, 6}, []int{1, 3, 5, 6}, []int{1, 5, 6}},
+		{[]int{3, 4, 9, 1}, []int{5, 3, 8, 9, 10, 2, 1}, []int{3, 9}},
+		{[]int{1, 2, 3}, []int{3, 2, 1}, []int{3}},
+		{[]int{1, 1, 1}, []int{1, 1}, []int{1}},
+		{[]int{1, 2}, nil, []int{}},
+	}
+	for _, test := range tests {
+		length, seq := dynamic.LongestCommonIncreasingSubsequence(test.a, test.b)
+		if length != len(test.want) || !reflect.DeepEqual(seq, test.want) {
+			t.Errorf("LongestCommonIncreasingSubsequence(%v, %v) = %d, %v, want %v", test.a, test.b, length, seq, test.want)
+		}
+	}
+}
+
+func TestLongestCommonIncreasingSubsequenceRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	random := func(n int) []int {
+		s := make([]int, rnd.Intn(n))
+		for i := range s {
+			s[i] = rnd.Intn(8)
+		}
+		return s
+	}
+	for round := 0; round < 300; round++ {
+		a, b := random(13), random(20)
+		length, seq := dynamic.LongestCommonIncreasingSubsequence(a, b)
+		if want := bruteLCIS(a, b); length != want || len(seq) != want {
+			t.Fatalf("LongestCommonIncreasingSubsequence(%v, %v) = %d, %v, want length %d", a, b, length, seq, want)
+		}
+		for i := 1; i < len(seq); i++ {
+			if seq[i-1] >= seq[i] {
+				t.Fatalf("LongestCommonIncreasingSubsequence(%v, %v) returned %v, which is not increasing", a, b, seq)
+			}
+		}
+		if !isIntSubsequence(seq, a) || !isIntSubsequence(seq, b) {
+			t.Fatalf("LongestCommonIncreasingSubsequence(%v, %v) returned %v, which is not common", a, b, seq)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+// LONGEST COMMON SUBSEQUENCE OF SEVERAL STRINGS
+// The longest common subsequence of k strings extends the dynamic programming
+// of two strings to a table with one dimension per string, indexed by the
+// length of a prefix of each: when the last runes of all the prefixes are
+// equal, the subsequence of the prefixes ends with it, and otherwise it is the
+// best one obtained by dropping the last rune of one of the prefixes. The
+// table is stored flat, a prefix length of string i counting stride[i]
+// cells, and walked back from the full strings to recover a subsequence. The
+// problem is NP-hard for an arbitrary number of strings, and the table grows
+// as the product of their lengths, so this suits small k only.
+// time complexity: O(k * (n1+1) * ... * (nk+1)) for strings of lengths n1 to nk
+// space complexity: O((n1+1) * ... * (nk+1))
+// https://en.wikipedia.org/wiki/Longest_common_subsequence#Solution_for_two_sequences
+
+package dynamic
+
+// LongestCommonSubsequenceMulti returns the length of the longest common
+// subsequence of all of strs together with one such subsequence. It returns
+// 0 and an empty string when strs is empty.
+func LongestCommonSubsequenceMulti(strs ...string) (int, string) {
+	k := len(strs)
+	if k == 0 {
+		return 0, ""
+	}
+	runes := make([][]rune, k)
+	stride := make([]int, k)
+	size := 1
+	for i, s := range strs {
+		runes[i] = []rune(s)
+		stride[i] = size
+		size *= len(runes[i]) + 1
+	}
+
+	// index holds the prefix lengths of the current cell
+	index := make([]int, k)
+	// allMatch reports whether the prefixes of the cell all end with the same
+	// rune
+	allMatch := func() bool {
+		for i := range index {
+			if index[i] == 0 || runes[i][index[i]-1] != runes[0][index[0]-1] {
+				return false
+			}
+		}
+		return true
+	}
+	all := 0
+	for i := range stride {
+		all += stride[i]
+	}
+	lcs := make([]int, size)
+	for cell := 0; cell < size; cell++ {
+		if allMatch() {
+			lcs[cell] = lcs[cell-all] + 1
+		} else {
+			for i := range index {
+				if index[i] > 0 {
+					lcs[cell] = Max(lcs[cell], lcs[cell-stride[i]])
+				}
+			}
+		}
+		// move to the next cell, the first prefix length varying fastest
+		for i := range index {
+			if index[i]++; index[i] <= len(runes[i]) {
+				break
+			}
+			index[i] = 0
+		}
+	}
+
+	for i := range index {
+		index[i] = len(runes[i])
+	}
+	cell := size - 1
+	result := make([]rune, lcs[cell])
+	for n := len(result); n > 0; {
+		if allMatch() {
+			n--
+			result[n] = runes[0][index[0]-1]
+			for i := range index {
+				index[i]--
+			}
+			cell -= all
+			continue
+		}
+		for i := range index {
+			if index[i] > 0 && lcs[cell-stride[i]] == lcs[cell] {
+				index[i]--
+				cell -= stride[i]
+				break
+			}
+		}
+	}
+	return len(result), string(result)
+}
@@ -0,0 +1,81 @@
+package dynamic_test
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/dynamic"
+)
+
+// isCommonSubsequence reports whether s is a subsequence of every string of
+// strs.
+func isCommonSubsequence(s string, strs []string) bool {
+	for _, str := range strs {
+		rest := str
+		for _, r := range s {
+			i := strings.IndexRune(rest, r)
+			if i < 0 {
+				return false
+			}
+			rest = rest[i+1:]
+		}
+	}
+	return true
+}
+
+func TestLongestCommonSubsequenceMulti(t *testing.T) {
+	tests := []struct {
+		strs []string
+		want string
+	}{
+		{[]string{"geeks", "geeksfor", "geeksforgeeks"}, "geeks"},
+		{[]string{"abcd1e2", "bc12ea", "bd1ea"}, "b1e"},
+		{[]string{"abc"}, "abc"},
+		{[]string{"abc", "def", "abc"}, ""},
+		{[]string{"héllo", "hél", "xhél"}, "hél"},
+		{nil, ""},
+	}
+	for _, test := range tests {
+		length, seq := dynamic.LongestCommonSubsequenceMulti(test.strs...)
+		if length != len([]rune(test.want)) || !isCommonSubsequence(seq, test.strs) || len(seq) != len(test.want) {
+			t.Errorf("LongestCommonSubsequenceMulti(%q) = %d, %q, want %q", test.strs, length, seq, test.want)
+		}
+	}
+}
+
+func TestLongestCommonSubsequenceMultiRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	random := func(n int) string {
+		b := make([]byte, rnd.Intn(n))
+		for i := range b {
+			b[i] = "abc"[rnd.Intn(3)]
+		}
+		return string(b)
+	}
+	for round := 0; round < 200; round++ {
+		// two strings agree with the two-string dynamic programming
+		a, b := random(15), random(15)
+		if length, seq := dynamic.LongestCommonSubsequenceMulti(a, b); length != dynamic.LongestCommonSubsequence(a, b) || !isCommonSubsequence(seq, []string{a, b}) {
+			t.Fatalf("LongestCommonSubsequenceMulti(%q, %q) = %d, %q, want length %d", a, b, length, seq, dynamic.LongestCommonSubsequence(a, b))
+		}
+		// three strings agree with every subsequence of the first one
+		strs := []string{random(10), random(10), random(10)}
+		want := 0
+		for mask := 0; mask < 1<<len(strs[0]); mask++ {
+			var s []byte
+			for i := range strs[0] {
+				if mask&(1<<i) != 0 {
+					s = append(s, strs[0][i])
+				}
+			}
+			if len(s) > want && isCommonSubsequence(string(s), strs[1:]) {
+				want = len(s)
+			}
+		}
+		length, seq := dynamic.LongestCommonSubsequenceMulti(strs...)
+		if length != want || len(seq) != want || !isCommonSubsequence(seq, strs) {
+			t.Fatalf("LongestCommonSubsequenceMulti(%q) = %d, %q, want length %d", strs, length, seq, want)
+		}
+	}
+}
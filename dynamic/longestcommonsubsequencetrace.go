@@ -0,0 +1,117 @@
+// LONGEST COMMON SUBSEQUENCE WITH RECONSTRUCTION
+// LongestCommonSubsequenceString walks the full DP table back to recover
+// one longest common subsequence. LongestCommonSubsequenceLowMemory uses
+// Hirschberg's divide and conquer instead: the middle row of the first string
+// is matched against the best split of the second string, found with two
+// linear-space passes, and both halves are solved recursively.
+// time complexity: O(m*n) where m and n are lengths of the strings
+// space complexity: O(m*n) for LongestCommonSubsequenceString,
+// O(min(m, n)) extra for LongestCommonSubsequenceLowMemory
+// https://en.wikipedia.org/wiki/Hirschberg%27s_algorithm
+
+package dynamic
+
+// LongestCommonSubsequenceString returns the length of the longest common
+// subsequence of a and b together with one such subsequence.
+func LongestCommonSubsequenceString(a string, b string) (int, string) {
+	aRunes, aLen := strToRuneSlice(a)
+	bRunes, bLen := strToRuneSlice(b)
+
+	lcs := make([][]int, aLen+1)
+	for i := 0; i <= aLen; i++ {
+		lcs[i] = make([]int, bLen+1)
+	}
+	for i := 1; i <= aLen; i++ {
+		for j := 1; j <= bLen; j++ {
+			if aRunes[i-1] == bRunes[j-1] {
+				lcs[i][j] = lcs[i-1][j-1] + 1
+			} else {
+				lcs[i][j] = Max(lcs[i-1][j], lcs[i][j-1])
+			}
+		}
+	}
+
+	result := make([]rune, lcs[aLen][bLen])
+	k := len(result)
+	for i, j := aLen, bLen; i > 0 && j > 0; {
+		switch {
+		case aRunes[i-1] == bRunes[j-1]:
+			k--
+			result[k] = aRunes[i-1]
+			i, j = i-1, j-1
+		case lcs[i-1][j] >= lcs[i][j-1]:
+			i--
+		default:
+			j--
+		}
+	}
+	return len(result), string(result)
+}
+
+// LongestCommonSubsequenceLowMemory returns the same length as
+// LongestCommonSubsequenceString and a longest common subsequence, which may
+// differ from the one returned there, using memory linear in the input.
+func LongestCommonSubsequenceLowMemory(a string, b string) (int, string) {
+	aRunes, aLen := strToRuneSlice(a)
+	bRunes, bLen := strToRuneSlice(b)
+	if aLen < bLen {
+		aRunes, bRunes = bRunes, aRunes
+	}
+	result := hirschberg(aRunes, bRunes, nil)
+	return len(result), string(result)
+}
+
+// hirschberg appends a longest common subsequence of a and b to out.
+func hirschberg(a, b []rune, out []rune) []rune {
+	if len(a) == 0 || len(b) == 0 {
+		return out
+	}
+	if len(a) == 1 {
+		for _, r := range b {
+			if r == a[0] {
+				return append(out, r)
+			}
+		}
+		return out
+	}
+
+	mid := len(a) / 2
+	left := lcsLastRow(a[:mid], b, false)
+	right := lcsLastRow(a[mid:], b, true)
+
+	// Split b where the two halves together give the longest subsequence.
+	split, best := 0, -1
+	for j := 0; j <= len(b); j++ {
+		if v := left[j] + right[len(b)-j]; v > best {
+			split, best = j, v
+		}
+	}
+	out = hirschberg(a[:mid], b[:split], out)
+	return hirschberg(a[mid:], b[split:], out)
+}
+
+// lcsLastRow returns row[j] = LCS length of a and the first j runes of b, or,
+// when reversed is true, of a and the last j runes of b.
+func lcsLastRow(a, b []rune, reversed bool) []int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for i := 0; i < len(a); i++ {
+		x := a[i]
+		if reversed {
+			x = a[len(a)-1-i]
+		}
+		for j := 1; j <= len(b); j++ {
+			y := b[j-1]
+			if reversed {
+				y = b[len(b)-j]
+			}
+			if x == y {
+				curr[j] = prev[j-1] + 1
+			} else {
+				curr[j] = Max(prev[j], curr[j-1])
+			}
+		}
+		prev, curr = curr, prev
+	}
+	return prev
+}
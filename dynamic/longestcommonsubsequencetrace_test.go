@@ -0,0 +1,48 @@
+package dynamic
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func isSubsequence(s, of string) bool {
+	r := []rune(of)
+	i := 0
+	for _, c := range s {
+		for i < len(r) && r[i] != c {
+			i++
+		}
+		if i == len(r) {
+			return false
+		}
+		i++
+	}
+	return true
+}
+
+func TestLongestCommonSubsequenceReconstruction(t *testing.T) {
+	rnd := rand.New(rand.NewSource(2))
+	randomString := func() string {
+		b := make([]byte, rnd.Intn(40))
+		for i := range b {
+			b[i] = "abcd"[rnd.Intn(4)]
+		}
+		return string(b)
+	}
+	for i := 0; i < 200; i++ {
+		a, b := randomString(), randomString()
+		want := LongestCommonSubsequence(a, b)
+		for name, lcs := range map[string]func(string, string) (int, string){
+			"LongestCommonSubsequenceString":    LongestCommonSubsequenceString,
+			"LongestCommonSubsequenceLowMemory": LongestCommonSubsequenceLowMemory,
+		} {
+			length, seq := lcs(a, b)
+			if length != want || len(seq) != want {
+				t.Fatalf("%s(%q, %q) = %d, %q, want length %d", name, a, b, length, seq, want)
+			}
+			if !isSubsequence(seq, a) || !isSubsequence(seq, b) {
+				t.Fatalf("%s(%q, %q) returned %q, which is not a common subsequence", name, a, b, seq)
+			}
+		}
+	}
+}
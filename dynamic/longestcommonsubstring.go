@@ -0,0 +1,44 @@
+// LONGEST COMMON SUBSTRING
+// dp[i][j] is the length of the longest common suffix of a[:i] and b[:j];
+// the longest common substring ends where it is largest. Only the previous
+// row is ever read, so two rows as long as the shorter string are enough.
+// time complexity: O(m * n) where m and n are lengths of the strings
+// space complexity: O(min(m, n))
+// https://en.wikipedia.org/wiki/Longest_common_substring
+
+package dynamic
+
+// LongestCommonSubstring returns the length of the longest string that is
+// a substring of both a and b, together with the leftmost such substring of a.
+// Strings are compared rune by rune.
+func LongestCommonSubstring(a string, b string) (int, string) {
+	aRunes, aLen := strToRuneSlice(a)
+	bRunes, bLen := strToRuneSlice(b)
+	swapped := false
+	if aLen < bLen {
+		aRunes, bRunes, aLen, bLen = bRunes, aRunes, bLen, aLen
+		swapped = true
+	}
+
+	prev := make([]int, bLen+1)
+	curr := make([]int, bLen+1)
+	best, bestEndA, bestEndB := 0, 0, 0
+	for i := 1; i <= aLen; i++ {
+		for j := 1; j <= bLen; j++ {
+			if aRunes[i-1] == bRunes[j-1] {
+				curr[j] = prev[j-1] + 1
+				if curr[j] > best || (curr[j] == best && swapped && j < bestEndB) {
+					best, bestEndA, bestEndB = curr[j], i, j
+				}
+			} else {
+				curr[j] = 0
+			}
+		}
+		prev, curr = curr, prev
+	}
+
+	if swapped {
+		return best, string(bRunes[bestEndB-best : bestEndB])
+	}
+	return best, string(aRunes[bestEndA-best : bestEndA])
+}
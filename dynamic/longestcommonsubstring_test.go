@@ -0,0 +1,24 @@
+package dynamic
+
+import "testing"
+
+func TestLongestCommonSubstring(t *testing.T) {
+	var testCases = []struct {
+		a, b      string
+		length    int
+		substring string
+	}{
+		{"", "abc", 0, ""},
+		{"abcdxyz", "xyzabcd", 4, "abcd"},
+		{"zxabcdezy", "yzabcdezx", 6, "abcdez"},
+		{"ab", "xxab", 2, "ab"},
+		{"abxcd", "cdyab", 2, "ab"},
+	}
+
+	for _, tc := range testCases {
+		length, substring := LongestCommonSubstring(tc.a, tc.b)
+		if length != tc.length || substring != tc.substring {
+			t.Errorf("LongestCommonSubstring(%q, %q) = %d, %q, want %d, %q", tc.a, tc.b, length, substring, tc.length, tc.substring)
+		}
+	}
+}
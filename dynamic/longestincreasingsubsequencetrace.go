@@ -0,0 +1,43 @@
+// LONGEST INCREASING SUBSEQUENCE WITH RECONSTRUCTION
+// Patience sorting keeps, for every length l, the index of the smallest element
+// ending an increasing subsequence of length l; those tails are increasing, so
+// the place of each new element is found by binary search. Every element also
+// records the tail it extended, and following those links back from the last
+// tail yields the subsequence itself.
+// time complexity: O(n log n)
+// space complexity: O(n)
+// https://en.wikipedia.org/wiki/Longest_increasing_subsequence#Efficient_algorithms
+
+package dynamic
+
+import "sort"
+
+// LongestIncreasingSubsequenceTrace returns the length of the longest
+// strictly increasing subsequence of elements together with one such
+// subsequence.
+func LongestIncreasingSubsequenceTrace(elements []int) (int, []int) {
+	// tails[l] is the index of the smallest element ending an increasing
+	// subsequence of length l+1.
+	var tails []int
+	previous := make([]int, len(elements))
+	for i, v := range elements {
+		l := sort.Search(len(tails), func(k int) bool { return elements[tails[k]] >= v })
+		previous[i] = -1
+		if l > 0 {
+			previous[i] = tails[l-1]
+		}
+		if l == len(tails) {
+			tails = append(tails, i)
+		} else {
+			tails[l] = i
+		}
+	}
+
+	result := make([]int, len(tails))
+	if len(tails) > 0 {
+		for k, i := len(result)-1, tails[len(tails)-1]; k >= 0; k, i = k-1, previous[i] {
+			result[k] = elements[i]
+		}
+	}
+	return len(result), result
+}
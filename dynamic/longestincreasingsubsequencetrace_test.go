@@ -0,0 +1,56 @@
+package dynamic_test
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/dynamic"
+)
+
+func TestLongestIncreasingSubsequenceTrace(t *testing.T) {
+	tests := []struct {
+		elements []int
+		want     []int
+	}{
+		{[]int{10, 9, 2, 5, 3, 7, 101, 18}, []int{2, 3, 7, 18}},
+		{[]int{1, 7, 3, 4, 5}, []int{1, 3, 4, 5}},
+		{[]int{3, 3, 3}, []int{3}},
+		{[]int{5, 4, 3, 2, 1}, []int{1}},
+		{nil, []int{}},
+	}
+	for _, test := range tests {
+		length, seq := dynamic.LongestIncreasingSubsequenceTrace(test.elements)
+		if length != len(test.want) || !reflect.DeepEqual(seq, test.want) {
+			t.Errorf("LongestIncreasingSubsequenceTrace(%v) = %d, %v, want %d, %v", test.elements, length, seq, len(test.want), test.want)
+		}
+	}
+}
+
+func TestLongestIncreasingSubsequenceTraceRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(4))
+	for round := 0; round < 200; round++ {
+		elements := make([]int, rnd.Intn(50))
+		for i := range elements {
+			elements[i] = rnd.Intn(30)
+		}
+		length, seq := dynamic.LongestIncreasingSubsequenceTrace(elements)
+		if want := dynamic.LongestIncreasingSubsequence(elements); length != want || len(seq) != want {
+			t.Fatalf("LongestIncreasingSubsequenceTrace(%v) = %d, %v, want length %d", elements, length, seq, want)
+		}
+		// seq must be strictly increasing and a subsequence of elements.
+		j := 0
+		for k, v := range seq {
+			if k > 0 && seq[k-1] >= v {
+				t.Fatalf("%v is not strictly increasing", seq)
+			}
+			for j < len(elements) && elements[j] != v {
+				j++
+			}
+			if j == len(elements) {
+				t.Fatalf("%v is not a subsequence of %v", seq, elements)
+			}
+			j++
+		}
+	}
+}
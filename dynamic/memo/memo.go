@@ -0,0 +1,172 @@
+// memo.go
+// description: Memoization of pure functions, optionally bounded and safe for concurrent use
+// details:
+// Memoizing a function stores its result for every argument it has been
+// called with, so that later calls with the same argument are answered from
+// the store. It turns a recursion whose subproblems overlap, such as the
+// naive Fibonacci recursion, into top-down dynamic programming: every
+// subproblem is solved once.
+// A recursive function is memoized by calling the memoized version from its
+// own body:
+//
+//	var fib func(n int) int
+//	fib = memo.Memoize1(func(n int) int {
+//		if n < 2 {
+//			return n
+//		}
+//		return fib(n-1) + fib(n-2)
+//	})
+//
+// By default the store grows without bound. WithCapacity keeps only the most
+// recently used results, evicting the least recently used one when full.
+// Concurrent guards the store with a mutex; the lock is not held while the
+// function runs, so recursion does not deadlock, and two goroutines asking
+// for the same missing argument may both compute it.
+// time complexity: O(1) per call answered from the store
+// space complexity: O(number of distinct arguments), or O(capacity)
+// reference: https://en.wikipedia.org/wiki/Memoization
+// see memo_test.go
+
+// Package memo wraps functions so that their results are cached, turning
+// recursive algorithms into top-down dynamic programming.
+package memo
+
+import (
+	"sync"
+
+	"github.com/TheAlgorithms/Go/structure/linkedlist"
+)
+
+// config holds the settings chosen by the options.
+type config struct {
+	capacity   int
+	concurrent bool
+}
+
+// Option configures a memoized function.
+type Option func(*config)
+
+// WithCapacity bounds the number of stored results. When the store is full
+// the least recently used result is evicted. A capacity of zero or less
+// means no bound.
+func WithCapacity(capacity int) Option {
+	return func(c *config) {
+		c.capacity = capacity
+	}
+}
+
+// Concurrent makes the memoized function safe to call from several
+// goroutines at once.
+func Concurrent() Option {
+	return func(c *config) {
+		c.concurrent = true
+	}
+}
+
+// entry is a stored result, kept in recency order when the store is bounded.
+type entry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// store maps arguments to results.
+type store[K comparable, V any] struct {
+	mu       sync.Mutex
+	config   config
+	values   map[K]V                                // used when unbounded
+	elements map[K]*linkedlist.Element[entry[K, V]] // used when bounded
+	recency  *linkedlist.List[entry[K, V]]          // least recently used first
+}
+
+func newStore[K comparable, V any](options []Option) *store[K, V] {
+	s := &store[K, V]{}
+	for _, option := range options {
+		option(&s.config)
+	}
+	if s.config.capacity > 0 {
+		s.elements = make(map[K]*linkedlist.Element[entry[K, V]])
+		s.recency = linkedlist.NewList[entry[K, V]]()
+	} else {
+		s.values = make(map[K]V)
+	}
+	return s
+}
+
+// get returns the result stored for key, marking it as the most recently
+// used.
+func (s *store[K, V]) get(key K) (V, bool) {
+	if s.config.concurrent {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+	}
+	if s.recency == nil {
+		v, ok := s.values[key]
+		return v, ok
+	}
+	e, ok := s.elements[key]
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	s.recency.MoveToBack(e)
+	return e.Value.value, true
+}
+
+// put stores the result for key, evicting the least recently used result if
+// the store is full.
+func (s *store[K, V]) put(key K, value V) {
+	if s.config.concurrent {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+	}
+	if s.recency == nil {
+		s.values[key] = value
+		return
+	}
+	if e, ok := s.elements[key]; ok {
+		e.Value.value = value
+		s.recency.MoveToBack(e)
+		return
+	}
+	if s.recency.Len() >= s.config.capacity {
+		oldest := s.recency.Front()
+		delete(s.elements, oldest.Value.key)
+		s.recency.Remove(oldest)
+	}
+	s.elements[key] = s.recency.PushBack(entry[K, V]{key: key, value: value})
+}
+
+// call returns the stored result for key, computing and storing it with f
+// when it is missing.
+func (s *store[K, V]) call(key K, f func() V) V {
+	if v, ok := s.get(key); ok {
+		return v
+	}
+	v := f()
+	s.put(key, v)
+	return v
+}
+
+// Memoize1 returns a function computing f and remembering its results.
+// f must be pure: its result must only depend on its argument.
+func Memoize1[K comparable, V any](f func(K) V, options ...Option) func(K) V {
+	s := newStore[K, V](options)
+	return func(k K) V {
+		return s.call(k, func() V { return f(k) })
+	}
+}
+
+// pair is the key of a function of two arguments.
+type pair[K1, K2 comparable] struct {
+	first  K1
+	second K2
+}
+
+// Memoize2 returns a function computing f and remembering its results for
+// every pair of arguments. f must be pure.
+func Memoize2[K1, K2 comparable, V any](f func(K1, K2) V, options ...Option) func(K1, K2) V {
+	s := newStore[pair[K1, K2], V](options)
+	return func(a K1, b K2) V {
+		return s.call(pair[K1, K2]{a, b}, func() V { return f(a, b) })
+	}
+}
@@ -0,0 +1,103 @@
+package memo
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestMemoize1(t *testing.T) {
+	calls := 0
+	var fib func(n int) int
+	fib = Memoize1(func(n int) int {
+		calls++
+		if n < 2 {
+			return n
+		}
+		return fib(n-1) + fib(n-2)
+	})
+	if got := fib(80); got != 23416728348467685 {
+		t.Fatalf("fib(80) = %d", got)
+	}
+	if calls != 81 {
+		t.Errorf("computed %d values, want 81", calls)
+	}
+	fib(80)
+	fib(40)
+	if calls != 81 {
+		t.Errorf("stored values were computed again")
+	}
+}
+
+func TestMemoize2(t *testing.T) {
+	calls := 0
+	var binomial func(n, k int) int
+	binomial = Memoize2(func(n, k int) int {
+		calls++
+		if k == 0 || k == n {
+			return 1
+		}
+		return binomial(n-1, k-1) + binomial(n-1, k)
+	})
+	if got := binomial(30, 15); got != 155117520 {
+		t.Fatalf("binomial(30, 15) = %d", got)
+	}
+	// every (n, k) with k <= 15 and n-k <= 15 once, except (0, 0)
+	if calls != 16*16-1 {
+		t.Errorf("computed %d values, want %d", calls, 16*16-1)
+	}
+}
+
+func TestWithCapacity(t *testing.T) {
+	calls := map[int]int{}
+	square := Memoize1(func(n int) int {
+		calls[n]++
+		return n * n
+	}, WithCapacity(2))
+	for _, n := range []int{1, 2, 1, 3, 1, 2} {
+		if got := square(n); got != n*n {
+			t.Fatalf("square(%d) = %d", n, got)
+		}
+	}
+	// 2 is evicted by 3, being less recently used than 1
+	if want := map[int]int{1: 1, 2: 2, 3: 1}; len(calls) != len(want) || calls[1] != want[1] || calls[2] != want[2] || calls[3] != want[3] {
+		t.Errorf("calls %v, want %v", calls, want)
+	}
+
+	// a bounded store still gives the right results for a deep recursion
+	var fib func(n uint64) uint64
+	fib = Memoize1(func(n uint64) uint64 {
+		if n < 2 {
+			return n
+		}
+		return fib(n-1) + fib(n-2)
+	}, WithCapacity(3))
+	if got := fib(90); got != 2880067194370816120 {
+		t.Errorf("fib(90) = %d", got)
+	}
+}
+
+func TestConcurrent(t *testing.T) {
+	var fib func(n int) int
+	fib = Memoize1(func(n int) int {
+		if n < 2 {
+			return n
+		}
+		return fib(n-1) + fib(n-2)
+	}, Concurrent(), WithCapacity(50))
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for n := 0; n < 90; n++ {
+				if got, want := fib((n+i)%90), fib((n+i)%90); got != want {
+					t.Errorf("fib(%d) changed from %d to %d", (n+i)%90, want, got)
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+	if got := fib(50); got != 12586269025 {
+		t.Errorf("fib(50) = %d", got)
+	}
+}
@@ -0,0 +1,100 @@
+// OPTIMAL BINARY SEARCH TREE
+// Given the frequencies with which sorted keys are searched, and those of the
+// searches falling in each gap between them, the optimal binary search tree
+// minimizes the total number of comparisons. A key at depth d costs d + 1
+// comparisons and a search ending in a gap under d keys costs d. cost[i][j],
+// the cost of the best tree of the keys i to j-1 and the gaps i to j, is the
+// best over the roots r of cost[i][r] + cost[r+1][j] plus the total frequency
+// w[i][j] of the range, since every search in it goes one level deeper under
+// the root. Knuth showed that the best root moves right with the range:
+// root[i][j-1] <= root[i][j] <= root[i+1][j], so the roots tried for all
+// ranges of the same length add up to O(n), and the table fills in O(n^2)
+// instead of O(n^3). The tree is then built by pushing the keys into a
+// binary search tree in pre-order, which reproduces the chosen shape.
+// With frequencies summing to 1, the cost is the expected number of
+// comparisons of a search.
+// time complexity: O(n^2)
+// space complexity: O(n^2)
+// https://en.wikipedia.org/wiki/Optimal_binary_search_tree
+// Knuth, Optimum binary search trees, Acta Informatica 1971
+
+package dynamic
+
+import (
+	"errors"
+
+	"github.com/TheAlgorithms/Go/constraints"
+	"github.com/TheAlgorithms/Go/structure/tree"
+)
+
+// ErrInvalidFrequencies is returned when the frequencies of an optimal binary
+// search tree do not match its keys or are negative.
+var ErrInvalidFrequencies = errors.New("invalid search frequencies")
+
+// ErrUnsortedKeys is returned when the keys of an optimal binary search tree
+// are not strictly increasing.
+var ErrUnsortedKeys = errors.New("keys must be strictly increasing")
+
+// OptimalBinarySearchTree returns the smallest total cost of searches in a
+// binary search tree of keys, key i being searched hits[i] times and the
+// gap before key i, or after the last key for i = len(keys), misses[i]
+// times, along with such a tree. misses may be nil when no search fails.
+func OptimalBinarySearchTree[T constraints.Ordered](keys []T, hits, misses []float64) (float64, *tree.BinarySearch[T], error) {
+	n := len(keys)
+	if misses == nil {
+		misses = make([]float64, n+1)
+	}
+	if len(hits) != n || len(misses) != n+1 {
+		return 0, nil, ErrInvalidFrequencies
+	}
+	for i := range misses {
+		if misses[i] < 0 || i < n && hits[i] < 0 {
+			return 0, nil, ErrInvalidFrequencies
+		}
+		if i > 0 && i < n && keys[i-1] >= keys[i] {
+			return 0, nil, ErrUnsortedKeys
+		}
+	}
+
+	// cost[i][j], weight[i][j] and root[i][j] describe the best tree of the
+	// keys i to j-1
+	cost := make([][]float64, n+1)
+	weight := make([][]float64, n+1)
+	root := make([][]int, n+1)
+	for i := 0; i <= n; i++ {
+		cost[i] = make([]float64, n+1)
+		weight[i] = make([]float64, n+1)
+		root[i] = make([]int, n+1)
+		weight[i][i] = misses[i]
+	}
+	for length := 1; length <= n; length++ {
+		for i := 0; i+length <= n; i++ {
+			j := i + length
+			weight[i][j] = weight[i][j-1] + hits[j-1] + misses[j]
+			low, high := i, j-1
+			if length > 1 {
+				low, high = root[i][j-1], root[i+1][j]
+			}
+			best := -1.0
+			for r := low; r <= high; r++ {
+				if c := cost[i][r] + cost[r+1][j]; best < 0 || c < best {
+					best, root[i][j] = c, r
+				}
+			}
+			cost[i][j] = best + weight[i][j]
+		}
+	}
+
+	t := tree.NewBinarySearch[T]()
+	var build func(i, j int)
+	build = func(i, j int) {
+		if i < j {
+			r := root[i][j]
+			t.Push(keys[r])
+			build(i, r)
+			build(r+1, j)
+		}
+	}
+	build(0, n)
+	return cost[0][n], t, nil
+}
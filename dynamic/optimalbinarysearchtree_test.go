@@ -0,0 +1,122 @@
+package dynamic_test
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/dynamic"
+	"github.com/TheAlgorithms/Go/structure/tree"
+)
+
+// comparisons returns the number of keys of t compared while searching key.
+func comparisons(t *tree.BinarySearch[int], key int) int {
+	count := 0
+	var none *tree.BSNode[int]
+	for n := tree.Node[int](t.Root); n != tree.Node[int](none); count++ {
+		switch {
+		case key == n.Key():
+			return count + 1
+		case key < n.Key():
+			n = n.Left()
+		default:
+			n = n.Right()
+		}
+	}
+	return count
+}
+
+// searchCost returns the total cost of the searches in t of the keys 2, 4,
+// ..., 2n and of the gaps between them at 1, 3, ..., 2n+1.
+func searchCost(t *tree.BinarySearch[int], hits, misses []float64) float64 {
+	cost := 0.0
+	for i, h := range hits {
+		cost += h * float64(comparisons(t, 2*i+2))
+	}
+	for i, m := range misses {
+		cost += m * float64(comparisons(t, 2*i+1))
+	}
+	return cost
+}
+
+// cubicOptimalCost tries every root of every range.
+func cubicOptimalCost(hits, misses []float64) float64 {
+	n := len(hits)
+	var best func(i, j int) float64
+	best = func(i, j int) float64 {
+		if i == j {
+			return 0
+		}
+		weight := misses[i]
+		for k := i; k < j; k++ {
+			weight += hits[k] + misses[k+1]
+		}
+		cost := math.Inf(1)
+		for r := i; r < j; r++ {
+			cost = math.Min(cost, best(i, r)+best(r+1, j))
+		}
+		return cost + weight
+	}
+	return best(0, n)
+}
+
+func TestOptimalBinarySearchTree(t *testing.T) {
+	// the example of Introduction to Algorithms, whose expected cost of 2.75
+	// also counts a comparison for every failed search
+	keys := []int{2, 4, 6, 8, 10}
+	hits := []float64{0.15, 0.10, 0.05, 0.10, 0.20}
+	misses := []float64{0.05, 0.10, 0.05, 0.05, 0.05, 0.10}
+	cost, bst, err := dynamic.OptimalBinarySearchTree(keys, hits, misses)
+	if err != nil || math.Abs(cost-(2.75-0.40)) > 1e-9 {
+		t.Errorf("OptimalBinarySearchTree cost = %v, %v, want 2.35", cost, err)
+	}
+	if got, want := bst.PreOrder(), []int{4, 2, 10, 8, 6}; !reflect.DeepEqual(got, want) {
+		t.Errorf("OptimalBinarySearchTree pre-order = %v, want %v", got, want)
+	}
+	if got := searchCost(bst, hits, misses); math.Abs(got-cost) > 1e-9 {
+		t.Errorf("the tree costs %v, the table %v", got, cost)
+	}
+	// without failed searches, the most searched key is the root
+	_, letters, _ := dynamic.OptimalBinarySearchTree([]string{"a", "b", "c"}, []float64{1, 1, 10}, nil)
+	if got := letters.PreOrder(); !reflect.DeepEqual(got, []string{"c", "a", "b"}) && !reflect.DeepEqual(got, []string{"c", "b", "a"}) {
+		t.Errorf("OptimalBinarySearchTree pre-order = %v, want c at the root", got)
+	}
+	if cost, bst, err := dynamic.OptimalBinarySearchTree[int](nil, nil, nil); err != nil || cost != 0 || !bst.Empty() {
+		t.Errorf("OptimalBinarySearchTree of no key = %v, %v, %v", cost, bst, err)
+	}
+	if _, _, err := dynamic.OptimalBinarySearchTree([]int{2, 1}, []float64{1, 1}, nil); !errors.Is(err, dynamic.ErrUnsortedKeys) {
+		t.Errorf("OptimalBinarySearchTree of unsorted keys error = %v", err)
+	}
+	if _, _, err := dynamic.OptimalBinarySearchTree([]int{1, 2}, []float64{1}, nil); !errors.Is(err, dynamic.ErrInvalidFrequencies) {
+		t.Errorf("OptimalBinarySearchTree with missing frequencies error = %v", err)
+	}
+	if _, _, err := dynamic.OptimalBinarySearchTree([]int{1}, []float64{1}, []float64{0, -1}); !errors.Is(err, dynamic.ErrInvalidFrequencies) {
+		t.Errorf("OptimalBinarySearchTree with a negative frequency error = %v", err)
+	}
+}
+
+func TestOptimalBinarySearchTreeRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for round := 0; round < 200; round++ {
+		n := rnd.Intn(9)
+		keys := make([]int, n)
+		hits := make([]float64, n)
+		misses := make([]float64, n+1)
+		for i := range keys {
+			keys[i] = 2*i + 2
+			hits[i] = float64(rnd.Intn(10))
+		}
+		for i := range misses {
+			misses[i] = float64(rnd.Intn(5))
+		}
+		cost, bst, err := dynamic.OptimalBinarySearchTree(keys, hits, misses)
+		if err != nil || cost != cubicOptimalCost(hits, misses) {
+			t.Fatalf("OptimalBinarySearchTree(%v, %v) = %v, %v, want %v", hits, misses, cost, err, cubicOptimalCost(hits, misses))
+		}
+		if got := searchCost(bst, hits, misses); got != cost || len(bst.InOrder()) != n {
+			t.Fatalf("OptimalBinarySearchTree(%v, %v) built a tree of cost %v holding %v, want %v", hits, misses, got, bst.InOrder(), cost)
+		}
+	}
+}
@@ -4,6 +4,8 @@
 // author: Rares Mateizer (https://github.com/rares985)
 package dynamic
 
+import "github.com/TheAlgorithms/Go/dynamic/memo"
+
 // UniquePaths implements the solution to the "Unique Paths" problem
 func UniquePaths(m, n int) int {
 	if m <= 0 || n <= 0 {
@@ -31,3 +33,29 @@ func UniquePaths(m, n int) int {
 
 	return grid[m-1][n-1]
 }
+
+// uniquePathsStep returns the number of paths through an m x n grid from the
+// numbers for the grids one row or one column smaller, obtained through paths.
+func uniquePathsStep(m, n int, paths func(m, n int) int) int {
+	if m <= 0 || n <= 0 {
+		return 0
+	}
+	if m == 1 || n == 1 {
+		return 1
+	}
+	return paths(m-1, n) + paths(m, n-1)
+}
+
+// UniquePathsRecursive solves the "Unique Paths" problem by the plain
+// recursion, which takes exponential time.
+func UniquePathsRecursive(m, n int) int {
+	return uniquePathsStep(m, n, UniquePathsRecursive)
+}
+
+// UniquePathsMemoized solves the "Unique Paths" problem by the recursion of
+// UniquePathsRecursive, memoized so that it takes O(m*n) time.
+func UniquePathsMemoized(m, n int) int {
+	var paths func(m, n int) int
+	paths = memo.Memoize2(func(m, n int) int { return uniquePathsStep(m, n, paths) })
+	return paths(m, n)
+}
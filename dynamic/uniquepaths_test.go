@@ -23,6 +23,37 @@ func TestUniquePaths(t *testing.T) {
 			if got := UniquePaths(test.m, test.n); got != test.want {
 				t.Errorf("UniquePaths(%v, %v) = %v, want %v", test.m, test.n, got, test.want)
 			}
+			if got := UniquePathsMemoized(test.m, test.n); got != test.want {
+				t.Errorf("UniquePathsMemoized(%v, %v) = %v, want %v", test.m, test.n, got, test.want)
+			}
 		})
 	}
 }
+
+func TestUniquePathsRecursive(t *testing.T) {
+	for m := 0; m <= 6; m++ {
+		for n := 0; n <= 6; n++ {
+			if got, want := UniquePathsRecursive(m, n), UniquePaths(m, n); got != want {
+				t.Errorf("UniquePathsRecursive(%v, %v) = %v, want %v", m, n, got, want)
+			}
+		}
+	}
+}
+
+func BenchmarkUniquePaths(b *testing.B) {
+	b.Run("Recursive", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			UniquePathsRecursive(12, 12)
+		}
+	})
+	b.Run("Memoized", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			UniquePathsMemoized(12, 12)
+		}
+	})
+	b.Run("DP", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			UniquePaths(12, 12)
+		}
+	})
+}
@@ -0,0 +1,216 @@
+// connectfour.go
+// description: Connect Four as a Game for the minimax search
+// details:
+// Players drop stones in turn into the seven columns of a vertical board six
+// rows high, and the first to line up four stones horizontally, vertically or
+// diagonally wins. A move is the number of a column that is not full.
+// The game is too large to search to the end, so positions at the depth
+// limit are evaluated heuristically: every window of four cells in a line
+// that holds stones of a single player is worth 1, 4 or 16 to that player for
+// one, two or three stones, and stones in the centre column are worth 3 more.
+// Central columns are tried first, since they are part of the most lines.
+// see connectfour_test.go
+
+package gametheory
+
+import "strings"
+
+const (
+	connectFourColumns = 7
+	connectFourRows    = 6
+)
+
+// connectFourOrder lists the columns from the centre outwards.
+var connectFourOrder = [connectFourColumns]int{3, 2, 4, 1, 5, 0, 6}
+
+// ConnectFour is a Connect Four position. X moves first.
+type ConnectFour struct {
+	// cells[c][r] is the cell of column c and row r, row 0 being the bottom.
+	cells   [connectFourColumns][connectFourRows]Player
+	heights [connectFourColumns]int
+	toMove  Player
+	winner  Player
+	stones  int
+}
+
+var _ Game[int] = &ConnectFour{}
+
+// NewConnectFour returns the empty board with X to move.
+func NewConnectFour() *ConnectFour {
+	return &ConnectFour{toMove: X}
+}
+
+// ParseConnectFour reads a board written as six lines of seven characters,
+// from the top row to the bottom one, "X", "O" and "." for an empty cell.
+// The player to move is deduced from the number of stones. It returns false
+// when the board is malformed or has a stone above an empty cell.
+func ParseConnectFour(s string) (*ConnectFour, bool) {
+	lines := strings.Fields(s)
+	if len(lines) != connectFourRows {
+		return nil, false
+	}
+	b := NewConnectFour()
+	xs, oh := 0, 0
+	for i, line := range lines {
+		if len(line) != connectFourColumns {
+			return nil, false
+		}
+		r := connectFourRows - 1 - i
+		for c, ch := range line {
+			switch ch {
+			case 'X':
+				b.cells[c][r] = X
+				xs++
+			case 'O':
+				b.cells[c][r] = O
+				oh++
+			case '.':
+			default:
+				return nil, false
+			}
+		}
+	}
+	for c := range b.cells {
+		for b.heights[c] < connectFourRows && b.cells[c][b.heights[c]] != Empty {
+			if b.connects(c, b.heights[c]) {
+				b.winner = b.cells[c][b.heights[c]]
+			}
+			b.heights[c]++
+		}
+		for r := b.heights[c]; r < connectFourRows; r++ {
+			if b.cells[c][r] != Empty {
+				return nil, false
+			}
+		}
+	}
+	switch xs - oh {
+	case 0:
+	case 1:
+		b.toMove = O
+	default:
+		return nil, false
+	}
+	b.stones = xs + oh
+	return b, true
+}
+
+// ToMove returns the player to move.
+func (b *ConnectFour) ToMove() Player {
+	return b.toMove
+}
+
+// Winner returns the player who lined up four stones, or Empty.
+func (b *ConnectFour) Winner() Player {
+	return b.winner
+}
+
+// Moves returns the columns that are not full, central ones first.
+func (b *ConnectFour) Moves() []int {
+	if b.winner != Empty {
+		return nil
+	}
+	moves := make([]int, 0, connectFourColumns)
+	for _, c := range connectFourOrder {
+		if b.heights[c] < connectFourRows {
+			moves = append(moves, c)
+		}
+	}
+	return moves
+}
+
+// Play drops a stone of the player to move into the column.
+func (b *ConnectFour) Play(column int) {
+	r := b.heights[column]
+	b.cells[column][r] = b.toMove
+	b.heights[column]++
+	b.stones++
+	if b.connects(column, r) {
+		b.winner = b.toMove
+	}
+	b.toMove = b.toMove.opponent()
+}
+
+// Undo takes back the top stone of the column, which must be the last one
+// played.
+func (b *ConnectFour) Undo(column int) {
+	b.heights[column]--
+	b.cells[column][b.heights[column]] = Empty
+	b.stones--
+	// the game ended with the move being undone, if at all
+	b.winner = Empty
+	b.toMove = b.toMove.opponent()
+}
+
+// connects reports whether the stone at column c and row r is part of four
+// in a line.
+func (b *ConnectFour) connects(c, r int) bool {
+	p := b.cells[c][r]
+	for _, d := range [4][2]int{{1, 0}, {0, 1}, {1, 1}, {1, -1}} {
+		count := 1
+		for _, sign := range [2]int{1, -1} {
+			x, y := c+sign*d[0], r+sign*d[1]
+			for x >= 0 && x < connectFourColumns && y >= 0 && y < connectFourRows && b.cells[x][y] == p {
+				count++
+				x, y = x+sign*d[0], y+sign*d[1]
+			}
+		}
+		if count >= 4 {
+			return true
+		}
+	}
+	return false
+}
+
+// Over reports whether a player has won or the board is full.
+func (b *ConnectFour) Over() bool {
+	return b.winner != Empty || b.stones == connectFourColumns*connectFourRows
+}
+
+// windowScore is the value of a window of four cells holding that many
+// stones of a single player.
+var windowScore = [4]int{0, 1, 4, 16}
+
+// Evaluate returns -Win when the previous player has won, 0 for a draw, and
+// otherwise the heuristic value of the position for the player to move.
+func (b *ConnectFour) Evaluate() int {
+	switch {
+	case b.winner != Empty:
+		return -Win
+	case b.stones == connectFourColumns*connectFourRows:
+		return 0
+	}
+	score := 0
+	for _, d := range [4][2]int{{1, 0}, {0, 1}, {1, 1}, {1, -1}} {
+		for c := 0; c < connectFourColumns; c++ {
+			for r := 0; r < connectFourRows; r++ {
+				endC, endR := c+3*d[0], r+3*d[1]
+				if endC >= connectFourColumns || endR < 0 || endR >= connectFourRows {
+					continue
+				}
+				own, other := 0, 0
+				for i := 0; i < 4; i++ {
+					switch b.cells[c+i*d[0]][r+i*d[1]] {
+					case b.toMove:
+						own++
+					case b.toMove.opponent():
+						other++
+					}
+				}
+				if other == 0 {
+					score += windowScore[own]
+				} else if own == 0 {
+					score -= windowScore[other]
+				}
+			}
+		}
+	}
+	for r := 0; r < connectFourRows; r++ {
+		switch b.cells[3][r] {
+		case b.toMove:
+			score += 3
+		case b.toMove.opponent():
+			score -= 3
+		}
+	}
+	return score
+}
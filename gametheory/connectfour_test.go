@@ -0,0 +1,89 @@
+package gametheory
+
+import "testing"
+
+func TestConnectFour(t *testing.T) {
+	tests := []struct {
+		name  string
+		board string
+		depth int
+		moves []int
+		wins  bool
+	}{
+		{"win on the row", `
+			.......
+			.......
+			.......
+			.......
+			O.O....
+			XXX.O..`, 2, []int{3}, true},
+		{"block the column", `
+			.......
+			.......
+			.......
+			.O.....
+			.O.....
+			XO...XX`, 4, []int{1}, false},
+		{"win on the diagonal", `
+			.......
+			.......
+			.......
+			..XX...
+			.XOO...
+			XOOX..O`, 2, []int{3}, true},
+		{"make two threats", `
+			.......
+			.......
+			.......
+			.......
+			..OO...
+			..XX...`, 4, []int{1, 4}, true},
+	}
+	for _, test := range tests {
+		g, ok := ParseConnectFour(test.board)
+		if !ok {
+			t.Fatalf("%s: cannot parse the board", test.name)
+		}
+		r := AlphaBeta[int](g, test.depth)
+		if !contains(test.moves, r.Move) {
+			t.Errorf("%s: move %d, want one of %v", test.name, r.Move, test.moves)
+		}
+		if test.wins && r.Value < Win-test.depth {
+			t.Errorf("%s: value %d, want a win", test.name, r.Value)
+		}
+	}
+}
+
+func TestConnectFourRules(t *testing.T) {
+	g := NewConnectFour()
+	for _, c := range []int{0, 1, 0, 1, 0, 1} {
+		g.Play(c)
+	}
+	if g.Over() || g.ToMove() != X {
+		t.Fatalf("the game should go on with X to move")
+	}
+	g.Play(0)
+	if !g.Over() || g.Winner() != X || g.Moves() != nil || g.Evaluate() != -Win {
+		t.Errorf("four X in column 0 should end the game")
+	}
+	g.Undo(0)
+	if g.Over() || g.Winner() != Empty {
+		t.Errorf("undoing the winning move should resume the game")
+	}
+	for i := 0; i < 6; i++ {
+		g.Play(6)
+	}
+	if contains(g.Moves(), 6) {
+		t.Errorf("a full column should not be playable")
+	}
+
+	for _, bad := range []string{
+		"X......\n.......\n.......\n.......\n.......\n.......", // floating stone
+		".......\n.......\n.......\n.......\n.......\nXX.....", // too many X
+		".......\n.......\n.......\n.......\nXXXX",             // short
+	} {
+		if _, ok := ParseConnectFour(bad); ok {
+			t.Errorf("%q should not parse", bad)
+		}
+	}
+}
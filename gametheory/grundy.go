@@ -0,0 +1,122 @@
+// grundy.go
+// description: Sprague-Grundy numbers of impartial games
+// details:
+// In an impartial game both players have the same moves, and the player who
+// cannot move loses. The Sprague-Grundy theorem says that every position is
+// equivalent to a Nim heap whose size is its Grundy number: the mex (minimum
+// excluded value) of the Grundy numbers of the positions one move away. A
+// position is lost for the player to move exactly when its Grundy number is
+// 0, and the Grundy number of a sum of independent games, where a move is
+// made in one game of the player's choice, is the XOR of their numbers.
+// Grundy numbers of a game given as a graph of positions are computed in
+// topological order, which requires the graph to be acyclic: every play must
+// end.
+// time complexity: O(V + E) for a game graph, O(n k) for a subtraction game
+// with k moves
+// space complexity: O(V)
+// reference: https://en.wikipedia.org/wiki/Sprague%E2%80%93Grundy_theorem
+// see grundy_test.go
+
+// Package gametheory solves two-player games: Grundy numbers of impartial
+// games, Nim and its variants, and a generic minimax search with alpha-beta
+// pruning, shown on tic-tac-toe and Connect Four.
+package gametheory
+
+import "errors"
+
+// ErrCyclicGame is returned when a game graph has a cycle, so that play may
+// never end.
+var ErrCyclicGame = errors.New("game graph has a cycle")
+
+// Mex returns the smallest non-negative integer that is not in values.
+func Mex(values []int) int {
+	seen := make([]bool, len(values)+1)
+	for _, v := range values {
+		if v >= 0 && v < len(seen) {
+			seen[v] = true
+		}
+	}
+	m := 0
+	for seen[m] {
+		m++
+	}
+	return m
+}
+
+// Grundy returns the Grundy number of every position of a game whose
+// positions are 0 to len(moves)-1, moves[p] listing the positions reachable
+// from p in one move. It returns ErrCyclicGame when the game graph has a
+// cycle.
+func Grundy(moves [][]int) ([]int, error) {
+	n := len(moves)
+	// order the positions so that every position comes after the positions
+	// it moves to, by a depth first search
+	const (
+		unvisited = iota
+		inProgress
+		finished
+	)
+	state := make([]int, n)
+	grundy := make([]int, n)
+	type frame struct{ position, next int }
+	for start := 0; start < n; start++ {
+		if state[start] != unvisited {
+			continue
+		}
+		stack := []frame{{start, 0}}
+		state[start] = inProgress
+		for len(stack) > 0 {
+			top := &stack[len(stack)-1]
+			if top.next < len(moves[top.position]) {
+				q := moves[top.position][top.next]
+				top.next++
+				switch state[q] {
+				case inProgress:
+					return nil, ErrCyclicGame
+				case unvisited:
+					state[q] = inProgress
+					stack = append(stack, frame{q, 0})
+				}
+				continue
+			}
+			// every successor is finished
+			p := top.position
+			successors := make([]int, len(moves[p]))
+			for i, q := range moves[p] {
+				successors[i] = grundy[q]
+			}
+			grundy[p] = Mex(successors)
+			state[p] = finished
+			stack = stack[:len(stack)-1]
+		}
+	}
+	return grundy, nil
+}
+
+// SubtractionGame returns the Grundy numbers of the heap sizes 0 to n in the
+// game where a move removes from a heap a number of tokens taken from
+// subtract, whose values must be positive.
+func SubtractionGame(n int, subtract []int) []int {
+	grundy := make([]int, n+1)
+	successors := make([]int, 0, len(subtract))
+	for size := 1; size <= n; size++ {
+		successors = successors[:0]
+		for _, s := range subtract {
+			if s > 0 && s <= size {
+				successors = append(successors, grundy[size-s])
+			}
+		}
+		grundy[size] = Mex(successors)
+	}
+	return grundy
+}
+
+// Sum returns the Grundy number of the sum of games with the given Grundy
+// numbers. The player to move wins the sum exactly when it is not 0.
+func Sum(grundy ...int) int {
+	x := 0
+	for _, g := range grundy {
+		x ^= g
+	}
+	return x
+}
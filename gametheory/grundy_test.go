@@ -0,0 +1,74 @@
+package gametheory
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestMex(t *testing.T) {
+	tests := []struct {
+		values []int
+		want   int
+	}{
+		{nil, 0},
+		{[]int{1, 2}, 0},
+		{[]int{0, 1, 3}, 2},
+		{[]int{2, 0, 1, 1}, 3},
+		{[]int{-1, 7, 0}, 1},
+	}
+	for _, test := range tests {
+		if got := Mex(test.values); got != test.want {
+			t.Errorf("Mex(%v) = %d, want %d", test.values, got, test.want)
+		}
+	}
+}
+
+func TestGrundy(t *testing.T) {
+	// positions 0 to 5, position p can move to p-1 and p-2: the subtraction
+	// game {1, 2}, listed in no particular order
+	moves := [][]int{{}, {0}, {1, 0}, {2, 1}, {3, 2}, {4, 3}}
+	got, err := Grundy(moves)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := SubtractionGame(5, []int{1, 2}); !reflect.DeepEqual(got, want) {
+		t.Errorf("Grundy = %v, want %v", got, want)
+	}
+	if want := []int{0, 1, 2, 0, 1, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Grundy = %v, want %v", got, want)
+	}
+
+	// a Nim heap of size n is worth n
+	nim := make([][]int, 8)
+	for n := range nim {
+		for m := 0; m < n; m++ {
+			nim[n] = append(nim[n], m)
+		}
+	}
+	got, _ = Grundy(nim)
+	for n, g := range got {
+		if g != n {
+			t.Errorf("Nim heap %d has Grundy number %d", n, g)
+		}
+	}
+
+	if _, err := Grundy([][]int{{1}, {2}, {0}}); !errors.Is(err, ErrCyclicGame) {
+		t.Errorf("Grundy of a cycle: got %v, want ErrCyclicGame", err)
+	}
+}
+
+func TestSubtractionGame(t *testing.T) {
+	// with moves {1, 2, 3}, the heap is lost exactly on multiples of 4
+	for n, g := range SubtractionGame(40, []int{1, 2, 3}) {
+		if g != n%4 {
+			t.Errorf("g(%d) = %d, want %d", n, g, n%4)
+		}
+	}
+	if got, want := SubtractionGame(10, []int{2, 5}), []int{0, 0, 1, 1, 0, 2, 1, 0, 0, 1, 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SubtractionGame(10, {2, 5}) = %v, want %v", got, want)
+	}
+	if Sum(1, 2, 3) != 0 || Sum(5) != 5 || Sum() != 0 {
+		t.Errorf("Sum should be the XOR of the Grundy numbers")
+	}
+}
@@ -0,0 +1,165 @@
+// mcts.go
+// description: Monte Carlo tree search with UCT selection
+// details:
+// Monte Carlo tree search estimates the value of moves by playing many
+// random games. It grows a tree of positions from the current one, and
+// every iteration runs four steps:
+// - selection walks down the tree, choosing at every node the child with the
+// best upper confidence bound (UCT): its average score plus an exploration
+// term, c * sqrt(ln(visits of the node) / visits of the child), which favours
+// the children tried least,
+// - expansion adds one untried move of the node reached to the tree,
+// - rollout plays random moves from there until the game is over,
+// - backpropagation adds the score of that game to every node on the path,
+// for the player who made the move leading to it.
+// The most visited move of the root is played. Unlike minimax, no
+// evaluation function is needed: only Over and the sign of Evaluate at the
+// end of a game are used, so the search suits games where positions are hard
+// to judge. The game is played and undone in place, as for Minimax.
+// time complexity: O(iterations * length of a game)
+// space complexity: O(iterations), one node per iteration
+// reference: https://en.wikipedia.org/wiki/Monte_Carlo_tree_search
+// see mcts_test.go
+
+package gametheory
+
+import (
+	"math"
+	"math/rand"
+)
+
+// Exploration is the usual UCT exploration constant, sqrt(2).
+const Exploration = math.Sqrt2
+
+// MCTS is a Monte Carlo tree search with its own source of randomness, so
+// that searches are repeatable for a given seed.
+type MCTS[M any] struct {
+	// Exploration weighs trying new moves against the ones that scored best.
+	Exploration float64
+	rnd         *rand.Rand
+}
+
+// NewMCTS returns a search using Exploration and random numbers from seed.
+func NewMCTS[M any](seed int64) *MCTS[M] {
+	return &MCTS[M]{Exploration: Exploration, rnd: rand.New(rand.NewSource(seed))}
+}
+
+// Estimate is the outcome of a Monte Carlo tree search.
+type Estimate[M any] struct {
+	// Move is the most visited move. It is the zero value when the game is
+	// over.
+	Move M
+	// Score is the average score of Move for the player to move: 1 for a
+	// win, 1/2 for a draw and 0 for a loss.
+	Score float64
+	// Visits is the number of iterations that went through Move.
+	Visits int
+}
+
+// mctsNode is a position of the search tree.
+type mctsNode[M any] struct {
+	move     M // move leading to the node
+	parent   *mctsNode[M]
+	children []*mctsNode[M]
+	untried  []M
+	visits   int
+	score    float64 // total score of the player who made move
+}
+
+// newMCTSNode returns the node of the current position of g.
+func newMCTSNode[M any](g Game[M], move M, parent *mctsNode[M]) *mctsNode[M] {
+	n := &mctsNode[M]{move: move, parent: parent}
+	if !g.Over() {
+		n.untried = g.Moves()
+	}
+	return n
+}
+
+// uct returns the upper confidence bound of the child n.
+func (n *mctsNode[M]) uct(exploration float64) float64 {
+	return n.score/float64(n.visits) + exploration*math.Sqrt(math.Log(float64(n.parent.visits))/float64(n.visits))
+}
+
+// outcome returns the score of the player to move in a game that has ended.
+func outcome[M any](g Game[M]) float64 {
+	switch v := g.Evaluate(); {
+	case v > 0:
+		return 1
+	case v < 0:
+		return 0
+	}
+	return 0.5
+}
+
+// Search runs the given number of iterations from the position of g and
+// returns the most promising move. The game is left as it was.
+func (s *MCTS[M]) Search(g Game[M], iterations int) Estimate[M] {
+	var none M
+	root := newMCTSNode(g, none, nil)
+	var played []M
+	for i := 0; i < iterations; i++ {
+		node := root
+		played = played[:0]
+		// selection
+		for len(node.untried) == 0 && len(node.children) > 0 {
+			best := node.children[0]
+			for _, c := range node.children[1:] {
+				if c.uct(s.Exploration) > best.uct(s.Exploration) {
+					best = c
+				}
+			}
+			node = best
+			g.Play(node.move)
+			played = append(played, node.move)
+		}
+		// expansion
+		if len(node.untried) > 0 {
+			j := s.rnd.Intn(len(node.untried))
+			m := node.untried[j]
+			node.untried[j] = node.untried[len(node.untried)-1]
+			node.untried = node.untried[:len(node.untried)-1]
+			g.Play(m)
+			played = append(played, m)
+			child := newMCTSNode(g, m, node)
+			node.children = append(node.children, child)
+			node = child
+		}
+		// rollout
+		depth := len(played)
+		for !g.Over() {
+			moves := g.Moves()
+			if len(moves) == 0 {
+				break
+			}
+			m := moves[s.rnd.Intn(len(moves))]
+			g.Play(m)
+			played = append(played, m)
+		}
+		score := outcome(g)
+		for len(played) > depth {
+			g.Undo(played[len(played)-1])
+			played = played[:len(played)-1]
+			score = 1 - score
+		}
+		// backpropagation: score is for the player to move at node
+		for ; node != nil; node = node.parent {
+			node.visits++
+			node.score += 1 - score
+			score = 1 - score
+			if node.parent != nil {
+				g.Undo(node.move)
+			}
+		}
+	}
+
+	if len(root.children) == 0 {
+		return Estimate[M]{}
+	}
+	best := root.children[0]
+	for _, c := range root.children[1:] {
+		if c.visits > best.visits {
+			best = c
+		}
+	}
+	return Estimate[M]{Move: best.move, Score: best.score / float64(best.visits), Visits: best.visits}
+}
@@ -0,0 +1,95 @@
+package gametheory
+
+import (
+	"math"
+	"testing"
+)
+
+func TestMCTSTicTacToe(t *testing.T) {
+	tests := []struct {
+		board string
+		moves []int
+	}{
+		// X wins at once
+		{"XX. OO. ...", []int{2}},
+		// O must block the row
+		{"XX. .O. ...", []int{2}},
+		// against opposite corners O must answer on an edge
+		{"X.. .O. ..X", []int{1, 3, 5, 7}},
+	}
+	for _, test := range tests {
+		g, _ := ParseTicTacToe(test.board)
+		before := *g
+		e := NewMCTS[int](1).Search(g, 5000)
+		if !contains(test.moves, e.Move) {
+			t.Errorf("%q: move %d, want one of %v", test.board, e.Move, test.moves)
+		}
+		if *g != before {
+			t.Errorf("%q: the search should leave the board as it was", test.board)
+		}
+	}
+
+	// a won position has the best score
+	g, _ := ParseTicTacToe("XX. OO. ...")
+	if e := NewMCTS[int](1).Search(g, 2000); e.Score < 0.95 {
+		t.Errorf("winning move scored %f", e.Score)
+	}
+	// a finished game has no move
+	g, _ = ParseTicTacToe("XXX OO. ...")
+	if e := NewMCTS[int](1).Search(g, 100); e.Visits != 0 {
+		t.Errorf("a finished game should have no move, got %+v", e)
+	}
+}
+
+func TestMCTSSelfPlay(t *testing.T) {
+	// a search strong enough draws against perfect play
+	for _, mctsPlays := range []Player{X, O} {
+		s := NewMCTS[int](7)
+		g := NewTicTacToe()
+		for !g.Over() {
+			if g.ToMove == mctsPlays {
+				g.Play(s.Search(g, 3000).Move)
+			} else {
+				g.Play(AlphaBeta[int](g, 9).Move)
+			}
+		}
+		if w := g.Winner(); w != Empty {
+			t.Errorf("MCTS playing %d: the game was won by %d", mctsPlays, w)
+		}
+	}
+}
+
+func TestMCTSDeterministic(t *testing.T) {
+	g := NewConnectFour()
+	a := NewMCTS[int](42).Search(g, 500)
+	b := NewMCTS[int](42).Search(g, 500)
+	if a != b {
+		t.Errorf("searches with the same seed differ: %+v and %+v", a, b)
+	}
+	if math.IsNaN(a.Score) || a.Score < 0 || a.Score > 1 || a.Visits <= 0 || a.Visits > 500 {
+		t.Errorf("unexpected estimate %+v", a)
+	}
+}
+
+func TestMCTSConnectFour(t *testing.T) {
+	g, _ := ParseConnectFour(`
+		.......
+		.......
+		.......
+		.O.....
+		.O.....
+		XO...XX`)
+	if e := NewMCTS[int](3).Search(g, 3000); e.Move != 1 {
+		t.Errorf("move %d, want the block in column 1", e.Move)
+	}
+	g, _ = ParseConnectFour(`
+		.......
+		.......
+		.......
+		.......
+		O.O....
+		XXX.O..`)
+	if e := NewMCTS[int](3).Search(g, 3000); e.Move != 3 {
+		t.Errorf("move %d, want the win in column 3", e.Move)
+	}
+}
@@ -0,0 +1,115 @@
+// minimax.go
+// description: Minimax search with alpha-beta pruning
+// details:
+// In a two-player zero-sum game the value of a position for the player to
+// move is the maximum, over its moves, of the opposite of the value of the
+// resulting position for the opponent (the negamax form of minimax). The
+// search stops at terminal positions and at a depth limit, where the game
+// evaluates the position heuristically.
+// Alpha-beta pruning keeps a window [alpha, beta] of values that can still
+// change the result: once a move reaches beta, the opponent will avoid this
+// position anyway and the remaining moves are skipped. It returns the same
+// value as plain minimax while visiting as few as O(b^(d/2)) positions
+// instead of O(b^d) when good moves are tried first.
+// Wins found closer to the root score higher, so that the search prefers the
+// quickest win and the slowest loss.
+// time complexity: O(b^d) for b moves per position and depth d, O(b^(d/2))
+// at best with alpha-beta
+// space complexity: O(d)
+// reference: https://en.wikipedia.org/wiki/Negamax
+// reference: https://en.wikipedia.org/wiki/Alpha%E2%80%93beta_pruning
+// see minimax_test.go
+
+package gametheory
+
+// Win is the value of a won position. Heuristic evaluations must stay
+// strictly between -Win and Win, less the maximum search depth.
+const Win = 1 << 20
+
+// Game is a two-player zero-sum game with moves of type M, played and undone
+// in place by the search.
+type Game[M any] interface {
+	// Moves returns the legal moves of the player to move, best guesses
+	// first to make pruning more effective.
+	Moves() []M
+	// Play makes the move for the player to move, and Undo takes it back.
+	Play(m M)
+	Undo(m M)
+	// Over reports whether the game has ended.
+	Over() bool
+	// Evaluate returns the value of the position for the player to move:
+	// -Win when the game is lost, 0 for a draw, and a heuristic estimate
+	// when the game is not over.
+	Evaluate() int
+}
+
+// Result is the outcome of a search.
+type Result[M any] struct {
+	// Value of the position for the player to move.
+	Value int
+	// Move reaching it. It is the zero value when the game is over.
+	Move M
+	// Nodes is the number of positions visited.
+	Nodes int
+}
+
+// search is the negamax search of g up to depth moves, with the window
+// [alpha, beta] when prune is set.
+func search[M any](g Game[M], depth, ply, alpha, beta int, prune bool, nodes *int) (int, M) {
+	*nodes++
+	var best M
+	if g.Over() || depth == 0 {
+		return adjust(g.Evaluate(), ply), best
+	}
+	moves := g.Moves()
+	if len(moves) == 0 {
+		return adjust(g.Evaluate(), ply), best
+	}
+	value := -2 * Win
+	for _, m := range moves {
+		g.Play(m)
+		v, _ := search(g, depth-1, ply+1, -beta, -alpha, prune, nodes)
+		g.Undo(m)
+		v = -v
+		if v > value {
+			value, best = v, m
+		}
+		if prune {
+			if v > alpha {
+				alpha = v
+			}
+			if alpha >= beta {
+				break
+			}
+		}
+	}
+	return value, best
+}
+
+// adjust makes wins and losses found deeper in the search worth less.
+func adjust(value, ply int) int {
+	switch {
+	case value >= Win:
+		return value - ply
+	case value <= -Win:
+		return value + ply
+	}
+	return value
+}
+
+// Minimax searches g up to depth moves ahead, visiting every position, and
+// returns the value of the position and a best move. The game is left as it
+// was.
+func Minimax[M any](g Game[M], depth int) Result[M] {
+	var r Result[M]
+	r.Value, r.Move = search(g, depth, 0, -2*Win, 2*Win, false, &r.Nodes)
+	return r
+}
+
+// AlphaBeta searches g up to depth moves ahead with alpha-beta pruning. It
+// returns the same value as Minimax, and a move with that value.
+func AlphaBeta[M any](g Game[M], depth int) Result[M] {
+	var r Result[M]
+	r.Value, r.Move = search(g, depth, 0, -2*Win, 2*Win, true, &r.Nodes)
+	return r
+}
@@ -0,0 +1,109 @@
+package gametheory
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestTicTacToe(t *testing.T) {
+	g := NewTicTacToe()
+	full := Minimax[int](g, 9)
+	pruned := AlphaBeta[int](g, 9)
+	if full.Value != 0 || pruned.Value != 0 {
+		t.Errorf("tic-tac-toe should be a draw, got %d and %d", full.Value, pruned.Value)
+	}
+	if pruned.Nodes*10 > full.Nodes {
+		t.Errorf("alpha-beta visited %d positions, minimax %d", pruned.Nodes, full.Nodes)
+	}
+	if *g != *NewTicTacToe() {
+		t.Errorf("the search should leave the board as it was")
+	}
+
+	// perfect play against perfect play ends in a draw
+	for !g.Over() {
+		g.Play(AlphaBeta[int](g, 9).Move)
+	}
+	if w := g.Winner(); w != Empty {
+		t.Errorf("perfect play was won by %d", w)
+	}
+
+	tests := []struct {
+		board string
+		value int // sign of the value for the player to move
+		moves []int
+	}{
+		// X wins at once in the corner, not later
+		{"XX. OO. ...", 1, []int{2}},
+		// O must block the row, but X then makes two threats
+		{"XX. O.. ...", -1, []int{2}},
+		// against opposite corners O must answer on an edge
+		{"X.. .O. ..X", 0, []int{1, 3, 5, 7}},
+		// X has already won
+		{"XXX OO. ...", -1, nil},
+	}
+	for _, test := range tests {
+		g, ok := ParseTicTacToe(test.board)
+		if !ok {
+			t.Fatalf("cannot parse %q", test.board)
+		}
+		r := AlphaBeta[int](g, 9)
+		if sign(r.Value) != test.value {
+			t.Errorf("%q: value %d, want the sign %d", test.board, r.Value, test.value)
+		}
+		if test.moves != nil && !contains(test.moves, r.Move) {
+			t.Errorf("%q: move %d, want one of %v", test.board, r.Move, test.moves)
+		}
+	}
+	if _, ok := ParseTicTacToe("XXX XXX ..."); ok {
+		t.Errorf("a board with too many X should not parse")
+	}
+}
+
+func sign(x int) int {
+	switch {
+	case x > 0:
+		return 1
+	case x < 0:
+		return -1
+	}
+	return 0
+}
+
+func contains(values []int, x int) bool {
+	for _, v := range values {
+		if v == x {
+			return true
+		}
+	}
+	return false
+}
+
+func TestAlphaBetaMatchesMinimax(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		g := NewConnectFour()
+		for j := rnd.Intn(12); j > 0 && !g.Over(); j-- {
+			moves := g.Moves()
+			g.Play(moves[rnd.Intn(len(moves))])
+		}
+		full, pruned := Minimax[int](g, 4), AlphaBeta[int](g, 4)
+		if full.Value != pruned.Value {
+			t.Fatalf("minimax value %d, alpha-beta value %d", full.Value, pruned.Value)
+		}
+		if pruned.Nodes > full.Nodes {
+			t.Errorf("alpha-beta visited %d positions, minimax %d", pruned.Nodes, full.Nodes)
+		}
+		// the move found has the value found, one ply further for wins
+		// and losses
+		if !g.Over() {
+			g.Play(pruned.Move)
+			v := -Minimax[int](g, 3).Value
+			if v > Win/2 || v < -Win/2 {
+				v -= sign(v)
+			}
+			if v != pruned.Value {
+				t.Errorf("move %d is worth %d, want %d", pruned.Move, v, pruned.Value)
+			}
+		}
+	}
+}
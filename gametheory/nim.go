@@ -0,0 +1,148 @@
+// nim.go
+// description: Nim and its misère and subtraction variants
+// details:
+// In Nim players take turns removing any positive number of tokens from a
+// single heap, and whoever takes the last token wins. By Bouton's theorem the
+// player to move wins exactly when the XOR of the heap sizes, the nim-sum, is
+// not 0, and a winning move brings it back to 0: it reduces a heap h to
+// h XOR nim-sum, which is smaller for a heap having the highest bit of the
+// nim-sum set.
+// In misère Nim, whoever takes the last token loses. Play is the same until
+// a move would leave only heaps of size 1: the winner then leaves an odd
+// number of them instead of an even one.
+// In a subtraction game the number of tokens a move takes must belong to a
+// given set; each heap is then worth its Grundy number, and the heaps add up
+// by XOR like in Nim.
+// time complexity: O(n) for n heaps, plus O(h k) for the Grundy numbers of a
+// subtraction game with heaps of at most h tokens and k moves
+// space complexity: O(1), O(h) for a subtraction game
+// reference: https://en.wikipedia.org/wiki/Nim
+// see nim_test.go
+
+package gametheory
+
+// Move takes Take tokens from the heap of index Heap.
+type Move struct {
+	Heap, Take int
+}
+
+// NimWins reports whether the player to move wins Nim with these heaps.
+func NimWins(heaps []int) bool {
+	return Sum(heaps...) != 0
+}
+
+// NimMove returns a winning move of Nim, or false when the position is lost
+// for the player to move.
+func NimMove(heaps []int) (Move, bool) {
+	x := Sum(heaps...)
+	if x == 0 {
+		return Move{}, false
+	}
+	for i, h := range heaps {
+		if target := h ^ x; target < h {
+			return Move{Heap: i, Take: h - target}, true
+		}
+	}
+	return Move{}, false
+}
+
+// MisereNimWins reports whether the player to move wins misère Nim, where
+// taking the last token loses, with these heaps.
+func MisereNimWins(heaps []int) bool {
+	big, ones := 0, 0
+	for _, h := range heaps {
+		switch {
+		case h > 1:
+			big++
+		case h == 1:
+			ones++
+		}
+	}
+	if big == 0 {
+		// only heaps of one token: the player to move wins with an even
+		// number of them, leaving an odd number to the opponent
+		return ones%2 == 0
+	}
+	return Sum(heaps...) != 0
+}
+
+// MisereNimMove returns a winning move of misère Nim, or false when the
+// position is lost for the player to move or, having been won already, has
+// no token left.
+func MisereNimMove(heaps []int) (Move, bool) {
+	if !MisereNimWins(heaps) {
+		return Move{}, false
+	}
+	big, largest, ones := 0, -1, 0
+	for i, h := range heaps {
+		if h > 1 {
+			big++
+			largest = i
+		} else if h == 1 {
+			ones++
+		}
+	}
+	switch {
+	case big == 0:
+		// an even, non-zero number of single tokens: take one
+		for i, h := range heaps {
+			if h == 1 {
+				return Move{Heap: i, Take: 1}, true
+			}
+		}
+	case big == 1:
+		// leave an odd number of single tokens
+		h := heaps[largest]
+		if ones%2 == 0 {
+			return Move{Heap: largest, Take: h - 1}, true
+		}
+		return Move{Heap: largest, Take: h}, true
+	}
+	return NimMove(heaps)
+}
+
+// SubtractionWins reports whether the player to move wins the subtraction
+// game with these heaps, where a move takes from one heap a number of tokens
+// belonging to subtract.
+func SubtractionWins(heaps, subtract []int) bool {
+	grundy := SubtractionGame(largest(heaps), subtract)
+	x := 0
+	for _, h := range heaps {
+		x ^= grundy[h]
+	}
+	return x != 0
+}
+
+// SubtractionMove returns a winning move of the subtraction game, or false
+// when the position is lost for the player to move.
+func SubtractionMove(heaps, subtract []int) (Move, bool) {
+	grundy := SubtractionGame(largest(heaps), subtract)
+	x := 0
+	for _, h := range heaps {
+		x ^= grundy[h]
+	}
+	if x == 0 {
+		return Move{}, false
+	}
+	// a move from h must reach a position worth grundy[h] XOR x
+	for i, h := range heaps {
+		target := grundy[h] ^ x
+		for _, s := range subtract {
+			if s > 0 && s <= h && grundy[h-s] == target {
+				return Move{Heap: i, Take: s}, true
+			}
+		}
+	}
+	return Move{}, false
+}
+
+// largest returns the largest heap size, or 0 when there are none.
+func largest(heaps []int) int {
+	m := 0
+	for _, h := range heaps {
+		if h > m {
+			m = h
+		}
+	}
+	return m
+}
@@ -0,0 +1,105 @@
+package gametheory
+
+import "testing"
+
+// bruteWins solves a game on heaps by exhaustive search: a move takes a
+// number of tokens allowed by canTake from one heap, and misere reverses the
+// outcome of taking the last token.
+func bruteWins(heaps []int, canTake func(int) bool, misere bool, memo map[[3]int]bool) bool {
+	key := [3]int{}
+	copy(key[:], heaps)
+	if w, ok := memo[key]; ok {
+		return w
+	}
+	moved, wins := false, false
+	for i, h := range heaps {
+		for take := 1; take <= h && !wins; take++ {
+			if !canTake(take) {
+				continue
+			}
+			moved = true
+			heaps[i] -= take
+			wins = !bruteWins(heaps, canTake, misere, memo)
+			heaps[i] += take
+		}
+	}
+	if !moved {
+		// no move left: the previous player took the last token
+		wins = misere
+	}
+	memo[key] = wins
+	return wins
+}
+
+// forEachPosition calls f with every triple of heaps of at most limit tokens.
+func forEachPosition(limit int, f func(heaps []int)) {
+	for a := 0; a <= limit; a++ {
+		for b := 0; b <= limit; b++ {
+			for c := 0; c <= limit; c++ {
+				f([]int{a, b, c})
+			}
+		}
+	}
+}
+
+// checkMove checks that move is legal and leaves a lost position.
+func checkMove(t *testing.T, name string, heaps []int, move Move, ok bool, canTake func(int) bool, lost func([]int) bool) {
+	t.Helper()
+	if !ok {
+		t.Fatalf("%s(%v) found no winning move", name, heaps)
+	}
+	if move.Heap < 0 || move.Heap >= len(heaps) || move.Take < 1 || move.Take > heaps[move.Heap] || !canTake(move.Take) {
+		t.Fatalf("%s(%v) = %+v is not a legal move", name, heaps, move)
+	}
+	after := append([]int(nil), heaps...)
+	after[move.Heap] -= move.Take
+	if !lost(after) {
+		t.Fatalf("%s(%v) = %+v leaves a won position %v", name, heaps, move, after)
+	}
+}
+
+func TestNim(t *testing.T) {
+	anyTake := func(int) bool { return true }
+	normal, misere := map[[3]int]bool{}, map[[3]int]bool{}
+	forEachPosition(7, func(heaps []int) {
+		if got, want := NimWins(heaps), bruteWins(heaps, anyTake, false, normal); got != want {
+			t.Fatalf("NimWins(%v) = %v, want %v", heaps, got, want)
+		}
+		if got, want := MisereNimWins(heaps), bruteWins(heaps, anyTake, true, misere); got != want {
+			t.Fatalf("MisereNimWins(%v) = %v, want %v", heaps, got, want)
+		}
+		if NimWins(heaps) {
+			move, ok := NimMove(heaps)
+			checkMove(t, "NimMove", heaps, move, ok, anyTake, func(h []int) bool { return !NimWins(h) })
+		} else if _, ok := NimMove(heaps); ok {
+			t.Fatalf("NimMove(%v) found a move in a lost position", heaps)
+		}
+		// with no token left the player to move has already won
+		if MisereNimWins(heaps) && largest(heaps) > 0 {
+			move, ok := MisereNimMove(heaps)
+			checkMove(t, "MisereNimMove", heaps, move, ok, anyTake, func(h []int) bool { return !MisereNimWins(h) })
+		} else if _, ok := MisereNimMove(heaps); ok {
+			t.Fatalf("MisereNimMove(%v) found a move in a lost position", heaps)
+		}
+	})
+}
+
+func TestSubtraction(t *testing.T) {
+	subtract := []int{1, 3, 4}
+	canTake := func(n int) bool { return n == 1 || n == 3 || n == 4 }
+	memo := map[[3]int]bool{}
+	forEachPosition(9, func(heaps []int) {
+		wins := SubtractionWins(heaps, subtract)
+		if want := bruteWins(heaps, canTake, false, memo); wins != want {
+			t.Fatalf("SubtractionWins(%v) = %v, want %v", heaps, wins, want)
+		}
+		move, ok := SubtractionMove(heaps, subtract)
+		if !wins {
+			if ok {
+				t.Fatalf("SubtractionMove(%v) found a move in a lost position", heaps)
+			}
+			return
+		}
+		checkMove(t, "SubtractionMove", heaps, move, ok, canTake, func(h []int) bool { return !SubtractionWins(h, subtract) })
+	})
+}
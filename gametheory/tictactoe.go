@@ -0,0 +1,141 @@
+// tictactoe.go
+// description: Tic-tac-toe as a Game for the minimax search
+// details:
+// The board is stored as nine cells, numbered row by row from 0 to 8, and a
+// move is the number of the cell to mark. The game is small enough for the
+// search to reach every end of the game: with perfect play it is a draw.
+// Moves are ordered centre first, then corners, then edges, which are the
+// strongest cells, so that alpha-beta prunes early.
+// see tictactoe_test.go
+
+package gametheory
+
+import "strings"
+
+// Player is the content of a cell: Empty or the mark of a player.
+type Player int8
+
+// The contents of a cell. X and O also name the players.
+const (
+	Empty Player = iota
+	X
+	O
+)
+
+// opponent returns the other player.
+func (p Player) opponent() Player {
+	return 3 - p
+}
+
+// ticTacToeLines are the rows, columns and diagonals.
+var ticTacToeLines = [8][3]int{
+	{0, 1, 2}, {3, 4, 5}, {6, 7, 8},
+	{0, 3, 6}, {1, 4, 7}, {2, 5, 8},
+	{0, 4, 8}, {2, 4, 6},
+}
+
+// ticTacToeOrder lists the cells from the strongest to the weakest.
+var ticTacToeOrder = [9]int{4, 0, 2, 6, 8, 1, 3, 5, 7}
+
+// TicTacToe is a tic-tac-toe position. X moves first.
+type TicTacToe struct {
+	Cells  [9]Player
+	ToMove Player
+}
+
+var _ Game[int] = &TicTacToe{}
+
+// NewTicTacToe returns the empty board with X to move.
+func NewTicTacToe() *TicTacToe {
+	return &TicTacToe{ToMove: X}
+}
+
+// ParseTicTacToe reads a board written as nine characters, row by row, "X",
+// "O" and "." for an empty cell; spaces and newlines are ignored. The player
+// to move is deduced from the number of marks. It returns false when the
+// board is malformed.
+func ParseTicTacToe(s string) (*TicTacToe, bool) {
+	s = strings.NewReplacer(" ", "", "\n", "").Replace(s)
+	if len(s) != 9 {
+		return nil, false
+	}
+	t := &TicTacToe{}
+	xs, oh := 0, 0
+	for i, c := range s {
+		switch c {
+		case 'X':
+			t.Cells[i] = X
+			xs++
+		case 'O':
+			t.Cells[i] = O
+			oh++
+		case '.':
+		default:
+			return nil, false
+		}
+	}
+	switch xs - oh {
+	case 0:
+		t.ToMove = X
+	case 1:
+		t.ToMove = O
+	default:
+		return nil, false
+	}
+	return t, true
+}
+
+// Winner returns the player owning a full line, or Empty.
+func (t *TicTacToe) Winner() Player {
+	for _, line := range ticTacToeLines {
+		if p := t.Cells[line[0]]; p != Empty && p == t.Cells[line[1]] && p == t.Cells[line[2]] {
+			return p
+		}
+	}
+	return Empty
+}
+
+// Moves returns the empty cells, strongest first.
+func (t *TicTacToe) Moves() []int {
+	var moves []int
+	for _, c := range ticTacToeOrder {
+		if t.Cells[c] == Empty {
+			moves = append(moves, c)
+		}
+	}
+	return moves
+}
+
+// Play marks the cell for the player to move.
+func (t *TicTacToe) Play(cell int) {
+	t.Cells[cell] = t.ToMove
+	t.ToMove = t.ToMove.opponent()
+}
+
+// Undo empties the cell marked by the last move.
+func (t *TicTacToe) Undo(cell int) {
+	t.Cells[cell] = Empty
+	t.ToMove = t.ToMove.opponent()
+}
+
+// Over reports whether a player has won or the board is full.
+func (t *TicTacToe) Over() bool {
+	if t.Winner() != Empty {
+		return true
+	}
+	for _, p := range t.Cells {
+		if p == Empty {
+			return false
+		}
+	}
+	return true
+}
+
+// Evaluate returns -Win when the previous player has won, and 0 otherwise:
+// the search always reaches the end of the game.
+func (t *TicTacToe) Evaluate() int {
+	if t.Winner() == t.ToMove.opponent() {
+		return -Win
+	}
+	return 0
+}
@@ -0,0 +1,67 @@
+package gametheory
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTicTacToeRules(t *testing.T) {
+	tests := []struct {
+		board  string
+		toMove Player
+		winner Player
+		over   bool
+		moves  []int
+	}{
+		{"... ... ...", X, Empty, false, []int{4, 0, 2, 6, 8, 1, 3, 5, 7}},
+		{"X.. .O. ...", X, Empty, false, []int{2, 6, 8, 1, 3, 5, 7}},
+		{"XXX OO. ...", O, X, true, []int{6, 8, 5, 7}},
+		{"X.O XO. O.X", X, O, true, []int{1, 5, 7}},
+		{"XOX XOO OXX", O, Empty, true, nil},
+	}
+	for _, test := range tests {
+		g, ok := ParseTicTacToe(test.board)
+		if !ok {
+			t.Fatalf("cannot parse %q", test.board)
+		}
+		if g.ToMove != test.toMove {
+			t.Errorf("%q: %d to move, want %d", test.board, g.ToMove, test.toMove)
+		}
+		if w := g.Winner(); w != test.winner {
+			t.Errorf("%q: winner %d, want %d", test.board, w, test.winner)
+		}
+		if g.Over() != test.over {
+			t.Errorf("%q: Over() = %v, want %v", test.board, g.Over(), test.over)
+		}
+		if moves := g.Moves(); !reflect.DeepEqual(moves, test.moves) {
+			t.Errorf("%q: moves %v, want %v", test.board, moves, test.moves)
+		}
+		want := 0
+		if test.winner != Empty {
+			want = -Win
+		}
+		if v := g.Evaluate(); v != want {
+			t.Errorf("%q: Evaluate() = %d, want %d", test.board, v, want)
+		}
+	}
+
+	for _, board := range []string{"", "XX. OO.", "XO? ... ...", "OO. ... ...", "XXX XXX ..."} {
+		if _, ok := ParseTicTacToe(board); ok {
+			t.Errorf("%q should not parse", board)
+		}
+	}
+}
+
+func TestTicTacToePlayUndo(t *testing.T) {
+	g := NewTicTacToe()
+	g.Play(4)
+	g.Play(0)
+	if g.Cells[4] != X || g.Cells[0] != O || g.ToMove != X {
+		t.Fatalf("Play marked %v with %d to move", g.Cells, g.ToMove)
+	}
+	g.Undo(0)
+	g.Undo(4)
+	if *g != *NewTicTacToe() {
+		t.Errorf("undoing every move should give the empty board, got %v", *g)
+	}
+}
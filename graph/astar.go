@@ -0,0 +1,212 @@
+// astar.go
+// description: A* search, weighted A* and iterative-deepening A*
+// details:
+// A* searches a shortest path from a start state to a goal guided by a
+// heuristic h, an estimate of the cost left from a state to the goal. It
+// expands the states in increasing order of f = g + h, g being the cost of
+// the best path found to the state. When h never overestimates, it is
+// admissible, and the first path to reach the goal is a shortest one; with
+// h = 0, A* is Dijkstra's algorithm. The better the estimate, the fewer
+// states are expanded.
+// - weighted A* orders the states by g + epsilon h for some epsilon >= 1.
+// Trusting the heuristic more makes the search greedier: it expands fewer
+// states, and the path it returns costs at most epsilon times the optimum.
+// - iterative-deepening A* (IDA*) runs depth-first searches cut at states
+// whose f exceeds a bound, starting with h(start) and raising the bound to
+// the smallest f cut at each pass. It only stores the current path, at the
+// price of expanding states again in every pass and along every path.
+// The searches work on any implicit graph given by its successors, such as a
+// Graph or the cells of a Grid, and report how many states they expanded.
+// Move costs must not be negative.
+// time complexity: O(E log E) for A* on a graph of E edges; IDA* may expand
+// exponentially many paths
+// space complexity: O(V) for A*, O(d) for IDA* with paths of d moves
+// reference: https://en.wikipedia.org/wiki/A*_search_algorithm
+// reference: https://en.wikipedia.org/wiki/Iterative_deepening_A*
+// see astar_test.go
+
+package graph
+
+import (
+	"errors"
+	"math"
+
+	"github.com/TheAlgorithms/Go/structure/heap"
+)
+
+// Heuristic estimates the cost of the cheapest path from a state to the goal
+// of a search. A nil Heuristic estimates 0 everywhere.
+type Heuristic[S any] func(s S) float64
+
+// Successors calls visit for every move from a state, with the state it
+// leads to and its cost.
+type Successors[S any] func(s S, visit func(next S, cost float64))
+
+// SearchStats is the work done by a search.
+type SearchStats struct {
+	// Expanded is the number of times a state had its successors generated.
+	Expanded int
+	// Generated is the number of successors generated.
+	Generated int
+	// Iterations is the number of depth-first passes of IDA*, 1 otherwise.
+	Iterations int
+}
+
+// SearchResult is the outcome of a search.
+type SearchResult[S any] struct {
+	// Path holds the states from the start to the goal, nil if none is found.
+	Path []S
+	// Cost is the cost of the path.
+	Cost float64
+	// Found reports whether the goal was reached.
+	Found bool
+	// Stats is the work done by the search.
+	Stats SearchStats
+}
+
+// expander calls visit for every move from a state reached from parent, root
+// being true for the start, which has no parent.
+type expander[S any] func(s, parent S, root bool, visit func(next S, cost float64))
+
+// bestFirst runs weighted A* from start, with the successors of a state
+// given by expand. A state is expanded again when a cheaper path to it is
+// found, which only happens when epsilon h is not consistent.
+func bestFirst[S comparable](start, goal S, expand expander[S], h Heuristic[S], epsilon float64) SearchResult[S] {
+	type entry struct {
+		state S
+		g, f  float64
+	}
+	if h == nil {
+		h = func(S) float64 { return 0 }
+	}
+	result := SearchResult[S]{Stats: SearchStats{Iterations: 1}}
+	// on equal f, the deeper state is closer to the goal
+	open, _ := heap.NewAny(func(a, b entry) bool { return a.f < b.f || a.f == b.f && a.g > b.g })
+	best := map[S]float64{start: 0}
+	parent := map[S]S{}
+	open.Push(entry{start, 0, epsilon * h(start)})
+	for !open.Empty() {
+		e := open.Top()
+		open.Pop()
+		// stale entries are skipped instead of being updated
+		if e.g > best[e.state] {
+			continue
+		}
+		if e.state == goal {
+			result.Found, result.Cost = true, e.g
+			break
+		}
+		result.Stats.Expanded++
+		p, root := parent[e.state], e.state == start
+		expand(e.state, p, root, func(next S, cost float64) {
+			result.Stats.Generated++
+			g := e.g + cost
+			if old, seen := best[next]; seen && old <= g {
+				return
+			}
+			best[next] = g
+			parent[next] = e.state
+			open.Push(entry{next, g, g + epsilon*h(next)})
+		})
+	}
+	if result.Found {
+		result.Path = []S{goal}
+		for s := goal; s != start; {
+			s = parent[s]
+			result.Path = append(result.Path, s)
+		}
+		for i, j := 0, len(result.Path)-1; i < j; i, j = i+1, j-1 {
+			result.Path[i], result.Path[j] = result.Path[j], result.Path[i]
+		}
+	}
+	return result
+}
+
+// AStar returns a cheapest path from start to goal with the moves given by
+// next, which is a shortest one when h is admissible.
+func AStar[S comparable](start, goal S, next Successors[S], h Heuristic[S]) SearchResult[S] {
+	return bestFirst(start, goal, func(s, _ S, _ bool, visit func(S, float64)) { next(s, visit) }, h, 1)
+}
+
+// WeightedAStar returns a path from start to goal with the moves given by
+// next, ordering the states by g + epsilon h. The path costs at most epsilon
+// times the optimum when h is admissible. It returns an error if epsilon is
+// below 1.
+func WeightedAStar[S comparable](start, goal S, next Successors[S], h Heuristic[S], epsilon float64) (SearchResult[S], error) {
+	if !(epsilon >= 1) {
+		return SearchResult[S]{}, errors.New("weighted A* requires epsilon >= 1")
+	}
+	return bestFirst(start, goal, func(s, _ S, _ bool, visit func(S, float64)) { next(s, visit) }, h, epsilon), nil
+}
+
+// IDAStar returns a cheapest path from start to goal with the moves given by
+// next, which is a shortest one when h is admissible, by iterative-deepening
+// A*. Paths never visit a state twice.
+func IDAStar[S comparable](start, goal S, next Successors[S], h Heuristic[S]) SearchResult[S] {
+	if h == nil {
+		h = func(S) float64 { return 0 }
+	}
+	var result SearchResult[S]
+	path := []S{start}
+	onPath := map[S]bool{start: true}
+	// search returns whether the goal was found below the last state of path,
+	// reached at cost g, and the smallest f above bound otherwise
+	var search func(g, bound float64) (bool, float64)
+	search = func(g, bound float64) (bool, float64) {
+		s := path[len(path)-1]
+		if f := g + h(s); f > bound {
+			return false, f
+		}
+		if s == goal {
+			result.Cost = g
+			return true, g
+		}
+		result.Stats.Expanded++
+		type move struct {
+			state S
+			cost  float64
+		}
+		var moves []move
+		next(s, func(n S, cost float64) {
+			result.Stats.Generated++
+			if !onPath[n] {
+				moves = append(moves, move{n, cost})
+			}
+		})
+		least := math.Inf(1)
+		for _, m := range moves {
+			path = append(path, m.state)
+			onPath[m.state] = true
+			found, f := search(g+m.cost, bound)
+			if found {
+				return true, f
+			}
+			path = path[:len(path)-1]
+			delete(onPath, m.state)
+			if f < least {
+				least = f
+			}
+		}
+		return false, least
+	}
+	for bound := h(start); !math.IsInf(bound, 1); {
+		result.Stats.Iterations++
+		found, f := search(0, bound)
+		if found {
+			result.Found, result.Path = true, path
+			break
+		}
+		bound = f
+	}
+	return result
+}
+
+// Successors returns the moves of the graph: to every neighbour of a vertex,
+// in increasing order, at the weight of the edge.
+func (g *Graph) Successors() Successors[int] {
+	return func(v int, visit func(int, float64)) {
+		for _, n := range g.neighbours(v) {
+			visit(n, float64(g.edges[v][n]))
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package graph
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// randomWeightedGraph returns a directed graph of n vertices with the given
+// number of random edges of weight 0 to 9.
+func randomWeightedGraph(rnd *rand.Rand, n, edges int) *Graph {
+	g := New(n)
+	g.Directed = true
+	for i := 0; i < edges; i++ {
+		g.AddWeightedEdge(rnd.Intn(n), rnd.Intn(n), rnd.Intn(10))
+	}
+	return g
+}
+
+// checkPath fails the test if path is not a walk from start to goal of the
+// given cost with the moves of next.
+func checkPath[S comparable](t *testing.T, path []S, start, goal S, next Successors[S], cost float64) {
+	t.Helper()
+	if len(path) == 0 || path[0] != start || path[len(path)-1] != goal {
+		t.Fatalf("path %v does not go from %v to %v", path, start, goal)
+	}
+	total := 0.0
+	for i := 1; i < len(path); i++ {
+		step := math.Inf(1)
+		next(path[i-1], func(n S, c float64) {
+			if n == path[i] && c < step {
+				step = c
+			}
+		})
+		if math.IsInf(step, 1) {
+			t.Fatalf("path %v has no move from %v to %v", path, path[i-1], path[i])
+		}
+		total += step
+	}
+	if math.Abs(total-cost) > 1e-9 {
+		t.Fatalf("path %v costs %v, reported %v", path, total, cost)
+	}
+}
+
+func TestAStarRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		n := 2 + rnd.Intn(10)
+		g := randomWeightedGraph(rnd, n, rnd.Intn(4*n))
+		start, goal := rnd.Intn(n), rnd.Intn(n)
+		want, reachable := g.Dijkstra(start, goal)
+		next := g.Successors()
+
+		for name, result := range map[string]SearchResult[int]{
+			"AStar":   AStar(start, goal, next, nil),
+			"IDAStar": IDAStar(start, goal, next, nil),
+		} {
+			if result.Found != reachable {
+				t.Fatalf("%s found = %v, want %v", name, result.Found, reachable)
+			}
+			if !reachable {
+				continue
+			}
+			if result.Cost != float64(want) {
+				t.Fatalf("%s cost = %v, want %d", name, result.Cost, want)
+			}
+			checkPath(t, result.Path, start, goal, next, result.Cost)
+		}
+
+		weighted, err := WeightedAStar(start, goal, next, nil, 3)
+		if err != nil || weighted.Found != reachable {
+			t.Fatalf("WeightedAStar found = %v, %v, want %v", weighted.Found, err, reachable)
+		}
+		if reachable {
+			checkPath(t, weighted.Path, start, goal, next, weighted.Cost)
+		}
+	}
+}
+
+func TestWeightedAStar(t *testing.T) {
+	cells := parseGrid(`
+		..........
+		.#######..
+		.......#..
+		######.#..
+		.......#..`)
+	g, _ := NewGrid(cells, EightConnected)
+	from, to := Cell{4, 0}, Cell{0, 9}
+	h := OctileDistance(to)
+	if _, err := WeightedAStar(from, to, g.Moves(isOpen), h, 0.5); err == nil {
+		t.Errorf("WeightedAStar with epsilon 0.5 should fail")
+	}
+	optimal := AStar(from, to, g.Moves(isOpen), h)
+	if !optimal.Found {
+		t.Fatalf("AStar found no path")
+	}
+	for _, epsilon := range []float64{1, 1.5, 2, 5} {
+		result, _ := WeightedAStar(from, to, g.Moves(isOpen), h, epsilon)
+		if !result.Found || result.Cost > epsilon*optimal.Cost+1e-9 {
+			t.Errorf("epsilon %v: cost %v, more than %v times %v", epsilon, result.Cost, epsilon, optimal.Cost)
+		}
+		checkPath(t, result.Path, from, to, g.Moves(isOpen), result.Cost)
+	}
+}
+
+func TestHeuristicSavesExpansions(t *testing.T) {
+	cells := make([][]byte, 30)
+	for r := range cells {
+		cells[r] = make([]byte, 30)
+		for c := range cells[r] {
+			cells[r][c] = '.'
+		}
+	}
+	g, _ := NewGrid(cells, FourConnected)
+	from, to := Cell{0, 0}, Cell{29, 29}
+	blind := AStar(from, to, g.Moves(isOpen), nil)
+	informed := AStar(from, to, g.Moves(isOpen), ManhattanDistance(to))
+	if blind.Cost != 58 || informed.Cost != 58 {
+		t.Fatalf("costs = %v, %v, want 58", blind.Cost, informed.Cost)
+	}
+	if informed.Stats.Expanded >= blind.Stats.Expanded {
+		t.Errorf("the heuristic expanded %d cells, without it %d", informed.Stats.Expanded, blind.Stats.Expanded)
+	}
+}
+
+func TestIDAStar(t *testing.T) {
+	cells := parseGrid(`
+		...#....
+		.#.#.##.
+		.#...#..
+		.####.#.
+		........`)
+	for _, connectivity := range []Connectivity{FourConnected, EightConnected} {
+		g, _ := NewGrid(cells, connectivity)
+		from, to := Cell{0, 0}, Cell{0, 7}
+		h := ManhattanDistance(to)
+		if connectivity == EightConnected {
+			h = OctileDistance(to)
+		}
+		want := AStar(from, to, g.Moves(isOpen), h)
+		got := IDAStar(from, to, g.Moves(isOpen), h)
+		if !got.Found || math.Abs(got.Cost-want.Cost) > 1e-9 {
+			t.Fatalf("%d-connected IDAStar cost = %v, %v, want %v", connectivity, got.Cost, got.Found, want.Cost)
+		}
+		checkPath(t, got.Path, from, to, g.Moves(isOpen), got.Cost)
+		if got.Stats.Iterations < 1 || want.Stats.Iterations != 1 {
+			t.Errorf("iterations = %d and %d", got.Stats.Iterations, want.Stats.Iterations)
+		}
+	}
+	g, _ := NewGrid(cells, FourConnected)
+	if result := IDAStar(Cell{0, 0}, Cell{0, 3}, g.Moves(isOpen), nil); result.Found {
+		t.Errorf("IDAStar reached a blocked cell")
+	}
+	if result := IDAStar(Cell{4, 4}, Cell{4, 4}, g.Moves(isOpen), nil); !result.Found || len(result.Path) != 1 {
+		t.Errorf("IDAStar to the start = %v", result)
+	}
+}
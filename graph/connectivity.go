@@ -0,0 +1,188 @@
+// connectivity.go
+// description: Cycles, two-coloring, connected components, bridges and articulation points
+// details:
+// Each function here is a depth-first search of traversal.go with a few
+// Visitor callbacks:
+// - a cycle is closed by the first back edge; the tree path from the head of
+// that edge down to its tail completes it,
+// - a graph is bipartite when every back edge joins vertices of different
+// sides, each tree edge putting its endpoints on opposite sides,
+// - the connected components are the trees of the depth-first forest,
+// - the low link of a vertex is the earliest discovered vertex reachable
+// from its subtree through at most one back edge. A tree edge (u, v) is a
+// bridge when low(v) > disc(u), and u is an articulation point when
+// low(v) >= disc(u) for some child v, unless u is a root with one child.
+// Bipartiteness, components, bridges and articulation points ignore the
+// direction of the edges of a directed graph.
+// time complexity: O(V + E log E)
+// space complexity: O(V)
+// reference: https://en.wikipedia.org/wiki/Bipartite_graph
+// reference: https://en.wikipedia.org/wiki/Bridge_(graph_theory)#Tarjan's_bridge-finding_algorithm
+// see connectivity_test.go
+
+package graph
+
+import "sort"
+
+// undirected returns g itself if it is undirected, and otherwise a copy of
+// g in which every edge can be followed both ways.
+func (g *Graph) undirected() *Graph {
+	if !g.Directed {
+		return g
+	}
+	u := g.empty()
+	u.Directed = false
+	for v, neighbours := range g.edges {
+		u.AddVertex(v)
+		for w, weight := range neighbours {
+			u.AddWeightedEdge(v, w, weight)
+		}
+	}
+	return u
+}
+
+// FindCycle returns the vertices of a cycle of g in the order of its edges,
+// the last one leading back to the first, and reports whether there is one.
+// It follows the direction of the edges of a directed graph. In an
+// undirected graph an edge cannot be used twice in a row, so a cycle has at
+// least three vertices, or one for a self-loop.
+func (g *Graph) FindCycle() ([]int, bool) {
+	parent := map[int]int{}
+	var cycle []int
+	g.DFSForest(Visitor{
+		TreeEdge: func(u, v int) { parent[v] = u },
+		BackEdge: func(u, v int) {
+			if cycle != nil {
+				return
+			}
+			cycle = []int{u}
+			for w := u; w != v; {
+				w = parent[w]
+				cycle = append(cycle, w)
+			}
+			for i, j := 0, len(cycle)-1; i < j; i, j = i+1, j-1 {
+				cycle[i], cycle[j] = cycle[j], cycle[i]
+			}
+		},
+	})
+	return cycle, cycle != nil
+}
+
+// Bipartite reports whether the vertices of g can be split into two sides
+// such that every edge joins both sides, and returns the side, 0 or 1, of
+// every vertex when it can. The smallest vertex of every component is on
+// side 0.
+func (g *Graph) Bipartite() (map[int]int, bool) {
+	side := map[int]int{}
+	ok := true
+	g.undirected().DFSForest(Visitor{
+		Discover: func(v int) {
+			if _, colored := side[v]; !colored {
+				side[v] = 0
+			}
+		},
+		TreeEdge: func(u, v int) { side[v] = 1 - side[u] },
+		BackEdge: func(u, v int) {
+			if side[u] == side[v] {
+				ok = false
+			}
+		},
+	})
+	if !ok {
+		return nil, false
+	}
+	return side, true
+}
+
+// ConnectedComponents returns the connected components of g, each in
+// increasing order, ordered by their smallest vertex.
+func (g *Graph) ConnectedComponents() [][]int {
+	var components [][]int
+	depth := 0
+	g.undirected().DFSForest(Visitor{
+		Discover: func(v int) {
+			if depth == 0 {
+				components = append(components, nil)
+			}
+			depth++
+			components[len(components)-1] = append(components[len(components)-1], v)
+		},
+		Finish: func(int) { depth-- },
+	})
+	for _, c := range components {
+		sort.Ints(c)
+	}
+	return components
+}
+
+// Bridges returns the edges of g whose removal disconnects their endpoints,
+// ordered as in an undirected edge list: from the smaller endpoint, by
+// start then end vertex.
+func (g *Graph) Bridges() []Edge {
+	bridges, _ := g.lowLinks()
+	sort.Slice(bridges, func(i, j int) bool {
+		if bridges[i].Start != bridges[j].Start {
+			return bridges[i].Start < bridges[j].Start
+		}
+		return bridges[i].End < bridges[j].End
+	})
+	return bridges
+}
+
+// ArticulationPoints returns, in increasing order, the vertices of g whose
+// removal increases the number of connected components. Unlike
+// ArticulationPoint, every component of the graph is searched.
+func (g *Graph) ArticulationPoints() []int {
+	_, points := g.lowLinks()
+	sort.Ints(points)
+	return points
+}
+
+// lowLinks finds the bridges and the articulation points of g.
+func (g *Graph) lowLinks() ([]Edge, []int) {
+	u := g.undirected()
+	disc, low, parent, children := map[int]int{}, map[int]int{}, map[int]int{}, map[int]int{}
+	isPoint := map[int]bool{}
+	var bridges []Edge
+	var points []int
+	time := 0
+	u.DFSForest(Visitor{
+		Discover: func(v int) {
+			disc[v], low[v] = time, time
+			time++
+		},
+		TreeEdge: func(p, v int) {
+			parent[v] = p
+			children[p]++
+		},
+		BackEdge: func(v, w int) {
+			if disc[w] < low[v] {
+				low[v] = disc[w]
+			}
+		},
+		Finish: func(v int) {
+			p, ok := parent[v]
+			if !ok {
+				if children[v] > 1 {
+					points = append(points, v)
+				}
+				return
+			}
+			if low[v] < low[p] {
+				low[p] = low[v]
+			}
+			if low[v] > disc[p] {
+				start, end := p, v
+				if end < start {
+					start, end = end, start
+				}
+				bridges = append(bridges, Edge{Start: Vertex(start), End: Vertex(end), Weight: u.edges[p][v]})
+			}
+			if _, hasParent := parent[p]; hasParent && low[v] >= disc[p] && !isPoint[p] {
+				isPoint[p] = true
+				points = append(points, p)
+			}
+		},
+	})
+	return bridges, points
+}
@@ -0,0 +1,121 @@
+package graph
+
+import (
+	"reflect"
+	"testing"
+)
+
+// unweightedGraph returns a graph with n vertices and the given edges.
+func unweightedGraph(directed bool, n int, edges [][2]int) *Graph {
+	g := New(n)
+	g.Directed = directed
+	for _, e := range edges {
+		g.AddEdge(e[0], e[1])
+	}
+	return g
+}
+
+func TestFindCycle(t *testing.T) {
+	tests := []struct {
+		name     string
+		directed bool
+		edges    [][2]int
+		cycle    bool
+	}{
+		{"directed triangle", true, [][2]int{{0, 1}, {1, 2}, {2, 0}, {4, 0}}, true},
+		{"directed acyclic", true, [][2]int{{0, 1}, {1, 2}, {0, 2}, {4, 0}}, false},
+		{"directed two-cycle", true, [][2]int{{0, 1}, {1, 0}}, true},
+		{"undirected tree", false, [][2]int{{0, 1}, {1, 2}, {1, 3}}, false},
+		{"undirected square", false, [][2]int{{5, 0}, {0, 1}, {1, 2}, {2, 3}, {3, 0}}, true},
+		{"self-loop", false, [][2]int{{0, 1}, {1, 1}}, true},
+	}
+	for _, test := range tests {
+		g := unweightedGraph(test.directed, 0, test.edges)
+		cycle, ok := g.FindCycle()
+		if ok != test.cycle {
+			t.Errorf("%s: found a cycle %v, want %v", test.name, ok, test.cycle)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		if !test.directed && len(cycle) == 2 {
+			t.Errorf("%s: %v uses an edge twice", test.name, cycle)
+		}
+		for i, u := range cycle {
+			v := cycle[(i+1)%len(cycle)]
+			if _, ok := g.edges[u][v]; !ok {
+				t.Errorf("%s: %v has no edge %d-%d", test.name, cycle, u, v)
+			}
+		}
+	}
+}
+
+func TestBipartite(t *testing.T) {
+	even := unweightedGraph(false, 6, [][2]int{{0, 1}, {1, 2}, {2, 3}, {3, 0}, {4, 5}})
+	side, ok := even.Bipartite()
+	if !ok {
+		t.Fatalf("an even cycle is bipartite")
+	}
+	if want := map[int]int{0: 0, 1: 1, 2: 0, 3: 1, 4: 0, 5: 1}; !reflect.DeepEqual(side, want) {
+		t.Errorf("sides %v, want %v", side, want)
+	}
+
+	odd := unweightedGraph(false, 0, [][2]int{{0, 1}, {1, 2}, {2, 3}, {3, 4}, {4, 0}})
+	if _, ok := odd.Bipartite(); ok {
+		t.Errorf("an odd cycle is not bipartite")
+	}
+
+	// directions are ignored
+	directed := unweightedGraph(true, 0, [][2]int{{0, 1}, {2, 1}, {2, 0}})
+	if _, ok := directed.Bipartite(); ok {
+		t.Errorf("a directed triangle is not bipartite")
+	}
+}
+
+func TestConnectedComponents(t *testing.T) {
+	g := unweightedGraph(true, 7, [][2]int{{3, 0}, {0, 5}, {2, 4}, {6, 4}})
+	want := [][]int{{0, 3, 5}, {1}, {2, 4, 6}}
+	if got := g.ConnectedComponents(); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestBridgesAndArticulationPoints(t *testing.T) {
+	// two triangles joined by the path 2-3-4, and a separate edge 7-8
+	g := unweightedGraph(false, 9, [][2]int{
+		{0, 1}, {1, 2}, {2, 0},
+		{2, 3}, {3, 4},
+		{4, 5}, {5, 6}, {6, 4},
+		{7, 8},
+	})
+	wantBridges := []Edge{{Start: 2, End: 3}, {Start: 3, End: 4}, {Start: 7, End: 8}}
+	if got := g.Bridges(); !reflect.DeepEqual(got, wantBridges) {
+		t.Errorf("bridges %v, want %v", got, wantBridges)
+	}
+	if got, want := g.ArticulationPoints(), []int{2, 3, 4}; !reflect.DeepEqual(got, want) {
+		t.Errorf("articulation points %v, want %v", got, want)
+	}
+
+	// the results agree with ArticulationPoint on connected graphs
+	for _, test := range []struct {
+		n     int
+		edges [][2]int
+	}{
+		{5, [][2]int{{0, 1}, {1, 2}, {2, 3}, {3, 4}}},
+		{4, [][2]int{{0, 1}, {0, 2}, {0, 3}, {1, 2}, {1, 3}, {2, 3}}},
+		{5, [][2]int{{0, 1}, {0, 2}, {0, 3}, {3, 4}}},
+		{6, [][2]int{{0, 1}, {1, 2}, {2, 0}, {1, 3}, {3, 4}, {4, 5}, {5, 3}}},
+	} {
+		g := unweightedGraph(false, test.n, test.edges)
+		var want []int
+		for v, ok := range ArticulationPoint(g) {
+			if ok {
+				want = append(want, v)
+			}
+		}
+		if got := g.ArticulationPoints(); len(got)+len(want) > 0 && !reflect.DeepEqual(got, want) {
+			t.Errorf("%v: got %v, want %v", test.edges, got, want)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+// csr.go
+// description: Conversion between Graph and a compressed sparse row adjacency matrix
+// details:
+// A graph with V vertices and E edges can be stored as a V x V adjacency matrix
+// in compressed sparse row (CSR) format: the neighbours of every vertex are
+// then a contiguous, sorted slice, which is far more cache friendly to walk
+// than the nested maps of Graph. Entry (u, v) holds the weight of the edge
+// from u to v; edges of weight 0 are stored explicitly.
+// time complexity: O(V + E log E) to build the matrix, O(V + E) for the search
+// space complexity: O(V + E)
+// reference: https://en.wikipedia.org/wiki/Sparse_matrix#Compressed_sparse_row_(CSR,_CRS_or_Yale_format)
+// see csr_test.go
+
+package graph
+
+import (
+	"errors"
+
+	"github.com/TheAlgorithms/Go/math/matrix/sparse"
+)
+
+// CSR returns the adjacency matrix of the graph in compressed sparse row
+// format. It has one row and one column per vertex, covering vertices added
+// beyond the count given to New as well. It returns an error if a vertex is
+// negative, as a matrix has no row for it.
+func (g *Graph) CSR() (*sparse.CSR[int], error) {
+	n := g.vertices
+	var entries []sparse.Entry[int]
+	for u, neighbours := range g.edges {
+		if u >= n {
+			n = u + 1
+		}
+		for v, weight := range neighbours {
+			entries = append(entries, sparse.Entry[int]{Row: u, Column: v, Value: weight})
+		}
+	}
+	// Every vertex is a key of edges, so n already covers all entries.
+	return sparse.NewCSR(n, n, entries)
+}
+
+// FromCSR creates a graph from a square adjacency matrix, with an edge for
+// every stored entry. For an undirected graph the matrix should be symmetric.
+func FromCSR(adjacency *sparse.CSR[int], directed bool) (*Graph, error) {
+	if adjacency.Rows() != adjacency.Columns() {
+		return nil, errors.New("adjacency matrix must be square")
+	}
+	g := New(adjacency.Rows())
+	g.Directed = directed
+	for u := 0; u < adjacency.Rows(); u++ {
+		g.AddVertex(u)
+		columns, weights := adjacency.Row(u)
+		for k, v := range columns {
+			g.AddWeightedEdge(u, v, weights[k])
+		}
+	}
+	return g, nil
+}
+
+// BreadthFirstSearchCSR returns the number of edges on a shortest path from
+// start to every vertex of the graph stored in adjacency, or -1 for the
+// vertices that cannot be reached.
+func BreadthFirstSearchCSR(adjacency *sparse.CSR[int], start int) []int {
+	distance := make([]int, adjacency.Rows())
+	for i := range distance {
+		distance[i] = -1
+	}
+	distance[start] = 0
+	queue := []int{start}
+	for len(queue) > 0 {
+		u := queue[0]
+		queue = queue[1:]
+		neighbours, _ := adjacency.Row(u)
+		for _, v := range neighbours {
+			if distance[v] == -1 {
+				distance[v] = distance[u] + 1
+				queue = append(queue, v)
+			}
+		}
+	}
+	return distance
+}
@@ -0,0 +1,70 @@
+package graph
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestCSRRoundTrip(t *testing.T) {
+	for _, test := range graphTestCases {
+		for _, directed := range []bool{false, true} {
+			g := New(test.vertices)
+			g.Directed = directed
+			for _, edge := range test.edges {
+				g.AddWeightedEdge(edge[0], edge[1], edge[2])
+			}
+			adjacency, err := g.CSR()
+			if err != nil {
+				t.Fatal(err)
+			}
+			// FromCSR creates every vertex, isolated ones included.
+			for v := 0; v < adjacency.Rows(); v++ {
+				g.AddVertex(v)
+			}
+			back, err := FromCSR(adjacency, directed)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(back.edges, g.edges) {
+				t.Errorf("%s (directed %v): round trip gave %v, want %v", test.name, directed, back.edges, g.edges)
+			}
+			for _, edge := range test.edges {
+				if got := adjacency.At(edge[0], edge[1]); got != g.edges[edge[0]][edge[1]] {
+					t.Errorf("%s: At(%d, %d) = %d, want %d", test.name, edge[0], edge[1], got, edge[2])
+				}
+			}
+		}
+	}
+}
+
+func TestBreadthFirstSearchCSR(t *testing.T) {
+	rnd := rand.New(rand.NewSource(6))
+	const n = 60
+	dense := make([][]int, n)
+	for i := range dense {
+		dense[i] = make([]int, n)
+	}
+	g := New(n)
+	g.Directed = true
+	for e := 0; e < 120; e++ {
+		u, v := rnd.Intn(n), rnd.Intn(n)
+		dense[u][v] = 1
+		g.AddEdge(u, v)
+	}
+
+	adjacency, err := g.CSR()
+	if err != nil {
+		t.Fatal(err)
+	}
+	distance := BreadthFirstSearchCSR(adjacency, 0)
+	for end := 1; end < n; end++ {
+		connected, want := BreadthFirstSearch(0, end, n, dense)
+		if !connected {
+			want = -1
+		}
+		if distance[end] != want {
+			t.Errorf("distance to %d = %d, want %d", end, distance[end], want)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+// deltastepping.go
+// description: Parallel single-source shortest paths with delta-stepping
+// details:
+// Delta-stepping sits between Dijkstra's algorithm, which settles one vertex
+// at a time, and Bellman-Ford, which relaxes every edge in every round.
+// Tentative distances are kept in buckets of width delta, and the lowest
+// non-empty bucket is processed as a whole: its vertices relax their light
+// edges (weight <= delta), which may put vertices back into the same bucket,
+// until the bucket stays empty; then the heavy edges of every vertex removed
+// from it are relaxed once. All relaxations of a phase are independent, so
+// they are shared between goroutines, and a distance is lowered with an
+// atomic compare-and-swap loop. A small delta behaves like Dijkstra, a large
+// one like Bellman-Ford with more parallelism.
+// time complexity: O(V + E + D/delta * L) for maximum shortest path weight D and L light relaxation rounds per bucket
+// space complexity: O(V + E)
+// reference: https://en.wikipedia.org/wiki/Parallel_single-source_shortest_path_algorithm#Delta_stepping_algorithm
+// reference: Meyer, Sanders, "Delta-stepping: a parallelizable shortest path algorithm", 2003
+// see deltastepping_test.go
+
+package graph
+
+import (
+	"errors"
+	"runtime"
+	"sync/atomic"
+)
+
+// unreached is the tentative distance of a vertex no path reached yet.
+const unreached = int64(-1)
+
+// DeltaStepping returns the length of the shortest path from start to every
+// vertex, or -1 for the vertices that cannot be reached, using buckets of
+// width delta and up to workers goroutines. A non-positive delta selects the
+// average edge weight, and non-positive workers one goroutine per CPU.
+// Edge weights must not be negative, and start must be a vertex of f.
+func (f *FrozenGraph) DeltaStepping(start int, delta int, workers int) ([]int, error) {
+	if start < 0 || start >= f.Vertices() {
+		return nil, errors.New("start vertex out of range")
+	}
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	var total int64
+	for u := 0; u < f.Vertices(); u++ {
+		_, weights := f.Neighbors(u)
+		for _, w := range weights {
+			if w < 0 {
+				return nil, errors.New("delta-stepping requires non-negative edge weights")
+			}
+			total += int64(w)
+		}
+	}
+	if delta <= 0 {
+		delta = 1
+		if f.Edges() > 0 && total/int64(f.Edges()) > 1 {
+			delta = int(total / int64(f.Edges()))
+		}
+	}
+
+	dist := make([]int64, f.Vertices())
+	for i := range dist {
+		dist[i] = unreached
+	}
+	dist[start] = 0
+	buckets := [][]int{{start}}
+	// inPhase[v] is the last phase that took v, to drop duplicates from buckets.
+	inPhase := make([]int, f.Vertices())
+	phase := 0
+	improved := make([][]int, workers)
+
+	// relax relaxes the light or heavy edges leaving the given vertices and
+	// files every vertex whose distance went down into its new bucket.
+	relax := func(vertices []int, light bool) {
+		used := parallelChunks(len(vertices), workers, func(lo, hi, chunk int) {
+			local := improved[chunk][:0]
+			for _, u := range vertices[lo:hi] {
+				du := atomic.LoadInt64(&dist[u])
+				targets, weights := f.Neighbors(u)
+				for k, v := range targets {
+					if (weights[k] <= delta) != light {
+						continue
+					}
+					candidate := du + int64(weights[k])
+					for {
+						dv := atomic.LoadInt64(&dist[v])
+						if dv != unreached && dv <= candidate {
+							break
+						}
+						if atomic.CompareAndSwapInt64(&dist[v], dv, candidate) {
+							local = append(local, v)
+							break
+						}
+					}
+				}
+			}
+			improved[chunk] = local
+		})
+		for _, local := range improved[:used] {
+			for _, v := range local {
+				b := int(dist[v] / int64(delta))
+				for len(buckets) <= b {
+					buckets = append(buckets, nil)
+				}
+				buckets[b] = append(buckets[b], v)
+			}
+		}
+	}
+
+	for i := 0; i < len(buckets); i++ {
+		var settled []int
+		for len(buckets[i]) > 0 {
+			phase++
+			var frontier []int
+			for _, v := range buckets[i] {
+				// Skip stale entries of vertices that moved to a lower bucket.
+				if inPhase[v] != phase && int(dist[v]/int64(delta)) == i {
+					inPhase[v] = phase
+					frontier = append(frontier, v)
+				}
+			}
+			buckets[i] = nil
+			settled = append(settled, frontier...)
+			relax(frontier, true)
+		}
+		relax(settled, false)
+	}
+
+	result := make([]int, len(dist))
+	for i, d := range dist {
+		result[i] = int(d)
+	}
+	return result, nil
+}
@@ -0,0 +1,60 @@
+package graph
+
+import (
+	"testing"
+)
+
+func TestDeltaStepping(t *testing.T) {
+	for _, directed := range []bool{false, true} {
+		f := freeze(t, randomGraph(5, 3000, 9000, directed))
+		want, err := f.ShortestPaths(0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, delta := range []int{0, 1, 10, 1000} {
+			for _, workers := range []int{1, 4} {
+				got, err := f.DeltaStepping(0, delta, workers)
+				if err != nil {
+					t.Fatal(err)
+				}
+				for v := range want {
+					if got[v] != want[v] {
+						t.Fatalf("directed %v, delta %d, %d workers: distance to %d = %d, want %d", directed, delta, workers, v, got[v], want[v])
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestDeltaSteppingNegativeWeight(t *testing.T) {
+	g := New(2)
+	g.Directed = true
+	g.AddWeightedEdge(0, 1, -1)
+	if _, err := freeze(t, g).DeltaStepping(0, 0, 0); err == nil {
+		t.Errorf("DeltaStepping should reject negative weights")
+	}
+}
+
+func TestDeltaSteppingStartOutOfRange(t *testing.T) {
+	f := freeze(t, New(3))
+	for _, start := range []int{-1, 3} {
+		if _, err := f.DeltaStepping(start, 0, 0); err == nil {
+			t.Errorf("DeltaStepping(%d) should reject a start out of range", start)
+		}
+	}
+}
+
+func BenchmarkShortestPathsFrozen(b *testing.B) {
+	f := freeze(b, randomGraph(1, 100_000, 500_000, false))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		f.ShortestPaths(0)
+	}
+}
+
+func BenchmarkDeltaStepping(b *testing.B) {
+	f := freeze(b, randomGraph(1, 100_000, 500_000, false))
+	b.ResetTimer()
+	benchmarkWorkers(b, func(workers int) { _, _ = f.DeltaStepping(0, 0, workers) })
+}
@@ -0,0 +1,101 @@
+// dynamicmst.go
+// description: Minimum spanning forest maintained under edge insertions
+// details:
+// When an edge (u, v) is inserted into a graph whose minimum spanning forest
+// is known, the forest changes by at most one edge: if u and v are in
+// different trees the edge joins them, and otherwise it closes a cycle with
+// the tree path from u to v, and by the cycle property the heaviest edge of
+// that cycle is not in the new forest. The forest is kept in a link-cut tree
+// (see linkcut.go) where every edge is a node of its own between its two
+// endpoints, holding its weight, so that the heaviest edge of the path from
+// u to v is found in O(log V), and swapping it for the new edge is a cut and
+// two links.
+// time complexity: O(log V) amortized per insertion
+// space complexity: O(V)
+// reference: https://en.wikipedia.org/wiki/Minimum_spanning_tree#Dynamic_MST
+// see dynamicmst_test.go
+
+package graph
+
+// DynamicMST is a minimum spanning forest of a graph with vertices 0 to n-1
+// whose edges are inserted one at a time.
+type DynamicMST struct {
+	forest linkCut
+	edges  map[int]Edge // edges of the forest by their node
+	free   []int        // nodes of edges that left the forest
+	weight int
+}
+
+// NewDynamicMST creates the minimum spanning forest of n vertices and no
+// edge.
+func NewDynamicMST(n int) *DynamicMST {
+	m := &DynamicMST{edges: map[int]Edge{}}
+	for v := 0; v < n; v++ {
+		m.forest.add(0, false)
+	}
+	return m
+}
+
+// Insert adds e to the graph, its endpoints being vertices between 0 and
+// n-1, and returns the edge that the insertion leaves out of the forest:
+// either e itself or the heaviest edge of the cycle it closes, which e
+// replaces. The second return value is false when e joined two trees and
+// no edge is left out. A self-loop is always left out, as is an edge no
+// lighter than every edge of the cycle it closes.
+func (m *DynamicMST) Insert(e Edge) (Edge, bool) {
+	u, v := int(e.Start), int(e.End)
+	if u == v {
+		return e, true
+	}
+	if !m.forest.connected(u, v) {
+		m.link(e)
+		return Edge{}, false
+	}
+	heaviest := m.forest.pathMax(u, v)
+	old := m.edges[heaviest]
+	if e.Weight >= old.Weight {
+		return e, true
+	}
+	m.forest.cut(int(old.Start), heaviest)
+	m.forest.cut(heaviest, int(old.End))
+	delete(m.edges, heaviest)
+	m.free = append(m.free, heaviest)
+	m.weight -= old.Weight
+	m.link(e)
+	return old, true
+}
+
+// link adds e, whose endpoints are in different trees, to the forest.
+func (m *DynamicMST) link(e Edge) {
+	var x int
+	if k := len(m.free); k > 0 {
+		x = m.free[k-1]
+		m.free = m.free[:k-1]
+		m.forest.reset(x, e.Weight)
+	} else {
+		x = m.forest.add(e.Weight, true)
+	}
+	m.forest.link(int(e.Start), x)
+	m.forest.link(x, int(e.End))
+	m.edges[x] = e
+	m.weight += e.Weight
+}
+
+// Connected reports whether u and v are in the same tree of the forest.
+func (m *DynamicMST) Connected(u, v Vertex) bool {
+	return m.forest.connected(int(u), int(v))
+}
+
+// Weight returns the total weight of the forest.
+func (m *DynamicMST) Weight() int {
+	return m.weight
+}
+
+// Edges returns the edges of the forest, in no particular order.
+func (m *DynamicMST) Edges() []Edge {
+	edges := make([]Edge, 0, len(m.edges))
+	for _, e := range m.edges {
+		edges = append(edges, e)
+	}
+	return edges
+}
@@ -0,0 +1,63 @@
+package graph
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestDynamicMST(t *testing.T) {
+	m := NewDynamicMST(4)
+	if _, ok := m.Insert(Edge{0, 1, 5}); ok {
+		t.Errorf("Insert of an edge joining two trees left an edge out")
+	}
+	m.Insert(Edge{1, 2, 3})
+	if m.Connected(0, 3) || !m.Connected(0, 2) {
+		t.Errorf("Connected does not follow the forest")
+	}
+	if out, ok := m.Insert(Edge{0, 2, 9}); !ok || out != (Edge{0, 2, 9}) {
+		t.Errorf("Insert of the heaviest edge of a cycle = %v, %t", out, ok)
+	}
+	if out, ok := m.Insert(Edge{0, 2, 1}); !ok || out != (Edge{0, 1, 5}) {
+		t.Errorf("Insert of a light edge closing a cycle = %v, %t, want {0 1 5}", out, ok)
+	}
+	if out, ok := m.Insert(Edge{3, 3, 0}); !ok || out != (Edge{3, 3, 0}) {
+		t.Errorf("Insert of a self-loop = %v, %t", out, ok)
+	}
+	if m.Weight() != 4 || len(m.Edges()) != 2 {
+		t.Errorf("Weight() = %d, Edges() = %v, want weight 4 with 2 edges", m.Weight(), m.Edges())
+	}
+}
+
+func TestDynamicMSTRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	const n = 40
+	m := NewDynamicMST(n)
+	var all []Edge
+	for i := 0; i < 600; i++ {
+		e := Edge{Vertex(rnd.Intn(n)), Vertex(rnd.Intn(n)), rnd.Intn(100) - 20}
+		m.Insert(e)
+		all = append(all, e)
+
+		edges := append([]Edge(nil), all...)
+		mst, cost := KruskalMST(n, edges)
+		if m.Weight() != cost {
+			t.Fatalf("after %d insertions Weight() = %d, Kruskal gives %d", i+1, m.Weight(), cost)
+		}
+		forest := m.Edges()
+		if len(forest) != len(mst) {
+			t.Fatalf("after %d insertions the forest has %d edges, Kruskal gives %d", i+1, len(forest), len(mst))
+		}
+		// the edges of the forest must not close a cycle
+		u := NewUnionFind(n)
+		for _, e := range forest {
+			if u.Find(int(e.Start)) == u.Find(int(e.End)) {
+				t.Fatalf("the forest has a cycle through %v", e)
+			}
+			u.Union(int(e.Start), int(e.End))
+		}
+		a, b := Vertex(rnd.Intn(n)), Vertex(rnd.Intn(n))
+		if got, want := m.Connected(a, b), u.Find(int(a)) == u.Find(int(b)); got != want {
+			t.Fatalf("Connected(%d, %d) = %t, want %t", a, b, got, want)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+// encoding.go
+// description: JSON and gob encoding of graphs
+// details:
+// A graph is encoded as its declared number of vertices, whether it is
+// directed, its vertices in increasing order and its edges ordered by start
+// then end vertex, an undirected edge being listed once from its smaller
+// endpoint. In JSON:
+// {"vertices": 3, "directed": false, "nodes": [0, 1, 2],
+//  "edges": [{"Start": 0, "End": 1, "Weight": 4}]}
+// Decoding rebuilds the graph with AddVertex and AddWeightedEdge, so the
+// edges of an undirected graph come back in both directions. Vertices are
+// listed on their own to keep isolated ones.
+// see encoding_test.go
+
+package graph
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+)
+
+// graphEncoding is the encoded form of a Graph.
+type graphEncoding struct {
+	Vertices int    `json:"vertices"`
+	Directed bool   `json:"directed"`
+	Nodes    []int  `json:"nodes"`
+	Edges    []Edge `json:"edges"`
+}
+
+// encoding returns the encoded form of g.
+func (g *Graph) encoding() graphEncoding {
+	e := graphEncoding{Vertices: g.vertices, Directed: g.Directed, Nodes: g.vertexList(), Edges: g.edgeList()}
+	if e.Edges == nil {
+		e.Edges = []Edge{}
+	}
+	return e
+}
+
+// load replaces g with the graph described by e.
+func (g *Graph) load(e graphEncoding) {
+	*g = Graph{vertices: e.Vertices, Directed: e.Directed}
+	for _, v := range e.Nodes {
+		g.AddVertex(v)
+	}
+	for _, edge := range e.Edges {
+		g.AddWeightedEdge(int(edge.Start), int(edge.End), edge.Weight)
+	}
+}
+
+// MarshalJSON encodes the graph as a JSON object holding its number of
+// vertices, its direction, its vertices and its edges.
+func (g *Graph) MarshalJSON() ([]byte, error) {
+	return json.Marshal(g.encoding())
+}
+
+// UnmarshalJSON replaces the graph with the one encoded by MarshalJSON.
+func (g *Graph) UnmarshalJSON(data []byte) error {
+	var e graphEncoding
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	g.load(e)
+	return nil
+}
+
+// GobEncode encodes the graph with gob.
+func (g *Graph) GobEncode() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(g.encoding()); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode replaces the graph with the one encoded by GobEncode.
+func (g *Graph) GobDecode(data []byte) error {
+	var e graphEncoding
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&e); err != nil {
+		return err
+	}
+	g.load(e)
+	return nil
+}
@@ -0,0 +1,60 @@
+package graph
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"testing"
+)
+
+func TestGraphEncoding(t *testing.T) {
+	undirected := New(5)
+	undirected.AddWeightedEdge(0, 1, 4)
+	undirected.AddWeightedEdge(2, 1, -3)
+	undirected.AddVertex(4) // isolated
+
+	directed := New(3)
+	directed.Directed = true
+	directed.AddWeightedEdge(0, 1, 1)
+	directed.AddWeightedEdge(1, 0, 2)
+	directed.AddWeightedEdge(2, 2, 7)
+
+	for name, g := range map[string]*Graph{"undirected": undirected, "directed": directed, "empty": New(0)} {
+		t.Run(name, func(t *testing.T) {
+			data, err := json.Marshal(g)
+			if err != nil {
+				t.Fatal(err)
+			}
+			fromJSON := New(9)
+			fromJSON.AddEdge(7, 8)
+			if err := json.Unmarshal(data, fromJSON); err != nil {
+				t.Fatal(err)
+			}
+
+			var buf bytes.Buffer
+			if err := gob.NewEncoder(&buf).Encode(g); err != nil {
+				t.Fatal(err)
+			}
+			fromGob := &Graph{}
+			if err := gob.NewDecoder(&buf).Decode(fromGob); err != nil {
+				t.Fatal(err)
+			}
+
+			if !fromJSON.Equal(g) {
+				t.Errorf("JSON round trip gave %v, want %v", fromJSON.edges, g.edges)
+			}
+			if !fromGob.Equal(g) {
+				t.Errorf("gob round trip gave %v, want %v", fromGob.edges, g.edges)
+			}
+		})
+	}
+
+	data, _ := json.Marshal(undirected)
+	want := `{"vertices":5,"directed":false,"nodes":[0,1,2,4],"edges":[{"Start":0,"End":1,"Weight":4},{"Start":1,"End":2,"Weight":-3}]}`
+	if string(data) != want {
+		t.Errorf("json.Marshal = %s, want %s", data, want)
+	}
+	if err := json.Unmarshal([]byte(`{"nodes":"x"}`), &Graph{}); err == nil {
+		t.Errorf("decoding malformed data should fail")
+	}
+}
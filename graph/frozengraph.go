@@ -0,0 +1,186 @@
+// frozengraph.go
+// description: Immutable graph backed by a compressed sparse row adjacency matrix
+// details:
+// Graph keeps its edges in nested maps, which makes adding edges easy but
+// every traversal pays for hashing and for pointer chasing through scattered
+// buckets. A FrozenGraph is a read-only snapshot of a Graph where the edges
+// leaving every vertex are one contiguous slice of targets, sorted, with a
+// parallel slice of weights, as in the rows of a CSR matrix. Read-heavy
+// algorithms such as shortest paths and strongly connected components run
+// several times faster on it; build it once the graph stops changing.
+// Freeze: O(V + E log E), Dijkstra and ShortestPaths: O((V + E) log V), Kosaraju: O(V + E)
+// space complexity: O(V + E)
+// reference: https://en.wikipedia.org/wiki/Sparse_matrix#Compressed_sparse_row_(CSR,_CRS_or_Yale_format)
+// see frozengraph_test.go
+
+package graph
+
+import (
+	"errors"
+
+	"github.com/TheAlgorithms/Go/math/matrix/sparse"
+	"github.com/TheAlgorithms/Go/structure/heap"
+)
+
+// FrozenGraph is an immutable, CSR backed copy of a Graph.
+type FrozenGraph struct {
+	adjacency *sparse.CSR[int]
+	directed  bool
+}
+
+// Freeze returns an immutable snapshot of the graph. Later changes to g are
+// not reflected in it. It returns the error of CSR for a graph it cannot
+// store.
+func (g *Graph) Freeze() (*FrozenGraph, error) {
+	adjacency, err := g.CSR()
+	if err != nil {
+		return nil, err
+	}
+	return &FrozenGraph{adjacency: adjacency, directed: g.Directed}, nil
+}
+
+// Vertices returns the number of vertices of the graph.
+func (f *FrozenGraph) Vertices() int {
+	return f.adjacency.Rows()
+}
+
+// Edges returns the number of stored arcs. An undirected edge between two
+// distinct vertices is stored once in each direction.
+func (f *FrozenGraph) Edges() int {
+	return f.adjacency.NonZeros()
+}
+
+// Directed reports whether the graph was directed when it was frozen.
+func (f *FrozenGraph) Directed() bool {
+	return f.directed
+}
+
+// Neighbors returns the vertices reachable from v through one edge, in
+// increasing order, and the weights of those edges. The slices must not be
+// modified.
+func (f *FrozenGraph) Neighbors(v int) (targets, weights []int) {
+	return f.adjacency.Row(v)
+}
+
+// Adjacency returns the adjacency matrix of the graph.
+func (f *FrozenGraph) Adjacency() *sparse.CSR[int] {
+	return f.adjacency
+}
+
+// Dijkstra returns the length of the shortest path from start to end and
+// whether end can be reached, like Graph.Dijkstra. It reports false if start
+// or end is not a vertex of f.
+func (f *FrozenGraph) Dijkstra(start, end int) (int, bool) {
+	if start < 0 || start >= f.Vertices() || end < 0 || end >= f.Vertices() {
+		return -1, false
+	}
+	if start == end {
+		return 0, true
+	}
+	d := f.dijkstra(start, end)[end]
+	return d, d != -1
+}
+
+// ShortestPaths returns the length of the shortest path from start to every
+// vertex, or -1 for the vertices that cannot be reached. It fails if start is
+// not a vertex of f.
+func (f *FrozenGraph) ShortestPaths(start int) ([]int, error) {
+	if start < 0 || start >= f.Vertices() {
+		return nil, errors.New("start vertex out of range")
+	}
+	return f.dijkstra(start, -1), nil
+}
+
+// dijkstra computes shortest path lengths from start, stopping once end is
+// settled, and returns them with -1 for the vertices not reached. start must
+// be a vertex of f.
+func (f *FrozenGraph) dijkstra(start, end int) []int {
+	type item struct{ node, dist int }
+	dist := make([]int, f.Vertices())
+	for i := range dist {
+		dist[i] = -1
+	}
+	done := make([]bool, f.Vertices())
+	pq, _ := heap.NewAny(func(a, b item) bool { return a.dist < b.dist })
+	dist[start] = 0
+	pq.Push(item{start, 0})
+	for !pq.Empty() {
+		curr := pq.Top()
+		pq.Pop()
+		// Stale entries are left in the queue instead of being updated.
+		if done[curr.node] {
+			continue
+		}
+		if curr.node == end {
+			break
+		}
+		done[curr.node] = true
+		targets, weights := f.Neighbors(curr.node)
+		for k, n := range targets {
+			if d := curr.dist + weights[k]; !done[n] && (dist[n] == -1 || d < dist[n]) {
+				dist[n] = d
+				pq.Push(item{n, d})
+			}
+		}
+	}
+	return dist
+}
+
+// Kosaraju returns the strongly connected components of the graph, like
+// Graph.Kosaraju. It uses explicit stacks, so deep graphs cannot overflow
+// the goroutine stack.
+func (f *FrozenGraph) Kosaraju() [][]int {
+	n := f.Vertices()
+	visited := make([]bool, n)
+	order := make([]int, 0, n)
+	// next[v] is the position of the next neighbour of v to explore.
+	next := make([]int, n)
+	for root := 0; root < n; root++ {
+		if visited[root] {
+			continue
+		}
+		visited[root] = true
+		stack := []int{root}
+		for len(stack) > 0 {
+			v := stack[len(stack)-1]
+			targets, _ := f.Neighbors(v)
+			if next[v] < len(targets) {
+				u := targets[next[v]]
+				next[v]++
+				if !visited[u] {
+					visited[u] = true
+					stack = append(stack, u)
+				}
+				continue
+			}
+			stack = stack[:len(stack)-1]
+			order = append(order, v)
+		}
+	}
+
+	transposed := f.adjacency.Transpose()
+	assigned := make([]bool, n)
+	var sccs [][]int
+	for i := n - 1; i >= 0; i-- {
+		if assigned[order[i]] {
+			continue
+		}
+		assigned[order[i]] = true
+		scc := []int{}
+		stack := []int{order[i]}
+		for len(stack) > 0 {
+			v := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			scc = append(scc, v)
+			sources, _ := transposed.Row(v)
+			for _, u := range sources {
+				if !assigned[u] {
+					assigned[u] = true
+					stack = append(stack, u)
+				}
+			}
+		}
+		sccs = append(sccs, scc)
+	}
+	return sccs
+}
@@ -0,0 +1,173 @@
+package graph
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// randomGraph returns a graph with n vertices and about m random weighted edges.
+func randomGraph(seed int64, n, m int, directed bool) *Graph {
+	rnd := rand.New(rand.NewSource(seed))
+	g := New(n)
+	g.Directed = directed
+	for v := 0; v < n; v++ {
+		g.AddVertex(v)
+	}
+	for e := 0; e < m; e++ {
+		g.AddWeightedEdge(rnd.Intn(n), rnd.Intn(n), 1+rnd.Intn(100))
+	}
+	return g
+}
+
+// referenceDistance returns the Bellman-Ford distance from start to end,
+// which unlike the map graph's Dijkstra does not depend on map order.
+func referenceDistance(tb testing.TB, g *Graph, start, end int) (int, bool) {
+	tb.Helper()
+	ok, d, err := g.BellmanFord(start, end)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	if !ok {
+		return -1, false
+	}
+	return d, true
+}
+
+// freeze returns the frozen copy of g, failing the test if Freeze fails.
+func freeze(tb testing.TB, g *Graph) *FrozenGraph {
+	tb.Helper()
+	f, err := g.Freeze()
+	if err != nil {
+		tb.Fatal(err)
+	}
+	return f
+}
+
+func TestFrozenGraph(t *testing.T) {
+	g := New(4)
+	g.Directed = true
+	g.AddWeightedEdge(0, 2, 7)
+	g.AddWeightedEdge(0, 1, 3)
+	g.AddWeightedEdge(2, 3, 1)
+	f := freeze(t, g)
+	g.AddWeightedEdge(3, 0, 1)
+
+	g.AddEdge(-1, 0)
+	if _, err := g.Freeze(); err == nil {
+		t.Error("Freeze of a graph with a negative vertex should fail")
+	}
+
+	if f.Vertices() != 4 || f.Edges() != 3 || !f.Directed() {
+		t.Errorf("got %d vertices, %d edges, directed %v", f.Vertices(), f.Edges(), f.Directed())
+	}
+	targets, weights := f.Neighbors(0)
+	if !reflect.DeepEqual(targets, []int{1, 2}) || !reflect.DeepEqual(weights, []int{3, 7}) {
+		t.Errorf("Neighbors(0) = %v, %v, want [1 2], [3 7]", targets, weights)
+	}
+	if targets, _ := f.Neighbors(3); len(targets) != 0 {
+		t.Errorf("Neighbors(3) = %v, the snapshot changed with the graph", targets)
+	}
+}
+
+func TestFrozenDijkstra(t *testing.T) {
+	for _, tc := range tc_dijkstra {
+		t.Run(tc.name, func(t *testing.T) {
+			var g Graph
+			for _, edge := range tc.edges {
+				g.AddWeightedEdge(edge[0], edge[1], edge[2])
+			}
+			got, _ := freeze(t, &g).Dijkstra(tc.node0, tc.node1)
+			if got != tc.expected {
+				t.Errorf("Dijkstra(%d, %d) = %d, want %d", tc.node0, tc.node1, got, tc.expected)
+			}
+		})
+	}
+
+	for _, directed := range []bool{false, true} {
+		g := randomGraph(1, 200, 600, directed)
+		f := freeze(t, g)
+		for end := 0; end < 200; end += 7 {
+			want, wantOK := referenceDistance(t, g, 0, end)
+			got, ok := f.Dijkstra(0, end)
+			if got != want || ok != wantOK {
+				t.Fatalf("directed %v: Dijkstra(0, %d) = %d, %v, want %d, %v", directed, end, got, ok, want, wantOK)
+			}
+		}
+	}
+}
+
+func TestFrozenKosaraju(t *testing.T) {
+	for seed := int64(0); seed < 10; seed++ {
+		g := randomGraph(seed, 60, 80, true)
+		want := g.Kosaraju()
+		got := freeze(t, g).Kosaraju()
+		sortSlices(want)
+		sortSlices(got)
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("seed %d: Kosaraju() = %v, want %v", seed, got, want)
+		}
+	}
+}
+
+func BenchmarkDijkstraMapGraph(b *testing.B) {
+	g := randomGraph(1, 5000, 50000, true)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		g.Dijkstra(0, 4999)
+	}
+}
+
+func BenchmarkDijkstraFrozenGraph(b *testing.B) {
+	f := freeze(b, randomGraph(1, 5000, 50000, true))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		f.Dijkstra(0, 4999)
+	}
+}
+
+func BenchmarkKosarajuMapGraph(b *testing.B) {
+	g := randomGraph(1, 5000, 50000, true)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		g.Kosaraju()
+	}
+}
+
+func BenchmarkKosarajuFrozenGraph(b *testing.B) {
+	f := freeze(b, randomGraph(1, 5000, 50000, true))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		f.Kosaraju()
+	}
+}
+
+func TestFrozenShortestPaths(t *testing.T) {
+	g := randomGraph(2, 100, 300, true)
+	f := freeze(t, g)
+	got, err := f.ShortestPaths(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for v := range got {
+		want, _ := referenceDistance(t, g, 0, v)
+		if got[v] != want {
+			t.Fatalf("ShortestPaths(0)[%d] = %d, want %d", v, got[v], want)
+		}
+	}
+}
+
+func TestFrozenVertexOutOfRange(t *testing.T) {
+	f := freeze(t, New(3))
+	for _, v := range []int{-1, 3} {
+		if _, err := f.ShortestPaths(v); err == nil {
+			t.Errorf("ShortestPaths(%d) should reject a start out of range", v)
+		}
+		if d, ok := f.Dijkstra(v, v); ok {
+			t.Errorf("Dijkstra(%d, %d) = %d, true for a vertex out of range", v, v, d)
+		}
+		if d, ok := f.Dijkstra(0, v); ok {
+			t.Errorf("Dijkstra(0, %d) = %d, true for an end out of range", v, d)
+		}
+	}
+}
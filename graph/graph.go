@@ -52,3 +52,39 @@ func (g *Graph) AddWeightedEdge(one, two, weight int) {
 		g.edges[two][one] = weight
 	}
 }
+
+// Clone returns an independent copy of the graph: adding edges to the copy
+// leaves g untouched.
+func (g *Graph) Clone() *Graph {
+	c := &Graph{vertices: g.vertices, Directed: g.Directed}
+	if g.edges != nil {
+		c.edges = make(map[int]map[int]int, len(g.edges))
+		for v, neighbours := range g.edges {
+			c.edges[v] = make(map[int]int, len(neighbours))
+			for u, weight := range neighbours {
+				c.edges[v][u] = weight
+			}
+		}
+	}
+	return c
+}
+
+// Equal reports whether g and other have the same vertices and the same
+// weighted edges, and are both directed or both undirected.
+func (g *Graph) Equal(other *Graph) bool {
+	if g.vertices != other.vertices || g.Directed != other.Directed || len(g.edges) != len(other.edges) {
+		return false
+	}
+	for v, neighbours := range g.edges {
+		otherNeighbours, ok := other.edges[v]
+		if !ok || len(neighbours) != len(otherNeighbours) {
+			return false
+		}
+		for u, weight := range neighbours {
+			if w, ok := otherNeighbours[u]; !ok || w != weight {
+				return false
+			}
+		}
+	}
+	return true
+}
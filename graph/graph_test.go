@@ -129,3 +129,36 @@ func TestUndirectedGraph(t *testing.T) {
 		})
 	}
 }
+
+func TestGraphClone(t *testing.T) {
+	for _, test := range graphTestCases {
+		t.Run(test.name, func(t *testing.T) {
+			g := New(test.vertices)
+			for _, edge := range test.edges {
+				g.AddWeightedEdge(edge[0], edge[1], edge[2])
+			}
+			c := g.Clone()
+			if !g.Equal(c) || !c.Equal(g) {
+				t.Fatalf("a clone should equal its original")
+			}
+			c.AddWeightedEdge(test.edges[0][0], test.edges[0][1], test.edges[0][2]+1)
+			if g.Equal(c) {
+				t.Errorf("graphs with different weights should not be equal")
+			}
+			if g.edges[test.edges[0][0]][test.edges[0][1]] != test.edges[0][2] {
+				t.Errorf("changing a clone should not affect the original")
+			}
+		})
+	}
+
+	directed := &Graph{Directed: true}
+	directed.AddEdge(0, 1)
+	undirected := New(0)
+	undirected.AddEdge(0, 1)
+	if directed.Equal(undirected) {
+		t.Errorf("a directed and an undirected graph should not be equal")
+	}
+	if !New(3).Equal(New(3).Clone()) {
+		t.Errorf("empty graphs should be equal")
+	}
+}
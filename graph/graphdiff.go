@@ -0,0 +1,62 @@
+// graphdiff.go
+// description: Structural difference between two graphs
+// details:
+// Diff compares the vertex and edge sets of two graphs and reports what has
+// to be added, removed or reweighted to turn the first one into the second,
+// for example to check what a pipeline step changed in a graph.
+// time complexity: O((V + E) log(V + E)) because the results are sorted
+// space complexity: O(V + E)
+// see graphdiff_test.go
+
+package graph
+
+// GraphDiff lists the changes that turn one graph into another. Undirected
+// edges are reported once, from their smaller endpoint. All lists are sorted.
+type GraphDiff struct {
+	AddedVertices   []int
+	RemovedVertices []int
+	AddedEdges      []Edge
+	RemovedEdges    []Edge
+	// ChangedEdges holds the edges present in both graphs with a different
+	// weight, with the weight they have in the second graph.
+	ChangedEdges []Edge
+}
+
+// Empty reports whether the two compared graphs have the same structure.
+func (d GraphDiff) Empty() bool {
+	return len(d.AddedVertices) == 0 && len(d.RemovedVertices) == 0 &&
+		len(d.AddedEdges) == 0 && len(d.RemovedEdges) == 0 && len(d.ChangedEdges) == 0
+}
+
+// Diff returns the changes that turn g into other.
+func (g *Graph) Diff(other *Graph) (GraphDiff, error) {
+	if g.Directed != other.Directed {
+		return GraphDiff{}, ErrDirectionMismatch
+	}
+	var d GraphDiff
+	for _, v := range other.vertexList() {
+		if _, ok := g.edges[v]; !ok {
+			d.AddedVertices = append(d.AddedVertices, v)
+		}
+	}
+	for _, v := range g.vertexList() {
+		if _, ok := other.edges[v]; !ok {
+			d.RemovedVertices = append(d.RemovedVertices, v)
+		}
+	}
+	for _, e := range other.edgeList() {
+		weight, ok := g.hasEdge(int(e.Start), int(e.End))
+		switch {
+		case !ok:
+			d.AddedEdges = append(d.AddedEdges, e)
+		case weight != e.Weight:
+			d.ChangedEdges = append(d.ChangedEdges, e)
+		}
+	}
+	for _, e := range g.edgeList() {
+		if _, ok := other.hasEdge(int(e.Start), int(e.End)); !ok {
+			d.RemovedEdges = append(d.RemovedEdges, e)
+		}
+	}
+	return d, nil
+}
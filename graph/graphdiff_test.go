@@ -0,0 +1,40 @@
+package graph
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	before := buildGraph(false, [][]int{{0, 1, 1}, {1, 2, 2}, {2, 3, 3}})
+	after := buildGraph(false, [][]int{{0, 1, 1}, {2, 1, 5}, {3, 4, 4}})
+
+	d, err := before.Diff(after)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := GraphDiff{
+		AddedVertices: []int{4},
+		AddedEdges:    []Edge{{3, 4, 4}},
+		RemovedEdges:  []Edge{{2, 3, 3}},
+		ChangedEdges:  []Edge{{1, 2, 5}},
+	}
+	if !reflect.DeepEqual(d, want) {
+		t.Errorf("Diff() = %+v, want %+v", d, want)
+	}
+	if d.Empty() {
+		t.Errorf("Empty() = true for different graphs")
+	}
+
+	back, _ := after.Diff(before)
+	if !reflect.DeepEqual(back.RemovedVertices, []int{4}) || !reflect.DeepEqual(back.AddedEdges, []Edge{{2, 3, 3}}) {
+		t.Errorf("reverse Diff() = %+v", back)
+	}
+
+	if d, _ := before.Diff(before.Reverse()); !d.Empty() {
+		t.Errorf("an undirected graph differs from its reverse: %+v", d)
+	}
+	if _, err := before.Diff(&Graph{Directed: true}); err != ErrDirectionMismatch {
+		t.Errorf("Diff of directed and undirected graphs error = %v", err)
+	}
+}
@@ -0,0 +1,271 @@
+// grid.go
+// description: Grids of cells as graphs, flood fill, component labeling and shortest paths
+// details:
+// A 2D matrix is an implicit graph whose vertices are the cells and whose
+// edges join neighbouring cells: the 4 orthogonal ones, or also the 4
+// diagonal ones with 8-connectivity. Grid works on the matrix directly, with
+// no edge stored, and converts it to a Graph when a general algorithm is
+// needed, the cell in row r and column c being vertex r*columns + c.
+// - flood fill recolours the region of same-valued cells around a seed. The
+// scanline method fills a whole horizontal run of the region at once and
+// only queues one seed for every run of region cells next to it in the rows
+// above and below, instead of every cell, which keeps the stack small,
+// - component labeling gives every passable cell the number of its connected
+// region in two raster scans: the first joins every cell with its already
+// scanned neighbours in a disjoint-set forest, and the second numbers the
+// roots in order of first appearance,
+// - the shortest path between two cells avoiding obstacles is a
+// breadth-first search, every move costing 1, diagonal ones included.
+// time complexity: O(R C) for R rows and C columns, times the inverse Ackermann function for labeling
+// space complexity: O(R C)
+// reference: https://en.wikipedia.org/wiki/Flood_fill#Span_filling
+// reference: https://en.wikipedia.org/wiki/Connected-component_labeling#Two-pass
+// see grid_test.go
+
+package graph
+
+import "errors"
+
+// Connectivity is the number of neighbours of a cell of a Grid.
+type Connectivity int
+
+const (
+	// FourConnected cells are neighbours when they share a side.
+	FourConnected Connectivity = 4
+	// EightConnected cells are neighbours when they share a side or a corner.
+	EightConnected Connectivity = 8
+)
+
+// Cell is the position of a cell of a Grid.
+type Cell struct {
+	Row, Column int
+}
+
+// Grid is a rectangular matrix of cells seen as a graph.
+type Grid[T comparable] struct {
+	cells        [][]T
+	rows         int
+	columns      int
+	connectivity Connectivity
+}
+
+// NewGrid creates a grid over cells, which it uses without copying, with
+// the given connectivity. It returns an error if the rows of cells do not
+// have the same length or the connectivity is neither 4 nor 8.
+func NewGrid[T comparable](cells [][]T, connectivity Connectivity) (*Grid[T], error) {
+	if connectivity != FourConnected && connectivity != EightConnected {
+		return nil, errors.New("grid connectivity must be 4 or 8")
+	}
+	g := &Grid[T]{cells: cells, rows: len(cells), connectivity: connectivity}
+	if g.rows > 0 {
+		g.columns = len(cells[0])
+	}
+	for _, row := range cells {
+		if len(row) != g.columns {
+			return nil, errors.New("grid rows must have the same length")
+		}
+	}
+	return g, nil
+}
+
+// Rows returns the number of rows of the grid.
+func (g *Grid[T]) Rows() int {
+	return g.rows
+}
+
+// Columns returns the number of columns of the grid.
+func (g *Grid[T]) Columns() int {
+	return g.columns
+}
+
+// Contains reports whether c is a cell of the grid.
+func (g *Grid[T]) Contains(c Cell) bool {
+	return c.Row >= 0 && c.Row < g.rows && c.Column >= 0 && c.Column < g.columns
+}
+
+// At returns the value of cell c, which must be in the grid.
+func (g *Grid[T]) At(c Cell) T {
+	return g.cells[c.Row][c.Column]
+}
+
+// Vertex returns the vertex of cell c in the graphs made by Graph.
+func (g *Grid[T]) Vertex(c Cell) int {
+	return c.Row*g.columns + c.Column
+}
+
+// Cell returns the cell of vertex v of the graphs made by Graph.
+func (g *Grid[T]) Cell(v int) Cell {
+	return Cell{Row: v / g.columns, Column: v % g.columns}
+}
+
+// offsets are the moves to the neighbours of a cell, the four orthogonal
+// ones first.
+var offsets = [8]Cell{{-1, 0}, {0, -1}, {0, 1}, {1, 0}, {-1, -1}, {-1, 1}, {1, -1}, {1, 1}}
+
+// Neighbours returns the neighbours of cell c in the grid.
+func (g *Grid[T]) Neighbours(c Cell) []Cell {
+	neighbours := make([]Cell, 0, g.connectivity)
+	for _, d := range offsets[:g.connectivity] {
+		if n := (Cell{c.Row + d.Row, c.Column + d.Column}); g.Contains(n) {
+			neighbours = append(neighbours, n)
+		}
+	}
+	return neighbours
+}
+
+// Graph returns the undirected graph of the cells whose value is passable,
+// with an edge of weight 1 between neighbouring ones. Every passable cell is
+// a vertex, numbered as by Vertex.
+func (g *Grid[T]) Graph(passable func(T) bool) *Graph {
+	graph := New(0)
+	for r, row := range g.cells {
+		for c, value := range row {
+			if !passable(value) {
+				continue
+			}
+			cell := Cell{r, c}
+			graph.AddVertex(g.Vertex(cell))
+			for _, n := range g.Neighbours(cell) {
+				if passable(g.At(n)) {
+					graph.AddWeightedEdge(g.Vertex(cell), g.Vertex(n), 1)
+				}
+			}
+		}
+	}
+	return graph
+}
+
+// FloodFill sets to value every cell of the region of seed: the cells
+// connected to it through cells holding the same value as seed. It returns
+// the number of cells changed, 0 if seed is outside the grid or already
+// holds value.
+func (g *Grid[T]) FloodFill(seed Cell, value T) int {
+	if !g.Contains(seed) {
+		return 0
+	}
+	old := g.At(seed)
+	if old == value {
+		return 0
+	}
+	// diagonal neighbours of a run reach one column further on each side
+	reach := 0
+	if g.connectivity == EightConnected {
+		reach = 1
+	}
+	filled := 0
+	stack := []Cell{seed}
+	for len(stack) > 0 {
+		s := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		row := g.cells[s.Row]
+		if row[s.Column] != old {
+			continue
+		}
+		left, right := s.Column, s.Column
+		for left > 0 && row[left-1] == old {
+			left--
+		}
+		for right < g.columns-1 && row[right+1] == old {
+			right++
+		}
+		for c := left; c <= right; c++ {
+			row[c] = value
+		}
+		filled += right - left + 1
+		from, to := left-reach, right+reach
+		if from < 0 {
+			from = 0
+		}
+		if to > g.columns-1 {
+			to = g.columns - 1
+		}
+		for _, r := range []int{s.Row - 1, s.Row + 1} {
+			if r < 0 || r >= g.rows {
+				continue
+			}
+			// one seed for every run of region cells
+			for c := from; c <= to; c++ {
+				if g.cells[r][c] == old && (c == from || g.cells[r][c-1] != old) {
+					stack = append(stack, Cell{r, c})
+				}
+			}
+		}
+	}
+	return filled
+}
+
+// Components labels the cells whose value is passable with the number of
+// their connected region, numbered from 0 in the order of the first cell of
+// each region in row-major order, and the other cells with -1. It also
+// returns the number of regions.
+func (g *Grid[T]) Components(passable func(T) bool) ([][]int, int) {
+	forest := NewUnionFind(g.rows * g.columns)
+	labels := make([][]int, g.rows)
+	for r, row := range g.cells {
+		labels[r] = make([]int, g.columns)
+		for c, value := range row {
+			labels[r][c] = -1
+			if !passable(value) {
+				continue
+			}
+			cell := Cell{r, c}
+			// the neighbours already scanned come before cell in row-major
+			// order: above it, or on its left
+			for _, n := range g.Neighbours(cell) {
+				if g.Vertex(n) < g.Vertex(cell) && labels[n.Row][n.Column] != -1 {
+					forest.Union(g.Vertex(n), g.Vertex(cell))
+				}
+			}
+			labels[r][c] = 0
+		}
+	}
+	number := map[int]int{}
+	for r := range labels {
+		for c := range labels[r] {
+			if labels[r][c] == -1 {
+				continue
+			}
+			root := forest.Find(g.Vertex(Cell{r, c}))
+			n, ok := number[root]
+			if !ok {
+				n = len(number)
+				number[root] = n
+			}
+			labels[r][c] = n
+		}
+	}
+	return labels, len(number)
+}
+
+// ShortestPath returns the cells of a shortest path from one cell to another
+// through cells whose value is passable, both ends included, and reports
+// whether there is one. Every move to a neighbour costs 1.
+func (g *Grid[T]) ShortestPath(from, to Cell, passable func(T) bool) ([]Cell, bool) {
+	if !g.Contains(from) || !g.Contains(to) || !passable(g.At(from)) || !passable(g.At(to)) {
+		return nil, false
+	}
+	parent := map[Cell]Cell{from: from}
+	queue := []Cell{from}
+	for len(queue) > 0 && queue[0] != to {
+		c := queue[0]
+		queue = queue[1:]
+		for _, n := range g.Neighbours(c) {
+			if _, seen := parent[n]; !seen && passable(g.At(n)) {
+				parent[n] = c
+				queue = append(queue, n)
+			}
+		}
+	}
+	if _, ok := parent[to]; !ok {
+		return nil, false
+	}
+	path := []Cell{to}
+	for c := to; c != from; {
+		c = parent[c]
+		path = append(path, c)
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path, true
+}
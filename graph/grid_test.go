@@ -0,0 +1,163 @@
+package graph
+
+import (
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// parseGrid returns the cells of a picture, one string per row.
+func parseGrid(picture string) [][]byte {
+	var cells [][]byte
+	for _, line := range strings.Fields(picture) {
+		cells = append(cells, []byte(line))
+	}
+	return cells
+}
+
+func isOpen(b byte) bool { return b != '#' }
+
+func TestNewGrid(t *testing.T) {
+	if _, err := NewGrid([][]int{{1, 2}, {3}}, FourConnected); err == nil {
+		t.Errorf("NewGrid with ragged rows should fail")
+	}
+	if _, err := NewGrid([][]int{{1}}, 6); err == nil {
+		t.Errorf("NewGrid with connectivity 6 should fail")
+	}
+	g, _ := NewGrid([][]int{{1, 2, 3}, {4, 5, 6}}, EightConnected)
+	if g.Rows() != 2 || g.Columns() != 3 || g.At(Cell{1, 2}) != 6 {
+		t.Errorf("Rows, Columns, At = %d, %d, %d", g.Rows(), g.Columns(), g.At(Cell{1, 2}))
+	}
+	if v := g.Vertex(Cell{1, 2}); v != 5 || g.Cell(v) != (Cell{1, 2}) {
+		t.Errorf("Vertex(1, 2) = %d, Cell(%d) = %v", v, v, g.Cell(v))
+	}
+	if got := g.Neighbours(Cell{0, 0}); !reflect.DeepEqual(got, []Cell{{0, 1}, {1, 0}, {1, 1}}) {
+		t.Errorf("Neighbours(0, 0) = %v", got)
+	}
+}
+
+func TestGridGraph(t *testing.T) {
+	cells := parseGrid(`
+		..#
+		#..`)
+	four, _ := NewGrid(cells, FourConnected)
+	eight, _ := NewGrid(cells, EightConnected)
+	// the open cells are 0, 1, 4 and 5
+	if got := four.Graph(isOpen).ConnectedComponents(); !reflect.DeepEqual(got, [][]int{{0, 1, 4, 5}}) {
+		t.Errorf("4-connected components = %v", got)
+	}
+	g := eight.Graph(isOpen)
+	if _, ok := g.edges[0][4]; !ok {
+		t.Errorf("the diagonal edge 0 - 4 is missing with 8-connectivity")
+	}
+	if _, ok := g.edges[2]; ok {
+		t.Errorf("the blocked cell 2 is a vertex")
+	}
+}
+
+func TestFloodFill(t *testing.T) {
+	picture := `
+		aab.b
+		a.bab
+		aabba`
+	tests := []struct {
+		connectivity Connectivity
+		filled       int
+		want         string
+	}{
+		{FourConnected, 4, `
+			aaX.b
+			a.Xab
+			aaXXa`},
+		{EightConnected, 6, `
+			aaX.X
+			a.XaX
+			aaXXa`},
+	}
+	for _, test := range tests {
+		g, _ := NewGrid(parseGrid(picture), test.connectivity)
+		if n := g.FloodFill(Cell{0, 2}, 'X'); n != test.filled {
+			t.Errorf("%d-connected FloodFill changed %d cells, want %d", test.connectivity, n, test.filled)
+		}
+		if got, want := g.cells, parseGrid(test.want); !reflect.DeepEqual(got, want) {
+			t.Errorf("%d-connected FloodFill gave %q, want %q", test.connectivity, got, want)
+		}
+		if n := g.FloodFill(Cell{0, 2}, 'X'); n != 0 {
+			t.Errorf("FloodFill with the same value changed %d cells", n)
+		}
+		if n := g.FloodFill(Cell{5, 0}, 'X'); n != 0 {
+			t.Errorf("FloodFill outside the grid changed %d cells", n)
+		}
+	}
+}
+
+func TestGridComponentsAndFloodFillRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		cells := make([][]byte, 1+rnd.Intn(12))
+		columns := 1 + rnd.Intn(12)
+		for r := range cells {
+			cells[r] = make([]byte, columns)
+			for c := range cells[r] {
+				cells[r][c] = ".#"[rnd.Intn(2)]
+			}
+		}
+		for _, connectivity := range []Connectivity{FourConnected, EightConnected} {
+			g, _ := NewGrid(cells, connectivity)
+			labels, count := g.Components(isOpen)
+			components := g.Graph(isOpen).ConnectedComponents()
+			if count != len(components) {
+				t.Fatalf("Components found %d regions, the graph has %d", count, len(components))
+			}
+			// labels follow the order of the smallest vertex of each region
+			for k, component := range components {
+				for _, v := range component {
+					if c := g.Cell(v); labels[c.Row][c.Column] != k {
+						t.Fatalf("cell %v has label %d, want %d", c, labels[c.Row][c.Column], k)
+					}
+				}
+			}
+			// flood filling a region changes exactly its cells
+			if len(components) > 0 {
+				component := components[rnd.Intn(len(components))]
+				copied := make([][]byte, len(cells))
+				for r := range cells {
+					copied[r] = append([]byte(nil), cells[r]...)
+				}
+				fill, _ := NewGrid(copied, connectivity)
+				if n := fill.FloodFill(g.Cell(component[0]), 'X'); n != len(component) {
+					t.Fatalf("FloodFill changed %d cells of a region of %d", n, len(component))
+				}
+				for _, v := range component {
+					if c := g.Cell(v); copied[c.Row][c.Column] != 'X' {
+						t.Fatalf("FloodFill left %v unchanged", c)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestGridShortestPath(t *testing.T) {
+	cells := parseGrid(`
+		...#.
+		.#.#.
+		.#...`)
+	four, _ := NewGrid(cells, FourConnected)
+	path, ok := four.ShortestPath(Cell{0, 0}, Cell{0, 4}, isOpen)
+	want := []Cell{{0, 0}, {0, 1}, {0, 2}, {1, 2}, {2, 2}, {2, 3}, {2, 4}, {1, 4}, {0, 4}}
+	if !ok || !reflect.DeepEqual(path, want) {
+		t.Errorf("4-connected ShortestPath = %v, %v, want %v", path, ok, want)
+	}
+	eight, _ := NewGrid(cells, EightConnected)
+	if path, ok := eight.ShortestPath(Cell{0, 0}, Cell{0, 4}, isOpen); !ok || len(path) != 6 {
+		t.Errorf("8-connected ShortestPath = %v, %v, want 6 cells", path, ok)
+	}
+	if _, ok := four.ShortestPath(Cell{0, 0}, Cell{0, 3}, isOpen); ok {
+		t.Errorf("ShortestPath to a blocked cell was found")
+	}
+	if path, ok := four.ShortestPath(Cell{2, 0}, Cell{2, 0}, isOpen); !ok || len(path) != 1 {
+		t.Errorf("ShortestPath to the start = %v, %v", path, ok)
+	}
+}
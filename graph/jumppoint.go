@@ -0,0 +1,214 @@
+// jumppoint.go
+// description: Heuristic search on grids and jump point search
+// details:
+// On a grid where moving to a side neighbour costs 1 and to a diagonal one
+// costs sqrt(2), many shortest paths differ only by the order of their
+// moves, and A* expands the cells of all of them. Jump point search keeps a
+// single canonical one: from a cell reached in some direction, it only
+// considers the natural neighbours, those not reached more cheaply without
+// going through the cell, and the forced ones, which become natural because
+// an obstacle next to the cell blocks the other way to them. It then jumps
+// from the cell in each remaining direction, without queuing the cells in
+// between, until it reaches the goal, an obstacle, or a jump point: a cell
+// with a forced neighbour, or, for a diagonal move, a cell from which a
+// straight jump finds one. Only the jump points enter the priority queue of
+// A*, so large open areas are crossed in a few expansions, and the paths
+// found are still shortest ones. Diagonal moves may cut the corner of an
+// obstacle, as in an 8-connected Grid.
+// The octile distance, the cost of the path to the goal without obstacles,
+// is an admissible heuristic for such grids, and the Manhattan distance for
+// 4-connected ones.
+// time complexity: O(R C log(R C)) for R rows and C columns
+// space complexity: O(R C)
+// reference: https://en.wikipedia.org/wiki/Jump_point_search
+// reference: Harabor, Grastien, Online Graph Pruning for Pathfinding on Grid Maps, AAAI 2011
+// see jumppoint_test.go
+
+package graph
+
+import (
+	"errors"
+	"math"
+)
+
+// OctileDistance returns the heuristic estimating the cost from a cell to
+// goal as the cost of the moves to it on an empty 8-connected grid.
+func OctileDistance(goal Cell) Heuristic[Cell] {
+	return func(c Cell) float64 {
+		return octile(c, goal)
+	}
+}
+
+// ManhattanDistance returns the heuristic estimating the cost from a cell to
+// goal as the number of moves to it on an empty 4-connected grid.
+func ManhattanDistance(goal Cell) Heuristic[Cell] {
+	return func(c Cell) float64 {
+		return float64(abs(c.Row-goal.Row) + abs(c.Column-goal.Column))
+	}
+}
+
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+func sign(x int) int {
+	switch {
+	case x > 0:
+		return 1
+	case x < 0:
+		return -1
+	}
+	return 0
+}
+
+// octile returns the cost of the moves from a to b on an empty 8-connected
+// grid.
+func octile(a, b Cell) float64 {
+	dr, dc := abs(a.Row-b.Row), abs(a.Column-b.Column)
+	if dr > dc {
+		dr, dc = dc, dr
+	}
+	return float64(dc-dr) + math.Sqrt2*float64(dr)
+}
+
+// Moves returns the moves between neighbouring cells whose value is
+// passable, costing 1 to a side neighbour and sqrt(2) to a diagonal one.
+func (g *Grid[T]) Moves(passable func(T) bool) Successors[Cell] {
+	return func(c Cell, visit func(Cell, float64)) {
+		for _, n := range g.Neighbours(c) {
+			if passable(g.At(n)) {
+				visit(n, octile(c, n))
+			}
+		}
+	}
+}
+
+// jumper finds the jump points of a search towards goal.
+type jumper[T comparable] struct {
+	grid     *Grid[T]
+	passable func(T) bool
+	goal     Cell
+}
+
+func (j *jumper[T]) open(r, c int) bool {
+	cell := Cell{r, c}
+	return j.grid.Contains(cell) && j.passable(j.grid.At(cell))
+}
+
+// forced reports whether cell c, entered moving by d, has a forced
+// neighbour.
+func (j *jumper[T]) forced(c, d Cell) bool {
+	r, col := c.Row, c.Column
+	switch {
+	case d.Row != 0 && d.Column != 0:
+		return !j.open(r, col-d.Column) && j.open(r+d.Row, col-d.Column) ||
+			!j.open(r-d.Row, col) && j.open(r-d.Row, col+d.Column)
+	case d.Row == 0:
+		return !j.open(r+1, col) && j.open(r+1, col+d.Column) ||
+			!j.open(r-1, col) && j.open(r-1, col+d.Column)
+	default:
+		return !j.open(r, col+1) && j.open(r+d.Row, col+1) ||
+			!j.open(r, col-1) && j.open(r+d.Row, col-1)
+	}
+}
+
+// jump moves from c by d until it reaches the goal or a jump point, which it
+// returns, or an obstacle or the border of the grid.
+func (j *jumper[T]) jump(c, d Cell) (Cell, bool) {
+	for {
+		c = Cell{c.Row + d.Row, c.Column + d.Column}
+		if !j.open(c.Row, c.Column) {
+			return c, false
+		}
+		if c == j.goal || j.forced(c, d) {
+			return c, true
+		}
+		if d.Row != 0 && d.Column != 0 {
+			if _, ok := j.jump(c, Cell{d.Row, 0}); ok {
+				return c, true
+			}
+			if _, ok := j.jump(c, Cell{0, d.Column}); ok {
+				return c, true
+			}
+		}
+	}
+}
+
+// directions returns the directions to the natural and forced neighbours of
+// c, entered moving by d.
+func (j *jumper[T]) directions(c, d Cell) []Cell {
+	r, col := c.Row, c.Column
+	var directions []Cell
+	switch {
+	case d.Row != 0 && d.Column != 0:
+		directions = append(directions, Cell{d.Row, 0}, Cell{0, d.Column}, d)
+		if !j.open(r, col-d.Column) {
+			directions = append(directions, Cell{d.Row, -d.Column})
+		}
+		if !j.open(r-d.Row, col) {
+			directions = append(directions, Cell{-d.Row, d.Column})
+		}
+	case d.Row == 0:
+		directions = append(directions, d)
+		if !j.open(r+1, col) {
+			directions = append(directions, Cell{1, d.Column})
+		}
+		if !j.open(r-1, col) {
+			directions = append(directions, Cell{-1, d.Column})
+		}
+	default:
+		directions = append(directions, d)
+		if !j.open(r, col+1) {
+			directions = append(directions, Cell{d.Row, 1})
+		}
+		if !j.open(r, col-1) {
+			directions = append(directions, Cell{d.Row, -1})
+		}
+	}
+	return directions
+}
+
+// JumpPointSearch returns a shortest path from one cell to another through
+// cells whose value is passable, moves costing as with Moves, by A* with
+// heuristic h over jump points. The path holds every cell from one end to
+// the other, and the statistics count the jump points expanded. It returns
+// an error if the grid is not 8-connected.
+func (g *Grid[T]) JumpPointSearch(from, to Cell, passable func(T) bool, h Heuristic[Cell]) (SearchResult[Cell], error) {
+	if g.connectivity != EightConnected {
+		return SearchResult[Cell]{}, errors.New("jump point search requires an 8-connected grid")
+	}
+	if !g.Contains(from) || !g.Contains(to) || !passable(g.At(from)) || !passable(g.At(to)) {
+		return SearchResult[Cell]{Stats: SearchStats{Iterations: 1}}, nil
+	}
+	j := &jumper[T]{grid: g, passable: passable, goal: to}
+	expand := func(c, parent Cell, root bool, visit func(Cell, float64)) {
+		directions := offsets[:]
+		if !root {
+			d := Cell{sign(c.Row - parent.Row), sign(c.Column - parent.Column)}
+			directions = j.directions(c, d)
+		}
+		for _, d := range directions {
+			if n, ok := j.jump(c, d); ok {
+				visit(n, octile(c, n))
+			}
+		}
+	}
+	result := bestFirst(from, to, expand, h, 1)
+	if result.Found {
+		// fill in the straight or diagonal runs between jump points
+		path := []Cell{from}
+		for _, next := range result.Path[1:] {
+			c := path[len(path)-1]
+			d := Cell{sign(next.Row - c.Row), sign(next.Column - c.Column)}
+			for c != next {
+				c = Cell{c.Row + d.Row, c.Column + d.Column}
+				path = append(path, c)
+			}
+		}
+		result.Path = path
+	}
+	return result, nil
+}
@@ -0,0 +1,92 @@
+package graph
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestJumpPointSearch(t *testing.T) {
+	four, _ := NewGrid([][]byte{{'.'}}, FourConnected)
+	if _, err := four.JumpPointSearch(Cell{0, 0}, Cell{0, 0}, isOpen, nil); err == nil {
+		t.Errorf("JumpPointSearch on a 4-connected grid should fail")
+	}
+	cells := parseGrid(`
+		..........
+		....#.....
+		....#.....
+		....#.....
+		..........`)
+	g, _ := NewGrid(cells, EightConnected)
+	from, to := Cell{2, 0}, Cell{2, 9}
+	result, err := g.JumpPointSearch(from, to, isOpen, OctileDistance(to))
+	if err != nil || !result.Found {
+		t.Fatalf("JumpPointSearch = %v, %v", result, err)
+	}
+	want := 5 + 4*math.Sqrt2
+	if math.Abs(result.Cost-want) > 1e-9 {
+		t.Errorf("JumpPointSearch cost = %v, want %v", result.Cost, want)
+	}
+	checkPath(t, result.Path, from, to, g.Moves(isOpen), result.Cost)
+	if result, _ := g.JumpPointSearch(from, Cell{1, 4}, isOpen, nil); result.Found {
+		t.Errorf("JumpPointSearch reached a blocked cell")
+	}
+	if result, _ := g.JumpPointSearch(from, from, isOpen, nil); !result.Found || len(result.Path) != 1 {
+		t.Errorf("JumpPointSearch to the start = %v", result)
+	}
+}
+
+func TestJumpPointSearchRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		cells := make([][]byte, 1+rnd.Intn(15))
+		columns := 1 + rnd.Intn(15)
+		for r := range cells {
+			cells[r] = make([]byte, columns)
+			for c := range cells[r] {
+				cells[r][c] = "..#"[rnd.Intn(3)]
+			}
+		}
+		g, _ := NewGrid(cells, EightConnected)
+		from := Cell{rnd.Intn(len(cells)), rnd.Intn(columns)}
+		to := Cell{rnd.Intn(len(cells)), rnd.Intn(columns)}
+		// A* never checks the start cell, which has no move to it
+		cells[from.Row][from.Column] = '.'
+		want := AStar(from, to, g.Moves(isOpen), OctileDistance(to))
+		got, _ := g.JumpPointSearch(from, to, isOpen, OctileDistance(to))
+		if got.Found != want.Found {
+			t.Fatalf("JumpPointSearch found = %v, A* %v on %q from %v to %v", got.Found, want.Found, cells, from, to)
+		}
+		if !got.Found {
+			continue
+		}
+		if math.Abs(got.Cost-want.Cost) > 1e-9 {
+			t.Fatalf("JumpPointSearch cost = %v, A* %v on %q from %v to %v", got.Cost, want.Cost, cells, from, to)
+		}
+		checkPath(t, got.Path, from, to, g.Moves(isOpen), got.Cost)
+	}
+}
+
+func TestJumpPointSearchExpansions(t *testing.T) {
+	cells := make([][]byte, 40)
+	for r := range cells {
+		cells[r] = make([]byte, 40)
+		for c := range cells[r] {
+			cells[r][c] = '.'
+		}
+	}
+	// a wall with a gap at the bottom
+	for r := 0; r < 35; r++ {
+		cells[r][20] = '#'
+	}
+	g, _ := NewGrid(cells, EightConnected)
+	from, to := Cell{0, 0}, Cell{0, 39}
+	astar := AStar(from, to, g.Moves(isOpen), OctileDistance(to))
+	jps, _ := g.JumpPointSearch(from, to, isOpen, OctileDistance(to))
+	if math.Abs(astar.Cost-jps.Cost) > 1e-9 {
+		t.Fatalf("costs = %v and %v", astar.Cost, jps.Cost)
+	}
+	if jps.Stats.Expanded*10 > astar.Stats.Expanded {
+		t.Errorf("jump point search expanded %d cells, A* %d", jps.Stats.Expanded, astar.Stats.Expanded)
+	}
+}
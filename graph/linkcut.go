@@ -0,0 +1,199 @@
+// linkcut.go
+// description: Link-cut tree over a forest with weighted nodes
+// details:
+// A link-cut tree keeps a forest of rooted trees under linking and cutting
+// and answers queries on the path between two nodes. Each tree is split into
+// preferred paths, each kept in a splay tree ordered by depth, and the root
+// of a splay tree points to the parent of the top of its path. access(x)
+// makes the path from the root of x's tree to x preferred, so that it is one
+// splay tree rooted at x. Rerooting a tree reverses the order of such a path,
+// which is done lazily with a flag. Here every node has a weight and the
+// splay trees keep the node of maximum weight of their subtree, so the
+// heaviest node of the path between two nodes is found in one access.
+// time complexity: O(log n) amortized per operation
+// space complexity: O(n)
+// reference: https://en.wikipedia.org/wiki/Link/cut_tree
+// reference: Sleator, Tarjan, "A data structure for dynamic trees", 1983
+// see dynamicmst.go
+
+package graph
+
+// linkCut is a link-cut tree of nodes numbered from 0, -1 standing for no
+// node. Only nodes marked weighted take part in path maxima.
+type linkCut struct {
+	child    [][2]int
+	parent   []int
+	reversed []bool
+	weighted []bool
+	weight   []int
+	max      []int // node of maximum weight in the splay subtree, or -1
+}
+
+// add appends a new node, alone in its tree, and returns it.
+func (t *linkCut) add(weight int, weighted bool) int {
+	x := len(t.parent)
+	t.child = append(t.child, [2]int{-1, -1})
+	t.parent = append(t.parent, -1)
+	t.reversed = append(t.reversed, false)
+	t.weighted = append(t.weighted, weighted)
+	t.weight = append(t.weight, weight)
+	t.max = append(t.max, -1)
+	t.pull(x)
+	return x
+}
+
+// reset gives the isolated node x a new weight, to reuse it.
+func (t *linkCut) reset(x int, weight int) {
+	t.weight[x] = weight
+	t.pull(x)
+}
+
+// heavier reports whether node a weighs more than node b, -1 weighing less
+// than any node.
+func (t *linkCut) heavier(a, b int) bool {
+	return a != -1 && (b == -1 || t.weight[a] > t.weight[b])
+}
+
+func (t *linkCut) isRoot(x int) bool {
+	p := t.parent[x]
+	return p == -1 || t.child[p][0] != x && t.child[p][1] != x
+}
+
+// pull recomputes the maximum of x from its children.
+func (t *linkCut) pull(x int) {
+	t.max[x] = -1
+	if t.weighted[x] {
+		t.max[x] = x
+	}
+	for _, c := range t.child[x] {
+		if c != -1 && t.heavier(t.max[c], t.max[x]) {
+			t.max[x] = t.max[c]
+		}
+	}
+}
+
+// push applies a pending reversal of x to its children.
+func (t *linkCut) push(x int) {
+	if !t.reversed[x] {
+		return
+	}
+	t.child[x][0], t.child[x][1] = t.child[x][1], t.child[x][0]
+	for _, c := range t.child[x] {
+		if c != -1 {
+			t.reversed[c] = !t.reversed[c]
+		}
+	}
+	t.reversed[x] = false
+}
+
+func (t *linkCut) rotate(x int) {
+	p := t.parent[x]
+	g := t.parent[p]
+	side := 0
+	if t.child[p][1] == x {
+		side = 1
+	}
+	if !t.isRoot(p) {
+		if t.child[g][0] == p {
+			t.child[g][0] = x
+		} else {
+			t.child[g][1] = x
+		}
+	}
+	t.parent[x] = g
+	b := t.child[x][1-side]
+	t.child[p][side] = b
+	if b != -1 {
+		t.parent[b] = p
+	}
+	t.child[x][1-side] = p
+	t.parent[p] = x
+	t.pull(p)
+	t.pull(x)
+}
+
+// splay makes x the root of its splay tree.
+func (t *linkCut) splay(x int) {
+	path := []int{x}
+	for y := x; !t.isRoot(y); y = t.parent[y] {
+		path = append(path, t.parent[y])
+	}
+	for i := len(path) - 1; i >= 0; i-- {
+		t.push(path[i])
+	}
+	for !t.isRoot(x) {
+		p := t.parent[x]
+		if !t.isRoot(p) {
+			g := t.parent[p]
+			if (t.child[g][0] == p) == (t.child[p][0] == x) {
+				t.rotate(p)
+			} else {
+				t.rotate(x)
+			}
+		}
+		t.rotate(x)
+	}
+}
+
+// access makes the path from the root of x's tree to x one splay tree, with
+// x at its root.
+func (t *linkCut) access(x int) {
+	last := -1
+	for y := x; y != -1; y = t.parent[y] {
+		t.splay(y)
+		t.child[y][1] = last
+		t.pull(y)
+		last = y
+	}
+	t.splay(x)
+}
+
+// makeRoot makes x the root of its tree.
+func (t *linkCut) makeRoot(x int) {
+	t.access(x)
+	t.reversed[x] = !t.reversed[x]
+}
+
+// findRoot returns the root of x's tree.
+func (t *linkCut) findRoot(x int) int {
+	t.access(x)
+	for {
+		t.push(x)
+		if t.child[x][0] == -1 {
+			break
+		}
+		x = t.child[x][0]
+	}
+	t.splay(x)
+	return x
+}
+
+// connected reports whether x and y are in the same tree.
+func (t *linkCut) connected(x, y int) bool {
+	return x == y || t.findRoot(x) == t.findRoot(y)
+}
+
+// link joins the trees of x and y, which must be different, by the edge
+// from x to y.
+func (t *linkCut) link(x, y int) {
+	t.makeRoot(x)
+	t.parent[x] = y
+}
+
+// cut removes the edge between x and y, which must exist.
+func (t *linkCut) cut(x, y int) {
+	t.makeRoot(x)
+	t.access(y)
+	// the path is x, y, so x is the left child of y and has no children
+	t.child[y][0] = -1
+	t.parent[x] = -1
+	t.pull(y)
+}
+
+// pathMax returns the weighted node of maximum weight on the path between
+// x and y, which must be connected, or -1 if there is none.
+func (t *linkCut) pathMax(x, y int) int {
+	t.makeRoot(x)
+	t.access(y)
+	return t.max[y]
+}
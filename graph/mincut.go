@@ -0,0 +1,190 @@
+// mincut.go
+// description: Global minimum cut by Karger's contraction and by Stoer-Wagner
+// details:
+// A global minimum cut splits the vertices of an undirected weighted graph
+// into two non-empty sides such that the total weight of the edges between
+// them is as small as possible, without fixing a source and a sink.
+// - Karger's algorithm contracts random edges, with probability proportional
+// to their weight, until two vertices are left, which stand for the two sides
+// of a cut. Contracting the edges in increasing order of exponential random
+// keys, of rate their weights, picks them with the right probabilities, so a
+// trial is Kruskal's algorithm over the edges sorted by key, stopped at two
+// components. A trial finds a given minimum cut with probability at least
+// 2 / (V (V - 1)), so V^2 ln(V) / 2 trials miss it with probability at most
+// 1 / V. The answer is the best cut of all trials.
+// - Stoer-Wagner is deterministic. A phase adds the vertices one by one to a
+// growing set, always the one most tightly connected to it. The cut between
+// the last vertex added and all the others is a minimum cut between the last
+// two vertices, which are then merged: the minimum cut of the graph either
+// separates them, and is the cut of the phase, or does not, and survives the
+// merge. The best of the V - 1 phases is a minimum cut.
+// Edge weights must not be negative. Parallel edges add up and self-loops are
+// ignored.
+// time complexity: O(T E log E) for T trials of Karger, O(V^3) for Stoer-Wagner
+// space complexity: O(E) for Karger, O(V^2) for Stoer-Wagner
+// reference: https://en.wikipedia.org/wiki/Karger%27s_algorithm
+// reference: https://en.wikipedia.org/wiki/Stoer%E2%80%93Wagner_algorithm
+// see mincut_test.go
+
+package graph
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"sort"
+)
+
+// checkCutInput returns an error unless the graph of n vertices and the
+// given edges has a cut.
+func checkCutInput(n int, edges []Edge) error {
+	if n < 2 {
+		return errors.New("a cut needs at least two vertices")
+	}
+	for _, e := range edges {
+		if e.Start < 0 || int(e.Start) >= n || e.End < 0 || int(e.End) >= n {
+			return errors.New("edge endpoint out of range")
+		}
+		if e.Weight < 0 {
+			return errors.New("minimum cut requires non-negative edge weights")
+		}
+	}
+	return nil
+}
+
+// cutWeight returns the weight of the edges with one end in side.
+func cutWeight(edges []Edge, side []bool) int {
+	weight := 0
+	for _, e := range edges {
+		if side[e.Start] != side[e.End] {
+			weight += e.Weight
+		}
+	}
+	return weight
+}
+
+// members returns the vertices in side, in increasing order.
+func members(side []bool) []int {
+	var vertices []int
+	for v, in := range side {
+		if in {
+			vertices = append(vertices, v)
+		}
+	}
+	return vertices
+}
+
+// KargerMinCut returns the weight of the smallest cut found by trials runs of
+// Karger's contraction on the graph of n vertices and the given edges, and
+// the vertices of the side of vertex 0, in increasing order, drawing the
+// contractions from a generator seeded with seed. The cut is a minimum one
+// with high probability for about n^2 ln(n) / 2 trials. It returns an error
+// if n is below 2, trials below 1, or an edge is invalid.
+func KargerMinCut(n int, edges []Edge, trials int, seed int64) (int, []int, error) {
+	if err := checkCutInput(n, edges); err != nil {
+		return 0, nil, err
+	}
+	if trials < 1 {
+		return 0, nil, errors.New("Karger's algorithm needs at least one trial")
+	}
+	rnd := rand.New(rand.NewSource(seed))
+	keys := make([]float64, len(edges))
+	order := make([]int, len(edges))
+	best, bestSide := math.MaxInt, []bool(nil)
+	for trial := 0; trial < trials; trial++ {
+		for i, e := range edges {
+			// edges of weight 0 are never contracted
+			keys[i] = math.Inf(1)
+			if e.Weight > 0 {
+				keys[i] = rnd.ExpFloat64() / float64(e.Weight)
+			}
+			order[i] = i
+		}
+		sort.Slice(order, func(a, b int) bool { return keys[order[a]] < keys[order[b]] })
+		u := NewUnionFind(n)
+		components := n
+		for _, i := range order {
+			if components == 2 || math.IsInf(keys[i], 1) {
+				break
+			}
+			e := edges[i]
+			if u.Find(int(e.Start)) != u.Find(int(e.End)) {
+				u.Union(int(e.Start), int(e.End))
+				components--
+			}
+		}
+		// when the graph falls apart, the side of 0 is a cut of weight 0
+		side := make([]bool, n)
+		root := u.Find(0)
+		for v := range side {
+			side[v] = u.Find(v) == root
+		}
+		if weight := cutWeight(edges, side); weight < best {
+			best, bestSide = weight, side
+		}
+	}
+	return best, members(bestSide), nil
+}
+
+// StoerWagnerMinCut returns the weight of a minimum cut of the graph of n
+// vertices and the given edges, and the vertices of one side, in increasing
+// order. It returns an error if n is below 2 or an edge is invalid.
+func StoerWagnerMinCut(n int, edges []Edge) (int, []int, error) {
+	if err := checkCutInput(n, edges); err != nil {
+		return 0, nil, err
+	}
+	weights := make([][]int, n)
+	for v := range weights {
+		weights[v] = make([]int, n)
+	}
+	for _, e := range edges {
+		if e.Start != e.End {
+			weights[e.Start][e.End] += e.Weight
+			weights[e.End][e.Start] += e.Weight
+		}
+	}
+	// merged[v] holds the vertices of the graph merged into v
+	merged := make([][]int, n)
+	active := make([]int, n)
+	for v := range merged {
+		merged[v] = []int{v}
+		active[v] = v
+	}
+	best, bestSide := math.MaxInt, []int(nil)
+	for len(active) > 1 {
+		// connection[i] is the weight between active[i] and the growing set
+		connection := make([]int, len(active))
+		added := make([]bool, len(active))
+		prev, last := -1, -1
+		for k := 0; k < len(active); k++ {
+			next := -1
+			for i := range active {
+				if !added[i] && (next < 0 || connection[i] > connection[next]) {
+					next = i
+				}
+			}
+			added[next] = true
+			prev, last = last, next
+			for i, v := range active {
+				if !added[i] {
+					connection[i] += weights[active[next]][v]
+				}
+			}
+		}
+		if connection[last] < best {
+			best = connection[last]
+			bestSide = append([]int(nil), merged[active[last]]...)
+		}
+		// merge the last vertex into the one added before it
+		s, t := active[prev], active[last]
+		merged[s] = append(merged[s], merged[t]...)
+		for _, v := range active {
+			weights[s][v] += weights[t][v]
+			weights[v][s] = weights[s][v]
+		}
+		weights[s][s] = 0
+		active = append(active[:last], active[last+1:]...)
+	}
+	sort.Ints(bestSide)
+	return best, bestSide, nil
+}
@@ -0,0 +1,114 @@
+package graph
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// bruteMinCut returns the weight of a minimum cut by trying every side
+// holding vertex 0.
+func bruteMinCut(n int, edges []Edge) int {
+	best := -1
+	for mask := 1; mask < 1<<(n-1); mask++ {
+		side := make([]bool, n)
+		side[0] = true
+		for v := 1; v < n; v++ {
+			side[v] = mask&(1<<(v-1)) == 0
+		}
+		if w := cutWeight(edges, side); best < 0 || w < best {
+			best = w
+		}
+	}
+	return best
+}
+
+// checkCut reports whether side is a proper side of a cut of the given
+// weight.
+func checkCut(n int, edges []Edge, weight int, side []int) bool {
+	if len(side) == 0 || len(side) == n {
+		return false
+	}
+	in := make([]bool, n)
+	for _, v := range side {
+		in[v] = true
+	}
+	return cutWeight(edges, in) == weight
+}
+
+func TestMinCut(t *testing.T) {
+	// the Stoer-Wagner paper example, whose minimum cut of weight 4 splits
+	// {2, 3, 6, 7} from the rest, numbered from 0
+	edges := []Edge{
+		{0, 1, 2}, {0, 4, 3}, {1, 2, 3}, {1, 4, 2}, {1, 5, 2}, {2, 3, 4},
+		{2, 6, 2}, {3, 6, 2}, {3, 7, 2}, {4, 5, 3}, {5, 6, 1}, {6, 7, 3},
+	}
+	weight, side, err := StoerWagnerMinCut(8, edges)
+	if err != nil || weight != 4 || !reflect.DeepEqual(side, []int{2, 3, 6, 7}) && !reflect.DeepEqual(side, []int{0, 1, 4, 5}) {
+		t.Errorf("StoerWagnerMinCut = %d, %v, %v, want 4, [2 3 6 7]", weight, side, err)
+	}
+	weight, side, err = KargerMinCut(8, edges, 100, 1)
+	if err != nil || weight != 4 || !reflect.DeepEqual(side, []int{0, 1, 4, 5}) {
+		t.Errorf("KargerMinCut = %d, %v, %v, want 4, [0 1 4 5]", weight, side, err)
+	}
+	// a disconnected graph has a cut of weight 0
+	weight, side, _ = StoerWagnerMinCut(4, []Edge{{0, 1, 5}, {2, 3, 1}})
+	if weight != 0 || !checkCut(4, []Edge{{0, 1, 5}, {2, 3, 1}}, 0, side) {
+		t.Errorf("StoerWagnerMinCut of a disconnected graph = %d, %v", weight, side)
+	}
+	if _, _, err := StoerWagnerMinCut(1, nil); err == nil {
+		t.Errorf("StoerWagnerMinCut of one vertex should fail")
+	}
+	if _, _, err := KargerMinCut(3, []Edge{{0, 3, 1}}, 1, 1); err == nil {
+		t.Errorf("KargerMinCut with an edge out of range should fail")
+	}
+	if _, _, err := KargerMinCut(3, []Edge{{0, 1, -1}}, 1, 1); err == nil {
+		t.Errorf("KargerMinCut with a negative weight should fail")
+	}
+	if _, _, err := KargerMinCut(3, nil, 0, 1); err == nil {
+		t.Errorf("KargerMinCut without trials should fail")
+	}
+}
+
+func TestMinCutRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for iter := 0; iter < 200; iter++ {
+		n := 2 + rnd.Intn(7)
+		var edges []Edge
+		for i := rnd.Intn(3 * n); i >= 0; i-- {
+			edges = append(edges, Edge{Vertex(rnd.Intn(n)), Vertex(rnd.Intn(n)), rnd.Intn(5)})
+		}
+		want := bruteMinCut(n, edges)
+		sw, swSide, err := StoerWagnerMinCut(n, edges)
+		if err != nil || sw != want || !checkCut(n, edges, sw, swSide) {
+			t.Fatalf("StoerWagnerMinCut(%d, %v) = %d, %v, %v, want %d", n, edges, sw, swSide, err, want)
+		}
+		karger, kargerSide, err := KargerMinCut(n, edges, 4*n*n, int64(iter))
+		if err != nil || karger != sw || !checkCut(n, edges, karger, kargerSide) {
+			t.Fatalf("KargerMinCut(%d, %v) = %d, %v, %v, Stoer-Wagner found %d", n, edges, karger, kargerSide, err, sw)
+		}
+	}
+}
+
+func BenchmarkStoerWagnerMinCut(b *testing.B) {
+	edges := randomCutEdges(100, 1000)
+	for i := 0; i < b.N; i++ {
+		_, _, _ = StoerWagnerMinCut(100, edges)
+	}
+}
+
+func BenchmarkKargerMinCut(b *testing.B) {
+	edges := randomCutEdges(100, 1000)
+	for i := 0; i < b.N; i++ {
+		_, _, _ = KargerMinCut(100, edges, 100, int64(i))
+	}
+}
+
+func randomCutEdges(n, m int) []Edge {
+	rnd := rand.New(rand.NewSource(1))
+	edges := make([]Edge, m)
+	for i := range edges {
+		edges[i] = Edge{Vertex(rnd.Intn(n)), Vertex(rnd.Intn(n)), 1 + rnd.Intn(10)}
+	}
+	return edges
+}
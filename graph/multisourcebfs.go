@@ -0,0 +1,45 @@
+// multisourcebfs.go
+// description: Breadth-first search from several sources at once
+// details:
+// Starting a breadth-first search with all the sources in the queue at
+// distance 0 explores the graph by increasing distance to the nearest
+// source, as if from a virtual vertex with an edge to every source, in one
+// pass instead of one search per source. Each vertex also records the
+// source it was reached from: the vertices are queued level by level and,
+// within a level, in the order of the sources they come from, so among
+// equally near sources the one listed first wins. Typical uses are the
+// distance to the nearest exit, hospital or obstacle on a grid or a map.
+// Edges are followed in their direction, and weights are ignored.
+// time complexity: O(V + E log E)
+// space complexity: O(V)
+// reference: https://cp-algorithms.com/graph/breadth-first-search.html
+// see multisourcebfs_test.go
+
+package graph
+
+// MultiSourceBFS returns, for every vertex reachable from one of the
+// sources, the number of edges to the nearest source and that source. A
+// vertex at the same distance from several sources is given the one that
+// comes first in sources.
+func (g *Graph) MultiSourceBFS(sources []int) (distance map[int]int, nearest map[int]int) {
+	distance, nearest = map[int]int{}, map[int]int{}
+	var queue []int
+	for _, s := range sources {
+		if _, ok := distance[s]; ok {
+			continue
+		}
+		distance[s], nearest[s] = 0, s
+		queue = append(queue, s)
+	}
+	for len(queue) > 0 {
+		u := queue[0]
+		queue = queue[1:]
+		for _, v := range g.neighbours(u) {
+			if _, ok := distance[v]; !ok {
+				distance[v], nearest[v] = distance[u]+1, nearest[u]
+				queue = append(queue, v)
+			}
+		}
+	}
+	return distance, nearest
+}
@@ -0,0 +1,55 @@
+package graph
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestMultiSourceBFS(t *testing.T) {
+	// the path 0 - 1 - 2 - 3 - 4 - 5 and an isolated vertex 6
+	g := New(7)
+	for v := 0; v < 5; v++ {
+		g.AddEdge(v, v+1)
+	}
+	g.AddVertex(6)
+	distance, nearest := g.MultiSourceBFS([]int{4, 0})
+	wantDistance := map[int]int{0: 0, 1: 1, 2: 2, 3: 1, 4: 0, 5: 1}
+	// 2 is as near to 0 as to 4, and 4 is listed first
+	wantNearest := map[int]int{0: 0, 1: 0, 2: 4, 3: 4, 4: 4, 5: 4}
+	if !reflect.DeepEqual(distance, wantDistance) || !reflect.DeepEqual(nearest, wantNearest) {
+		t.Errorf("MultiSourceBFS() = %v, %v, want %v, %v", distance, nearest, wantDistance, wantNearest)
+	}
+	if distance, _ := g.MultiSourceBFS(nil); len(distance) != 0 {
+		t.Errorf("MultiSourceBFS without sources reached %v", distance)
+	}
+}
+
+func TestMultiSourceBFSRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		g := New(30)
+		g.Directed = rnd.Intn(2) == 0
+		for e := 0; e < 50; e++ {
+			g.AddEdge(rnd.Intn(30), rnd.Intn(30))
+		}
+		sources := []int{rnd.Intn(30), rnd.Intn(30), rnd.Intn(30)}
+		distance, nearest := g.MultiSourceBFS(sources)
+		// compare with one search per source
+		single := make([]map[int]int, len(sources))
+		for k, s := range sources {
+			single[k], _ = g.MultiSourceBFS([]int{s})
+		}
+		for v := 0; v < 30; v++ {
+			best, from := -1, -1
+			for k, s := range sources {
+				if d, ok := single[k][v]; ok && (best == -1 || d < best) {
+					best, from = d, s
+				}
+			}
+			if d, ok := distance[v]; best == -1 && ok || best != -1 && (d != best || nearest[v] != from) {
+				t.Fatalf("vertex %d: distance %d from %d, want %d from %d", v, d, nearest[v], best, from)
+			}
+		}
+	}
+}
@@ -0,0 +1,101 @@
+// parallelbfs.go
+// description: Level-synchronous parallel breadth-first search on a FrozenGraph
+// details:
+// Breadth-first search visits the graph one level at a time: the frontier is
+// the set of vertices at distance d, and the next frontier gathers their
+// unvisited neighbours. The vertices of a frontier can be expanded in any
+// order, so the frontier is split between goroutines. A vertex is claimed by
+// atomically swapping its distance from -1 to d+1, which makes exactly one
+// goroutine add it to the next frontier even when several reach it at once.
+// Each goroutine collects its part of the next frontier locally, and the parts
+// are concatenated once the level is done.
+// time complexity: O(V + E) work, O(D) synchronization steps for a graph of diameter D
+// space complexity: O(V)
+// reference: https://en.wikipedia.org/wiki/Parallel_breadth-first_search
+// see parallelbfs_test.go
+
+package graph
+
+import (
+	"errors"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// minChunk is the smallest number of vertices handed to one goroutine, below
+// which starting a goroutine costs more than it saves.
+const minChunk = 256
+
+// parallelChunks splits [0, n) into at most workers contiguous chunks of at
+// least minChunk items, runs f on each in its own goroutine and waits for all
+// of them. f receives the bounds of its chunk and its index.
+func parallelChunks(n, workers int, f func(lo, hi, chunk int)) int {
+	chunks := (n + minChunk - 1) / minChunk
+	if chunks > workers {
+		chunks = workers
+	}
+	if chunks <= 1 {
+		f(0, n, 0)
+		return 1
+	}
+	var wg sync.WaitGroup
+	for c := 0; c < chunks; c++ {
+		wg.Add(1)
+		go func(c int) {
+			defer wg.Done()
+			f(c*n/chunks, (c+1)*n/chunks, c)
+		}(c)
+	}
+	wg.Wait()
+	return chunks
+}
+
+// BreadthFirstSearch returns the number of edges on a shortest path from start
+// to every vertex, or -1 for the vertices that cannot be reached.
+func (f *FrozenGraph) BreadthFirstSearch(start int) []int {
+	return BreadthFirstSearchCSR(f.adjacency, start)
+}
+
+// ParallelBFS computes the same distances as BreadthFirstSearch with up to
+// workers goroutines, or one per CPU when workers is not positive. It fails
+// if start is not a vertex of f.
+func (f *FrozenGraph) ParallelBFS(start, workers int) ([]int, error) {
+	if start < 0 || start >= f.Vertices() {
+		return nil, errors.New("start vertex out of range")
+	}
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	dist := make([]int64, f.Vertices())
+	for i := range dist {
+		dist[i] = -1
+	}
+	dist[start] = 0
+	frontier := []int{start}
+	next := make([][]int, workers)
+	for level := int64(1); len(frontier) > 0; level++ {
+		used := parallelChunks(len(frontier), workers, func(lo, hi, chunk int) {
+			local := next[chunk][:0]
+			for _, u := range frontier[lo:hi] {
+				targets, _ := f.Neighbors(u)
+				for _, v := range targets {
+					if atomic.LoadInt64(&dist[v]) == -1 && atomic.CompareAndSwapInt64(&dist[v], -1, level) {
+						local = append(local, v)
+					}
+				}
+			}
+			next[chunk] = local
+		})
+		frontier = frontier[:0:0]
+		for _, local := range next[:used] {
+			frontier = append(frontier, local...)
+		}
+	}
+
+	result := make([]int, len(dist))
+	for i, d := range dist {
+		result[i] = int(d)
+	}
+	return result, nil
+}
@@ -0,0 +1,58 @@
+package graph
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestParallelBFS(t *testing.T) {
+	for _, directed := range []bool{false, true} {
+		// Enough vertices for the frontiers to be split between goroutines.
+		f := freeze(t, randomGraph(3, 5000, 12000, directed))
+		want := f.BreadthFirstSearch(0)
+		for _, workers := range []int{0, 1, 3, 8} {
+			if got, err := f.ParallelBFS(0, workers); err != nil || !reflect.DeepEqual(got, want) {
+				t.Errorf("directed %v, %d workers: ParallelBFS differs from BreadthFirstSearch", directed, workers)
+			}
+		}
+	}
+
+	var g Graph
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	g.AddVertex(3)
+	f := freeze(t, &g)
+	if got, err := f.ParallelBFS(2, 2); err != nil || !reflect.DeepEqual(got, []int{2, 1, 0, -1}) {
+		t.Errorf("ParallelBFS(2) = %v, %v, want [2 1 0 -1]", got, err)
+	}
+	for _, start := range []int{-1, 4} {
+		if _, err := f.ParallelBFS(start, 2); err == nil {
+			t.Errorf("ParallelBFS(%d) should reject a start out of range", start)
+		}
+	}
+}
+
+func benchmarkWorkers(b *testing.B, run func(workers int)) {
+	for _, workers := range []int{1, 2, 4, 8} {
+		b.Run(strconv.Itoa(workers), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				run(workers)
+			}
+		})
+	}
+}
+
+func BenchmarkBreadthFirstSearchFrozen(b *testing.B) {
+	f := freeze(b, randomGraph(1, 200_000, 1_000_000, false))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		f.BreadthFirstSearch(0)
+	}
+}
+
+func BenchmarkParallelBFS(b *testing.B) {
+	f := freeze(b, randomGraph(1, 200_000, 1_000_000, false))
+	b.ResetTimer()
+	benchmarkWorkers(b, func(workers int) { _, _ = f.ParallelBFS(0, workers) })
+}
@@ -0,0 +1,276 @@
+// spectral.go
+// description: Adjacency and Laplacian matrices of a graph and power iteration
+// details:
+// Spectral methods read the structure of a graph off the eigenvectors of its
+// matrices. The adjacency matrix A has a 1 for every edge, and the Laplacian
+// L = D - A subtracts it from the diagonal matrix D of the degrees; both are
+// built over vertices 0 to V-1, like CSR, from the undirected view of the
+// graph for the Laplacian.
+// Power iteration finds the dominant eigenvector of a matrix M by multiplying
+// a start vector by M and normalizing it until it stops changing; it
+// converges at the rate of the ratio of the two largest eigenvalues in
+// absolute value. Adding a shift s to the diagonal, iterating with M + sI,
+// keeps that ratio below 1 for matrices such as the adjacency matrix of a
+// bipartite graph, whose largest eigenvalues are l and -l.
+// - the eigenvector centrality of a vertex is its entry in the dominant
+// eigenvector of A: a vertex is central when its neighbours are,
+// - the Fiedler vector, the eigenvector of the second smallest eigenvalue
+// of L, splits a connected graph into two loosely connected halves by the
+// signs of its entries. It is the dominant eigenvector of cI - L, for c at
+// least the largest eigenvalue of L, once the constant vector, the
+// eigenvector of the smallest one, is projected out at every step.
+// time complexity: O(k (V + E)) for k iterations
+// space complexity: O(V + E)
+// reference: https://en.wikipedia.org/wiki/Power_iteration
+// reference: https://en.wikipedia.org/wiki/Laplacian_matrix
+// reference: https://en.wikipedia.org/wiki/Algebraic_connectivity#The_Fiedler_vector
+// see spectral_test.go
+
+package graph
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+
+	"github.com/TheAlgorithms/Go/math/matrix"
+	"github.com/TheAlgorithms/Go/math/matrix/sparse"
+)
+
+// ErrNoConvergence is returned when power iteration does not converge within
+// the allowed number of iterations.
+var ErrNoConvergence = errors.New("power iteration did not converge")
+
+// order returns the number of rows of the matrices of g: the vertex count
+// given to New or one more than the largest vertex, whichever is larger.
+func (g *Graph) order() int {
+	n := g.vertices
+	for v := range g.edges {
+		if v >= n {
+			n = v + 1
+		}
+	}
+	return n
+}
+
+// unitEntries returns the entries of the adjacency matrix of g with a 1 for
+// every edge.
+func (g *Graph) unitEntries() []sparse.Entry[int] {
+	var entries []sparse.Entry[int]
+	for u, neighbours := range g.edges {
+		for v := range neighbours {
+			entries = append(entries, sparse.Entry[int]{Row: u, Column: v, Value: 1})
+		}
+	}
+	return entries
+}
+
+// laplacianEntries returns the entries of the Laplacian matrix of g,
+// ignoring self-loops and direction, and the largest degree.
+func (g *Graph) laplacianEntries() ([]sparse.Entry[int], int) {
+	u := g.undirected()
+	var entries []sparse.Entry[int]
+	maxDegree := 0
+	for v, neighbours := range u.edges {
+		degree := 0
+		for w := range neighbours {
+			if w != v {
+				entries = append(entries, sparse.Entry[int]{Row: v, Column: w, Value: -1})
+				degree++
+			}
+		}
+		entries = append(entries, sparse.Entry[int]{Row: v, Column: v, Value: degree})
+		if degree > maxDegree {
+			maxDegree = degree
+		}
+	}
+	return entries, maxDegree
+}
+
+// dense returns the n x n matrix holding entries.
+func dense(n int, entries []sparse.Entry[int]) matrix.Matrix[int] {
+	elements := make([][]int, n)
+	for i := range elements {
+		elements[i] = make([]int, n)
+	}
+	for _, e := range entries {
+		elements[e.Row][e.Column] += e.Value
+	}
+	m, _ := matrix.NewFromElements(elements)
+	return m
+}
+
+// Adjacency returns the adjacency matrix of g, with a 1 for every edge
+// whatever its weight. CSR gives the weights instead.
+func (g *Graph) Adjacency() matrix.Matrix[int] {
+	return dense(g.order(), g.unitEntries())
+}
+
+// Laplacian returns the Laplacian matrix of g, the degrees on the diagonal
+// minus the adjacency matrix, ignoring self-loops and the direction of the
+// edges.
+func (g *Graph) Laplacian() matrix.Matrix[int] {
+	entries, _ := g.laplacianEntries()
+	return dense(g.order(), entries)
+}
+
+// LaplacianCSR returns the Laplacian matrix of g in compressed sparse row
+// format.
+func (g *Graph) LaplacianCSR() *sparse.CSR[int] {
+	entries, _ := g.laplacianEntries()
+	n := g.order()
+	laplacian, _ := sparse.NewCSR(n, n, entries)
+	return laplacian
+}
+
+// mulShifted sets y to (m + shift I) x.
+func mulShifted(m *sparse.CSR[int], shift float64, x, y []float64) {
+	for i := range y {
+		sum := shift * x[i]
+		columns, values := m.Row(i)
+		for k, j := range columns {
+			sum += float64(values[k]) * x[j]
+		}
+		y[i] = sum
+	}
+}
+
+// normalize scales x to unit length with its largest entry in absolute value
+// positive, and returns its former length.
+func normalize(x []float64) float64 {
+	length, largest := 0.0, 0.0
+	for _, v := range x {
+		length += v * v
+		if math.Abs(v) > math.Abs(largest) {
+			largest = v
+		}
+	}
+	length = math.Sqrt(length)
+	if length == 0 {
+		return 0
+	}
+	scale := 1 / length
+	if largest < 0 {
+		scale = -scale
+	}
+	for i := range x {
+		x[i] *= scale
+	}
+	return length
+}
+
+// powerIteration returns the dominant eigenvector of m + shift I, restricted
+// to the vectors left by project if it is not nil, with its eigenvalue, or
+// the last estimates of both with ErrNoConvergence.
+func powerIteration(m *sparse.CSR[int], shift float64, project func([]float64), maxIterations int, tolerance float64) ([]float64, float64, error) {
+	n := m.Rows()
+	x, y := make([]float64, n), make([]float64, n)
+	rnd := rand.New(rand.NewSource(1))
+	for i := range x {
+		x[i] = 1 + rnd.Float64()
+	}
+	if project != nil {
+		project(x)
+	}
+	normalize(x)
+	value := 0.0
+	for it := 0; it < maxIterations; it++ {
+		mulShifted(m, shift, x, y)
+		if project != nil {
+			project(y)
+		}
+		// x has unit length, so the Rayleigh quotient is x . y
+		value = 0
+		for i := range x {
+			value += x[i] * y[i]
+		}
+		if normalize(y) == 0 {
+			return y, 0, nil
+		}
+		change := 0.0
+		for i := range x {
+			change += (y[i] - x[i]) * (y[i] - x[i])
+		}
+		x, y = y, x
+		if math.Sqrt(change) <= tolerance {
+			return x, value, nil
+		}
+	}
+	return x, value, ErrNoConvergence
+}
+
+// PowerIteration returns the dominant eigenvector of the square matrix m,
+// of unit length with its largest entry positive, and its eigenvalue. It
+// iterates with m + shift I, a positive shift making the iteration converge
+// when the two largest eigenvalues of m have opposite signs and the same
+// absolute value, and stops when the vector changes by at most tolerance.
+// It returns ErrNoConvergence after maxIterations iterations otherwise.
+func PowerIteration(m *sparse.CSR[int], shift float64, maxIterations int, tolerance float64) ([]float64, float64, error) {
+	if m.Rows() != m.Columns() {
+		return nil, 0, errors.New("power iteration needs a square matrix")
+	}
+	if m.Rows() == 0 {
+		return nil, 0, nil
+	}
+	x, value, err := powerIteration(m, shift, nil, maxIterations, tolerance)
+	return x, value - shift, err
+}
+
+// EigenvectorCentrality returns the eigenvector centrality of the vertices
+// 0 to V-1 of g, ignoring the direction and the weights of the edges, as a
+// vector of unit length.
+func (g *Graph) EigenvectorCentrality(maxIterations int, tolerance float64) ([]float64, error) {
+	u := g.undirected()
+	n := g.order()
+	adjacency, _ := sparse.NewCSR(n, n, u.unitEntries())
+	centrality, _, err := PowerIteration(adjacency, 1, maxIterations, tolerance)
+	return centrality, err
+}
+
+// FiedlerVector returns the eigenvector of the second smallest eigenvalue
+// of the Laplacian of g, of unit length, and that eigenvalue, the algebraic
+// connectivity of g, which is 0 when g is not connected.
+func (g *Graph) FiedlerVector(maxIterations int, tolerance float64) ([]float64, float64, error) {
+	entries, maxDegree := g.laplacianEntries()
+	n := g.order()
+	if n < 2 {
+		return nil, 0, errors.New("the Fiedler vector needs at least two vertices")
+	}
+	// the eigenvalues of the Laplacian are at most twice the largest degree
+	c := float64(2*maxDegree + 1)
+	for i := range entries {
+		entries[i].Value = -entries[i].Value
+	}
+	negated, _ := sparse.NewCSR(n, n, entries)
+	removeMean := func(x []float64) {
+		mean := 0.0
+		for _, v := range x {
+			mean += v
+		}
+		mean /= float64(len(x))
+		for i := range x {
+			x[i] -= mean
+		}
+	}
+	x, value, err := powerIteration(negated, c, removeMean, maxIterations, tolerance)
+	return x, c - value, err
+}
+
+// SpectralBisection splits the vertices 0 to V-1 of g in two by the signs of
+// the entries of its Fiedler vector, giving two sets with few edges between
+// them. Both sets are in increasing order.
+func (g *Graph) SpectralBisection(maxIterations int, tolerance float64) ([]int, []int, error) {
+	fiedler, _, err := g.FiedlerVector(maxIterations, tolerance)
+	if err != nil {
+		return nil, nil, err
+	}
+	var negative, positive []int
+	for v, x := range fiedler {
+		if x < 0 {
+			negative = append(negative, v)
+		} else {
+			positive = append(positive, v)
+		}
+	}
+	return negative, positive, nil
+}
@@ -0,0 +1,128 @@
+package graph
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/math/matrix/sparse"
+)
+
+// elements returns the rows of a matrix with Get.
+func elements(m interface {
+	Rows() int
+	Columns() int
+	Get(row, col int) (int, error)
+}) [][]int {
+	rows := make([][]int, m.Rows())
+	for i := range rows {
+		rows[i] = make([]int, m.Columns())
+		for j := range rows[i] {
+			rows[i][j], _ = m.Get(i, j)
+		}
+	}
+	return rows
+}
+
+func TestAdjacencyAndLaplacian(t *testing.T) {
+	g := New(4)
+	g.AddWeightedEdge(0, 1, 5)
+	g.AddEdge(1, 2)
+	g.AddEdge(2, 0)
+	g.AddEdge(2, 2)
+	wantAdjacency := [][]int{{0, 1, 1, 0}, {1, 0, 1, 0}, {1, 1, 1, 0}, {0, 0, 0, 0}}
+	if got := elements(g.Adjacency()); !reflect.DeepEqual(got, wantAdjacency) {
+		t.Errorf("Adjacency() = %v, want %v", got, wantAdjacency)
+	}
+	wantLaplacian := [][]int{{2, -1, -1, 0}, {-1, 2, -1, 0}, {-1, -1, 2, 0}, {0, 0, 0, 0}}
+	if got := elements(g.Laplacian()); !reflect.DeepEqual(got, wantLaplacian) {
+		t.Errorf("Laplacian() = %v, want %v", got, wantLaplacian)
+	}
+	if got := g.LaplacianCSR().Dense(); !reflect.DeepEqual(got, wantLaplacian) {
+		t.Errorf("LaplacianCSR() = %v, want %v", got, wantLaplacian)
+	}
+
+	// the Laplacian ignores the direction of the edges
+	d := New(2)
+	d.Directed = true
+	d.AddEdge(0, 1)
+	if got, want := elements(d.Laplacian()), [][]int{{1, -1}, {-1, 1}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Laplacian() of a directed edge = %v, want %v", got, want)
+	}
+}
+
+func TestPowerIteration(t *testing.T) {
+	m, _ := sparse.CSRFromDense([][]int{{2, 1}, {1, 2}})
+	x, value, err := PowerIteration(m, 0, 1000, 1e-12)
+	if err != nil || math.Abs(value-3) > 1e-9 || math.Abs(x[0]-math.Sqrt2/2) > 1e-6 || math.Abs(x[1]-x[0]) > 1e-6 {
+		t.Errorf("PowerIteration = %v, %v, %v, want (1, 1)/sqrt(2), 3", x, value, err)
+	}
+
+	// the path 0 - 1 - 2 is bipartite, with eigenvalues sqrt(2) and -sqrt(2)
+	path := New(3)
+	path.AddEdge(0, 1)
+	path.AddEdge(1, 2)
+	adjacency, _ := sparse.NewCSR(3, 3, path.unitEntries())
+	if _, _, err := PowerIteration(adjacency, 0, 100, 1e-9); err != ErrNoConvergence {
+		t.Errorf("PowerIteration without shift on a bipartite graph = %v, want ErrNoConvergence", err)
+	}
+	if _, value, err := PowerIteration(adjacency, 1, 1000, 1e-12); err != nil || math.Abs(value-math.Sqrt2) > 1e-9 {
+		t.Errorf("PowerIteration with shift = %v, %v, want sqrt(2)", value, err)
+	}
+}
+
+func TestEigenvectorCentrality(t *testing.T) {
+	// a star with centre 0 and a leaf 4 holding a tail 5
+	g := New(6)
+	for v := 1; v <= 4; v++ {
+		g.AddEdge(0, v)
+	}
+	g.AddEdge(4, 5)
+	centrality, err := g.EigenvectorCentrality(1000, 1e-12)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for v := 1; v < 6; v++ {
+		if centrality[v] >= centrality[0] {
+			t.Errorf("vertex %d is as central as the centre: %v", v, centrality)
+		}
+	}
+	if centrality[4] <= centrality[1] || centrality[5] >= centrality[1] {
+		t.Errorf("centrality %v does not rank 4 above the other leaves above 5", centrality)
+	}
+}
+
+func TestSpectralBisection(t *testing.T) {
+	// two cliques of five vertices joined by the edge 4 - 5
+	g := New(10)
+	for _, base := range []int{0, 5} {
+		for u := base; u < base+5; u++ {
+			for v := u + 1; v < base+5; v++ {
+				g.AddEdge(u, v)
+			}
+		}
+	}
+	g.AddEdge(4, 5)
+	a, b, err := g.SpectralBisection(10000, 1e-10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a[0] != 0 {
+		a, b = b, a
+	}
+	if !reflect.DeepEqual(a, []int{0, 1, 2, 3, 4}) || !reflect.DeepEqual(b, []int{5, 6, 7, 8, 9}) {
+		t.Errorf("SpectralBisection() = %v, %v", a, b)
+	}
+
+	// the algebraic connectivity of a path of n vertices is 2 - 2 cos(pi / n)
+	path := New(6)
+	for v := 0; v < 5; v++ {
+		path.AddEdge(v, v+1)
+	}
+	if _, value, err := path.FiedlerVector(10000, 1e-12); err != nil || math.Abs(value-(2-2*math.Cos(math.Pi/6))) > 1e-6 {
+		t.Errorf("FiedlerVector() of a path = %v, %v, want %v", value, err, 2-2*math.Cos(math.Pi/6))
+	}
+	if _, _, err := New(1).FiedlerVector(10, 1e-9); err == nil {
+		t.Errorf("FiedlerVector() of one vertex should fail")
+	}
+}
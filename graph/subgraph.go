@@ -0,0 +1,154 @@
+// subgraph.go
+// description: Subgraph extraction, reversal, union and intersection of graphs
+// details:
+// These functions build new graphs out of existing ones without modifying
+// them, so graphs can be transformed step by step. Vertices keep their
+// numbers in the results. An undirected edge is treated as a single edge
+// between its two endpoints.
+// time complexity: O(V + E) for every operation
+// space complexity: O(V + E)
+// reference: https://en.wikipedia.org/wiki/Glossary_of_graph_theory#subgraph
+// see subgraph_test.go
+
+package graph
+
+import (
+	"errors"
+	"sort"
+)
+
+// ErrDirectionMismatch is returned when combining a directed graph with an
+// undirected one.
+var ErrDirectionMismatch = errors.New("cannot combine directed and undirected graphs")
+
+// empty returns a graph with no vertices of the same kind as g.
+func (g *Graph) empty() *Graph {
+	return &Graph{vertices: g.vertices, Directed: g.Directed}
+}
+
+// hasEdge reports whether g has an edge from one to two and its weight.
+func (g *Graph) hasEdge(one, two int) (int, bool) {
+	weight, ok := g.edges[one][two]
+	return weight, ok
+}
+
+// vertexList returns the vertices of g in increasing order.
+func (g *Graph) vertexList() []int {
+	vertices := make([]int, 0, len(g.edges))
+	for v := range g.edges {
+		vertices = append(vertices, v)
+	}
+	sort.Ints(vertices)
+	return vertices
+}
+
+// edgeList returns the edges of g ordered by start then end vertex. An
+// undirected edge is listed once, from its smaller endpoint.
+func (g *Graph) edgeList() []Edge {
+	var edges []Edge
+	for u, neighbours := range g.edges {
+		for v, weight := range neighbours {
+			if !g.Directed && v < u {
+				continue
+			}
+			edges = append(edges, Edge{Start: Vertex(u), End: Vertex(v), Weight: weight})
+		}
+	}
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i].Start != edges[j].Start {
+			return edges[i].Start < edges[j].Start
+		}
+		return edges[i].End < edges[j].End
+	})
+	return edges
+}
+
+// InducedSubgraph returns the subgraph of g made of the vertices for which
+// keep returns true and every edge of g between two of them.
+func (g *Graph) InducedSubgraph(keep func(v int) bool) *Graph {
+	sub := g.empty()
+	for v := range g.edges {
+		if keep(v) {
+			sub.AddVertex(v)
+		}
+	}
+	for u, neighbours := range g.edges {
+		if !keep(u) {
+			continue
+		}
+		for v, weight := range neighbours {
+			if keep(v) {
+				sub.AddWeightedEdge(u, v, weight)
+			}
+		}
+	}
+	return sub
+}
+
+// Subgraph returns the subgraph of g induced by the given vertices. Vertices
+// that are not in g are ignored.
+func (g *Graph) Subgraph(nodes []int) *Graph {
+	set := make(map[int]bool, len(nodes))
+	for _, v := range nodes {
+		set[v] = true
+	}
+	return g.InducedSubgraph(func(v int) bool { return set[v] })
+}
+
+// Reverse returns g with the direction of every edge flipped. The reverse of
+// an undirected graph is a copy of it.
+func (g *Graph) Reverse() *Graph {
+	reversed := g.empty()
+	for u, neighbours := range g.edges {
+		reversed.AddVertex(u)
+		for v, weight := range neighbours {
+			reversed.AddWeightedEdge(v, u, weight)
+		}
+	}
+	return reversed
+}
+
+// Union returns a graph with the vertices and edges found in g or in other.
+// When both have the same edge, the weight from g is kept.
+func (g *Graph) Union(other *Graph) (*Graph, error) {
+	if g.Directed != other.Directed {
+		return nil, ErrDirectionMismatch
+	}
+	union := g.empty()
+	if other.vertices > union.vertices {
+		union.vertices = other.vertices
+	}
+	for _, source := range []*Graph{other, g} {
+		for u, neighbours := range source.edges {
+			union.AddVertex(u)
+			for v, weight := range neighbours {
+				union.AddWeightedEdge(u, v, weight)
+			}
+		}
+	}
+	return union, nil
+}
+
+// Intersect returns a graph with the vertices and edges found in both g and
+// other. The weights of the edges are taken from g.
+func (g *Graph) Intersect(other *Graph) (*Graph, error) {
+	if g.Directed != other.Directed {
+		return nil, ErrDirectionMismatch
+	}
+	common := g.empty()
+	if other.vertices < common.vertices {
+		common.vertices = other.vertices
+	}
+	for u, neighbours := range g.edges {
+		if _, ok := other.edges[u]; !ok {
+			continue
+		}
+		common.AddVertex(u)
+		for v, weight := range neighbours {
+			if _, ok := other.hasEdge(u, v); ok {
+				common.AddWeightedEdge(u, v, weight)
+			}
+		}
+	}
+	return common, nil
+}
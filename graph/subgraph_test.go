@@ -0,0 +1,84 @@
+package graph
+
+import (
+	"reflect"
+	"testing"
+)
+
+// buildGraph returns a graph with the given {from, to, weight} edges.
+func buildGraph(directed bool, edges [][]int) *Graph {
+	g := &Graph{Directed: directed}
+	for _, e := range edges {
+		g.AddWeightedEdge(e[0], e[1], e[2])
+	}
+	return g
+}
+
+func TestSubgraph(t *testing.T) {
+	g := buildGraph(false, [][]int{{0, 1, 4}, {1, 2, 3}, {2, 3, 1}, {3, 0, 2}, {1, 3, 9}})
+
+	sub := g.Subgraph([]int{1, 2, 3, 7})
+	if got, want := sub.edgeList(), []Edge{{1, 2, 3}, {1, 3, 9}, {2, 3, 1}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Subgraph edges = %v, want %v", got, want)
+	}
+	if got, want := sub.vertexList(), []int{1, 2, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Subgraph vertices = %v, want %v", got, want)
+	}
+
+	even := g.InducedSubgraph(func(v int) bool { return v%2 == 0 })
+	if got, want := even.vertexList(), []int{0, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("InducedSubgraph vertices = %v, want %v", got, want)
+	}
+	if got := even.edgeList(); len(got) != 0 {
+		t.Errorf("InducedSubgraph edges = %v, want none", got)
+	}
+	if len(g.edgeList()) != 5 {
+		t.Errorf("the original graph was modified")
+	}
+}
+
+func TestReverse(t *testing.T) {
+	g := buildGraph(true, [][]int{{0, 1, 4}, {1, 2, 3}, {2, 0, 1}})
+	g.AddVertex(5)
+	r := g.Reverse()
+	if got, want := r.edgeList(), []Edge{{0, 2, 1}, {1, 0, 4}, {2, 1, 3}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Reverse edges = %v, want %v", got, want)
+	}
+	if got, want := r.vertexList(), []int{0, 1, 2, 5}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Reverse vertices = %v, want %v", got, want)
+	}
+	if d, _ := g.Diff(r.Reverse()); !d.Empty() {
+		t.Errorf("reversing twice changed the graph: %+v", d)
+	}
+}
+
+func TestUnionIntersect(t *testing.T) {
+	a := buildGraph(true, [][]int{{0, 1, 1}, {1, 2, 2}})
+	b := buildGraph(true, [][]int{{1, 2, 7}, {2, 3, 3}})
+
+	union, err := a.Union(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := union.edgeList(), []Edge{{0, 1, 1}, {1, 2, 2}, {2, 3, 3}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Union edges = %v, want %v", got, want)
+	}
+
+	common, err := a.Intersect(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := common.edgeList(), []Edge{{1, 2, 2}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Intersect edges = %v, want %v", got, want)
+	}
+	if got, want := common.vertexList(), []int{1, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Intersect vertices = %v, want %v", got, want)
+	}
+
+	if _, err := a.Union(buildGraph(false, nil)); err != ErrDirectionMismatch {
+		t.Errorf("Union of directed and undirected graphs error = %v", err)
+	}
+	if _, err := a.Intersect(buildGraph(false, nil)); err != ErrDirectionMismatch {
+		t.Errorf("Intersect of directed and undirected graphs error = %v", err)
+	}
+}
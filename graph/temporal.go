@@ -0,0 +1,154 @@
+// temporal.go
+// description: Temporal graph whose edges exist only during a time interval
+// details:
+// In a temporal network, like a contact or flight network, an edge is only
+// usable while it is valid: here during the half-open interval [Start, End)
+// of integer times. SnapshotAt turns the edges valid at one moment into a
+// plain Graph, so every algorithm of the package runs on it. Reachability
+// must respect time instead: a journey uses its edges at times that never
+// decrease, so an edge that ended before the journey reached it cannot be
+// used, even if the static graph has a path. Edges are crossed instantly,
+// and the earliest time each vertex is reached is found like in Dijkstra's
+// algorithm: the journey waits at a vertex until an edge opens, and reaching
+// a vertex earlier never makes the rest of the journey later.
+// time complexity: O(E) for a snapshot, O((V + E) log E) for earliest arrival
+// space complexity: O(V + E)
+// reference: https://en.wikipedia.org/wiki/Temporal_network
+// reference: Wu et al., "Path Problems in Temporal Graphs", 2014
+// see temporal_test.go
+
+package graph
+
+import (
+	"errors"
+
+	"github.com/TheAlgorithms/Go/structure/heap"
+)
+
+// ErrInvalidInterval is returned for an interval that does not end after
+// it starts.
+var ErrInvalidInterval = errors.New("interval must end after it starts")
+
+// TemporalEdge is a weighted edge valid at the times from Start to End-1.
+type TemporalEdge struct {
+	From, To   int
+	Weight     int
+	Start, End int
+}
+
+// TemporalGraph stores edges that exist during an interval of time.
+// It is safe to use its empty object, which is undirected.
+type TemporalGraph struct {
+	vertices int
+	edges    []TemporalEdge
+	incident map[int][]int // the indices of the edges leaving each vertex
+	directed bool          // Differentiate directed/undirected graphs
+}
+
+// NewTemporal creates a temporal graph of v vertices, directed or not.
+// Whether it is directed cannot change once edges are added.
+func NewTemporal(v int, directed bool) *TemporalGraph {
+	return &TemporalGraph{vertices: v, directed: directed}
+}
+
+// Directed reports whether the edges of the graph are directed.
+func (g *TemporalGraph) Directed() bool {
+	return g.directed
+}
+
+// AddEdge adds an edge from one to two of the given weight, valid at the
+// times from start to end-1. Several edges may join the same vertices, and
+// their intervals may overlap.
+func (g *TemporalGraph) AddEdge(one, two, weight, start, end int) error {
+	if end <= start {
+		return ErrInvalidInterval
+	}
+	if g.incident == nil {
+		g.incident = make(map[int][]int)
+	}
+	g.edges = append(g.edges, TemporalEdge{From: one, To: two, Weight: weight, Start: start, End: end})
+	g.incident[one] = append(g.incident[one], len(g.edges)-1)
+	if !g.directed && one != two {
+		g.incident[two] = append(g.incident[two], len(g.edges)-1)
+	}
+	return nil
+}
+
+// Edges returns the edges of the graph in the order they were added.
+func (g *TemporalGraph) Edges() []TemporalEdge {
+	return append([]TemporalEdge(nil), g.edges...)
+}
+
+// SnapshotAt returns the graph of the edges valid at time t. Every vertex
+// with an edge at any time is in the snapshot, so snapshots at different
+// times have the same vertices. When several edges join the same vertices
+// at time t, the snapshot keeps the smallest weight.
+func (g *TemporalGraph) SnapshotAt(t int) *Graph {
+	s := &Graph{vertices: g.vertices, Directed: g.directed}
+	for _, e := range g.edges {
+		s.AddVertex(e.From)
+		s.AddVertex(e.To)
+	}
+	for _, e := range g.edges {
+		if t < e.Start || t >= e.End {
+			continue
+		}
+		if w, ok := s.hasEdge(e.From, e.To); ok && w <= e.Weight {
+			continue
+		}
+		s.AddWeightedEdge(e.From, e.To, e.Weight)
+	}
+	return s
+}
+
+// EarliestArrival returns the earliest time each vertex can be reached by a
+// journey leaving source at time from and arriving before time to. The
+// source is reached at time from, and vertices that cannot be reached
+// within the window are left out.
+func (g *TemporalGraph) EarliestArrival(source, from, to int) map[int]int {
+	type item struct{ node, time int }
+	arrival := make(map[int]int)
+	if from >= to {
+		return arrival
+	}
+	done := make(map[int]bool)
+	pq, _ := heap.NewAny(func(a, b item) bool { return a.time < b.time })
+	arrival[source] = from
+	pq.Push(item{source, from})
+	for !pq.Empty() {
+		curr := pq.Top()
+		pq.Pop()
+		// Stale entries are left in the queue instead of being updated.
+		if done[curr.node] {
+			continue
+		}
+		done[curr.node] = true
+		for _, i := range g.incident[curr.node] {
+			e := g.edges[i]
+			next := e.To
+			if next == curr.node {
+				next = e.From
+			}
+			// wait at the vertex until the edge opens
+			t := curr.time
+			if t < e.Start {
+				t = e.Start
+			}
+			if t >= e.End || t >= to || done[next] {
+				continue
+			}
+			if a, ok := arrival[next]; !ok || t < a {
+				arrival[next] = t
+				pq.Push(item{next, t})
+			}
+		}
+	}
+	return arrival
+}
+
+// ReachableWithin reports whether a journey leaving source at time from can
+// reach target before time to.
+func (g *TemporalGraph) ReachableWithin(source, target, from, to int) bool {
+	_, ok := g.EarliestArrival(source, from, to)[target]
+	return ok
+}
@@ -0,0 +1,149 @@
+package graph
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestTemporalSnapshot(t *testing.T) {
+	g := NewTemporal(4, false)
+	for _, e := range [][5]int{{0, 1, 5, 0, 10}, {1, 2, 3, 5, 8}, {0, 1, 2, 4, 6}, {2, 3, 1, 9, 12}} {
+		if err := g.AddEdge(e[0], e[1], e[2], e[3], e[4]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := g.AddEdge(0, 3, 1, 4, 4); !errors.Is(err, ErrInvalidInterval) {
+		t.Errorf("AddEdge with an empty interval error = %v", err)
+	}
+	if len(g.Edges()) != 4 {
+		t.Errorf("Edges() = %v, want 4 edges", g.Edges())
+	}
+
+	want := buildGraph(false, [][]int{{0, 1, 2}, {1, 2, 3}})
+	want.AddVertex(3)
+	want.vertices = 4
+	if got := g.SnapshotAt(5); !got.Equal(want) {
+		t.Errorf("SnapshotAt(5) = %v, want %v", got.edgeList(), want.edgeList())
+	}
+	want = buildGraph(false, [][]int{{2, 3, 1}})
+	want.AddVertex(0)
+	want.AddVertex(1)
+	want.vertices = 4
+	if got := g.SnapshotAt(10); !got.Equal(want) {
+		t.Errorf("SnapshotAt(10) = %v, want %v", got.edgeList(), want.edgeList())
+	}
+}
+
+func TestTemporalDirectedSnapshot(t *testing.T) {
+	// vertex 2 is only ever the target of an edge, and is in every snapshot
+	g := NewTemporal(3, true)
+	_ = g.AddEdge(0, 1, 1, 0, 2)
+	_ = g.AddEdge(1, 2, 4, 3, 5)
+	if !g.Directed() {
+		t.Error("Directed() = false for a directed graph")
+	}
+	want := buildGraph(true, [][]int{{0, 1, 1}})
+	want.AddVertex(2)
+	want.vertices = 3
+	if got := g.SnapshotAt(1); !got.Equal(want) {
+		t.Errorf("SnapshotAt(1) = %v, want %v", got.edgeList(), want.edgeList())
+	}
+}
+
+func TestTemporalReachability(t *testing.T) {
+	// a journey from 0 waits at 1 for the edge to 2 to open, and one from 2
+	// must leave before time 2 to catch the edge from 1 back to 0.
+	g := NewTemporal(3, true)
+	_ = g.AddEdge(0, 1, 0, 1, 3)
+	_ = g.AddEdge(1, 2, 0, 4, 6)
+	_ = g.AddEdge(2, 1, 0, 0, 2)
+	_ = g.AddEdge(1, 0, 0, 2, 3)
+
+	if got, want := g.EarliestArrival(0, 0, 10), map[int]int{0: 0, 1: 1, 2: 4}; !reflect.DeepEqual(got, want) {
+		t.Errorf("EarliestArrival(0, 0, 10) = %v, want %v", got, want)
+	}
+	if got, want := g.EarliestArrival(2, 0, 10), map[int]int{2: 0, 1: 0, 0: 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("EarliestArrival(2, 0, 10) = %v, want %v", got, want)
+	}
+	if g.ReachableWithin(0, 2, 0, 4) || !g.ReachableWithin(0, 2, 0, 5) {
+		t.Error("ReachableWithin(0, 2) should need the window to include time 4")
+	}
+	if g.ReachableWithin(0, 1, 3, 10) {
+		t.Error("ReachableWithin(0, 1, 3, 10) after the edge closed")
+	}
+	if g.ReachableWithin(2, 0, 2, 10) {
+		t.Error("ReachableWithin(2, 0, 2, 10) after the edge to 1 closed")
+	}
+	if len(g.EarliestArrival(0, 5, 5)) != 0 {
+		t.Error("EarliestArrival over an empty window should reach nothing")
+	}
+}
+
+// naiveArrival steps through the window one time at a time, spreading over
+// the edges valid at each time until nothing changes.
+func naiveArrival(g *TemporalGraph, source, from, to int) map[int]int {
+	arrival := map[int]int{source: from}
+	if from >= to {
+		return map[int]int{}
+	}
+	for t := from; t < to; t++ {
+		for changed := true; changed; {
+			changed = false
+			for _, e := range g.edges {
+				if t < e.Start || t >= e.End {
+					continue
+				}
+				for _, p := range [][2]int{{e.From, e.To}, {e.To, e.From}} {
+					if p != [2]int{e.From, e.To} && g.Directed() {
+						continue
+					}
+					if _, ok := arrival[p[0]]; !ok {
+						continue
+					}
+					if _, ok := arrival[p[1]]; !ok {
+						arrival[p[1]] = t
+						changed = true
+					}
+				}
+			}
+		}
+	}
+	return arrival
+}
+
+func TestTemporalRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for iter := 0; iter < 300; iter++ {
+		n := 1 + rnd.Intn(7)
+		g := NewTemporal(n, iter%2 == 0)
+		for i := rnd.Intn(15); i > 0; i-- {
+			start := rnd.Intn(12)
+			_ = g.AddEdge(rnd.Intn(n), rnd.Intn(n), rnd.Intn(5), start, start+1+rnd.Intn(4))
+		}
+		source, from := rnd.Intn(n), rnd.Intn(10)
+		to := from + rnd.Intn(8)
+		if got, want := g.EarliestArrival(source, from, to), naiveArrival(g, source, from, to); !reflect.DeepEqual(got, want) {
+			t.Fatalf("EarliestArrival(%d, %d, %d) of %v = %v, want %v", source, from, to, g.edges, got, want)
+		}
+		at := rnd.Intn(14)
+		snapshot := g.SnapshotAt(at)
+		for _, e := range g.edges {
+			w, ok := snapshot.hasEdge(e.From, e.To)
+			if valid := at >= e.Start && at < e.End; valid && (!ok || w > e.Weight) {
+				t.Fatalf("SnapshotAt(%d) misses edge %v", at, e)
+			}
+		}
+		for _, e := range snapshot.edgeList() {
+			found := false
+			for _, te := range g.edges {
+				same := te.From == int(e.Start) && te.To == int(e.End) || !g.Directed() && te.To == int(e.Start) && te.From == int(e.End)
+				found = found || same && te.Weight == e.Weight && at >= te.Start && at < te.End
+			}
+			if !found {
+				t.Fatalf("SnapshotAt(%d) has edge %v not valid then", at, e)
+			}
+		}
+	}
+}
@@ -0,0 +1,224 @@
+// traversal.go
+// description: Breadth-first and depth-first traversal with visitor callbacks
+// details:
+// The traversals walk the graph and report what they see to a Visitor: when
+// a vertex is discovered, when it is finished, and which edges belong to the
+// search tree. Depth-first search also reports back edges, the edges leading
+// to a vertex that is still being explored. In a directed graph a back edge
+// closes a cycle; in an undirected graph every edge that is not in the tree
+// is a back edge. Algorithms such as cycle detection, two-coloring and
+// low-link computations are then written as a few callbacks, see
+// connectivity.go.
+// Neighbours are visited in increasing order so that traversals are
+// repeatable. Depth-first search uses an explicit stack and does not
+// overflow on long paths.
+// time complexity: O(V + E log E), the logarithm coming from sorting neighbours
+// space complexity: O(V)
+// reference: https://en.wikipedia.org/wiki/Depth-first_search#Output_of_a_depth-first_search
+// see traversal_test.go
+
+package graph
+
+import (
+	"sort"
+
+	"github.com/TheAlgorithms/Go/structure/iterutil"
+)
+
+// Visitor holds the callbacks of a traversal. Any of them may be nil.
+type Visitor struct {
+	// Discover is called when v is reached for the first time.
+	Discover func(v int)
+	// Finish is called when every neighbour of v has been explored.
+	Finish func(v int)
+	// TreeEdge is called for the edge from u through which v is discovered,
+	// before Discover(v).
+	TreeEdge func(u, v int)
+	// BackEdge is called by depth-first search for an edge from u to a
+	// vertex v whose exploration has started but not finished. The edge
+	// through which an undirected graph reached u is not reported again.
+	BackEdge func(u, v int)
+}
+
+// The states of a vertex during a traversal.
+const (
+	unvisited = iota
+	exploring
+	finished
+)
+
+// neighbours returns the vertices adjacent to v in increasing order.
+func (g *Graph) neighbours(v int) []int {
+	adjacent := make([]int, 0, len(g.edges[v]))
+	for u := range g.edges[v] {
+		adjacent = append(adjacent, u)
+	}
+	sort.Ints(adjacent)
+	return adjacent
+}
+
+// allVertices returns the vertices 0 to g.vertices-1 and every other vertex
+// holding edges, in increasing order.
+func (g *Graph) allVertices() []int {
+	vertices := make([]int, 0, g.vertices)
+	for v := 0; v < g.vertices; v++ {
+		vertices = append(vertices, v)
+	}
+	for _, v := range g.vertexList() {
+		if v < 0 || v >= g.vertices {
+			vertices = append(vertices, v)
+		}
+	}
+	sort.Ints(vertices)
+	return vertices
+}
+
+// BFS explores the vertices reachable from start breadth first: by
+// increasing number of edges from start. A vertex is finished once its
+// neighbours have been discovered. BackEdge is not called.
+func (g *Graph) BFS(start int, visit Visitor) {
+	state := map[int]int{start: exploring}
+	if visit.Discover != nil {
+		visit.Discover(start)
+	}
+	queue := []int{start}
+	for len(queue) > 0 {
+		u := queue[0]
+		queue = queue[1:]
+		for _, v := range g.neighbours(u) {
+			if state[v] != unvisited {
+				continue
+			}
+			state[v] = exploring
+			if visit.TreeEdge != nil {
+				visit.TreeEdge(u, v)
+			}
+			if visit.Discover != nil {
+				visit.Discover(v)
+			}
+			queue = append(queue, v)
+		}
+		state[u] = finished
+		if visit.Finish != nil {
+			visit.Finish(u)
+		}
+	}
+}
+
+// DFS explores the vertices reachable from start depth first.
+func (g *Graph) DFS(start int, visit Visitor) {
+	g.traverse(start, map[int]int{}, visit)
+}
+
+// DFSForest explores every vertex of the graph depth first, starting a new
+// tree from the smallest vertex not reached yet.
+func (g *Graph) DFSForest(visit Visitor) {
+	state := map[int]int{}
+	for _, v := range g.allVertices() {
+		if state[v] == unvisited {
+			g.traverse(v, state, visit)
+		}
+	}
+}
+
+// BFSSeq returns the vertices reachable from start in the order BFS
+// discovers them, as a lazy sequence: the search goes no further than the
+// last vertex consumed.
+func (g *Graph) BFSSeq(start int) iterutil.Seq[int] {
+	return func(yield func(int) bool) {
+		seen := map[int]bool{start: true}
+		if !yield(start) {
+			return
+		}
+		queue := []int{start}
+		for len(queue) > 0 {
+			u := queue[0]
+			queue = queue[1:]
+			for _, v := range g.neighbours(u) {
+				if seen[v] {
+					continue
+				}
+				seen[v] = true
+				if !yield(v) {
+					return
+				}
+				queue = append(queue, v)
+			}
+		}
+	}
+}
+
+// DFSSeq returns the vertices reachable from start in the order DFS
+// discovers them, as a lazy sequence.
+func (g *Graph) DFSSeq(start int) iterutil.Seq[int] {
+	return func(yield func(int) bool) {
+		seen := map[int]bool{start: true}
+		if !yield(start) {
+			return
+		}
+		stack := [][]int{g.neighbours(start)}
+		for len(stack) > 0 {
+			top := &stack[len(stack)-1]
+			if len(*top) == 0 {
+				stack = stack[:len(stack)-1]
+				continue
+			}
+			v := (*top)[0]
+			*top = (*top)[1:]
+			if seen[v] {
+				continue
+			}
+			seen[v] = true
+			if !yield(v) {
+				return
+			}
+			stack = append(stack, g.neighbours(v))
+		}
+	}
+}
+
+// traverse is the depth-first search from start, skipping the vertices
+// already explored according to state.
+func (g *Graph) traverse(start int, state map[int]int, visit Visitor) {
+	type frame struct {
+		vertex, parent int
+		hasParent      bool
+		next           []int
+	}
+	state[start] = exploring
+	if visit.Discover != nil {
+		visit.Discover(start)
+	}
+	stack := []frame{{vertex: start, next: g.neighbours(start)}}
+	for len(stack) > 0 {
+		top := &stack[len(stack)-1]
+		if len(top.next) == 0 {
+			state[top.vertex] = finished
+			if visit.Finish != nil {
+				visit.Finish(top.vertex)
+			}
+			stack = stack[:len(stack)-1]
+			continue
+		}
+		u, v := top.vertex, top.next[0]
+		top.next = top.next[1:]
+		switch state[v] {
+		case unvisited:
+			state[v] = exploring
+			if visit.TreeEdge != nil {
+				visit.TreeEdge(u, v)
+			}
+			if visit.Discover != nil {
+				visit.Discover(v)
+			}
+			stack = append(stack, frame{vertex: v, parent: u, hasParent: true, next: g.neighbours(v)})
+		case exploring:
+			if !g.Directed && top.hasParent && v == top.parent {
+				continue
+			}
+			if visit.BackEdge != nil {
+				visit.BackEdge(u, v)
+			}
+		}
+	}
+}
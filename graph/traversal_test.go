@@ -0,0 +1,132 @@
+package graph
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/structure/iterutil"
+)
+
+// recorder returns a Visitor logging every callback into events.
+func recorder(events *[]string) Visitor {
+	log := func(kind string, vertices ...int) {
+		e := kind
+		for _, v := range vertices {
+			e += " " + string(rune('0'+v))
+		}
+		*events = append(*events, e)
+	}
+	return Visitor{
+		Discover: func(v int) { log("discover", v) },
+		Finish:   func(v int) { log("finish", v) },
+		TreeEdge: func(u, v int) { log("tree", u, v) },
+		BackEdge: func(u, v int) { log("back", u, v) },
+	}
+}
+
+func TestDFS(t *testing.T) {
+	g := &Graph{Directed: true}
+	for _, e := range [][2]int{{0, 1}, {1, 2}, {2, 0}, {0, 3}, {3, 2}} {
+		g.AddEdge(e[0], e[1])
+	}
+	var events []string
+	g.DFS(0, recorder(&events))
+	want := []string{
+		"discover 0",
+		"tree 0 1", "discover 1",
+		"tree 1 2", "discover 2",
+		"back 2 0",
+		"finish 2", "finish 1",
+		"tree 0 3", "discover 3",
+		"finish 3", "finish 0",
+	}
+	if !reflect.DeepEqual(events, want) {
+		t.Errorf("got %q, want %q", events, want)
+	}
+
+	// the edge to the parent of an undirected tree is not a back edge
+	g = New(0)
+	g.AddEdge(0, 1)
+	g.AddEdge(1, 2)
+	events = nil
+	g.DFS(1, Visitor{BackEdge: func(u, v int) { events = append(events, "back") }})
+	if len(events) != 0 {
+		t.Errorf("a path has no back edge, got %d", len(events))
+	}
+
+	// long paths do not overflow the stack
+	g = New(0)
+	n := 200000
+	for i := 1; i < n; i++ {
+		g.AddEdge(i-1, i)
+	}
+	count := 0
+	g.DFS(0, Visitor{Finish: func(int) { count++ }})
+	if count != n {
+		t.Errorf("finished %d vertices, want %d", count, n)
+	}
+}
+
+func TestDFSForest(t *testing.T) {
+	g := New(5)
+	g.AddEdge(3, 1)
+	var roots []int
+	parent := map[int]bool{}
+	g.DFSForest(Visitor{
+		TreeEdge: func(u, v int) { parent[v] = true },
+		Discover: func(v int) {
+			if !parent[v] {
+				roots = append(roots, v)
+			}
+		},
+	})
+	if want := []int{0, 1, 2, 4}; !reflect.DeepEqual(roots, want) {
+		t.Errorf("roots %v, want %v", roots, want)
+	}
+}
+
+func TestBFS(t *testing.T) {
+	g := New(0)
+	for _, e := range [][2]int{{0, 2}, {0, 1}, {1, 3}, {2, 3}, {3, 4}} {
+		g.AddEdge(e[0], e[1])
+	}
+	var events []string
+	g.BFS(0, recorder(&events))
+	want := []string{
+		"discover 0",
+		"tree 0 1", "discover 1",
+		"tree 0 2", "discover 2",
+		"finish 0",
+		"tree 1 3", "discover 3",
+		"finish 1", "finish 2",
+		"tree 3 4", "discover 4",
+		"finish 3", "finish 4",
+	}
+	if !reflect.DeepEqual(events, want) {
+		t.Errorf("got %q, want %q", events, want)
+	}
+}
+
+func TestTraversalSeq(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for iter := 0; iter < 50; iter++ {
+		g := &Graph{Directed: iter%2 == 0}
+		for e := 0; e < 30; e++ {
+			g.AddEdge(rnd.Intn(15), rnd.Intn(15))
+		}
+		start := rnd.Intn(15)
+		var bfs, dfs []int
+		g.BFS(start, Visitor{Discover: func(v int) { bfs = append(bfs, v) }})
+		g.DFS(start, Visitor{Discover: func(v int) { dfs = append(dfs, v) }})
+		if got := iterutil.Collect(g.BFSSeq(start)); !reflect.DeepEqual(got, bfs) {
+			t.Fatalf("BFSSeq(%d) = %v, want %v", start, got, bfs)
+		}
+		if got := iterutil.Collect(g.DFSSeq(start)); !reflect.DeepEqual(got, dfs) {
+			t.Fatalf("DFSSeq(%d) = %v, want %v", start, got, dfs)
+		}
+		if got := iterutil.Collect(iterutil.Take(g.DFSSeq(start), 2)); !reflect.DeepEqual(got, dfs[:len(got)]) || len(got) != 2 && len(got) != len(dfs) {
+			t.Fatalf("DFSSeq(%d) taking 2 = %v, want a prefix of %v", start, got, dfs)
+		}
+	}
+}
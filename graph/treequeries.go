@@ -0,0 +1,204 @@
+// treequeries.go
+// description: Offline batches of subtree and path queries on a rooted tree
+// details:
+// AnswerTreeQueries takes a rooted tree with a value on every vertex and a
+// batch of operations, adding to the value of a vertex or asking for the sum
+// of a subtree or of a path, and answers them in order with one traversal
+// of the tree. The Euler tour of the tree numbers the vertices in the order
+// a depth-first search enters them, so that the subtree of v is the range of
+// numbers from its entry to the last entry below it. The sum of a path u - v
+// is sum(u) + sum(v) - 2 sum(w) + value(w), sum(x) being the sum from the
+// root to x and w the lowest common ancestor of u and v, found by binary
+// lifting with Tree. Adding to v changes sum(x) for every x in its subtree,
+// so both kinds of query reduce to ranges of the tour.
+// The batch is planned before it is run: without updates, both kinds are
+// answered from prefix sums over the tour in O(1) each; otherwise subtree
+// sums use a Fenwick tree over the tour with point updates, and the sums
+// from the root a range-update Fenwick tree, built only when the batch asks
+// for that kind of query.
+// time complexity: O(n log n + q) without updates, O((n + q) log n) with them
+// space complexity: O(n log n)
+// reference: https://en.wikipedia.org/wiki/Euler_tour_technique
+// reference: https://cp-algorithms.com/graph/lca_binary_lifting.html
+// see treequeries_test.go
+
+package graph
+
+import (
+	"errors"
+
+	"github.com/TheAlgorithms/Go/structure/fenwicktree"
+)
+
+// TreeQueryKind is the kind of an operation of a batch of tree queries.
+type TreeQueryKind int
+
+const (
+	// AddValue adds Value to the value of vertex U.
+	AddValue TreeQueryKind = iota
+	// SubtreeSum asks for the sum of the values of the subtree of U.
+	SubtreeSum
+	// PathSum asks for the sum of the values of the vertices on the path
+	// between U and V, both included.
+	PathSum
+)
+
+// TreeQuery is an operation of a batch of tree queries.
+type TreeQuery struct {
+	Kind  TreeQueryKind
+	U, V  int
+	Value int
+}
+
+// tour is the Euler tour of a rooted tree.
+type tour struct {
+	tree  *Tree
+	order []int // the vertices in the order they are entered
+	enter []int // the position of each vertex in order
+	exit  []int // the last position in order of the subtree of each vertex
+}
+
+// newTour returns the Euler tour of the tree of n vertices and the given
+// edges, rooted at root, with an error if the edges do not form a tree.
+func newTour(n, root int, edges []Edge) (*tour, error) {
+	if n < 1 || root < 0 || root >= n {
+		return nil, errors.New("tree root out of range")
+	}
+	if len(edges) != n-1 {
+		return nil, errors.New("a tree of n vertices has n - 1 edges")
+	}
+	u := NewUnionFind(n)
+	treeEdges := make([]TreeEdge, len(edges))
+	for i, e := range edges {
+		a, b := int(e.Start), int(e.End)
+		if a < 0 || a >= n || b < 0 || b >= n {
+			return nil, errors.New("edge endpoint out of range")
+		}
+		if u.Find(a) == u.Find(b) {
+			return nil, errors.New("edges contain a cycle")
+		}
+		u.Union(a, b)
+		treeEdges[i] = TreeEdge{from: a, to: b}
+	}
+	t := &tour{
+		tree:  NewTree(n, root, treeEdges),
+		order: make([]int, 0, n),
+		enter: make([]int, n),
+		exit:  make([]int, n),
+	}
+	LowestCommonAncestor(t.tree)
+	// the search keeps, for each vertex on the stack, the next neighbour to
+	// visit
+	type frame struct{ v, next int }
+	stack := []frame{{root, 0}}
+	t.order = append(t.order, root)
+	for len(stack) > 0 {
+		top := &stack[len(stack)-1]
+		neighbours := t.tree.edges[top.v]
+		if top.next == len(neighbours) {
+			t.exit[top.v] = len(t.order) - 1
+			stack = stack[:len(stack)-1]
+			continue
+		}
+		w := neighbours[top.next]
+		top.next++
+		// the root is its own parent, and has no neighbour equal to it
+		if w == t.tree.GetDad(top.v) {
+			continue
+		}
+		t.enter[w] = len(t.order)
+		t.order = append(t.order, w)
+		stack = append(stack, frame{w, 0})
+	}
+	return t, nil
+}
+
+// AnswerTreeQueries runs the queries in order on the tree of n vertices and
+// the given edges, whose weights are ignored, rooted at root, vertex v
+// holding values[v] at first, or 0 when values is nil. Answer i is the
+// answer to query i, and for AddValue the new value of the vertex. It
+// returns an error if the edges do not form a tree, values has a length
+// other than n, or a query is invalid.
+func AnswerTreeQueries(n, root int, edges []Edge, values []int, queries []TreeQuery) ([]int, error) {
+	if values == nil {
+		values = make([]int, n)
+	}
+	if len(values) != n {
+		return nil, errors.New("there must be one value per vertex")
+	}
+	t, err := newTour(n, root, edges)
+	if err != nil {
+		return nil, err
+	}
+	updates, subtrees, paths := false, false, false
+	for _, q := range queries {
+		if q.U < 0 || q.U >= n || q.Kind == PathSum && (q.V < 0 || q.V >= n) {
+			return nil, errors.New("query vertex out of range")
+		}
+		switch q.Kind {
+		case AddValue:
+			updates = true
+		case SubtreeSum:
+			subtrees = true
+		case PathSum:
+			paths = true
+		default:
+			return nil, errors.New("unknown tree query kind")
+		}
+	}
+	value := append([]int(nil), values...)
+	// the values and the sums from the root, in the order of the tour
+	flat := make([]int, n)
+	fromRoot := make([]int, n)
+	for i, v := range t.order {
+		flat[i] = value[v]
+		fromRoot[i] = value[v]
+		if v != root {
+			fromRoot[i] += fromRoot[t.enter[t.tree.GetDad(v)]]
+		}
+	}
+	var subtreeSum, rootSum func(v int) int
+	var add func(v, delta int)
+	if !updates {
+		prefix := make([]int, n+1)
+		for i, x := range flat {
+			prefix[i+1] = prefix[i] + x
+		}
+		subtreeSum = func(v int) int { return prefix[t.exit[v]+1] - prefix[t.enter[v]] }
+		rootSum = func(v int) int { return fromRoot[t.enter[v]] }
+	} else {
+		var sums *fenwicktree.FenwickTree
+		var roots *fenwicktree.RangeFenwickTree
+		if subtrees {
+			sums = fenwicktree.NewFenwickTree(flat)
+			subtreeSum = func(v int) int { return sums.RangeSum(t.enter[v]+1, t.exit[v]+1) }
+		}
+		if paths {
+			roots = fenwicktree.NewRangeFenwickTree(fromRoot)
+			rootSum = func(v int) int { return roots.Get(t.enter[v] + 1) }
+		}
+		add = func(v, delta int) {
+			if sums != nil {
+				sums.Add(t.enter[v]+1, delta)
+			}
+			if roots != nil {
+				roots.RangeAdd(t.enter[v]+1, t.exit[v]+1, delta)
+			}
+		}
+	}
+	answers := make([]int, len(queries))
+	for i, q := range queries {
+		switch q.Kind {
+		case AddValue:
+			value[q.U] += q.Value
+			add(q.U, q.Value)
+			answers[i] = value[q.U]
+		case SubtreeSum:
+			answers[i] = subtreeSum(q.U)
+		case PathSum:
+			w := t.tree.GetLCA(q.U, q.V)
+			answers[i] = rootSum(q.U) + rootSum(q.V) - 2*rootSum(w) + value[w]
+		}
+	}
+	return answers, nil
+}
@@ -0,0 +1,142 @@
+package graph
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// naiveTreeQueries answers the queries by walking the tree.
+func naiveTreeQueries(n, root int, edges []Edge, values []int, queries []TreeQuery) []int {
+	adjacency := make([][]int, n)
+	for _, e := range edges {
+		adjacency[e.Start] = append(adjacency[e.Start], int(e.End))
+		adjacency[e.End] = append(adjacency[e.End], int(e.Start))
+	}
+	parent := make([]int, n)
+	depth := make([]int, n)
+	parent[root] = -1
+	order := []int{root}
+	for i := 0; i < len(order); i++ {
+		v := order[i]
+		for _, w := range adjacency[v] {
+			if w != parent[v] {
+				parent[w], depth[w] = v, depth[v]+1
+				order = append(order, w)
+			}
+		}
+	}
+	value := append([]int(nil), values...)
+	answers := make([]int, len(queries))
+	for i, q := range queries {
+		switch q.Kind {
+		case AddValue:
+			value[q.U] += q.Value
+			answers[i] = value[q.U]
+		case SubtreeSum:
+			for v := 0; v < n; v++ {
+				for a := v; a != -1; a = parent[a] {
+					if a == q.U {
+						answers[i] += value[v]
+						break
+					}
+				}
+			}
+		case PathSum:
+			u, v := q.U, q.V
+			for u != v {
+				if depth[u] < depth[v] {
+					u, v = v, u
+				}
+				answers[i] += value[u]
+				u = parent[u]
+			}
+			answers[i] += value[u]
+		}
+	}
+	return answers
+}
+
+func TestAnswerTreeQueries(t *testing.T) {
+	//        0
+	//      /   \
+	//     1     2
+	//    / \     \
+	//   3   4     5
+	edges := []Edge{{Start: 0, End: 1}, {Start: 0, End: 2}, {Start: 1, End: 3}, {Start: 1, End: 4}, {Start: 2, End: 5}}
+	values := []int{1, 2, 3, 4, 5, 6}
+	queries := []TreeQuery{
+		{Kind: SubtreeSum, U: 1},
+		{Kind: PathSum, U: 3, V: 5},
+		{Kind: PathSum, U: 4, V: 4},
+		{Kind: AddValue, U: 1, Value: 10},
+		{Kind: SubtreeSum, U: 0},
+		{Kind: PathSum, U: 3, V: 4},
+	}
+	got, err := AnswerTreeQueries(6, 0, edges, values, queries)
+	want := []int{11, 16, 5, 12, 31, 21}
+	if err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("AnswerTreeQueries = %v, %v, want %v", got, err, want)
+	}
+	// without the update, the static plan answers
+	got, err = AnswerTreeQueries(6, 0, edges, values, append(queries[:3:3], queries[4:]...))
+	want = []int{11, 16, 5, 21, 11}
+	if err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("AnswerTreeQueries without updates = %v, %v, want %v", got, err, want)
+	}
+	if got, err := AnswerTreeQueries(1, 0, nil, nil, []TreeQuery{{Kind: PathSum}}); err != nil || !reflect.DeepEqual(got, []int{0}) {
+		t.Errorf("AnswerTreeQueries on one vertex = %v, %v", got, err)
+	}
+	invalid := []struct {
+		name    string
+		n       int
+		edges   []Edge
+		values  []int
+		queries []TreeQuery
+	}{
+		{"cycle", 3, []Edge{{Start: 0, End: 1}, {Start: 1, End: 0}}, nil, nil},
+		{"too few edges", 3, []Edge{{Start: 0, End: 1}}, nil, nil},
+		{"edge out of range", 2, []Edge{{Start: 0, End: 2}}, nil, nil},
+		{"values", 2, []Edge{{Start: 0, End: 1}}, []int{1}, nil},
+		{"query vertex", 2, []Edge{{Start: 0, End: 1}}, nil, []TreeQuery{{Kind: PathSum, U: 0, V: 2}}},
+		{"query kind", 2, []Edge{{Start: 0, End: 1}}, nil, []TreeQuery{{Kind: 7}}},
+	}
+	for _, test := range invalid {
+		if _, err := AnswerTreeQueries(test.n, 0, test.edges, test.values, test.queries); err == nil {
+			t.Errorf("AnswerTreeQueries with an invalid %s should fail", test.name)
+		}
+	}
+}
+
+func TestAnswerTreeQueriesRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for iter := 0; iter < 200; iter++ {
+		n := 1 + rnd.Intn(30)
+		edges := make([]Edge, 0, n-1)
+		for v := 1; v < n; v++ {
+			edges = append(edges, Edge{Start: Vertex(rnd.Intn(v)), End: Vertex(v)})
+		}
+		rnd.Shuffle(len(edges), func(i, j int) { edges[i], edges[j] = edges[j], edges[i] })
+		values := make([]int, n)
+		for v := range values {
+			values[v] = rnd.Intn(21) - 10
+		}
+		// some batches have no update, or only one kind of query
+		var kinds []TreeQueryKind
+		mask := 1 + rnd.Intn(7)
+		for kind := AddValue; kind <= PathSum; kind++ {
+			if mask&(1<<kind) != 0 {
+				kinds = append(kinds, kind)
+			}
+		}
+		queries := make([]TreeQuery, rnd.Intn(40))
+		for i := range queries {
+			queries[i] = TreeQuery{Kind: kinds[rnd.Intn(len(kinds))], U: rnd.Intn(n), V: rnd.Intn(n), Value: rnd.Intn(21) - 10}
+		}
+		root := rnd.Intn(n)
+		got, err := AnswerTreeQueries(n, root, edges, values, queries)
+		if want := naiveTreeQueries(n, root, edges, values, queries); err != nil || !reflect.DeepEqual(got, want) {
+			t.Fatalf("AnswerTreeQueries(%d, %d, %v, %v, %v) = %v, %v, want %v", n, root, edges, values, queries, got, err, want)
+		}
+	}
+}
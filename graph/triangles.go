@@ -0,0 +1,112 @@
+// triangles.go
+// description: Triangle counting and clustering coefficients
+// details:
+// The forward algorithm orders the vertices by degree and directs every edge
+// from its lower to its higher end in that order. Each triangle then has one
+// vertex before the two others, and is found exactly once, by the edge from
+// that vertex to the middle one, as the common out-neighbour of both ends of
+// the edge. Out-neighbour lists are sorted by the order, so intersecting two
+// of them is a merge, and since a vertex keeps at most sqrt(2E) out-neighbours
+// the whole count takes O(E sqrt(E)) time.
+// The local clustering coefficient of a vertex is the fraction of the pairs
+// of its neighbours that are adjacent, the triangles through it over its
+// d(d-1)/2 wedges (paths of length two centred on it). The global clustering
+// coefficient, or transitivity, is the fraction of all wedges that are closed,
+// three times the triangles over the wedges.
+// Direction and self-loops are ignored.
+// time complexity: O(E sqrt(E) + V log V)
+// space complexity: O(V + E)
+// reference: https://en.wikipedia.org/wiki/Clustering_coefficient
+// reference: Schank, Wagner, "Finding, counting and listing all triangles in large graphs", 2005
+// see triangles_test.go
+
+package graph
+
+import "sort"
+
+// triangles returns the number of triangles of g and of the triangles
+// through each vertex, with the degrees of the vertices, direction and
+// self-loops being ignored.
+func (g *Graph) triangles() (total int, local map[int]int, degree map[int]int) {
+	u := g.undirected()
+	degree = map[int]int{}
+	for v, neighbours := range u.edges {
+		degree[v] = len(neighbours)
+		if _, loop := neighbours[v]; loop {
+			degree[v]--
+		}
+	}
+	order := u.vertexList()
+	sort.SliceStable(order, func(i, j int) bool { return degree[order[i]] < degree[order[j]] })
+	position := make(map[int]int, len(order))
+	for i, v := range order {
+		position[v] = i
+	}
+	// out holds the positions of the later neighbours of each vertex, sorted
+	out := make([][]int, len(order))
+	for i, v := range order {
+		for w := range u.edges[v] {
+			if position[w] > i {
+				out[i] = append(out[i], position[w])
+			}
+		}
+		sort.Ints(out[i])
+	}
+
+	local = map[int]int{}
+	for i := range out {
+		for _, j := range out[i] {
+			a, b := out[i], out[j]
+			for len(a) > 0 && len(b) > 0 {
+				switch {
+				case a[0] < b[0]:
+					a = a[1:]
+				case a[0] > b[0]:
+					b = b[1:]
+				default:
+					total++
+					local[order[i]]++
+					local[order[j]]++
+					local[order[a[0]]]++
+					a, b = a[1:], b[1:]
+				}
+			}
+		}
+	}
+	return total, local, degree
+}
+
+// Triangles returns the number of triangles of g, sets of three vertices
+// adjacent to each other.
+func (g *Graph) Triangles() int {
+	total, _, _ := g.triangles()
+	return total
+}
+
+// LocalClustering returns the local clustering coefficient of every vertex
+// of g, 0 for a vertex with fewer than two neighbours.
+func (g *Graph) LocalClustering() map[int]float64 {
+	_, local, degree := g.triangles()
+	coefficients := map[int]float64{}
+	for _, v := range g.allVertices() {
+		coefficients[v] = 0
+		if d := degree[v]; d >= 2 {
+			coefficients[v] = float64(local[v]) / float64(d*(d-1)/2)
+		}
+	}
+	return coefficients
+}
+
+// GlobalClustering returns the transitivity of g, the fraction of its wedges
+// that are closed by an edge, or 0 if g has no wedge.
+func (g *Graph) GlobalClustering() float64 {
+	total, _, degree := g.triangles()
+	wedges := 0
+	for _, d := range degree {
+		wedges += d * (d - 1) / 2
+	}
+	if wedges == 0 {
+		return 0
+	}
+	return float64(3*total) / float64(wedges)
+}
@@ -0,0 +1,133 @@
+package graph
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// bruteTriangles counts the triangles of g by trying every triple of
+// vertices, and the triangles through each vertex.
+func bruteTriangles(g *Graph) (int, map[int]int) {
+	u := g.undirected()
+	vertices := u.vertexList()
+	adjacent := func(a, b int) bool { _, ok := u.edges[a][b]; return ok }
+	total, local := 0, map[int]int{}
+	for i, a := range vertices {
+		for j := i + 1; j < len(vertices); j++ {
+			b := vertices[j]
+			if !adjacent(a, b) {
+				continue
+			}
+			for _, c := range vertices[j+1:] {
+				if adjacent(a, c) && adjacent(b, c) {
+					total++
+					local[a]++
+					local[b]++
+					local[c]++
+				}
+			}
+		}
+	}
+	return total, local
+}
+
+func TestTriangles(t *testing.T) {
+	// two triangles sharing the edge 1-2, a pendant vertex 4 and a self-loop
+	g := New(6)
+	for _, e := range [][2]int{{0, 1}, {0, 2}, {1, 2}, {1, 3}, {2, 3}, {3, 4}, {4, 4}} {
+		g.AddEdge(e[0], e[1])
+	}
+	if got := g.Triangles(); got != 2 {
+		t.Errorf("Triangles() = %d, want 2", got)
+	}
+	local := g.LocalClustering()
+	want := map[int]float64{0: 1, 1: 2.0 / 3, 2: 2.0 / 3, 3: 1.0 / 3, 4: 0, 5: 0}
+	for v, c := range want {
+		if math.Abs(local[v]-c) > 1e-9 {
+			t.Errorf("LocalClustering()[%d] = %v, want %v", v, local[v], c)
+		}
+	}
+	// 6 closed wedges out of 1 + 3 + 3 + 3 = 10
+	if got := g.GlobalClustering(); math.Abs(got-0.6) > 1e-9 {
+		t.Errorf("GlobalClustering() = %v, want 0.6", got)
+	}
+	if got := New(3).GlobalClustering(); got != 0 {
+		t.Errorf("GlobalClustering() of a graph without edges = %v", got)
+	}
+
+	// direction is ignored
+	d := New(3)
+	d.Directed = true
+	d.AddEdge(0, 1)
+	d.AddEdge(1, 2)
+	d.AddEdge(0, 2)
+	if got := d.Triangles(); got != 1 {
+		t.Errorf("Triangles() of a directed triangle = %d, want 1", got)
+	}
+}
+
+func TestTrianglesRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 20; i++ {
+		g := New(30)
+		for e := rnd.Intn(200); e > 0; e-- {
+			g.AddEdge(rnd.Intn(30), rnd.Intn(30))
+		}
+		total, local := bruteTriangles(g)
+		if got := g.Triangles(); got != total {
+			t.Fatalf("Triangles() = %d, want %d", got, total)
+		}
+		_, got, _ := g.triangles()
+		for v := range g.edges {
+			if got[v] != local[v] {
+				t.Fatalf("%d triangles through %d, want %d", got[v], v, local[v])
+			}
+		}
+	}
+}
+
+// randomClusteredGraph returns a graph of n vertices made of
+// many small dense groups and some random edges between them.
+func randomClusteredGraph(rnd *rand.Rand, n int) *Graph {
+	g := New(n)
+	for v := 0; v < n; v++ {
+		for k := 0; k < 3; k++ {
+			w := v - v%10 + rnd.Intn(10)
+			if rnd.Intn(4) == 0 {
+				w = rnd.Intn(n)
+			}
+			if w != v {
+				g.AddEdge(v, w)
+			}
+		}
+	}
+	return g
+}
+
+func TestTriangleStream(t *testing.T) {
+	if _, err := NewTriangleStream(1, 10, 1); err == nil {
+		t.Errorf("NewTriangleStream with room for one edge should fail")
+	}
+	rnd := rand.New(rand.NewSource(2))
+	g := randomClusteredGraph(rnd, 2000)
+	edges := g.edgeList()
+	rnd.Shuffle(len(edges), func(i, j int) { edges[i], edges[j] = edges[j], edges[i] })
+	triangles, transitivity := float64(g.Triangles()), g.GlobalClustering()
+
+	for _, size := range []int{len(edges), len(edges) / 2} {
+		s, _ := NewTriangleStream(size, 5000, 3)
+		for _, e := range edges {
+			s.Add(int(e.Start), int(e.End))
+		}
+		if s.Edges() != len(edges) {
+			t.Errorf("Edges() = %d, want %d", s.Edges(), len(edges))
+		}
+		if got := s.Triangles(); math.Abs(got-triangles) > 0.2*triangles {
+			t.Errorf("with %d edges kept, Triangles() = %v, want about %v", size, got, triangles)
+		}
+		if got := s.Transitivity(); math.Abs(got-transitivity) > 0.2*transitivity {
+			t.Errorf("with %d edges kept, Transitivity() = %v, want about %v", size, got, transitivity)
+		}
+	}
+}
@@ -0,0 +1,184 @@
+// trianglestream.go
+// description: Approximate triangle counting over a stream of edges by wedge sampling
+// details:
+// A graph too large to keep can still have its triangles estimated from one
+// pass over its edges, in the space of two fixed-size reservoirs. The first
+// is a uniform sample of the edges seen so far (reservoir sampling), whose
+// wedges, pairs of sampled edges sharing an endpoint, stand for the wedges
+// of the graph. The second is a uniform sample of these wedges, each with a
+// flag set when a later edge of the stream closes it. A triangle is closed
+// after exactly one of its three wedges, the one made of its first two
+// edges, so the fraction rho of closed sampled wedges estimates a third of
+// the transitivity, and the number of triangles is rho times the number of
+// wedges of the graph, which is estimated from the wedges of the edge sample
+// scaled by the probability that both edges of a wedge are sampled.
+// With the edge reservoir holding every edge, the wedge count is exact and
+// only the wedge sample adds error, of order 1/sqrt(wedge reservoir size).
+// time complexity: O(W + d) per edge, for a wedge reservoir of size W and d sampled edges touching the new one
+// space complexity: O(E' + W) for an edge reservoir of size E'
+// reference: Jha, Seshadhri, Pinar, "A space efficient streaming algorithm for triangle counting using the birthday paradox", 2013
+// see triangles_test.go
+
+package graph
+
+import (
+	"errors"
+	"math/rand"
+)
+
+// wedge is a path u - center - v of two edges.
+type wedge struct {
+	u, center, v int
+	closed       bool
+}
+
+// TriangleStream estimates the triangles and the transitivity of a graph
+// whose edges are given one at a time.
+type TriangleStream struct {
+	rnd      *rand.Rand
+	seen     int      // edges given so far
+	edges    [][2]int // reservoir of edges
+	size     int      // capacity of the edge reservoir
+	incident map[int][]int
+	total    int // wedges between the edges of the reservoir
+	wedges   []wedge
+	capacity int // capacity of the wedge reservoir
+}
+
+// NewTriangleStream creates an estimator keeping up to edges edges and
+// wedges wedges of the stream, drawing its samples from a generator seeded
+// with seed. It returns an error unless edges is at least 2 and wedges is
+// positive.
+func NewTriangleStream(edges, wedges int, seed int64) (*TriangleStream, error) {
+	if edges < 2 || wedges < 1 {
+		return nil, errors.New("triangle stream needs room for two edges and a wedge")
+	}
+	return &TriangleStream{
+		rnd:      rand.New(rand.NewSource(seed)),
+		size:     edges,
+		incident: map[int][]int{},
+		capacity: wedges,
+	}, nil
+}
+
+// Add gives the next edge of the stream, between u and v. Every edge of the
+// graph must be given once; self-loops are ignored.
+func (s *TriangleStream) Add(u, v int) {
+	if u == v {
+		return
+	}
+	s.seen++
+	for i := range s.wedges {
+		w := &s.wedges[i]
+		if w.u == u && w.v == v || w.u == v && w.v == u {
+			w.closed = true
+		}
+	}
+
+	slot := len(s.edges)
+	if slot == s.size {
+		slot = s.rnd.Intn(s.seen)
+		if slot >= s.size {
+			return
+		}
+		s.remove(slot)
+	} else {
+		s.edges = append(s.edges, [2]int{})
+	}
+	fresh := len(s.incident[u]) + len(s.incident[v])
+	s.edges[slot] = [2]int{u, v}
+	s.total += fresh
+	s.incident[u] = append(s.incident[u], slot)
+	s.incident[v] = append(s.incident[v], slot)
+	if fresh == 0 {
+		return
+	}
+
+	// every sampled wedge is replaced by a new one with the probability
+	// that a uniform wedge of the reservoir is new
+	p := float64(fresh) / float64(s.total)
+	for len(s.wedges) < s.capacity {
+		s.wedges = append(s.wedges, s.newWedge(u, v, fresh))
+	}
+	for i := range s.wedges {
+		if s.rnd.Float64() < p {
+			s.wedges[i] = s.newWedge(u, v, fresh)
+		}
+	}
+}
+
+// newWedge returns a uniform wedge made of the edge from u to v, the latest
+// in the reservoir, and one of the fresh other edges touching it.
+func (s *TriangleStream) newWedge(u, v, fresh int) wedge {
+	k := s.rnd.Intn(fresh)
+	center, end := u, v
+	if k >= len(s.incident[u])-1 {
+		k -= len(s.incident[u]) - 1
+		center, end = v, u
+	}
+	e := s.edges[s.incident[center][k]]
+	other := e[0]
+	if other == center {
+		other = e[1]
+	}
+	return wedge{u: end, center: center, v: other}
+}
+
+// remove takes the edge in slot out of the reservoir.
+func (s *TriangleStream) remove(slot int) {
+	for _, x := range s.edges[slot] {
+		slots := s.incident[x]
+		for i, t := range slots {
+			if t == slot {
+				slots = append(slots[:i], slots[i+1:]...)
+				break
+			}
+		}
+		s.total -= len(slots)
+		if len(slots) == 0 {
+			delete(s.incident, x)
+		} else {
+			s.incident[x] = slots
+		}
+	}
+}
+
+// Edges returns the number of edges given so far.
+func (s *TriangleStream) Edges() int {
+	return s.seen
+}
+
+// closedFraction returns the fraction of the sampled wedges that are closed.
+func (s *TriangleStream) closedFraction() float64 {
+	if len(s.wedges) == 0 {
+		return 0
+	}
+	closed := 0
+	for _, w := range s.wedges {
+		if w.closed {
+			closed++
+		}
+	}
+	return float64(closed) / float64(len(s.wedges))
+}
+
+// Transitivity returns the estimated global clustering coefficient of the
+// graph so far.
+func (s *TriangleStream) Transitivity() float64 {
+	t := 3 * s.closedFraction()
+	if t > 1 {
+		t = 1
+	}
+	return t
+}
+
+// Triangles returns the estimated number of triangles of the graph so far.
+func (s *TriangleStream) Triangles() float64 {
+	wedges := float64(s.total)
+	if k := len(s.edges); s.seen > k {
+		// both edges of a wedge are in the reservoir with probability
+		// k(k-1) / (seen(seen-1))
+		wedges *= float64(s.seen) * float64(s.seen-1) / (float64(k) * float64(k-1))
+	}
+	return s.closedFraction() * wedges
+}
@@ -0,0 +1,118 @@
+// weightedunionfind.go
+// description: Union-find keeping the differences between the values of elements
+// details:
+// A weighted union-find stores, besides the disjoint sets, a potential for
+// every element: the difference between its unknown value and the value of
+// its parent in the forest. Summing the potentials up to the root gives the
+// difference with the root, so the difference between two elements of the
+// same set is known, and a constraint value(b) - value(a) = w joining two
+// sets fixes the potential of one root relative to the other. A constraint
+// between elements of one set is only checked against the difference
+// already implied. Path compression adds the potentials skipped to those of
+// the elements moved, and union by rank keeps the trees flat. This solves
+// systems of difference equations, such as relative weights or positions
+// given pairwise, and finds the first equation contradicting the others.
+// Floating-point potentials are compared exactly.
+// time complexity: O(α(n)) amortized per operation, α being the inverse Ackermann function
+// space complexity: O(n)
+// reference: https://en.wikipedia.org/wiki/Disjoint-set_data_structure
+// reference: https://cp-algorithms.com/data_structures/disjoint_set_union.html
+// see weightedunionfind_test.go
+
+package graph
+
+import (
+	"errors"
+
+	"github.com/TheAlgorithms/Go/constraints"
+)
+
+// ErrInconsistent is returned by WeightedUnionFind.Union for a constraint
+// contradicting the ones already recorded.
+var ErrInconsistent = errors.New("constraint contradicts the recorded differences")
+
+// Potential is the type of the differences between the values of elements.
+type Potential interface {
+	constraints.Signed | constraints.Float
+}
+
+// WeightedUnionFind is a union-find recording the differences between the
+// values of the elements of each set.
+type WeightedUnionFind[W Potential] struct {
+	parent []int
+	rank   []int
+	diff   []W // value of the element minus value of its parent
+}
+
+// NewWeightedUnionFind creates a weighted union-find of n elements, each in
+// its own set.
+func NewWeightedUnionFind[W Potential](n int) *WeightedUnionFind[W] {
+	u := &WeightedUnionFind[W]{parent: make([]int, n), rank: make([]int, n), diff: make([]W, n)}
+	for i := range u.parent {
+		u.parent[i] = i
+	}
+	return u
+}
+
+// Find returns the root of the set of x, and the value of x minus the value
+// of the root.
+func (u *WeightedUnionFind[W]) Find(x int) (int, W) {
+	root := x
+	var offset W
+	for u.parent[root] != root {
+		offset += u.diff[root]
+		root = u.parent[root]
+	}
+	// point every element of the path at the root, with the part of the
+	// offset above it
+	for rest := offset; x != root; {
+		next, d := u.parent[x], u.diff[x]
+		u.parent[x], u.diff[x] = root, rest
+		rest -= d
+		x = next
+	}
+	return root, offset
+}
+
+// Union records that value(b) - value(a) = w, joining the sets of a and b.
+// It returns ErrInconsistent, and records nothing, if a and b are in the
+// same set and their values differ by another amount.
+func (u *WeightedUnionFind[W]) Union(a, b int, w W) error {
+	ra, da := u.Find(a)
+	rb, db := u.Find(b)
+	if ra == rb {
+		if db-da != w {
+			return ErrInconsistent
+		}
+		return nil
+	}
+	// value(rb) - value(ra) = da + w - db
+	d := da + w - db
+	if u.rank[ra] < u.rank[rb] {
+		ra, rb, d = rb, ra, -d
+	}
+	u.parent[rb], u.diff[rb] = ra, d
+	if u.rank[ra] == u.rank[rb] {
+		u.rank[ra]++
+	}
+	return nil
+}
+
+// Connected reports whether a and b are in the same set.
+func (u *WeightedUnionFind[W]) Connected(a, b int) bool {
+	ra, _ := u.Find(a)
+	rb, _ := u.Find(b)
+	return ra == rb
+}
+
+// Diff returns value(b) - value(a), with false if a and b are in different
+// sets, where it is unknown.
+func (u *WeightedUnionFind[W]) Diff(a, b int) (W, bool) {
+	ra, da := u.Find(a)
+	rb, db := u.Find(b)
+	if ra != rb {
+		var zero W
+		return zero, false
+	}
+	return db - da, true
+}
@@ -0,0 +1,82 @@
+package graph
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestWeightedUnionFind(t *testing.T) {
+	u := NewWeightedUnionFind[int](6)
+	// 1 is 3 above 0, 2 is 5 above 1, 3 is 2 below 2
+	for _, c := range [][3]int{{0, 1, 3}, {1, 2, 5}, {2, 3, -2}} {
+		if err := u.Union(c[0], c[1], c[2]); err != nil {
+			t.Fatalf("Union(%d, %d, %d) = %v", c[0], c[1], c[2], err)
+		}
+	}
+	if d, ok := u.Diff(0, 3); !ok || d != 6 {
+		t.Errorf("Diff(0, 3) = %d, %t, want 6", d, ok)
+	}
+	if d, ok := u.Diff(3, 1); !ok || d != -3 {
+		t.Errorf("Diff(3, 1) = %d, %t, want -3", d, ok)
+	}
+	if _, ok := u.Diff(0, 4); ok || u.Connected(0, 4) {
+		t.Errorf("0 and 4 are not constrained")
+	}
+	if err := u.Union(3, 0, -6); err != nil {
+		t.Errorf("a consistent constraint failed: %v", err)
+	}
+	if err := u.Union(0, 2, 7); err != ErrInconsistent {
+		t.Errorf("Union(0, 2, 7) = %v, want ErrInconsistent", err)
+	}
+	if d, _ := u.Diff(0, 2); d != 8 {
+		t.Errorf("an inconsistent constraint changed Diff(0, 2) to %d", d)
+	}
+	if err := u.Union(4, 5, 10); err != nil || !u.Connected(4, 5) {
+		t.Errorf("Union(4, 5, 10) = %v", err)
+	}
+	if err := u.Union(5, 3, 1); err != nil {
+		t.Fatalf("joining two sets failed: %v", err)
+	}
+	// value(3) = value(5) + 1 = value(4) + 11, value(0) = value(3) - 6
+	if d, _ := u.Diff(4, 0); d != 5 {
+		t.Errorf("Diff(4, 0) = %d, want 5", d)
+	}
+}
+
+func TestWeightedUnionFindRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for round := 0; round < 20; round++ {
+		n := 1 + rnd.Intn(50)
+		value := make([]float64, n)
+		for i := range value {
+			value[i] = float64(rnd.Intn(100))
+		}
+		u := NewWeightedUnionFind[float64](n)
+		plain := NewUnionFind(n)
+		for i := 0; i < 2*n; i++ {
+			a, b := rnd.Intn(n), rnd.Intn(n)
+			w := value[b] - value[a]
+			if u.Connected(a, b) && rnd.Intn(3) == 0 {
+				if err := u.Union(a, b, w+1); err != ErrInconsistent {
+					t.Fatalf("Union(%d, %d) of a wrong difference = %v", a, b, err)
+				}
+				continue
+			}
+			if err := u.Union(a, b, w); err != nil {
+				t.Fatalf("Union(%d, %d, %v) = %v", a, b, w, err)
+			}
+			plain.Union(a, b)
+		}
+		for a := 0; a < n; a++ {
+			for b := 0; b < n; b++ {
+				d, ok := u.Diff(a, b)
+				if ok != (plain.Find(a) == plain.Find(b)) {
+					t.Fatalf("Diff(%d, %d) known = %t", a, b, ok)
+				}
+				if ok && d != value[b]-value[a] {
+					t.Fatalf("Diff(%d, %d) = %v, want %v", a, b, d, value[b]-value[a])
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,62 @@
+// zeroonebfs.go
+// description: Shortest paths in a graph whose edges weigh 0 or 1
+// details:
+// When every edge weighs 0 or 1, Dijkstra's priority queue only ever holds
+// vertices at two distances, d and d + 1, and a double-ended queue can take
+// its place: a vertex reached by an edge of weight 0 goes to the front, at
+// the distance of the vertex being scanned, and one reached by an edge of
+// weight 1 to the back. The queue stays sorted by distance, so every vertex
+// is settled when it first leaves the front, in linear time. A vertex may be
+// queued again when a shorter path is found, and its older copies are
+// skipped. Such graphs model grids where some moves are free, or the fewest
+// edges to reverse to reach a vertex of a directed graph.
+// The deque is a deque.DoublyEndedQueue, a ring buffer.
+// time complexity: O(V + E log E)
+// space complexity: O(V + E)
+// reference: https://cp-algorithms.com/graph/01_bfs.html
+// see zeroonebfs_test.go
+
+package graph
+
+import (
+	"errors"
+
+	"github.com/TheAlgorithms/Go/structure/deque"
+)
+
+// ZeroOneBFS returns the weight of the lightest path from start to every
+// vertex it reaches, in a graph whose edges weigh 0 or 1. It returns an
+// error if an edge has another weight.
+func (g *Graph) ZeroOneBFS(start int) (map[int]int, error) {
+	for _, neighbours := range g.edges {
+		for _, weight := range neighbours {
+			if weight != 0 && weight != 1 {
+				return nil, errors.New("0-1 BFS requires edge weights of 0 or 1")
+			}
+		}
+	}
+	distance := map[int]int{start: 0}
+	settled := map[int]bool{}
+	queue := deque.New[int]()
+	queue.EnqueueRear(start)
+	for !queue.IsEmpty() {
+		u, _ := queue.DequeueFront()
+		if settled[u] {
+			continue
+		}
+		settled[u] = true
+		for _, v := range g.neighbours(u) {
+			weight := g.edges[u][v]
+			if d, ok := distance[v]; ok && d <= distance[u]+weight {
+				continue
+			}
+			distance[v] = distance[u] + weight
+			if weight == 0 {
+				queue.EnqueueFront(v)
+			} else {
+				queue.EnqueueRear(v)
+			}
+		}
+	}
+	return distance, nil
+}
@@ -0,0 +1,69 @@
+package graph
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestZeroOneBFS(t *testing.T) {
+	// the fewest edges to reverse to go from 0 to every vertex: every edge
+	// costs 0 along its direction and 1 against it
+	directed := [][2]int{{0, 1}, {2, 1}, {2, 3}, {4, 3}, {0, 4}}
+	g := New(5)
+	g.Directed = true
+	for _, e := range directed {
+		g.AddWeightedEdge(e[0], e[1], 0)
+		if _, ok := g.edges[e[1]][e[0]]; !ok {
+			g.AddWeightedEdge(e[1], e[0], 1)
+		}
+	}
+	distance, err := g.ZeroOneBFS(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[int]int{0: 0, 1: 0, 2: 1, 3: 0, 4: 0}
+	if !reflect.DeepEqual(distance, want) {
+		t.Errorf("ZeroOneBFS(0) = %v, want %v", distance, want)
+	}
+
+	g.AddWeightedEdge(1, 3, 2)
+	if _, err := g.ZeroOneBFS(0); err == nil {
+		t.Errorf("ZeroOneBFS with an edge of weight 2 should fail")
+	}
+}
+
+func TestZeroOneBFSRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		g := New(25)
+		g.Directed = rnd.Intn(2) == 0
+		for e := 0; e < 60; e++ {
+			g.AddWeightedEdge(rnd.Intn(25), rnd.Intn(25), rnd.Intn(2))
+		}
+		distance, err := g.ZeroOneBFS(0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Bellman-Ford style relaxation until nothing changes
+		want := map[int]int{0: 0}
+		for changed := true; changed; {
+			changed = false
+			for u, neighbours := range g.edges {
+				du, ok := want[u]
+				if !ok {
+					continue
+				}
+				for v, w := range neighbours {
+					if dv, ok := want[v]; !ok || du+w < dv {
+						want[v] = du + w
+						changed = true
+					}
+				}
+			}
+		}
+		if !reflect.DeepEqual(distance, want) {
+			t.Fatalf("ZeroOneBFS(0) = %v, want %v", distance, want)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+// intervalpartitioning.go
+// description: Interval partitioning and conflict detection
+// details:
+// Interval partitioning assigns every interval to a resource, such as a
+// lecture to a room, so that the intervals sharing a resource are disjoint,
+// with as few resources as possible: the chromatic number of the interval
+// graph. Sweeping the intervals by start, each one takes a resource freed by
+// an interval ending no later than it starts, found at the top of a min-heap
+// of the busy resources by end, or a new one. A new resource is only opened
+// when all the busy ones hold an interval overlapping the current one, so
+// the count reached is the depth, the largest number of intervals sharing a
+// point, which no assignment can beat.
+// The conflicts, the pairs of overlapping intervals, are found by the same
+// sweep: every interval overlaps exactly the intervals still active when it
+// starts, those started before and not yet ended. The ended ones are dropped
+// from the active list while it is scanned, so each scan costs the conflicts
+// it reports plus the intervals it drops, each dropped once.
+// Intervals are half-open, as in IntervalScheduling, so that [1, 3) and
+// [3, 5) do not conflict. Every interval must end after it starts: an empty
+// interval would hold no point, yet take a resource of its own.
+// time complexity: O(n log n) for partitioning, O(n log n + k) for k conflicts
+// space complexity: O(n) for partitioning, O(n + k) for conflicts
+// reference: https://en.wikipedia.org/wiki/Interval_graph
+// reference: Kleinberg, Tardos, Algorithm Design, section 4.1
+// see intervalpartitioning_test.go
+
+package greedy
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/TheAlgorithms/Go/structure/heap"
+)
+
+// ErrEmptyInterval is returned when an interval does not end after it starts.
+var ErrEmptyInterval = errors.New("interval must end after it starts")
+
+// checkIntervals returns ErrEmptyInterval if an interval is empty.
+func checkIntervals(intervals []Interval) error {
+	for _, in := range intervals {
+		if in.Start >= in.End {
+			return ErrEmptyInterval
+		}
+	}
+	return nil
+}
+
+// byStart returns the indices of the intervals sorted by start, then by end.
+func byStart(intervals []Interval) []int {
+	order := make([]int, len(intervals))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		x, y := intervals[order[a]], intervals[order[b]]
+		return x.Start < y.Start || x.Start == y.Start && x.End < y.End
+	})
+	return order
+}
+
+// IntervalPartitioning returns the least number of resources among which the
+// intervals can be shared without two overlapping intervals on the same
+// resource, and the resource, from 0, assigned to each interval. It fails
+// with ErrEmptyInterval if an interval does not end after it starts.
+func IntervalPartitioning(intervals []Interval) (int, []int, error) {
+	if err := checkIntervals(intervals); err != nil {
+		return 0, nil, err
+	}
+	type busy struct {
+		end, resource int
+	}
+	// the busy resources by end
+	busies, _ := heap.NewAny(func(a, b busy) bool { return a.end < b.end })
+	resources := 0
+	assigned := make([]int, len(intervals))
+	for _, i := range byStart(intervals) {
+		in := intervals[i]
+		if !busies.Empty() && busies.Top().end <= in.Start {
+			assigned[i] = busies.Top().resource
+			busies.Pop()
+		} else {
+			assigned[i] = resources
+			resources++
+		}
+		busies.Push(busy{in.End, assigned[i]})
+	}
+	return resources, assigned, nil
+}
+
+// IntervalConflicts returns the pairs of indices of overlapping intervals,
+// the smaller index first, in increasing order. It fails with
+// ErrEmptyInterval if an interval does not end after it starts.
+func IntervalConflicts(intervals []Interval) ([][2]int, error) {
+	if err := checkIntervals(intervals); err != nil {
+		return nil, err
+	}
+	var conflicts [][2]int
+	var active []int
+	for _, i := range byStart(intervals) {
+		in := intervals[i]
+		// keep the active intervals not yet ended, each a conflict
+		kept := active[:0]
+		for _, j := range active {
+			if intervals[j].End > in.Start {
+				kept = append(kept, j)
+				if i < j {
+					conflicts = append(conflicts, [2]int{i, j})
+				} else {
+					conflicts = append(conflicts, [2]int{j, i})
+				}
+			}
+		}
+		active = append(kept, i)
+	}
+	sort.Slice(conflicts, func(a, b int) bool {
+		x, y := conflicts[a], conflicts[b]
+		return x[0] < y[0] || x[0] == y[0] && x[1] < y[1]
+	})
+	return conflicts, nil
+}
@@ -0,0 +1,116 @@
+package greedy_test
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/greedy"
+)
+
+// overlap reports whether two intervals share a point.
+func overlap(x, y greedy.Interval) bool {
+	return x.Start < y.End && y.Start < x.End
+}
+
+func TestIntervalPartitioning(t *testing.T) {
+	intervals := []greedy.Interval{
+		{Start: 9, End: 11},
+		{Start: 9, End: 13},
+		{Start: 9, End: 11},
+		{Start: 11, End: 13},
+		{Start: 11, End: 14},
+		{Start: 13, End: 15},
+		{Start: 14, End: 16},
+		{Start: 14, End: 16},
+		{Start: 15, End: 17},
+		{Start: 16, End: 17},
+	}
+	resources, assigned, err := greedy.IntervalPartitioning(intervals)
+	if err != nil || resources != 3 {
+		t.Fatalf("IntervalPartitioning used %d resources, %v, want 3", resources, err)
+	}
+	for i := range intervals {
+		for j := i + 1; j < len(intervals); j++ {
+			if assigned[i] == assigned[j] && overlap(intervals[i], intervals[j]) {
+				t.Errorf("overlapping intervals %d and %d share resource %d", i, j, assigned[i])
+			}
+		}
+	}
+	if resources, assigned, err := greedy.IntervalPartitioning(nil); resources != 0 || len(assigned) != 0 || err != nil {
+		t.Errorf("IntervalPartitioning(nil) = %d, %v, %v", resources, assigned, err)
+	}
+}
+
+func TestIntervalPartitioningEmptyInterval(t *testing.T) {
+	for _, in := range []greedy.Interval{{Start: 3, End: 3}, {Start: 4, End: 2}} {
+		intervals := []greedy.Interval{{Start: 1, End: 5}, in}
+		if _, _, err := greedy.IntervalPartitioning(intervals); err != greedy.ErrEmptyInterval {
+			t.Errorf("IntervalPartitioning with %v: err = %v, want ErrEmptyInterval", in, err)
+		}
+		if _, err := greedy.IntervalConflicts(intervals); err != greedy.ErrEmptyInterval {
+			t.Errorf("IntervalConflicts with %v: err = %v, want ErrEmptyInterval", in, err)
+		}
+	}
+}
+
+func TestIntervalConflicts(t *testing.T) {
+	intervals := []greedy.Interval{
+		{Start: 1, End: 3},
+		{Start: 3, End: 5},
+		{Start: 2, End: 4},
+		{Start: 6, End: 7},
+		{Start: 0, End: 10},
+	}
+	want := [][2]int{{0, 2}, {0, 4}, {1, 2}, {1, 4}, {2, 4}, {3, 4}}
+	if got, err := greedy.IntervalConflicts(intervals); err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("IntervalConflicts = %v, %v, want %v", got, err, want)
+	}
+	if got, err := greedy.IntervalConflicts(intervals[:2]); err != nil || len(got) != 0 {
+		t.Errorf("IntervalConflicts of touching intervals = %v, %v", got, err)
+	}
+}
+
+func TestIntervalPartitioningRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for iter := 0; iter < 300; iter++ {
+		intervals := make([]greedy.Interval, rnd.Intn(30))
+		for i := range intervals {
+			s := rnd.Intn(20) - 5
+			intervals[i] = greedy.Interval{Start: s, End: s + 1 + rnd.Intn(6)}
+		}
+		// the depth is the most intervals containing a point
+		depth := 0
+		for p := -5; p < 25; p++ {
+			n := 0
+			for _, in := range intervals {
+				if in.Start <= p && p < in.End {
+					n++
+				}
+			}
+			if n > depth {
+				depth = n
+			}
+		}
+		var want [][2]int
+		for i := range intervals {
+			for j := i + 1; j < len(intervals); j++ {
+				if overlap(intervals[i], intervals[j]) {
+					want = append(want, [2]int{i, j})
+				}
+			}
+		}
+		resources, assigned, err := greedy.IntervalPartitioning(intervals)
+		if err != nil || resources != depth {
+			t.Fatalf("IntervalPartitioning(%v) used %d resources, want %d", intervals, resources, depth)
+		}
+		for _, c := range want {
+			if assigned[c[0]] == assigned[c[1]] {
+				t.Fatalf("IntervalPartitioning(%v) put overlapping %v on one resource", intervals, c)
+			}
+		}
+		if got, err := greedy.IntervalConflicts(intervals); err != nil || !reflect.DeepEqual(got, want) {
+			t.Fatalf("IntervalConflicts(%v) = %v, want %v", intervals, got, want)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+// intervalscheduling.go
+// description: Unweighted and weighted interval scheduling, and activity selection
+// details:
+// Interval scheduling chooses as many pairwise disjoint intervals as
+// possible. The greedy choice of the interval ending first is safe: any
+// optimal solution can swap its first interval for it. Activity selection is
+// the same problem stated with arrays of start and finish times.
+// With weights, the greedy choice fails and the best total weight is found by
+// dynamic programming over the intervals sorted by end: the best schedule of
+// the first j intervals either skips interval j, or takes it along with the
+// best schedule of the intervals ending before it starts, found by binary
+// search. The table is then walked back to rebuild the chosen intervals.
+// Intervals are half-open, so that [1, 3) and [3, 5) are disjoint.
+// time complexity: O(n log n)
+// space complexity: O(n)
+// reference: https://en.wikipedia.org/wiki/Interval_scheduling
+// reference: https://en.wikipedia.org/wiki/Activity_selection_problem
+// see intervalscheduling_test.go
+
+// Package greedy gathers greedy algorithms, and the exact algorithms of the
+// problems where the greedy choice falls short, each returning the solution
+// itself along with its value.
+package greedy
+
+import (
+	"errors"
+	"sort"
+)
+
+// ErrLengthMismatch is returned when slices that should have the same length
+// do not.
+var ErrLengthMismatch = errors.New("slices have different lengths")
+
+// Interval is the half-open interval [Start, End) with a weight, which is
+// ignored by unweighted scheduling.
+type Interval struct {
+	Start, End int
+	Weight     int
+}
+
+// byEnd returns the indices of the intervals sorted by end, then by start.
+func byEnd(intervals []Interval) []int {
+	order := make([]int, len(intervals))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		x, y := intervals[order[a]], intervals[order[b]]
+		return x.End < y.End || x.End == y.End && x.Start < y.Start
+	})
+	return order
+}
+
+// IntervalScheduling returns the indices of a largest set of pairwise
+// disjoint intervals, in increasing order of end.
+func IntervalScheduling(intervals []Interval) []int {
+	var chosen []int
+	free := 0
+	for k, i := range byEnd(intervals) {
+		if k == 0 || intervals[i].Start >= free {
+			chosen = append(chosen, i)
+			free = intervals[i].End
+		}
+	}
+	return chosen
+}
+
+// ActivitySelection returns the indices of a largest set of activities that
+// do not overlap, activity i taking place from start[i] to finish[i], in
+// increasing order of finish.
+func ActivitySelection(start, finish []int) ([]int, error) {
+	if len(start) != len(finish) {
+		return nil, ErrLengthMismatch
+	}
+	intervals := make([]Interval, len(start))
+	for i := range start {
+		intervals[i] = Interval{Start: start[i], End: finish[i]}
+	}
+	return IntervalScheduling(intervals), nil
+}
+
+// WeightedIntervalScheduling returns the largest total weight of pairwise
+// disjoint intervals, and their indices in increasing order of end.
+// Intervals of negative weight are never chosen.
+func WeightedIntervalScheduling(intervals []Interval) (int, []int) {
+	order := byEnd(intervals)
+	n := len(order)
+	// best[j] is the largest weight using the first j intervals of order,
+	// and prev[j] the number of them ending before the j-th one starts
+	best := make([]int, n+1)
+	prev := make([]int, n+1)
+	for j := 1; j <= n; j++ {
+		in := intervals[order[j-1]]
+		prev[j] = sort.Search(j-1, func(k int) bool { return intervals[order[k]].End > in.Start })
+		best[j] = best[j-1]
+		if take := best[prev[j]] + in.Weight; take > best[j] {
+			best[j] = take
+		}
+	}
+	var chosen []int
+	for j := n; j > 0; {
+		if best[j] == best[j-1] {
+			j--
+			continue
+		}
+		chosen = append(chosen, order[j-1])
+		j = prev[j]
+	}
+	for i, k := 0, len(chosen)-1; i < k; i, k = i+1, k-1 {
+		chosen[i], chosen[k] = chosen[k], chosen[i]
+	}
+	return best[n], chosen
+}
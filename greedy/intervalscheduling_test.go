@@ -0,0 +1,101 @@
+package greedy_test
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/greedy"
+)
+
+func TestActivitySelection(t *testing.T) {
+	start := []int{1, 3, 0, 5, 8, 5}
+	finish := []int{2, 4, 6, 7, 9, 9}
+	got, err := greedy.ActivitySelection(start, finish)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []int{0, 1, 3, 4}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ActivitySelection = %v, want %v", got, want)
+	}
+	if _, err := greedy.ActivitySelection(start, finish[1:]); !errors.Is(err, greedy.ErrLengthMismatch) {
+		t.Errorf("ActivitySelection with different lengths error = %v", err)
+	}
+}
+
+func TestWeightedIntervalScheduling(t *testing.T) {
+	intervals := []greedy.Interval{
+		{Start: 1, End: 4, Weight: 5},
+		{Start: 3, End: 5, Weight: 1},
+		{Start: 0, End: 6, Weight: 8},
+		{Start: 4, End: 7, Weight: 4},
+		{Start: 3, End: 9, Weight: 6},
+		{Start: 5, End: 9, Weight: 3},
+		{Start: 6, End: 10, Weight: 2},
+		{Start: 8, End: 11, Weight: 4},
+	}
+	weight, chosen := greedy.WeightedIntervalScheduling(intervals)
+	if weight != 13 || !reflect.DeepEqual(chosen, []int{0, 3, 7}) {
+		t.Errorf("WeightedIntervalScheduling = %d, %v, want 13, [0 3 7]", weight, chosen)
+	}
+	if weight, chosen := greedy.WeightedIntervalScheduling(nil); weight != 0 || len(chosen) != 0 {
+		t.Errorf("WeightedIntervalScheduling(nil) = %d, %v", weight, chosen)
+	}
+}
+
+// disjoint reports whether the chosen intervals do not overlap.
+func disjoint(intervals []greedy.Interval, chosen []int) bool {
+	for a := range chosen {
+		for b := a + 1; b < len(chosen); b++ {
+			x, y := intervals[chosen[a]], intervals[chosen[b]]
+			if x.Start < y.End && y.Start < x.End {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func TestIntervalSchedulingRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for iter := 0; iter < 300; iter++ {
+		intervals := make([]greedy.Interval, rnd.Intn(11))
+		for i := range intervals {
+			s := rnd.Intn(20) - 5
+			intervals[i] = greedy.Interval{Start: s, End: s + 1 + rnd.Intn(6), Weight: rnd.Intn(10) - 2}
+		}
+		// the best count and weight over every subset
+		bestCount, bestWeight := 0, 0
+		for mask := 0; mask < 1<<len(intervals); mask++ {
+			var subset []int
+			weight := 0
+			for i := range intervals {
+				if mask&(1<<i) != 0 {
+					subset = append(subset, i)
+					weight += intervals[i].Weight
+				}
+			}
+			if disjoint(intervals, subset) {
+				if len(subset) > bestCount {
+					bestCount = len(subset)
+				}
+				if weight > bestWeight {
+					bestWeight = weight
+				}
+			}
+		}
+		chosen := greedy.IntervalScheduling(intervals)
+		if len(chosen) != bestCount || !disjoint(intervals, chosen) {
+			t.Fatalf("IntervalScheduling(%v) = %v, want %d intervals", intervals, chosen, bestCount)
+		}
+		weight, chosen := greedy.WeightedIntervalScheduling(intervals)
+		sum := 0
+		for _, i := range chosen {
+			sum += intervals[i].Weight
+		}
+		if weight != bestWeight || sum != weight || !disjoint(intervals, chosen) {
+			t.Fatalf("WeightedIntervalScheduling(%v) = %d, %v, want %d", intervals, weight, chosen, bestWeight)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+// jobsequencing.go
+// description: Job sequencing with deadlines
+// details:
+// Every job takes one unit of time, must be done by its deadline to earn its
+// profit, and a single job runs at a time. Taking the jobs by decreasing
+// profit and giving each the latest free slot before its deadline is
+// optimal, since the feasible sets of jobs form a matroid. The latest free
+// slot is found with a disjoint-set forest in which every slot points to the
+// next free slot below it, so the schedule is built in near-linear time.
+// time complexity: O(n log n) for n jobs
+// space complexity: O(n)
+// reference: https://www.geeksforgeeks.org/job-sequencing-problem/
+// see jobsequencing_test.go
+
+package greedy
+
+import "sort"
+
+// Job is a unit-time job earning Profit when done by Deadline, that is in
+// one of the slots 0 to Deadline-1.
+type Job struct {
+	Deadline int
+	Profit   int
+}
+
+// JobSequencing returns the largest total profit of jobs done by their
+// deadlines, and the schedule: the job done in each slot, or -1 when the
+// slot is idle. Jobs without a positive profit are left out.
+func JobSequencing(jobs []Job) (int, []int) {
+	order := make([]int, 0, len(jobs))
+	slots := 0
+	for i, j := range jobs {
+		if j.Profit > 0 && j.Deadline > 0 {
+			order = append(order, i)
+		}
+	}
+	sort.SliceStable(order, func(a, b int) bool { return jobs[order[a]].Profit > jobs[order[b]].Profit })
+	// there are no more useful slots than jobs
+	for _, i := range order {
+		if d := jobs[i].Deadline; d > slots {
+			slots = d
+		}
+	}
+	if slots > len(order) {
+		slots = len(order)
+	}
+	// free[s] leads to the latest free slot at most s, counting slots from
+	// 1 so that 0 means none is left
+	free := make([]int, slots+1)
+	for s := range free {
+		free[s] = s
+	}
+	var find func(s int) int
+	find = func(s int) int {
+		if free[s] != s {
+			free[s] = find(free[s])
+		}
+		return free[s]
+	}
+	schedule := make([]int, slots)
+	for s := range schedule {
+		schedule[s] = -1
+	}
+	profit := 0
+	for _, i := range order {
+		d := jobs[i].Deadline
+		if d > slots {
+			d = slots
+		}
+		if s := find(d); s > 0 {
+			schedule[s-1] = i
+			profit += jobs[i].Profit
+			free[s] = s - 1
+		}
+	}
+	// idle slots at the end are dropped
+	end := len(schedule)
+	for end > 0 && schedule[end-1] == -1 {
+		end--
+	}
+	return profit, schedule[:end]
+}
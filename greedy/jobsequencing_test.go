@@ -0,0 +1,74 @@
+package greedy_test
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/greedy"
+)
+
+func TestJobSequencing(t *testing.T) {
+	jobs := []greedy.Job{
+		{Deadline: 2, Profit: 100},
+		{Deadline: 1, Profit: 19},
+		{Deadline: 2, Profit: 27},
+		{Deadline: 1, Profit: 25},
+		{Deadline: 3, Profit: 15},
+	}
+	profit, schedule := greedy.JobSequencing(jobs)
+	if profit != 142 || !reflect.DeepEqual(schedule, []int{2, 0, 4}) {
+		t.Errorf("JobSequencing = %d, %v, want 142, [2 0 4]", profit, schedule)
+	}
+	profit, schedule = greedy.JobSequencing([]greedy.Job{{Deadline: 5, Profit: 1}, {Deadline: 0, Profit: 9}, {Deadline: 2, Profit: -3}})
+	if profit != 1 || !reflect.DeepEqual(schedule, []int{0}) {
+		t.Errorf("JobSequencing with useless jobs = %d, %v, want 1, [0]", profit, schedule)
+	}
+}
+
+func TestJobSequencingRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for iter := 0; iter < 300; iter++ {
+		jobs := make([]greedy.Job, rnd.Intn(10))
+		for i := range jobs {
+			jobs[i] = greedy.Job{Deadline: rnd.Intn(6), Profit: rnd.Intn(20) - 3}
+		}
+		// a set of jobs is feasible when, for every d, at most d of them
+		// have a deadline at most d
+		best := 0
+		for mask := 0; mask < 1<<len(jobs); mask++ {
+			var byDeadline [6]int
+			profit := 0
+			for i, j := range jobs {
+				if mask&(1<<i) != 0 {
+					byDeadline[j.Deadline]++
+					profit += j.Profit
+				}
+			}
+			feasible := true
+			for d, count := 0, 0; d < len(byDeadline); d++ {
+				if count += byDeadline[d]; count > d {
+					feasible = false
+				}
+			}
+			if feasible && profit > best {
+				best = profit
+			}
+		}
+		profit, schedule := greedy.JobSequencing(jobs)
+		sum, seen := 0, map[int]bool{}
+		for slot, i := range schedule {
+			if i == -1 {
+				continue
+			}
+			if seen[i] || jobs[i].Deadline <= slot {
+				t.Fatalf("JobSequencing(%v) schedule %v is not valid", jobs, schedule)
+			}
+			seen[i] = true
+			sum += jobs[i].Profit
+		}
+		if profit != best || sum != profit {
+			t.Fatalf("JobSequencing(%v) = %d, %v, want %d", jobs, profit, schedule, best)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+// optimalmerge.go
+// description: Minimum cost merge of sorted files
+// details:
+// Merging two files costs the sum of their sizes, and a set of files is
+// merged into one by a sequence of pairwise merges. Always merging the two
+// smallest files is optimal, for the same reason as in Huffman coding: the
+// total cost is the sum of the sizes weighted by how many merges each file
+// goes through, which is the depth of its leaf in the tree of the merges.
+// The files waiting to be merged are kept in the binary heap of
+// structure/heap.
+// time complexity: O(n log n) for n files
+// space complexity: O(n)
+// reference: https://en.wikipedia.org/wiki/Huffman_coding#Optimality
+// see optimalmerge_test.go
+
+package greedy
+
+import "github.com/TheAlgorithms/Go/structure/heap"
+
+// Merge is the merge of files A and B into file Result. The files given to
+// OptimalMerge are numbered from 0 in their order, and the merged ones
+// follow in the order of the merges.
+type Merge struct {
+	A, B, Result int
+	Cost         int
+}
+
+// file is a file waiting to be merged.
+type file struct {
+	size, id int
+}
+
+// OptimalMerge returns the smallest total cost of merging files of the given
+// sizes into one, and the merges achieving it, in the order they are done.
+func OptimalMerge(sizes []int) (int, []Merge) {
+	files, _ := heap.NewAny[file](func(a, b file) bool {
+		return a.size < b.size || a.size == b.size && a.id < b.id
+	})
+	for id, size := range sizes {
+		files.Push(file{size, id})
+	}
+	total := 0
+	var merges []Merge
+	for next := len(sizes); files.Size() > 1; next++ {
+		a := files.Top()
+		files.Pop()
+		b := files.Top()
+		files.Pop()
+		cost := a.size + b.size
+		merges = append(merges, Merge{A: a.id, B: b.id, Result: next, Cost: cost})
+		total += cost
+		files.Push(file{cost, next})
+	}
+	return total, merges
+}
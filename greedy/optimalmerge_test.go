@@ -0,0 +1,61 @@
+package greedy_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/greedy"
+)
+
+func TestOptimalMerge(t *testing.T) {
+	cost, merges := greedy.OptimalMerge([]int{20, 30, 10, 5, 30})
+	want := []greedy.Merge{
+		{A: 3, B: 2, Result: 5, Cost: 15},
+		{A: 5, B: 0, Result: 6, Cost: 35},
+		{A: 1, B: 4, Result: 7, Cost: 60},
+		{A: 6, B: 7, Result: 8, Cost: 95},
+	}
+	if cost != 205 || !reflect.DeepEqual(merges, want) {
+		t.Errorf("OptimalMerge = %d, %v, want 205, %v", cost, merges, want)
+	}
+	for _, sizes := range [][]int{nil, {7}} {
+		if cost, merges := greedy.OptimalMerge(sizes); cost != 0 || len(merges) != 0 {
+			t.Errorf("OptimalMerge(%v) = %d, %v, want no merge", sizes, cost, merges)
+		}
+	}
+}
+
+// mergeCost returns the cost of merging the files in the given order, from
+// left to right.
+func mergeCost(sizes []int) int {
+	cost, acc := 0, sizes[0]
+	for _, s := range sizes[1:] {
+		acc += s
+		cost += acc
+	}
+	return cost
+}
+
+func TestOptimalMergeBeatsSequential(t *testing.T) {
+	sizes := []int{8, 4, 6, 12, 3, 9}
+	cost, merges := greedy.OptimalMerge(sizes)
+	if len(merges) != len(sizes)-1 {
+		t.Fatalf("%d merges for %d files", len(merges), len(sizes))
+	}
+	// replaying the merges gives the cost
+	files := append([]int(nil), sizes...)
+	replayed := 0
+	for _, m := range merges {
+		if m.Result != len(files) || m.Cost != files[m.A]+files[m.B] {
+			t.Fatalf("merge %+v does not match the files %v", m, files)
+		}
+		files = append(files, m.Cost)
+		replayed += m.Cost
+	}
+	if replayed != cost {
+		t.Errorf("merges cost %d, OptimalMerge returned %d", replayed, cost)
+	}
+	if sequential := mergeCost([]int{3, 4, 6, 8, 9, 12}); cost > sequential {
+		t.Errorf("OptimalMerge cost %d is above the sorted sequential cost %d", cost, sequential)
+	}
+}
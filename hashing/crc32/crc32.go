@@ -0,0 +1,109 @@
+// crc32.go
+// description: Table-driven CRC-32 with custom polynomials
+// details:
+// A CRC is the remainder of the division of the message, seen as a polynomial
+// over GF(2), by a generator polynomial. The reflected (least significant bit
+// first) form used by Ethernet, zip and PNG processes a byte at a time with a
+// table of the remainders of the 256 byte values. The register starts at all
+// ones and is inverted at the end, as in the standard CRC-32.
+// time complexity: O(n)
+// space complexity: O(1), plus the 1 KiB table
+// ref: https://en.wikipedia.org/wiki/Cyclic_redundancy_check
+// ref: https://reveng.sourceforge.io/crc-catalogue/17plus.htm#crc.cat-bits.32
+// see crc32_test.go
+
+// Package crc32 implements the 32-bit cyclic redundancy check with any
+// polynomial, as a hash.Hash32.
+package crc32
+
+import (
+	"encoding/binary"
+	"hash"
+)
+
+// Size of a CRC-32 checksum in bytes.
+const Size = 4
+
+// Polynomials in reversed notation, their least significant bit being the
+// coefficient of x^31.
+const (
+	// IEEE is the polynomial of Ethernet, gzip, zip and PNG.
+	IEEE = 0xedb88320
+	// Castagnoli is the polynomial of iSCSI, SCTP and ext4.
+	Castagnoli = 0x82f63b78
+	// Koopman is the polynomial proposed by Koopman, with a larger Hamming
+	// distance than IEEE for some message lengths.
+	Koopman = 0xeb31d82e
+)
+
+// Table holds the remainders of the byte values for a polynomial.
+type Table [256]uint32
+
+// MakeTable returns the table of poly, given in reversed notation.
+func MakeTable(poly uint32) *Table {
+	t := new(Table)
+	for i := range t {
+		crc := uint32(i)
+		for j := 0; j < 8; j++ {
+			if crc&1 == 1 {
+				crc = crc>>1 ^ poly
+			} else {
+				crc >>= 1
+			}
+		}
+		t[i] = crc
+	}
+	return t
+}
+
+// IEEETable is the table of the IEEE polynomial.
+var IEEETable = MakeTable(IEEE)
+
+// Update returns the checksum of the data summed by crc followed by p.
+func Update(crc uint32, tab *Table, p []byte) uint32 {
+	crc = ^crc
+	for _, b := range p {
+		crc = tab[byte(crc)^b] ^ crc>>8
+	}
+	return ^crc
+}
+
+// Checksum returns the CRC-32 of data with the polynomial of tab.
+func Checksum(data []byte, tab *Table) uint32 {
+	return Update(0, tab, data)
+}
+
+// ChecksumIEEE returns the CRC-32 of data with the IEEE polynomial.
+func ChecksumIEEE(data []byte) uint32 {
+	return Update(0, IEEETable, data)
+}
+
+type digest struct {
+	crc uint32
+	tab *Table
+}
+
+// New returns a hash.Hash32 computing the CRC-32 with the polynomial of tab.
+// Its Sum appends the checksum in big-endian order.
+func New(tab *Table) hash.Hash32 {
+	return &digest{tab: tab}
+}
+
+// NewIEEE returns a hash.Hash32 computing the CRC-32 with the IEEE polynomial.
+func NewIEEE() hash.Hash32 {
+	return New(IEEETable)
+}
+
+func (d *digest) Size() int      { return Size }
+func (d *digest) BlockSize() int { return 1 }
+func (d *digest) Reset()         { d.crc = 0 }
+func (d *digest) Sum32() uint32  { return d.crc }
+
+func (d *digest) Write(p []byte) (int, error) {
+	d.crc = Update(d.crc, d.tab, p)
+	return len(p), nil
+}
+
+func (d *digest) Sum(in []byte) []byte {
+	return binary.BigEndian.AppendUint32(in, d.crc)
+}
@@ -0,0 +1,75 @@
+// crc32_test.go
+// description: Tests for the table-driven CRC-32
+// see crc32.go
+
+package crc32
+
+import (
+	stdcrc32 "hash/crc32"
+	"math/rand"
+	"testing"
+)
+
+func TestCheckValues(t *testing.T) {
+	// The check values of the CRC catalogue, for the bytes "123456789".
+	tests := []struct {
+		name string
+		poly uint32
+		want uint32
+	}{
+		{"CRC-32/ISO-HDLC", IEEE, 0xcbf43926},
+		{"CRC-32/ISCSI", Castagnoli, 0xe3069283},
+		{"CRC-32/KOOPMAN", Koopman, 0x2d3dd0ae},
+		{"CRC-32/BASE91-D", 0xd419cc15, 0x87315576},
+	}
+	for _, test := range tests {
+		if got := Checksum([]byte("123456789"), MakeTable(test.poly)); got != test.want {
+			t.Errorf("%s: got %08x, want %08x", test.name, got, test.want)
+		}
+	}
+	if got := ChecksumIEEE(nil); got != 0 {
+		t.Errorf("ChecksumIEEE(nil) = %08x, want 0", got)
+	}
+}
+
+func TestMatchesStandardLibrary(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	data := make([]byte, 10000)
+	rnd.Read(data)
+	for _, poly := range []uint32{IEEE, Castagnoli, Koopman} {
+		want := stdcrc32.Checksum(data, stdcrc32.MakeTable(poly))
+		h := New(MakeTable(poly))
+		// write in uneven pieces
+		for rest := data; len(rest) > 0; {
+			n := rnd.Intn(100) + 1
+			if n > len(rest) {
+				n = len(rest)
+			}
+			_, _ = h.Write(rest[:n])
+			rest = rest[n:]
+		}
+		if got := h.Sum32(); got != want {
+			t.Errorf("polynomial %08x: got %08x, want %08x", poly, got, want)
+		}
+	}
+}
+
+func TestHash(t *testing.T) {
+	h := NewIEEE()
+	_, _ = h.Write([]byte("123456789"))
+	if got := h.Sum([]byte{0xff}); string(got) != "\xff\xcb\xf4\x39\x26" {
+		t.Errorf("Sum = %x", got)
+	}
+	h.Reset()
+	if h.Sum32() != 0 || h.Size() != 4 {
+		t.Errorf("after Reset: Sum32 = %08x, Size = %d", h.Sum32(), h.Size())
+	}
+}
+
+func BenchmarkChecksumIEEE(b *testing.B) {
+	data := make([]byte, 1<<16)
+	b.SetBytes(int64(len(data)))
+	for i := 0; i < b.N; i++ {
+		ChecksumIEEE(data)
+	}
+}
@@ -0,0 +1,103 @@
+// fnv.go
+// description: FNV-1a non-cryptographic hash functions
+// details:
+// FNV-1a starts from an offset basis and, for every byte, XORs the byte into
+// the hash and multiplies the hash by a prime. It is tiny and fast on short
+// keys such as identifiers, which made it a popular hash table function.
+// time complexity: O(n)
+// space complexity: O(1)
+// ref: https://datatracker.ietf.org/doc/html/draft-eastlake-fnv
+// ref: http://www.isthe.com/chongo/tech/comp/fnv/index.html
+// see fnv_test.go
+
+// Package fnv implements the 32 and 64-bit FNV-1a hash functions as
+// hash.Hash32 and hash.Hash64.
+package fnv
+
+import (
+	"encoding/binary"
+	"hash"
+)
+
+const (
+	offset32 = 2166136261
+	prime32  = 16777619
+	offset64 = 14695981039346656037
+	prime64  = 1099511628211
+)
+
+// Sum32a returns the 32-bit FNV-1a hash of data.
+func Sum32a(data []byte) uint32 {
+	h := uint32(offset32)
+	for _, b := range data {
+		h ^= uint32(b)
+		h *= prime32
+	}
+	return h
+}
+
+// Sum64a returns the 64-bit FNV-1a hash of data.
+func Sum64a(data []byte) uint64 {
+	h := uint64(offset64)
+	for _, b := range data {
+		h ^= uint64(b)
+		h *= prime64
+	}
+	return h
+}
+
+type digest32a uint32
+
+// New32a returns a hash.Hash32 computing the 32-bit FNV-1a hash. Its Sum
+// appends the hash in big-endian order.
+func New32a() hash.Hash32 {
+	d := digest32a(offset32)
+	return &d
+}
+
+func (d *digest32a) Size() int      { return 4 }
+func (d *digest32a) BlockSize() int { return 1 }
+func (d *digest32a) Reset()         { *d = offset32 }
+func (d *digest32a) Sum32() uint32  { return uint32(*d) }
+
+func (d *digest32a) Write(p []byte) (int, error) {
+	h := uint32(*d)
+	for _, b := range p {
+		h ^= uint32(b)
+		h *= prime32
+	}
+	*d = digest32a(h)
+	return len(p), nil
+}
+
+func (d *digest32a) Sum(in []byte) []byte {
+	return binary.BigEndian.AppendUint32(in, uint32(*d))
+}
+
+type digest64a uint64
+
+// New64a returns a hash.Hash64 computing the 64-bit FNV-1a hash. Its Sum
+// appends the hash in big-endian order.
+func New64a() hash.Hash64 {
+	d := digest64a(offset64)
+	return &d
+}
+
+func (d *digest64a) Size() int      { return 8 }
+func (d *digest64a) BlockSize() int { return 1 }
+func (d *digest64a) Reset()         { *d = offset64 }
+func (d *digest64a) Sum64() uint64  { return uint64(*d) }
+
+func (d *digest64a) Write(p []byte) (int, error) {
+	h := uint64(*d)
+	for _, b := range p {
+		h ^= uint64(b)
+		h *= prime64
+	}
+	*d = digest64a(h)
+	return len(p), nil
+}
+
+func (d *digest64a) Sum(in []byte) []byte {
+	return binary.BigEndian.AppendUint64(in, uint64(*d))
+}
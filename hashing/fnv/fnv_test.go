@@ -0,0 +1,60 @@
+// fnv_test.go
+// description: Tests for the FNV-1a hash functions
+// see fnv.go
+
+package fnv
+
+import (
+	stdfnv "hash/fnv"
+	"math/rand"
+	"testing"
+)
+
+func TestVectors(t *testing.T) {
+	// Test vectors of the reference implementation.
+	tests := []struct {
+		input  string
+		want32 uint32
+		want64 uint64
+	}{
+		{"", 0x811c9dc5, 0xcbf29ce484222325},
+		{"a", 0xe40c292c, 0xaf63dc4c8601ec8c},
+		{"foobar", 0xbf9cf968, 0x85944171f73967e8},
+	}
+	for _, test := range tests {
+		if got := Sum32a([]byte(test.input)); got != test.want32 {
+			t.Errorf("Sum32a(%q) = %08x, want %08x", test.input, got, test.want32)
+		}
+		if got := Sum64a([]byte(test.input)); got != test.want64 {
+			t.Errorf("Sum64a(%q) = %016x, want %016x", test.input, got, test.want64)
+		}
+	}
+}
+
+func TestMatchesStandardLibrary(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	data := make([]byte, 1000)
+	rnd.Read(data)
+	h32, h64 := New32a(), New64a()
+	want32, want64 := stdfnv.New32a(), stdfnv.New64a()
+	for i := 0; i < len(data); i += 7 {
+		end := i + 7
+		if end > len(data) {
+			end = len(data)
+		}
+		for _, h := range []interface{ Write([]byte) (int, error) }{h32, h64, want32, want64} {
+			_, _ = h.Write(data[i:end])
+		}
+		if h32.Sum32() != want32.Sum32() || h64.Sum64() != want64.Sum64() {
+			t.Fatalf("hashes of %d bytes differ from hash/fnv", end)
+		}
+	}
+	if string(h32.Sum(nil)) != string(want32.Sum(nil)) || string(h64.Sum(nil)) != string(want64.Sum(nil)) {
+		t.Error("Sum differs from hash/fnv")
+	}
+	h32.Reset()
+	h64.Reset()
+	if h32.Sum32() != Sum32a(nil) || h64.Sum64() != Sum64a(nil) {
+		t.Error("Reset does not restore the offset basis")
+	}
+}
@@ -0,0 +1,242 @@
+// murmur3.go
+// description: MurmurHash3 non-cryptographic hash functions
+// details:
+// MurmurHash3 mixes the input in blocks of 4 bytes (x86_32 variant) or of 16
+// bytes in two 64-bit lanes (x64_128 variant) with multiplications and
+// rotations, mixes in the remaining bytes and the length, and ends with a
+// finalizer that makes every output bit depend on every input bit. It is fast
+// and well distributed, and is used for hash tables and Bloom filters; it
+// gives no protection against crafted collisions.
+// time complexity: O(n)
+// space complexity: O(1)
+// ref: https://github.com/aappleby/smhasher/blob/master/src/MurmurHash3.cpp
+// ref: https://en.wikipedia.org/wiki/MurmurHash
+// see murmur3_test.go
+
+// Package murmur3 implements the 32-bit (x86_32) and 128-bit (x64_128)
+// variants of MurmurHash3 as hash.Hash32 and Hash128.
+package murmur3
+
+import (
+	"encoding/binary"
+	"hash"
+	"math/bits"
+)
+
+const (
+	c1x32 = 0xcc9e2d51
+	c2x32 = 0x1b873593
+	c1x64 = 0x87c37b91114253d5
+	c2x64 = 0x4cf5ad432745937f
+)
+
+func fmix32(h uint32) uint32 {
+	h ^= h >> 16
+	h *= 0x85ebca6b
+	h ^= h >> 13
+	h *= 0xc2b2ae35
+	h ^= h >> 16
+	return h
+}
+
+func fmix64(k uint64) uint64 {
+	k ^= k >> 33
+	k *= 0xff51afd7ed558ccd
+	k ^= k >> 33
+	k *= 0xc4ceb9fe1a85ec53
+	k ^= k >> 33
+	return k
+}
+
+// block32 mixes a block of 4 bytes into h.
+func block32(h, k uint32) uint32 {
+	k *= c1x32
+	k = bits.RotateLeft32(k, 15)
+	k *= c2x32
+	h ^= k
+	h = bits.RotateLeft32(h, 13)
+	return h*5 + 0xe6546b64
+}
+
+// tail32 mixes the last 0 to 3 bytes and the length into h and finalizes it.
+func tail32(h uint32, tail []byte, length int) uint32 {
+	var k uint32
+	for i := len(tail) - 1; i >= 0; i-- {
+		k = k<<8 | uint32(tail[i])
+	}
+	if len(tail) > 0 {
+		k *= c1x32
+		k = bits.RotateLeft32(k, 15)
+		k *= c2x32
+		h ^= k
+	}
+	h ^= uint32(length)
+	return fmix32(h)
+}
+
+// Sum32 returns the x86_32 MurmurHash3 of data with seed.
+func Sum32(data []byte, seed uint32) uint32 {
+	h := seed
+	n := len(data) / 4 * 4
+	for i := 0; i < n; i += 4 {
+		h = block32(h, binary.LittleEndian.Uint32(data[i:]))
+	}
+	return tail32(h, data[n:], len(data))
+}
+
+// block128 mixes a block of 16 bytes into h1 and h2.
+func block128(h1, h2, k1, k2 uint64) (uint64, uint64) {
+	k1 *= c1x64
+	k1 = bits.RotateLeft64(k1, 31)
+	k1 *= c2x64
+	h1 ^= k1
+	h1 = bits.RotateLeft64(h1, 27)
+	h1 += h2
+	h1 = h1*5 + 0x52dce729
+
+	k2 *= c2x64
+	k2 = bits.RotateLeft64(k2, 33)
+	k2 *= c1x64
+	h2 ^= k2
+	h2 = bits.RotateLeft64(h2, 31)
+	h2 += h1
+	h2 = h2*5 + 0x38495ab5
+	return h1, h2
+}
+
+// tail128 mixes the last 0 to 15 bytes and the length into h1 and h2 and
+// finalizes them.
+func tail128(h1, h2 uint64, tail []byte, length int) (uint64, uint64) {
+	var k1, k2 uint64
+	for i := len(tail) - 1; i >= 0; i-- {
+		if i >= 8 {
+			k2 = k2<<8 | uint64(tail[i])
+		} else {
+			k1 = k1<<8 | uint64(tail[i])
+		}
+	}
+	if len(tail) > 8 {
+		k2 *= c2x64
+		k2 = bits.RotateLeft64(k2, 33)
+		k2 *= c1x64
+		h2 ^= k2
+	}
+	if len(tail) > 0 {
+		k1 *= c1x64
+		k1 = bits.RotateLeft64(k1, 31)
+		k1 *= c2x64
+		h1 ^= k1
+	}
+	h1 ^= uint64(length)
+	h2 ^= uint64(length)
+	h1 += h2
+	h2 += h1
+	h1, h2 = fmix64(h1), fmix64(h2)
+	h1 += h2
+	h2 += h1
+	return h1, h2
+}
+
+// Sum128 returns the two halves of the x64_128 MurmurHash3 of data with seed.
+// The reference implementation writes h1 then h2 in little-endian order.
+func Sum128(data []byte, seed uint32) (h1, h2 uint64) {
+	h1, h2 = uint64(seed), uint64(seed)
+	n := len(data) / 16 * 16
+	for i := 0; i < n; i += 16 {
+		h1, h2 = block128(h1, h2, binary.LittleEndian.Uint64(data[i:]), binary.LittleEndian.Uint64(data[i+8:]))
+	}
+	return tail128(h1, h2, data[n:], len(data))
+}
+
+// Hash128 is a hash.Hash with a 128-bit result.
+type Hash128 interface {
+	hash.Hash
+	Sum128() (h1, h2 uint64)
+}
+
+// digest buffers the bytes of an incomplete block between writes.
+type digest struct {
+	seed   uint32
+	h1, h2 uint64 // h1 alone holds the 32-bit state
+	buffer [16]byte
+	n      int // bytes in buffer
+	length int
+	block  int
+	mix    func(d *digest, p []byte) // mixes complete blocks into the state
+}
+
+func (d *digest) BlockSize() int { return d.block }
+
+func (d *digest) Reset() {
+	d.h1, d.h2, d.n, d.length = uint64(d.seed), uint64(d.seed), 0, 0
+}
+
+func (d *digest) Write(p []byte) (int, error) {
+	written := len(p)
+	d.length += len(p)
+	if d.n > 0 {
+		c := copy(d.buffer[d.n:d.block], p)
+		d.n += c
+		p = p[c:]
+		if d.n < d.block {
+			return written, nil
+		}
+		d.mix(d, d.buffer[:d.block])
+		d.n = 0
+	}
+	n := len(p) / d.block * d.block
+	d.mix(d, p[:n])
+	d.n = copy(d.buffer[:], p[n:])
+	return written, nil
+}
+
+// New32 returns a hash.Hash32 computing the x86_32 MurmurHash3 with seed. Its
+// Sum appends the hash in big-endian order.
+func New32(seed uint32) hash.Hash32 {
+	d := &digest32{digest{seed: seed, block: 4, mix: func(d *digest, p []byte) {
+		h := uint32(d.h1)
+		for i := 0; i < len(p); i += 4 {
+			h = block32(h, binary.LittleEndian.Uint32(p[i:]))
+		}
+		d.h1 = uint64(h)
+	}}}
+	d.Reset()
+	return d
+}
+
+type digest32 struct{ digest }
+
+func (d *digest32) Size() int { return 4 }
+
+func (d *digest32) Sum32() uint32 {
+	return tail32(uint32(d.h1), d.buffer[:d.n], d.length)
+}
+
+func (d *digest32) Sum(in []byte) []byte {
+	return binary.BigEndian.AppendUint32(in, d.Sum32())
+}
+
+// New128 returns a Hash128 computing the x64_128 MurmurHash3 with seed. Its
+// Sum appends h1 then h2 in big-endian order.
+func New128(seed uint32) Hash128 {
+	d := &digest128{digest{seed: seed, block: 16, mix: func(d *digest, p []byte) {
+		for i := 0; i < len(p); i += 16 {
+			d.h1, d.h2 = block128(d.h1, d.h2, binary.LittleEndian.Uint64(p[i:]), binary.LittleEndian.Uint64(p[i+8:]))
+		}
+	}}}
+	d.Reset()
+	return d
+}
+
+type digest128 struct{ digest }
+
+func (d *digest128) Size() int { return 16 }
+
+func (d *digest128) Sum128() (h1, h2 uint64) {
+	return tail128(d.h1, d.h2, d.buffer[:d.n], d.length)
+}
+
+func (d *digest128) Sum(in []byte) []byte {
+	h1, h2 := d.Sum128()
+	return binary.BigEndian.AppendUint64(binary.BigEndian.AppendUint64(in, h1), h2)
+}
@@ -0,0 +1,96 @@
+// murmur3_test.go
+// description: Tests for MurmurHash3
+// see murmur3.go
+
+package murmur3
+
+import (
+	"math/rand"
+	"testing"
+)
+
+const fox = "The quick brown fox jumps over the lazy dog"
+
+func TestSum32(t *testing.T) {
+	// Values of the reference implementation, MurmurHash3_x86_32.
+	tests := []struct {
+		input string
+		seed  uint32
+		want  uint32
+	}{
+		{"", 0, 0},
+		{"", 1, 0x514e28b7},
+		{"", 0xffffffff, 0x81f16f39},
+		{"\x00\x00\x00\x00", 0, 0x2362f9de},
+		{"test", 0, 0xba6bd213},
+		{"Hello, world!", 0, 0xc0363e43},
+		{"Hello, world!", 1234, 0xfaf6cdb3},
+		{fox, 0, 0x2e4ff723},
+	}
+	for _, test := range tests {
+		if got := Sum32([]byte(test.input), test.seed); got != test.want {
+			t.Errorf("Sum32(%q, %d) = %08x, want %08x", test.input, test.seed, got, test.want)
+		}
+	}
+}
+
+func TestSum128(t *testing.T) {
+	// Values of the reference implementation, MurmurHash3_x64_128.
+	tests := []struct {
+		input  string
+		seed   uint32
+		h1, h2 uint64
+	}{
+		{"", 0, 0, 0},
+		{fox, 0, 0xe34bbc7bbc071b6c, 0x7a433ca9c49a9347},
+	}
+	for _, test := range tests {
+		if h1, h2 := Sum128([]byte(test.input), test.seed); h1 != test.h1 || h2 != test.h2 {
+			t.Errorf("Sum128(%q, %d) = %016x %016x, want %016x %016x", test.input, test.seed, h1, h2, test.h1, test.h2)
+		}
+	}
+}
+
+func TestStreaming(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	data := make([]byte, 1000)
+	rnd.Read(data)
+	for _, seed := range []uint32{0, 42} {
+		h32, h128 := New32(seed), New128(seed)
+		for written := 0; written < len(data); {
+			n := rnd.Intn(20)
+			if written+n > len(data) {
+				n = len(data) - written
+			}
+			_, _ = h32.Write(data[written : written+n])
+			_, _ = h128.Write(data[written : written+n])
+			written += n
+			if got, want := h32.Sum32(), Sum32(data[:written], seed); got != want {
+				t.Fatalf("New32 after %d bytes: %08x, want %08x", written, got, want)
+			}
+			h1, h2 := h128.Sum128()
+			if w1, w2 := Sum128(data[:written], seed); h1 != w1 || h2 != w2 {
+				t.Fatalf("New128 after %d bytes: %016x %016x, want %016x %016x", written, h1, h2, w1, w2)
+			}
+		}
+		h32.Reset()
+		h128.Reset()
+		h1, h2 := h128.Sum128()
+		if w1, w2 := Sum128(nil, seed); h32.Sum32() != Sum32(nil, seed) || h1 != w1 || h2 != w2 {
+			t.Errorf("seed %d: Reset does not restore the initial state", seed)
+		}
+	}
+	h := New128(0)
+	_, _ = h.Write([]byte(fox))
+	if got := h.Sum(nil); string(got) != "\xe3\x4b\xbc\x7b\xbc\x07\x1b\x6c\x7a\x43\x3c\xa9\xc4\x9a\x93\x47" {
+		t.Errorf("Sum = %x", got)
+	}
+}
+
+func BenchmarkSum128(b *testing.B) {
+	data := make([]byte, 1<<16)
+	b.SetBytes(int64(len(data)))
+	for i := 0; i < b.N; i++ {
+		Sum128(data, 0)
+	}
+}
@@ -0,0 +1,264 @@
+// xxhash.go
+// description: xxHash (XXH32 and XXH64) non-cryptographic hash functions
+// details:
+// xxHash keeps four accumulators that consume the input in stripes of four
+// lanes (16 bytes for XXH32, 32 bytes for XXH64) independently of each other,
+// which lets the processor work on them in parallel. The accumulators are
+// then merged, the last bytes and the length are mixed in and a final
+// avalanche spreads every input bit over the output. It runs close to memory
+// speed and passes the SMHasher quality tests.
+// time complexity: O(n)
+// space complexity: O(1)
+// ref: https://github.com/Cyan4973/xxHash/blob/dev/doc/xxhash_spec.md
+// see xxhash_test.go
+
+// Package xxhash implements the XXH32 and XXH64 hash functions as
+// hash.Hash32 and hash.Hash64.
+package xxhash
+
+import (
+	"encoding/binary"
+	"hash"
+	"math/bits"
+)
+
+const (
+	prime32x1 uint32 = 0x9e3779b1
+	prime32x2 uint32 = 0x85ebca77
+	prime32x3 uint32 = 0xc2b2ae3d
+	prime32x4 uint32 = 0x27d4eb2f
+	prime32x5 uint32 = 0x165667b1
+
+	prime64x1 uint64 = 0x9e3779b185ebca87
+	prime64x2 uint64 = 0xc2b2ae3d27d4eb4f
+	prime64x3 uint64 = 0x165667b19e3779f9
+	prime64x4 uint64 = 0x85ebca77c2b2ae63
+	prime64x5 uint64 = 0x27d4eb2f165667c5
+)
+
+// state32 holds the accumulators of XXH32.
+type state32 [4]uint32
+
+func newState32(seed uint32) state32 {
+	return state32{seed + prime32x1 + prime32x2, seed + prime32x2, seed, seed - prime32x1}
+}
+
+func round32(acc, lane uint32) uint32 {
+	acc += lane * prime32x2
+	return bits.RotateLeft32(acc, 13) * prime32x1
+}
+
+// stripes consumes the complete 16-byte stripes of p.
+func (s *state32) stripes(p []byte) {
+	for ; len(p) >= 16; p = p[16:] {
+		s[0] = round32(s[0], binary.LittleEndian.Uint32(p))
+		s[1] = round32(s[1], binary.LittleEndian.Uint32(p[4:]))
+		s[2] = round32(s[2], binary.LittleEndian.Uint32(p[8:]))
+		s[3] = round32(s[3], binary.LittleEndian.Uint32(p[12:]))
+	}
+}
+
+// finish returns the hash of an input of length bytes whose stripes went
+// through s, ending with the bytes of tail.
+func (s *state32) finish(seed uint32, tail []byte, length int) uint32 {
+	var h uint32
+	if length >= 16 {
+		h = bits.RotateLeft32(s[0], 1) + bits.RotateLeft32(s[1], 7) + bits.RotateLeft32(s[2], 12) + bits.RotateLeft32(s[3], 18)
+	} else {
+		h = seed + prime32x5
+	}
+	h += uint32(length)
+	for ; len(tail) >= 4; tail = tail[4:] {
+		h += binary.LittleEndian.Uint32(tail) * prime32x3
+		h = bits.RotateLeft32(h, 17) * prime32x4
+	}
+	for _, b := range tail {
+		h += uint32(b) * prime32x5
+		h = bits.RotateLeft32(h, 11) * prime32x1
+	}
+	h ^= h >> 15
+	h *= prime32x2
+	h ^= h >> 13
+	h *= prime32x3
+	h ^= h >> 16
+	return h
+}
+
+// Sum32 returns the XXH32 hash of data with seed.
+func Sum32(data []byte, seed uint32) uint32 {
+	s := newState32(seed)
+	n := len(data) / 16 * 16
+	s.stripes(data[:n])
+	return s.finish(seed, data[n:], len(data))
+}
+
+// state64 holds the accumulators of XXH64.
+type state64 [4]uint64
+
+func newState64(seed uint64) state64 {
+	return state64{seed + prime64x1 + prime64x2, seed + prime64x2, seed, seed - prime64x1}
+}
+
+func round64(acc, lane uint64) uint64 {
+	acc += lane * prime64x2
+	return bits.RotateLeft64(acc, 31) * prime64x1
+}
+
+func mergeRound64(acc, v uint64) uint64 {
+	acc ^= round64(0, v)
+	return acc*prime64x1 + prime64x4
+}
+
+// stripes consumes the complete 32-byte stripes of p.
+func (s *state64) stripes(p []byte) {
+	for ; len(p) >= 32; p = p[32:] {
+		s[0] = round64(s[0], binary.LittleEndian.Uint64(p))
+		s[1] = round64(s[1], binary.LittleEndian.Uint64(p[8:]))
+		s[2] = round64(s[2], binary.LittleEndian.Uint64(p[16:]))
+		s[3] = round64(s[3], binary.LittleEndian.Uint64(p[24:]))
+	}
+}
+
+// finish returns the hash of an input of length bytes whose stripes went
+// through s, ending with the bytes of tail.
+func (s *state64) finish(seed uint64, tail []byte, length int) uint64 {
+	var h uint64
+	if length >= 32 {
+		h = bits.RotateLeft64(s[0], 1) + bits.RotateLeft64(s[1], 7) + bits.RotateLeft64(s[2], 12) + bits.RotateLeft64(s[3], 18)
+		for _, v := range s {
+			h = mergeRound64(h, v)
+		}
+	} else {
+		h = seed + prime64x5
+	}
+	h += uint64(length)
+	for ; len(tail) >= 8; tail = tail[8:] {
+		h ^= round64(0, binary.LittleEndian.Uint64(tail))
+		h = bits.RotateLeft64(h, 27)*prime64x1 + prime64x4
+	}
+	if len(tail) >= 4 {
+		h ^= uint64(binary.LittleEndian.Uint32(tail)) * prime64x1
+		h = bits.RotateLeft64(h, 23)*prime64x2 + prime64x3
+		tail = tail[4:]
+	}
+	for _, b := range tail {
+		h ^= uint64(b) * prime64x5
+		h = bits.RotateLeft64(h, 11) * prime64x1
+	}
+	h ^= h >> 33
+	h *= prime64x2
+	h ^= h >> 29
+	h *= prime64x3
+	h ^= h >> 32
+	return h
+}
+
+// Sum64 returns the XXH64 hash of data with seed.
+func Sum64(data []byte, seed uint64) uint64 {
+	s := newState64(seed)
+	n := len(data) / 32 * 32
+	s.stripes(data[:n])
+	return s.finish(seed, data[n:], len(data))
+}
+
+// digest32 buffers the bytes of an incomplete stripe between writes.
+type digest32 struct {
+	seed   uint32
+	state  state32
+	buffer [16]byte
+	n      int
+	length int
+}
+
+// New32 returns a hash.Hash32 computing XXH32 with seed. Its Sum appends the
+// hash in big-endian order, the canonical representation of xxHash.
+func New32(seed uint32) hash.Hash32 {
+	d := &digest32{seed: seed}
+	d.Reset()
+	return d
+}
+
+func (d *digest32) Size() int      { return 4 }
+func (d *digest32) BlockSize() int { return 16 }
+
+func (d *digest32) Reset() {
+	d.state, d.n, d.length = newState32(d.seed), 0, 0
+}
+
+func (d *digest32) Write(p []byte) (int, error) {
+	written := len(p)
+	d.length += len(p)
+	if d.n > 0 {
+		c := copy(d.buffer[d.n:], p)
+		d.n += c
+		p = p[c:]
+		if d.n < len(d.buffer) {
+			return written, nil
+		}
+		d.state.stripes(d.buffer[:])
+		d.n = 0
+	}
+	n := len(p) / 16 * 16
+	d.state.stripes(p[:n])
+	d.n = copy(d.buffer[:], p[n:])
+	return written, nil
+}
+
+func (d *digest32) Sum32() uint32 {
+	return d.state.finish(d.seed, d.buffer[:d.n], d.length)
+}
+
+func (d *digest32) Sum(in []byte) []byte {
+	return binary.BigEndian.AppendUint32(in, d.Sum32())
+}
+
+// digest64 buffers the bytes of an incomplete stripe between writes.
+type digest64 struct {
+	seed   uint64
+	state  state64
+	buffer [32]byte
+	n      int
+	length int
+}
+
+// New64 returns a hash.Hash64 computing XXH64 with seed. Its Sum appends the
+// hash in big-endian order, the canonical representation of xxHash.
+func New64(seed uint64) hash.Hash64 {
+	d := &digest64{seed: seed}
+	d.Reset()
+	return d
+}
+
+func (d *digest64) Size() int      { return 8 }
+func (d *digest64) BlockSize() int { return 32 }
+
+func (d *digest64) Reset() {
+	d.state, d.n, d.length = newState64(d.seed), 0, 0
+}
+
+func (d *digest64) Write(p []byte) (int, error) {
+	written := len(p)
+	d.length += len(p)
+	if d.n > 0 {
+		c := copy(d.buffer[d.n:], p)
+		d.n += c
+		p = p[c:]
+		if d.n < len(d.buffer) {
+			return written, nil
+		}
+		d.state.stripes(d.buffer[:])
+		d.n = 0
+	}
+	n := len(p) / 32 * 32
+	d.state.stripes(p[:n])
+	d.n = copy(d.buffer[:], p[n:])
+	return written, nil
+}
+
+func (d *digest64) Sum64() uint64 {
+	return d.state.finish(d.seed, d.buffer[:d.n], d.length)
+}
+
+func (d *digest64) Sum(in []byte) []byte {
+	return binary.BigEndian.AppendUint64(in, d.Sum64())
+}
@@ -0,0 +1,79 @@
+// xxhash_test.go
+// description: Tests for the XXH32 and XXH64 hash functions
+// see xxhash.go
+
+package xxhash
+
+import (
+	"math/rand"
+	"testing"
+)
+
+const spam = "Nobody inspects the spammish repetition"
+
+func TestVectors(t *testing.T) {
+	// Values of the reference implementation.
+	tests := []struct {
+		input  string
+		want32 uint32
+		want64 uint64
+	}{
+		{"", 0x02cc5d05, 0xef46db3751d8e999},
+		{"a", 0x550d7456, 0xd24ec4f1a98c6e5b},
+		{"abc", 0x32d153ff, 0x44bc2cf5ad770999},
+		{spam, 0xe2293b2f, 0xfbcea83c8a378bf1},
+	}
+	for _, test := range tests {
+		if got := Sum32([]byte(test.input), 0); got != test.want32 {
+			t.Errorf("Sum32(%q) = %08x, want %08x", test.input, got, test.want32)
+		}
+		if got := Sum64([]byte(test.input), 0); got != test.want64 {
+			t.Errorf("Sum64(%q) = %016x, want %016x", test.input, got, test.want64)
+		}
+	}
+	if Sum64([]byte(spam), 1) == Sum64([]byte(spam), 0) || Sum32([]byte(spam), 1) == Sum32([]byte(spam), 0) {
+		t.Error("the seed does not change the hash")
+	}
+}
+
+func TestStreaming(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	data := make([]byte, 1000)
+	rnd.Read(data)
+	for _, seed := range []uint32{0, 7} {
+		h32, h64 := New32(seed), New64(uint64(seed))
+		for written := 0; written < len(data); {
+			n := rnd.Intn(40)
+			if written+n > len(data) {
+				n = len(data) - written
+			}
+			_, _ = h32.Write(data[written : written+n])
+			_, _ = h64.Write(data[written : written+n])
+			written += n
+			if got, want := h32.Sum32(), Sum32(data[:written], seed); got != want {
+				t.Fatalf("New32 after %d bytes: %08x, want %08x", written, got, want)
+			}
+			if got, want := h64.Sum64(), Sum64(data[:written], uint64(seed)); got != want {
+				t.Fatalf("New64 after %d bytes: %016x, want %016x", written, got, want)
+			}
+		}
+		h32.Reset()
+		h64.Reset()
+		if h32.Sum32() != Sum32(nil, seed) || h64.Sum64() != Sum64(nil, uint64(seed)) {
+			t.Errorf("seed %d: Reset does not restore the initial state", seed)
+		}
+	}
+	h := New64(0)
+	_, _ = h.Write([]byte("abc"))
+	if got := h.Sum(nil); string(got) != "\x44\xbc\x2c\xf5\xad\x77\x09\x99" {
+		t.Errorf("Sum = %x", got)
+	}
+}
+
+func BenchmarkSum64(b *testing.B) {
+	data := make([]byte, 1<<16)
+	b.SetBytes(int64(len(data)))
+	for i := 0; i < b.N; i++ {
+		Sum64(data, 0)
+	}
+}
@@ -0,0 +1,105 @@
+// model.go
+// description: Model-based testing of containers
+// details:
+// A model-based test applies a random sequence of operations both to the
+// container under test and to a reference model that is obviously correct,
+// such as a sorted slice for a heap or a built-in map for a trie, and checks
+// after every step that they agree. Invariants of the container, like the
+// balance of a tree, can be checked along the way. When the two disagree the
+// failure lists the operations applied so far, which together with the seed
+// or the fuzzing input is enough to reproduce it.
+// The same Model runs from a seed in ordinary tests (Check) and from the
+// bytes of a fuzzing input in `go test -fuzz` harnesses (Fuzz).
+// see testutil_test.go
+
+// Package testutil provides helpers shared by the tests of the repository:
+// model-based testing of containers against reference implementations,
+// driven either by a seeded generator or by fuzzing inputs.
+package testutil
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// Op is one kind of operation of a model-based test.
+type Op[S, M any] struct {
+	Name string
+	// Run draws the arguments of the operation from src, applies it to the
+	// container sut and to the model, and returns an error describing any
+	// disagreement between them. Its first return value describes the
+	// operation applied, such as "Push(3)", for the failure report.
+	Run func(src *Source, sut S, model M) (string, error)
+}
+
+// Model describes a model-based test of a container S against a reference
+// model M.
+type Model[S, M any] struct {
+	// New returns an empty container and the matching empty model.
+	New func() (S, M)
+	// Ops are the operations to pick from, with equal probability.
+	Ops []Op[S, M]
+	// Invariant, when not nil, is checked after every operation.
+	Invariant func(sut S, model M) error
+}
+
+// run applies up to steps operations drawn from src, or operations until src
+// is exhausted when steps is negative, and returns the applied operations
+// and the first error.
+func (m Model[S, M]) run(src *Source, steps int) ([]string, error) {
+	if len(m.Ops) == 0 {
+		panic("testutil: model without operations")
+	}
+	sut, model := m.New()
+	var history []string
+	for i := 0; steps < 0 || i < steps; i++ {
+		if src.Exhausted() {
+			break
+		}
+		op := m.Ops[src.Intn(len(m.Ops))]
+		applied, err := op.Run(src, sut, model)
+		if applied == "" {
+			applied = op.Name
+		}
+		history = append(history, applied)
+		if err == nil && m.Invariant != nil {
+			err = m.Invariant(sut, model)
+		}
+		if err != nil {
+			return history, err
+		}
+	}
+	return history, nil
+}
+
+// report fails t with err and the operations that led to it. Long histories
+// are shortened to their last operations.
+func report(t testing.TB, history []string, err error) {
+	t.Helper()
+	const shown = 20
+	prefix := ""
+	if len(history) > shown {
+		prefix = fmt.Sprintf("... %d operations, ", len(history)-shown)
+		history = history[len(history)-shown:]
+	}
+	t.Fatalf("after %s%s: %v", prefix, strings.Join(history, ", "), err)
+}
+
+// Check runs steps random operations drawn from a generator seeded with
+// seed, and fails t at the first disagreement.
+func (m Model[S, M]) Check(t testing.TB, seed int64, steps int) {
+	t.Helper()
+	if history, err := m.run(NewSource(seed), steps); err != nil {
+		report(t, history, fmt.Errorf("seed %d: %w", seed, err))
+	}
+}
+
+// Fuzz runs the operations read from a fuzzing input until it is consumed,
+// and fails t at the first disagreement.
+func (m Model[S, M]) Fuzz(t testing.TB, data []byte) {
+	t.Helper()
+	if history, err := m.run(NewByteSource(data), -1); err != nil {
+		report(t, history, err)
+	}
+}
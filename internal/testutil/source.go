@@ -0,0 +1,59 @@
+// source.go
+// description: Source of the random choices of a model-based test
+// details:
+// A Source hands out the choices of a test run: which operation to apply next
+// and with which arguments. It either draws them from a seeded generator, so
+// that a failing run can be replayed from its seed, or reads them from the
+// bytes of a fuzzing input, which lets `go test -fuzz` mutate the operation
+// sequences themselves. A byte-backed source is exhausted once its bytes are
+// consumed, which ends the run.
+// see testutil_test.go
+
+package testutil
+
+import "math/rand"
+
+// Source draws the choices of a test run.
+type Source struct {
+	rnd  *rand.Rand
+	data []byte
+}
+
+// NewSource returns a Source drawing its choices from a generator seeded with
+// seed. It is never exhausted.
+func NewSource(seed int64) *Source {
+	return &Source{rnd: rand.New(rand.NewSource(seed))}
+}
+
+// NewByteSource returns a Source reading its choices from data.
+func NewByteSource(data []byte) *Source {
+	return &Source{data: data}
+}
+
+// Exhausted reports whether a byte-backed source has consumed all its bytes.
+func (s *Source) Exhausted() bool {
+	return s.rnd == nil && len(s.data) == 0
+}
+
+// Intn returns a choice in [0, n), which must be positive. An exhausted
+// source returns 0.
+func (s *Source) Intn(n int) int {
+	if n <= 0 {
+		panic("testutil: Intn of a non-positive bound")
+	}
+	if s.rnd != nil {
+		return s.rnd.Intn(n)
+	}
+	// read as many bytes as it takes to cover n choices
+	x := 0
+	for limit := 1; limit > 0 && limit < n && len(s.data) > 0; limit <<= 8 {
+		x = x<<8 | int(s.data[0])
+		s.data = s.data[1:]
+	}
+	return x % n
+}
+
+// Bool returns a choice between false and true.
+func (s *Source) Bool() bool {
+	return s.Intn(2) == 1
+}
@@ -0,0 +1,130 @@
+package testutil
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// recorder is a testing.TB that records the failure instead of stopping the
+// test.
+type recorder struct {
+	testing.TB
+	failure string
+}
+
+func (r *recorder) Helper() {}
+
+func (r *recorder) Fatalf(format string, args ...any) {
+	r.failure = fmt.Sprintf(format, args...)
+}
+
+// stack is a container under test: a stack of ints that is meant to pop its
+// largest element, which it does unless buggy is set and the element is 7.
+type stack struct {
+	values []int
+	buggy  bool
+}
+
+func (s *stack) push(x int) { s.values = append(s.values, x) }
+
+func (s *stack) popMax() int {
+	best := 0
+	for i, v := range s.values {
+		if v > s.values[best] && !(s.buggy && v == 7) {
+			best = i
+		}
+	}
+	x := s.values[best]
+	s.values = append(s.values[:best], s.values[best+1:]...)
+	return x
+}
+
+func maxModel(buggy bool) Model[*stack, *[]int] {
+	return Model[*stack, *[]int]{
+		New: func() (*stack, *[]int) { return &stack{buggy: buggy}, &[]int{} },
+		Ops: []Op[*stack, *[]int]{
+			{Name: "Push", Run: func(src *Source, s *stack, m *[]int) (string, error) {
+				x := src.Intn(10)
+				s.push(x)
+				*m = append(*m, x)
+				sort.Ints(*m)
+				return fmt.Sprintf("Push(%d)", x), nil
+			}},
+			{Name: "Pop", Run: func(src *Source, s *stack, m *[]int) (string, error) {
+				if len(*m) == 0 {
+					return "", nil
+				}
+				want := (*m)[len(*m)-1]
+				*m = (*m)[:len(*m)-1]
+				if got := s.popMax(); got != want {
+					return "", fmt.Errorf("Pop() = %d, want %d", got, want)
+				}
+				return "", nil
+			}},
+		},
+		Invariant: func(s *stack, m *[]int) error {
+			if len(s.values) != len(*m) {
+				return errors.New("sizes differ")
+			}
+			return nil
+		},
+	}
+}
+
+func TestModelCheck(t *testing.T) {
+	maxModel(false).Check(t, 1, 500)
+
+	r := &recorder{TB: t}
+	maxModel(true).Check(r, 1, 500)
+	if !strings.Contains(r.failure, "seed 1") || !strings.Contains(r.failure, "Pop() =") {
+		t.Errorf("the bug should be reported with the seed, got %q", r.failure)
+	}
+	if !strings.Contains(r.failure, "Push(") {
+		t.Errorf("the failure should list the operations, got %q", r.failure)
+	}
+}
+
+func TestModelFuzz(t *testing.T) {
+	// Push(3), Push(7), Pop
+	data := []byte{0, 3, 0, 7, 1}
+	maxModel(false).Fuzz(t, data)
+
+	r := &recorder{TB: t}
+	maxModel(true).Fuzz(r, data)
+	if want := "after Push(3), Push(7), Pop: Pop() = 3, want 7"; r.failure != want {
+		t.Errorf("failure = %q, want %q", r.failure, want)
+	}
+
+	// operations stop with the input, even in the middle of an operation
+	maxModel(true).Fuzz(t, []byte{0})
+	maxModel(true).Fuzz(t, nil)
+}
+
+func TestSource(t *testing.T) {
+	a, b := NewSource(5), NewSource(5)
+	for i := 0; i < 100; i++ {
+		if a.Intn(1000) != b.Intn(1000) {
+			t.Fatalf("sources with the same seed should agree")
+		}
+	}
+	if a.Exhausted() {
+		t.Errorf("a seeded source is never exhausted")
+	}
+
+	s := NewByteSource([]byte{5, 1, 2, 9})
+	if got := s.Intn(4); got != 1 {
+		t.Errorf("Intn(4) = %d, want 5 %% 4", got)
+	}
+	if got := s.Intn(1000); got != 258 {
+		t.Errorf("Intn(1000) = %d, want 1<<8 | 2", got)
+	}
+	if got := s.Bool(); !got {
+		t.Errorf("Bool() = false, want 9 %% 2 == 1")
+	}
+	if !s.Exhausted() || s.Intn(10) != 0 {
+		t.Errorf("an exhausted source should return 0")
+	}
+}
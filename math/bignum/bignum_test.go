@@ -0,0 +1,253 @@
+package bignum
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+// randomPair returns the same random number as an Int and a big.Int, with up
+// to the given number of 32-bit digits and a random sign.
+func randomPair(rnd *rand.Rand, digits int) (*Int, *big.Int) {
+	n := rnd.Intn(digits + 1)
+	b := new(big.Int)
+	for i := 0; i < n; i++ {
+		b.Lsh(b, 32)
+		b.Or(b, big.NewInt(int64(rnd.Uint32())))
+	}
+	if rnd.Intn(2) == 0 {
+		b.Neg(b)
+	}
+	x, err := Parse(b.String())
+	if err != nil {
+		panic(err)
+	}
+	return x, b
+}
+
+func toBig(x *Int) *big.Int {
+	b, _ := new(big.Int).SetString(x.String(), 10)
+	return b
+}
+
+func TestParseString(t *testing.T) {
+	for _, s := range []string{"0", "7", "-7", "4294967296", "-1000000000", "123456789012345678901234567890", "-9223372036854775808"} {
+		x, err := Parse(s)
+		if err != nil {
+			t.Fatalf("Parse(%q) returned error %v", s, err)
+		}
+		if got := x.String(); got != s {
+			t.Errorf("Parse(%q).String() = %q", s, got)
+		}
+	}
+	if got := NewInt(-1 << 63).String(); got != "-9223372036854775808" {
+		t.Errorf("NewInt(MinInt64) = %s", got)
+	}
+	if x, _ := Parse("+0012"); x.String() != "12" {
+		t.Errorf("Parse(\"+0012\") = %s, want 12", x)
+	}
+	if x, _ := Parse("-0"); x.Sign() != 0 || x.String() != "0" {
+		t.Errorf("Parse(\"-0\") = %s, want 0", x)
+	}
+	for _, s := range []string{"", "-", "12a", "1 2", "0x10"} {
+		if _, err := Parse(s); err != ErrInvalidNumber {
+			t.Errorf("Parse(%q) error = %v, want ErrInvalidNumber", s, err)
+		}
+	}
+}
+
+func TestArithmeticAgainstBig(t *testing.T) {
+	rnd := rand.New(rand.NewSource(7))
+	for i := 0; i < 2000; i++ {
+		x, bx := randomPair(rnd, 12)
+		y, by := randomPair(rnd, 8)
+
+		check := func(op string, got *Int, want *big.Int) {
+			if got.String() != want.String() {
+				t.Fatalf("%s %s %s = %s, want %s", bx, op, by, got, want)
+			}
+		}
+		check("+", x.Add(y), new(big.Int).Add(bx, by))
+		check("-", x.Sub(y), new(big.Int).Sub(bx, by))
+		check("*", x.Mul(y), new(big.Int).Mul(bx, by))
+		if got, want := x.Cmp(y), bx.Cmp(by); got != want {
+			t.Fatalf("Cmp(%s, %s) = %d, want %d", bx, by, got, want)
+		}
+		if by.Sign() == 0 {
+			if _, _, err := x.QuoRem(y); err != ErrDivisionByZero {
+				t.Fatalf("QuoRem by zero error = %v", err)
+			}
+			continue
+		}
+		q, r, _ := x.QuoRem(y)
+		wq, wr := new(big.Int).QuoRem(bx, by, new(big.Int))
+		check("/", q, wq)
+		check("%", r, wr)
+		mod, _ := x.Mod(y)
+		check("mod", mod, new(big.Int).Mod(bx, new(big.Int).Abs(by)))
+	}
+}
+
+func TestKaratsuba(t *testing.T) {
+	rnd := rand.New(rand.NewSource(3))
+	for _, size := range [][2]int{{40, 40}, {100, 100}, {257, 130}, {41, 400}, {500, 1}} {
+		x, y := make(nat, size[0]), make(nat, size[1])
+		for i := range x {
+			x[i] = rnd.Uint32()
+		}
+		for i := range y {
+			y[i] = rnd.Uint32()
+		}
+		x, y = x.norm(), y.norm()
+		if cmpNat(mulKaratsuba(x, y), mulSchoolbook(x, y)) != 0 {
+			t.Errorf("Karatsuba and schoolbook products of %d and %d digits differ", size[0], size[1])
+		}
+	}
+	// All digits at their maximum push every carry to its limit.
+	ones := make(nat, 300)
+	for i := range ones {
+		ones[i] = 1<<32 - 1
+	}
+	if cmpNat(mulKaratsuba(ones, ones), mulSchoolbook(ones, ones)) != 0 {
+		t.Errorf("Karatsuba and schoolbook squares of 2^9600-1 differ")
+	}
+}
+
+func TestModPow(t *testing.T) {
+	rnd := rand.New(rand.NewSource(5))
+	for i := 0; i < 300; i++ {
+		base, bb := randomPair(rnd, 6)
+		exp, be := randomPair(rnd, 3)
+		mod, bm := randomPair(rnd, 5)
+		exp, be = exp.Abs(), be.Abs(be)
+		mod, bm = mod.Abs().Add(one), bm.Abs(bm).Add(bm, big.NewInt(1))
+
+		got, err := ModPow(base, exp, mod)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// big.Int.Exp works with the non-negative base.
+		want := new(big.Int).Exp(new(big.Int).Mod(bb, bm), be, bm)
+		if got.String() != want.String() {
+			t.Fatalf("ModPow(%s, %s, %s) = %s, want %s", bb, be, bm, got, want)
+		}
+	}
+	if _, err := ModPow(NewInt(2), NewInt(-1), NewInt(5)); err != ErrNegativeExponent {
+		t.Errorf("negative exponent error = %v", err)
+	}
+	if _, err := ModPow(NewInt(2), NewInt(3), NewInt(0)); err != ErrNonPositiveModulus {
+		t.Errorf("zero modulus error = %v", err)
+	}
+	if got, _ := ModPow(NewInt(5), NewInt(0), NewInt(1)); got.Sign() != 0 {
+		t.Errorf("ModPow(5, 0, 1) = %s, want 0", got)
+	}
+}
+
+func TestExtendedGCDAndInverse(t *testing.T) {
+	rnd := rand.New(rand.NewSource(6))
+	for i := 0; i < 500; i++ {
+		a, ba := randomPair(rnd, 5)
+		b, bb := randomPair(rnd, 5)
+		g, x, y := ExtendedGCD(a, b)
+		want := new(big.Int).GCD(nil, nil, new(big.Int).Abs(ba), new(big.Int).Abs(bb))
+		if g.String() != want.String() {
+			t.Fatalf("gcd(%s, %s) = %s, want %s", ba, bb, g, want)
+		}
+		if a.Mul(x).Add(b.Mul(y)).Cmp(g) != 0 {
+			t.Fatalf("%s*%s + %s*%s != %s", ba, x, bb, y, g)
+		}
+
+		m := b.Abs()
+		if m.Sign() == 0 {
+			continue
+		}
+		inv, err := ModInverse(a, m)
+		wantInv := new(big.Int).ModInverse(new(big.Int).Mod(ba, toBig(m)), toBig(m))
+		switch {
+		case g.Cmp(one) != 0 && m.Cmp(one) != 0:
+			if err != ErrNoInverse {
+				t.Fatalf("ModInverse(%s, %s) error = %v, want ErrNoInverse", ba, m, err)
+			}
+		case m.Cmp(one) == 0:
+			if err != nil || inv.Sign() != 0 {
+				t.Fatalf("ModInverse(%s, 1) = %s, %v, want 0", ba, inv, err)
+			}
+		case err != nil || inv.String() != wantInv.String():
+			t.Fatalf("ModInverse(%s, %s) = %s, %v, want %s", ba, m, inv, err, wantInv)
+		}
+	}
+}
+
+func TestCRT(t *testing.T) {
+	ints := func(values ...int64) []*Int {
+		result := make([]*Int, len(values))
+		for i, v := range values {
+			result[i] = NewInt(v)
+		}
+		return result
+	}
+	tests := []struct {
+		name               string
+		remainders, moduli []*Int
+		wantX, wantM       string
+		wantErr            error
+	}{
+		{"coprime", ints(2, 3, 2), ints(3, 5, 7), "23", "105", nil},
+		{"not coprime", ints(3, 5), ints(4, 6), "11", "12", nil},
+		{"contradiction", ints(1, 2), ints(4, 6), "", "", ErrNoSolution},
+		{"negative remainder", ints(-1), ints(10), "9", "10", nil},
+		{"empty", nil, nil, "0", "1", nil},
+		{"zero modulus", ints(1), ints(0), "", "", ErrNonPositiveModulus},
+	}
+	for _, test := range tests {
+		x, m, err := CRT(test.remainders, test.moduli)
+		if err != test.wantErr {
+			t.Errorf("%s: error = %v, want %v", test.name, err, test.wantErr)
+			continue
+		}
+		if err == nil && (x.String() != test.wantX || m.String() != test.wantM) {
+			t.Errorf("%s: CRT = %s mod %s, want %s mod %s", test.name, x, m, test.wantX, test.wantM)
+		}
+	}
+
+	// Large pairwise coprime moduli, checked by reducing the solution.
+	primes := []string{"1000000007", "998244353", "18446744073709551557", "340282366920938463463374607431768211297"}
+	var remainders, moduli []*Int
+	rnd := rand.New(rand.NewSource(2))
+	for _, p := range primes {
+		m, _ := Parse(p)
+		r, _ := randomPair(rnd, 3)
+		remainders, moduli = append(remainders, r), append(moduli, m)
+	}
+	x, _, err := CRT(remainders, moduli)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range moduli {
+		got, _ := x.Mod(moduli[i])
+		want, _ := remainders[i].Mod(moduli[i])
+		if got.Cmp(want) != 0 {
+			t.Errorf("x mod %s = %s, want %s", moduli[i], got, want)
+		}
+	}
+}
+
+func BenchmarkMulSchoolbook(b *testing.B) {
+	benchmarkMul(b, mulSchoolbook)
+}
+
+func BenchmarkMulKaratsuba(b *testing.B) {
+	benchmarkMul(b, mulKaratsuba)
+}
+
+func benchmarkMul(b *testing.B, mul func(x, y nat) nat) {
+	rnd := rand.New(rand.NewSource(1))
+	x, y := make(nat, 2000), make(nat, 2000)
+	for i := range x {
+		x[i], y[i] = rnd.Uint32(), rnd.Uint32()
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		mul(x, y)
+	}
+}
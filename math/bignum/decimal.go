@@ -0,0 +1,215 @@
+// decimal.go
+// description: Fixed-point decimal numbers with explicit rounding
+// details:
+// A Decimal is an arbitrary precision integer, its unscaled value, and a
+// scale: it stands for unscaled / 10^scale, so 12.30 is 1230 with scale 2.
+// Decimal fractions such as 0.1 are exact, unlike binary floating point, which
+// is what financial calculations need. Addition, subtraction and
+// multiplication are exact and grow the scale as needed; division and
+// rescaling round the result to the requested scale with one of the rounding
+// modes, half-even (banker's rounding) being the usual choice for money since
+// it does not bias sums of rounded values.
+// Like Int, Decimal values are immutable.
+// reference: https://en.wikipedia.org/wiki/Fixed-point_arithmetic
+// reference: https://en.wikipedia.org/wiki/Rounding#Rounding_half_to_even
+// see decimal_test.go
+
+package bignum
+
+import (
+	"errors"
+	"strings"
+)
+
+// ErrInvalidDecimal is returned when parsing a string that is not a decimal number.
+var ErrInvalidDecimal = errors.New("invalid decimal number")
+
+// RoundingMode tells how to round a value that falls between two numbers of
+// the target scale.
+type RoundingMode int
+
+const (
+	// HalfEven rounds to the nearest number, and ties to the even one.
+	HalfEven RoundingMode = iota
+	// HalfUp rounds to the nearest number, and ties away from zero.
+	HalfUp
+	// Down rounds towards zero, dropping the extra digits.
+	Down
+)
+
+// Decimal is a fixed-point decimal number of arbitrary precision. The zero
+// value is 0 with scale 0.
+type Decimal struct {
+	unscaled *Int // nil in the zero value
+	scale    int
+}
+
+// value returns the unscaled value of x, which is 0 when it is not set.
+func (x *Decimal) value() *Int {
+	if x.unscaled == nil {
+		return NewInt(0)
+	}
+	return x.unscaled
+}
+
+// NewDecimal returns unscaled / 10^scale. The scale must not be negative.
+func NewDecimal(unscaled int64, scale int) *Decimal {
+	if scale < 0 {
+		panic("bignum: negative decimal scale")
+	}
+	return &Decimal{unscaled: NewInt(unscaled), scale: scale}
+}
+
+// ParseDecimal reads a number such as "-12.30". The scale of the result is
+// the number of digits after the decimal point.
+func ParseDecimal(s string) (*Decimal, error) {
+	digits, fraction := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		digits, fraction = s[:i], s[i+1:]
+		if fraction == "" || fraction[0] == '+' || fraction[0] == '-' {
+			return nil, ErrInvalidDecimal
+		}
+	}
+	if digits == "" || digits == "+" || digits == "-" {
+		if fraction == "" {
+			return nil, ErrInvalidDecimal
+		}
+		digits += "0"
+	}
+	unscaled, err := Parse(digits + fraction)
+	if err != nil {
+		return nil, ErrInvalidDecimal
+	}
+	return &Decimal{unscaled: unscaled, scale: len(fraction)}, nil
+}
+
+// pow10 returns 10^k.
+func pow10(k int) *Int {
+	p, ten := NewInt(1), NewInt(10)
+	for ; k > 0; k-- {
+		p = p.Mul(ten)
+	}
+	return p
+}
+
+// roundQuo returns num / den rounded to an integer according to mode.
+func roundQuo(num, den *Int, mode RoundingMode) (*Int, error) {
+	q, r, err := num.QuoRem(den)
+	if err != nil || r.Sign() == 0 || mode == Down {
+		return q, err
+	}
+	// q is truncated towards zero: move it one step away from zero when the
+	// remainder is more than half of the divisor, or exactly half for a tie
+	// that the mode breaks upwards.
+	c := r.Abs().Add(r.Abs()).Cmp(den.Abs())
+	if c > 0 || c == 0 && (mode == HalfUp || q.bit(0) == 1) {
+		if num.Sign() == den.Sign() {
+			return q.Add(NewInt(1)), nil
+		}
+		return q.Sub(NewInt(1)), nil
+	}
+	return q, nil
+}
+
+// Scale returns the number of digits of x after the decimal point.
+func (x *Decimal) Scale() int {
+	return x.scale
+}
+
+// Unscaled returns the integer x * 10^Scale().
+func (x *Decimal) Unscaled() *Int {
+	return x.value()
+}
+
+// Rescale returns x with the given number of digits after the decimal
+// point, rounding according to mode if digits are dropped.
+func (x *Decimal) Rescale(scale int, mode RoundingMode) *Decimal {
+	if scale < 0 {
+		panic("bignum: negative decimal scale")
+	}
+	if scale >= x.scale {
+		return &Decimal{unscaled: x.value().Mul(pow10(scale - x.scale)), scale: scale}
+	}
+	unscaled, _ := roundQuo(x.value(), pow10(x.scale-scale), mode)
+	return &Decimal{unscaled: unscaled, scale: scale}
+}
+
+// align returns the unscaled values of x and y at the larger of their scales.
+func align(x, y *Decimal) (*Int, *Int, int) {
+	if x.scale < y.scale {
+		return x.value().Mul(pow10(y.scale - x.scale)), y.value(), y.scale
+	}
+	return x.value(), y.value().Mul(pow10(x.scale - y.scale)), x.scale
+}
+
+// Add returns x + y, exactly, at the larger of their scales.
+func (x *Decimal) Add(y *Decimal) *Decimal {
+	a, b, scale := align(x, y)
+	return &Decimal{unscaled: a.Add(b), scale: scale}
+}
+
+// Sub returns x - y, exactly, at the larger of their scales.
+func (x *Decimal) Sub(y *Decimal) *Decimal {
+	a, b, scale := align(x, y)
+	return &Decimal{unscaled: a.Sub(b), scale: scale}
+}
+
+// Mul returns x * y, exactly, at the sum of their scales. Rescale brings the
+// product back to the scale of the operands.
+func (x *Decimal) Mul(y *Decimal) *Decimal {
+	return &Decimal{unscaled: x.value().Mul(y.value()), scale: x.scale + y.scale}
+}
+
+// Quo returns x / y rounded to the given scale according to mode.
+func (x *Decimal) Quo(y *Decimal, scale int, mode RoundingMode) (*Decimal, error) {
+	if scale < 0 {
+		panic("bignum: negative decimal scale")
+	}
+	// x/y = (x.unscaled / y.unscaled) * 10^(y.scale-x.scale), and the result
+	// is that times 10^scale.
+	num, den := x.value(), y.value()
+	if shift := scale + y.scale - x.scale; shift >= 0 {
+		num = num.Mul(pow10(shift))
+	} else {
+		den = den.Mul(pow10(-shift))
+	}
+	unscaled, err := roundQuo(num, den, mode)
+	if err != nil {
+		return nil, err
+	}
+	return &Decimal{unscaled: unscaled, scale: scale}, nil
+}
+
+// Neg returns -x.
+func (x *Decimal) Neg() *Decimal {
+	return &Decimal{unscaled: x.value().Neg(), scale: x.scale}
+}
+
+// Sign returns -1, 0 or 1 as x is negative, zero or positive.
+func (x *Decimal) Sign() int {
+	return x.value().Sign()
+}
+
+// Cmp returns -1, 0 or 1 as x is less than, equal to or greater than y,
+// whatever their scales: 1.5 and 1.50 are equal.
+func (x *Decimal) Cmp(y *Decimal) int {
+	a, b, _ := align(x, y)
+	return a.Cmp(b)
+}
+
+// String returns x in decimal notation with exactly Scale() digits after
+// the decimal point.
+func (x *Decimal) String() string {
+	digits := x.value().Abs().String()
+	if x.scale == 0 {
+		return x.value().String()
+	}
+	if len(digits) <= x.scale {
+		digits = strings.Repeat("0", x.scale-len(digits)+1) + digits
+	}
+	s := digits[:len(digits)-x.scale] + "." + digits[len(digits)-x.scale:]
+	if x.Sign() < 0 {
+		return "-" + s
+	}
+	return s
+}
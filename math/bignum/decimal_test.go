@@ -0,0 +1,164 @@
+package bignum
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func TestParseDecimal(t *testing.T) {
+	tests := []struct {
+		in, want string
+		scale    int
+	}{
+		{"12.30", "12.30", 2},
+		{"-0.05", "-0.05", 2},
+		{".5", "0.5", 1},
+		{"-.5", "-0.5", 1},
+		{"+7", "7", 0},
+		{"-0.00", "0.00", 2},
+		{"000123.4500", "123.4500", 4},
+	}
+	for _, test := range tests {
+		d, err := ParseDecimal(test.in)
+		if err != nil {
+			t.Errorf("ParseDecimal(%q) error: %v", test.in, err)
+			continue
+		}
+		if d.String() != test.want || d.Scale() != test.scale {
+			t.Errorf("ParseDecimal(%q) = %s with scale %d, want %s with scale %d", test.in, d, d.Scale(), test.want, test.scale)
+		}
+	}
+	for _, in := range []string{"", ".", "-", "1.", "1.2.3", "1.-2", "1e5", "--1", "1,5"} {
+		if _, err := ParseDecimal(in); err != ErrInvalidDecimal {
+			t.Errorf("ParseDecimal(%q) error = %v, want %v", in, err, ErrInvalidDecimal)
+		}
+	}
+}
+
+func TestDecimalZeroValue(t *testing.T) {
+	var zero Decimal
+	if got := zero.String(); got != "0" {
+		t.Errorf("zero value String() = %q, want 0", got)
+	}
+	if zero.Sign() != 0 || zero.Unscaled().Sign() != 0 {
+		t.Errorf("the zero value should be 0")
+	}
+	if got := zero.Add(NewDecimal(125, 2)).String(); got != "1.25" {
+		t.Errorf("0 + 1.25 = %s", got)
+	}
+	if got := NewDecimal(5, 1).Mul(&zero).Rescale(1, HalfEven).String(); got != "0.0" {
+		t.Errorf("0.5 * 0 = %s", got)
+	}
+	if _, err := NewDecimal(1, 0).Quo(&zero, 2, HalfEven); err == nil {
+		t.Errorf("dividing by the zero value should fail")
+	}
+}
+
+func TestRescale(t *testing.T) {
+	tests := []struct {
+		in                     string
+		halfEven, halfUp, down string
+	}{
+		{"2.5", "2", "3", "2"},
+		{"3.5", "4", "4", "3"},
+		{"-2.5", "-2", "-3", "-2"},
+		{"-3.5", "-4", "-4", "-3"},
+		{"2.51", "3", "3", "2"},
+		{"-2.49", "-2", "-2", "-2"},
+		{"0.5", "0", "1", "0"},
+		{"7", "7", "7", "7"},
+	}
+	for _, test := range tests {
+		d, _ := ParseDecimal(test.in)
+		for mode, want := range map[RoundingMode]string{HalfEven: test.halfEven, HalfUp: test.halfUp, Down: test.down} {
+			if got := d.Rescale(0, mode).String(); got != want {
+				t.Errorf("%s rescaled to 0 with mode %d = %s, want %s", test.in, mode, got, want)
+			}
+		}
+	}
+	d, _ := ParseDecimal("1.5")
+	if got := d.Rescale(3, HalfEven).String(); got != "1.500" {
+		t.Errorf("1.5 rescaled to 3 = %s, want 1.500", got)
+	}
+}
+
+func TestDecimalArithmetic(t *testing.T) {
+	// 0.1 + 0.2 is exactly 0.3, unlike with floats
+	a, b, c := NewDecimal(1, 1), NewDecimal(2, 1), NewDecimal(3, 1)
+	if sum := a.Add(b); sum.Cmp(c) != 0 {
+		t.Errorf("0.1 + 0.2 = %s, want 0.3", sum)
+	}
+
+	price, _ := ParseDecimal("19.99")
+	rate, _ := ParseDecimal("0.0825")
+	tax := price.Mul(rate)
+	if tax.String() != "1.649175" {
+		t.Errorf("19.99 * 0.0825 = %s, want 1.649175", tax)
+	}
+	if total := price.Add(tax.Rescale(2, HalfEven)); total.String() != "21.64" {
+		t.Errorf("total = %s, want 21.64", total)
+	}
+
+	// a year of monthly interest at 5%, rounded to cents every month
+	balance, _ := ParseDecimal("1000.00")
+	monthly, _ := ParseDecimal("1.00")
+	interest, _ := NewDecimal(5, 2).Quo(NewDecimal(12, 0), 10, HalfEven)
+	monthly = monthly.Add(interest)
+	for i := 0; i < 12; i++ {
+		balance = balance.Mul(monthly).Rescale(2, HalfEven)
+	}
+	if balance.String() != "1051.16" {
+		t.Errorf("balance = %s, want 1051.16", balance)
+	}
+
+	third, err := NewDecimal(1, 0).Quo(NewDecimal(3, 0), 4, HalfEven)
+	if err != nil || third.String() != "0.3333" {
+		t.Errorf("1 / 3 = %v, %v, want 0.3333", third, err)
+	}
+	if _, err := a.Quo(NewDecimal(0, 2), 2, HalfEven); err != ErrDivisionByZero {
+		t.Errorf("division by zero error = %v, want %v", err, ErrDivisionByZero)
+	}
+	if x, y := NewDecimal(150, 2), NewDecimal(15, 1); x.Cmp(y) != 0 || x.Sub(y).Sign() != 0 {
+		t.Errorf("1.50 and 1.5 should be equal")
+	}
+}
+
+// roundRat rounds r to an integer the way roundQuo should.
+func roundRat(r *big.Rat, mode RoundingMode) *big.Int {
+	q, m := new(big.Int).QuoRem(r.Num(), r.Denom(), new(big.Int))
+	if m.Sign() == 0 || mode == Down {
+		return q
+	}
+	twice := new(big.Int).Mul(new(big.Int).Abs(m), big.NewInt(2))
+	c := twice.Cmp(r.Denom())
+	if c > 0 || c == 0 && (mode == HalfUp || q.Bit(0) == 1) {
+		q.Add(q, big.NewInt(int64(r.Sign())))
+	}
+	return q
+}
+
+func TestQuoAgainstRat(t *testing.T) {
+	rnd := rand.New(rand.NewSource(5))
+	for round := 0; round < 500; round++ {
+		x := NewDecimal(rnd.Int63n(2000000)-1000000, rnd.Intn(5))
+		y := NewDecimal(rnd.Int63n(2000)-1000, rnd.Intn(5))
+		if y.Sign() == 0 {
+			continue
+		}
+		scale := rnd.Intn(6)
+		mode := RoundingMode(rnd.Intn(3))
+		got, err := x.Quo(y, scale, mode)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		rx, _ := new(big.Rat).SetString(x.String())
+		ry, _ := new(big.Rat).SetString(y.String())
+		r := new(big.Rat).Quo(rx, ry)
+		r.Mul(r, new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(scale)), nil)))
+		if want := roundRat(r, mode); got.Unscaled().String() != want.String() {
+			t.Fatalf("%s / %s at scale %d with mode %d = %s, want unscaled %s", x, y, scale, mode, got, want)
+		}
+	}
+}
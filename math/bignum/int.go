@@ -0,0 +1,196 @@
+// int.go
+// description: Arbitrary precision signed integers
+// details:
+// An Int is a sign and a magnitude stored as a nat. Values are immutable:
+// every operation returns a new Int and leaves its operands untouched, and
+// the zero value is the number 0.
+// Division truncates towards zero like Go's / and % operators, while Mod
+// returns the non-negative remainder used in modular arithmetic.
+// reference: https://en.wikipedia.org/wiki/Arbitrary-precision_arithmetic
+// see bignum_test.go
+
+// Package bignum implements arbitrary precision integers from scratch, with
+// Karatsuba multiplication and the modular arithmetic algorithms built on
+// them: modular exponentiation, the extended Euclidean algorithm, modular
+// inverses and the Chinese remainder theorem, as well as fixed-point decimal
+// numbers with explicit rounding.
+package bignum
+
+import (
+	"errors"
+	"math/bits"
+	"strings"
+)
+
+var (
+	// ErrDivisionByZero is returned when dividing by zero.
+	ErrDivisionByZero = errors.New("division by zero")
+	// ErrInvalidNumber is returned when parsing a string that is not a decimal integer.
+	ErrInvalidNumber = errors.New("invalid decimal integer")
+)
+
+// Int is an arbitrary precision signed integer.
+type Int struct {
+	neg bool
+	abs nat
+}
+
+func newInt(neg bool, abs nat) *Int {
+	abs = abs.norm()
+	return &Int{neg: neg && len(abs) > 0, abs: abs}
+}
+
+// NewInt returns x as an Int.
+func NewInt(x int64) *Int {
+	if x < 0 {
+		// -x overflows for the smallest int64, but its uint64 conversion is right.
+		return newInt(true, natFromUint64(uint64(-x)))
+	}
+	return newInt(false, natFromUint64(uint64(x)))
+}
+
+// Parse reads a decimal integer with an optional leading sign.
+func Parse(s string) (*Int, error) {
+	neg := false
+	if s != "" && (s[0] == '-' || s[0] == '+') {
+		neg = s[0] == '-'
+		s = s[1:]
+	}
+	if s == "" {
+		return nil, ErrInvalidNumber
+	}
+	var abs nat
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return nil, ErrInvalidNumber
+		}
+		abs = mulAddWord(abs, 10, uint32(c-'0'))
+	}
+	return newInt(neg, abs), nil
+}
+
+// String returns x in decimal.
+func (x *Int) String() string {
+	if len(x.abs) == 0 {
+		return "0"
+	}
+	// Peel off nine decimal digits at a time, least significant first.
+	var chunks []uint32
+	for rest := x.abs; len(rest) > 0; {
+		var chunk uint32
+		rest, chunk = divWord(rest, 1e9)
+		chunks = append(chunks, chunk)
+	}
+	var b strings.Builder
+	if x.neg {
+		b.WriteByte('-')
+	}
+	for i := len(chunks) - 1; i >= 0; i-- {
+		digits := []byte("000000000")
+		for k, c := 8, chunks[i]; c > 0; k, c = k-1, c/10 {
+			digits[k] = byte('0' + c%10)
+		}
+		if i == len(chunks)-1 {
+			// The leading chunk is not padded.
+			for len(digits) > 1 && digits[0] == '0' {
+				digits = digits[1:]
+			}
+		}
+		b.Write(digits)
+	}
+	return b.String()
+}
+
+// Sign returns -1, 0 or 1 as x is negative, zero or positive.
+func (x *Int) Sign() int {
+	switch {
+	case len(x.abs) == 0:
+		return 0
+	case x.neg:
+		return -1
+	}
+	return 1
+}
+
+// Cmp returns -1, 0 or 1 as x is less than, equal to or greater than y.
+func (x *Int) Cmp(y *Int) int {
+	switch {
+	case x.neg && !y.neg:
+		return -1
+	case !x.neg && y.neg:
+		return 1
+	case x.neg:
+		return cmpNat(y.abs, x.abs)
+	}
+	return cmpNat(x.abs, y.abs)
+}
+
+// Neg returns -x.
+func (x *Int) Neg() *Int {
+	return newInt(!x.neg, x.abs)
+}
+
+// Abs returns the absolute value of x.
+func (x *Int) Abs() *Int {
+	return newInt(false, x.abs)
+}
+
+// Add returns x + y.
+func (x *Int) Add(y *Int) *Int {
+	if x.neg == y.neg {
+		return newInt(x.neg, addNat(x.abs, y.abs))
+	}
+	// Opposite signs: subtract the smaller magnitude from the larger one.
+	if cmpNat(x.abs, y.abs) >= 0 {
+		return newInt(x.neg, subNat(x.abs, y.abs))
+	}
+	return newInt(y.neg, subNat(y.abs, x.abs))
+}
+
+// Sub returns x - y.
+func (x *Int) Sub(y *Int) *Int {
+	return x.Add(y.Neg())
+}
+
+// Mul returns x * y, using Karatsuba multiplication for long operands.
+func (x *Int) Mul(y *Int) *Int {
+	return newInt(x.neg != y.neg, mulKaratsuba(x.abs, y.abs))
+}
+
+// QuoRem returns the quotient x / y truncated towards zero and the remainder
+// x - y*(x/y), which has the sign of x.
+func (x *Int) QuoRem(y *Int) (*Int, *Int, error) {
+	if len(y.abs) == 0 {
+		return nil, nil, ErrDivisionByZero
+	}
+	q, r := divNat(x.abs, y.abs)
+	return newInt(x.neg != y.neg, q), newInt(x.neg, r), nil
+}
+
+// Mod returns x modulo |y|, in the range [0, |y|).
+func (x *Int) Mod(y *Int) (*Int, error) {
+	_, r, err := x.QuoRem(y)
+	if err != nil {
+		return nil, err
+	}
+	if r.neg {
+		r = r.Add(y.Abs())
+	}
+	return r, nil
+}
+
+// bit returns bit i of the magnitude of x.
+func (x *Int) bit(i int) uint32 {
+	if i/32 >= len(x.abs) {
+		return 0
+	}
+	return x.abs[i/32] >> uint(i%32) & 1
+}
+
+// bitLen returns the number of bits of the magnitude of x.
+func (x *Int) bitLen() int {
+	if len(x.abs) == 0 {
+		return 0
+	}
+	return 32*(len(x.abs)-1) + bits.Len32(x.abs[len(x.abs)-1])
+}
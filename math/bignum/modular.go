@@ -0,0 +1,113 @@
+// modular.go
+// description: Modular arithmetic on arbitrary precision integers
+// details:
+// ModPow computes base^exponent mod m by square-and-multiply, scanning the
+// exponent from its most significant bit and reducing after every step, so
+// intermediate values never exceed m^2.
+// ExtendedGCD runs the iterative extended Euclidean algorithm, which also
+// returns Bezout coefficients x and y with a*x + b*y = gcd(a, b); a modular
+// inverse of a modulo m is the coefficient of a when gcd(a, m) = 1.
+// CRT merges congruences x = r_i (mod m_i) two at a time. The moduli do not
+// need to be pairwise coprime: two congruences are compatible when their
+// remainders agree modulo the gcd of their moduli, and merge into a single
+// congruence modulo the lcm.
+// ModPow: O(log(e)) multiplications, ExtendedGCD: O(log(min(a, b))) divisions
+// reference: https://en.wikipedia.org/wiki/Modular_exponentiation
+// reference: https://en.wikipedia.org/wiki/Extended_Euclidean_algorithm
+// reference: https://en.wikipedia.org/wiki/Chinese_remainder_theorem
+// see bignum_test.go
+
+package bignum
+
+import "errors"
+
+var (
+	// ErrNegativeExponent is returned by ModPow for a negative exponent.
+	ErrNegativeExponent = errors.New("negative exponent")
+	// ErrNonPositiveModulus is returned when a modulus is zero or negative.
+	ErrNonPositiveModulus = errors.New("modulus must be positive")
+	// ErrNoInverse is returned by ModInverse when a and m are not coprime.
+	ErrNoInverse = errors.New("no modular inverse exists")
+	// ErrNoSolution is returned by CRT when the congruences contradict each other.
+	ErrNoSolution = errors.New("congruences have no common solution")
+)
+
+var one = NewInt(1)
+
+// ModPow returns base^exponent mod modulus, in the range [0, modulus).
+func ModPow(base, exponent, modulus *Int) (*Int, error) {
+	if modulus.Sign() <= 0 {
+		return nil, ErrNonPositiveModulus
+	}
+	if exponent.Sign() < 0 {
+		return nil, ErrNegativeExponent
+	}
+	base, _ = base.Mod(modulus)
+	result, _ := one.Mod(modulus)
+	for i := exponent.bitLen() - 1; i >= 0; i-- {
+		result, _ = result.Mul(result).Mod(modulus)
+		if exponent.bit(i) == 1 {
+			result, _ = result.Mul(base).Mod(modulus)
+		}
+	}
+	return result, nil
+}
+
+// ExtendedGCD returns g = gcd(a, b), which is never negative, and x and y
+// such that a*x + b*y = g.
+func ExtendedGCD(a, b *Int) (g, x, y *Int) {
+	oldR, r := a, b
+	oldX, x := NewInt(1), NewInt(0)
+	oldY, y := NewInt(0), NewInt(1)
+	for r.Sign() != 0 {
+		q, rem, _ := oldR.QuoRem(r)
+		oldR, r = r, rem
+		oldX, x = x, oldX.Sub(q.Mul(x))
+		oldY, y = y, oldY.Sub(q.Mul(y))
+	}
+	if oldR.Sign() < 0 {
+		return oldR.Neg(), oldX.Neg(), oldY.Neg()
+	}
+	return oldR, oldX, oldY
+}
+
+// ModInverse returns the x in [0, m) with a*x = 1 (mod m).
+func ModInverse(a, m *Int) (*Int, error) {
+	if m.Sign() <= 0 {
+		return nil, ErrNonPositiveModulus
+	}
+	g, x, _ := ExtendedGCD(a, m)
+	if g.Cmp(one) != 0 {
+		return nil, ErrNoInverse
+	}
+	return x.Mod(m)
+}
+
+// CRT solves the system x = remainders[i] (mod moduli[i]) and returns the
+// solution x in [0, m) together with m, the lcm of the moduli; every solution
+// is x plus a multiple of m. An empty system has the solution 0 modulo 1.
+func CRT(remainders, moduli []*Int) (x, m *Int, err error) {
+	if len(remainders) != len(moduli) {
+		return nil, nil, errors.New("remainders and moduli must have the same length")
+	}
+	x, m = NewInt(0), NewInt(1)
+	for i, mi := range moduli {
+		if mi.Sign() <= 0 {
+			return nil, nil, ErrNonPositiveModulus
+		}
+		ri, _ := remainders[i].Mod(mi)
+		// Find t with x + m*t = ri (mod mi), that is m*t = ri - x (mod mi).
+		g, p, _ := ExtendedGCD(m, mi)
+		diff := ri.Sub(x)
+		quo, rem, _ := diff.QuoRem(g)
+		if rem.Sign() != 0 {
+			return nil, nil, ErrNoSolution
+		}
+		step, _, _ := mi.QuoRem(g)
+		t, _ := quo.Mul(p).Mod(step)
+		x = x.Add(m.Mul(t))
+		m = m.Mul(step)
+		x, _ = x.Mod(m)
+	}
+	return x, m, nil
+}
@@ -0,0 +1,252 @@
+// nat.go
+// description: Arithmetic on natural numbers stored as slices of 32-bit digits
+// details:
+// A natural number is kept as a little-endian slice of base 2^32 digits with
+// no leading zero digits, so zero is the empty slice. Digits are 32 bits wide
+// so that the product of two digits plus two carries always fits in a uint64.
+// Multiplication switches from the schoolbook method to Karatsuba's algorithm
+// for long operands: writing x = x1*B^m + x0 and y = y1*B^m + y0, the product
+// is z2*B^2m + z1*B^m + z0 with z2 = x1*y1, z0 = x0*y0 and
+// z1 = (x0+x1)*(y0+y1) - z2 - z0, three half size products instead of four.
+// Division is Knuth's Algorithm D, which estimates every quotient digit from
+// the leading digits and corrects it at most twice.
+// Karatsuba multiplication: O(n^log2(3)) ~ O(n^1.585), schoolbook multiplication and division: O(n*m)
+// reference: https://en.wikipedia.org/wiki/Karatsuba_algorithm
+// reference: Knuth, The Art of Computer Programming Vol. 2, section 4.3.1
+// see bignum_test.go
+
+package bignum
+
+import "math/bits"
+
+// nat is a natural number as little-endian base 2^32 digits.
+type nat []uint32
+
+// karatsubaThreshold is the number of digits below which schoolbook
+// multiplication is faster than Karatsuba's recursion.
+const karatsubaThreshold = 40
+
+// norm drops the leading zero digits of z.
+func (z nat) norm() nat {
+	i := len(z)
+	for i > 0 && z[i-1] == 0 {
+		i--
+	}
+	return z[:i]
+}
+
+func natFromUint64(x uint64) nat {
+	return nat{uint32(x), uint32(x >> 32)}.norm()
+}
+
+func cmpNat(x, y nat) int {
+	if len(x) != len(y) {
+		if len(x) < len(y) {
+			return -1
+		}
+		return 1
+	}
+	for i := len(x) - 1; i >= 0; i-- {
+		if x[i] != y[i] {
+			if x[i] < y[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+func addNat(x, y nat) nat {
+	if len(x) < len(y) {
+		x, y = y, x
+	}
+	z := make(nat, len(x)+1)
+	var carry uint64
+	for i := range x {
+		s := uint64(x[i]) + carry
+		if i < len(y) {
+			s += uint64(y[i])
+		}
+		z[i] = uint32(s)
+		carry = s >> 32
+	}
+	z[len(x)] = uint32(carry)
+	return z.norm()
+}
+
+// subNat returns x - y, which must not be negative.
+func subNat(x, y nat) nat {
+	z := make(nat, len(x))
+	var borrow uint64
+	for i := range x {
+		d := uint64(x[i]) - borrow
+		if i < len(y) {
+			d -= uint64(y[i])
+		}
+		z[i] = uint32(d)
+		// A wrapped difference has all its high bits set.
+		borrow = d >> 32 & 1
+	}
+	return z.norm()
+}
+
+// shiftDigits returns x * 2^(32*k).
+func shiftDigits(x nat, k int) nat {
+	if len(x) == 0 {
+		return nil
+	}
+	z := make(nat, len(x)+k)
+	copy(z[k:], x)
+	return z
+}
+
+func mulSchoolbook(x, y nat) nat {
+	if len(x) == 0 || len(y) == 0 {
+		return nil
+	}
+	z := make(nat, len(x)+len(y))
+	for i, xi := range x {
+		var carry uint64
+		for j, yj := range y {
+			t := uint64(xi)*uint64(yj) + uint64(z[i+j]) + carry
+			z[i+j] = uint32(t)
+			carry = t >> 32
+		}
+		z[i+len(y)] = uint32(carry)
+	}
+	return z.norm()
+}
+
+// split returns the low m digits of x and the rest.
+func split(x nat, m int) (lo, hi nat) {
+	if len(x) <= m {
+		return x, nil
+	}
+	return x[:m].norm(), x[m:]
+}
+
+func mulKaratsuba(x, y nat) nat {
+	if len(x) < karatsubaThreshold || len(y) < karatsubaThreshold {
+		return mulSchoolbook(x, y)
+	}
+	m := len(x)
+	if len(y) > m {
+		m = len(y)
+	}
+	m /= 2
+	x0, x1 := split(x, m)
+	y0, y1 := split(y, m)
+	z0 := mulKaratsuba(x0, y0)
+	z2 := mulKaratsuba(x1, y1)
+	z1 := subNat(subNat(mulKaratsuba(addNat(x0, x1), addNat(y0, y1)), z0), z2)
+	return addNat(addNat(z0, shiftDigits(z1, m)), shiftDigits(z2, 2*m))
+}
+
+// mulAddWord returns x*m + a.
+func mulAddWord(x nat, m, a uint32) nat {
+	z := make(nat, len(x)+1)
+	carry := uint64(a)
+	for i, xi := range x {
+		t := uint64(xi)*uint64(m) + carry
+		z[i] = uint32(t)
+		carry = t >> 32
+	}
+	z[len(x)] = uint32(carry)
+	return z.norm()
+}
+
+// divWord returns the quotient and remainder of x divided by d, which must
+// not be zero.
+func divWord(x nat, d uint32) (nat, uint32) {
+	q := make(nat, len(x))
+	var r uint64
+	for i := len(x) - 1; i >= 0; i-- {
+		cur := r<<32 | uint64(x[i])
+		q[i] = uint32(cur / uint64(d))
+		r = cur % uint64(d)
+	}
+	return q.norm(), uint32(r)
+}
+
+// shiftLeft returns x << s for s < 32 with one more digit than x, which may be zero.
+func shiftLeft(x nat, s uint) nat {
+	z := make(nat, len(x)+1)
+	var prev uint32
+	for i, xi := range x {
+		// For s == 0 the shift by 32 yields 0.
+		z[i] = xi<<s | prev>>(32-s)
+		prev = xi
+	}
+	z[len(x)] = prev >> (32 - s)
+	return z
+}
+
+// shiftRight returns x >> s for s < 32.
+func shiftRight(x nat, s uint) nat {
+	z := make(nat, len(x))
+	for i := range x {
+		z[i] = x[i] >> s
+		if i+1 < len(x) {
+			z[i] |= x[i+1] << (32 - s)
+		}
+	}
+	return z.norm()
+}
+
+// divNat returns the quotient and remainder of u divided by v, which must not
+// be zero.
+func divNat(u, v nat) (q, r nat) {
+	if cmpNat(u, v) < 0 {
+		return nil, append(nat(nil), u...)
+	}
+	if len(v) == 1 {
+		q, rem := divWord(u, v[0])
+		return q, nat{rem}.norm()
+	}
+
+	// Normalize so that the leading digit of v has its top bit set, which
+	// keeps the estimated quotient digits within two of the real ones.
+	s := uint(bits.LeadingZeros32(v[len(v)-1]))
+	vn := shiftLeft(v, s)[:len(v)]
+	un := shiftLeft(u, s)
+	n, m := len(vn), len(un)-len(vn)
+	q = make(nat, m)
+	for j := m - 1; j >= 0; j-- {
+		num := uint64(un[j+n])<<32 | uint64(un[j+n-1])
+		qhat, rhat := num/uint64(vn[n-1]), num%uint64(vn[n-1])
+		for qhat >= 1<<32 || qhat*uint64(vn[n-2]) > rhat<<32|uint64(un[j+n-2]) {
+			qhat--
+			rhat += uint64(vn[n-1])
+			if rhat >= 1<<32 {
+				break
+			}
+		}
+
+		// un[j:j+n+1] -= qhat * vn
+		var borrow, carry uint64
+		for i := 0; i < n; i++ {
+			p := qhat*uint64(vn[i]) + carry
+			carry = p >> 32
+			t := uint64(un[i+j]) - p&0xffffffff - borrow
+			un[i+j] = uint32(t)
+			borrow = t >> 32 & 1
+		}
+		t := uint64(un[j+n]) - carry - borrow
+		un[j+n] = uint32(t)
+
+		if t>>32 != 0 {
+			// qhat was one too large, add vn back.
+			qhat--
+			var c uint64
+			for i := 0; i < n; i++ {
+				sum := uint64(un[i+j]) + uint64(vn[i]) + c
+				un[i+j] = uint32(sum)
+				c = sum >> 32
+			}
+			un[j+n] += uint32(c)
+		}
+		q[j] = uint32(qhat)
+	}
+	return q.norm(), shiftRight(un[:n], s)
+}
@@ -0,0 +1,90 @@
+package bitboard
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// walk returns the squares reached from s by steps of (df, dr), up to the
+// first one of occupied, at most limit steps.
+func walk(s Square, df, dr int, occupied Bitboard, limit int) Bitboard {
+	var b Bitboard
+	file, rank := s.File(), s.Rank()
+	for i := 0; i < limit; i++ {
+		file, rank = file+df, rank+dr
+		if file < 0 || file >= 8 || rank < 0 || rank >= 8 {
+			break
+		}
+		b = b.Set(NewSquare(file, rank))
+		if occupied.Has(NewSquare(file, rank)) {
+			break
+		}
+	}
+	return b
+}
+
+func walkAll(s Square, steps [][2]int, occupied Bitboard, limit int) Bitboard {
+	var b Bitboard
+	for _, step := range steps {
+		b |= walk(s, step[0], step[1], occupied, limit)
+	}
+	return b
+}
+
+var (
+	straight = [][2]int{{0, 1}, {1, 0}, {0, -1}, {-1, 0}}
+	diagonal = [][2]int{{1, 1}, {1, -1}, {-1, -1}, {-1, 1}}
+	jumps    = [][2]int{{1, 2}, {2, 1}, {2, -1}, {1, -2}, {-1, -2}, {-2, -1}, {-2, 1}, {-1, 2}}
+)
+
+func TestSlidingAttacks(t *testing.T) {
+	d4, _ := ParseSquare("d4")
+	occupied := Of(NewSquare(3, 6), NewSquare(5, 3), NewSquare(1, 1), A1)
+	// the rook stops at d7 and f4, the bishop at b2
+	if got, want := RookAttacks(d4, occupied).Count(), 3+2+3+3; got != want {
+		t.Errorf("rook on d4 attacks %d squares, want %d", got, want)
+	}
+	if got, want := BishopAttacks(d4, occupied).Count(), 4+3+2+3; got != want {
+		t.Errorf("bishop on d4 attacks %d squares, want %d", got, want)
+	}
+	if got := QueenAttacks(A1, Empty).Count(); got != 21 {
+		t.Errorf("queen on a1 attacks %d squares, want 21", got)
+	}
+}
+
+func TestAttacksRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		occupied := Bitboard(rnd.Uint64() & rnd.Uint64())
+		for s := A1; s <= H8; s++ {
+			if got, want := RookAttacks(s, occupied), walkAll(s, straight, occupied, 8); got != want {
+				t.Fatalf("RookAttacks(%v) =\n%v\nwant\n%v", s, got, want)
+			}
+			if got, want := BishopAttacks(s, occupied), walkAll(s, diagonal, occupied, 8); got != want {
+				t.Fatalf("BishopAttacks(%v) =\n%v\nwant\n%v", s, got, want)
+			}
+		}
+	}
+	for s := A1; s <= H8; s++ {
+		if got, want := KnightAttacks(Of(s)), walkAll(s, jumps, Empty, 1); got != want {
+			t.Fatalf("KnightAttacks(%v) =\n%v\nwant\n%v", s, got, want)
+		}
+		king := walkAll(s, append(straight, diagonal...), Empty, 1)
+		if got := KingAttacks(Of(s)); got != king {
+			t.Fatalf("KingAttacks(%v) =\n%v\nwant\n%v", s, got, king)
+		}
+		if got, want := PawnAttacks(Of(s), White), walkAll(s, [][2]int{{1, 1}, {-1, 1}}, Empty, 1); got != want {
+			t.Fatalf("white PawnAttacks(%v) =\n%v\nwant\n%v", s, got, want)
+		}
+		if got, want := PawnAttacks(Of(s), Black), walkAll(s, [][2]int{{1, -1}, {-1, -1}}, Empty, 1); got != want {
+			t.Fatalf("black PawnAttacks(%v) =\n%v\nwant\n%v", s, got, want)
+		}
+	}
+}
+
+func BenchmarkQueenAttacks(b *testing.B) {
+	occupied := Bitboard(0x00ff_0042_1800_ff00)
+	for i := 0; i < b.N; i++ {
+		_ = QueenAttacks(Square(i&63), occupied)
+	}
+}
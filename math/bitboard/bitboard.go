@@ -0,0 +1,186 @@
+// bitboard.go
+// description: 64-bit bitboards of the squares of a chess board
+// details:
+// A bitboard holds a set of squares of an 8 by 8 board as the bits of a
+// uint64, square a1 being bit 0, h1 bit 7 and h8 bit 63, so that set
+// operations are single machine instructions. Moving every square of a set
+// one step in a direction is a shift by 1, 7, 8 or 9 bits, after which the
+// squares that wrapped around from one side of the board to the other are
+// cleared with the mask of the file they landed on. Counting the squares is
+// a popcount, and they are iterated from the lowest by taking the trailing
+// zeros of the board and clearing its lowest bit with b & (b - 1).
+// time complexity: O(1) per operation, O(k) to iterate k squares
+// space complexity: O(1)
+// reference: https://www.chessprogramming.org/Bitboards
+// reference: https://www.chessprogramming.org/General_Setwise_Operations
+// see bitboard_test.go
+
+// Package bitboard implements chess bitboards, the attacks of the pieces on
+// them, and move generation as an example of bit-level algorithms.
+package bitboard
+
+import (
+	"errors"
+	"math/bits"
+	"strings"
+
+	"github.com/TheAlgorithms/Go/structure/iterutil"
+)
+
+// ErrInvalidSquare is returned when parsing a string that names no square.
+var ErrInvalidSquare = errors.New("invalid square")
+
+// Square is a square of the board, from A1 = 0 to H8 = 63, rank by rank.
+type Square int
+
+// The corners of the board.
+const (
+	A1 Square = 0
+	H1 Square = 7
+	A8 Square = 56
+	H8 Square = 63
+)
+
+// NewSquare returns the square of a file and a rank, both from 0.
+func NewSquare(file, rank int) Square {
+	return Square(rank*8 + file)
+}
+
+// ParseSquare returns the square named by s in algebraic notation, like e4.
+func ParseSquare(s string) (Square, error) {
+	if len(s) != 2 || s[0] < 'a' || s[0] > 'h' || s[1] < '1' || s[1] > '8' {
+		return 0, ErrInvalidSquare
+	}
+	return NewSquare(int(s[0]-'a'), int(s[1]-'1')), nil
+}
+
+// File returns the file of s, from 0 for the a-file.
+func (s Square) File() int { return int(s) & 7 }
+
+// Rank returns the rank of s, from 0 for the first rank.
+func (s Square) Rank() int { return int(s) >> 3 }
+
+// String returns s in algebraic notation.
+func (s Square) String() string {
+	return string([]byte{byte('a' + s.File()), byte('1' + s.Rank())})
+}
+
+// Bitboard is a set of squares.
+type Bitboard uint64
+
+// Masks of files, ranks and the whole board.
+const (
+	FileA Bitboard = 0x0101010101010101
+	FileB Bitboard = FileA << 1
+	FileG Bitboard = FileA << 6
+	FileH Bitboard = FileA << 7
+	Rank1 Bitboard = 0xff
+	Rank2 Bitboard = Rank1 << 8
+	Rank3 Bitboard = Rank1 << 16
+	Rank6 Bitboard = Rank1 << 40
+	Rank7 Bitboard = Rank1 << 48
+	Rank8 Bitboard = Rank1 << 56
+	Empty Bitboard = 0
+	Full  Bitboard = ^Empty
+)
+
+// Of returns the bitboard of the given squares.
+func Of(squares ...Square) Bitboard {
+	var b Bitboard
+	for _, s := range squares {
+		b |= 1 << uint(s)
+	}
+	return b
+}
+
+// Has reports whether b holds s.
+func (b Bitboard) Has(s Square) bool { return b>>uint(s)&1 != 0 }
+
+// Set returns b with s.
+func (b Bitboard) Set(s Square) Bitboard { return b | 1<<uint(s) }
+
+// Clear returns b without s.
+func (b Bitboard) Clear(s Square) Bitboard { return b &^ (1 << uint(s)) }
+
+// Count returns the number of squares of b.
+func (b Bitboard) Count() int { return bits.OnesCount64(uint64(b)) }
+
+// Lowest returns the lowest square of b, with false if b is empty.
+func (b Bitboard) Lowest() (Square, bool) {
+	return Square(bits.TrailingZeros64(uint64(b))), b != 0
+}
+
+// Highest returns the highest square of b, with false if b is empty.
+func (b Bitboard) Highest() (Square, bool) {
+	return Square(63 - bits.LeadingZeros64(uint64(b))), b != 0
+}
+
+// Squares returns the squares of b in increasing order.
+func (b Bitboard) Squares() iterutil.Seq[Square] {
+	return func(yield func(Square) bool) {
+		for ; b != 0; b &= b - 1 {
+			if !yield(Square(bits.TrailingZeros64(uint64(b)))) {
+				return
+			}
+		}
+	}
+}
+
+// Direction is one of the eight directions of a move by one square.
+type Direction int
+
+// The directions, north being towards the eighth rank and east towards the
+// h-file.
+const (
+	North Direction = iota
+	NorthEast
+	East
+	SouthEast
+	South
+	SouthWest
+	West
+	NorthWest
+)
+
+// Shift returns the squares of b moved one step in direction d, without
+// those leaving the board.
+func (b Bitboard) Shift(d Direction) Bitboard {
+	switch d {
+	case North:
+		return b << 8
+	case NorthEast:
+		return b << 9 &^ FileA
+	case East:
+		return b << 1 &^ FileA
+	case SouthEast:
+		return b >> 7 &^ FileA
+	case South:
+		return b >> 8
+	case SouthWest:
+		return b >> 9 &^ FileH
+	case West:
+		return b >> 1 &^ FileH
+	case NorthWest:
+		return b << 7 &^ FileH
+	}
+	return Empty
+}
+
+// String draws b as eight lines of 1 for its squares and . for the others,
+// the eighth rank first.
+func (b Bitboard) String() string {
+	var sb strings.Builder
+	for rank := 7; rank >= 0; rank-- {
+		for file := 0; file < 8; file++ {
+			if b.Has(NewSquare(file, rank)) {
+				sb.WriteByte('1')
+			} else {
+				sb.WriteByte('.')
+			}
+		}
+		if rank > 0 {
+			sb.WriteByte('\n')
+		}
+	}
+	return sb.String()
+}
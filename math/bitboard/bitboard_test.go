@@ -0,0 +1,73 @@
+package bitboard
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/structure/iterutil"
+)
+
+func TestSquare(t *testing.T) {
+	s, err := ParseSquare("e4")
+	if err != nil || s != 28 || s.File() != 4 || s.Rank() != 3 || s.String() != "e4" {
+		t.Errorf("ParseSquare(e4) = %d, %v", s, err)
+	}
+	for _, name := range []string{"", "e", "i1", "a9", "e44"} {
+		if _, err := ParseSquare(name); !errors.Is(err, ErrInvalidSquare) {
+			t.Errorf("ParseSquare(%q) error = %v", name, err)
+		}
+	}
+	if A1.String() != "a1" || H8.String() != "h8" || NewSquare(7, 0) != H1 {
+		t.Errorf("corners are %v, %v, %v", A1, H8, NewSquare(7, 0))
+	}
+}
+
+func TestBitboard(t *testing.T) {
+	b := Of(A1, 28, H8)
+	if b.Count() != 3 || !b.Has(28) || b.Has(27) {
+		t.Errorf("Count, Has = %d, %v, %v", b.Count(), b.Has(28), b.Has(27))
+	}
+	if got := iterutil.Collect(b.Squares()); !reflect.DeepEqual(got, []Square{A1, 28, H8}) {
+		t.Errorf("Squares = %v", got)
+	}
+	if got := iterutil.Collect(iterutil.Take(b.Squares(), 1)); !reflect.DeepEqual(got, []Square{A1}) {
+		t.Errorf("Squares stopped early = %v", got)
+	}
+	if low, ok := b.Lowest(); !ok || low != A1 {
+		t.Errorf("Lowest = %v, %v", low, ok)
+	}
+	if high, ok := b.Clear(H8).Highest(); !ok || high != 28 {
+		t.Errorf("Highest = %v, %v", high, ok)
+	}
+	if _, ok := Empty.Lowest(); ok {
+		t.Errorf("Lowest of the empty board is found")
+	}
+	if Empty.Set(A8) != Of(A8) || Full.Count() != 64 {
+		t.Errorf("Set, Full are wrong")
+	}
+	want := "1.......\n" + "........\n" + "........\n" + "........\n" +
+		"........\n" + "........\n" + "........\n" + ".......1"
+	if got := Of(A8, H1).String(); got != want {
+		t.Errorf("String =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestShift(t *testing.T) {
+	offsets := map[Direction][2]int{
+		North: {0, 1}, NorthEast: {1, 1}, East: {1, 0}, SouthEast: {1, -1},
+		South: {0, -1}, SouthWest: {-1, -1}, West: {-1, 0}, NorthWest: {-1, 1},
+	}
+	for d, offset := range offsets {
+		for s := A1; s <= H8; s++ {
+			var want Bitboard
+			file, rank := s.File()+offset[0], s.Rank()+offset[1]
+			if file >= 0 && file < 8 && rank >= 0 && rank < 8 {
+				want = Of(NewSquare(file, rank))
+			}
+			if got := Of(s).Shift(d); got != want {
+				t.Fatalf("Shift(%d) of %v = %v, want %v", d, s, iterutil.Collect(got.Squares()), iterutil.Collect(want.Squares()))
+			}
+		}
+	}
+}
@@ -0,0 +1,84 @@
+// moves.go
+// description: Move generation for knights, kings and pawns
+// details:
+// The moves of a piece are its attacks minus the squares of its own side.
+// For knights and kings, they are the attacks of each piece, iterated from
+// the squares of the bitboard of the pieces. Pawns are moved all at once:
+// the single pushes are the pawns shifted one rank forward onto empty
+// squares, the double pushes those of the single pushes landing on the third
+// rank pushed again, and the captures the pawns shifted diagonally onto the
+// enemy pieces. The origin of each move is then found from its target by the
+// opposite offset. Castling, en passant and checks are left out: the moves
+// are pseudo-legal.
+// time complexity: O(k) for k moves
+// space complexity: O(k)
+// reference: https://www.chessprogramming.org/Move_Generation
+// reference: https://www.chessprogramming.org/Pawn_Pushes_(Bitboards)
+// see moves_test.go
+
+package bitboard
+
+// Color is the side of a piece.
+type Color int
+
+// The two sides, white moving towards the eighth rank.
+const (
+	White Color = iota
+	Black
+)
+
+// Move is the move of a piece from a square to another. A pawn reaching the
+// last rank is promoted, to a piece left to the caller.
+type Move struct {
+	From, To Square
+}
+
+// appendMoves appends the moves from every square of pieces to the squares
+// of targets(square), except those of own.
+func appendMoves(moves []Move, pieces, own Bitboard, targets func(Bitboard) Bitboard) []Move {
+	pieces.Squares()(func(from Square) bool {
+		(targets(Of(from)) &^ own).Squares()(func(to Square) bool {
+			moves = append(moves, Move{from, to})
+			return true
+		})
+		return true
+	})
+	return moves
+}
+
+// KnightMoves returns the moves of the knights of knights, own holding the
+// squares of their side.
+func KnightMoves(knights, own Bitboard) []Move {
+	return appendMoves(nil, knights, own, KnightAttacks)
+}
+
+// KingMoves returns the moves of the kings of kings, own holding the squares
+// of their side.
+func KingMoves(kings, own Bitboard) []Move {
+	return appendMoves(nil, kings, own, KingAttacks)
+}
+
+// PawnMoves returns the pushes and captures of the pawns of pawns of color
+// c, own and enemy holding the squares of each side.
+func PawnMoves(pawns, own, enemy Bitboard, c Color) []Move {
+	empty := ^(own | enemy)
+	forward, east, west, third := North, NorthEast, NorthWest, Rank3
+	step := Square(8)
+	if c == Black {
+		forward, east, west, third = South, SouthEast, SouthWest, Rank6
+		step = -8
+	}
+	var moves []Move
+	add := func(targets Bitboard, offset Square) {
+		targets.Squares()(func(to Square) bool {
+			moves = append(moves, Move{to - offset, to})
+			return true
+		})
+	}
+	single := pawns.Shift(forward) & empty
+	add(single, step)
+	add((single&third).Shift(forward)&empty, 2*step)
+	add(pawns.Shift(east)&enemy, step+1)
+	add(pawns.Shift(west)&enemy, step-1)
+	return moves
+}
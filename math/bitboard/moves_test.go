@@ -0,0 +1,83 @@
+package bitboard
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// parseMoves returns the moves written as pairs of squares, like e2e4.
+func parseMoves(t *testing.T, names ...string) []Move {
+	moves := make([]Move, len(names))
+	for i, name := range names {
+		from, err1 := ParseSquare(name[:2])
+		to, err2 := ParseSquare(name[2:])
+		if err1 != nil || err2 != nil {
+			t.Fatalf("bad move %q", name)
+		}
+		moves[i] = Move{from, to}
+	}
+	return moves
+}
+
+func sortMoves(moves []Move) []Move {
+	sort.Slice(moves, func(i, j int) bool {
+		return moves[i].From < moves[j].From || moves[i].From == moves[j].From && moves[i].To < moves[j].To
+	})
+	return moves
+}
+
+func TestStartingPosition(t *testing.T) {
+	white := Rank1 | Rank2
+	black := Rank7 | Rank8
+	knights := Of(NewSquare(1, 0), NewSquare(6, 0))
+	if got := KnightMoves(knights, white); !reflect.DeepEqual(sortMoves(got), sortMoves(parseMoves(t, "b1a3", "b1c3", "g1f3", "g1h3"))) {
+		t.Errorf("KnightMoves = %v", got)
+	}
+	if got := KingMoves(Of(NewSquare(4, 0)), white); len(got) != 0 {
+		t.Errorf("KingMoves = %v, want none", got)
+	}
+	if got := PawnMoves(Rank2, white, black, White); len(got) != 16 {
+		t.Errorf("white PawnMoves = %d moves, want 16", len(got))
+	}
+	if got := PawnMoves(Rank7, black, white, Black); len(got) != 16 {
+		t.Errorf("black PawnMoves = %d moves, want 16", len(got))
+	}
+	// the 20 opening moves of white
+	if n := len(KnightMoves(knights, white)) + len(PawnMoves(Rank2, white, black, White)); n != 20 {
+		t.Errorf("white has %d moves, want 20", n)
+	}
+}
+
+func TestPawnMoves(t *testing.T) {
+	sq := func(name string) Bitboard {
+		s, _ := ParseSquare(name)
+		return Of(s)
+	}
+	// the pawn on e2 is blocked at e4, the one on b7 promotes or captures a8
+	// or c8, the one on h5 captures g6
+	pawns := sq("e2") | sq("b7") | sq("h5")
+	enemy := sq("e4") | sq("a8") | sq("c8") | sq("g6") | sq("h6")
+	got := sortMoves(PawnMoves(pawns, pawns, enemy, White))
+	want := sortMoves(parseMoves(t, "e2e3", "b7b8", "b7a8", "b7c8", "h5g6"))
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("white PawnMoves = %v, want %v", got, want)
+	}
+	// a black pawn on its starting rank, and one capturing towards the a-file
+	pawns = sq("d7") | sq("b3")
+	enemy = sq("a2") | sq("c2") | sq("b2")
+	got = sortMoves(PawnMoves(pawns, pawns, enemy, Black))
+	want = sortMoves(parseMoves(t, "d7d6", "d7d5", "b3a2", "b3c2"))
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("black PawnMoves = %v, want %v", got, want)
+	}
+}
+
+func TestKingMoves(t *testing.T) {
+	h1, _ := ParseSquare("h1")
+	own := Of(NewSquare(6, 1))
+	got := sortMoves(KingMoves(Of(h1), own))
+	if want := sortMoves(parseMoves(t, "h1g1", "h1h2")); !reflect.DeepEqual(got, want) {
+		t.Errorf("KingMoves = %v, want %v", got, want)
+	}
+}
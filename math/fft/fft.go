@@ -0,0 +1,124 @@
+// fft.go
+// description: Iterative Cooley-Tukey Fast Fourier Transform
+// details:
+// The discrete Fourier transform of n values evaluates the polynomial with those
+// coefficients at the n complex roots of unity. Cooley-Tukey splits the
+// polynomial into its even and odd coefficients, which only need the n/2 roots
+// of unity, and combines the halves with butterflies. The iterative version
+// first puts the values in bit reversed order and then merges blocks of size
+// 2, 4, ... n in place, avoiding recursion and allocations.
+// Multiplying two polynomials is then a transform of both, a pointwise product
+// and an inverse transform.
+// time complexity: O(n log n)
+// space complexity: O(1) for the transforms, O(n) for Convolve
+// reference: https://en.wikipedia.org/wiki/Cooley%E2%80%93Tukey_FFT_algorithm, CLRS chapter 30
+// see fft_test.go
+
+// Package fft implements the Fast Fourier Transform, the Number Theoretic
+// Transform and fast polynomial multiplication built on them.
+package fft
+
+import (
+	"errors"
+	"math"
+	"math/cmplx"
+)
+
+// ErrNotPowerOfTwo is returned when the length of a transform is not a power of two.
+var ErrNotPowerOfTwo = errors.New("length must be a power of two")
+
+// FFT replaces a with its discrete Fourier transform. The length of a must
+// be a power of two.
+func FFT(a []complex128) error {
+	return fft(a, false)
+}
+
+// InverseFFT replaces a with its inverse discrete Fourier transform, so that
+// InverseFFT undoes FFT. The length of a must be a power of two.
+func InverseFFT(a []complex128) error {
+	return fft(a, true)
+}
+
+func fft(a []complex128, invert bool) error {
+	n := len(a)
+	if n == 0 || n&(n-1) != 0 {
+		return ErrNotPowerOfTwo
+	}
+	bitReverse(a)
+	for size := 2; size <= n; size <<= 1 {
+		angle := 2 * math.Pi / float64(size)
+		if invert {
+			angle = -angle
+		}
+		root := cmplx.Rect(1, angle)
+		for start := 0; start < n; start += size {
+			w := complex(1, 0)
+			for j := 0; j < size/2; j++ {
+				u, v := a[start+j], a[start+j+size/2]*w
+				a[start+j], a[start+j+size/2] = u+v, u-v
+				w *= root
+			}
+		}
+	}
+	if invert {
+		for i := range a {
+			a[i] /= complex(float64(n), 0)
+		}
+	}
+	return nil
+}
+
+// bitReverse permutes a so that element i moves to the index whose binary
+// representation is that of i reversed. The length must be a power of two.
+func bitReverse[T any](a []T) {
+	n := len(a)
+	for i, j := 1, 0; i < n; i++ {
+		bit := n >> 1
+		for ; j&bit != 0; bit >>= 1 {
+			j ^= bit
+		}
+		j ^= bit
+		if i < j {
+			a[i], a[j] = a[j], a[i]
+		}
+	}
+}
+
+// transformSize returns the smallest power of two that can hold the product
+// of polynomials with n and m coefficients.
+func transformSize(n, m int) int {
+	size := 1
+	for size < n+m-1 {
+		size <<= 1
+	}
+	return size
+}
+
+// Convolve returns the coefficients of the product of the polynomials with
+// coefficients a and b, lowest degree first. The result is subject to floating
+// point rounding; use MultiplyPolynomials for exact integer products.
+func Convolve(a, b []float64) []float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return nil
+	}
+	size := transformSize(len(a), len(b))
+	fa := make([]complex128, size)
+	fb := make([]complex128, size)
+	for i, v := range a {
+		fa[i] = complex(v, 0)
+	}
+	for i, v := range b {
+		fb[i] = complex(v, 0)
+	}
+	_ = FFT(fa)
+	_ = FFT(fb)
+	for i := range fa {
+		fa[i] *= fb[i]
+	}
+	_ = InverseFFT(fa)
+	result := make([]float64, len(a)+len(b)-1)
+	for i := range result {
+		result[i] = real(fa[i])
+	}
+	return result
+}
@@ -0,0 +1,65 @@
+package fft_test
+
+import (
+	"math"
+	"math/cmplx"
+	"math/rand"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/math/fft"
+)
+
+func TestFFT(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{1, 2, 8, 64} {
+		a := make([]complex128, n)
+		for i := range a {
+			a[i] = complex(rnd.Float64(), rnd.Float64())
+		}
+		got := append([]complex128(nil), a...)
+		if err := fft.FFT(got); err != nil {
+			t.Fatal(err)
+		}
+		// Direct O(n^2) evaluation of the transform.
+		for k := 0; k < n; k++ {
+			var want complex128
+			for j, v := range a {
+				want += v * cmplx.Rect(1, 2*math.Pi*float64(j*k)/float64(n))
+			}
+			if cmplx.Abs(got[k]-want) > 1e-9 {
+				t.Fatalf("FFT of length %d: element %d = %v, want %v", n, k, got[k], want)
+			}
+		}
+		if err := fft.InverseFFT(got); err != nil {
+			t.Fatal(err)
+		}
+		for i := range a {
+			if cmplx.Abs(got[i]-a[i]) > 1e-9 {
+				t.Fatalf("InverseFFT(FFT(a)) differs from a at %d: %v, want %v", i, got[i], a[i])
+			}
+		}
+	}
+
+	for _, n := range []int{0, 3, 12} {
+		if err := fft.FFT(make([]complex128, n)); err != fft.ErrNotPowerOfTwo {
+			t.Errorf("FFT of length %d: err = %v, want ErrNotPowerOfTwo", n, err)
+		}
+	}
+}
+
+func TestConvolve(t *testing.T) {
+	// (1 + 2x + 3x^2)(4 + 5x) = 4 + 13x + 22x^2 + 15x^3
+	got := fft.Convolve([]float64{1, 2, 3}, []float64{4, 5})
+	want := []float64{4, 13, 22, 15}
+	if len(got) != len(want) {
+		t.Fatalf("Convolve = %v, want %v", got, want)
+	}
+	for i := range want {
+		if math.Abs(got[i]-want[i]) > 1e-9 {
+			t.Fatalf("Convolve = %v, want %v", got, want)
+		}
+	}
+	if got := fft.Convolve(nil, []float64{1}); got != nil {
+		t.Errorf("Convolve with an empty polynomial = %v, want nil", got)
+	}
+}
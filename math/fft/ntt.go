@@ -0,0 +1,219 @@
+// ntt.go
+// description: Number Theoretic Transform and exact polynomial multiplication
+// details:
+// The Number Theoretic Transform is the Fourier transform over the integers
+// modulo a prime p of the form c * 2^k + 1, whose multiplicative group has roots
+// of unity of every order up to 2^k. Arithmetic is exact, so products of integer
+// polynomials come out without rounding, reduced modulo p.
+// MultiplyPolynomials runs the transform modulo three such primes and rebuilds
+// each coefficient with the Chinese remainder theorem in Garner's form. As the
+// three primes multiply to about 7.9e25, every coefficient of absolute value
+// below half of that is recovered.
+// time complexity: O(n log n)
+// space complexity: O(n)
+// reference: https://cp-algorithms.com/algebra/fft.html#number-theoretic-transform, https://en.wikipedia.org/wiki/Mixed_radix#Garner
+// see ntt_test.go
+
+package fft
+
+import "errors"
+
+// Modulus is the prime 119 * 2^23 + 1 used by NTT, InverseNTT and MultiplyMod.
+const Modulus = 998244353
+
+// MaxLength is the longest transform of NTT and InverseNTT, and the longest
+// product of MultiplyMod and MultiplyPolynomials: Modulus has no roots of
+// unity of a larger power of two order.
+const MaxLength = 1 << 23
+
+// ErrTooLong is returned when a transform or a product is longer than
+// MaxLength.
+var ErrTooLong = errors.New("length exceeds the maximum transform length")
+
+// nttPrime is a prime p = c * 2^k + 1 along with a generator of the
+// multiplicative group modulo p.
+type nttPrime struct {
+	p, g uint64
+}
+
+// The primes used by MultiplyPolynomials, the first being Modulus. All of
+// them allow transforms of length up to 2^23 at least.
+var nttPrimes = [3]nttPrime{
+	{998244353, 3}, // 119 * 2^23 + 1
+	{167772161, 3}, // 5 * 2^25 + 1
+	{469762049, 3}, // 7 * 2^26 + 1
+}
+
+// NTT replaces a with its Number Theoretic Transform modulo Modulus. The
+// length of a must be a power of two no larger than MaxLength, and its
+// elements smaller than Modulus.
+func NTT(a []uint64) error {
+	return ntt(a, nttPrimes[0], false)
+}
+
+// InverseNTT replaces a with its inverse Number Theoretic Transform modulo
+// Modulus, so that InverseNTT undoes NTT.
+func InverseNTT(a []uint64) error {
+	return ntt(a, nttPrimes[0], true)
+}
+
+func ntt(a []uint64, prime nttPrime, invert bool) error {
+	n := len(a)
+	if n == 0 || n&(n-1) != 0 {
+		return ErrNotPowerOfTwo
+	}
+	p := prime.p
+	if (p-1)%uint64(n) != 0 {
+		return ErrTooLong
+	}
+	bitReverse(a)
+	for size := 2; size <= n; size <<= 1 {
+		// root is a primitive root of unity of order size.
+		root := powMod(prime.g, (p-1)/uint64(size), p)
+		if invert {
+			root = powMod(root, p-2, p)
+		}
+		for start := 0; start < n; start += size {
+			w := uint64(1)
+			for j := 0; j < size/2; j++ {
+				u, v := a[start+j], a[start+j+size/2]*w%p
+				a[start+j] = (u + v) % p
+				a[start+j+size/2] = (u + p - v) % p
+				w = w * root % p
+			}
+		}
+	}
+	if invert {
+		nInverse := powMod(uint64(n), p-2, p)
+		for i := range a {
+			a[i] = a[i] * nInverse % p
+		}
+	}
+	return nil
+}
+
+// powMod returns base^exp mod m, for m below 2^32.
+func powMod(base, exp, m uint64) uint64 {
+	result := uint64(1)
+	base %= m
+	for ; exp > 0; exp >>= 1 {
+		if exp&1 == 1 {
+			result = result * base % m
+		}
+		base = base * base % m
+	}
+	return result
+}
+
+// checkProduct reports whether the product of polynomials with n and m
+// coefficients fits in a transform.
+func checkProduct(n, m int) error {
+	if n+m-1 > MaxLength {
+		return ErrTooLong
+	}
+	return nil
+}
+
+// multiplyMod returns the product of the polynomials a and b, whose
+// coefficients are already reduced, modulo prime.p.
+func multiplyMod(a, b []uint64, prime nttPrime) ([]uint64, error) {
+	if err := checkProduct(len(a), len(b)); err != nil {
+		return nil, err
+	}
+	size := transformSize(len(a), len(b))
+	fa := make([]uint64, size)
+	fb := make([]uint64, size)
+	copy(fa, a)
+	copy(fb, b)
+	if err := ntt(fa, prime, false); err != nil {
+		return nil, err
+	}
+	if err := ntt(fb, prime, false); err != nil {
+		return nil, err
+	}
+	for i := range fa {
+		fa[i] = fa[i] * fb[i] % prime.p
+	}
+	if err := ntt(fa, prime, true); err != nil {
+		return nil, err
+	}
+	return fa[:len(a)+len(b)-1], nil
+}
+
+// MultiplyMod returns the coefficients of the product of the polynomials
+// with coefficients a and b, lowest degree first, modulo Modulus. It fails
+// with ErrTooLong if the product has more than MaxLength coefficients.
+func MultiplyMod(a, b []uint64) ([]uint64, error) {
+	if len(a) == 0 || len(b) == 0 {
+		return nil, nil
+	}
+	if err := checkProduct(len(a), len(b)); err != nil {
+		return nil, err
+	}
+	ra := make([]uint64, len(a))
+	rb := make([]uint64, len(b))
+	for i, v := range a {
+		ra[i] = v % Modulus
+	}
+	for i, v := range b {
+		rb[i] = v % Modulus
+	}
+	return multiplyMod(ra, rb, nttPrimes[0])
+}
+
+// MultiplyPolynomials returns the coefficients of the product of the
+// polynomials with coefficients a and b, lowest degree first. Coefficients of
+// the product that fit in an int64 are exact. Larger ones wrap around like
+// int64 arithmetic does, as long as they stay below about 3.9e25 in absolute value.
+// It fails with ErrTooLong if the product has more than MaxLength coefficients.
+func MultiplyPolynomials(a, b []int64) ([]int64, error) {
+	if len(a) == 0 || len(b) == 0 {
+		return nil, nil
+	}
+	if err := checkProduct(len(a), len(b)); err != nil {
+		return nil, err
+	}
+	var residues [3][]uint64
+	for k, prime := range nttPrimes {
+		var err error
+		residues[k], err = multiplyMod(reduce(a, prime.p), reduce(b, prime.p), prime)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	m1, m2, m3 := nttPrimes[0].p, nttPrimes[1].p, nttPrimes[2].p
+	m1InvM2 := powMod(m1, m2-2, m2)
+	m1m2InvM3 := powMod(m1*m2%m3, m3-2, m3)
+	result := make([]int64, len(a)+len(b)-1)
+	for i := range result {
+		// Garner: x = r1 + m1*k2 + m1*m2*k3 with r1 < m1, k2 < m2, k3 < m3.
+		r1, r2, r3 := residues[0][i], residues[1][i], residues[2][i]
+		k2 := (r2 + m2 - r1%m2) % m2 * m1InvM2 % m2
+		k3 := (r3 + m3 - (r1+m1%m3*k2)%m3) % m3 * m1m2InvM3 % m3
+		// Evaluate x modulo 2^64 by letting the arithmetic wrap.
+		x := r1 + m1*k2 + m1*m2*k3
+		// x lies in [0, m1*m2*m3); values past half of it stand for negative
+		// numbers. Comparing the mixed radix digits with those of (P-1)/2,
+		// which are ((m1-1)/2, (m2-1)/2, (m3-1)/2) as every prime is odd,
+		// avoids computing P itself.
+		if k3 > (m3-1)/2 || (k3 == (m3-1)/2 && (k2 > (m2-1)/2 || (k2 == (m2-1)/2 && r1 > (m1-1)/2))) {
+			x -= m1 * m2 * m3
+		}
+		result[i] = int64(x)
+	}
+	return result, nil
+}
+
+// reduce returns the coefficients of a reduced into [0, p).
+func reduce(a []int64, p uint64) []uint64 {
+	r := make([]uint64, len(a))
+	for i, v := range a {
+		m := v % int64(p)
+		if m < 0 {
+			m += int64(p)
+		}
+		r[i] = uint64(m)
+	}
+	return r
+}
@@ -0,0 +1,127 @@
+package fft_test
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/math/fft"
+)
+
+// naive multiplies polynomials in O(n*m), wrapping around on overflow.
+func naive(a, b []int64) []int64 {
+	result := make([]int64, len(a)+len(b)-1)
+	for i, x := range a {
+		for j, y := range b {
+			result[i+j] += x * y
+		}
+	}
+	return result
+}
+
+func randomPolynomial(rnd *rand.Rand, n int, bound int64) []int64 {
+	p := make([]int64, n)
+	for i := range p {
+		p[i] = rnd.Int63n(2*bound+1) - bound
+	}
+	return p
+}
+
+func TestNTT(t *testing.T) {
+	rnd := rand.New(rand.NewSource(2))
+	a := make([]uint64, 256)
+	for i := range a {
+		a[i] = uint64(rnd.Int63n(fft.Modulus))
+	}
+	got := append([]uint64(nil), a...)
+	if err := fft.NTT(got); err != nil {
+		t.Fatal(err)
+	}
+	if err := fft.InverseNTT(got); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, a) {
+		t.Errorf("InverseNTT(NTT(a)) differs from a")
+	}
+	if err := fft.NTT(make([]uint64, 6)); err != fft.ErrNotPowerOfTwo {
+		t.Errorf("NTT of length 6: err = %v, want ErrNotPowerOfTwo", err)
+	}
+	if err := fft.NTT(make([]uint64, 2*fft.MaxLength)); err != fft.ErrTooLong {
+		t.Errorf("NTT of length 2*MaxLength: err = %v, want ErrTooLong", err)
+	}
+}
+
+func TestMultiplyMod(t *testing.T) {
+	// (x + 2)(x^2 - 1) with -1 written as Modulus - 1.
+	got, err := fft.MultiplyMod([]uint64{2, 1}, []uint64{fft.Modulus - 1, 0, 1})
+	want := []uint64{fft.Modulus - 2, fft.Modulus - 1, 2, 1}
+	if err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("MultiplyMod = %v, %v, want %v", got, err, want)
+	}
+	if got, err := fft.MultiplyMod(nil, []uint64{1}); got != nil || err != nil {
+		t.Errorf("MultiplyMod with an empty polynomial = %v, %v, want nil", got, err)
+	}
+	// The product has MaxLength + 1 coefficients.
+	long := make([]uint64, fft.MaxLength/2+1)
+	if _, err := fft.MultiplyMod(long, long); err != fft.ErrTooLong {
+		t.Errorf("MultiplyMod of a product too long: err = %v, want ErrTooLong", err)
+	}
+}
+
+func TestMultiplyPolynomials(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b []int64
+		want []int64
+	}{
+		{"constants", []int64{6}, []int64{-7}, []int64{-42}},
+		{"small", []int64{1, 2, 3}, []int64{4, 5}, []int64{4, 13, 22, 15}},
+		{"negative", []int64{-1, 1}, []int64{1, 1}, []int64{-1, 0, 1}},
+		{"zeros", []int64{0, 0}, []int64{5, 0, 3}, []int64{0, 0, 0, 0}},
+		{"int64 bounds", []int64{math.MaxInt64}, []int64{1, -1}, []int64{math.MaxInt64, -math.MaxInt64}},
+		{"int64 minimum", []int64{math.MinInt64 / 2}, []int64{2}, []int64{math.MinInt64}},
+		{"empty", nil, []int64{1}, nil},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got, err := fft.MultiplyPolynomials(test.a, test.b); err != nil || !reflect.DeepEqual(got, test.want) {
+				t.Errorf("MultiplyPolynomials(%v, %v) = %v, %v, want %v", test.a, test.b, got, err, test.want)
+			}
+		})
+	}
+	long := make([]int64, fft.MaxLength/2+1)
+	if _, err := fft.MultiplyPolynomials(long, long); err != fft.ErrTooLong {
+		t.Errorf("MultiplyPolynomials of a product too long: err = %v, want ErrTooLong", err)
+	}
+}
+
+func TestMultiplyPolynomialsAgainstNaive(t *testing.T) {
+	rnd := rand.New(rand.NewSource(3))
+	// With 1 << 38 the products overflow int64 and both sides wrap the same way.
+	for _, bound := range []int64{10, 1 << 20, 1 << 31, 1 << 38} {
+		for round := 0; round < 10; round++ {
+			a := randomPolynomial(rnd, 1+rnd.Intn(300), bound)
+			b := randomPolynomial(rnd, 1+rnd.Intn(300), bound)
+			if got, err := fft.MultiplyPolynomials(a, b); err != nil || !reflect.DeepEqual(got, naive(a, b)) {
+				t.Fatalf("MultiplyPolynomials differs from the naive product for bound %d", bound)
+			}
+		}
+	}
+}
+
+func BenchmarkMultiplyPolynomials(b *testing.B) {
+	rnd := rand.New(rand.NewSource(1))
+	x := randomPolynomial(rnd, 1<<12, 1<<20)
+	y := randomPolynomial(rnd, 1<<12, 1<<20)
+	b.Run("ntt", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = fft.MultiplyPolynomials(x, y)
+		}
+	})
+	b.Run("naive", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			naive(x, y)
+		}
+	})
+}
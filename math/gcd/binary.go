@@ -0,0 +1,50 @@
+// binary.go
+// description: Stein's binary GCD algorithm
+// details:
+// Binary GCD replaces the divisions of Euclid's algorithm by shifts and
+// subtractions: gcd(2a, 2b) = 2 gcd(a, b), gcd(2a, b) = gcd(a, b) for odd b,
+// and gcd(a, b) = gcd(|a - b|, min(a, b)) for odd a and b, whose difference
+// is even again. Counting trailing zeros removes all factors of two at once.
+// time complexity: O(log(a) + log(b)) shifts and subtractions
+// space complexity: O(1)
+// reference: https://en.wikipedia.org/wiki/Binary_GCD_algorithm
+// see gcd_test.go
+
+package gcd
+
+import "math/bits"
+
+// binaryUint64 returns the greatest common divisor of a and b.
+func binaryUint64(a, b uint64) uint64 {
+	if a == 0 {
+		return b
+	}
+	if b == 0 {
+		return a
+	}
+	shift := bits.TrailingZeros64(a | b)
+	a >>= bits.TrailingZeros64(a)
+	for b != 0 {
+		b >>= bits.TrailingZeros64(b)
+		if a > b {
+			a, b = b, a
+		}
+		b -= a
+	}
+	return a << shift
+}
+
+// abs returns the magnitude of x, which is right even for the smallest int64.
+func abs(x int64) uint64 {
+	if x < 0 {
+		return uint64(-x)
+	}
+	return uint64(x)
+}
+
+// Binary returns the greatest common divisor of a and b, which is never
+// negative, using Stein's algorithm. The result overflows only for
+// gcd(math.MinInt64, 0) and gcd(math.MinInt64, math.MinInt64), which are 2^63.
+func Binary(a, b int64) int64 {
+	return int64(binaryUint64(abs(a), abs(b)))
+}
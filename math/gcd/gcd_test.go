@@ -1,6 +1,10 @@
 package gcd
 
-import "testing"
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
 
 type testFunction func(int64, int64) int64
 
@@ -47,3 +51,26 @@ func BenchmarkGCDRecursive(b *testing.B) {
 func BenchmarkGCDIterative(b *testing.B) {
 	TemplateBenchmarkGCD(b, Iterative)
 }
+
+func TestGCDBinary(t *testing.T) {
+	TemplateTestGCD(t, Binary)
+
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		a, b := rnd.Int63n(1<<40)-1<<39, rnd.Int63n(1<<40)-1<<39
+		want := Iterative(a, b)
+		if want < 0 {
+			want = -want
+		}
+		if got := Binary(a, b); got != want {
+			t.Fatalf("Binary(%d, %d) = %d, want %d", a, b, got, want)
+		}
+	}
+	if got := Binary(math.MinInt64, 6); got != 2 {
+		t.Errorf("Binary(MinInt64, 6) = %d, want 2", got)
+	}
+}
+
+func BenchmarkGCDBinary(b *testing.B) {
+	TemplateBenchmarkGCD(b, Binary)
+}
@@ -0,0 +1,53 @@
+// rangegcd.go
+// description: Constant time GCD of any range of a slice with a sparse table
+// details:
+// A sparse table stores the GCD of every range of 2^k consecutive values.
+// Since gcd(x, x) = x, overlapping ranges do no harm: the GCD of values[l:r]
+// is the GCD of the two ranges of length 2^k starting at l and ending at r,
+// 2^k being the largest power of two not above r - l.
+// Build: O(n log n) GCDs
+// Query: O(1) GCDs
+// space complexity: O(n log n)
+// reference: https://cp-algorithms.com/data_structures/sparse-table.html
+// see rangegcd_test.go
+
+package gcd
+
+import "math/bits"
+
+// Range answers GCD queries over ranges of a fixed slice.
+type Range struct {
+	// table[k][i] is the GCD of values[i : i+2^k]
+	table [][]uint64
+}
+
+// NewRange builds the sparse table of values.
+func NewRange(values []int64) *Range {
+	level := make([]uint64, len(values))
+	for i, v := range values {
+		level[i] = abs(v)
+	}
+	r := &Range{table: [][]uint64{level}}
+	for k := 1; 1<<k <= len(values); k++ {
+		previous, half := r.table[k-1], 1<<(k-1)
+		level := make([]uint64, len(values)-1<<k+1)
+		for i := range level {
+			level[i] = binaryUint64(previous[i], previous[i+half])
+		}
+		r.table = append(r.table, level)
+	}
+	return r
+}
+
+// Query returns the greatest common divisor of values[l:r], 0 for an empty
+// range. It panics if the range is out of bounds.
+func (g *Range) Query(l, r int) int64 {
+	if l < 0 || r > len(g.table[0]) || l > r {
+		panic("gcd: range out of bounds")
+	}
+	if l == r {
+		return 0
+	}
+	k := bits.Len(uint(r-l)) - 1
+	return int64(binaryUint64(g.table[k][l], g.table[k][r-1<<k]))
+}
@@ -0,0 +1,32 @@
+package gcd
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestRange(t *testing.T) {
+	rnd := rand.New(rand.NewSource(2))
+	for round := 0; round < 20; round++ {
+		values := make([]int64, rnd.Intn(40))
+		factor := int64(1 + rnd.Intn(6))
+		for i := range values {
+			values[i] = factor * (rnd.Int63n(200) - 100)
+		}
+		r := NewRange(values)
+		for l := 0; l <= len(values); l++ {
+			for h := l; h <= len(values); h++ {
+				if got, want := r.Query(l, h), Slice(values[l:h]); got != want {
+					t.Fatalf("Query(%d, %d) of %v = %d, want %d", l, h, values, got, want)
+				}
+			}
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Query out of bounds should panic")
+		}
+	}()
+	NewRange([]int64{1, 2}).Query(1, 3)
+}
@@ -0,0 +1,25 @@
+// slice.go
+// description: Greatest common divisor of a whole slice
+// details:
+// The GCD is associative, so the GCD of many numbers is obtained by folding
+// the slice with the binary GCD. The running GCD only decreases, and the fold
+// stops as soon as it reaches 1.
+// time complexity: O(n + log(max)) amortized: every step that does not leave
+// the running GCD unchanged at least halves it
+// space complexity: O(1)
+// see slice_test.go
+
+package gcd
+
+// Slice returns the greatest common divisor of values, which is 0 for an
+// empty slice or a slice of zeros.
+func Slice(values []int64) int64 {
+	var g uint64
+	for _, v := range values {
+		g = binaryUint64(g, abs(v))
+		if g == 1 {
+			break
+		}
+	}
+	return int64(g)
+}
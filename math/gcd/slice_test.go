@@ -0,0 +1,23 @@
+package gcd
+
+import "testing"
+
+func TestSlice(t *testing.T) {
+	tests := []struct {
+		values []int64
+		want   int64
+	}{
+		{nil, 0},
+		{[]int64{0, 0}, 0},
+		{[]int64{12}, 12},
+		{[]int64{-12}, 12},
+		{[]int64{12, 18, 30}, 6},
+		{[]int64{12, 18, 35, 30}, 1},
+		{[]int64{0, -8, 12}, 4},
+	}
+	for _, test := range tests {
+		if got := Slice(test.values); got != test.want {
+			t.Errorf("Slice(%v) = %d, want %d", test.values, got, test.want)
+		}
+	}
+}
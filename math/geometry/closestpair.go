@@ -0,0 +1,105 @@
+// closestpair.go
+// description: Closest pair of points by divide and conquer
+// details:
+// The points are sorted by X and split at the median. The closest pair is either
+// inside one half, found recursively, or straddles the dividing line. In the
+// latter case only points closer than the best distance d to the line matter,
+// and when those are sorted by Y each one has to be compared with a constant
+// number of successors, less than d apart vertically. Sorting by Y is done by
+// merging the halves on the way back, keeping the whole O(n log n).
+// time complexity: O(n log n)
+// space complexity: O(n)
+// reference: https://en.wikipedia.org/wiki/Closest_pair_of_points_problem, CLRS chapter 33.4
+// see closestpair_test.go
+
+package geometry
+
+import (
+	"errors"
+	"math"
+	"sort"
+)
+
+// ErrTooFewPoints is returned when fewer than two points are given.
+var ErrTooFewPoints = errors.New("at least two points are required")
+
+// ClosestPair returns two of the points with the smallest distance between
+// them, along with that distance.
+func ClosestPair(points []Point) (Point, Point, float64, error) {
+	if len(points) < 2 {
+		return Point{}, Point{}, 0, ErrTooFewPoints
+	}
+	byX := make([]Point, len(points))
+	copy(byX, points)
+	sort.Slice(byX, func(i, j int) bool { return byX[i].X < byX[j].X })
+
+	best := pair{d: math.Inf(1)}
+	closest(byX, make([]Point, len(byX)), &best)
+	return best.a, best.b, math.Sqrt(best.d), nil
+}
+
+// pair holds the best pair found so far and their squared distance.
+type pair struct {
+	a, b Point
+	d    float64
+}
+
+func (p *pair) consider(a, b Point) {
+	if d := squaredDistance(a, b); d < p.d {
+		*p = pair{a: a, b: b, d: d}
+	}
+}
+
+// closest finds the closest pair in points, sorted by X, updating best. On
+// return points is sorted by Y instead; buffer is scratch space of the same size.
+func closest(points, buffer []Point, best *pair) {
+	n := len(points)
+	if n <= 3 {
+		for i := 0; i < n; i++ {
+			for j := i + 1; j < n; j++ {
+				best.consider(points[i], points[j])
+			}
+		}
+		sort.Slice(points, func(i, j int) bool { return points[i].Y < points[j].Y })
+		return
+	}
+	mid := n / 2
+	midX := points[mid].X
+	closest(points[:mid], buffer[:mid], best)
+	closest(points[mid:], buffer[mid:], best)
+	mergeByY(points, mid, buffer)
+
+	// Collect the strip around the dividing line, still sorted by Y.
+	strip := buffer[:0]
+	for _, p := range points {
+		if dx := p.X - midX; dx*dx < best.d {
+			strip = append(strip, p)
+		}
+	}
+	for i := range strip {
+		for j := i + 1; j < len(strip); j++ {
+			if dy := strip[j].Y - strip[i].Y; dy*dy >= best.d {
+				break
+			}
+			best.consider(strip[i], strip[j])
+		}
+	}
+}
+
+// mergeByY merges the halves points[:mid] and points[mid:], both sorted by Y.
+func mergeByY(points []Point, mid int, buffer []Point) {
+	merged := buffer[:0]
+	i, j := 0, mid
+	for i < mid && j < len(points) {
+		if points[j].Y < points[i].Y {
+			merged = append(merged, points[j])
+			j++
+		} else {
+			merged = append(merged, points[i])
+			i++
+		}
+	}
+	merged = append(merged, points[i:mid]...)
+	merged = append(merged, points[j:]...)
+	copy(points, merged)
+}
@@ -0,0 +1,67 @@
+package geometry
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestClosestPair(t *testing.T) {
+	tests := []struct {
+		name   string
+		points []Point
+		want   float64
+	}{
+		{"two points", []Point{{0, 0}, {3, 4}}, 5},
+		{"classic", []Point{{2, 3}, {12, 30}, {40, 50}, {5, 1}, {12, 10}, {3, 4}}, math.Sqrt(2)},
+		{"collinear", []Point{{0, 0}, {10, 0}, {4, 0}, {7, 0}, {2, 0}}, 2},
+		{"vertical line", []Point{{1, 9}, {1, 0}, {1, 5}, {1, 7}}, 2},
+		{"duplicates", []Point{{5, 5}, {1, 2}, {8, 1}, {1, 2}}, 0},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			a, b, d, err := ClosestPair(test.points)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if d != test.want || Distance(&a, &b) != d {
+				t.Errorf("ClosestPair(%v) = %v, %v, %v, want distance %v", test.points, a, b, d, test.want)
+			}
+		})
+	}
+
+	if _, _, _, err := ClosestPair([]Point{{1, 1}}); err != ErrTooFewPoints {
+		t.Errorf("ClosestPair of one point: err = %v, want ErrTooFewPoints", err)
+	}
+}
+
+func TestClosestPairAgainstBruteForce(t *testing.T) {
+	rnd := rand.New(rand.NewSource(2))
+	for round := 0; round < 50; round++ {
+		points := make([]Point, 2+rnd.Intn(200))
+		for i := range points {
+			points[i] = Point{float64(rnd.Intn(1000)), float64(rnd.Intn(1000))}
+		}
+		want := math.Inf(1)
+		for i := range points {
+			for j := i + 1; j < len(points); j++ {
+				want = math.Min(want, Distance(&points[i], &points[j]))
+			}
+		}
+		if _, _, got, _ := ClosestPair(points); got != want {
+			t.Fatalf("ClosestPair distance = %v, want %v", got, want)
+		}
+	}
+}
+
+func BenchmarkClosestPair(b *testing.B) {
+	rnd := rand.New(rand.NewSource(1))
+	points := make([]Point, 10000)
+	for i := range points {
+		points[i] = Point{rnd.Float64(), rnd.Float64()}
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _, _, _ = ClosestPair(points)
+	}
+}
@@ -0,0 +1,106 @@
+// convexhull.go
+// description: Convex hull of a set of points in the plane
+// details:
+// The convex hull is the smallest convex polygon containing every point.
+// Graham scan sorts the points by polar angle around the lowest point and
+// walks them keeping a stack of left turns. Andrew's monotone chain sorts the
+// points by coordinates and builds the lower and upper hulls separately, which
+// avoids angle comparisons altogether.
+// Both return the vertices of the hull in counter-clockwise order, without
+// duplicates and without points lying in the middle of an edge. Collinear
+// inputs give the two extreme points and a single distinct point gives itself.
+// time complexity: O(n log n)
+// space complexity: O(n)
+// reference: https://en.wikipedia.org/wiki/Graham_scan, https://en.wikibooks.org/wiki/Algorithm_Implementation/Geometry/Convex_hull/Monotone_chain
+// see convexhull_test.go
+
+package geometry
+
+import "sort"
+
+// GrahamScan returns the convex hull of points in counter-clockwise order,
+// starting from the lowest point, the leftmost one on ties.
+func GrahamScan(points []Point) []Point {
+	unique := uniquePoints(points)
+	if len(unique) < 3 {
+		return unique
+	}
+	pivot := 0
+	for i, p := range unique {
+		if p.Y < unique[pivot].Y || (p.Y == unique[pivot].Y && p.X < unique[pivot].X) {
+			pivot = i
+		}
+	}
+	unique[0], unique[pivot] = unique[pivot], unique[0]
+	p0 := unique[0]
+	rest := unique[1:]
+	sort.Slice(rest, func(i, j int) bool {
+		c := Cross(p0, rest[i], rest[j])
+		if c != 0 {
+			return c > 0
+		}
+		// Same direction from the pivot: nearer points first.
+		return squaredDistance(p0, rest[i]) < squaredDistance(p0, rest[j])
+	})
+
+	hull := []Point{p0}
+	for _, p := range rest {
+		for len(hull) > 1 && Cross(hull[len(hull)-2], hull[len(hull)-1], p) <= 0 {
+			hull = hull[:len(hull)-1]
+		}
+		hull = append(hull, p)
+	}
+	return hull
+}
+
+// MonotoneChain returns the convex hull of points in counter-clockwise
+// order, starting from the leftmost point, the lowest one on ties.
+func MonotoneChain(points []Point) []Point {
+	sorted := uniquePoints(points)
+	if len(sorted) < 3 {
+		return sorted
+	}
+	hull := make([]Point, 0, 2*len(sorted))
+	// Lower hull, from left to right.
+	for _, p := range sorted {
+		for len(hull) > 1 && Cross(hull[len(hull)-2], hull[len(hull)-1], p) <= 0 {
+			hull = hull[:len(hull)-1]
+		}
+		hull = append(hull, p)
+	}
+	// Upper hull, from right to left, on top of the lower one.
+	lower := len(hull)
+	for i := len(sorted) - 2; i >= 0; i-- {
+		p := sorted[i]
+		for len(hull) > lower && Cross(hull[len(hull)-2], hull[len(hull)-1], p) <= 0 {
+			hull = hull[:len(hull)-1]
+		}
+		hull = append(hull, p)
+	}
+	// The last point is the first one again.
+	return hull[:len(hull)-1]
+}
+
+// uniquePoints returns a copy of points sorted by X then Y, without duplicates.
+func uniquePoints(points []Point) []Point {
+	sorted := make([]Point, len(points))
+	copy(sorted, points)
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].X != sorted[j].X {
+			return sorted[i].X < sorted[j].X
+		}
+		return sorted[i].Y < sorted[j].Y
+	})
+	unique := sorted[:0]
+	for i, p := range sorted {
+		if i == 0 || p != sorted[i-1] {
+			unique = append(unique, p)
+		}
+	}
+	return unique
+}
+
+func squaredDistance(a, b Point) float64 {
+	dx, dy := a.X-b.X, a.Y-b.Y
+	return dx*dx + dy*dy
+}
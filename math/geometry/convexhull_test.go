@@ -0,0 +1,102 @@
+package geometry
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestConvexHull(t *testing.T) {
+	tests := []struct {
+		name     string
+		points   []Point
+		graham   []Point
+		monotone []Point
+	}{
+		{
+			"square with inner and edge points",
+			[]Point{{0, 0}, {2, 0}, {2, 2}, {0, 2}, {1, 1}, {1, 0}, {0, 1}, {2, 1}, {1, 2}},
+			[]Point{{0, 0}, {2, 0}, {2, 2}, {0, 2}},
+			[]Point{{0, 0}, {2, 0}, {2, 2}, {0, 2}},
+		},
+		{
+			"triangle",
+			[]Point{{3, 1}, {0, 0}, {1, 3}, {1, 1}},
+			[]Point{{0, 0}, {3, 1}, {1, 3}},
+			[]Point{{0, 0}, {3, 1}, {1, 3}},
+		},
+		{
+			"lowest point is not leftmost",
+			[]Point{{2, 0}, {4, 2}, {2, 4}, {0, 2}},
+			[]Point{{2, 0}, {4, 2}, {2, 4}, {0, 2}},
+			[]Point{{0, 2}, {2, 0}, {4, 2}, {2, 4}},
+		},
+		{
+			"collinear",
+			[]Point{{1, 1}, {3, 3}, {0, 0}, {2, 2}},
+			[]Point{{0, 0}, {3, 3}},
+			[]Point{{0, 0}, {3, 3}},
+		},
+		{
+			"vertical collinear",
+			[]Point{{0, 2}, {0, 0}, {0, 1}},
+			[]Point{{0, 0}, {0, 2}},
+			[]Point{{0, 0}, {0, 2}},
+		},
+		{
+			"duplicates",
+			[]Point{{1, 1}, {1, 1}, {1, 1}},
+			[]Point{{1, 1}},
+			[]Point{{1, 1}},
+		},
+		{
+			"duplicated vertices",
+			[]Point{{0, 0}, {0, 0}, {4, 0}, {4, 0}, {0, 4}, {0, 4}},
+			[]Point{{0, 0}, {4, 0}, {0, 4}},
+			[]Point{{0, 0}, {4, 0}, {0, 4}},
+		},
+		{"empty", nil, []Point{}, []Point{}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := GrahamScan(test.points); !reflect.DeepEqual(got, test.graham) {
+				t.Errorf("GrahamScan(%v) = %v, want %v", test.points, got, test.graham)
+			}
+			if got := MonotoneChain(test.points); !reflect.DeepEqual(got, test.monotone) {
+				t.Errorf("MonotoneChain(%v) = %v, want %v", test.points, got, test.monotone)
+			}
+		})
+	}
+}
+
+func TestConvexHullRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for round := 0; round < 50; round++ {
+		points := make([]Point, 1+rnd.Intn(60))
+		for i := range points {
+			// A small grid produces many collinear and repeated points.
+			points[i] = Point{float64(rnd.Intn(10)), float64(rnd.Intn(10))}
+		}
+		graham, monotone := GrahamScan(points), MonotoneChain(points)
+		if len(graham) != len(monotone) {
+			t.Fatalf("hulls of %v differ: %v and %v", points, graham, monotone)
+		}
+		if len(graham) < 3 {
+			continue
+		}
+		if PolygonArea(graham) != PolygonArea(monotone) {
+			t.Fatalf("hulls of %v differ: %v and %v", points, graham, monotone)
+		}
+		for i := range graham {
+			a, b, c := graham[i], graham[(i+1)%len(graham)], graham[(i+2)%len(graham)]
+			if Orientation(a, b, c) != 1 {
+				t.Fatalf("hull %v is not strictly convex at %v", graham, b)
+			}
+		}
+		for _, p := range points {
+			if PointInPolygon(p, graham) == Outside {
+				t.Fatalf("point %v lies outside the hull %v", p, graham)
+			}
+		}
+	}
+}
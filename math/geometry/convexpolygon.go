@@ -0,0 +1,252 @@
+// convexpolygon.go
+// description: Minkowski sum and intersection of convex polygons
+// details:
+// The Minkowski sum of two convex polygons is the set of sums a + b of a
+// point of each. It is convex, and its edges are the edges of both polygons
+// sorted by angle: starting from the lowest vertex of each, the two edge
+// sequences are merged like sorted lists, comparing directions with cross
+// products. Two shapes A and B collide exactly when the Minkowski sum of A
+// and -B contains the origin, which is how collision detection uses it.
+// The intersection of two convex polygons is found by O'Rourke's rotating
+// sweep: an edge of each polygon is advanced in turn, always the one aiming
+// at the other edge's line, so that both boundaries are walked at most twice
+// and every crossing of the boundaries is met. Between crossings the
+// vertices of the polygon that is inside the other one are kept. When the
+// boundaries never cross, one polygon contains the other or they are apart.
+// Polygons are given as their vertices in order, counter-clockwise or
+// clockwise. Results are counter-clockwise.
+// time complexity: O(n + m) for polygons with n and m vertices
+// space complexity: O(n + m)
+// reference: https://en.wikipedia.org/wiki/Minkowski_addition
+// reference: O'Rourke, Chien, Olson and Naddor, "A new linear algorithm for intersecting convex polygons", 1982
+// see convexpolygon_test.go
+
+package geometry
+
+// counterClockwise returns a copy of polygon with its vertices in
+// counter-clockwise order.
+func counterClockwise(polygon []Point) []Point {
+	c := make([]Point, len(polygon))
+	copy(c, polygon)
+	if SignedArea(c) < 0 {
+		for i, j := 0, len(c)-1; i < j; i, j = i+1, j-1 {
+			c[i], c[j] = c[j], c[i]
+		}
+	}
+	return c
+}
+
+// fromLowest returns polygon rotated to start from its lowest vertex, the
+// leftmost one on ties.
+func fromLowest(polygon []Point) []Point {
+	low := 0
+	for i, p := range polygon {
+		if p.Y < polygon[low].Y || (p.Y == polygon[low].Y && p.X < polygon[low].X) {
+			low = i
+		}
+	}
+	return append(append([]Point{}, polygon[low:]...), polygon[:low]...)
+}
+
+// sub returns the vector from b to a.
+func sub(a, b Point) Point {
+	return Point{X: a.X - b.X, Y: a.Y - b.Y}
+}
+
+// MinkowskiSum returns the Minkowski sum of two convex polygons, starting
+// from its lowest vertex. Points and segments are accepted as degenerate
+// polygons.
+func MinkowskiSum(a, b []Point) []Point {
+	if len(a) == 0 || len(b) == 0 {
+		return nil
+	}
+	if len(a) < 3 || len(b) < 3 {
+		// One side is tiny: the hull of all the sums is cheap.
+		sums := make([]Point, 0, len(a)*len(b))
+		for _, p := range a {
+			for _, q := range b {
+				sums = append(sums, Point{X: p.X + q.X, Y: p.Y + q.Y})
+			}
+		}
+		return fromLowest(MonotoneChain(sums))
+	}
+	a, b = fromLowest(counterClockwise(a)), fromLowest(counterClockwise(b))
+	n, m := len(a), len(b)
+	// Repeat the first two vertices so that edges can be read past the end.
+	a = append(a, a[0], a[1])
+	b = append(b, b[0], b[1])
+	var origin Point
+	sum := make([]Point, 0, n+m)
+	for i, j := 0, 0; i < n || j < m; {
+		sum = append(sum, Point{X: a[i].X + b[j].X, Y: a[i].Y + b[j].Y})
+		c := Cross(origin, sub(a[i+1], a[i]), sub(b[j+1], b[j]))
+		if c >= 0 && i < n {
+			i++
+		}
+		if c <= 0 && j < m {
+			j++
+		}
+	}
+	return removeCollinear(sum)
+}
+
+// removeCollinear returns polygon without repeated vertices and without the
+// vertices lying in the middle of an edge.
+func removeCollinear(polygon []Point) []Point {
+	var clean []Point
+	for _, p := range polygon {
+		if len(clean) > 0 && clean[len(clean)-1] == p {
+			continue
+		}
+		for len(clean) > 1 && Cross(clean[len(clean)-2], clean[len(clean)-1], p) == 0 {
+			clean = clean[:len(clean)-1]
+		}
+		clean = append(clean, p)
+	}
+	// Close the polygon, removing the vertices made useless at the seam.
+	for len(clean) > 1 && clean[len(clean)-1] == clean[0] {
+		clean = clean[:len(clean)-1]
+	}
+	for changed := true; changed && len(clean) > 2; {
+		changed = false
+		n := len(clean)
+		if Cross(clean[n-2], clean[n-1], clean[0]) == 0 {
+			clean = clean[:n-1]
+			changed = true
+		} else if Cross(clean[n-1], clean[0], clean[1]) == 0 {
+			clean = clean[1:]
+			changed = true
+		}
+	}
+	return clean
+}
+
+// sign returns the sign of x as -1, 0 or 1.
+func sign(x float64) int {
+	switch {
+	case x > 0:
+		return 1
+	case x < 0:
+		return -1
+	}
+	return 0
+}
+
+// The polygon found inside the other one while sweeping.
+const (
+	neitherInside = iota
+	firstInside
+	secondInside
+)
+
+// ConvexIntersection returns the intersection of two convex polygons, or nil
+// when it has no area: the polygons are apart or only touch.
+func ConvexIntersection(a, b []Point) []Point {
+	if len(a) < 3 || len(b) < 3 {
+		return nil
+	}
+	p, q := counterClockwise(a), counterClockwise(b)
+	n, m := len(p), len(q)
+	var result []Point
+	var origin Point
+	inside := neitherInside
+	crossed := false
+	i, j := 0, 0   // heads of the current edges of p and q
+	ai, bj := 0, 0 // number of advances on each polygon
+	advance := func(k, count *int, size int, keep bool, v Point) {
+		if keep {
+			result = append(result, v)
+		}
+		*count++
+		*k = (*k + 1) % size
+	}
+	for (ai < n || bj < m) && ai < 2*n && bj < 2*m {
+		p1, q1 := p[(i+n-1)%n], q[(j+m-1)%m]
+		edgeP, edgeQ := sub(p[i], p1), sub(q[j], q1)
+		cross := sign(Cross(origin, edgeP, edgeQ))
+		pHalf := Orientation(q1, q[j], p[i]) // side of the head of edgeP
+		qHalf := Orientation(p1, p[i], q[j]) // side of the head of edgeQ
+
+		lp, lq := &Line{P1: p1, P2: p[i]}, &Line{P1: q1, P2: q[j]}
+		if cross == 0 && Orientation(p1, p[i], q1) == 0 {
+			// Collinear edges: overlapping in opposite directions, the
+			// polygons lie on both sides of the common line.
+			if SegmentsIntersect(lp, lq) && edgeP.X*edgeQ.X+edgeP.Y*edgeQ.Y < 0 {
+				return nil
+			}
+		} else if x, ok := Intersection(lp, lq); ok {
+			if !crossed {
+				// Walk both boundaries once more from the first crossing.
+				crossed = true
+				ai, bj = 0, 0
+			}
+			result = append(result, x)
+			switch {
+			case pHalf > 0:
+				inside = firstInside
+			case qHalf > 0:
+				inside = secondInside
+			}
+		}
+
+		switch {
+		case cross == 0 && pHalf < 0 && qHalf < 0:
+			// Parallel edges facing away from each other.
+			return nil
+		case cross == 0 && pHalf == 0 && qHalf == 0:
+			// Collinear edges: advance without keeping a vertex.
+			if inside == firstInside {
+				advance(&j, &bj, m, inside == secondInside, q[j])
+			} else {
+				advance(&i, &ai, n, inside == firstInside, p[i])
+			}
+		case cross >= 0:
+			if qHalf > 0 {
+				advance(&i, &ai, n, inside == firstInside, p[i])
+			} else {
+				advance(&j, &bj, m, inside == secondInside, q[j])
+			}
+		default:
+			if pHalf > 0 {
+				advance(&j, &bj, m, inside == secondInside, q[j])
+			} else {
+				advance(&i, &ai, n, inside == firstInside, p[i])
+			}
+		}
+	}
+
+	if !crossed || inside == neitherInside {
+		// The boundaries do not cross: one polygon holds the other or they
+		// are apart.
+		if PointInPolygon(interiorPoint(p), q) == Outside && PointInPolygon(interiorPoint(q), p) == Outside {
+			return nil
+		}
+		if PolygonArea(q) < PolygonArea(p) {
+			result = q
+		} else {
+			result = p
+		}
+	}
+	result = removeCollinear(result)
+	if len(result) < 3 {
+		return nil
+	}
+	return result
+}
+
+// interiorPoint returns a point inside a convex polygon with a positive
+// area: the centroid of three of its vertices that are not collinear.
+func interiorPoint(polygon []Point) Point {
+	a, b := polygon[0], polygon[1]
+	for _, c := range polygon[2:] {
+		if Cross(a, b, c) != 0 {
+			return Point{X: (a.X + b.X + c.X) / 3, Y: (a.Y + b.Y + c.Y) / 3}
+		}
+	}
+	return a
+}
+
+// IntersectionArea returns the area shared by two convex polygons.
+func IntersectionArea(a, b []Point) float64 {
+	return PolygonArea(ConvexIntersection(a, b))
+}
@@ -0,0 +1,170 @@
+package geometry
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func square(x, y, side float64) []Point {
+	return []Point{{x, y}, {x + side, y}, {x + side, y + side}, {x, y + side}}
+}
+
+func TestMinkowskiSum(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b []Point
+		want []Point
+	}{
+		{"squares", square(0, 0, 1), square(2, 3, 2), []Point{{2, 3}, {5, 3}, {5, 6}, {2, 6}}},
+		{"triangle and square", []Point{{0, 0}, {2, 0}, {0, 2}}, square(0, 0, 1), []Point{{0, 0}, {3, 0}, {3, 1}, {1, 3}, {0, 3}}},
+		{"clockwise", []Point{{0, 2}, {2, 0}, {0, 0}}, []Point{{0, 1}, {1, 1}, {1, 0}, {0, 0}}, []Point{{0, 0}, {3, 0}, {3, 1}, {1, 3}, {0, 3}}},
+		{"point", square(0, 0, 1), []Point{{5, 5}}, square(5, 5, 1)},
+		{"segment", []Point{{0, 0}, {2, 0}}, []Point{{0, 0}, {1, 1}, {0, 1}}, []Point{{0, 0}, {2, 0}, {3, 1}, {0, 1}}},
+	}
+	for _, test := range tests {
+		got := MinkowskiSum(test.a, test.b)
+		if !samePolygon(got, test.want) {
+			t.Errorf("%s: got %v, want %v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestMinkowskiSumRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		a, b := randomConvex(rnd, 3+rnd.Intn(10)), randomConvex(rnd, 3+rnd.Intn(10))
+		var sums []Point
+		for _, p := range a {
+			for _, q := range b {
+				sums = append(sums, Point{p.X + q.X, p.Y + q.Y})
+			}
+		}
+		if got, want := MinkowskiSum(a, b), fromLowest(MonotoneChain(sums)); !samePolygon(got, want) {
+			t.Fatalf("sum of %v and %v: got %v, want %v", a, b, got, want)
+		}
+	}
+}
+
+func TestConvexIntersection(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b []Point
+		area float64
+	}{
+		{"overlapping squares", square(0, 0, 2), square(1, 1, 2), 1},
+		{"apart", square(0, 0, 1), square(3, 0, 1), 0},
+		{"sharing an edge", square(0, 0, 1), square(1, 0, 1), 0},
+		{"sharing a vertex", square(0, 0, 1), square(1, 1, 1), 0},
+		{"nested", square(0, 0, 4), square(1, 1, 1), 1},
+		{"nested touching", square(0, 0, 4), square(0, 0, 2), 4},
+		{"equal", square(0, 0, 3), square(0, 0, 3), 9},
+		{"star of David", []Point{{0, 0}, {6, 0}, {3, 6}}, []Point{{0, 4}, {3, -2}, {6, 4}}, 12},
+		{"cross", []Point{{0, 1}, {3, 1}, {3, 2}, {0, 2}}, []Point{{1, 0}, {2, 0}, {2, 3}, {1, 3}}, 1},
+		{"clockwise", []Point{{0, 2}, {2, 2}, {2, 0}, {0, 0}}, square(1, -1, 2), 1},
+	}
+	for _, test := range tests {
+		got := ConvexIntersection(test.a, test.b)
+		if area := PolygonArea(got); math.Abs(area-test.area) > 1e-9 {
+			t.Errorf("%s: area %v of %v, want %v", test.name, area, got, test.area)
+		}
+		if test.area == 0 && got != nil {
+			t.Errorf("%s: got %v, want nil", test.name, got)
+		}
+		if got != nil && SignedArea(got) < 0 {
+			t.Errorf("%s: %v is clockwise", test.name, got)
+		}
+		if area := IntersectionArea(test.b, test.a); math.Abs(area-test.area) > 1e-9 {
+			t.Errorf("%s: swapped area %v, want %v", test.name, area, test.area)
+		}
+	}
+}
+
+func TestConvexIntersectionRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(2))
+	for i := 0; i < 2000; i++ {
+		a, b := randomConvex(rnd, 3+rnd.Intn(8)), randomConvex(rnd, 3+rnd.Intn(8))
+		got := ConvexIntersection(a, b)
+		want := clip(a, b)
+		if math.Abs(PolygonArea(got)-PolygonArea(want)) > 1e-6 {
+			t.Fatalf("%v and %v: got %v (area %v), want %v (area %v)", a, b, got, PolygonArea(got), want, PolygonArea(want))
+		}
+		for _, p := range got {
+			if !nearPolygon(p, a) || !nearPolygon(p, b) {
+				t.Fatalf("%v and %v: vertex %v lies outside", a, b, p)
+			}
+		}
+	}
+}
+
+// randomConvex returns the convex hull of random points on a small grid,
+// where shared vertices and collinear edges are frequent.
+func randomConvex(rnd *rand.Rand, n int) []Point {
+	for {
+		points := make([]Point, n)
+		for i := range points {
+			points[i] = Point{float64(rnd.Intn(8)), float64(rnd.Intn(8))}
+		}
+		if hull := MonotoneChain(points); len(hull) >= 3 {
+			return hull
+		}
+	}
+}
+
+// clip is the Sutherland-Hodgman clipping of subject by the convex polygon
+// clipper, both counter-clockwise, in O(n * m).
+func clip(subject, clipper []Point) []Point {
+	out := subject
+	for i := range clipper {
+		a, b := clipper[i], clipper[(i+1)%len(clipper)]
+		in := out
+		out = nil
+		for j := range in {
+			p, q := in[j], in[(j+1)%len(in)]
+			cp, cq := Cross(a, b, p), Cross(a, b, q)
+			if cp >= 0 {
+				out = append(out, p)
+			}
+			if (cp > 0 && cq < 0) || (cp < 0 && cq > 0) {
+				t := cp / (cp - cq)
+				out = append(out, Point{p.X + t*(q.X-p.X), p.Y + t*(q.Y-p.Y)})
+			}
+		}
+		if len(out) == 0 {
+			return nil
+		}
+	}
+	return out
+}
+
+// nearPolygon reports whether p lies in the convex counter-clockwise
+// polygon, up to rounding.
+func nearPolygon(p Point, polygon []Point) bool {
+	for i := range polygon {
+		if Cross(polygon[i], polygon[(i+1)%len(polygon)], p) < -1e-9 {
+			return false
+		}
+	}
+	return true
+}
+
+// samePolygon reports whether a and b list the same vertices in the same
+// cyclic order.
+func samePolygon(a, b []Point) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for shift := range a {
+		same := true
+		for i := range a {
+			if a[(i+shift)%len(a)] != b[i] {
+				same = false
+				break
+			}
+		}
+		if same {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,261 @@
+// dynamichull.go
+// description: Convex hull under insertion of points
+// details:
+// GrahamScan and MonotoneChain compute the hull of a fixed set of points.
+// When points arrive one at a time, DynamicHull keeps the hull up to date in
+// O(log n) amortized time per point, as its upper and lower chains, the
+// lower one stored as the upper chain of the points mirrored across the x
+// axis. A chain holds its vertices in a treap ordered by x, linked to their
+// neighbours. A point is under the upper chain when it is under the edge
+// spanning its x, found by one search of the treap. A new point above it is
+// inserted, and its neighbours removed on each side for as long as they no
+// longer make a clockwise turn with the point and the vertex beyond: every
+// point is removed at most once, which pays for the removals. The vertex
+// extreme in a direction, where the tangent of that direction touches the
+// hull, is where the edges of the chain start pointing away from it, found
+// by one more search.
+// time complexity: O(log n) amortized per insertion, O(log n) per query
+// space complexity: O(h) for h vertices on the hull
+// reference: https://en.wikipedia.org/wiki/Dynamic_convex_hull
+// reference: Preparata, An Optimal Real-Time Algorithm for Planar Convex Hulls, 1979
+// see dynamichull_test.go
+
+package geometry
+
+import (
+	"github.com/TheAlgorithms/Go/structure/treap"
+)
+
+// chain is an upper hull: its vertices make clockwise turns from left to
+// right, one vertex at most per x.
+type chain struct {
+	xs   *treap.Treap[float64]
+	y    map[float64]float64
+	prev map[float64]float64
+	next map[float64]float64
+}
+
+func newChain() *chain {
+	return &chain{
+		xs:   treap.New[float64](1),
+		y:    map[float64]float64{},
+		prev: map[float64]float64{},
+		next: map[float64]float64{},
+	}
+}
+
+func (c *chain) point(x float64) Point {
+	return Point{X: x, Y: c.y[x]}
+}
+
+// ceiling returns the smallest x of a vertex not below x.
+func (c *chain) ceiling(x float64) (float64, bool) {
+	return c.xs.Search(func(k float64) bool { return k >= x })
+}
+
+// under reports whether p is on or under the chain, between its ends.
+func (c *chain) under(p Point) bool {
+	right, ok := c.ceiling(p.X)
+	if !ok {
+		return false
+	}
+	if right == p.X {
+		return p.Y <= c.y[right]
+	}
+	left, ok := c.prev[right]
+	return ok && Cross(c.point(left), c.point(right), p) <= 0
+}
+
+// remove removes the vertex at x, linking its neighbours.
+func (c *chain) remove(x float64) {
+	left, hasLeft := c.prev[x]
+	right, hasRight := c.next[x]
+	if hasLeft {
+		if hasRight {
+			c.next[left] = right
+		} else {
+			delete(c.next, left)
+		}
+	}
+	if hasRight {
+		if hasLeft {
+			c.prev[right] = left
+		} else {
+			delete(c.prev, right)
+		}
+	}
+	delete(c.y, x)
+	delete(c.prev, x)
+	delete(c.next, x)
+	c.xs.Delete(x)
+}
+
+// add inserts p into the chain and reports whether it changed.
+func (c *chain) add(p Point) bool {
+	if c.under(p) {
+		return false
+	}
+	if _, ok := c.y[p.X]; ok {
+		c.remove(p.X)
+	}
+	// link p between its neighbours
+	right, hasRight := c.ceiling(p.X)
+	var left float64
+	hasLeft := false
+	if hasRight {
+		left, hasLeft = c.prev[right]
+	} else if n := c.xs.Len(); n > 0 {
+		left, hasLeft = c.xs.Kth(n)
+	}
+	c.xs.Insert(p.X)
+	c.y[p.X] = p.Y
+	if hasLeft {
+		c.prev[p.X], c.next[left] = left, p.X
+	}
+	if hasRight {
+		c.next[p.X], c.prev[right] = right, p.X
+	}
+	// remove the vertices no longer on the hull
+	for {
+		r, ok := c.next[p.X]
+		if !ok {
+			break
+		}
+		rr, ok := c.next[r]
+		if !ok || Cross(p, c.point(r), c.point(rr)) < 0 {
+			break
+		}
+		c.remove(r)
+	}
+	for {
+		l, ok := c.prev[p.X]
+		if !ok {
+			break
+		}
+		ll, ok := c.prev[l]
+		if !ok || Cross(c.point(ll), c.point(l), p) < 0 {
+			break
+		}
+		c.remove(l)
+	}
+	return true
+}
+
+// extreme returns the vertex maximizing the dot product with d, which must
+// point upwards.
+func (c *chain) extreme(d Point) Point {
+	// the edges point towards d, then away from it
+	x, ok := c.xs.Search(func(x float64) bool {
+		next, ok := c.next[x]
+		return !ok || dot(d, sub(c.point(next), c.point(x))) <= 0
+	})
+	if !ok {
+		x, _ = c.xs.Kth(c.xs.Len())
+	}
+	return c.point(x)
+}
+
+// vertices returns the vertices of the chain from left to right.
+func (c *chain) vertices() []Point {
+	vertices := make([]Point, 0, c.xs.Len())
+	x, ok := c.xs.Kth(1)
+	for ok {
+		vertices = append(vertices, c.point(x))
+		x, ok = c.next[x]
+	}
+	return vertices
+}
+
+// mirror returns p reflected across the x axis.
+func mirror(p Point) Point {
+	return Point{X: p.X, Y: -p.Y}
+}
+
+// DynamicHull is the convex hull of a set of points growing one point at a
+// time.
+type DynamicHull struct {
+	upper *chain
+	lower *chain // upper chain of the mirrored points
+}
+
+// NewDynamicHull creates the hull of no point.
+func NewDynamicHull() *DynamicHull {
+	return &DynamicHull{upper: newChain(), lower: newChain()}
+}
+
+// Add adds p to the set and reports whether the hull changed, that is
+// whether p was outside it.
+func (h *DynamicHull) Add(p Point) bool {
+	upper := h.upper.add(p)
+	lower := h.lower.add(mirror(p))
+	return upper || lower
+}
+
+// Contains reports whether p is inside the hull or on its boundary.
+func (h *DynamicHull) Contains(p Point) bool {
+	return h.upper.under(p) && h.lower.under(mirror(p))
+}
+
+// Extreme returns the vertex of the hull maximizing the dot product with d,
+// where the tangent line with normal d touches the hull, with false if the
+// hull is empty. For d = 0 it returns any vertex.
+func (h *DynamicHull) Extreme(d Point) (Point, bool) {
+	if h.upper.xs.Empty() {
+		return Point{}, false
+	}
+	switch {
+	case d.Y > 0:
+		return h.upper.extreme(d), true
+	case d.Y < 0:
+		return mirror(h.lower.extreme(mirror(d))), true
+	case d.X < 0:
+		x, _ := h.upper.xs.Kth(1)
+		return h.upper.point(x), true
+	default:
+		x, _ := h.upper.xs.Kth(h.upper.xs.Len())
+		return h.upper.point(x), true
+	}
+}
+
+// Hull returns the vertices of the hull in counter-clockwise order, starting
+// from the leftmost point, the lowest one on ties, like MonotoneChain.
+func (h *DynamicHull) Hull() []Point {
+	if h.upper.xs.Empty() {
+		return nil
+	}
+	lower := h.lower.vertices()
+	for i := range lower {
+		lower[i] = mirror(lower[i])
+	}
+	upper := h.upper.vertices()
+	hull := lower
+	// the upper chain from right to left, without the vertices shared with
+	// the lower one
+	for i := len(upper) - 1; i >= 0; i-- {
+		p := upper[i]
+		if p == hull[len(hull)-1] || i == 0 && p == hull[0] {
+			continue
+		}
+		hull = append(hull, p)
+	}
+	return hull
+}
+
+// Len returns the number of vertices of the hull.
+func (h *DynamicHull) Len() int {
+	n := h.upper.xs.Len() + h.lower.xs.Len()
+	if n == 0 {
+		return 0
+	}
+	// the chains share their ends when a single point has the smallest or
+	// the largest x
+	left, _ := h.upper.xs.Kth(1)
+	right, _ := h.upper.xs.Kth(h.upper.xs.Len())
+	if h.upper.y[left] == -h.lower.y[left] {
+		n--
+	}
+	if right != left && h.upper.y[right] == -h.lower.y[right] {
+		n--
+	}
+	return n
+}
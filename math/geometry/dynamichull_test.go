@@ -0,0 +1,107 @@
+package geometry
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// insideHull reports whether p is in the convex polygon hull, given counter-
+// clockwise, or on its boundary. A hull of one or two points is a point or a
+// segment.
+func insideHull(p Point, hull []Point) bool {
+	switch len(hull) {
+	case 0:
+		return false
+	case 1:
+		return p == hull[0]
+	case 2:
+		return Cross(hull[0], hull[1], p) == 0 && inBox(p, &Line{hull[0], hull[1]})
+	}
+	for i := range hull {
+		if Cross(hull[i], hull[(i+1)%len(hull)], p) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func TestDynamicHull(t *testing.T) {
+	h := NewDynamicHull()
+	if h.Len() != 0 || h.Hull() != nil || h.Contains(Point{0, 0}) {
+		t.Errorf("empty hull: Len = %d, Hull = %v", h.Len(), h.Hull())
+	}
+	if _, ok := h.Extreme(Point{1, 0}); ok {
+		t.Errorf("Extreme of an empty hull reported a vertex")
+	}
+	steps := []struct {
+		p       Point
+		changed bool
+		hull    []Point
+	}{
+		{Point{1, 1}, true, []Point{{1, 1}}},
+		{Point{1, 1}, false, []Point{{1, 1}}},
+		{Point{3, 1}, true, []Point{{1, 1}, {3, 1}}},
+		{Point{2, 1}, false, []Point{{1, 1}, {3, 1}}},
+		{Point{2, 3}, true, []Point{{1, 1}, {3, 1}, {2, 3}}},
+		{Point{2, 2}, false, []Point{{1, 1}, {3, 1}, {2, 3}}},
+		{Point{2, -1}, true, []Point{{1, 1}, {2, -1}, {3, 1}, {2, 3}}},
+		{Point{0, 1}, true, []Point{{0, 1}, {2, -1}, {3, 1}, {2, 3}}},
+		{Point{0, 0}, true, []Point{{0, 0}, {2, -1}, {3, 1}, {2, 3}, {0, 1}}},
+	}
+	for _, step := range steps {
+		if changed := h.Add(step.p); changed != step.changed {
+			t.Errorf("Add(%v) = %t, want %t", step.p, changed, step.changed)
+		}
+		if got := h.Hull(); !reflect.DeepEqual(got, step.hull) {
+			t.Errorf("after Add(%v), Hull = %v, want %v", step.p, got, step.hull)
+		}
+		if h.Len() != len(step.hull) {
+			t.Errorf("after Add(%v), Len = %d, want %d", step.p, h.Len(), len(step.hull))
+		}
+	}
+	if p, _ := h.Extreme(Point{0, 1}); p != (Point{2, 3}) {
+		t.Errorf("Extreme upwards = %v", p)
+	}
+	if p, _ := h.Extreme(Point{1, -1}); p != (Point{2, -1}) {
+		t.Errorf("Extreme down and right = %v", p)
+	}
+	if p, _ := h.Extreme(Point{-1, 0}); p.X != 0 {
+		t.Errorf("Extreme leftwards = %v", p)
+	}
+}
+
+func TestDynamicHullRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for round := 0; round < 100; round++ {
+		h := NewDynamicHull()
+		var points []Point
+		for i := 0; i < 40; i++ {
+			// a small grid gives many collinear and repeated points
+			p := Point{float64(rnd.Intn(12)), float64(rnd.Intn(12))}
+			before := MonotoneChain(points)
+			points = append(points, p)
+			if changed := h.Add(p); changed == insideHull(p, before) {
+				t.Fatalf("Add(%v) = %t to the hull %v", p, changed, before)
+			}
+			want := MonotoneChain(points)
+			if got := h.Hull(); !reflect.DeepEqual(got, want) {
+				t.Fatalf("Hull of %v = %v, want %v", points, got, want)
+			}
+			if h.Len() != len(want) {
+				t.Fatalf("Len = %d, want %d for %v", h.Len(), len(want), want)
+			}
+			q := Point{float64(rnd.Intn(14) - 1), float64(rnd.Intn(14) - 1)}
+			if h.Contains(q) != insideHull(q, want) {
+				t.Fatalf("Contains(%v) = %t in %v", q, h.Contains(q), want)
+			}
+			d := Point{float64(rnd.Intn(7) - 3), float64(rnd.Intn(7) - 3)}
+			e, _ := h.Extreme(d)
+			for _, p := range points {
+				if dot(d, p) > dot(d, e) {
+					t.Fatalf("Extreme(%v) = %v, but %v is further in %v", d, e, p, want)
+				}
+			}
+		}
+	}
+}
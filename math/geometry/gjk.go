@@ -0,0 +1,237 @@
+// gjk.go
+// description: Collision detection of convex shapes with GJK and EPA
+// details:
+// Two convex shapes A and B intersect exactly when their Minkowski difference
+// A - B = {a - b} contains the origin. The Gilbert-Johnson-Keerthi algorithm
+// decides this without building the difference: a convex shape is known
+// through its support function, the point of the shape farthest in a given
+// direction, and the support of A - B in direction d is the support of A in
+// d minus the support of B in -d. GJK keeps a simplex of up to three support
+// points and repeatedly looks for a new one in the direction of the origin.
+// If the new point does not pass the origin, the shapes are apart; if the
+// triangle encloses the origin, they intersect.
+// When they intersect, the Expanding Polytope Algorithm grows the final
+// triangle into a polygon inside A - B, adding the support point beyond the
+// edge closest to the origin until that edge lies on the boundary. Its
+// distance to the origin is the penetration depth: the shortest translation
+// separating the shapes.
+// Any convex shape with a support function can be tested, polygons and
+// circles being provided. Touching shapes count as intersecting.
+// time complexity: O(iterations * cost of Support), O(n) per call for a polygon
+// space complexity: O(1) for GJK, O(iterations) for EPA
+// reference: https://en.wikipedia.org/wiki/Gilbert%E2%80%93Johnson%E2%80%93Keerthi_distance_algorithm
+// reference: https://dyn4j.org/2010/05/epa-expanding-polytope-algorithm/
+// see gjk_test.go
+
+package geometry
+
+import "math"
+
+// Shape is a convex shape in the plane, known through its support function.
+type Shape interface {
+	// Support returns a point of the shape farthest in direction d, that is
+	// one maximizing its dot product with d.
+	Support(d Point) Point
+}
+
+// ConvexPolygon is a convex polygon given by its vertices, in any order.
+type ConvexPolygon []Point
+
+// Support returns the vertex of the polygon farthest in direction d.
+func (p ConvexPolygon) Support(d Point) Point {
+	best := p[0]
+	for _, v := range p[1:] {
+		if dot(v, d) > dot(best, d) {
+			best = v
+		}
+	}
+	return best
+}
+
+// Circle is a disk with its center and radius.
+type Circle struct {
+	Center Point
+	Radius float64
+}
+
+// Support returns the point of the circle farthest in direction d.
+func (c Circle) Support(d Point) Point {
+	length := math.Hypot(d.X, d.Y)
+	if length == 0 {
+		return c.Center
+	}
+	return Point{X: c.Center.X + c.Radius*d.X/length, Y: c.Center.Y + c.Radius*d.Y/length}
+}
+
+const (
+	// gjkIterations bounds the number of steps of GJK and EPA, which only
+	// matters for curved shapes and rounding errors.
+	gjkIterations = 100
+	// epaTolerance is how close to the boundary the closest edge of EPA must
+	// be to stop.
+	epaTolerance = 1e-9
+)
+
+func dot(a, b Point) float64 {
+	return a.X*b.X + a.Y*b.Y
+}
+
+func neg(a Point) Point {
+	return Point{X: -a.X, Y: -a.Y}
+}
+
+// support returns the support point of a - b in direction d.
+func support(a, b Shape, d Point) Point {
+	return sub(a.Support(d), b.Support(neg(d)))
+}
+
+// towardOrigin returns the normal to the segment from a to b pointing to the
+// origin's side, or the zero vector when the origin lies on its line.
+func towardOrigin(a, b Point) Point {
+	ab := sub(b, a)
+	normal := Point{X: -ab.Y, Y: ab.X}
+	if dot(normal, a) > 0 {
+		normal = neg(normal)
+	}
+	if dot(normal, a) == 0 {
+		return Point{}
+	}
+	return normal
+}
+
+// gjk reports whether the shapes intersect, and when they do, returns a
+// simplex of the difference a - b containing the origin.
+func gjk(a, b Shape) (bool, []Point) {
+	d := Point{X: 1}
+	simplex := []Point{support(a, b, d)}
+	d = neg(simplex[0])
+	for i := 0; i < gjkIterations; i++ {
+		if d == (Point{}) {
+			// The origin lies on the simplex.
+			return true, simplex
+		}
+		p := support(a, b, d)
+		if dot(p, d) < 0 {
+			// No point of a - b goes past the origin.
+			return false, nil
+		}
+		simplex = append(simplex, p)
+		switch len(simplex) {
+		case 2:
+			// Does the origin lie beyond the new point, or by the segment?
+			if dot(sub(simplex[0], p), neg(p)) <= 0 {
+				simplex = simplex[1:]
+				d = neg(p)
+			} else {
+				d = towardOrigin(simplex[0], p)
+			}
+		case 3:
+			// The origin is not beyond the old edge simplex[0]-simplex[1]:
+			// check the two edges through the new point.
+			x, y := simplex[0], simplex[1]
+			if n := outward(p, x, y); dot(n, neg(p)) > 0 {
+				simplex = []Point{x, p}
+				d = towardOrigin(x, p)
+			} else if n := outward(p, y, x); dot(n, neg(p)) > 0 {
+				simplex = []Point{y, p}
+				d = towardOrigin(y, p)
+			} else {
+				return true, simplex
+			}
+		}
+	}
+	// Rounding keeps GJK from settling: the shapes are in contact.
+	return true, simplex
+}
+
+// outward returns the normal to the edge from a to b pointing away from c.
+func outward(a, b, c Point) Point {
+	ab := sub(b, a)
+	normal := Point{X: -ab.Y, Y: ab.X}
+	if dot(normal, sub(c, a)) > 0 {
+		normal = neg(normal)
+	}
+	return normal
+}
+
+// ShapesIntersect reports whether the convex shapes a and b share a point.
+func ShapesIntersect(a, b Shape) bool {
+	ok, _ := gjk(a, b)
+	return ok
+}
+
+// Penetration returns the penetration depth of the convex shapes a and b
+// and the unit normal along which it is measured: translating b by depth
+// along normal, or a by depth against it, leaves the shapes touching. It
+// reports false when the shapes do not intersect. Touching shapes have a
+// depth of 0.
+func Penetration(a, b Shape) (normal Point, depth float64, ok bool) {
+	ok, simplex := gjk(a, b)
+	if !ok {
+		return Point{}, 0, false
+	}
+	polytope := initialPolytope(a, b, simplex)
+	if polytope == nil {
+		// a - b is flat: the shapes only touch.
+		return Point{}, 0, true
+	}
+	for i := 0; i < gjkIterations; i++ {
+		edge, normal, distance := closestEdge(polytope)
+		p := support(a, b, normal)
+		if dot(p, normal)-distance < epaTolerance {
+			return normal, distance, true
+		}
+		// Insert p between the ends of the edge.
+		polytope = append(polytope, Point{})
+		copy(polytope[edge+2:], polytope[edge+1:])
+		polytope[edge+1] = p
+	}
+	_, normal, depth = closestEdge(polytope)
+	return normal, depth, true
+}
+
+// initialPolytope turns the simplex found by GJK into a counter-clockwise
+// triangle of a - b containing the origin, or returns nil when a - b has no
+// area.
+func initialPolytope(a, b Shape, simplex []Point) []Point {
+	for len(simplex) < 3 {
+		var d Point
+		if len(simplex) == 1 {
+			d = Point{X: 1}
+		} else {
+			edge := sub(simplex[1], simplex[0])
+			d = Point{X: -edge.Y, Y: edge.X}
+		}
+		p := support(a, b, d)
+		if len(simplex) == 2 && Cross(simplex[0], simplex[1], p) == 0 {
+			p = support(a, b, neg(d))
+		}
+		if p == simplex[0] || (len(simplex) == 2 && Cross(simplex[0], simplex[1], p) == 0) {
+			return nil
+		}
+		simplex = append(simplex, p)
+	}
+	if Cross(simplex[0], simplex[1], simplex[2]) < 0 {
+		simplex[1], simplex[2] = simplex[2], simplex[1]
+	}
+	return simplex
+}
+
+// closestEdge returns the index of the edge of the counter-clockwise
+// polytope closest to the origin, its outward unit normal and its distance.
+func closestEdge(polytope []Point) (int, Point, float64) {
+	best, bestNormal, bestDistance := 0, Point{}, math.Inf(1)
+	for i, p := range polytope {
+		q := polytope[(i+1)%len(polytope)]
+		edge := sub(q, p)
+		length := math.Hypot(edge.X, edge.Y)
+		if length == 0 {
+			continue
+		}
+		normal := Point{X: edge.Y / length, Y: -edge.X / length}
+		if distance := dot(normal, p); distance < bestDistance {
+			best, bestNormal, bestDistance = i, normal, distance
+		}
+	}
+	return best, bestNormal, bestDistance
+}
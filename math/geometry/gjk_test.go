@@ -0,0 +1,115 @@
+package geometry
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// translated is a shape moved by offset.
+type translated struct {
+	Shape
+	offset Point
+}
+
+func (t translated) Support(d Point) Point {
+	p := t.Shape.Support(d)
+	return Point{X: p.X + t.offset.X, Y: p.Y + t.offset.Y}
+}
+
+func TestShapesIntersect(t *testing.T) {
+	tests := []struct {
+		name      string
+		a, b      Shape
+		intersect bool
+	}{
+		{"overlapping squares", ConvexPolygon(square(0, 0, 2)), ConvexPolygon(square(1, 1, 2)), true},
+		{"apart squares", ConvexPolygon(square(0, 0, 1)), ConvexPolygon(square(2, 0, 1)), false},
+		{"touching squares", ConvexPolygon(square(0, 0, 1)), ConvexPolygon(square(1, 0.5, 1)), true},
+		{"nested", ConvexPolygon(square(0, 0, 10)), ConvexPolygon(square(4, 4, 1)), true},
+		{"diagonal gap", ConvexPolygon([]Point{{0, 0}, {2, 0}, {0, 2}}), ConvexPolygon(square(1.1, 1.1, 1)), false},
+		{"circles", Circle{Point{0, 0}, 1}, Circle{Point{1.5, 0}, 1}, true},
+		{"apart circles", Circle{Point{0, 0}, 1}, Circle{Point{2, 2}, 1}, false},
+		{"circle and square corner", Circle{Point{0, 0}, 1}, ConvexPolygon(square(0.8, 0.8, 1)), false},
+		{"circle and square side", Circle{Point{0, 0}, 1}, ConvexPolygon(square(0.9, -0.5, 1)), true},
+		{"segment through triangle", ConvexPolygon([]Point{{-5, 1}, {5, 1}}), ConvexPolygon([]Point{{0, 0}, {2, 0}, {1, 3}}), true},
+		{"point inside", ConvexPolygon([]Point{{1, 1}}), ConvexPolygon(square(0, 0, 2)), true},
+	}
+	for _, test := range tests {
+		if got := ShapesIntersect(test.a, test.b); got != test.intersect {
+			t.Errorf("%s: got %v, want %v", test.name, got, test.intersect)
+		}
+		if got := ShapesIntersect(test.b, test.a); got != test.intersect {
+			t.Errorf("%s swapped: got %v, want %v", test.name, got, test.intersect)
+		}
+	}
+}
+
+func TestPenetration(t *testing.T) {
+	tests := []struct {
+		name   string
+		a, b   Shape
+		normal Point
+		depth  float64
+	}{
+		{"squares", ConvexPolygon(square(0, 0, 2)), ConvexPolygon(square(1.5, 0.5, 2)), Point{1, 0}, 0.5},
+		{"squares from above", ConvexPolygon(square(0, 0, 4)), ConvexPolygon(square(1, 3.75, 2)), Point{0, 1}, 0.25},
+		{"circles", Circle{Point{0, 0}, 1}, Circle{Point{0, -1.5}, 1}, Point{0, -1}, 0.5},
+	}
+	for _, test := range tests {
+		normal, depth, ok := Penetration(test.a, test.b)
+		if !ok {
+			t.Errorf("%s: no penetration found", test.name)
+			continue
+		}
+		if math.Abs(depth-test.depth) > 1e-6 || math.Abs(normal.X-test.normal.X) > 1e-3 || math.Abs(normal.Y-test.normal.Y) > 1e-3 {
+			t.Errorf("%s: normal %v depth %v, want %v and %v", test.name, normal, depth, test.normal, test.depth)
+		}
+	}
+	if _, _, ok := Penetration(Circle{Point{0, 0}, 1}, Circle{Point{3, 0}, 1}); ok {
+		t.Errorf("apart circles should not penetrate")
+	}
+}
+
+func TestGJKRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(3))
+	for i := 0; i < 2000; i++ {
+		a, b := randomPolygon(rnd), randomPolygon(rnd)
+		area := IntersectionArea(a, b)
+		got := ShapesIntersect(ConvexPolygon(a), ConvexPolygon(b))
+		if got != (area > 0) {
+			t.Fatalf("%v and %v: intersect %v, common area %v", a, b, got, area)
+		}
+		if !got {
+			continue
+		}
+		normal, depth, _ := Penetration(ConvexPolygon(a), ConvexPolygon(b))
+		// Moving b a little more than depth along normal separates them,
+		// a little less does not.
+		apart := translated{ConvexPolygon(b), Point{normal.X * (depth + 1e-6), normal.Y * (depth + 1e-6)}}
+		if ShapesIntersect(ConvexPolygon(a), apart) {
+			t.Fatalf("%v and %v: moving by %v * %v does not separate them", a, b, normal, depth)
+		}
+		if depth > 1e-3 {
+			close := translated{ConvexPolygon(b), Point{normal.X * (depth - 1e-3), normal.Y * (depth - 1e-3)}}
+			if !ShapesIntersect(ConvexPolygon(a), close) {
+				t.Fatalf("%v and %v: depth %v along %v is too large", a, b, depth, normal)
+			}
+		}
+	}
+}
+
+// randomPolygon returns a convex polygon with random real coordinates, so
+// that touching shapes are unlikely.
+func randomPolygon(rnd *rand.Rand) []Point {
+	for {
+		points := make([]Point, 3+rnd.Intn(6))
+		cx, cy := rnd.Float64()*6, rnd.Float64()*6
+		for i := range points {
+			points[i] = Point{cx + rnd.Float64()*3, cy + rnd.Float64()*3}
+		}
+		if hull := MonotoneChain(points); len(hull) >= 3 {
+			return hull
+		}
+	}
+}
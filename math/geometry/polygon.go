@@ -0,0 +1,68 @@
+// polygon.go
+// description: Area of a simple polygon and point in polygon test
+// details:
+// The shoelace formula sums the cross products of consecutive vertices, which
+// gives twice the signed area: positive when the vertices are listed
+// counter-clockwise and negative when they are listed clockwise.
+// A point is located with the crossing number method: a ray cast from the
+// point to the right crosses the boundary an odd number of times exactly when
+// the point is inside. Points on an edge are reported separately.
+// time complexity: O(n)
+// space complexity: O(1)
+// reference: https://en.wikipedia.org/wiki/Shoelace_formula, https://en.wikipedia.org/wiki/Point_in_polygon
+// see polygon_test.go
+
+package geometry
+
+import "math"
+
+// Location is the position of a point relative to a polygon.
+type Location int
+
+const (
+	Outside Location = iota
+	Inside
+	OnBoundary
+)
+
+// SignedArea returns the signed area of the polygon whose vertices are given
+// in order: positive for counter-clockwise and negative for clockwise order.
+func SignedArea(polygon []Point) float64 {
+	var sum float64
+	for i, p := range polygon {
+		q := polygon[(i+1)%len(polygon)]
+		sum += p.X*q.Y - q.X*p.Y
+	}
+	return sum / 2
+}
+
+// PolygonArea returns the area of the simple polygon whose vertices are
+// given in order, either clockwise or counter-clockwise.
+func PolygonArea(polygon []Point) float64 {
+	return math.Abs(SignedArea(polygon))
+}
+
+// PointInPolygon locates p relative to the simple polygon whose vertices are
+// given in order. A polygon with fewer than three vertices has no inside.
+func PointInPolygon(p Point, polygon []Point) Location {
+	n := len(polygon)
+	inside := false
+	for i := 0; i < n; i++ {
+		a, b := polygon[i], polygon[(i+1)%n]
+		if OnSegment(p, &Line{P1: a, P2: b}) {
+			return OnBoundary
+		}
+		// Count the edges crossing the horizontal ray to the right of p. An
+		// edge owns its lower endpoint only, so vertices are not counted twice.
+		if (a.Y > p.Y) != (b.Y > p.Y) {
+			x := a.X + (p.Y-a.Y)*(b.X-a.X)/(b.Y-a.Y)
+			if x > p.X {
+				inside = !inside
+			}
+		}
+	}
+	if inside && n >= 3 {
+		return Inside
+	}
+	return Outside
+}
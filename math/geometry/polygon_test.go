@@ -0,0 +1,63 @@
+package geometry
+
+import "testing"
+
+func TestPolygonArea(t *testing.T) {
+	square := []Point{{0, 0}, {4, 0}, {4, 4}, {0, 4}}
+	if got := SignedArea(square); got != 16 {
+		t.Errorf("SignedArea(counter-clockwise square) = %v, want 16", got)
+	}
+	clockwise := []Point{{0, 4}, {4, 4}, {4, 0}, {0, 0}}
+	if got := SignedArea(clockwise); got != -16 {
+		t.Errorf("SignedArea(clockwise square) = %v, want -16", got)
+	}
+	if got := PolygonArea(clockwise); got != 16 {
+		t.Errorf("PolygonArea(clockwise square) = %v, want 16", got)
+	}
+	// An L shape made of three unit squares.
+	l := []Point{{0, 0}, {2, 0}, {2, 1}, {1, 1}, {1, 2}, {0, 2}}
+	if got := PolygonArea(l); got != 3 {
+		t.Errorf("PolygonArea(L) = %v, want 3", got)
+	}
+	if got := PolygonArea([]Point{{0, 0}, {1, 1}, {2, 2}}); got != 0 {
+		t.Errorf("PolygonArea(collinear) = %v, want 0", got)
+	}
+	if got := PolygonArea(nil); got != 0 {
+		t.Errorf("PolygonArea(nil) = %v, want 0", got)
+	}
+}
+
+func TestPointInPolygon(t *testing.T) {
+	// A concave polygon shaped like a U.
+	u := []Point{{0, 0}, {3, 0}, {3, 3}, {2, 3}, {2, 1}, {1, 1}, {1, 3}, {0, 3}}
+	tests := []struct {
+		name string
+		p    Point
+		want Location
+	}{
+		{"left arm", Point{0.5, 2}, Inside},
+		{"bottom", Point{1.5, 0.5}, Inside},
+		{"inside the notch", Point{1.5, 2}, Outside},
+		{"far away", Point{5, 1}, Outside},
+		{"on an edge", Point{1.5, 1}, OnBoundary},
+		{"on a vertex", Point{2, 3}, OnBoundary},
+		{"ray through vertices", Point{-1, 3}, Outside},
+		{"ray through a reflex vertex", Point{0.5, 1}, Inside},
+		{"ray along an edge", Point{-1, 0}, Outside},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := PointInPolygon(test.p, u); got != test.want {
+				t.Errorf("PointInPolygon(%v) = %v, want %v", test.p, got, test.want)
+			}
+		})
+	}
+
+	segment := []Point{{0, 0}, {2, 2}}
+	if got := PointInPolygon(Point{1, 1}, segment); got != OnBoundary {
+		t.Errorf("PointInPolygon on a degenerate polygon = %v, want OnBoundary", got)
+	}
+	if got := PointInPolygon(Point{1, 0}, segment); got != Outside {
+		t.Errorf("PointInPolygon off a degenerate polygon = %v, want Outside", got)
+	}
+}
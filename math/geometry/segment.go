@@ -0,0 +1,125 @@
+// segment.go
+// description: Orientation of point triples and line segment intersection
+// details:
+// The orientation of three points is the sign of the cross product of the
+// vectors p1->p2 and p1->p3: positive for a counter-clockwise turn, negative
+// for a clockwise turn and zero when the points are collinear.
+// Two segments intersect when the endpoints of each one lie on different sides
+// of the other, or when an endpoint of one lies on the other one. The
+// computations use exact comparisons, so inputs are best given on a grid.
+// time complexity: O(1)
+// space complexity: O(1)
+// reference: https://en.wikipedia.org/wiki/Line_segment_intersection, CLRS chapter 33.1
+// see segment_test.go
+
+package geometry
+
+// Cross returns the cross product of the vectors o->a and o->b, which is
+// twice the signed area of the triangle o, a, b.
+func Cross(o, a, b Point) float64 {
+	return (a.X-o.X)*(b.Y-o.Y) - (a.Y-o.Y)*(b.X-o.X)
+}
+
+// Orientation returns 1 if p1, p2, p3 make a counter-clockwise turn, -1 if
+// they make a clockwise turn and 0 if they are collinear.
+func Orientation(p1, p2, p3 Point) int {
+	c := Cross(p1, p2, p3)
+	switch {
+	case c > 0:
+		return 1
+	case c < 0:
+		return -1
+	}
+	return 0
+}
+
+// OnSegment reports whether p lies on the segment l, endpoints included.
+func OnSegment(p Point, l *Line) bool {
+	return Orientation(l.P1, l.P2, p) == 0 && inBox(p, l)
+}
+
+// inBox reports whether p lies in the bounding box of the segment l.
+func inBox(p Point, l *Line) bool {
+	return p.X >= minFloat(l.P1.X, l.P2.X) && p.X <= maxFloat(l.P1.X, l.P2.X) &&
+		p.Y >= minFloat(l.P1.Y, l.P2.Y) && p.Y <= maxFloat(l.P1.Y, l.P2.Y)
+}
+
+// SegmentsIntersect reports whether the closed segments l1 and l2 share at
+// least one point, touching endpoints and collinear overlaps included.
+func SegmentsIntersect(l1, l2 *Line) bool {
+	d1 := Orientation(l2.P1, l2.P2, l1.P1)
+	d2 := Orientation(l2.P1, l2.P2, l1.P2)
+	d3 := Orientation(l1.P1, l1.P2, l2.P1)
+	d4 := Orientation(l1.P1, l1.P2, l2.P2)
+	if d1*d2 < 0 && d3*d4 < 0 {
+		return true
+	}
+	return (d1 == 0 && inBox(l1.P1, l2)) ||
+		(d2 == 0 && inBox(l1.P2, l2)) ||
+		(d3 == 0 && inBox(l2.P1, l1)) ||
+		(d4 == 0 && inBox(l2.P2, l1))
+}
+
+// Intersection returns the single point shared by the segments l1 and l2.
+// It reports false when the segments are disjoint and also when they are
+// collinear, since the overlap is then either empty or a whole segment.
+func Intersection(l1, l2 *Line) (Point, bool) {
+	if !SegmentsIntersect(l1, l2) {
+		return Point{}, false
+	}
+	dx1, dy1 := l1.P2.X-l1.P1.X, l1.P2.Y-l1.P1.Y
+	dx2, dy2 := l2.P2.X-l2.P1.X, l2.P2.Y-l2.P1.Y
+	denominator := dx1*dy2 - dy1*dx2
+	if denominator == 0 {
+		// A segment reduced to a point is the intersection itself.
+		if l1.P1 == l1.P2 {
+			return l1.P1, true
+		}
+		if l2.P1 == l2.P2 {
+			return l2.P1, true
+		}
+		// Collinear segments meeting at exactly one endpoint.
+		for _, p := range []Point{l1.P1, l1.P2} {
+			if p == l2.P1 || p == l2.P2 {
+				if touchOnly(l1, l2) {
+					return p, true
+				}
+			}
+		}
+		return Point{}, false
+	}
+	t := ((l2.P1.X-l1.P1.X)*dy2 - (l2.P1.Y-l1.P1.Y)*dx2) / denominator
+	return Point{X: l1.P1.X + t*dx1, Y: l1.P1.Y + t*dy1}, true
+}
+
+// touchOnly reports whether two collinear segments sharing an endpoint have
+// no other point in common.
+func touchOnly(l1, l2 *Line) bool {
+	count := 0
+	for _, p := range []Point{l1.P1, l1.P2} {
+		if inBox(p, l2) {
+			count++
+		}
+	}
+	for _, p := range []Point{l2.P1, l2.P2} {
+		if inBox(p, l1) {
+			count++
+		}
+	}
+	// The shared endpoint is counted once from each side.
+	return count == 2
+}
+
+func minFloat(a, b float64) float64 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxFloat(a, b float64) float64 {
+	if a > b {
+		return a
+	}
+	return b
+}
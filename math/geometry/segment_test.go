@@ -0,0 +1,57 @@
+package geometry
+
+import "testing"
+
+func TestOrientation(t *testing.T) {
+	tests := []struct {
+		p1, p2, p3 Point
+		want       int
+	}{
+		{Point{0, 0}, Point{1, 0}, Point{1, 1}, 1},
+		{Point{0, 0}, Point{1, 1}, Point{1, 0}, -1},
+		{Point{0, 0}, Point{1, 1}, Point{3, 3}, 0},
+		{Point{0, 0}, Point{0, 0}, Point{3, 3}, 0},
+	}
+	for _, test := range tests {
+		if got := Orientation(test.p1, test.p2, test.p3); got != test.want {
+			t.Errorf("Orientation(%v, %v, %v) = %d, want %d", test.p1, test.p2, test.p3, got, test.want)
+		}
+	}
+}
+
+func TestSegmentsIntersect(t *testing.T) {
+	tests := []struct {
+		name   string
+		l1, l2 Line
+		want   bool
+		point  Point
+		single bool
+	}{
+		{"crossing", Line{Point{0, 0}, Point{4, 4}}, Line{Point{0, 4}, Point{4, 0}}, true, Point{2, 2}, true},
+		{"disjoint", Line{Point{0, 0}, Point{1, 1}}, Line{Point{2, 0}, Point{3, 1}}, false, Point{}, false},
+		{"parallel", Line{Point{0, 0}, Point{4, 0}}, Line{Point{0, 1}, Point{4, 1}}, false, Point{}, false},
+		{"touching endpoint", Line{Point{0, 0}, Point{2, 2}}, Line{Point{2, 2}, Point{4, 0}}, true, Point{2, 2}, true},
+		{"T junction", Line{Point{0, 0}, Point{4, 0}}, Line{Point{2, 0}, Point{2, 3}}, true, Point{2, 0}, true},
+		{"collinear overlapping", Line{Point{0, 0}, Point{3, 0}}, Line{Point{2, 0}, Point{5, 0}}, true, Point{}, false},
+		{"collinear touching", Line{Point{0, 0}, Point{2, 0}}, Line{Point{2, 0}, Point{5, 0}}, true, Point{2, 0}, true},
+		{"collinear apart", Line{Point{0, 0}, Point{1, 0}}, Line{Point{2, 0}, Point{5, 0}}, false, Point{}, false},
+		{"collinear contained", Line{Point{0, 0}, Point{5, 5}}, Line{Point{1, 1}, Point{2, 2}}, true, Point{}, false},
+		{"degenerate on segment", Line{Point{1, 1}, Point{1, 1}}, Line{Point{0, 0}, Point{2, 2}}, true, Point{1, 1}, true},
+		{"degenerate off segment", Line{Point{1, 2}, Point{1, 2}}, Line{Point{0, 0}, Point{2, 2}}, false, Point{}, false},
+		{"collinear lines but no overlap", Line{Point{0, 0}, Point{1, 1}}, Line{Point{2, 2}, Point{3, 3}}, false, Point{}, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := SegmentsIntersect(&test.l1, &test.l2); got != test.want {
+				t.Errorf("SegmentsIntersect(%v, %v) = %v, want %v", test.l1, test.l2, got, test.want)
+			}
+			if got := SegmentsIntersect(&test.l2, &test.l1); got != test.want {
+				t.Errorf("SegmentsIntersect(%v, %v) = %v, want %v", test.l2, test.l1, got, test.want)
+			}
+			p, ok := Intersection(&test.l1, &test.l2)
+			if ok != test.single || p != test.point {
+				t.Errorf("Intersection(%v, %v) = %v, %v, want %v, %v", test.l1, test.l2, p, ok, test.point, test.single)
+			}
+		})
+	}
+}
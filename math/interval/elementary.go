@@ -0,0 +1,166 @@
+// elementary.go
+// description: Powers, square roots and elementary functions of intervals
+// details:
+// A monotonic function maps an interval to the interval between the images
+// of its bounds. Sin and Cos are not monotonic: the images of the bounds are
+// completed with 1 or -1 when the interval contains a point where the
+// function reaches its maximum or minimum. Since pi itself is rounded, points
+// close to an extremum count as containing it, which may widen the result but
+// never loses the exact value.
+// Integer powers and square roots get exact outward rounding like the basic
+// operations. Go computes Exp, Log, Sin and Cos to within about one unit in
+// the last place, so their bounds are moved outwards by two units instead.
+// time complexity: O(1), O(log n) for Pow
+// space complexity: O(1)
+// reference: https://en.wikipedia.org/wiki/Interval_arithmetic#Elementary_functions
+// see elementary_test.go
+
+package interval
+
+import "math"
+
+// widen moves both bounds of x two floats outwards.
+func widen(lo, hi float64) Interval {
+	return Interval{down(down(lo)), up(up(hi))}
+}
+
+// Abs returns the absolute values of the numbers of x.
+func (x Interval) Abs() Interval {
+	switch {
+	case x.Lo >= 0:
+		return x
+	case x.Hi <= 0:
+		return x.Neg()
+	}
+	return Interval{0, math.Max(-x.Lo, x.Hi)}
+}
+
+// powBounds returns bounds of a^n for a >= 0 and n >= 1, multiplying the
+// lower and upper bounds separately by squaring.
+func powBounds(a float64, n int) (lo, hi float64) {
+	lo, hi = 1, 1
+	blo, bhi := a, a
+	for {
+		if n&1 == 1 {
+			lo, _ = mulBounds(lo, blo)
+			_, hi = mulBounds(hi, bhi)
+		}
+		n >>= 1
+		if n == 0 {
+			return lo, hi
+		}
+		blo, _ = mulBounds(blo, blo)
+		_, bhi = mulBounds(bhi, bhi)
+	}
+}
+
+// Pow returns x^n for n >= 0. Unlike repeated multiplication, it knows that
+// both factors of x*x are the same number: [-1, 2]^2 is [0, 4], not [-2, 4].
+func (x Interval) Pow(n int) Interval {
+	if n < 0 {
+		panic("interval: negative exponent")
+	}
+	if n == 0 {
+		return Point(1)
+	}
+	if n%2 == 0 {
+		a := x.Abs()
+		lo, _ := powBounds(a.Lo, n)
+		_, hi := powBounds(a.Hi, n)
+		return Interval{lo, hi}
+	}
+	// odd powers are increasing, and (-a)^n = -(a^n)
+	bound := func(v float64) (float64, float64) {
+		if v >= 0 {
+			return powBounds(v, n)
+		}
+		lo, hi := powBounds(-v, n)
+		return -hi, -lo
+	}
+	lo, _ := bound(x.Lo)
+	_, hi := bound(x.Hi)
+	return Interval{lo, hi}
+}
+
+// sqrtBounds returns bounds of the square root of a >= 0.
+func sqrtBounds(a float64) (lo, hi float64) {
+	s := math.Sqrt(a)
+	if s == 0 || math.IsInf(s, 0) {
+		return s, s
+	}
+	if a < tiny {
+		return round(s, math.NaN())
+	}
+	// a = s*s + r exactly, and the root moves the way r points
+	return round(s, math.FMA(-s, s, a))
+}
+
+// Sqrt returns the square roots of the nonnegative numbers of x. It fails if
+// x holds no such number.
+func (x Interval) Sqrt() (Interval, error) {
+	if x.Hi < 0 {
+		return Interval{}, ErrDomain
+	}
+	lo, _ := sqrtBounds(math.Max(x.Lo, 0))
+	_, hi := sqrtBounds(x.Hi)
+	return Interval{lo, hi}, nil
+}
+
+// Exp returns e^x.
+func (x Interval) Exp() Interval {
+	r := widen(math.Exp(x.Lo), math.Exp(x.Hi))
+	r.Lo = math.Max(r.Lo, 0)
+	return r
+}
+
+// Log returns the natural logarithms of the positive numbers of x. It fails
+// if x holds no such number.
+func (x Interval) Log() (Interval, error) {
+	if x.Hi <= 0 {
+		return Interval{}, ErrDomain
+	}
+	r := widen(math.Log(x.Lo), math.Log(x.Hi))
+	if x.Lo <= 0 {
+		r.Lo = math.Inf(-1)
+	}
+	return r, nil
+}
+
+// reaches reports whether x may contain offset + 2*k*pi for some integer k,
+// erring on the side of yes.
+func (x Interval) reaches(offset float64) bool {
+	const slack = 1e-9 // in turns, far above the error of the computation
+	lo := (x.Lo - offset) / (2 * math.Pi)
+	hi := (x.Hi - offset) / (2 * math.Pi)
+	return math.Floor(hi+slack) >= math.Ceil(lo-slack)
+}
+
+// periodic returns the image of x by sin or cos, whose maximum 1 is reached
+// at top + 2*k*pi and minimum -1 at top + pi + 2*k*pi.
+func (x Interval) periodic(f func(float64) float64, top float64) Interval {
+	// beyond 2^50 consecutive floats are too far apart to tell
+	if x.Width() >= 2*math.Pi || math.Abs(x.Lo) > 0x1p50 || math.Abs(x.Hi) > 0x1p50 {
+		return Interval{-1, 1}
+	}
+	a, b := f(x.Lo), f(x.Hi)
+	r := widen(math.Min(a, b), math.Max(a, b))
+	if x.reaches(top) {
+		r.Hi = 1
+	}
+	if x.reaches(top + math.Pi) {
+		r.Lo = -1
+	}
+	r.Lo = math.Max(r.Lo, -1)
+	r.Hi = math.Min(r.Hi, 1)
+	return r
+}
+
+// Sin returns the sines of x.
+func (x Interval) Sin() Interval {
+	return x.periodic(math.Sin, math.Pi/2)
+}
+
+// Cos returns the cosines of x.
+func (x Interval) Cos() Interval {
+	return x.periodic(math.Cos, 0)
+}
@@ -0,0 +1,106 @@
+package interval_test
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/math/interval"
+)
+
+func TestPow(t *testing.T) {
+	x, _ := interval.New(-1, 2)
+	if r := x.Pow(2); r != (interval.Interval{Lo: 0, Hi: 4}) {
+		t.Errorf("[-1, 2]^2 = %v, want [0, 4]", r)
+	}
+	if r := x.Pow(3); r != (interval.Interval{Lo: -1, Hi: 8}) {
+		t.Errorf("[-1, 2]^3 = %v, want [-1, 8]", r)
+	}
+	if r := x.Pow(0); r != interval.Point(1) {
+		t.Errorf("x^0 = %v, want 1", r)
+	}
+	y, _ := interval.New(-3, -2)
+	if r := y.Pow(2); r != (interval.Interval{Lo: 4, Hi: 9}) {
+		t.Errorf("[-3, -2]^2 = %v, want [4, 9]", r)
+	}
+	if r := interval.Point(1.1).Pow(10); !r.Contains(math.Pow(1.1, 10)) {
+		t.Errorf("1.1^10 = %v should contain %v", r, math.Pow(1.1, 10))
+	}
+}
+
+func TestSqrt(t *testing.T) {
+	if r, err := interval.Point(4).Sqrt(); err != nil || r != interval.Point(2) {
+		t.Errorf("sqrt(4) = %v, %v, want exactly 2", r, err)
+	}
+	two, _ := interval.Point(2).Sqrt()
+	if math.Nextafter(two.Lo, 2) != two.Hi || two.Pow(2).Contains(2) == false {
+		t.Errorf("sqrt(2) = %v should have adjacent bounds and square to 2", two)
+	}
+	x, _ := interval.New(-1, 9)
+	if r, err := x.Sqrt(); err != nil || r != (interval.Interval{Lo: 0, Hi: 3}) {
+		t.Errorf("sqrt([-1, 9]) = %v, %v, want [0, 3]", r, err)
+	}
+	if _, err := interval.Point(-1).Sqrt(); err != interval.ErrDomain {
+		t.Errorf("sqrt(-1) error = %v, want %v", err, interval.ErrDomain)
+	}
+}
+
+func TestExpLog(t *testing.T) {
+	x, _ := interval.New(0, 1)
+	e := x.Exp()
+	if !e.Contains(1) || !e.Contains(math.E) || e.Lo < 0.99 || e.Hi > 2.72 {
+		t.Errorf("exp([0, 1]) = %v", e)
+	}
+	l, err := e.Log()
+	if err != nil || !x.Subset(l) || l.Width() > 1+1e-12 {
+		t.Errorf("log(exp([0, 1])) = %v, %v", l, err)
+	}
+	if l, _ := (interval.Interval{Lo: 0, Hi: 1}).Log(); !math.IsInf(l.Lo, -1) {
+		t.Errorf("log([0, 1]) = %v, want an infinite lower bound", l)
+	}
+	if _, err := interval.Point(0).Log(); err != interval.ErrDomain {
+		t.Errorf("log(0) error = %v, want %v", err, interval.ErrDomain)
+	}
+	if r := interval.Point(-1000).Exp(); r.Lo != 0 || r.Hi <= 0 {
+		t.Errorf("exp(-1000) = %v, want [0, tiny]", r)
+	}
+}
+
+func TestSinCos(t *testing.T) {
+	tests := []struct {
+		lo, hi       float64
+		sinLo, sinHi float64
+		cosLo, cosHi float64
+	}{
+		{0, 3, 0, 1, math.Cos(3), 1},
+		{1, 2, math.Sin(1), 1, math.Cos(2), math.Cos(1)},
+		{-0.5, 0.5, math.Sin(-0.5), math.Sin(0.5), math.Cos(0.5), 1},
+		{4, 5, -1, math.Sin(4), math.Cos(4), math.Cos(5)},
+		{0, 10, -1, 1, -1, 1},
+	}
+	near := func(a, b float64) bool { return math.Abs(a-b) < 1e-12 }
+	for _, test := range tests {
+		x, _ := interval.New(test.lo, test.hi)
+		s, c := x.Sin(), x.Cos()
+		if !near(s.Lo, test.sinLo) || !near(s.Hi, test.sinHi) {
+			t.Errorf("sin([%v, %v]) = %v, want about [%v, %v]", test.lo, test.hi, s, test.sinLo, test.sinHi)
+		}
+		if !near(c.Lo, test.cosLo) || !near(c.Hi, test.cosHi) {
+			t.Errorf("cos([%v, %v]) = %v, want about [%v, %v]", test.lo, test.hi, c, test.cosLo, test.cosHi)
+		}
+	}
+
+	// sampled values always fall inside
+	rnd := rand.New(rand.NewSource(2))
+	for round := 0; round < 1000; round++ {
+		lo := (rnd.Float64() - 0.5) * 40
+		x, _ := interval.New(lo, lo+rnd.Float64()*4)
+		s, c := x.Sin(), x.Cos()
+		for k := 0; k <= 10; k++ {
+			v := math.Min(x.Lo+(x.Hi-x.Lo)*float64(k)/10, x.Hi)
+			if !s.Contains(math.Sin(v)) || !c.Contains(math.Cos(v)) {
+				t.Fatalf("sin or cos of %v = %v, %v misses %v", x, s, c, v)
+			}
+		}
+	}
+}
@@ -0,0 +1,263 @@
+// interval.go
+// description: Interval arithmetic with outward rounding
+// details:
+// An interval [Lo, Hi] stands for an unknown real number known to lie between
+// its bounds. Every operation returns an interval containing all the results
+// of the operation applied to numbers of its operands, so that a computation
+// done with intervals yields a guaranteed enclosure of the exact result in
+// spite of rounding errors and uncertain inputs.
+// Floating-point results are rounded to nearest, so a computed bound may fall
+// on the wrong side of the exact one. The basic operations avoid that by
+// computing their exact rounding error with error-free transformations
+// (TwoSum for additions, a fused multiply-add for products, quotients and
+// square roots) and moving a bound one step outwards only when the error
+// points outwards. Results are therefore as tight as floating point allows.
+// time complexity: O(1) per operation
+// space complexity: O(1)
+// reference: https://en.wikipedia.org/wiki/Interval_arithmetic
+// reference: https://en.wikipedia.org/wiki/2Sum
+// see interval_test.go
+
+// Package interval implements interval arithmetic with outward rounding,
+// elementary functions on intervals and the interval Newton method, which
+// computes verified enclosures of the roots of a function.
+package interval
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+var (
+	// ErrInvalidInterval is returned for bounds that are NaN or out of order.
+	ErrInvalidInterval = errors.New("interval bounds must be ordered numbers")
+	// ErrDivisionByZero is returned when dividing by an interval containing zero.
+	ErrDivisionByZero = errors.New("division by an interval containing zero")
+	// ErrDomain is returned when a function is applied outside of its domain.
+	ErrDomain = errors.New("interval outside of the function domain")
+)
+
+// Interval is the set of real numbers between Lo and Hi, both included.
+type Interval struct {
+	Lo, Hi float64
+}
+
+// New returns the interval [lo, hi].
+func New(lo, hi float64) (Interval, error) {
+	if math.IsNaN(lo) || math.IsNaN(hi) || lo > hi {
+		return Interval{}, ErrInvalidInterval
+	}
+	return Interval{lo, hi}, nil
+}
+
+// Point returns the interval holding x alone. Note that x is already a
+// rounded value: Point(0.1) does not contain the real number 1/10, which
+// Parse can enclose.
+func Point(x float64) Interval {
+	return Interval{x, x}
+}
+
+// Parse returns the narrowest interval containing the real number written
+// in decimal in s, such as "0.1", which has no exact float64 representation.
+func Parse(s string) (Interval, error) {
+	var x float64
+	if _, err := fmt.Sscan(s, &x); err != nil || math.IsNaN(x) {
+		return Interval{}, ErrInvalidInterval
+	}
+	// x is the nearest float64 to the real number, which therefore lies
+	// strictly between its neighbours.
+	if math.IsInf(x, 0) {
+		return Interval{x, x}, nil
+	}
+	return Interval{down(x), up(x)}, nil
+}
+
+// down returns the float64 right below x.
+func down(x float64) float64 {
+	return math.Nextafter(x, math.Inf(-1))
+}
+
+// up returns the float64 right above x.
+func up(x float64) float64 {
+	return math.Nextafter(x, math.Inf(1))
+}
+
+// round returns the bounds of an exact value equal to r + err, r being its
+// rounded value: only the side err points to is moved outwards.
+func round(r, err float64) (lo, hi float64) {
+	lo, hi = r, r
+	if err < 0 || math.IsNaN(err) {
+		lo = down(r)
+	}
+	if err > 0 || math.IsNaN(err) {
+		hi = up(r)
+	}
+	return lo, hi
+}
+
+// sumError returns the exact error of a + b, rounded to s (TwoSum).
+func sumError(a, b, s float64) float64 {
+	bv := s - a
+	av := s - bv
+	return (a - av) + (b - bv)
+}
+
+// addBounds returns bounds of a + b.
+func addBounds(a, b float64) (lo, hi float64) {
+	s := a + b
+	switch {
+	case math.IsInf(a, 0) || math.IsInf(b, 0):
+		return s, s
+	case math.IsInf(s, 0): // overflow
+		return round(s, math.NaN())
+	}
+	return round(s, sumError(a, b, s))
+}
+
+// addDown returns a lower bound of a + b.
+func addDown(a, b float64) float64 {
+	lo, _ := addBounds(a, b)
+	return lo
+}
+
+// addUp returns an upper bound of a + b.
+func addUp(a, b float64) float64 {
+	_, hi := addBounds(a, b)
+	return hi
+}
+
+// tiny is a magnitude below which products and quotients may have lost
+// bits to underflow, so that their rounding error cannot be computed exactly.
+const tiny = 0x1p-968
+
+// mulBounds returns bounds of a * b.
+func mulBounds(a, b float64) (lo, hi float64) {
+	if a == 0 || b == 0 {
+		// an infinite bound stands for large finite numbers: 0 * Inf is 0
+		return 0, 0
+	}
+	p := a * b
+	switch {
+	case math.IsInf(a, 0) || math.IsInf(b, 0):
+		return p, p
+	case math.IsInf(p, 0) || math.Abs(p) < tiny:
+		return round(p, math.NaN())
+	}
+	return round(p, math.FMA(a, b, -p))
+}
+
+// quoBounds returns bounds of a / b, b being nonzero.
+func quoBounds(a, b float64) (lo, hi float64) {
+	switch {
+	case a == 0 || math.IsInf(b, 0) && !math.IsInf(a, 0):
+		return 0, 0
+	case math.IsInf(a, 0) && math.IsInf(b, 0):
+		if (a > 0) == (b > 0) {
+			return 0, math.Inf(1)
+		}
+		return math.Inf(-1), 0
+	case math.IsInf(a, 0):
+		return a / b, a / b
+	}
+	q := a / b
+	if math.IsInf(q, 0) || math.Abs(q) < tiny || math.Abs(a) < tiny {
+		return round(q, math.NaN())
+	}
+	// a = q*b + r exactly, so a/b = q + r/b
+	r := math.FMA(-q, b, a)
+	if b < 0 {
+		r = -r
+	}
+	return round(q, r)
+}
+
+// Add returns x + y.
+func (x Interval) Add(y Interval) Interval {
+	return Interval{addDown(x.Lo, y.Lo), addUp(x.Hi, y.Hi)}
+}
+
+// Sub returns x - y.
+func (x Interval) Sub(y Interval) Interval {
+	return x.Add(y.Neg())
+}
+
+// Neg returns -x.
+func (x Interval) Neg() Interval {
+	return Interval{-x.Hi, -x.Lo}
+}
+
+// Mul returns x * y: the hull of the products of the bounds.
+func (x Interval) Mul(y Interval) Interval {
+	r := Interval{math.Inf(1), math.Inf(-1)}
+	for _, a := range [2]float64{x.Lo, x.Hi} {
+		for _, b := range [2]float64{y.Lo, y.Hi} {
+			lo, hi := mulBounds(a, b)
+			r.Lo = math.Min(r.Lo, lo)
+			r.Hi = math.Max(r.Hi, hi)
+		}
+	}
+	return r
+}
+
+// Div returns x / y. It fails if y contains zero.
+func (x Interval) Div(y Interval) (Interval, error) {
+	if y.Contains(0) {
+		return Interval{}, ErrDivisionByZero
+	}
+	r := Interval{math.Inf(1), math.Inf(-1)}
+	for _, a := range [2]float64{x.Lo, x.Hi} {
+		for _, b := range [2]float64{y.Lo, y.Hi} {
+			lo, hi := quoBounds(a, b)
+			r.Lo = math.Min(r.Lo, lo)
+			r.Hi = math.Max(r.Hi, hi)
+		}
+	}
+	return r, nil
+}
+
+// Contains reports whether v lies in x.
+func (x Interval) Contains(v float64) bool {
+	return x.Lo <= v && v <= x.Hi
+}
+
+// Subset reports whether every number of x lies in y.
+func (x Interval) Subset(y Interval) bool {
+	return y.Lo <= x.Lo && x.Hi <= y.Hi
+}
+
+// Intersect returns the numbers common to x and y. The second return value
+// is false when there are none.
+func (x Interval) Intersect(y Interval) (Interval, bool) {
+	r := Interval{math.Max(x.Lo, y.Lo), math.Min(x.Hi, y.Hi)}
+	return r, r.Lo <= r.Hi
+}
+
+// Hull returns the smallest interval containing x and y.
+func (x Interval) Hull(y Interval) Interval {
+	return Interval{math.Min(x.Lo, y.Lo), math.Max(x.Hi, y.Hi)}
+}
+
+// Width returns an upper bound of Hi - Lo.
+func (x Interval) Width() float64 {
+	return addUp(x.Hi, -x.Lo)
+}
+
+// Mid returns a number of x close to its midpoint.
+func (x Interval) Mid() float64 {
+	switch {
+	case math.IsInf(x.Lo, -1) && math.IsInf(x.Hi, 1):
+		return 0
+	case math.IsInf(x.Lo, -1):
+		return -math.MaxFloat64
+	case math.IsInf(x.Hi, 1):
+		return math.MaxFloat64
+	}
+	m := x.Lo/2 + x.Hi/2
+	return math.Max(x.Lo, math.Min(m, x.Hi))
+}
+
+// String returns x as [Lo, Hi].
+func (x Interval) String() string {
+	return fmt.Sprintf("[%v, %v]", x.Lo, x.Hi)
+}
@@ -0,0 +1,138 @@
+package interval_test
+
+import (
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/math/interval"
+)
+
+// encloses reports whether r contains the exact value v.
+func encloses(r interval.Interval, v *big.Float) bool {
+	return big.NewFloat(r.Lo).Cmp(v) <= 0 && v.Cmp(big.NewFloat(r.Hi)) <= 0
+}
+
+func exact(op byte, a, b float64) *big.Float {
+	x := new(big.Float).SetPrec(4096).SetFloat64(a)
+	y := new(big.Float).SetPrec(4096).SetFloat64(b)
+	switch op {
+	case '+':
+		return x.Add(x, y)
+	case '-':
+		return x.Sub(x, y)
+	case '*':
+		return x.Mul(x, y)
+	}
+	return x.Quo(x, y)
+}
+
+func TestNew(t *testing.T) {
+	if _, err := interval.New(2, 1); err != interval.ErrInvalidInterval {
+		t.Errorf("New(2, 1) error = %v, want %v", err, interval.ErrInvalidInterval)
+	}
+	if _, err := interval.New(math.NaN(), 1); err != interval.ErrInvalidInterval {
+		t.Errorf("New(NaN, 1) error = %v, want %v", err, interval.ErrInvalidInterval)
+	}
+	x, err := interval.New(-1, 2)
+	if err != nil || x.String() != "[-1, 2]" {
+		t.Errorf("New(-1, 2) = %v, %v", x, err)
+	}
+}
+
+func TestParse(t *testing.T) {
+	tenth, err := interval.Parse("0.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, _ := new(big.Float).SetPrec(4096).SetString("0.1")
+	if !encloses(tenth, v) || tenth.Lo == tenth.Hi {
+		t.Errorf("Parse(0.1) = %v should strictly enclose 1/10", tenth)
+	}
+	if _, err := interval.Parse("abc"); err != interval.ErrInvalidInterval {
+		t.Errorf("Parse(abc) error = %v", err)
+	}
+
+	// ten additions of 0.1 are known to be exactly 1 at the end
+	sum := interval.Point(0)
+	for i := 0; i < 10; i++ {
+		sum = sum.Add(tenth)
+	}
+	if !sum.Contains(1) || sum.Width() > 1e-14 {
+		t.Errorf("ten tenths = %v, want a narrow enclosure of 1", sum)
+	}
+}
+
+func TestExactOperationsStayPoints(t *testing.T) {
+	a, b := interval.Point(1.5), interval.Point(0.25)
+	if r := a.Add(b); r != interval.Point(1.75) {
+		t.Errorf("1.5 + 0.25 = %v, want a point", r)
+	}
+	if r := a.Mul(b); r != interval.Point(0.375) {
+		t.Errorf("1.5 * 0.25 = %v, want a point", r)
+	}
+	if r, _ := a.Div(b); r != interval.Point(6) {
+		t.Errorf("1.5 / 0.25 = %v, want a point", r)
+	}
+	// 1/3 is not a float: the result is the two floats around it
+	third, _ := interval.Point(1).Div(interval.Point(3))
+	if math.Nextafter(third.Lo, 1) != third.Hi {
+		t.Errorf("1 / 3 = %v, want adjacent bounds", third)
+	}
+}
+
+func TestArithmeticEncloses(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	random := func() float64 {
+		return (rnd.Float64() - 0.5) * math.Pow(10, float64(rnd.Intn(40)-20))
+	}
+	for round := 0; round < 5000; round++ {
+		a, b := random(), random()
+		x, y := interval.Point(a), interval.Point(b)
+		results := map[byte]interval.Interval{'+': x.Add(y), '-': x.Sub(y), '*': x.Mul(y)}
+		if q, err := x.Div(y); err == nil {
+			results['/'] = q
+		}
+		for op, r := range results {
+			if !encloses(r, exact(op, a, b)) {
+				t.Fatalf("%v %c %v = %v does not enclose the exact result", a, op, b, r)
+			}
+		}
+	}
+}
+
+func TestIntervalOperations(t *testing.T) {
+	x, _ := interval.New(-1, 2)
+	y, _ := interval.New(3, 4)
+	if r := x.Mul(y); r != (interval.Interval{Lo: -4, Hi: 8}) {
+		t.Errorf("[-1, 2] * [3, 4] = %v, want [-4, 8]", r)
+	}
+	if r := x.Sub(x); r != (interval.Interval{Lo: -3, Hi: 3}) {
+		t.Errorf("[-1, 2] - [-1, 2] = %v, want [-3, 3]", r)
+	}
+	if r, _ := y.Div(interval.Interval{Lo: -2, Hi: -1}); r != (interval.Interval{Lo: -4, Hi: -1.5}) {
+		t.Errorf("[3, 4] / [-2, -1] = %v, want [-4, -1.5]", r)
+	}
+	if _, err := y.Div(x); err != interval.ErrDivisionByZero {
+		t.Errorf("division by [-1, 2] error = %v", err)
+	}
+	if _, ok := x.Intersect(y); ok {
+		t.Errorf("[-1, 2] and [3, 4] should not intersect")
+	}
+	if r := x.Hull(y); r != (interval.Interval{Lo: -1, Hi: 4}) {
+		t.Errorf("hull = %v, want [-1, 4]", r)
+	}
+	if !x.Contains(0) || x.Contains(3) || !interval.Point(1).Subset(x) {
+		t.Errorf("Contains or Subset is wrong")
+	}
+
+	// overflow keeps the bounds honest
+	huge := interval.Point(math.MaxFloat64)
+	if r := huge.Add(huge); r.Lo != math.MaxFloat64 || !math.IsInf(r.Hi, 1) {
+		t.Errorf("overflowing sum = %v", r)
+	}
+	if r := huge.Mul(huge); r.Lo != math.MaxFloat64 || !math.IsInf(r.Hi, 1) {
+		t.Errorf("overflowing product = %v", r)
+	}
+}
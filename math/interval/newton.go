@@ -0,0 +1,62 @@
+// newton.go
+// description: Verified root enclosure with the interval Newton method
+// details:
+// For a function f with derivative enclosure F' that does not contain zero
+// on X, every root of f in X lies in the Newton image
+// N(X) = m - f(m)/F'(X), m being any point of X, by the mean value theorem.
+// Iterating X = X ∩ N(X) therefore never loses a root and, with interval
+// arithmetic, the guarantee survives rounding errors. When the intersection is
+// empty X has no root, and when N(X) lies inside X the Brouwer fixed point
+// theorem proves that X holds exactly one root. The width of X shrinks
+// quadratically near a simple root, so a few iterations reach the precision
+// of float64.
+// time complexity: O(k) evaluations of f and its derivative, k being the
+// number of iterations
+// space complexity: O(1)
+// reference: https://en.wikipedia.org/wiki/Interval_arithmetic#Interval_Newton_method
+// see newton_test.go
+
+package interval
+
+import "errors"
+
+var (
+	// ErrNoRoot is returned when a function is proven to have no root in an interval.
+	ErrNoRoot = errors.New("no root in the interval")
+	// ErrFlatDerivative is returned when the derivative may vanish in an interval.
+	ErrFlatDerivative = errors.New("derivative enclosure contains zero")
+)
+
+// maxNewtonIterations bounds the iterations of Newton, which converges long
+// before when the derivative is bounded away from zero.
+const maxNewtonIterations = 100
+
+// Newton narrows x down to an interval holding all the roots of f in x, f
+// and df being interval extensions of a function and of its derivative:
+// f(X) must contain f(v) for every number v of X. The second return value is
+// true if the result is proven to hold exactly one root.
+// Newton fails with ErrNoRoot when x is proven to hold no root, and with
+// ErrFlatDerivative when df(x) contains zero, in which case x should be split.
+func Newton(f, df func(Interval) Interval, x Interval) (Interval, bool, error) {
+	unique := false
+	for i := 0; i < maxNewtonIterations; i++ {
+		m := Point(x.Mid())
+		step, err := f(m).Div(df(x))
+		if err != nil {
+			return x, unique, ErrFlatDerivative
+		}
+		n := m.Sub(step)
+		if n.Subset(x) {
+			unique = true
+		}
+		next, ok := x.Intersect(n)
+		if !ok {
+			return Interval{}, false, ErrNoRoot
+		}
+		if next == x {
+			break
+		}
+		x = next
+	}
+	return x, unique, nil
+}
@@ -0,0 +1,42 @@
+package interval_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/math/interval"
+)
+
+func TestNewton(t *testing.T) {
+	// f(x) = x^2 - 2 has the root sqrt(2) in [1, 2]
+	f := func(x interval.Interval) interval.Interval { return x.Pow(2).Sub(interval.Point(2)) }
+	df := func(x interval.Interval) interval.Interval { return x.Mul(interval.Point(2)) }
+	x, _ := interval.New(1, 2)
+	root, unique, err := interval.Newton(f, df, x)
+	if err != nil || !unique {
+		t.Fatalf("Newton = %v, %v, %v, want a unique root", root, unique, err)
+	}
+	if !root.Contains(math.Sqrt2) || root.Width() > 4e-16 {
+		t.Errorf("root = %v, want a tight enclosure of sqrt(2)", root)
+	}
+
+	// cos(x) = x has a root near 0.739
+	g := func(x interval.Interval) interval.Interval { return x.Cos().Sub(x) }
+	dg := func(x interval.Interval) interval.Interval { return x.Sin().Neg().Sub(interval.Point(1)) }
+	x, _ = interval.New(0, 1)
+	root, unique, err = interval.Newton(g, dg, x)
+	if err != nil || !unique || root.Width() > 1e-14 || math.Abs(math.Cos(root.Mid())-root.Mid()) > 1e-14 {
+		t.Errorf("Newton for cos(x) = x = %v, %v, %v", root, unique, err)
+	}
+
+	// no root in [2, 3]
+	x, _ = interval.New(2, 3)
+	if _, _, err := interval.Newton(f, df, x); err != interval.ErrNoRoot {
+		t.Errorf("Newton on [2, 3] error = %v, want %v", err, interval.ErrNoRoot)
+	}
+	// the derivative vanishes in [-2, 2]
+	x, _ = interval.New(-2, 2)
+	if _, _, err := interval.Newton(f, df, x); err != interval.ErrFlatDerivative {
+		t.Errorf("Newton on [-2, 2] error = %v, want %v", err, interval.ErrFlatDerivative)
+	}
+}
@@ -0,0 +1,67 @@
+// checked.go
+// description: Least common multiple of two numbers or of a slice, with overflow detection
+// details:
+// lcm(a, b) = |a| / gcd(a, b) * |b|, dividing first so that the intermediate
+// value never exceeds the result. The product is computed on 128 bits, and
+// an error is returned when it does not fit in an int64. The LCM of a slice
+// is the fold of the pairwise LCM, which is associative.
+// time complexity: O(log(a) + log(b)) per pair, O(n log(max)) for a slice
+// space complexity: O(1)
+// reference: https://en.wikipedia.org/wiki/Least_common_multiple
+// see checked_test.go
+
+package lcm
+
+import (
+	"errors"
+	"math"
+	"math/bits"
+
+	"github.com/TheAlgorithms/Go/math/gcd"
+)
+
+// ErrOverflow is returned when a least common multiple does not fit in an int64.
+var ErrOverflow = errors.New("least common multiple overflows int64")
+
+// magnitude returns |x| as a uint64, which is right even for math.MinInt64.
+func magnitude(x int64) uint64 {
+	if x < 0 {
+		return uint64(-x)
+	}
+	return uint64(x)
+}
+
+// Checked returns the least common multiple of a and b, which is never
+// negative and is 0 if a or b is 0, or ErrOverflow if it does not fit in an
+// int64.
+func Checked(a, b int64) (int64, error) {
+	if a == 0 || b == 0 {
+		return 0, nil
+	}
+	x, y := magnitude(a), magnitude(b)
+	g := uint64(gcd.Binary(a, b))
+	if g == 1<<63 { // a and b are both math.MinInt64
+		return 0, ErrOverflow
+	}
+	hi, lo := bits.Mul64(x/g, y)
+	if hi != 0 || lo > math.MaxInt64 {
+		return 0, ErrOverflow
+	}
+	return int64(lo), nil
+}
+
+// Slice returns the least common multiple of values, 1 for an empty slice,
+// or ErrOverflow if it does not fit in an int64.
+func Slice(values []int64) (int64, error) {
+	result := int64(1)
+	for _, v := range values {
+		var err error
+		if result, err = Checked(result, v); err != nil {
+			return 0, err
+		}
+		if result == 0 {
+			return 0, nil
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,51 @@
+package lcm
+
+import (
+	"math"
+	"testing"
+)
+
+func TestChecked(t *testing.T) {
+	tests := []struct {
+		a, b int64
+		want int64
+		err  error
+	}{
+		{4, 6, 12, nil},
+		{-4, 6, 12, nil},
+		{0, 7, 0, nil},
+		{math.MaxInt64, 1, math.MaxInt64, nil},
+		{math.MinInt64, 1, 0, ErrOverflow},
+		{math.MinInt64, math.MinInt64, 0, ErrOverflow},
+		{1 << 62, 3, 0, ErrOverflow},
+		{1 << 62, 1 << 61, 1 << 62, nil},
+		{4294967296, 4294967295, 0, ErrOverflow},
+		{3037000493, 3037000453, 9223371873002223329, nil},
+	}
+	for _, test := range tests {
+		got, err := Checked(test.a, test.b)
+		if got != test.want || err != test.err {
+			t.Errorf("Checked(%d, %d) = %d, %v, want %d, %v", test.a, test.b, got, err, test.want, test.err)
+		}
+	}
+}
+
+func TestSlice(t *testing.T) {
+	tests := []struct {
+		values []int64
+		want   int64
+		err    error
+	}{
+		{nil, 1, nil},
+		{[]int64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, 2520, nil},
+		{[]int64{12, 0, 5}, 0, nil},
+		{[]int64{2, 3, 5, 7, 11, 13, 17, 19, 23, 29, 31, 37, 41, 43, 47}, 614889782588491410, nil},
+		{[]int64{2, 3, 5, 7, 11, 13, 17, 19, 23, 29, 31, 37, 41, 43, 47, 53}, 0, ErrOverflow},
+	}
+	for _, test := range tests {
+		got, err := Slice(test.values)
+		if got != test.want || err != test.err {
+			t.Errorf("Slice(%v) = %d, %v, want %d, %v", test.values, got, err, test.want, test.err)
+		}
+	}
+}
@@ -46,14 +46,11 @@ func (mat Matrix[T]) Determinant() (T, error) {
 				}
 			}
 
+			minor_det, _ := minor.Determinant()
 			if i%2 == 0 {
-				minor_det, _ := minor.Determinant()
-
 				determinant += elements[0][i] * minor_det
 			} else {
-				minor_det, _ := minor.Determinant()
-
-				determinant += elements[0][i] * minor_det
+				determinant -= elements[0][i] * minor_det
 			}
 		}
 		return determinant, nil
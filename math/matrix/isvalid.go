@@ -3,7 +3,7 @@ package matrix
 import "github.com/TheAlgorithms/Go/constraints"
 
 // IsValid checks if the input matrix has consistent row lengths.
-func IsValid[T constraints.Integer](elements [][]T) bool {
+func IsValid[T constraints.Number](elements [][]T) bool {
 	if len(elements) == 0 {
 		return true
 	}
@@ -0,0 +1,149 @@
+// lu.go
+// description: LU decomposition with partial pivoting, determinant and inverse
+// details:
+// The LU decomposition factors a square matrix A into PA = LU where P is a
+// permutation, L is unit lower triangular and U is upper triangular. At every
+// step the row with the largest pivot in absolute value is swapped into place,
+// which keeps the elimination numerically stable.
+// Once factored, the determinant is the signed product of the diagonal of U and
+// the inverse is found by solving LUx = Pe for every column e of the identity.
+// The computations are done in float64 whatever the element type of the matrix.
+// time complexity: O(n^3)
+// space complexity: O(n^2)
+// reference: https://en.wikipedia.org/wiki/LU_decomposition
+// see lu_test.go
+
+package matrix
+
+import (
+	"errors"
+	"math"
+)
+
+var (
+	// ErrNotSquare is returned when an operation needs a square matrix.
+	ErrNotSquare = errors.New("matrix must be square")
+	// ErrSingular is returned when a matrix has no inverse.
+	ErrSingular = errors.New("matrix is singular")
+)
+
+// singularEpsilon is the magnitude below which a pivot is treated as zero.
+const singularEpsilon = 1e-12
+
+// LU factors the square matrix into PA = LU. The permutation is returned as
+// perm, where row i of PA is row perm[i] of A. ErrSingular is returned when
+// a zero pivot is met; the determinant of such a matrix is zero.
+func (m Matrix[T]) LU() (lower, upper Matrix[float64], perm []int, err error) {
+	lower, upper, perm, _, err = m.lu()
+	return lower, upper, perm, err
+}
+
+// lu is LU that also returns the parity of the permutation, as +1 or -1.
+func (m Matrix[T]) lu() (lower, upper Matrix[float64], perm []int, sign float64, err error) {
+	n := m.Rows()
+	if n != m.Columns() {
+		return Matrix[float64]{}, Matrix[float64]{}, nil, 0, ErrNotSquare
+	}
+	a := make([][]float64, n)
+	for i, row := range m.elements {
+		a[i] = make([]float64, n)
+		for j, v := range row {
+			a[i][j] = float64(v)
+		}
+	}
+	perm = make([]int, n)
+	for i := range perm {
+		perm[i] = i
+	}
+	sign = 1
+
+	// Elimination is done in place: the multipliers of L are stored below the
+	// diagonal and U on and above it.
+	for k := 0; k < n; k++ {
+		pivot := k
+		for i := k + 1; i < n; i++ {
+			if math.Abs(a[i][k]) > math.Abs(a[pivot][k]) {
+				pivot = i
+			}
+		}
+		if math.Abs(a[pivot][k]) < singularEpsilon {
+			return Matrix[float64]{}, Matrix[float64]{}, nil, 0, ErrSingular
+		}
+		if pivot != k {
+			a[k], a[pivot] = a[pivot], a[k]
+			perm[k], perm[pivot] = perm[pivot], perm[k]
+			sign = -sign
+		}
+		for i := k + 1; i < n; i++ {
+			a[i][k] /= a[k][k]
+			f := a[i][k]
+			for j := k + 1; j < n; j++ {
+				a[i][j] -= f * a[k][j]
+			}
+		}
+	}
+
+	lower = New(n, n, 0.0)
+	upper = New(n, n, 0.0)
+	for i := 0; i < n; i++ {
+		lower.elements[i][i] = 1
+		copy(lower.elements[i][:i], a[i][:i])
+		copy(upper.elements[i][i:], a[i][i:])
+	}
+	return lower, upper, perm, sign, nil
+}
+
+// DeterminantLU returns the determinant of the square matrix computed from
+// its LU decomposition. Unlike Determinant it runs in O(n^3), at the cost of
+// floating point rounding.
+func (m Matrix[T]) DeterminantLU() (float64, error) {
+	_, upper, _, sign, err := m.lu()
+	if errors.Is(err, ErrSingular) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	det := sign
+	for i := 0; i < upper.Rows(); i++ {
+		det *= upper.elements[i][i]
+	}
+	return det, nil
+}
+
+// Inverse returns the inverse of the square matrix, or ErrSingular when
+// the matrix has none.
+func (m Matrix[T]) Inverse() (Matrix[float64], error) {
+	lower, upper, perm, _, err := m.lu()
+	if err != nil {
+		return Matrix[float64]{}, err
+	}
+	n := len(perm)
+	inverse := New(n, n, 0.0)
+	x := make([]float64, n)
+	for col := 0; col < n; col++ {
+		// Forward substitution: Ly = Pe, with e the col-th unit vector.
+		for i := 0; i < n; i++ {
+			var sum float64
+			if perm[i] == col {
+				sum = 1
+			}
+			for j := 0; j < i; j++ {
+				sum -= lower.elements[i][j] * x[j]
+			}
+			x[i] = sum
+		}
+		// Back substitution: Ux = y.
+		for i := n - 1; i >= 0; i-- {
+			sum := x[i]
+			for j := i + 1; j < n; j++ {
+				sum -= upper.elements[i][j] * x[j]
+			}
+			x[i] = sum / upper.elements[i][i]
+		}
+		for i := 0; i < n; i++ {
+			inverse.elements[i][col] = x[i]
+		}
+	}
+	return inverse, nil
+}
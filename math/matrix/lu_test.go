@@ -0,0 +1,146 @@
+package matrix_test
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/math/matrix"
+)
+
+func almostEqual(a, b matrix.Matrix[float64], eps float64) bool {
+	if a.Rows() != b.Rows() || a.Columns() != b.Columns() {
+		return false
+	}
+	for i := 0; i < a.Rows(); i++ {
+		for j := 0; j < a.Columns(); j++ {
+			x, _ := a.Get(i, j)
+			y, _ := b.Get(i, j)
+			if math.Abs(x-y) > eps {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func toFloat(m matrix.Matrix[int]) matrix.Matrix[float64] {
+	result := matrix.New(m.Rows(), m.Columns(), 0.0)
+	for i := 0; i < m.Rows(); i++ {
+		for j := 0; j < m.Columns(); j++ {
+			v, _ := m.Get(i, j)
+			_ = result.Set(i, j, float64(v))
+		}
+	}
+	return result
+}
+
+func TestLU(t *testing.T) {
+	m, _ := matrix.NewFromElements([][]float64{{0, 2, 1}, {1, 1, 1}, {4, 3, 6}})
+	lower, upper, perm, err := m.LU()
+	if err != nil {
+		t.Fatal(err)
+	}
+	permuted := matrix.New(3, 3, 0.0)
+	for i, p := range perm {
+		for j := 0; j < 3; j++ {
+			v, _ := m.Get(p, j)
+			_ = permuted.Set(i, j, v)
+		}
+	}
+	product, _ := lower.Multiply(upper)
+	if !almostEqual(product, permuted, 1e-9) {
+		t.Errorf("L*U = %v, want P*A = %v", product, permuted)
+	}
+	for i := 0; i < 3; i++ {
+		for j := i + 1; j < 3; j++ {
+			if v, _ := lower.Get(i, j); v != 0 {
+				t.Errorf("L(%d, %d) = %v, want 0", i, j, v)
+			}
+			if v, _ := upper.Get(j, i); v != 0 {
+				t.Errorf("U(%d, %d) = %v, want 0", j, i, v)
+			}
+		}
+	}
+}
+
+func TestDeterminantLU(t *testing.T) {
+	rnd := rand.New(rand.NewSource(3))
+	for n := 1; n <= 6; n++ {
+		m := randomIntMatrix(rnd, n, n)
+		want, _ := m.Determinant()
+		got, err := m.DeterminantLU()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if math.Abs(got-float64(want)) > 1e-6*math.Max(1, math.Abs(float64(want))) {
+			t.Errorf("DeterminantLU() = %v, want %d", got, want)
+		}
+	}
+
+	singular, _ := matrix.NewFromElements([][]int{{1, 2}, {2, 4}})
+	if got, err := singular.DeterminantLU(); err != nil || got != 0 {
+		t.Errorf("DeterminantLU() of a singular matrix = %v, %v, want 0, nil", got, err)
+	}
+	if _, err := randomIntMatrix(rnd, 2, 3).DeterminantLU(); !errors.Is(err, matrix.ErrNotSquare) {
+		t.Errorf("DeterminantLU() of a 2x3 matrix: err = %v, want ErrNotSquare", err)
+	}
+}
+
+func TestDeterminant3x3Sign(t *testing.T) {
+	tests := []struct {
+		elements [][]int
+		want     int
+	}{
+		// the pivot of the first column needs a row swap
+		{[][]int{{0, 2, 1}, {1, 1, 1}, {4, 3, 6}}, -5},
+		// an odd permutation of the rows of the identity
+		{[][]int{{0, 1, 0}, {1, 0, 0}, {0, 0, 1}}, -1},
+		// an even one, with two swaps
+		{[][]int{{0, 1, 0}, {0, 0, 1}, {1, 0, 0}}, 1},
+		{[][]int{{2, -3, 1}, {2, 0, -1}, {1, 4, 5}}, 49},
+	}
+	for _, test := range tests {
+		m, _ := matrix.NewFromElements(test.elements)
+		if got, err := m.Determinant(); err != nil || got != test.want {
+			t.Errorf("Determinant() of %v = %d, %v, want %d", test.elements, got, err, test.want)
+		}
+		if got, err := m.DeterminantLU(); err != nil || math.Abs(got-float64(test.want)) > 1e-9 {
+			t.Errorf("DeterminantLU() of %v = %v, %v, want %d", test.elements, got, err, test.want)
+		}
+	}
+}
+
+func TestInverse(t *testing.T) {
+	m, _ := matrix.NewFromElements([][]int{{4, 7}, {2, 6}})
+	want, _ := matrix.NewFromElements([][]float64{{0.6, -0.7}, {-0.2, 0.4}})
+	got, err := m.Inverse()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !almostEqual(got, want, 1e-9) {
+		t.Errorf("Inverse() = %v, want %v", got, want)
+	}
+
+	rnd := rand.New(rand.NewSource(8))
+	for n := 1; n <= 8; n++ {
+		a := randomIntMatrix(rnd, n, n)
+		inverse, err := a.Inverse()
+		if errors.Is(err, matrix.ErrSingular) {
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		product, _ := toFloat(a).Multiply(inverse)
+		if !almostEqual(product, matrix.Identity[float64](n), 1e-9) {
+			t.Errorf("A * Inverse(A) = %v, want the identity", product)
+		}
+	}
+
+	singular, _ := matrix.NewFromElements([][]int{{1, 2}, {2, 4}})
+	if _, err := singular.Inverse(); !errors.Is(err, matrix.ErrSingular) {
+		t.Errorf("Inverse() of a singular matrix: err = %v, want ErrSingular", err)
+	}
+}
@@ -11,11 +11,15 @@ import (
 	"sync"
 )
 
+// errMultiplyDimensions is returned by the multiplications of matrices whose
+// shapes do not allow multiplying them.
+var errMultiplyDimensions = errors.New("matrices cannot be multiplied: column count of the first matrix must match row count of the second matrix")
+
 // Multiply multiplies the current matrix (m1) with another matrix (m2) and returns the result as a new matrix.
 func (m1 Matrix[T]) Multiply(m2 Matrix[T]) (Matrix[T], error) {
 	// Check if the matrices can be multiplied.
 	if m1.Columns() != m2.Rows() {
-		return Matrix[T]{}, errors.New("matrices cannot be multiplied: column count of the first matrix must match row count of the second matrix")
+		return Matrix[T]{}, errMultiplyDimensions
 	}
 
 	// Create a new matrix to store the result.
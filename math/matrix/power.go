@@ -0,0 +1,54 @@
+// power.go
+// description: Matrix exponentiation by repeated squaring
+// details:
+// A^n is computed from the binary expansion of n: the matrix is squared once
+// per bit and multiplied into the result for every set bit, so only O(log n)
+// products are needed. A classic use is the n-th Fibonacci number, which is
+// the top right element of [[1, 1], [1, 0]]^n.
+// time complexity: O(k^3 log n) for a k x k matrix
+// space complexity: O(k^2)
+// reference: https://en.wikipedia.org/wiki/Exponentiation_by_squaring
+// see power_test.go
+
+package matrix
+
+import (
+	"errors"
+
+	"github.com/TheAlgorithms/Go/constraints"
+)
+
+var errNegativePower = errors.New("matrix power must not be negative")
+
+// Identity returns the n x n identity matrix.
+func Identity[T constraints.Number](n int) Matrix[T] {
+	var zeroVal T
+	result := New(n, n, zeroVal)
+	for i := 0; i < n; i++ {
+		result.elements[i][i] = 1
+	}
+	return result
+}
+
+// Power returns the square matrix raised to the n-th power. The 0-th power
+// is the identity matrix.
+func (m Matrix[T]) Power(n int) (Matrix[T], error) {
+	if m.Rows() != m.Columns() {
+		return Matrix[T]{}, ErrNotSquare
+	}
+	if n < 0 {
+		return Matrix[T]{}, errNegativePower
+	}
+	result := Identity[T](m.Rows())
+	base := m
+	for n > 0 {
+		if n&1 == 1 {
+			result, _ = result.MultiplyNaive(base)
+		}
+		n >>= 1
+		if n > 0 {
+			base, _ = base.MultiplyNaive(base)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,48 @@
+package matrix_test
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/math/matrix"
+)
+
+func TestPowerFibonacci(t *testing.T) {
+	q, _ := matrix.NewFromElements([][]uint64{{1, 1}, {1, 0}})
+	var a, b uint64 = 0, 1
+	for n := 0; n <= 90; n++ {
+		p, err := q.Power(n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Q^n = [[F(n+1), F(n)], [F(n), F(n-1)]]
+		if got, _ := p.Get(0, 1); got != a {
+			t.Errorf("F(%d) = %d, want %d", n, got, a)
+		}
+		a, b = b, a+b
+	}
+}
+
+func TestPower(t *testing.T) {
+	rnd := rand.New(rand.NewSource(9))
+	m := randomIntMatrix(rnd, 4, 4)
+	want := matrix.Identity[int](4)
+	for n := 0; n <= 7; n++ {
+		got, err := m.Power(n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !got.CheckEqual(want) {
+			t.Errorf("Power(%d) = %v, want %v", n, got, want)
+		}
+		want, _ = want.Multiply(m)
+	}
+
+	if _, err := randomIntMatrix(rnd, 2, 3).Power(2); !errors.Is(err, matrix.ErrNotSquare) {
+		t.Errorf("Power of a 2x3 matrix: err = %v, want ErrNotSquare", err)
+	}
+	if _, err := m.Power(-1); err == nil {
+		t.Errorf("Power(-1) should fail")
+	}
+}
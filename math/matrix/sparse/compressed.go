@@ -0,0 +1,144 @@
+// compressed.go
+// description: Storage shared by the compressed sparse row and column formats
+// details:
+// A compressed matrix keeps its nonzero entries grouped by a major dimension,
+// the rows for CSR and the columns for CSC. ptr[i]..ptr[i+1] is the range of
+// index and values holding the entries of major line i, where index gives the
+// position of every entry along the minor dimension, in increasing order.
+// Swapping the two dimensions turns the CSR arrays of a matrix into the CSC
+// arrays of the same matrix, and into the CSR arrays of its transpose.
+// reference: https://en.wikipedia.org/wiki/Sparse_matrix#Compressed_sparse_row_(CSR,_CRS_or_Yale_format)
+
+// Package sparse implements sparse matrices stored in the compressed sparse
+// row (CSR) and compressed sparse column (CSC) formats.
+package sparse
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/TheAlgorithms/Go/constraints"
+)
+
+// Entry is the value at a row and column of a matrix.
+type Entry[T constraints.Number] struct {
+	Row, Column int
+	Value       T
+}
+
+type compressed[T constraints.Number] struct {
+	major, minor int // sizes of the two dimensions
+	ptr          []int
+	index        []int
+	values       []T
+}
+
+// compress builds the storage of a major x minor matrix from entries given as
+// (major, minor, value). Values at the same position are summed. Entries are
+// kept even when their value is zero, so they can carry structure such as the
+// edges of an unweighted graph.
+func compress[T constraints.Number](major, minor int, entries []Entry[T]) (compressed[T], error) {
+	if major < 0 || minor < 0 {
+		return compressed[T]{}, errors.New("dimensions must not be negative")
+	}
+	for _, e := range entries {
+		if e.Row < 0 || e.Row >= major || e.Column < 0 || e.Column >= minor {
+			return compressed[T]{}, errors.New("entry out of range")
+		}
+	}
+	sorted := append([]Entry[T](nil), entries...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Row != sorted[j].Row {
+			return sorted[i].Row < sorted[j].Row
+		}
+		return sorted[i].Column < sorted[j].Column
+	})
+
+	c := compressed[T]{major: major, minor: minor, ptr: make([]int, major+1)}
+	for i, e := range sorted {
+		if i > 0 && sorted[i-1].Row == e.Row && sorted[i-1].Column == e.Column {
+			c.values[len(c.values)-1] += e.Value
+			continue
+		}
+		c.index = append(c.index, e.Column)
+		c.values = append(c.values, e.Value)
+		c.ptr[e.Row+1]++
+	}
+	for i := 0; i < major; i++ {
+		c.ptr[i+1] += c.ptr[i]
+	}
+	return c, nil
+}
+
+// compressDense builds the storage from the nonzero elements of a dense
+// matrix, reading dense[i][j] as major line i and minor position j when
+// transposed is false, and as major line j and minor position i otherwise.
+func compressDense[T constraints.Number](dense [][]T, transposed bool) (compressed[T], error) {
+	rows, columns := len(dense), 0
+	if rows > 0 {
+		columns = len(dense[0])
+	}
+	var entries []Entry[T]
+	for i, row := range dense {
+		if len(row) != columns {
+			return compressed[T]{}, errors.New("rows have different numbers of columns")
+		}
+		for j, v := range row {
+			if v == 0 {
+				continue
+			}
+			if transposed {
+				entries = append(entries, Entry[T]{Row: j, Column: i, Value: v})
+			} else {
+				entries = append(entries, Entry[T]{Row: i, Column: j, Value: v})
+			}
+		}
+	}
+	if transposed {
+		return compress(columns, rows, entries)
+	}
+	return compress(rows, columns, entries)
+}
+
+// at returns the value at major line i and minor position j.
+func (c compressed[T]) at(i, j int) T {
+	line := c.index[c.ptr[i]:c.ptr[i+1]]
+	k := sort.SearchInts(line, j)
+	if k < len(line) && line[k] == j {
+		return c.values[c.ptr[i]+k]
+	}
+	return 0
+}
+
+// line returns the minor positions and values of major line i.
+func (c compressed[T]) line(i int) ([]int, []T) {
+	return c.index[c.ptr[i]:c.ptr[i+1]], c.values[c.ptr[i]:c.ptr[i+1]]
+}
+
+// swap exchanges the major and minor dimensions with a counting sort on the
+// minor positions, in O(major + minor + nonzeros).
+func (c compressed[T]) swap() compressed[T] {
+	s := compressed[T]{
+		major:  c.minor,
+		minor:  c.major,
+		ptr:    make([]int, c.minor+1),
+		index:  make([]int, len(c.index)),
+		values: make([]T, len(c.values)),
+	}
+	for _, j := range c.index {
+		s.ptr[j+1]++
+	}
+	for j := 0; j < c.minor; j++ {
+		s.ptr[j+1] += s.ptr[j]
+	}
+	next := append([]int(nil), s.ptr[:c.minor]...)
+	for i := 0; i < c.major; i++ {
+		for k := c.ptr[i]; k < c.ptr[i+1]; k++ {
+			j := c.index[k]
+			s.index[next[j]] = i
+			s.values[next[j]] = c.values[k]
+			next[j]++
+		}
+	}
+	return s
+}
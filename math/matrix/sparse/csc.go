@@ -0,0 +1,112 @@
+// csc.go
+// description: Compressed sparse column matrix
+// details:
+// CSC stores the nonzero entries of a matrix column by column. It is the
+// transpose of CSR: reading a column is fast, and the product with a vector is
+// computed by scattering every column scaled by the matching element of the
+// vector into the result.
+// MulVec: O(rows + columns + nonzeros), At: O(log(nonzeros in the column)), Transpose: O(rows + columns + nonzeros)
+// space complexity: O(columns + nonzeros)
+// reference: https://en.wikipedia.org/wiki/Sparse_matrix#Compressed_sparse_column_(CSC_or_CCS)
+// see sparse_test.go
+
+package sparse
+
+import (
+	"errors"
+
+	"github.com/TheAlgorithms/Go/constraints"
+)
+
+// CSC is a sparse matrix in compressed sparse column format.
+type CSC[T constraints.Number] struct {
+	c compressed[T]
+}
+
+// NewCSC creates a rows x columns matrix from its entries. Entries at the same
+// position are summed, and entries with a zero value are stored explicitly.
+func NewCSC[T constraints.Number](rows, columns int, entries []Entry[T]) (*CSC[T], error) {
+	swapped := make([]Entry[T], len(entries))
+	for i, e := range entries {
+		swapped[i] = Entry[T]{Row: e.Column, Column: e.Row, Value: e.Value}
+	}
+	c, err := compress(columns, rows, swapped)
+	if err != nil {
+		return nil, err
+	}
+	return &CSC[T]{c: c}, nil
+}
+
+// CSCFromDense creates a matrix holding the nonzero elements of dense.
+func CSCFromDense[T constraints.Number](dense [][]T) (*CSC[T], error) {
+	c, err := compressDense(dense, true)
+	if err != nil {
+		return nil, err
+	}
+	return &CSC[T]{c: c}, nil
+}
+
+// Rows returns the number of rows of the matrix.
+func (m *CSC[T]) Rows() int {
+	return m.c.minor
+}
+
+// Columns returns the number of columns of the matrix.
+func (m *CSC[T]) Columns() int {
+	return m.c.major
+}
+
+// NonZeros returns the number of stored entries.
+func (m *CSC[T]) NonZeros() int {
+	return len(m.c.values)
+}
+
+// At returns the element at row i and column j.
+func (m *CSC[T]) At(i, j int) T {
+	return m.c.at(j, i)
+}
+
+// Column returns the rows and values of the entries stored in column j, in
+// increasing order of row. The slices share the storage of the matrix and
+// must not be modified.
+func (m *CSC[T]) Column(j int) (rows []int, values []T) {
+	return m.c.line(j)
+}
+
+// MulVec returns the product of the matrix and the column vector x.
+func (m *CSC[T]) MulVec(x []T) ([]T, error) {
+	if len(x) != m.c.major {
+		return nil, errors.New("vector length must match the number of columns")
+	}
+	y := make([]T, m.c.minor)
+	for j, xj := range x {
+		for k := m.c.ptr[j]; k < m.c.ptr[j+1]; k++ {
+			y[m.c.index[k]] += m.c.values[k] * xj
+		}
+	}
+	return y, nil
+}
+
+// Transpose returns the transpose of the matrix.
+func (m *CSC[T]) Transpose() *CSC[T] {
+	return &CSC[T]{c: m.c.swap()}
+}
+
+// CSR returns the same matrix in compressed sparse row format.
+func (m *CSC[T]) CSR() *CSR[T] {
+	return &CSR[T]{c: m.c.swap()}
+}
+
+// Dense returns the matrix as a slice of rows.
+func (m *CSC[T]) Dense() [][]T {
+	dense := make([][]T, m.c.minor)
+	for i := range dense {
+		dense[i] = make([]T, m.c.major)
+	}
+	for j := 0; j < m.c.major; j++ {
+		for k := m.c.ptr[j]; k < m.c.ptr[j+1]; k++ {
+			dense[m.c.index[k]][j] = m.c.values[k]
+		}
+	}
+	return dense
+}
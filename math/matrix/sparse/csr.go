@@ -0,0 +1,108 @@
+// csr.go
+// description: Compressed sparse row matrix
+// details:
+// CSR stores the nonzero entries of a matrix row by row, which makes reading a
+// row and multiplying the matrix by a vector fast and cache friendly. It is
+// the format of choice for row oriented work such as iterating over the
+// neighbours of a vertex in a graph stored as an adjacency matrix.
+// MulVec: O(rows + nonzeros), At: O(log(nonzeros in the row)), Transpose: O(rows + columns + nonzeros)
+// space complexity: O(rows + nonzeros)
+// reference: https://en.wikipedia.org/wiki/Sparse_matrix#Compressed_sparse_row_(CSR,_CRS_or_Yale_format)
+// see sparse_test.go
+
+package sparse
+
+import (
+	"errors"
+
+	"github.com/TheAlgorithms/Go/constraints"
+)
+
+// CSR is a sparse matrix in compressed sparse row format.
+type CSR[T constraints.Number] struct {
+	c compressed[T]
+}
+
+// NewCSR creates a rows x columns matrix from its entries. Entries at the same
+// position are summed, and entries with a zero value are stored explicitly.
+func NewCSR[T constraints.Number](rows, columns int, entries []Entry[T]) (*CSR[T], error) {
+	c, err := compress(rows, columns, entries)
+	if err != nil {
+		return nil, err
+	}
+	return &CSR[T]{c: c}, nil
+}
+
+// CSRFromDense creates a matrix holding the nonzero elements of dense.
+func CSRFromDense[T constraints.Number](dense [][]T) (*CSR[T], error) {
+	c, err := compressDense(dense, false)
+	if err != nil {
+		return nil, err
+	}
+	return &CSR[T]{c: c}, nil
+}
+
+// Rows returns the number of rows of the matrix.
+func (m *CSR[T]) Rows() int {
+	return m.c.major
+}
+
+// Columns returns the number of columns of the matrix.
+func (m *CSR[T]) Columns() int {
+	return m.c.minor
+}
+
+// NonZeros returns the number of stored entries.
+func (m *CSR[T]) NonZeros() int {
+	return len(m.c.values)
+}
+
+// At returns the element at row i and column j.
+func (m *CSR[T]) At(i, j int) T {
+	return m.c.at(i, j)
+}
+
+// Row returns the columns and values of the entries stored in row i, in
+// increasing order of column. The slices share the storage of the matrix and
+// must not be modified.
+func (m *CSR[T]) Row(i int) (columns []int, values []T) {
+	return m.c.line(i)
+}
+
+// MulVec returns the product of the matrix and the column vector x.
+func (m *CSR[T]) MulVec(x []T) ([]T, error) {
+	if len(x) != m.c.minor {
+		return nil, errors.New("vector length must match the number of columns")
+	}
+	y := make([]T, m.c.major)
+	for i := range y {
+		var sum T
+		for k := m.c.ptr[i]; k < m.c.ptr[i+1]; k++ {
+			sum += m.c.values[k] * x[m.c.index[k]]
+		}
+		y[i] = sum
+	}
+	return y, nil
+}
+
+// Transpose returns the transpose of the matrix.
+func (m *CSR[T]) Transpose() *CSR[T] {
+	return &CSR[T]{c: m.c.swap()}
+}
+
+// CSC returns the same matrix in compressed sparse column format.
+func (m *CSR[T]) CSC() *CSC[T] {
+	return &CSC[T]{c: m.c.swap()}
+}
+
+// Dense returns the matrix as a slice of rows.
+func (m *CSR[T]) Dense() [][]T {
+	dense := make([][]T, m.c.major)
+	for i := range dense {
+		dense[i] = make([]T, m.c.minor)
+		for k := m.c.ptr[i]; k < m.c.ptr[i+1]; k++ {
+			dense[i][m.c.index[k]] = m.c.values[k]
+		}
+	}
+	return dense
+}
@@ -0,0 +1,153 @@
+package sparse_test
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/math/matrix/sparse"
+)
+
+var dense = [][]int{
+	{5, 0, 0, 0},
+	{0, 8, 0, 0},
+	{0, 0, 3, 0},
+	{0, 6, 0, -1},
+	{0, 0, 0, 0},
+}
+
+func TestCSR(t *testing.T) {
+	m, err := sparse.CSRFromDense(dense)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Rows() != 5 || m.Columns() != 4 || m.NonZeros() != 5 {
+		t.Fatalf("got %dx%d with %d entries, want 5x4 with 5", m.Rows(), m.Columns(), m.NonZeros())
+	}
+	if got := m.At(3, 3); got != -1 {
+		t.Errorf("At(3, 3) = %d, want -1", got)
+	}
+	if got := m.At(3, 2); got != 0 {
+		t.Errorf("At(3, 2) = %d, want 0", got)
+	}
+	columns, values := m.Row(3)
+	if !reflect.DeepEqual(columns, []int{1, 3}) || !reflect.DeepEqual(values, []int{6, -1}) {
+		t.Errorf("Row(3) = %v, %v, want [1 3], [6 -1]", columns, values)
+	}
+	if got := m.Dense(); !reflect.DeepEqual(got, dense) {
+		t.Errorf("Dense() = %v, want %v", got, dense)
+	}
+
+	y, err := m.MulVec([]int{1, 2, 3, 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []int{5, 16, 9, 8, 0}; !reflect.DeepEqual(y, want) {
+		t.Errorf("MulVec() = %v, want %v", y, want)
+	}
+	if _, err := m.MulVec([]int{1}); err == nil {
+		t.Errorf("MulVec with a short vector should fail")
+	}
+}
+
+func TestCSC(t *testing.T) {
+	m, err := sparse.CSCFromDense(dense)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Rows() != 5 || m.Columns() != 4 || m.NonZeros() != 5 {
+		t.Fatalf("got %dx%d with %d entries, want 5x4 with 5", m.Rows(), m.Columns(), m.NonZeros())
+	}
+	rows, values := m.Column(1)
+	if !reflect.DeepEqual(rows, []int{1, 3}) || !reflect.DeepEqual(values, []int{8, 6}) {
+		t.Errorf("Column(1) = %v, %v, want [1 3], [8 6]", rows, values)
+	}
+	if got := m.Dense(); !reflect.DeepEqual(got, dense) {
+		t.Errorf("Dense() = %v, want %v", got, dense)
+	}
+	y, _ := m.MulVec([]int{1, 2, 3, 4})
+	if want := []int{5, 16, 9, 8, 0}; !reflect.DeepEqual(y, want) {
+		t.Errorf("MulVec() = %v, want %v", y, want)
+	}
+	if got := m.CSR().Dense(); !reflect.DeepEqual(got, dense) {
+		t.Errorf("CSR().Dense() = %v, want %v", got, dense)
+	}
+}
+
+func TestEntries(t *testing.T) {
+	entries := []sparse.Entry[float64]{{2, 0, 1.5}, {0, 1, 2}, {2, 0, 0.5}, {1, 2, 0}}
+	m, err := sparse.NewCSR(3, 3, entries)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The duplicates are summed and the explicit zero is kept.
+	if m.NonZeros() != 3 || m.At(2, 0) != 2 {
+		t.Errorf("got %d entries and At(2, 0) = %v, want 3 and 2", m.NonZeros(), m.At(2, 0))
+	}
+	c, err := sparse.NewCSC(3, 3, entries)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(c.Dense(), m.Dense()) {
+		t.Errorf("CSC %v and CSR %v differ", c.Dense(), m.Dense())
+	}
+
+	for _, e := range []sparse.Entry[float64]{{3, 0, 1}, {0, -1, 1}} {
+		if _, err := sparse.NewCSR(3, 3, []sparse.Entry[float64]{e}); err == nil {
+			t.Errorf("NewCSR with entry %v should fail", e)
+		}
+		if _, err := sparse.NewCSC(3, 3, []sparse.Entry[float64]{e}); err == nil {
+			t.Errorf("NewCSC with entry %v should fail", e)
+		}
+	}
+	if _, err := sparse.CSRFromDense([][]int{{1, 2}, {3}}); err == nil {
+		t.Errorf("CSRFromDense of a ragged matrix should fail")
+	}
+}
+
+func TestTransposeAgainstDense(t *testing.T) {
+	rnd := rand.New(rand.NewSource(2))
+	for round := 0; round < 50; round++ {
+		rows, columns := 1+rnd.Intn(12), 1+rnd.Intn(12)
+		a := make([][]int, rows)
+		for i := range a {
+			a[i] = make([]int, columns)
+			for j := range a[i] {
+				if rnd.Intn(4) == 0 {
+					a[i][j] = rnd.Intn(19) - 9
+				}
+			}
+		}
+		at := make([][]int, columns)
+		for j := range at {
+			at[j] = make([]int, rows)
+			for i := range a {
+				at[j][i] = a[i][j]
+			}
+		}
+		x := make([]int, columns)
+		want := make([]int, rows)
+		for j := range x {
+			x[j] = rnd.Intn(11) - 5
+		}
+		for i := range a {
+			for j := range x {
+				want[i] += a[i][j] * x[j]
+			}
+		}
+
+		m, _ := sparse.CSRFromDense(a)
+		if got := m.Transpose().Dense(); !reflect.DeepEqual(got, at) {
+			t.Fatalf("CSR Transpose() = %v, want %v", got, at)
+		}
+		if got := m.CSC().Transpose().Dense(); !reflect.DeepEqual(got, at) {
+			t.Fatalf("CSC Transpose() = %v, want %v", got, at)
+		}
+		if got, _ := m.MulVec(x); !reflect.DeepEqual(got, want) {
+			t.Fatalf("CSR MulVec() = %v, want %v", got, want)
+		}
+		if got, _ := m.CSC().MulVec(x); !reflect.DeepEqual(got, want) {
+			t.Fatalf("CSC MulVec() = %v, want %v", got, want)
+		}
+	}
+}
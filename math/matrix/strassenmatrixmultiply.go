@@ -4,226 +4,140 @@
 // This program takes two matrices as input and performs matrix multiplication
 // using the Strassen algorithm, which is an optimized divide-and-conquer
 // approach. It allows for efficient multiplication of large matrices.
+// Both matrices are split into four blocks, and seven block products are
+// combined instead of eight. The extra additions and allocations only pay
+// off for large blocks, so below a threshold size the recursion switches to
+// the classical product. Matrices of any compatible shapes are padded with
+// zeros to the next power of two and the result is cropped.
 // time complexity: O(n^2.81)
 // space complexity: O(n^2)
+// reference: https://en.wikipedia.org/wiki/Strassen_algorithm
 // author(s): Mohit Raghav(https://github.com/mohit07raghav19)
 // See strassenmatrixmultiply_test.go for test cases
 package matrix
 
-// Perform matrix multiplication using Strassen's algorithm
-func (A Matrix[T]) StrassenMatrixMultiply(B Matrix[T]) (Matrix[T], error) {
-	n := A.rows
-	// Check if matrices are 2x2 or smaller
-	if n == 1 {
-		a1, err := A.Get(0, 0)
-		if err != nil {
-			return Matrix[T]{}, err
-		}
-		b1, err := B.Get(0, 0)
-		if err != nil {
-			return Matrix[T]{}, err
-		}
-		result := New(1, 1, a1*b1)
-		return result, nil
-	} else {
-		// Calculate the size of submatrices
-		mid := n / 2
-
-		// Create submatrices
-		A11, err := A.SubMatrix(0, 0, mid, mid)
-		if err != nil {
-			return Matrix[T]{}, err
-		}
-		A12, err := A.SubMatrix(0, mid, mid, n-mid)
-		if err != nil {
-			return Matrix[T]{}, err
-		}
-		A21, err := A.SubMatrix(mid, 0, n-mid, mid)
-		if err != nil {
-			return Matrix[T]{}, err
-		}
-		A22, err := A.SubMatrix(mid, mid, n-mid, n-mid)
-		if err != nil {
-			return Matrix[T]{}, err
-		}
-
-		B11, err := B.SubMatrix(0, 0, mid, mid)
-		if err != nil {
-			return Matrix[T]{}, err
-		}
-		B12, err := B.SubMatrix(0, mid, mid, n-mid)
-		if err != nil {
-			return Matrix[T]{}, err
-		}
-		B21, err := B.SubMatrix(mid, 0, n-mid, mid)
-		if err != nil {
-			return Matrix[T]{}, err
-		}
-		B22, err := B.SubMatrix(mid, mid, n-mid, n-mid)
-		if err != nil {
-			return Matrix[T]{}, err
-		}
-
-		// Calculate result submatrices
-		A1, err := A11.Add(A22)
-		if err != nil {
-			return Matrix[T]{}, err
-		}
-
-		A2, err := B11.Add(B22)
-		if err != nil {
-			return Matrix[T]{}, err
-		}
-
-		A3, err := A21.Add(A22)
-		if err != nil {
-			return Matrix[T]{}, err
-		}
-
-		A4, err := A11.Add(A12)
-		if err != nil {
-			return Matrix[T]{}, err
-		}
-
-		A5, err := B11.Add(B12)
-		if err != nil {
-			return Matrix[T]{}, err
-		}
-
-		A6, err := B21.Add(B22)
-		if err != nil {
-			return Matrix[T]{}, err
-		}
-		//
-		S1, err := B12.Subtract(B22)
-		if err != nil {
-			return Matrix[T]{}, err
-		}
-		S2, err := B21.Subtract(B11)
-		if err != nil {
-			return Matrix[T]{}, err
-		}
-		S3, err := A21.Subtract(A11)
-		if err != nil {
-			return Matrix[T]{}, err
-		}
-		S4, err := A12.Subtract(A22)
-		if err != nil {
-			return Matrix[T]{}, err
-		}
-		// Recursive steps
-		M1, err := A1.StrassenMatrixMultiply(A2)
-		if err != nil {
-			return Matrix[T]{}, err
-		}
-		M2, err := A3.StrassenMatrixMultiply(B11)
-		if err != nil {
-			return Matrix[T]{}, err
-		}
-		M3, err := A11.StrassenMatrixMultiply(S1)
-		if err != nil {
-			return Matrix[T]{}, err
-		}
-		M4, err := A22.StrassenMatrixMultiply(S2)
-		if err != nil {
-			return Matrix[T]{}, err
-		}
-		M5, err := A4.StrassenMatrixMultiply(B22)
-		if err != nil {
-			return Matrix[T]{}, err
-		}
-		M6, err := S3.StrassenMatrixMultiply(A5)
-		if err != nil {
-			return Matrix[T]{}, err
-		}
-		M7, err := S4.StrassenMatrixMultiply(A6)
+import "github.com/TheAlgorithms/Go/constraints"
 
-		if err != nil {
-			return Matrix[T]{}, err
-		} //
-		A7, err := M1.Add(M4)
+// DefaultStrassenThreshold is the block size at or below which
+// StrassenMatrixMultiply uses the classical algorithm.
+const DefaultStrassenThreshold = 64
 
-		if err != nil {
-			return Matrix[T]{}, err
-		}
-		A8, err := A7.Add(M7)
-
-		if err != nil {
-			return Matrix[T]{}, err
-		}
-		A9, err := M1.Add(M3)
-
-		if err != nil {
-			return Matrix[T]{}, err
-		}
-		A10, err := A9.Add(M6)
-
-		if err != nil {
-			return Matrix[T]{}, err
-		}
-		// Calculate result submatrices
-		C11, err := A8.Subtract(M5)
-		if err != nil {
-			return Matrix[T]{}, err
-		}
-		C12, err := M3.Add(M5)
-		if err != nil {
-			return Matrix[T]{}, err
-		}
-		C21, err := M2.Add(M4)
-		if err != nil {
-			return Matrix[T]{}, err
-		}
-		C22, err := A10.Subtract(M2)
-		if err != nil {
-			return Matrix[T]{}, err
-		}
+// StrassenMatrixMultiply multiplies A by B with Strassen's algorithm,
+// switching to the classical algorithm for blocks of
+// DefaultStrassenThreshold rows or less.
+func (A Matrix[T]) StrassenMatrixMultiply(B Matrix[T]) (Matrix[T], error) {
+	return A.StrassenMatrixMultiplyThreshold(B, DefaultStrassenThreshold)
+}
 
-		// Combine subMatrices into the result matrix
-		var zeroVal T
-		C := New(n, n, zeroVal)
+// StrassenMatrixMultiplyThreshold is StrassenMatrixMultiply switching to the
+// classical algorithm for blocks of threshold rows or less. A threshold of 1
+// recurses down to single elements, and a non-positive threshold means
+// DefaultStrassenThreshold.
+func (A Matrix[T]) StrassenMatrixMultiplyThreshold(B Matrix[T], threshold int) (Matrix[T], error) {
+	if A.Columns() != B.Rows() {
+		return Matrix[T]{}, errMultiplyDimensions
+	}
+	if threshold <= 0 {
+		threshold = DefaultStrassenThreshold
+	}
+	size := 1
+	for size < A.Rows() || size < A.Columns() || size < B.Columns() {
+		size *= 2
+	}
+	c := strassen(pad(A.elements, size), pad(B.elements, size), threshold)
 
-		for i := 0; i < mid; i++ {
-			for j := 0; j < mid; j++ {
-				val, err := C11.Get(i, j)
-				if err != nil {
-					return Matrix[T]{}, err
-				}
+	var zeroVal T
+	C := New(A.Rows(), B.Columns(), zeroVal)
+	for i := range C.elements {
+		copy(C.elements[i], c[i][:B.Columns()])
+	}
+	return C, nil
+}
 
-				err = C.Set(i, j, val)
-				if err != nil {
-					return Matrix[T]{}, err
-				}
+// pad copies elements into the top left corner of a size x size block of zeros.
+func pad[T any](elements [][]T, size int) [][]T {
+	padded := square[T](size)
+	for i, row := range elements {
+		copy(padded[i], row)
+	}
+	return padded
+}
 
-				val, err = C12.Get(i, j)
-				if err != nil {
-					return Matrix[T]{}, err
-				}
+func square[T any](size int) [][]T {
+	block := make([][]T, size)
+	for i := range block {
+		block[i] = make([]T, size)
+	}
+	return block
+}
 
-				err1 := C.Set(i, j+mid, val)
-				if err1 != nil {
-					return Matrix[T]{}, err1
-				}
-				val, err = C21.Get(i, j)
-				if err != nil {
-					return Matrix[T]{}, err
-				}
+// quadrant returns a view of the size x size block of a starting at (row, col).
+func quadrant[T any](a [][]T, row, col, size int) [][]T {
+	q := make([][]T, size)
+	for i := range q {
+		q[i] = a[row+i][col : col+size]
+	}
+	return q
+}
 
-				err2 := C.Set(i+mid, j, val)
-				if err2 != nil {
-					return Matrix[T]{}, err2
-				}
-				val, err = C22.Get(i, j)
-				if err != nil {
-					return Matrix[T]{}, err
+// combine returns the element-wise sum of a and the blocks bs, each added or
+// subtracted according to signs.
+func combine[T constraints.Number](a [][]T, bs [][][]T, signs []int) [][]T {
+	n := len(a)
+	c := square[T](n)
+	for i := 0; i < n; i++ {
+		copy(c[i], a[i])
+		for k, b := range bs {
+			for j := 0; j < n; j++ {
+				if signs[k] > 0 {
+					c[i][j] += b[i][j]
+				} else {
+					c[i][j] -= b[i][j]
 				}
+			}
+		}
+	}
+	return c
+}
 
-				err3 := C.Set(i+mid, j+mid, val)
-				if err3 != nil {
-					return Matrix[T]{}, err3
+func strassen[T constraints.Number](a, b [][]T, threshold int) [][]T {
+	n := len(a)
+	if n <= threshold {
+		c := square[T](n)
+		for i := 0; i < n; i++ {
+			for k := 0; k < n; k++ {
+				aik := a[i][k]
+				for j := 0; j < n; j++ {
+					c[i][j] += aik * b[k][j]
 				}
 			}
 		}
-		return C, nil
+		return c
+	}
+	h := n / 2
+	a11, a12, a21, a22 := quadrant(a, 0, 0, h), quadrant(a, 0, h, h), quadrant(a, h, 0, h), quadrant(a, h, h, h)
+	b11, b12, b21, b22 := quadrant(b, 0, 0, h), quadrant(b, 0, h, h), quadrant(b, h, 0, h), quadrant(b, h, h, h)
+	plus, minus := []int{1}, []int{-1}
+
+	m1 := strassen(combine(a11, [][][]T{a22}, plus), combine(b11, [][][]T{b22}, plus), threshold)
+	m2 := strassen(combine(a21, [][][]T{a22}, plus), b11, threshold)
+	m3 := strassen(a11, combine(b12, [][][]T{b22}, minus), threshold)
+	m4 := strassen(a22, combine(b21, [][][]T{b11}, minus), threshold)
+	m5 := strassen(combine(a11, [][][]T{a12}, plus), b22, threshold)
+	m6 := strassen(combine(a21, [][][]T{a11}, minus), combine(b11, [][][]T{b12}, plus), threshold)
+	m7 := strassen(combine(a12, [][][]T{a22}, minus), combine(b21, [][][]T{b22}, plus), threshold)
+
+	c11 := combine(m1, [][][]T{m4, m5, m7}, []int{1, -1, 1})
+	c12 := combine(m3, [][][]T{m5}, plus)
+	c21 := combine(m2, [][][]T{m4}, plus)
+	c22 := combine(m1, [][][]T{m2, m3, m6}, []int{-1, 1, 1})
+
+	c := square[T](n)
+	for i := 0; i < h; i++ {
+		copy(c[i], c11[i])
+		copy(c[i][h:], c12[i])
+		copy(c[i+h], c21[i])
+		copy(c[i+h][h:], c22[i])
 	}
+	return c
 }
@@ -129,3 +129,43 @@ func BenchmarkStrassenMatrixMultiply(b *testing.B) {
 		_, _ = m1.StrassenMatrixMultiply(m2)
 	}
 }
+
+func TestStrassenMatrixMultiplyShapes(t *testing.T) {
+	rnd := rand.New(rand.NewSource(6))
+	shapes := [][3]int{{1, 1, 1}, {2, 3, 4}, {5, 5, 5}, {17, 9, 33}, {64, 64, 64}, {70, 65, 3}}
+	for _, threshold := range []int{1, 4, 0} {
+		for _, shape := range shapes {
+			a := randomIntMatrix(rnd, shape[0], shape[1])
+			b := randomIntMatrix(rnd, shape[1], shape[2])
+			want, _ := a.MultiplyNaive(b)
+			got, err := a.StrassenMatrixMultiplyThreshold(b, threshold)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !got.CheckEqual(want) {
+				t.Errorf("StrassenMatrixMultiplyThreshold of %v with threshold %d differs from MultiplyNaive", shape, threshold)
+			}
+		}
+	}
+
+	a := randomIntMatrix(rnd, 2, 3)
+	if _, err := a.StrassenMatrixMultiply(a); err == nil {
+		t.Errorf("StrassenMatrixMultiply of a 2x3 by a 2x3 matrix should fail")
+	}
+}
+
+func BenchmarkStrassenMatrixMultiplyThreshold(b *testing.B) {
+	rnd := rand.New(rand.NewSource(1))
+	m1 := randomIntMatrix(rnd, 256, 256)
+	m2 := randomIntMatrix(rnd, 256, 256)
+	b.Run("naive", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = m1.MultiplyNaive(m2)
+		}
+	})
+	b.Run("strassen", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			_, _ = m1.StrassenMatrixMultiply(m2)
+		}
+	})
+}
@@ -0,0 +1,85 @@
+package matrix_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/math/matrix"
+)
+
+func randomIntMatrix(rnd *rand.Rand, rows, columns int) matrix.Matrix[int] {
+	elements := make([][]int, rows)
+	for i := range elements {
+		elements[i] = make([]int, columns)
+		for j := range elements[i] {
+			elements[i][j] = rnd.Intn(21) - 10
+		}
+	}
+	m, _ := matrix.NewFromElements(elements)
+	return m
+}
+
+func TestTiledMultiply(t *testing.T) {
+	rnd := rand.New(rand.NewSource(4))
+	shapes := [][3]int{{1, 1, 1}, {3, 5, 2}, {17, 9, 33}, {64, 64, 64}, {70, 65, 3}}
+	for _, shape := range shapes {
+		a := randomIntMatrix(rnd, shape[0], shape[1])
+		b := randomIntMatrix(rnd, shape[1], shape[2])
+		want, err := a.Multiply(b)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, tile := range []int{0, 1, 4, 16} {
+			results := map[string]func() (matrix.Matrix[int], error){
+				"MultiplyNaive":    func() (matrix.Matrix[int], error) { return a.MultiplyNaive(b) },
+				"MultiplyTiled":    func() (matrix.Matrix[int], error) { return a.MultiplyTiled(b, tile) },
+				"MultiplyParallel": func() (matrix.Matrix[int], error) { return a.MultiplyParallel(b, tile) },
+			}
+			for name, multiply := range results {
+				got, err := multiply()
+				if err != nil {
+					t.Fatalf("%s(%v, tile %d) returned error %v", name, shape, tile, err)
+				}
+				if !got.CheckEqual(want) {
+					t.Errorf("%s(%v, tile %d) differs from Multiply", name, shape, tile)
+				}
+			}
+		}
+	}
+}
+
+func TestTiledMultiplyIncompatible(t *testing.T) {
+	a := matrix.New(2, 3, 1)
+	b := matrix.New(2, 3, 1)
+	if _, err := a.MultiplyNaive(b); err == nil {
+		t.Errorf("MultiplyNaive of incompatible matrices should fail")
+	}
+	if _, err := a.MultiplyTiled(b, 0); err == nil {
+		t.Errorf("MultiplyTiled of incompatible matrices should fail")
+	}
+	if _, err := a.MultiplyParallel(b, 0); err == nil {
+		t.Errorf("MultiplyParallel of incompatible matrices should fail")
+	}
+}
+
+func benchmarkMultiply(b *testing.B, multiply func(x, y matrix.Matrix[int]) (matrix.Matrix[int], error)) {
+	rnd := rand.New(rand.NewSource(1))
+	x := randomIntMatrix(rnd, 512, 512)
+	y := randomIntMatrix(rnd, 512, 512)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = multiply(x, y)
+	}
+}
+
+func BenchmarkMultiplyNaive(b *testing.B) {
+	benchmarkMultiply(b, matrix.Matrix[int].MultiplyNaive)
+}
+
+func BenchmarkMultiplyTiled(b *testing.B) {
+	benchmarkMultiply(b, func(x, y matrix.Matrix[int]) (matrix.Matrix[int], error) { return x.MultiplyTiled(y, 0) })
+}
+
+func BenchmarkMultiplyParallel(b *testing.B) {
+	benchmarkMultiply(b, func(x, y matrix.Matrix[int]) (matrix.Matrix[int], error) { return x.MultiplyParallel(y, 0) })
+}
@@ -0,0 +1,20 @@
+// transpose.go
+// description: Transpose of a matrix
+// details: The transpose of an n x m matrix A is the m x n matrix whose element (j, i) is A(i, j).
+// time complexity: O(n*m)
+// space complexity: O(n*m)
+// see transpose_test.go
+
+package matrix
+
+// Transpose returns the transpose of the matrix.
+func (m Matrix[T]) Transpose() Matrix[T] {
+	var zeroVal T
+	result := New(m.Columns(), m.Rows(), zeroVal)
+	for i, row := range m.elements {
+		for j, v := range row {
+			result.elements[j][i] = v
+		}
+	}
+	return result
+}
@@ -0,0 +1,22 @@
+package matrix_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/math/matrix"
+)
+
+func TestTranspose(t *testing.T) {
+	m, _ := matrix.NewFromElements([][]int{{1, 2, 3}, {4, 5, 6}})
+	want, _ := matrix.NewFromElements([][]int{{1, 4}, {2, 5}, {3, 6}})
+	if got := m.Transpose(); !got.CheckEqual(want) {
+		t.Errorf("Transpose() = %v, want %v", got, want)
+	}
+
+	rnd := rand.New(rand.NewSource(2))
+	a := randomIntMatrix(rnd, 7, 4)
+	if !a.Transpose().Transpose().CheckEqual(a) {
+		t.Errorf("transposing twice should give back the matrix")
+	}
+}
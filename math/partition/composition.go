@@ -0,0 +1,99 @@
+// composition.go
+// description: Counting and enumerating compositions of an integer
+// details:
+// A composition of n into k parts writes n as an ordered sum of k positive
+// parts: 3 = 2+1 = 1+2 are two different compositions into 2 parts. Placing
+// n stars in a row, a composition into k parts is a choice of k-1 of the n-1
+// gaps between them to cut, so there are C(n-1, k-1) of them. Compositions
+// are enumerated in lexicographic order by walking through the choices of
+// cuts in lexicographic order.
+// time complexity: O(k) per composition
+// space complexity: O(k)
+// reference: https://en.wikipedia.org/wiki/Composition_(combinatorics)
+// see composition_test.go
+
+package partition
+
+import "math/big"
+
+// CountCompositions returns the number of compositions of n into k parts.
+func CountCompositions(n, k int) *big.Int {
+	if n == 0 && k == 0 {
+		return big.NewInt(1)
+	}
+	if n < 1 || k < 1 || k > n {
+		return new(big.Int)
+	}
+	return new(big.Int).Binomial(int64(n-1), int64(k-1))
+}
+
+// CompositionIterator enumerates the compositions of an integer into a given
+// number of parts in lexicographic order.
+type CompositionIterator struct {
+	n       int
+	cuts    []int // cuts[i] is the sum of the first i+1 parts
+	parts   []int
+	started bool
+	done    bool
+}
+
+// Compositions returns an iterator over the compositions of n into k parts.
+// There is one composition of 0 into 0 parts, the empty one.
+func Compositions(n, k int) *CompositionIterator {
+	it := &CompositionIterator{n: n}
+	switch {
+	case n == 0 && k == 0:
+	case n < 1 || k < 1 || k > n:
+		it.done = true
+	default:
+		it.cuts = make([]int, k-1)
+		it.parts = make([]int, k)
+	}
+	return it
+}
+
+// Next advances to the next composition and reports whether there is one.
+// The first call moves to the first composition.
+func (it *CompositionIterator) Next() bool {
+	if it.done {
+		return false
+	}
+	m := len(it.cuts)
+	if !it.started {
+		it.started = true
+		// the first parts as small as possible: 1+...+1+(n-m)
+		for i := range it.cuts {
+			it.cuts[i] = i + 1
+		}
+	} else {
+		// the rightmost cut that can move right, the cuts after it packed
+		// right behind it
+		i := m - 1
+		for i >= 0 && it.cuts[i] == it.n-m+i {
+			i--
+		}
+		if i < 0 {
+			it.done = true
+			return false
+		}
+		it.cuts[i]++
+		for j := i + 1; j < m; j++ {
+			it.cuts[j] = it.cuts[j-1] + 1
+		}
+	}
+	previous := 0
+	for i, cut := range it.cuts {
+		it.parts[i] = cut - previous
+		previous = cut
+	}
+	if it.parts != nil {
+		it.parts[m] = it.n - previous
+	}
+	return true
+}
+
+// Composition returns the current composition. The slice is reused by Next
+// and must not be modified.
+func (it *CompositionIterator) Composition() []int {
+	return it.parts
+}
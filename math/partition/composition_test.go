@@ -0,0 +1,47 @@
+package partition
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCompositions(t *testing.T) {
+	var got [][]int
+	for it := Compositions(5, 3); it.Next(); {
+		got = append(got, append([]int(nil), it.Composition()...))
+	}
+	want := [][]int{{1, 1, 3}, {1, 2, 2}, {1, 3, 1}, {2, 1, 2}, {2, 2, 1}, {3, 1, 1}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Compositions(5, 3) = %v, want %v", got, want)
+	}
+
+	for n := 0; n <= 12; n++ {
+		for k := 0; k <= n+1; k++ {
+			count := 0
+			var previous []int
+			for it := Compositions(n, k); it.Next(); count++ {
+				c := it.Composition()
+				sum := 0
+				for _, part := range c {
+					if part < 1 {
+						t.Fatalf("Compositions(%d, %d) has a part %d", n, k, part)
+					}
+					sum += part
+				}
+				if sum != n || len(c) != k {
+					t.Fatalf("Compositions(%d, %d) gave %v", n, k, c)
+				}
+				if previous != nil && !lexLess(previous, c) {
+					t.Fatalf("Compositions(%d, %d) gave %v after %v", n, k, c, previous)
+				}
+				previous = append(previous[:0], c...)
+			}
+			if want := CountCompositions(n, k); int64(count) != want.Int64() {
+				t.Errorf("Compositions(%d, %d) gave %d compositions, want %v", n, k, count, want)
+			}
+		}
+	}
+	if CountCompositions(-1, 1).Sign() != 0 || CountCompositions(30, 10).Int64() != 10015005 {
+		t.Errorf("CountCompositions is wrong")
+	}
+}
@@ -0,0 +1,145 @@
+// count.go
+// description: Counting integer partitions, unrestricted and restricted
+// details:
+// A partition of n writes it as a sum of positive parts where the order does
+// not matter: 4 = 3+1 = 2+2 = 2+1+1 = 1+1+1+1, so p(4) = 5. Euler's
+// pentagonal number theorem gives the recurrence
+// p(n) = sum over k >= 1 of (-1)^(k+1) (p(n - k(3k-1)/2) + p(n - k(3k+1)/2)),
+// which only looks back at O(sqrt(n)) values, so p(0..n) takes O(n sqrt(n))
+// additions. Partitions into distinct parts and into odd parts are counted by
+// dynamic programming over the allowed parts, as in the coin change problem;
+// by another theorem of Euler both counts are always equal. Partitions into
+// exactly k parts follow p(n, k) = p(n-1, k-1) + p(n-k, k): either a part is
+// 1, or every part can be lowered by one.
+// The counts grow like e^(pi sqrt(2n/3)) and leave 64 bits at n = 417, so
+// they are returned as big integers.
+// time complexity: O(n sqrt(n)) for Count, O(n^2) for the others
+// space complexity: O(n), O(n k) for CountParts
+// reference: https://en.wikipedia.org/wiki/Partition_function_(number_theory)
+// reference: https://en.wikipedia.org/wiki/Pentagonal_number_theorem
+// see count_test.go
+
+// Package partition counts and enumerates integer partitions, unrestricted or
+// restricted to distinct or odd parts, and compositions of an integer.
+package partition
+
+import "math/big"
+
+// Counts returns p(0), p(1), ..., p(n), the numbers of partitions of 0 to n.
+func Counts(n int) []*big.Int {
+	if n < 0 {
+		return nil
+	}
+	p := make([]*big.Int, n+1)
+	p[0] = big.NewInt(1)
+	for m := 1; m <= n; m++ {
+		sum := new(big.Int)
+		for k := 1; ; k++ {
+			// the generalized pentagonal numbers k(3k-1)/2 and k(3k+1)/2
+			first := k * (3*k - 1) / 2
+			if first > m {
+				break
+			}
+			term := new(big.Int).Set(p[m-first])
+			if second := first + k; second <= m {
+				term.Add(term, p[m-second])
+			}
+			if k%2 == 1 {
+				sum.Add(sum, term)
+			} else {
+				sum.Sub(sum, term)
+			}
+		}
+		p[m] = sum
+	}
+	return p
+}
+
+// Count returns p(n), the number of partitions of n. It is 0 for a negative n.
+func Count(n int) *big.Int {
+	if n < 0 {
+		return new(big.Int)
+	}
+	return Counts(n)[n]
+}
+
+// countWithParts counts the partitions of n whose parts are the given
+// candidates, each used at most once when distinct is set. n must not be
+// negative.
+func countWithParts(n int, parts []int, distinct bool) *big.Int {
+	ways := make([]*big.Int, n+1)
+	for i := range ways {
+		ways[i] = new(big.Int)
+	}
+	ways[0].SetInt64(1)
+	for _, part := range parts {
+		if distinct {
+			// going down uses every part at most once, as in 0/1 knapsack
+			for s := n; s >= part; s-- {
+				ways[s].Add(ways[s], ways[s-part])
+			}
+		} else {
+			for s := part; s <= n; s++ {
+				ways[s].Add(ways[s], ways[s-part])
+			}
+		}
+	}
+	return ways[n]
+}
+
+// CountDistinct returns the number of partitions of n into distinct parts.
+// It is 0 for a negative n.
+func CountDistinct(n int) *big.Int {
+	if n < 0 {
+		return new(big.Int)
+	}
+	parts := make([]int, 0, n)
+	for part := 1; part <= n; part++ {
+		parts = append(parts, part)
+	}
+	return countWithParts(n, parts, true)
+}
+
+// CountOdd returns the number of partitions of n into odd parts, which is
+// always equal to CountDistinct(n). It is 0 for a negative n.
+func CountOdd(n int) *big.Int {
+	if n < 0 {
+		return new(big.Int)
+	}
+	parts := make([]int, 0, n/2+1)
+	for part := 1; part <= n; part += 2 {
+		parts = append(parts, part)
+	}
+	return countWithParts(n, parts, false)
+}
+
+// CountParts returns p(n, k), the number of partitions of n into exactly k
+// parts, which is also the number of partitions of n whose largest part is k.
+func CountParts(n, k int) *big.Int {
+	if n == 0 && k == 0 {
+		return big.NewInt(1)
+	}
+	if n < 0 || k <= 0 || k > n {
+		return new(big.Int)
+	}
+	// previous[m] is p(m, j-1) and row[m] is p(m, j)
+	previous := make([]*big.Int, n+1)
+	for m := range previous {
+		previous[m] = new(big.Int)
+	}
+	previous[0].SetInt64(1) // p(0, 0)
+	for j := 1; j <= k; j++ {
+		row := make([]*big.Int, n+1)
+		for m := range row {
+			row[m] = new(big.Int)
+			if m >= 1 {
+				row[m].Set(previous[m-1])
+			}
+			if m >= j {
+				row[m].Add(row[m], row[m-j])
+			}
+		}
+		previous = row
+	}
+	return previous[n]
+}
@@ -0,0 +1,73 @@
+package partition
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestCount(t *testing.T) {
+	// OEIS A000041
+	want := []int64{1, 1, 2, 3, 5, 7, 11, 15, 22, 30, 42, 56, 77, 101, 135, 176, 231, 297, 385, 490, 627}
+	counts := Counts(len(want) - 1)
+	for n, w := range want {
+		if counts[n].Int64() != w || Count(n).Int64() != w {
+			t.Errorf("p(%d) = %v, want %d", n, counts[n], w)
+		}
+	}
+	p100, _ := new(big.Int).SetString("190569292", 10)
+	if got := Count(100); got.Cmp(p100) != 0 {
+		t.Errorf("p(100) = %v, want %v", got, p100)
+	}
+	p1000, _ := new(big.Int).SetString("24061467864032622473692149727991", 10)
+	if got := Count(1000); got.Cmp(p1000) != 0 {
+		t.Errorf("p(1000) = %v, want %v", got, p1000)
+	}
+	if Count(-1).Sign() != 0 || Counts(-1) != nil {
+		t.Errorf("a negative number has no partitions")
+	}
+}
+
+func TestCountRestricted(t *testing.T) {
+	// OEIS A000009
+	want := []int64{1, 1, 1, 2, 2, 3, 4, 5, 6, 8, 10, 12, 15, 18, 22, 27, 32, 38, 46, 54, 64}
+	for n, w := range want {
+		if got := CountDistinct(n); got.Int64() != w {
+			t.Errorf("q(%d) = %v, want %d", n, got, w)
+		}
+	}
+	// Euler's theorem: as many partitions into odd parts as into distinct ones
+	for n := 0; n <= 300; n += 7 {
+		if d, o := CountDistinct(n), CountOdd(n); d.Cmp(o) != 0 {
+			t.Errorf("n = %d: %v distinct partitions, %v odd ones", n, d, o)
+		}
+	}
+	if CountDistinct(-3).Sign() != 0 || CountOdd(-3).Sign() != 0 {
+		t.Errorf("a negative number has no restricted partitions")
+	}
+}
+
+func TestCountParts(t *testing.T) {
+	for n := 0; n <= 60; n++ {
+		sum := new(big.Int)
+		for k := 0; k <= n; k++ {
+			sum.Add(sum, CountParts(n, k))
+		}
+		if sum.Cmp(Count(n)) != 0 {
+			t.Errorf("the p(%d, k) add up to %v, want p(%d) = %v", n, sum, n, Count(n))
+		}
+	}
+	tests := []struct{ n, k, want int }{
+		{7, 3, 4}, // 5+1+1, 4+2+1, 3+3+1, 3+2+2
+		{10, 1, 1},
+		{10, 10, 1},
+		{10, 11, 0},
+		{5, 0, 0},
+		{0, 0, 1},
+		{-1, 1, 0},
+	}
+	for _, test := range tests {
+		if got := CountParts(test.n, test.k); got.Int64() != int64(test.want) {
+			t.Errorf("p(%d, %d) = %v, want %d", test.n, test.k, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,118 @@
+// iterator.go
+// description: Enumeration of integer partitions
+// details:
+// The partitions of n are listed with their parts in non-increasing order,
+// from n itself down to 1+1+...+1, in reverse lexicographic order. The next
+// partition is found by lowering the rightmost part that can be lowered to
+// the largest allowed smaller value, and completing the sum greedily with the
+// largest allowed parts. Restricting the parts to odd numbers only changes
+// which values are allowed; distinct parts also require every part to be
+// smaller than the previous one, and a part can only be lowered if the rest
+// of the sum still fits in distinct smaller parts, 1+2+...+(v-1) >= rest.
+// time complexity: O(n) per partition
+// space complexity: O(n)
+// reference: https://en.wikipedia.org/wiki/Integer_partition
+// see iterator_test.go
+
+package partition
+
+// Iterator enumerates partitions of an integer in reverse lexicographic
+// order.
+type Iterator struct {
+	n        int
+	parts    []int
+	allowed  func(part int) bool
+	distinct bool
+	started  bool
+	done     bool
+}
+
+func anyPart(int) bool { return true }
+
+func odd(part int) bool { return part%2 == 1 }
+
+// Partitions returns an iterator over the partitions of n. There is one
+// partition of 0, the empty one, and none of a negative n.
+func Partitions(n int) *Iterator {
+	return &Iterator{n: n, allowed: anyPart}
+}
+
+// DistinctPartitions returns an iterator over the partitions of n into
+// distinct parts.
+func DistinctPartitions(n int) *Iterator {
+	return &Iterator{n: n, allowed: anyPart, distinct: true}
+}
+
+// OddPartitions returns an iterator over the partitions of n into odd parts.
+func OddPartitions(n int) *Iterator {
+	return &Iterator{n: n, allowed: odd}
+}
+
+// fill appends to the partition the greedy, lexicographically largest
+// completion of rest with parts no larger than limit, and reports whether
+// there is one. The partition is left unchanged when there is none.
+func (it *Iterator) fill(rest, limit int) bool {
+	length := len(it.parts)
+	for rest > 0 {
+		part := limit
+		if rest < part {
+			part = rest
+		}
+		for part > 0 && !it.allowed(part) {
+			part--
+		}
+		if part == 0 {
+			it.parts = it.parts[:length]
+			return false
+		}
+		it.parts = append(it.parts, part)
+		rest -= part
+		limit = part
+		if it.distinct {
+			limit--
+		}
+	}
+	return true
+}
+
+// Next advances to the next partition and reports whether there is one. The
+// first call moves to the first partition.
+func (it *Iterator) Next() bool {
+	if it.done {
+		return false
+	}
+	if !it.started {
+		it.started = true
+		it.done = it.n < 0 || !it.fill(it.n, it.n)
+		return !it.done
+	}
+	rest := 0
+	for i := len(it.parts) - 1; i >= 0; i-- {
+		rest += it.parts[i]
+		v := it.parts[i] - 1
+		for v > 0 && !it.allowed(v) {
+			v--
+		}
+		if v == 0 {
+			continue
+		}
+		it.parts = append(it.parts[:i], v)
+		limit := v
+		if it.distinct {
+			limit--
+		}
+		if it.fill(rest-v, limit) {
+			return true
+		}
+		// only distinct parts can fail, and a smaller v would leave even
+		// less room: try further left, which only needs parts[:i] and rest
+	}
+	it.done = true
+	return false
+}
+
+// Partition returns the current partition, its parts in non-increasing
+// order. The slice is reused by Next and must not be modified.
+func (it *Iterator) Partition() []int {
+	return it.parts
+}
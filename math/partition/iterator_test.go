@@ -0,0 +1,93 @@
+package partition
+
+import (
+	"reflect"
+	"testing"
+)
+
+// collect returns every partition enumerated by it.
+func collect(it *Iterator) [][]int {
+	var all [][]int
+	for it.Next() {
+		all = append(all, append([]int(nil), it.Partition()...))
+	}
+	return all
+}
+
+func TestPartitions(t *testing.T) {
+	want := [][]int{{5}, {4, 1}, {3, 2}, {3, 1, 1}, {2, 2, 1}, {2, 1, 1, 1}, {1, 1, 1, 1, 1}}
+	if got := collect(Partitions(5)); !reflect.DeepEqual(got, want) {
+		t.Errorf("Partitions(5) = %v, want %v", got, want)
+	}
+	if got := collect(DistinctPartitions(8)); !reflect.DeepEqual(got, [][]int{{8}, {7, 1}, {6, 2}, {5, 3}, {5, 2, 1}, {4, 3, 1}}) {
+		t.Errorf("DistinctPartitions(8) = %v", got)
+	}
+	if got := collect(OddPartitions(8)); !reflect.DeepEqual(got, [][]int{{7, 1}, {5, 3}, {5, 1, 1, 1}, {3, 3, 1, 1}, {3, 1, 1, 1, 1, 1}, {1, 1, 1, 1, 1, 1, 1, 1}}) {
+		t.Errorf("OddPartitions(8) = %v", got)
+	}
+	if got := collect(Partitions(0)); len(got) != 1 || len(got[0]) != 0 {
+		t.Errorf("Partitions(0) = %v, want the empty partition", got)
+	}
+	if got := collect(Partitions(-3)); got != nil {
+		t.Errorf("Partitions(-3) = %v, want none", got)
+	}
+}
+
+func TestPartitionsMatchCounts(t *testing.T) {
+	iterators := map[string]struct {
+		new   func(n int) *Iterator
+		count func(n int) int64
+		valid func(p []int) bool
+	}{
+		"all": {Partitions, func(n int) int64 { return Count(n).Int64() }, func([]int) bool { return true }},
+		"distinct": {DistinctPartitions, func(n int) int64 { return CountDistinct(n).Int64() }, func(p []int) bool {
+			for i := 1; i < len(p); i++ {
+				if p[i] == p[i-1] {
+					return false
+				}
+			}
+			return true
+		}},
+		"odd": {OddPartitions, func(n int) int64 { return CountOdd(n).Int64() }, func(p []int) bool {
+			for _, part := range p {
+				if part%2 == 0 {
+					return false
+				}
+			}
+			return true
+		}},
+	}
+	for name, test := range iterators {
+		for n := 0; n <= 25; n++ {
+			all := collect(test.new(n))
+			if int64(len(all)) != test.count(n) {
+				t.Errorf("%s(%d): %d partitions, want %d", name, n, len(all), test.count(n))
+			}
+			for i, p := range all {
+				sum := 0
+				for j, part := range p {
+					sum += part
+					if j > 0 && part > p[j-1] {
+						t.Fatalf("%s(%d): parts of %v not in non-increasing order", name, n, p)
+					}
+				}
+				if sum != n || !test.valid(p) {
+					t.Fatalf("%s(%d): invalid partition %v", name, n, p)
+				}
+				if i > 0 && !lexLess(p, all[i-1]) {
+					t.Fatalf("%s(%d): %v listed after %v", name, n, p, all[i-1])
+				}
+			}
+		}
+	}
+}
+
+// lexLess reports whether a comes before b in lexicographic order.
+func lexLess(a, b []int) bool {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i] != b[i] {
+			return a[i] < b[i]
+		}
+	}
+	return len(a) < len(b)
+}
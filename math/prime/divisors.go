@@ -0,0 +1,58 @@
+// divisors.go
+// description: Euler's totient and divisor functions from the prime factorization
+// details:
+// With n = p1^e1 * ... * pk^ek, Euler's totient is
+// phi(n) = n * (1 - 1/p1) * ... * (1 - 1/pk), the number of divisors is
+// (e1 + 1) * ... * (ek + 1) and the sum of divisors is the product of
+// (pi^(ei+1) - 1) / (pi - 1). Factoring with FactorizePollard makes these
+// fast for any 64-bit n.
+// time complexity: dominated by the factorization, O(n^(1/4)) expected
+// space complexity: O(log n)
+// reference: https://en.wikipedia.org/wiki/Euler%27s_totient_function
+// reference: https://en.wikipedia.org/wiki/Divisor_function
+// see divisors_test.go
+
+package prime
+
+// EulerTotient returns the number of integers in [1, n] that are coprime to n.
+// It returns 0 for n = 0.
+func EulerTotient(n uint64) uint64 {
+	if n == 0 {
+		return 0
+	}
+	result := n
+	for p := range FactorizePollard(n) {
+		result = result / p * (p - 1)
+	}
+	return result
+}
+
+// DivisorCount returns the number of positive divisors of n. It returns 0 for n = 0.
+func DivisorCount(n uint64) uint64 {
+	if n == 0 {
+		return 0
+	}
+	count := uint64(1)
+	for _, e := range FactorizePollard(n) {
+		count *= uint64(e) + 1
+	}
+	return count
+}
+
+// DivisorSum returns the sum of the positive divisors of n. The result wraps
+// around when it does not fit in a uint64. It returns 0 for n = 0.
+func DivisorSum(n uint64) uint64 {
+	if n == 0 {
+		return 0
+	}
+	sum := uint64(1)
+	for p, e := range FactorizePollard(n) {
+		term, power := uint64(1), uint64(1)
+		for i := 0; i < e; i++ {
+			power *= p
+			term += power
+		}
+		sum *= term
+	}
+	return sum
+}
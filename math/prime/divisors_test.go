@@ -0,0 +1,43 @@
+package prime_test
+
+import (
+	"testing"
+
+	"github.com/TheAlgorithms/Go/math/prime"
+)
+
+func TestDivisorFunctions(t *testing.T) {
+	for n := uint64(1); n <= 2000; n++ {
+		var totient, count, sum uint64
+		for k := uint64(1); k <= n; k++ {
+			if n%k == 0 {
+				count++
+				sum += k
+			}
+			a, b := n, k
+			for b != 0 {
+				a, b = b, a%b
+			}
+			if a == 1 {
+				totient++
+			}
+		}
+		if got := prime.EulerTotient(n); got != totient {
+			t.Fatalf("EulerTotient(%d) = %d, want %d", n, got, totient)
+		}
+		if got := prime.DivisorCount(n); got != count {
+			t.Fatalf("DivisorCount(%d) = %d, want %d", n, got, count)
+		}
+		if got := prime.DivisorSum(n); got != sum {
+			t.Fatalf("DivisorSum(%d) = %d, want %d", n, got, sum)
+		}
+	}
+
+	// 2^32 + 1 = 641 * 6700417
+	if got := prime.EulerTotient(1<<32 + 1); got != 640*6700416 {
+		t.Errorf("EulerTotient(2^32 + 1) = %d, want %d", got, 640*6700416)
+	}
+	if got := prime.DivisorCount(0); got != 0 {
+		t.Errorf("DivisorCount(0) = %d, want 0", got)
+	}
+}
@@ -0,0 +1,79 @@
+// millerrabin64.go
+// description: Deterministic Miller-Rabin primality test for 64-bit integers
+// details:
+// MillerRabinDeterministic works on int64 but multiplies residues in 64 bits,
+// which overflows once the modulus passes 2^31.5. This version computes every
+// product as a full 128-bit value with math/bits before reducing it, so it is
+// exact over the whole uint64 range. Testing the first twelve primes as
+// witnesses is enough to prove primality for every n < 3.3 * 10^24, which
+// covers all 64-bit integers.
+// time complexity: O(log(n)) modular multiplications per witness
+// space complexity: O(1)
+// reference: https://en.wikipedia.org/wiki/Miller%E2%80%93Rabin_primality_test#Testing_against_small_sets_of_bases
+// see millerrabin64_test.go
+
+package prime
+
+import "math/bits"
+
+// witnesses64 decide the primality of every 64-bit integer.
+var witnesses64 = []uint64{2, 3, 5, 7, 11, 13, 17, 19, 23, 29, 31, 37}
+
+// mulMod returns a*b mod m without overflow.
+func mulMod(a, b, m uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	return bits.Rem64(hi, lo, m)
+}
+
+// addMod returns a+b mod m without overflow, for a and b below m.
+func addMod(a, b, m uint64) uint64 {
+	sum, carry := bits.Add64(a, b, 0)
+	return bits.Rem64(carry, sum, m)
+}
+
+// powMod returns base^exponent mod m without overflow.
+func powMod(base, exponent, m uint64) uint64 {
+	result := uint64(1) % m
+	base %= m
+	for exponent > 0 {
+		if exponent&1 == 1 {
+			result = mulMod(result, base, m)
+		}
+		base = mulMod(base, base, m)
+		exponent >>= 1
+	}
+	return result
+}
+
+// MillerRabin64 reports whether n is prime. The answer is exact for every uint64.
+func MillerRabin64(n uint64) bool {
+	if n < 2 {
+		return false
+	}
+	for _, p := range witnesses64 {
+		if n%p == 0 {
+			return n == p
+		}
+	}
+	// n - 1 = d * 2^s with d odd
+	s := bits.TrailingZeros64(n - 1)
+	d := (n - 1) >> uint(s)
+	for _, a := range witnesses64 {
+		x := powMod(a, d, n)
+		if x == 1 || x == n-1 {
+			continue
+		}
+		composite := true
+		for r := 1; r < s; r++ {
+			x = mulMod(x, x, n)
+			if x == n-1 {
+				composite = false
+				break
+			}
+		}
+		if composite {
+			return false
+		}
+	}
+	return true
+}
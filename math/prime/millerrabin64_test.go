@@ -0,0 +1,48 @@
+package prime_test
+
+import (
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/math/prime"
+)
+
+func TestMillerRabin64(t *testing.T) {
+	for n := uint64(0); n < 10_000; n++ {
+		if got, want := prime.MillerRabin64(n), prime.OptimizedTrialDivision(int64(n)); got != want {
+			t.Fatalf("MillerRabin64(%d) = %v, want %v", n, got, want)
+		}
+	}
+
+	tests := map[uint64]bool{
+		3_215_031_751:                 false, // strong pseudoprime to bases 2, 3, 5 and 7
+		3_825_123_056_546_413_051:     false, // strong pseudoprime to the bases up to 23
+		1_000_000_007:                 true,
+		4_294_967_291:                 true, // largest 32-bit prime
+		18_446_744_073_709_551_557:    true, // largest 64-bit prime
+		math.MaxUint64:                false,
+		4_294_967_291 * 4_294_967_279: false,
+	}
+	for n, want := range tests {
+		if got := prime.MillerRabin64(n); got != want {
+			t.Errorf("MillerRabin64(%d) = %v, want %v", n, got, want)
+		}
+	}
+
+	rnd := rand.New(rand.NewSource(9))
+	for i := 0; i < 2000; i++ {
+		n := rnd.Uint64() | 1
+		want := new(big.Int).SetUint64(n).ProbablyPrime(20)
+		if got := prime.MillerRabin64(n); got != want {
+			t.Fatalf("MillerRabin64(%d) = %v, want %v", n, got, want)
+		}
+	}
+}
+
+func BenchmarkMillerRabin64(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		prime.MillerRabin64(18_446_744_073_709_551_557)
+	}
+}
@@ -0,0 +1,131 @@
+// pollardrho.go
+// description: Integer factorization of 64-bit integers with Pollard's rho
+// details:
+// Pollard's rho iterates x -> x^2 + c (mod n). Modulo an unknown prime factor p
+// of n the sequence starts cycling after about sqrt(p) steps, and a collision
+// shows up as gcd(|x - y|, n) > 1. Brent's variant finds the cycle with a
+// doubling search and multiplies many differences together before taking a
+// gcd, which saves most of the gcd computations. Full factorization splits n
+// recursively, using MillerRabin64 to recognize the prime parts.
+// The math package has a math/big version of the basic algorithm; this one
+// works on uint64 with exact 128-bit products and factors any 64-bit integer.
+// time complexity: O(n^(1/4)) expected per split
+// space complexity: O(log n) for the factors
+// reference: https://en.wikipedia.org/wiki/Pollard%27s_rho_algorithm
+// reference: https://maths-people.anu.edu.au/~brent/pd/rpb051i.pdf
+// see pollardrho_test.go
+
+package prime
+
+import "sort"
+
+func gcd64(a, b uint64) uint64 {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+// PollardRho returns a non-trivial divisor of n, which must be composite.
+// It returns n itself when n is prime or smaller than 4.
+func PollardRho(n uint64) uint64 {
+	if n%2 == 0 && n > 2 {
+		return 2
+	}
+	if n < 4 || MillerRabin64(n) {
+		return n
+	}
+	// A constant c for which the sequence collides modulo n itself is
+	// replaced by the next one.
+	for c := uint64(1); ; c++ {
+		if d := brent(n, c); d != n {
+			return d
+		}
+	}
+}
+
+// brent runs Brent's cycle finding on x -> x^2 + c (mod n) and returns the
+// divisor it finds, which is n when the attempt failed.
+func brent(n, c uint64) uint64 {
+	const batch = 128
+	f := func(x uint64) uint64 { return addMod(mulMod(x, x, n), c, n) }
+	y, r, q, g := uint64(2), 1, uint64(1), uint64(1)
+	var x, ys uint64
+	for g == 1 {
+		x = y
+		for i := 0; i < r; i++ {
+			y = f(y)
+		}
+		for k := 0; k < r && g == 1; k += batch {
+			ys = y
+			for i := 0; i < batch && i < r-k; i++ {
+				y = f(y)
+				q = mulMod(q, diff(x, y), n)
+			}
+			g = gcd64(q, n)
+		}
+		r *= 2
+	}
+	if g == n {
+		// The batch overshot, replay it one step at a time.
+		for {
+			ys = f(ys)
+			if g = gcd64(diff(x, ys), n); g > 1 {
+				break
+			}
+		}
+	}
+	return g
+}
+
+func diff(a, b uint64) uint64 {
+	if a > b {
+		return a - b
+	}
+	return b - a
+}
+
+// FactorizePollard returns the prime factorization of n as a map from every
+// prime factor to its exponent, like Factorize, for any uint64 n. It returns
+// an empty map for 0 and 1.
+func FactorizePollard(n uint64) map[uint64]int {
+	result := make(map[uint64]int)
+	if n < 2 {
+		return result
+	}
+	// Trial division removes small factors cheaply.
+	for _, p := range witnesses64 {
+		for n%p == 0 {
+			result[p]++
+			n /= p
+		}
+	}
+	var split func(m uint64)
+	split = func(m uint64) {
+		if m == 1 {
+			return
+		}
+		if MillerRabin64(m) {
+			result[m]++
+			return
+		}
+		d := PollardRho(m)
+		split(d)
+		split(m / d)
+	}
+	split(n)
+	return result
+}
+
+// Factors returns the prime factors of n in increasing order, repeated as
+// many times as they divide n.
+func Factors(n uint64) []uint64 {
+	var factors []uint64
+	for p, e := range FactorizePollard(n) {
+		for i := 0; i < e; i++ {
+			factors = append(factors, p)
+		}
+	}
+	sort.Slice(factors, func(i, j int) bool { return factors[i] < factors[j] })
+	return factors
+}
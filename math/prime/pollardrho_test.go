@@ -0,0 +1,94 @@
+package prime_test
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/math/prime"
+)
+
+func TestFactorizePollard(t *testing.T) {
+	tests := []struct {
+		n    uint64
+		want map[uint64]int
+	}{
+		{0, map[uint64]int{}},
+		{1, map[uint64]int{}},
+		{2, map[uint64]int{2: 1}},
+		{360, map[uint64]int{2: 3, 3: 2, 5: 1}},
+		{1_000_000_007, map[uint64]int{1_000_000_007: 1}},
+		{4_294_967_291 * 4_294_967_279, map[uint64]int{4_294_967_279: 1, 4_294_967_291: 1}},
+		{1_000_000_007 * 1_000_000_007 * 13, map[uint64]int{13: 1, 1_000_000_007: 2}},
+		{1 << 63, map[uint64]int{2: 63}},
+	}
+	for _, test := range tests {
+		if got := prime.FactorizePollard(test.n); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("FactorizePollard(%d) = %v, want %v", test.n, got, test.want)
+		}
+	}
+}
+
+func TestFactorizePollardAgainstTrialDivision(t *testing.T) {
+	rnd := rand.New(rand.NewSource(4))
+	for i := 0; i < 500; i++ {
+		n := uint64(rnd.Int63n(1e12)) + 2
+		want := make(map[uint64]int)
+		for p, e := range prime.Factorize(int64(n)) {
+			want[uint64(p)] = int(e)
+		}
+		if got := prime.FactorizePollard(n); !reflect.DeepEqual(got, want) {
+			t.Fatalf("FactorizePollard(%d) = %v, want %v", n, got, want)
+		}
+	}
+
+	// Products of random factors of the whole 64-bit range.
+	for i := 0; i < 200; i++ {
+		n := rnd.Uint64()
+		product := uint64(1)
+		for _, p := range prime.Factors(n) {
+			if !prime.MillerRabin64(p) {
+				t.Fatalf("Factors(%d) returned the composite %d", n, p)
+			}
+			product *= p
+		}
+		if product != n && n > 1 {
+			t.Fatalf("the factors of %d multiply to %d", n, product)
+		}
+	}
+}
+
+func TestPollardRho(t *testing.T) {
+	n := uint64(600_851_475_143)
+	d := prime.PollardRho(n)
+	if d == 1 || d == n || n%d != 0 {
+		t.Errorf("PollardRho(%d) = %d, want a non-trivial divisor", n, d)
+	}
+	if got := prime.PollardRho(1_000_000_007); got != 1_000_000_007 {
+		t.Errorf("PollardRho of a prime = %d, want the prime", got)
+	}
+}
+
+func TestFactorizePollardNearMaxUint64(t *testing.T) {
+	for n := uint64(math.MaxUint64); n > math.MaxUint64-40; n-- {
+		product := uint64(1)
+		for p, e := range prime.FactorizePollard(n) {
+			if !prime.MillerRabin64(p) {
+				t.Fatalf("FactorizePollard(%d) returned the composite %d", n, p)
+			}
+			for ; e > 0; e-- {
+				product *= p
+			}
+		}
+		if product != n {
+			t.Fatalf("the factors of %d multiply to %d", n, product)
+		}
+	}
+}
+
+func BenchmarkFactorizePollard(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		prime.FactorizePollard(4_294_967_291 * 4_294_967_279)
+	}
+}
@@ -0,0 +1,138 @@
+// pratt.go
+// description: Pratt primality certificates for 64-bit integers
+// details:
+// A prime p has a primitive root: a number a whose powers modulo p go through
+// all p-1 non-zero residues. Conversely, if a^(p-1) = 1 (mod p) and
+// a^((p-1)/q) != 1 (mod p) for every prime factor q of p-1, the order of a is
+// p-1, which is only possible when p is prime. A Pratt certificate lists such
+// a witness a together with the prime factors of p-1, each with a certificate
+// of its own, down to 2. Checking it needs only modular exponentiations and
+// divisions: unlike a Miller-Rabin answer, it can be verified by someone who
+// does not trust the code that produced it.
+// Generation factors p-1 with FactorizePollard, tries witnesses 2, 3, ... and
+// recurses on the factors; MillerRabin64 rejects composites up front.
+// time complexity: O(log^2(p)) modular exponentiations to verify
+// space complexity: O(log(p)) certificates
+// reference: https://en.wikipedia.org/wiki/Primality_certificate#Pratt_certificates
+// see pratt_test.go
+
+package prime
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+var (
+	// ErrNotPrime is returned when asking for the certificate of a number
+	// that is not prime.
+	ErrNotPrime = errors.New("number is not prime")
+	// ErrInvalidCertificate is returned when a certificate does not prove
+	// the primality of its number.
+	ErrInvalidCertificate = errors.New("invalid primality certificate")
+)
+
+// Certificate is a Pratt certificate proving that Prime is prime.
+type Certificate struct {
+	Prime uint64
+	// Witness has order Prime-1 modulo Prime.
+	Witness uint64
+	// Factors certify the distinct prime factors of Prime-1, in increasing
+	// order. The certificate of 2 has none.
+	Factors []*Certificate
+}
+
+// NewCertificate returns a Pratt certificate for p, or ErrNotPrime when p is
+// not prime.
+func NewCertificate(p uint64) (*Certificate, error) {
+	if !MillerRabin64(p) {
+		return nil, fmt.Errorf("%w: %d", ErrNotPrime, p)
+	}
+	return certify(p), nil
+}
+
+// certify returns the certificate of the prime p.
+func certify(p uint64) *Certificate {
+	if p == 2 {
+		return &Certificate{Prime: 2, Witness: 1}
+	}
+	var factors []uint64
+	for q := range FactorizePollard(p - 1) {
+		factors = append(factors, q)
+	}
+	sort.Slice(factors, func(i, j int) bool { return factors[i] < factors[j] })
+
+	c := &Certificate{Prime: p}
+	for a := uint64(2); c.Witness == 0; a++ {
+		if isPrimitiveRoot(a, p, factors) {
+			c.Witness = a
+		}
+	}
+	for _, q := range factors {
+		c.Factors = append(c.Factors, certify(q))
+	}
+	return c
+}
+
+// isPrimitiveRoot reports whether a has order p-1 modulo p, the prime
+// factors of p-1 being factors.
+func isPrimitiveRoot(a, p uint64, factors []uint64) bool {
+	if powMod(a, p-1, p) != 1 {
+		return false
+	}
+	for _, q := range factors {
+		if powMod(a, (p-1)/q, p) == 1 {
+			return false
+		}
+	}
+	return true
+}
+
+// Verify checks the certificate and the certificates of the factors, and
+// returns an error wrapping ErrInvalidCertificate that tells the first
+// problem found, or nil when the certificate proves that c.Prime is prime.
+func (c *Certificate) Verify() error {
+	p := c.Prime
+	if p == 2 {
+		if len(c.Factors) != 0 {
+			return fmt.Errorf("%w: 2 has no factors to certify", ErrInvalidCertificate)
+		}
+		return nil
+	}
+	if p < 2 || p%2 == 0 {
+		return fmt.Errorf("%w: %d is not an odd number above 2", ErrInvalidCertificate, p)
+	}
+	if c.Witness < 2 || c.Witness >= p {
+		return fmt.Errorf("%w: witness %d of %d is out of range", ErrInvalidCertificate, c.Witness, p)
+	}
+
+	// The factors must account for all of p-1, each once.
+	rest := p - 1
+	factors := make([]uint64, 0, len(c.Factors))
+	for _, f := range c.Factors {
+		if f == nil {
+			return fmt.Errorf("%w: missing factor certificate for %d", ErrInvalidCertificate, p)
+		}
+		q := f.Prime
+		if q < 2 || rest%q != 0 {
+			return fmt.Errorf("%w: %d is not a new factor of %d", ErrInvalidCertificate, q, p-1)
+		}
+		for rest%q == 0 {
+			rest /= q
+		}
+		factors = append(factors, q)
+	}
+	if rest != 1 {
+		return fmt.Errorf("%w: factors of %d leave %d unaccounted", ErrInvalidCertificate, p-1, rest)
+	}
+	if !isPrimitiveRoot(c.Witness, p, factors) {
+		return fmt.Errorf("%w: %d does not have order %d modulo %d", ErrInvalidCertificate, c.Witness, p-1, p)
+	}
+	for _, f := range c.Factors {
+		if err := f.Verify(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
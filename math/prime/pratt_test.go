@@ -0,0 +1,103 @@
+package prime_test
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/math/prime"
+)
+
+func TestNewCertificate(t *testing.T) {
+	for n := uint64(0); n < 2000; n++ {
+		c, err := prime.NewCertificate(n)
+		if isPrime := prime.MillerRabin64(n); !isPrime {
+			if !errors.Is(err, prime.ErrNotPrime) {
+				t.Fatalf("NewCertificate(%d) error = %v, want ErrNotPrime", n, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("NewCertificate(%d): %v", n, err)
+		}
+		if err := c.Verify(); err != nil {
+			t.Fatalf("certificate of %d does not verify: %v", n, err)
+		}
+	}
+
+	large := []uint64{
+		1_000_000_007,
+		4_294_967_291,
+		2_305_843_009_213_693_951,  // Mersenne prime 2^61 - 1
+		18_446_744_073_709_551_557, // largest 64-bit prime
+	}
+	rnd := rand.New(rand.NewSource(4))
+	for len(large) < 30 {
+		if n := rnd.Uint64() | 1; prime.MillerRabin64(n) {
+			large = append(large, n)
+		}
+	}
+	for _, p := range large {
+		c, err := prime.NewCertificate(p)
+		if err != nil {
+			t.Fatalf("NewCertificate(%d): %v", p, err)
+		}
+		if c.Prime != p {
+			t.Errorf("certificate is for %d, want %d", c.Prime, p)
+		}
+		if err := c.Verify(); err != nil {
+			t.Errorf("certificate of %d does not verify: %v", p, err)
+		}
+	}
+}
+
+func TestCertificateVerifyRejects(t *testing.T) {
+	c, err := prime.NewCertificate(1_000_000_007)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 1_000_000_006 = 2 * 500_000_003, witness 5
+	tamper := map[string]func(c *prime.Certificate){
+		"composite":      func(c *prime.Certificate) { c.Prime = 1_000_000_009 * 3 },
+		"even":           func(c *prime.Certificate) { c.Prime = 1_000_000_008 },
+		"witness 1":      func(c *prime.Certificate) { c.Witness = 1 },
+		"not a root":     func(c *prime.Certificate) { c.Witness = 4 },
+		"missing factor": func(c *prime.Certificate) { c.Factors = c.Factors[:1] },
+		"repeated factor": func(c *prime.Certificate) {
+			c.Factors = append(c.Factors, c.Factors[0])
+		},
+		"composite factor": func(c *prime.Certificate) {
+			c.Factors[1].Witness++
+		},
+		"nil factor": func(c *prime.Certificate) { c.Factors[0] = nil },
+		"2 with factors": func(c *prime.Certificate) {
+			c.Factors[0].Factors = []*prime.Certificate{{Prime: 2}}
+		},
+	}
+	for name, change := range tamper {
+		t.Run(name, func(t *testing.T) {
+			bad, _ := prime.NewCertificate(1_000_000_007)
+			change(bad)
+			if err := bad.Verify(); !errors.Is(err, prime.ErrInvalidCertificate) {
+				t.Errorf("Verify() = %v, want ErrInvalidCertificate", err)
+			}
+		})
+	}
+	if err := c.Verify(); err != nil {
+		t.Errorf("untouched certificate should verify: %v", err)
+	}
+
+	// a composite with a Fermat-style witness is still rejected: 561 is a
+	// Carmichael number, so a^560 = 1 for every a coprime to it
+	fake := &prime.Certificate{Prime: 561, Witness: 2, Factors: []*prime.Certificate{
+		{Prime: 2, Witness: 1},
+		{Prime: 5, Witness: 2, Factors: []*prime.Certificate{{Prime: 2, Witness: 1}}},
+		{Prime: 7, Witness: 3, Factors: []*prime.Certificate{
+			{Prime: 2, Witness: 1},
+			{Prime: 3, Witness: 2, Factors: []*prime.Certificate{{Prime: 2, Witness: 1}}},
+		}},
+	}}
+	if err := fake.Verify(); !errors.Is(err, prime.ErrInvalidCertificate) {
+		t.Errorf("Verify() of a certificate for 561 = %v, want ErrInvalidCertificate", err)
+	}
+}
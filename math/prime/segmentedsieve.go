@@ -0,0 +1,86 @@
+// segmentedsieve.go
+// description: Segmented Sieve of Eratosthenes over an arbitrary range
+// details:
+// The plain sieve needs memory proportional to its upper limit. The segmented
+// sieve first finds the primes up to sqrt(hi), then sieves [lo, hi] in
+// fixed-size windows, crossing off multiples of those base primes in each
+// window. Memory is O(sqrt(hi) + segment) whatever the length of the range,
+// and primes are streamed to a callback, so ranges far larger than memory can
+// be processed.
+// time complexity: O((hi - lo) log log hi + sqrt(hi))
+// space complexity: O(sqrt(hi) / log(hi) + segment)
+// reference: https://cp-algorithms.com/algebra/sieve-of-eratosthenes.html#segmented-sieve
+// see segmentedsieve_test.go
+
+package prime
+
+import "math"
+
+// segmentSize is the length of the window sieved at once.
+const segmentSize = 1 << 16
+
+// SegmentedSieve calls visit with every prime p such that lo <= p <= hi, in
+// increasing order, until visit returns false. The primes up to sqrt(hi) are
+// kept in memory, so hi is practically limited to about 10^14.
+func SegmentedSieve(lo, hi uint64, visit func(p uint64) bool) {
+	if lo < 2 {
+		lo = 2
+	}
+	if hi < lo {
+		return
+	}
+	root := uint64(math.Sqrt(float64(hi)))
+	for root > 0 && root > hi/root {
+		root--
+	}
+	for root+1 <= hi/(root+1) {
+		root++
+	}
+	base := SieveEratosthenes(int(root))
+
+	composite := make([]bool, segmentSize)
+	for start := lo; ; start += segmentSize {
+		end := hi
+		if hi-start >= segmentSize {
+			end = start + segmentSize - 1
+		}
+		for i := range composite {
+			composite[i] = false
+		}
+		for _, b := range base {
+			p := uint64(b)
+			if p > end/p {
+				break
+			}
+			// Multiples below p*p were crossed off by smaller primes.
+			first := (start + p - 1) / p * p
+			if first < p*p {
+				first = p * p
+			}
+			for m := first; m <= end && m >= first; m += p {
+				composite[m-start] = true
+			}
+		}
+		for n := start; ; n++ {
+			if !composite[n-start] && !visit(n) {
+				return
+			}
+			if n == end {
+				break
+			}
+		}
+		if end == hi {
+			return
+		}
+	}
+}
+
+// PrimesInRange returns the primes p such that lo <= p <= hi.
+func PrimesInRange(lo, hi uint64) []uint64 {
+	var primes []uint64
+	SegmentedSieve(lo, hi, func(p uint64) bool {
+		primes = append(primes, p)
+		return true
+	})
+	return primes
+}
@@ -0,0 +1,56 @@
+package prime_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/math/prime"
+)
+
+func TestPrimesInRange(t *testing.T) {
+	tests := []struct {
+		lo, hi uint64
+		want   []uint64
+	}{
+		{0, 30, []uint64{2, 3, 5, 7, 11, 13, 17, 19, 23, 29}},
+		{24, 29, []uint64{29}},
+		{90, 96, nil},
+		{7, 7, []uint64{7}},
+		{10, 2, nil},
+		{1_000_000_000_000, 1_000_000_000_100, []uint64{1_000_000_000_039, 1_000_000_000_061, 1_000_000_000_063, 1_000_000_000_091}},
+	}
+	for _, test := range tests {
+		if got := prime.PrimesInRange(test.lo, test.hi); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("PrimesInRange(%d, %d) = %v, want %v", test.lo, test.hi, got, test.want)
+		}
+	}
+}
+
+func TestSegmentedSieveAgainstSieve(t *testing.T) {
+	// The range spans several segments.
+	const limit = 300_000
+	want := prime.SieveEratosthenes(limit)
+	var got []int
+	prime.SegmentedSieve(0, limit, func(p uint64) bool {
+		got = append(got, int(p))
+		return true
+	})
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("SegmentedSieve found %d primes, want %d", len(got), len(want))
+	}
+
+	count := 0
+	prime.SegmentedSieve(0, limit, func(uint64) bool {
+		count++
+		return count < 10
+	})
+	if count != 10 {
+		t.Errorf("SegmentedSieve did not stop, visited %d primes", count)
+	}
+}
+
+func BenchmarkSegmentedSieve(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		prime.SegmentedSieve(1e12, 1e12+1e6, func(uint64) bool { return true })
+	}
+}
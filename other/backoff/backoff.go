@@ -0,0 +1,88 @@
+// backoff.go
+// description: Delay sequences for retrying failed operations
+// details:
+// A Strategy maps the number of the retry, starting at 0, to the delay to
+// wait before it. Constant waits the same time every retry, Exponential
+// multiplies the delay by a fixed factor and Fibonacci grows it along the
+// Fibonacci numbers, which is gentler than doubling. Every strategy is capped
+// so that the delays cannot overflow. Strategies compose: jitter.go wraps
+// them with randomness and Retry in retry.go drives an operation with one.
+// time complexity: O(1) per delay, O(n) for Fibonacci
+// space complexity: O(1)
+// reference: https://aws.amazon.com/blogs/architecture/exponential-backoff-and-jitter/
+// see backoff_test.go
+
+// Package backoff implements retry delay strategies such as exponential
+// backoff, Fibonacci backoff and randomized jitter.
+package backoff
+
+import (
+	"math"
+	"time"
+)
+
+// Strategy returns the delay to wait before the retry numbered attempt,
+// the first retry being attempt 0.
+type Strategy func(attempt int) time.Duration
+
+// Constant returns a strategy that always waits delay.
+func Constant(delay time.Duration) Strategy {
+	return func(int) time.Duration {
+		return delay
+	}
+}
+
+// Exponential returns a strategy waiting base * factor^attempt, but never
+// more than limit.
+func Exponential(base time.Duration, factor float64, limit time.Duration) Strategy {
+	return func(attempt int) time.Duration {
+		if attempt < 0 {
+			attempt = 0
+		}
+		d := float64(base) * math.Pow(factor, float64(attempt))
+		if d >= float64(limit) {
+			return limit
+		}
+		return time.Duration(d)
+	}
+}
+
+// Fibonacci returns a strategy waiting base times the (attempt+1)-th Fibonacci
+// number, that is base, base, 2*base, 3*base, 5*base and so on, but never
+// more than limit.
+func Fibonacci(base, limit time.Duration) Strategy {
+	return func(attempt int) time.Duration {
+		a, b := base, base
+		for i := 0; i < attempt && a < limit; i++ {
+			// Saturate at limit, which also rules out overflow.
+			next := limit
+			if a <= limit-b {
+				next = a + b
+			}
+			a, b = b, next
+		}
+		if a > limit {
+			return limit
+		}
+		return a
+	}
+}
+
+// Cap limits the delays of s to at most limit.
+func Cap(s Strategy, limit time.Duration) Strategy {
+	return func(attempt int) time.Duration {
+		if d := s(attempt); d < limit {
+			return d
+		}
+		return limit
+	}
+}
+
+// Delays returns the first n delays of s.
+func Delays(s Strategy, n int) []time.Duration {
+	delays := make([]time.Duration, 0, n)
+	for i := 0; i < n; i++ {
+		delays = append(delays, s(i))
+	}
+	return delays
+}
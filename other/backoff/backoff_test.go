@@ -0,0 +1,152 @@
+package backoff_test
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/TheAlgorithms/Go/other/backoff"
+)
+
+func TestConstant(t *testing.T) {
+	got := backoff.Delays(backoff.Constant(time.Second), 3)
+	want := []time.Duration{time.Second, time.Second, time.Second}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Constant delays = %v, want %v", got, want)
+	}
+}
+
+func TestExponential(t *testing.T) {
+	got := backoff.Delays(backoff.Exponential(100*time.Millisecond, 2, time.Second), 6)
+	want := []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond, time.Second, time.Second}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Exponential delays = %v, want %v", got, want)
+	}
+
+	huge := backoff.Exponential(time.Second, 10, math.MaxInt64)
+	if got := huge(1000); got != math.MaxInt64 {
+		t.Errorf("Exponential(1000) = %v, want the limit", got)
+	}
+}
+
+func TestFibonacci(t *testing.T) {
+	got := backoff.Delays(backoff.Fibonacci(time.Second, 10*time.Second), 8)
+	want := []time.Duration{1, 1, 2, 3, 5, 8, 10, 10}
+	for i := range want {
+		want[i] *= time.Second
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Fibonacci delays = %v, want %v", got, want)
+	}
+
+	// The limit is reached exactly on a Fibonacci number.
+	if got := backoff.Fibonacci(1, 8)(5); got != 8 {
+		t.Errorf("Fibonacci(1, 8)(5) = %v, want 8", got)
+	}
+	if got := backoff.Fibonacci(1, math.MaxInt64)(200); got != math.MaxInt64 {
+		t.Errorf("Fibonacci(200) = %v, want the limit", got)
+	}
+}
+
+func TestCap(t *testing.T) {
+	s := backoff.Cap(backoff.Exponential(time.Second, 3, time.Hour), 5*time.Second)
+	want := []time.Duration{time.Second, 3 * time.Second, 5 * time.Second, 5 * time.Second}
+	if got := backoff.Delays(s, 4); !reflect.DeepEqual(got, want) {
+		t.Errorf("capped delays = %v, want %v", got, want)
+	}
+}
+
+func TestJitter(t *testing.T) {
+	base := backoff.Exponential(time.Millisecond, 2, time.Second)
+	full := backoff.FullJitter(base, rand.New(rand.NewSource(1)))
+	equal := backoff.EqualJitter(base, rand.New(rand.NewSource(1)))
+	for attempt := 0; attempt < 20; attempt++ {
+		d := base(attempt)
+		if got := full(attempt); got < 0 || got > d {
+			t.Errorf("FullJitter(%d) = %v, want within [0, %v]", attempt, got, d)
+		}
+		if got := equal(attempt); got < d-d/2 || got > d {
+			t.Errorf("EqualJitter(%d) = %v, want within [%v, %v]", attempt, got, d-d/2, d)
+		}
+	}
+
+	// The same seed gives the same sequence.
+	a := backoff.Delays(backoff.FullJitter(base, rand.New(rand.NewSource(7))), 10)
+	b := backoff.Delays(backoff.FullJitter(base, rand.New(rand.NewSource(7))), 10)
+	if !reflect.DeepEqual(a, b) {
+		t.Errorf("seeded sequences differ: %v and %v", a, b)
+	}
+}
+
+func TestDecorrelated(t *testing.T) {
+	const base, limit = 10 * time.Millisecond, time.Second
+	s := backoff.Decorrelated(base, limit, rand.New(rand.NewSource(3)))
+	delays := backoff.Delays(s, 50)
+	previous := base
+	for i, d := range delays {
+		hi := 3 * previous
+		if hi > limit {
+			hi = limit
+		}
+		if d < base || d > hi {
+			t.Fatalf("delay %d = %v, want within [%v, %v]", i, d, base, hi)
+		}
+		previous = d
+	}
+	if delays[len(delays)-1] < limit/10 {
+		t.Errorf("delays should grow towards the limit, got %v", delays)
+	}
+
+	// Attempt 0 starts the sequence over.
+	if got := s(0); got > 3*base {
+		t.Errorf("first delay after a restart = %v, want at most %v", got, 3*base)
+	}
+	if got := backoff.Decorrelated(time.Second, time.Second, nil)(4); got != time.Second {
+		t.Errorf("Decorrelated with base equal to limit = %v, want %v", got, time.Second)
+	}
+
+	// The stateless step gives the same delays from the same source.
+	rnd := rand.New(rand.NewSource(3))
+	previous = base
+	for i, want := range delays {
+		previous = backoff.DecorrelatedDelay(previous, base, limit, rnd)
+		if previous != want {
+			t.Fatalf("DecorrelatedDelay %d = %v, want %v", i, previous, want)
+		}
+	}
+}
+
+func TestRetry(t *testing.T) {
+	errFail := errors.New("fail")
+	var slept []time.Duration
+	sleep := func(d time.Duration) { slept = append(slept, d) }
+	calls := 0
+	op := func() error {
+		calls++
+		if calls < 4 {
+			return errFail
+		}
+		return nil
+	}
+	s := backoff.Exponential(time.Second, 2, time.Minute)
+	if err := backoff.Retry(5, s, sleep, op); err != nil {
+		t.Errorf("Retry() = %v, want nil", err)
+	}
+	if want := backoff.Delays(s, 3); calls != 4 || !reflect.DeepEqual(slept, want) {
+		t.Errorf("Retry made %d calls sleeping %v, want 4 calls sleeping %v", calls, slept, want)
+	}
+
+	calls, slept = 0, nil
+	if err := backoff.Retry(2, s, sleep, op); err != errFail {
+		t.Errorf("Retry() = %v, want %v", err, errFail)
+	}
+	if calls != 2 || len(slept) != 1 {
+		t.Errorf("Retry made %d calls and %d sleeps, want 2 and 1", calls, len(slept))
+	}
+	if err := backoff.Retry(0, s, sleep, op); err != backoff.ErrNoAttempts {
+		t.Errorf("Retry(0) = %v, want ErrNoAttempts", err)
+	}
+}
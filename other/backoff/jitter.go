@@ -0,0 +1,91 @@
+// jitter.go
+// description: Randomized jitter for backoff strategies
+// details:
+// When many clients fail at the same time, plain exponential backoff makes
+// them retry at the same time again. Jitter spreads the retries out:
+// FullJitter waits a uniform time between 0 and the delay, EqualJitter keeps
+// half of the delay and randomizes the other half, and decorrelated jitter
+// draws each delay between base and three times the previous one, which
+// makes it the one strategy with state; DecorrelatedDelay is its stateless
+// step, taking the previous delay as an argument.
+// The random source is passed in, so a seeded source gives a reproducible
+// sequence; a nil source uses the global one of math/rand.
+// time complexity: O(1) per delay
+// space complexity: O(1)
+// reference: https://aws.amazon.com/blogs/architecture/exponential-backoff-and-jitter/
+// see backoff_test.go
+
+package backoff
+
+import (
+	"math/rand"
+	"time"
+)
+
+// FullJitter returns a strategy waiting a uniformly random time in [0, d]
+// where d is the delay of s.
+func FullJitter(s Strategy, rnd *rand.Rand) Strategy {
+	return func(attempt int) time.Duration {
+		return between(rnd, 0, s(attempt))
+	}
+}
+
+// EqualJitter returns a strategy waiting a uniformly random time in
+// [d/2, d] where d is the delay of s.
+func EqualJitter(s Strategy, rnd *rand.Rand) Strategy {
+	return func(attempt int) time.Duration {
+		d := s(attempt)
+		return between(rnd, d-d/2, d)
+	}
+}
+
+// DecorrelatedDelay returns the delay following previous in decorrelated
+// jitter: a uniformly random time in [base, 3 * previous], capped at limit.
+// It keeps no state, so callers sharing it between goroutines only have to
+// keep their own previous delay, and rnd must then be nil or safe to share.
+func DecorrelatedDelay(previous, base, limit time.Duration, rnd *rand.Rand) time.Duration {
+	hi := limit
+	if previous < limit/3 {
+		hi = 3 * previous
+	}
+	if hi < base {
+		hi = base
+	}
+	d := between(rnd, base, hi)
+	if d > limit {
+		d = limit
+	}
+	return d
+}
+
+// Decorrelated returns a strategy whose delays follow DecorrelatedDelay,
+// starting from previous = base. Unlike the other strategies it is stateful,
+// remembering the last delay it returned: it must be called with consecutive
+// attempts, attempt 0 starting over, and it is not safe for concurrent use.
+// Goroutines retrying at the same time need a strategy each, or can call
+// DecorrelatedDelay with their own previous delay.
+func Decorrelated(base, limit time.Duration, rnd *rand.Rand) Strategy {
+	previous := base
+	return func(attempt int) time.Duration {
+		if attempt <= 0 {
+			previous = base
+		}
+		previous = DecorrelatedDelay(previous, base, limit, rnd)
+		return previous
+	}
+}
+
+// between returns a uniformly random duration in [lo, hi].
+func between(rnd *rand.Rand, lo, hi time.Duration) time.Duration {
+	if hi <= lo {
+		return lo
+	}
+	span := int64(hi - lo)
+	if span == 1<<63-1 {
+		span--
+	}
+	if rnd == nil {
+		return lo + time.Duration(rand.Int63n(span+1))
+	}
+	return lo + time.Duration(rnd.Int63n(span+1))
+}
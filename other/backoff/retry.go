@@ -0,0 +1,36 @@
+// retry.go
+// description: Retry an operation following a backoff strategy
+// details:
+// The operation is run until it succeeds or the number of attempts is used
+// up, waiting the delay given by the strategy between two attempts.
+// time complexity: O(attempts) calls of the operation
+// space complexity: O(1)
+// see backoff_test.go
+
+package backoff
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrNoAttempts is returned by Retry when asked for fewer than one attempt.
+var ErrNoAttempts = errors.New("at least one attempt is required")
+
+// Retry calls op up to attempts times, until it returns nil. Before retry i,
+// counting from 0, it calls sleep with s(i); a nil sleep means time.Sleep.
+// It returns nil on success and the last error of op otherwise.
+func Retry(attempts int, s Strategy, sleep func(time.Duration), op func() error) error {
+	if attempts < 1 {
+		return ErrNoAttempts
+	}
+	if sleep == nil {
+		sleep = time.Sleep
+	}
+	err := op()
+	for i := 0; err != nil && i < attempts-1; i++ {
+		sleep(s(i))
+		err = op()
+	}
+	return err
+}
@@ -0,0 +1,197 @@
+// ast.go
+// description: Abstract syntax tree of the expressions
+// details:
+// BuildAST replays the reverse Polish notation of ToRPN on a stack of nodes
+// instead of values: each operator or call pops the nodes of its operands
+// and pushes a node holding them. The tree can then be evaluated many times
+// with different variables, and printed back fully parenthesized.
+// see token.go, rpn.go, expression_test.go
+
+package expression
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/TheAlgorithms/Go/structure/stack"
+)
+
+// Node is a node of the syntax tree of an expression.
+type Node interface {
+	// Eval returns the value of the expression rooted at the node, looking
+	// the variables and functions up in env.
+	Eval(env *Env) (float64, error)
+	// String returns the expression, with every operation in parentheses.
+	String() string
+}
+
+// Literal is a number.
+type Literal float64
+
+// Variable is a variable.
+type Variable string
+
+// UnaryOp is a prefix operator applied to an operand.
+type UnaryOp struct {
+	Op string
+	X  Node
+}
+
+// BinaryOp is a binary operator applied to two operands.
+type BinaryOp struct {
+	Op   string
+	X, Y Node
+}
+
+// Call is a function call.
+type Call struct {
+	Name string
+	Args []Node
+}
+
+// Eval returns the number.
+func (l Literal) Eval(*Env) (float64, error) {
+	return float64(l), nil
+}
+
+// String returns the number.
+func (l Literal) String() string {
+	return strconv.FormatFloat(float64(l), 'g', -1, 64)
+}
+
+// Eval returns the value of the variable.
+func (v Variable) Eval(env *Env) (float64, error) {
+	return env.variable(string(v))
+}
+
+// String returns the name of the variable.
+func (v Variable) String() string {
+	return string(v)
+}
+
+// Eval applies the operator to the value of the operand.
+func (u *UnaryOp) Eval(env *Env) (float64, error) {
+	x, err := u.X.Eval(env)
+	if err != nil {
+		return 0, err
+	}
+	return unary(u.Op, x), nil
+}
+
+// String returns the operator and its operand in parentheses.
+func (u *UnaryOp) String() string {
+	return "(" + u.Op + u.X.String() + ")"
+}
+
+// Eval applies the operator to the values of both operands, which are both
+// evaluated.
+func (b *BinaryOp) Eval(env *Env) (float64, error) {
+	x, err := b.X.Eval(env)
+	if err != nil {
+		return 0, err
+	}
+	y, err := b.Y.Eval(env)
+	if err != nil {
+		return 0, err
+	}
+	return binary(b.Op, x, y)
+}
+
+// String returns the operation in parentheses.
+func (b *BinaryOp) String() string {
+	return "(" + b.X.String() + " " + b.Op + " " + b.Y.String() + ")"
+}
+
+// Eval calls the function with the values of the arguments.
+func (c *Call) Eval(env *Env) (float64, error) {
+	args := make([]float64, len(c.Args))
+	for i, a := range c.Args {
+		var err error
+		if args[i], err = a.Eval(env); err != nil {
+			return 0, err
+		}
+	}
+	return env.call(c.Name, args)
+}
+
+// String returns the call.
+func (c *Call) String() string {
+	args := make([]string, len(c.Args))
+	for i, a := range c.Args {
+		args[i] = a.String()
+	}
+	return c.Name + "(" + strings.Join(args, ", ") + ")"
+}
+
+// BuildAST builds the syntax tree of an expression in reverse Polish
+// notation, as returned by ToRPN.
+func BuildAST(rpn []Token) (Node, error) {
+	nodes := stack.NewStack[Node]()
+	// pop removes the last n nodes, in the order they were pushed.
+	pop := func(n int, t Token) ([]Node, error) {
+		if nodes.Length() < n {
+			return nil, fmt.Errorf("%w: missing operand of %q at %d", ErrSyntax, t.Text, t.Pos)
+		}
+		args := make([]Node, n)
+		for i := n - 1; i >= 0; i-- {
+			args[i] = nodes.Pop()
+		}
+		return args, nil
+	}
+	for _, t := range rpn {
+		switch t.Kind {
+		case Number:
+			nodes.Push(Literal(t.Value))
+		case Identifier:
+			nodes.Push(Variable(t.Text))
+		case Unary:
+			args, err := pop(1, t)
+			if err != nil {
+				return nil, err
+			}
+			nodes.Push(&UnaryOp{Op: t.Text, X: args[0]})
+		case Operator:
+			args, err := pop(2, t)
+			if err != nil {
+				return nil, err
+			}
+			nodes.Push(&BinaryOp{Op: t.Text, X: args[0], Y: args[1]})
+		case Function:
+			args, err := pop(t.Args, t)
+			if err != nil {
+				return nil, err
+			}
+			nodes.Push(&Call{Name: t.Text, Args: args})
+		default:
+			return nil, fmt.Errorf("%w: unexpected %q at %d", ErrSyntax, t.Text, t.Pos)
+		}
+	}
+	if nodes.Length() != 1 {
+		return nil, fmt.Errorf("%w: %d nodes left after building", ErrSyntax, nodes.Length())
+	}
+	return nodes.Pop(), nil
+}
+
+// Parse returns the syntax tree of an expression.
+func Parse(src string) (Node, error) {
+	tokens, err := Tokenize(src)
+	if err != nil {
+		return nil, err
+	}
+	rpn, err := ToRPN(tokens)
+	if err != nil {
+		return nil, err
+	}
+	return BuildAST(rpn)
+}
+
+// Eval parses and evaluates an expression, looking the variables and
+// functions up in env.
+func Eval(src string, env *Env) (float64, error) {
+	root, err := Parse(src)
+	if err != nil {
+		return 0, err
+	}
+	return root.Eval(env)
+}
@@ -0,0 +1,165 @@
+// env.go
+// description: Variables, functions and operators of the expressions
+// details:
+// An environment binds the names of an expression to values and functions.
+// NewEnv starts with the constants pi, e, true and false and a few functions
+// of the math package; Set and Define add to them or replace them.
+// see token.go, expression_test.go
+
+package expression
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+var (
+	// ErrUnknownVariable is returned when a variable is not in the environment.
+	ErrUnknownVariable = errors.New("unknown variable")
+	// ErrUnknownFunction is returned when a function is not in the environment.
+	ErrUnknownFunction = errors.New("unknown function")
+	// ErrArity is returned when a function gets a wrong number of arguments.
+	ErrArity = errors.New("wrong number of arguments")
+	// ErrDivisionByZero is returned by / and % with a zero divisor.
+	ErrDivisionByZero = errors.New("division by zero")
+)
+
+// Func is a function callable from an expression.
+type Func func(args ...float64) (float64, error)
+
+// Env holds the variables and functions of the expressions.
+type Env struct {
+	vars  map[string]float64
+	funcs map[string]Func
+}
+
+// fixed adapts a function of n arguments, checking their number.
+func fixed(name string, n int, f func(args []float64) float64) Func {
+	return func(args ...float64) (float64, error) {
+		if len(args) != n {
+			return 0, fmt.Errorf("%w: %s takes %d, got %d", ErrArity, name, n, len(args))
+		}
+		return f(args), nil
+	}
+}
+
+// extremum returns the function choosing the best of at least one argument.
+func extremum(name string, better func(a, b float64) bool) Func {
+	return func(args ...float64) (float64, error) {
+		if len(args) == 0 {
+			return 0, fmt.Errorf("%w: %s takes at least one", ErrArity, name)
+		}
+		best := args[0]
+		for _, x := range args[1:] {
+			if better(x, best) {
+				best = x
+			}
+		}
+		return best, nil
+	}
+}
+
+// NewEnv returns an environment with the constants pi, e, true and false and
+// the functions abs, sqrt, exp, ln, sin, cos, tan, floor, ceil, pow, min and
+// max.
+func NewEnv() *Env {
+	e := &Env{
+		vars:  map[string]float64{"pi": math.Pi, "e": math.E, "true": 1, "false": 0},
+		funcs: map[string]Func{},
+	}
+	for name, f := range map[string]func(float64) float64{
+		"abs": math.Abs, "sqrt": math.Sqrt, "exp": math.Exp, "ln": math.Log,
+		"sin": math.Sin, "cos": math.Cos, "tan": math.Tan, "floor": math.Floor, "ceil": math.Ceil,
+	} {
+		f := f
+		e.funcs[name] = fixed(name, 1, func(args []float64) float64 { return f(args[0]) })
+	}
+	e.funcs["pow"] = fixed("pow", 2, func(args []float64) float64 { return math.Pow(args[0], args[1]) })
+	e.funcs["min"] = extremum("min", func(a, b float64) bool { return a < b })
+	e.funcs["max"] = extremum("max", func(a, b float64) bool { return a > b })
+	return e
+}
+
+// Set binds name to the value v.
+func (e *Env) Set(name string, v float64) {
+	e.vars[name] = v
+}
+
+// Define binds name to the function f.
+func (e *Env) Define(name string, f Func) {
+	e.funcs[name] = f
+}
+
+func (e *Env) variable(name string) (float64, error) {
+	if e != nil {
+		if v, ok := e.vars[name]; ok {
+			return v, nil
+		}
+	}
+	return 0, fmt.Errorf("%w: %s", ErrUnknownVariable, name)
+}
+
+func (e *Env) call(name string, args []float64) (float64, error) {
+	if e != nil {
+		if f, ok := e.funcs[name]; ok {
+			return f(args...)
+		}
+	}
+	return 0, fmt.Errorf("%w: %s", ErrUnknownFunction, name)
+}
+
+func boolean(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// unary applies a prefix operator.
+func unary(op string, x float64) float64 {
+	switch op {
+	case "-":
+		return -x
+	case "!":
+		return boolean(x == 0)
+	}
+	return x
+}
+
+// binary applies a binary operator.
+func binary(op string, x, y float64) (float64, error) {
+	switch op {
+	case "||":
+		return boolean(x != 0 || y != 0), nil
+	case "&&":
+		return boolean(x != 0 && y != 0), nil
+	case "==":
+		return boolean(x == y), nil
+	case "!=":
+		return boolean(x != y), nil
+	case "<":
+		return boolean(x < y), nil
+	case "<=":
+		return boolean(x <= y), nil
+	case ">":
+		return boolean(x > y), nil
+	case ">=":
+		return boolean(x >= y), nil
+	case "+":
+		return x + y, nil
+	case "-":
+		return x - y, nil
+	case "*":
+		return x * y, nil
+	case "/", "%":
+		if y == 0 {
+			return 0, ErrDivisionByZero
+		}
+		if op == "/" {
+			return x / y, nil
+		}
+		return math.Mod(x, y), nil
+	}
+	return math.Pow(x, y), nil
+}
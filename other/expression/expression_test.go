@@ -0,0 +1,202 @@
+package expression
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestTokenize(t *testing.T) {
+	tokens, err := Tokenize("max(x_1, 2.5e3)>=.5&&!y")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var texts []string
+	for _, tok := range tokens {
+		texts = append(texts, tok.Text)
+	}
+	if got, want := strings.Join(texts, " "), "max ( x_1 , 2.5e3 ) >= .5 && ! y"; got != want {
+		t.Errorf("tokens = %q, want %q", got, want)
+	}
+	if tokens[4].Kind != Number || tokens[4].Value != 2500 || tokens[4].Pos != 9 {
+		t.Errorf("number token = %+v", tokens[4])
+	}
+	for _, src := range []string{"1 = 2", "a & b", "3 # 4", "."} {
+		if _, err := Tokenize(src); !errors.Is(err, ErrSyntax) {
+			t.Errorf("Tokenize(%q) error = %v, want ErrSyntax", src, err)
+		}
+	}
+}
+
+func TestToRPN(t *testing.T) {
+	tests := []struct {
+		src, rpn string
+	}{
+		{"1 + 2 * 3", "1 2 3 * +"},
+		{"(1 + 2) * 3", "1 2 + 3 *"},
+		{"2 ^ 3 ^ 2", "2 3 2 ^ ^"},
+		{"-2 ^ 2", "2 2 ^ -"},
+		{"2 ^ -1", "2 1 - ^"},
+		{"10 - 4 - 3", "10 4 - 3 -"},
+		{"a < b && !c || d", "a b < c ! && d ||"},
+		{"max(1, f(2, 3) + 4, g())", "1 2 3 f/2 4 + g/0 max/3"},
+	}
+	for _, test := range tests {
+		tokens, _ := Tokenize(test.src)
+		rpn, err := ToRPN(tokens)
+		if err != nil {
+			t.Errorf("ToRPN(%q) error = %v", test.src, err)
+			continue
+		}
+		var texts []string
+		for _, tok := range rpn {
+			if tok.Kind == Function {
+				texts = append(texts, fmt.Sprintf("%s/%d", tok.Text, tok.Args))
+			} else {
+				texts = append(texts, tok.Text)
+			}
+		}
+		if got := strings.Join(texts, " "); got != test.rpn {
+			t.Errorf("ToRPN(%q) = %q, want %q", test.src, got, test.rpn)
+		}
+	}
+}
+
+func TestSyntaxErrors(t *testing.T) {
+	for _, src := range []string{"", "1 +", "* 2", "(1 + 2", "1 + 2)", "1 2", "f(1,)", "f(,1)", "()", "(1, 2)", "1 (2)", "x y"} {
+		if _, err := Eval(src, NewEnv()); !errors.Is(err, ErrSyntax) {
+			t.Errorf("Eval(%q) error = %v, want ErrSyntax", src, err)
+		}
+	}
+}
+
+func TestEval(t *testing.T) {
+	env := NewEnv()
+	env.Set("x", 3)
+	env.Set("y", -2)
+	env.Define("hypot", func(args ...float64) (float64, error) {
+		if len(args) != 2 {
+			return 0, ErrArity
+		}
+		return math.Hypot(args[0], args[1]), nil
+	})
+	tests := []struct {
+		src  string
+		want float64
+	}{
+		{"1 + 2 * 3", 7},
+		{"-2 ^ 2", -4},
+		{"2 ^ 3 ^ 2", 512},
+		{"7 % 4 + 10 / 4", 5.5},
+		{"x * y + 1", -5},
+		{"hypot(x, 4)", 5},
+		{"max(x, y, 10 - x) - min(1, 2)", 6},
+		{"x > 2 && y > 0", 0},
+		{"x > 2 || y > 0", 1},
+		{"!(x == 3) + !0", 1},
+		{"floor(pi) == 3 && true", 1},
+		{"--x", 3},
+		{"sqrt(16) + abs(y) * +2", 8},
+	}
+	for _, test := range tests {
+		got, err := Eval(test.src, env)
+		if err != nil || got != test.want {
+			t.Errorf("Eval(%q) = %v, %v, want %v", test.src, got, err, test.want)
+		}
+	}
+}
+
+func TestEvalErrors(t *testing.T) {
+	tests := []struct {
+		src  string
+		want error
+	}{
+		{"1 / (2 - 2)", ErrDivisionByZero},
+		{"5 % 0", ErrDivisionByZero},
+		{"z + 1", ErrUnknownVariable},
+		{"f(1)", ErrUnknownFunction},
+		{"sqrt(1, 2)", ErrArity},
+		{"max()", ErrArity},
+	}
+	for _, test := range tests {
+		if _, err := Eval(test.src, NewEnv()); !errors.Is(err, test.want) {
+			t.Errorf("Eval(%q) error = %v, want %v", test.src, err, test.want)
+		}
+	}
+	if _, err := Eval("1 + x", nil); !errors.Is(err, ErrUnknownVariable) {
+		t.Errorf("Eval with no environment error = %v, want ErrUnknownVariable", err)
+	}
+}
+
+func TestString(t *testing.T) {
+	root, err := Parse("-a + 2 * f(b, 1.5) ^ 2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "((-a) + (2 * (f(b, 1.5) ^ 2)))"
+	if got := root.String(); got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+	// the printed tree parses back to itself
+	again, err := Parse(want)
+	if err != nil || again.String() != want {
+		t.Errorf("Parse(%q).String() = %v, %v", want, again, err)
+	}
+}
+
+// randomExpression returns a random well-formed expression of the given depth.
+func randomExpression(rnd *rand.Rand, depth int) string {
+	if depth == 0 || rnd.Intn(4) == 0 {
+		if rnd.Intn(2) == 0 {
+			return fmt.Sprint(rnd.Intn(10))
+		}
+		return []string{"x", "y"}[rnd.Intn(2)]
+	}
+	switch rnd.Intn(6) {
+	case 0:
+		return []string{"-", "!", "+"}[rnd.Intn(3)] + randomExpression(rnd, depth-1)
+	case 1:
+		return "(" + randomExpression(rnd, depth-1) + ")"
+	case 2:
+		return "max(" + randomExpression(rnd, depth-1) + ", " + randomExpression(rnd, depth-1) + ")"
+	}
+	ops := []string{"||", "&&", "==", "!=", "<", "<=", ">", ">=", "+", "-", "*", "/", "%"}
+	return randomExpression(rnd, depth-1) + " " + ops[rnd.Intn(len(ops))] + " " + randomExpression(rnd, depth-1)
+}
+
+// TestRPNMatchesAST checks that evaluating the reverse Polish notation and
+// the syntax tree give the same result, and that printing the tree keeps it.
+func TestRPNMatchesAST(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	env := NewEnv()
+	env.Set("x", 3)
+	env.Set("y", 0.5)
+	same := func(a, b float64) bool { return a == b || math.IsNaN(a) && math.IsNaN(b) }
+	for i := 0; i < 1000; i++ {
+		src := randomExpression(rnd, 5)
+		tokens, err := Tokenize(src)
+		if err != nil {
+			t.Fatalf("Tokenize(%q) error = %v", src, err)
+		}
+		rpn, err := ToRPN(tokens)
+		if err != nil {
+			t.Fatalf("ToRPN(%q) error = %v", src, err)
+		}
+		root, err := BuildAST(rpn)
+		if err != nil {
+			t.Fatalf("BuildAST(%q) error = %v", src, err)
+		}
+		a, errA := EvalRPN(rpn, env)
+		b, errB := root.Eval(env)
+		if fmt.Sprint(errA) != fmt.Sprint(errB) || !same(a, b) {
+			t.Fatalf("%q: EvalRPN = %v, %v but Eval = %v, %v", src, a, errA, b, errB)
+		}
+		c, errC := Eval(root.String(), env)
+		if fmt.Sprint(errC) != fmt.Sprint(errB) || !same(c, b) {
+			t.Fatalf("%q printed as %q evaluates to %v, %v instead of %v, %v", src, root.String(), c, errC, b, errB)
+		}
+	}
+}
@@ -0,0 +1,218 @@
+// rpn.go
+// description: Shunting-yard conversion to reverse Polish notation and its evaluation
+// details:
+// The shunting-yard algorithm reads the tokens from left to right, sending
+// operands straight to the output and holding operators on a stack until an
+// operator binding less tightly, or a closing parenthesis, comes. Function
+// calls are held on the stack like operators, and the commas between their
+// arguments are counted so that each call knows its arity. The algorithm also
+// tracks whether an operand or an operator is expected next, which tells the
+// prefix operators from the binary ones and rejects malformed expressions.
+// The result is evaluated with a stack of values: operands are pushed, and an
+// operator or a call pops its arguments and pushes its result.
+// see token.go, expression_test.go
+
+package expression
+
+import (
+	"fmt"
+
+	"github.com/TheAlgorithms/Go/structure/stack"
+)
+
+// precedence returns the binding power of an operator.
+func precedence(t Token) int {
+	if t.Kind == Unary {
+		return 7
+	}
+	switch t.Text {
+	case "||":
+		return 1
+	case "&&":
+		return 2
+	case "==", "!=":
+		return 3
+	case "<", "<=", ">", ">=":
+		return 4
+	case "+", "-":
+		return 5
+	case "*", "/", "%":
+		return 6
+	}
+	return 8 // ^
+}
+
+func rightAssociative(t Token) bool {
+	return t.Kind == Unary || t.Text == "^"
+}
+
+// held is an element of the operator stack of ToRPN: an operator, a
+// function, or an opening parenthesis with the number of commas seen since
+// it, when it opens the arguments of a call.
+type held struct {
+	tok    Token
+	call   bool
+	commas int
+}
+
+// ToRPN orders the tokens of an expression in reverse Polish notation. The
+// prefix operators become Unary tokens, and the identifiers followed by an
+// opening parenthesis Function tokens with their number of arguments.
+func ToRPN(tokens []Token) ([]Token, error) {
+	out := make([]Token, 0, len(tokens))
+	ops := stack.NewStack[*held]()
+	unexpected := func(t Token) error {
+		return fmt.Errorf("%w: unexpected %q at %d", ErrSyntax, t.Text, t.Pos)
+	}
+	// popUntilParen moves the operators above the innermost opening
+	// parenthesis to the output, and returns the parenthesis.
+	popUntilParen := func(t Token) (*held, error) {
+		for !ops.IsEmpty() && ops.Peek().tok.Kind != LeftParen {
+			out = append(out, ops.Pop().tok)
+		}
+		if ops.IsEmpty() {
+			return nil, unexpected(t)
+		}
+		return ops.Peek(), nil
+	}
+	expectOperand := true
+	for i, t := range tokens {
+		switch t.Kind {
+		case Number, Identifier:
+			if !expectOperand {
+				return nil, unexpected(t)
+			}
+			if t.Kind == Identifier && i+1 < len(tokens) && tokens[i+1].Kind == LeftParen {
+				t.Kind = Function
+				ops.Push(&held{tok: t})
+				continue
+			}
+			out = append(out, t)
+			expectOperand = false
+		case Operator:
+			if expectOperand {
+				if t.Text != "-" && t.Text != "+" && t.Text != "!" {
+					return nil, unexpected(t)
+				}
+				t.Kind = Unary
+				ops.Push(&held{tok: t})
+				continue
+			}
+			for !ops.IsEmpty() {
+				top := ops.Peek().tok
+				if top.Kind != Operator && top.Kind != Unary {
+					break
+				}
+				if p, q := precedence(top), precedence(t); p < q || p == q && rightAssociative(t) {
+					break
+				}
+				out = append(out, ops.Pop().tok)
+			}
+			ops.Push(&held{tok: t})
+			expectOperand = true
+		case LeftParen:
+			if !expectOperand {
+				return nil, unexpected(t)
+			}
+			call := !ops.IsEmpty() && ops.Peek().tok.Kind == Function
+			ops.Push(&held{tok: t, call: call})
+		case Comma:
+			if expectOperand {
+				return nil, unexpected(t)
+			}
+			paren, err := popUntilParen(t)
+			if err != nil || !paren.call {
+				return nil, unexpected(t)
+			}
+			paren.commas++
+			expectOperand = true
+		case RightParen:
+			// a call may have no argument
+			empty := expectOperand && i > 0 && tokens[i-1].Kind == LeftParen
+			if expectOperand && !empty {
+				return nil, unexpected(t)
+			}
+			paren, err := popUntilParen(t)
+			if err != nil || empty && !paren.call {
+				return nil, unexpected(t)
+			}
+			ops.Pop()
+			if paren.call {
+				f := ops.Pop().tok
+				f.Args = paren.commas + 1
+				if empty {
+					f.Args = 0
+				}
+				out = append(out, f)
+			}
+			expectOperand = false
+		}
+	}
+	if expectOperand {
+		return nil, fmt.Errorf("%w: unexpected end of expression", ErrSyntax)
+	}
+	for !ops.IsEmpty() {
+		h := ops.Pop()
+		if h.tok.Kind == LeftParen {
+			return nil, fmt.Errorf("%w: unclosed %q at %d", ErrSyntax, h.tok.Text, h.tok.Pos)
+		}
+		out = append(out, h.tok)
+	}
+	return out, nil
+}
+
+// EvalRPN evaluates the tokens of an expression in reverse Polish notation,
+// as returned by ToRPN, looking the variables and functions up in env.
+func EvalRPN(rpn []Token, env *Env) (float64, error) {
+	values := stack.NewStack[float64]()
+	// pop removes the last n values, in the order they were pushed.
+	pop := func(n int, t Token) ([]float64, error) {
+		if values.Length() < n {
+			return nil, fmt.Errorf("%w: missing operand of %q at %d", ErrSyntax, t.Text, t.Pos)
+		}
+		args := make([]float64, n)
+		for i := n - 1; i >= 0; i-- {
+			args[i] = values.Pop()
+		}
+		return args, nil
+	}
+	for _, t := range rpn {
+		var v float64
+		var err error
+		switch t.Kind {
+		case Number:
+			v = t.Value
+		case Identifier:
+			v, err = env.variable(t.Text)
+		case Unary, Operator, Function:
+			n := t.Args
+			if t.Kind == Unary {
+				n = 1
+			} else if t.Kind == Operator {
+				n = 2
+			}
+			var args []float64
+			if args, err = pop(n, t); err != nil {
+				return 0, err
+			}
+			switch t.Kind {
+			case Unary:
+				v = unary(t.Text, args[0])
+			case Operator:
+				v, err = binary(t.Text, args[0], args[1])
+			default:
+				v, err = env.call(t.Text, args)
+			}
+		default:
+			return 0, fmt.Errorf("%w: unexpected %q at %d", ErrSyntax, t.Text, t.Pos)
+		}
+		if err != nil {
+			return 0, err
+		}
+		values.Push(v)
+	}
+	if values.Length() != 1 {
+		return 0, fmt.Errorf("%w: %d values left after evaluation", ErrSyntax, values.Length())
+	}
+	return values.Pop(), nil
+}
@@ -0,0 +1,150 @@
+// token.go
+// description: Tokenizer of infix arithmetic and boolean expressions
+// details:
+// An expression is made of numbers such as 3, 2.5 or 1e-3, identifiers naming
+// variables and functions, parentheses, commas separating the arguments of a
+// function, and the operators below, from the loosest to the tightest:
+//   ||
+//   &&
+//   == !=
+//   < <= > >=
+//   + -
+//   * / %
+//   unary - + !
+//   ^ (power, right associative, so that -2^2 is -4)
+// There is a single type of value, float64. Booleans are numbers: the
+// comparisons and the logical operators give 1 for true and 0 for false, and
+// take any number other than 0 as true. Both operands of && and || are
+// always evaluated.
+// An expression goes through three stages: Tokenize splits it into tokens,
+// ToRPN orders them in reverse Polish notation with the shunting-yard
+// algorithm, and EvalRPN evaluates them with a stack, or BuildAST turns them
+// into a syntax tree whose nodes evaluate themselves.
+// time complexity: O(n) for each stage, for n characters
+// space complexity: O(n)
+// reference: https://en.wikipedia.org/wiki/Shunting_yard_algorithm
+// see expression_test.go
+
+// Package expression parses and evaluates arithmetic and boolean expressions
+// with variables and functions.
+package expression
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ErrSyntax is returned when an expression is not well formed.
+var ErrSyntax = errors.New("syntax error")
+
+// Kind is the kind of a token.
+type Kind int
+
+const (
+	// Number is a numeric literal.
+	Number Kind = iota
+	// Identifier is the name of a variable, or of a function before ToRPN.
+	Identifier
+	// Operator is a binary operator, or any operator before ToRPN.
+	Operator
+	// Unary is a prefix operator, told apart from the binary ones by ToRPN.
+	Unary
+	// Function is a function call, told apart from the variables by ToRPN.
+	Function
+	// LeftParen is an opening parenthesis.
+	LeftParen
+	// RightParen is a closing parenthesis.
+	RightParen
+	// Comma separates the arguments of a function.
+	Comma
+)
+
+// Token is a token of an expression.
+type Token struct {
+	Kind  Kind
+	Text  string  // the token as written
+	Value float64 // the value of a Number
+	Args  int     // the number of arguments of a Function
+	Pos   int     // the byte offset of the token in the expression
+}
+
+// operators lists the operators, the longer before their prefixes.
+var operators = []string{"||", "&&", "==", "!=", "<=", ">=", "<", ">", "+", "-", "*", "/", "%", "^", "!"}
+
+// Tokenize splits an expression into tokens.
+func Tokenize(src string) ([]Token, error) {
+	var tokens []Token
+	for i := 0; i < len(src); {
+		c := rune(src[i])
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case c >= '0' && c <= '9' || c == '.':
+			end := scanNumber(src, i)
+			v, err := strconv.ParseFloat(src[i:end], 64)
+			if err != nil {
+				return nil, fmt.Errorf("%w: invalid number %q at %d", ErrSyntax, src[i:end], i)
+			}
+			tokens = append(tokens, Token{Kind: Number, Text: src[i:end], Value: v, Pos: i})
+			i = end
+		case c == '_' || unicode.IsLetter(c):
+			end := i
+			for end < len(src) && (src[end] == '_' || unicode.IsLetter(rune(src[end])) || unicode.IsDigit(rune(src[end]))) {
+				end++
+			}
+			tokens = append(tokens, Token{Kind: Identifier, Text: src[i:end], Pos: i})
+			i = end
+		case c == '(':
+			tokens = append(tokens, Token{Kind: LeftParen, Text: "(", Pos: i})
+			i++
+		case c == ')':
+			tokens = append(tokens, Token{Kind: RightParen, Text: ")", Pos: i})
+			i++
+		case c == ',':
+			tokens = append(tokens, Token{Kind: Comma, Text: ",", Pos: i})
+			i++
+		default:
+			op := ""
+			for _, o := range operators {
+				if strings.HasPrefix(src[i:], o) {
+					op = o
+					break
+				}
+			}
+			if op == "" {
+				return nil, fmt.Errorf("%w: unexpected %q at %d", ErrSyntax, src[i], i)
+			}
+			tokens = append(tokens, Token{Kind: Operator, Text: op, Pos: i})
+			i += len(op)
+		}
+	}
+	return tokens, nil
+}
+
+// scanNumber returns the end of the number starting at i: digits with an
+// optional fraction and an optional exponent.
+func scanNumber(src string, i int) int {
+	digits := func(i int) int {
+		for i < len(src) && src[i] >= '0' && src[i] <= '9' {
+			i++
+		}
+		return i
+	}
+	i = digits(i)
+	if i < len(src) && src[i] == '.' {
+		i = digits(i + 1)
+	}
+	if i < len(src) && (src[i] == 'e' || src[i] == 'E') {
+		j := i + 1
+		if j < len(src) && (src[j] == '+' || src[j] == '-') {
+			j++
+		}
+		if end := digits(j); end > j {
+			i = end
+		}
+	}
+	return i
+}
@@ -0,0 +1,187 @@
+// instrument.go
+// description: Counters of comparisons, swaps and visits made by an algorithm
+// details:
+// Complexity bounds hide constant factors and say nothing of the inputs an
+// algorithm is actually run on. Counting its basic operations shows both:
+// the comparisons of a sort or a search, the swaps of an in-place sort and
+// the nodes or vertices visited by a traversal. The algorithms of this
+// repository take the hooks to count these without changing them: less
+// functions, the swap hooks of the sorts of type sort.SwapFunc, the visit
+// hooks of the binary search trees, graph visitors and successor
+// functions, sequences and the functions a search evaluates. The wrappers
+// below report every call of a hook to a Stats, and Counter is the Stats
+// that adds them up. Slice counts the swaps of the sorts working through
+// sort.Interface, such as those of the standard library.
+// time complexity: O(1) per counted operation
+// space complexity: O(1)
+// reference: https://en.wikipedia.org/wiki/Profiling_(computer_programming)
+// see instrument_test.go
+
+// Package instrument counts the operations done by the algorithms of the
+// other packages, to measure their complexity empirically.
+package instrument
+
+import (
+	"sync/atomic"
+
+	"github.com/TheAlgorithms/Go/constraints"
+	"github.com/TheAlgorithms/Go/graph"
+	"github.com/TheAlgorithms/Go/structure/iterutil"
+)
+
+// Stats receives the operations of an instrumented algorithm.
+type Stats interface {
+	// Compare is called for every comparison of two elements.
+	Compare()
+	// Swap is called for every exchange of two elements.
+	Swap()
+	// Visit is called for every node, vertex or value visited.
+	Visit()
+}
+
+// Counts are the numbers of operations counted by a Counter.
+type Counts struct {
+	Comparisons int64
+	Swaps       int64
+	Visits      int64
+}
+
+// Counter is a Stats adding up the operations. It is safe for concurrent
+// use, so parallel algorithms can share one, and its zero value is ready
+// to use.
+type Counter struct {
+	comparisons, swaps, visits atomic.Int64
+}
+
+// Verify that Counter is a Stats.
+var _ Stats = (*Counter)(nil)
+
+// Compare counts a comparison.
+func (c *Counter) Compare() {
+	c.comparisons.Add(1)
+}
+
+// Swap counts a swap.
+func (c *Counter) Swap() {
+	c.swaps.Add(1)
+}
+
+// Visit counts a visit.
+func (c *Counter) Visit() {
+	c.visits.Add(1)
+}
+
+// Counts returns the operations counted so far.
+func (c *Counter) Counts() Counts {
+	return Counts{
+		Comparisons: c.comparisons.Load(),
+		Swaps:       c.swaps.Load(),
+		Visits:      c.visits.Load(),
+	}
+}
+
+// Reset sets the counts back to zero.
+func (c *Counter) Reset() {
+	c.comparisons.Store(0)
+	c.swaps.Store(0)
+	c.visits.Store(0)
+}
+
+// Less returns less counting a comparison on every call, for the sorts,
+// selections and heaps ordered by a less function.
+func Less[T any](less func(a, b T) bool, s Stats) func(a, b T) bool {
+	return func(a, b T) bool {
+		s.Compare()
+		return less(a, b)
+	}
+}
+
+// Ordered returns the natural order of T counting a comparison on every
+// call.
+func Ordered[T constraints.Ordered](s Stats) func(a, b T) bool {
+	return Less(func(a, b T) bool { return a < b }, s)
+}
+
+// Swaps returns a swap hook counting a swap on every call, for the sorts of
+// type sort.SwapFunc, such as sort.PdqWithSwap.
+func Swaps(s Stats) func(i, j int) {
+	return func(i, j int) {
+		s.Swap()
+	}
+}
+
+// Nodes returns a visit hook counting a visit on every call, for the trees
+// reporting the nodes their searches and updates walk through, such as
+// tree.RB with SetVisit.
+func Nodes[T any](s Stats) func(key T) {
+	return func(key T) {
+		s.Visit()
+	}
+}
+
+// Slice is a sort.Interface over a slice counting the comparisons and the
+// swaps made through it.
+type Slice[T any] struct {
+	Items  []T
+	LessFn func(a, b T) bool
+	Stats  Stats
+}
+
+// Len returns the length of the slice.
+func (s Slice[T]) Len() int {
+	return len(s.Items)
+}
+
+// Less compares the elements at i and j and counts a comparison.
+func (s Slice[T]) Less(i, j int) bool {
+	s.Stats.Compare()
+	return s.LessFn(s.Items[i], s.Items[j])
+}
+
+// Swap exchanges the elements at i and j and counts a swap.
+func (s Slice[T]) Swap(i, j int) {
+	s.Stats.Swap()
+	s.Items[i], s.Items[j] = s.Items[j], s.Items[i]
+}
+
+// Func returns f counting a visit on every call, for the searches that
+// evaluate a function, such as search.TernaryMin, or a predicate, such as
+// sort.Search of the standard library.
+func Func[A, R any](f func(A) R, s Stats) func(A) R {
+	return func(a A) R {
+		s.Visit()
+		return f(a)
+	}
+}
+
+// Seq returns seq counting a visit for every value it yields, such as the
+// nodes of a tree traversal.
+func Seq[T any](seq iterutil.Seq[T], s Stats) iterutil.Seq[T] {
+	return func(yield func(T) bool) {
+		seq(func(v T) bool {
+			s.Visit()
+			return yield(v)
+		})
+	}
+}
+
+// Visitor returns v counting a visit for every vertex it discovers.
+func Visitor(v graph.Visitor, s Stats) graph.Visitor {
+	discover := v.Discover
+	v.Discover = func(u int) {
+		s.Visit()
+		if discover != nil {
+			discover(u)
+		}
+	}
+	return v
+}
+
+// Successors returns next counting a visit for every state it expands,
+// for the searches of graph.AStar and its variants.
+func Successors[S any](next graph.Successors[S], s Stats) graph.Successors[S] {
+	return func(state S, visit func(next S, cost float64)) {
+		s.Visit()
+		next(state, visit)
+	}
+}
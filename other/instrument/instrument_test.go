@@ -0,0 +1,162 @@
+package instrument_test
+
+import (
+	"math"
+	"math/rand"
+	stdsort "sort"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/graph"
+	"github.com/TheAlgorithms/Go/other/instrument"
+	"github.com/TheAlgorithms/Go/search"
+	"github.com/TheAlgorithms/Go/sort"
+	"github.com/TheAlgorithms/Go/structure/tree"
+)
+
+func TestCounter(t *testing.T) {
+	var c instrument.Counter
+	c.Compare()
+	c.Compare()
+	c.Swap()
+	c.Visit()
+	if got, want := c.Counts(), (instrument.Counts{Comparisons: 2, Swaps: 1, Visits: 1}); got != want {
+		t.Errorf("Counts() = %+v, want %+v", got, want)
+	}
+	c.Reset()
+	if got := c.Counts(); got != (instrument.Counts{}) {
+		t.Errorf("Counts() after Reset = %+v", got)
+	}
+}
+
+func TestLessParallel(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	items := rnd.Perm(5000)
+	var c instrument.Counter
+	sort.ParallelMergeFunc(items, instrument.Ordered[int](&c), 64)
+	if !stdsort.IntsAreSorted(items) {
+		t.Fatal("ParallelMergeFunc did not sort")
+	}
+	// merge sort makes at most n log n comparisons
+	if n := c.Counts().Comparisons; n < 5000 || n > 5000*13 {
+		t.Errorf("ParallelMergeFunc made %d comparisons", n)
+	}
+}
+
+func TestSlice(t *testing.T) {
+	var c instrument.Counter
+	items := []int{1, 2, 3, 4, 5, 6, 7, 8}
+	stdsort.Sort(stdsort.Reverse(instrument.Slice[int]{Items: items, LessFn: func(a, b int) bool { return a < b }, Stats: &c}))
+	if !stdsort.IsSorted(stdsort.Reverse(stdsort.IntSlice(items))) {
+		t.Fatalf("items = %v, want them in decreasing order", items)
+	}
+	if counts := c.Counts(); counts.Swaps == 0 || counts.Comparisons == 0 {
+		t.Errorf("reversing counted %+v", counts)
+	}
+}
+
+func TestSwaps(t *testing.T) {
+	rnd := rand.New(rand.NewSource(2))
+	items := rnd.Perm(1000)
+	var c instrument.Counter
+	sort.PdqWithSwap(items, instrument.Ordered[int](&c), instrument.Swaps(&c))
+	if !stdsort.IntsAreSorted(items) {
+		t.Fatal("PdqWithSwap did not sort")
+	}
+	// every swap but the last of a partition puts two elements in place
+	if counts := c.Counts(); counts.Swaps == 0 || counts.Swaps > 1000*11 || counts.Comparisons < counts.Swaps {
+		t.Errorf("PdqWithSwap counted %+v", counts)
+	}
+	// sorted input is recognized without moving anything
+	c.Reset()
+	sort.SymMergeSortWithSwap(items, instrument.Ordered[int](&c), instrument.Swaps(&c))
+	if counts := c.Counts(); counts.Swaps != 0 || counts.Comparisons == 0 {
+		t.Errorf("SymMergeSortWithSwap of sorted input counted %+v", counts)
+	}
+}
+
+func TestNodes(t *testing.T) {
+	var c instrument.Counter
+	rb := tree.NewRB[int]()
+	rb.SetVisit(instrument.Nodes[int](&c))
+	for k := 0; k < 1023; k++ {
+		rb.Push(k)
+	}
+	pushes := c.Counts().Visits
+	// each insertion walks down at most twice the height of a perfect tree
+	if pushes == 0 || pushes > 1023*2*10 {
+		t.Errorf("1023 pushes visited %d nodes", pushes)
+	}
+	c.Reset()
+	if !rb.Has(0) || rb.Has(-1) {
+		t.Fatal("Has disagrees with the pushed keys")
+	}
+	if n := c.Counts().Visits; n == 0 || n > 2*2*10 {
+		t.Errorf("two lookups visited %d nodes", n)
+	}
+	c.Reset()
+	rb.Delete(rb.Root.Key())
+	if n := c.Counts().Visits; n == 0 || n > 2*2*10 {
+		t.Errorf("deleting the root visited %d nodes", n)
+	}
+	rb.SetVisit(nil)
+	c.Reset()
+	rb.Has(5)
+	if n := c.Counts().Visits; n != 0 {
+		t.Errorf("Has without a hook counted %d visits", n)
+	}
+}
+
+func TestFunc(t *testing.T) {
+	var c instrument.Counter
+	f := instrument.Func(func(x float64) float64 { return (x - 1) * (x - 1) }, &c)
+	min, err := search.TernaryMin(-10, 10, 1e-6, f)
+	if err != nil || math.Abs(min) > 1e-9 {
+		t.Fatalf("TernaryMin = %v, %v, want 0", min, err)
+	}
+	// every iteration shrinks the interval to two thirds with two evaluations
+	if n := c.Counts().Visits; n < 2*30 || n > 2*60 {
+		t.Errorf("TernaryMin evaluated f %d times", n)
+	}
+}
+
+func TestSeq(t *testing.T) {
+	avl := tree.NewAVL[int]()
+	avl.Push(5, 3, 8, 1, 4)
+	var c instrument.Counter
+	instrument.Seq(avl.InOrderSeq(), &c)(func(v int) bool { return v != 4 })
+	if n := c.Counts().Visits; n != 3 {
+		t.Errorf("stopping at 4 visited %d keys, want 3", n)
+	}
+}
+
+func TestGraph(t *testing.T) {
+	g := graph.New(6)
+	for _, e := range [][2]int{{0, 1}, {1, 2}, {2, 0}, {3, 4}} {
+		g.AddEdge(e[0], e[1])
+	}
+	var c instrument.Counter
+	var order []int
+	g.BFS(0, instrument.Visitor(graph.Visitor{Discover: func(v int) { order = append(order, v) }}, &c))
+	if n := c.Counts().Visits; n != 3 || len(order) != 3 {
+		t.Errorf("BFS from 0 visited %d vertices, discovered %v", n, order)
+	}
+	c.Reset()
+	g.DFS(3, instrument.Visitor(graph.Visitor{}, &c))
+	if n := c.Counts().Visits; n != 2 {
+		t.Errorf("DFS from 3 visited %d vertices, want 2", n)
+	}
+
+	// a line of states from 0 to 10, searched without a heuristic
+	c.Reset()
+	next := instrument.Successors[int](func(s int, visit func(int, float64)) {
+		if s < 10 {
+			visit(s+1, 1)
+		}
+	}, &c)
+	if r := graph.AStar(0, 10, next, nil); !r.Found || r.Cost != 10 {
+		t.Fatalf("AStar = %+v", r)
+	}
+	if n := c.Counts().Visits; n != 10 {
+		t.Errorf("AStar expanded %d states, want 10", n)
+	}
+}
@@ -0,0 +1,66 @@
+// measure.go
+// description: Operation counts over growing inputs and their growth rate
+// details:
+// Measure runs an algorithm on inputs of increasing sizes with a fresh
+// Counter each time, giving the points of a plot of its cost against the
+// size. If the cost grows like c n^k, the points lie on a line of slope k
+// once both axes are logarithmic, so GrowthExponent estimates k by the
+// least squares line through them. A factor log n adds a little to the
+// slope: about 1.1 to 1.2 for n log n over the usual sizes.
+// time complexity: O(s) for s sizes, besides the runs
+// space complexity: O(s)
+// reference: https://en.wikipedia.org/wiki/Log%E2%80%93log_plot
+// see measure_test.go
+
+package instrument
+
+import (
+	"errors"
+	"math"
+)
+
+// ErrTooFewSamples is returned when fewer than two samples of positive size
+// and count are given to fit a growth rate.
+var ErrTooFewSamples = errors.New("at least two samples with positive size and count are required")
+
+// Sample holds the operations counted for an input of size N.
+type Sample struct {
+	N int
+	Counts
+}
+
+// Measure calls run for every size with a Counter, new for every run, and
+// returns the counts of each run in the order of sizes.
+func Measure(sizes []int, run func(n int, s Stats)) []Sample {
+	samples := make([]Sample, len(sizes))
+	for i, n := range sizes {
+		var c Counter
+		run(n, &c)
+		samples[i] = Sample{N: n, Counts: c.Counts()}
+	}
+	return samples
+}
+
+// GrowthExponent returns the slope of the least squares line through the
+// points (log N, log metric) of the samples, the k of a cost growing like
+// n^k. Samples whose size or metric is not positive are ignored.
+func GrowthExponent(samples []Sample, metric func(Counts) int64) (float64, error) {
+	var n, sx, sy, sxx, sxy float64
+	for _, s := range samples {
+		m := metric(s.Counts)
+		if s.N <= 0 || m <= 0 {
+			continue
+		}
+		x, y := math.Log(float64(s.N)), math.Log(float64(m))
+		n++
+		sx += x
+		sy += y
+		sxx += x * x
+		sxy += x * y
+	}
+	d := n*sxx - sx*sx
+	if n < 2 || d == 0 {
+		return 0, ErrTooFewSamples
+	}
+	return (n*sxy - sx*sy) / d, nil
+}
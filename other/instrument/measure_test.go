@@ -0,0 +1,60 @@
+package instrument_test
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/other/instrument"
+	"github.com/TheAlgorithms/Go/sort"
+)
+
+func comparisons(c instrument.Counts) int64 {
+	return c.Comparisons
+}
+
+func TestGrowthExponent(t *testing.T) {
+	sizes := []int{256, 512, 1024, 2048, 4096}
+	rnd := rand.New(rand.NewSource(1))
+
+	// insertion sort of a random permutation compares about n²/4 times
+	quadratic := instrument.Measure(sizes, func(n int, s instrument.Stats) {
+		items, less := rnd.Perm(n), instrument.Ordered[int](s)
+		for i := 1; i < n; i++ {
+			for j := i; j > 0 && less(items[j], items[j-1]); j-- {
+				items[j], items[j-1] = items[j-1], items[j]
+			}
+		}
+	})
+	k, err := instrument.GrowthExponent(quadratic, comparisons)
+	if err != nil || k < 1.9 || k > 2.1 {
+		t.Errorf("insertion sort grows like n^%v, %v, want n^2", k, err)
+	}
+
+	pdq := instrument.Measure(sizes, func(n int, s instrument.Stats) {
+		sort.Pdq(rnd.Perm(n), instrument.Ordered[int](s))
+	})
+	for i, sample := range pdq {
+		if sample.N != sizes[i] || sample.Comparisons == 0 {
+			t.Fatalf("Measure gave %+v for size %d", sample, sizes[i])
+		}
+	}
+	k, err = instrument.GrowthExponent(pdq, comparisons)
+	if err != nil || k < 1 || k > 1.25 {
+		t.Errorf("Pdq grows like n^%v, %v, want n log n", k, err)
+	}
+}
+
+func TestGrowthExponentTooFewSamples(t *testing.T) {
+	tests := [][]instrument.Sample{
+		nil,
+		{{N: 10, Counts: instrument.Counts{Comparisons: 5}}},
+		{{N: 10, Counts: instrument.Counts{Comparisons: 5}}, {N: 20}},
+		{{N: 10, Counts: instrument.Counts{Comparisons: 5}}, {N: 10, Counts: instrument.Counts{Comparisons: 7}}},
+	}
+	for _, samples := range tests {
+		if _, err := instrument.GrowthExponent(samples, comparisons); !errors.Is(err, instrument.ErrTooFewSamples) {
+			t.Errorf("GrowthExponent(%+v) error = %v", samples, err)
+		}
+	}
+}
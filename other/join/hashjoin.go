@@ -0,0 +1,54 @@
+// hashjoin.go
+// description: Hash join of two streams of keyed records
+// details:
+// An equi-join pairs every record of the left stream with every record of
+// the right stream having the same key. The hash join reads the right
+// stream, the build side, into a hash table from structure/hashmap mapping
+// every key to its records, then streams the left side, the probe side,
+// looking each key up. Only the build side is held in memory, so it should
+// be the smaller input; the inputs need no order and the output follows the
+// order of the left stream.
+// time complexity: O(n + m + k) expected for inputs of n and m records and k
+// joined pairs
+// space complexity: O(m)
+// reference: https://en.wikipedia.org/wiki/Hash_join
+// see join_test.go
+
+// Package join implements equi-joins of streams of keyed records, taking
+// and producing the sequences of structure/iterutil: a hash join, a
+// sort-merge join of sorted inputs and an external sort-merge join sorting
+// inputs larger than memory.
+package join
+
+import (
+	"github.com/TheAlgorithms/Go/structure/hashmap"
+	"github.com/TheAlgorithms/Go/structure/iterutil"
+)
+
+// HashJoin returns the pairs of records of left and right with equal keys,
+// leftKey and rightKey giving the keys of the records. Keys are hashed with
+// hash, or hashmap.DefaultHash if hash is nil. right is read in full when
+// the iteration starts.
+func HashJoin[L, R any, K comparable](left iterutil.Seq[L], right iterutil.Seq[R], leftKey func(L) K, rightKey func(R) K, hash hashmap.HashFunc[K]) iterutil.Seq2[L, R] {
+	if hash == nil {
+		hash = hashmap.DefaultHash[K]
+	}
+	return func(yield func(L, R) bool) {
+		build := hashmap.NewChained[K, []R](hash)
+		right(func(r R) bool {
+			k := rightKey(r)
+			group, _ := build.Get(k)
+			build.Put(k, append(group, r))
+			return true
+		})
+		left(func(l L) bool {
+			group, _ := build.Get(leftKey(l))
+			for _, r := range group {
+				if !yield(l, r) {
+					return false
+				}
+			}
+			return true
+		})
+	}
+}
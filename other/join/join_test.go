@@ -0,0 +1,216 @@
+package join
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/structure/iterutil"
+)
+
+// order is a record of the left side, joined on its customer.
+type order struct {
+	customer int
+	amount   int
+}
+
+// customer is a record of the right side, joined on its id.
+type customer struct {
+	id   int
+	name string
+}
+
+func orderKey(o order) int       { return o.customer }
+func customerKey(c customer) int { return c.id }
+
+// nestedLoopJoin returns every pair of records with equal keys, the obvious
+// way, as strings sorted for comparison.
+func nestedLoopJoin(orders []order, customers []customer) []string {
+	var pairs []string
+	for _, o := range orders {
+		for _, c := range customers {
+			if o.customer == c.id {
+				pairs = append(pairs, fmt.Sprint(o, c))
+			}
+		}
+	}
+	sort.Strings(pairs)
+	return pairs
+}
+
+// collectPairs returns the pairs of seq as strings, sorted when sorted is
+// set.
+func collectPairs(seq iterutil.Seq2[order, customer], sorted bool) []string {
+	var pairs []string
+	seq(func(o order, c customer) bool {
+		pairs = append(pairs, fmt.Sprint(o, c))
+		return true
+	})
+	if sorted {
+		sort.Strings(pairs)
+	}
+	return pairs
+}
+
+func randomRecords(rnd *rand.Rand, n, m, keys int) ([]order, []customer) {
+	orders := make([]order, n)
+	for i := range orders {
+		orders[i] = order{customer: rnd.Intn(keys), amount: i}
+	}
+	customers := make([]customer, m)
+	for i := range customers {
+		customers[i] = customer{id: rnd.Intn(keys), name: fmt.Sprint("c", i)}
+	}
+	return orders, customers
+}
+
+func encodeOrder(w io.Writer, o order) error {
+	return binary.Write(w, binary.LittleEndian, [2]int64{int64(o.customer), int64(o.amount)})
+}
+
+func decodeOrder(r io.Reader) (order, error) {
+	var v [2]int64
+	if err := binary.Read(r, binary.LittleEndian, &v); err != nil {
+		return order{}, err
+	}
+	return order{int(v[0]), int(v[1])}, nil
+}
+
+func encodeCustomer(w io.Writer, c customer) error {
+	_, err := fmt.Fprintf(w, "%d %s\n", c.id, c.name)
+	return err
+}
+
+func decodeCustomer(r io.Reader) (customer, error) {
+	var c customer
+	_, err := fmt.Fscanf(r, "%d %s\n", &c.id, &c.name)
+	return c, err
+}
+
+func newExternalJoin(runSize int, dir string) *ExternalMergeJoin[order, customer, int] {
+	return &ExternalMergeJoin[order, customer, int]{
+		LeftKey: orderKey, RightKey: customerKey,
+		EncodeLeft: encodeOrder, DecodeLeft: decodeOrder,
+		EncodeRight: encodeCustomer, DecodeRight: decodeCustomer,
+		RunSize: runSize, TempDir: dir,
+	}
+}
+
+func TestJoins(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for iter := 0; iter < 30; iter++ {
+		orders, customers := randomRecords(rnd, rnd.Intn(60), rnd.Intn(30), 1+rnd.Intn(20))
+		want := nestedLoopJoin(orders, customers)
+
+		got := collectPairs(HashJoin(iterutil.FromSlice(orders), iterutil.FromSlice(customers), orderKey, customerKey, nil), true)
+		if !reflect.DeepEqual(got, want) {
+			t.Fatalf("HashJoin = %v, want %v", got, want)
+		}
+
+		sortedOrders := append([]order(nil), orders...)
+		sort.SliceStable(sortedOrders, func(i, j int) bool { return sortedOrders[i].customer < sortedOrders[j].customer })
+		sortedCustomers := append([]customer(nil), customers...)
+		sort.SliceStable(sortedCustomers, func(i, j int) bool { return sortedCustomers[i].id < sortedCustomers[j].id })
+		merged := SortMergeJoin(iterutil.FromSlice(sortedOrders), iterutil.FromSlice(sortedCustomers), orderKey, customerKey)
+		got = collectPairs(merged, false)
+		if len(got) != len(want) || len(got) > 0 && !reflect.DeepEqual(sortedCopy(got), want) {
+			t.Fatalf("SortMergeJoin = %v, want %v", got, want)
+		}
+		last := -1
+		merged(func(o order, _ customer) bool {
+			if o.customer < last {
+				t.Fatalf("SortMergeJoin gave key %d after %d", o.customer, last)
+			}
+			last = o.customer
+			return true
+		})
+
+		j := newExternalJoin(1+rnd.Intn(8), t.TempDir())
+		got = collectPairs(j.Join(iterutil.FromSlice(orders), iterutil.FromSlice(customers)), true)
+		if err := j.Err(); err != nil {
+			t.Fatal(err)
+		}
+		if len(got) != len(want) || len(got) > 0 && !reflect.DeepEqual(got, want) {
+			t.Fatalf("ExternalMergeJoin = %v, want %v", got, want)
+		}
+	}
+}
+
+func sortedCopy(s []string) []string {
+	c := append([]string(nil), s...)
+	sort.Strings(c)
+	return c
+}
+
+func TestJoinEarlyStop(t *testing.T) {
+	orders := []order{{1, 10}, {1, 11}, {2, 20}, {3, 30}}
+	customers := []customer{{1, "ann"}, {1, "bob"}, {3, "cid"}}
+	joins := map[string]iterutil.Seq2[order, customer]{
+		"HashJoin":          HashJoin(iterutil.FromSlice(orders), iterutil.FromSlice(customers), orderKey, customerKey, nil),
+		"SortMergeJoin":     SortMergeJoin(iterutil.FromSlice(orders), iterutil.FromSlice(customers), orderKey, customerKey),
+		"ExternalMergeJoin": newExternalJoin(2, t.TempDir()).Join(iterutil.FromSlice(orders), iterutil.FromSlice(customers)),
+	}
+	for name, seq := range joins {
+		n := 0
+		seq(func(order, customer) bool {
+			n++
+			return n < 3
+		})
+		if n != 3 {
+			t.Errorf("%s went on for %d pairs after yield returned false", name, n)
+		}
+		if got := len(collectPairs(seq, false)); got != 5 {
+			t.Errorf("%s gave %d pairs, want 5", name, got)
+		}
+	}
+}
+
+func TestExternalMergeJoinErrors(t *testing.T) {
+	j := newExternalJoin(2, t.TempDir())
+	j.DecodeRight = func(io.Reader) (customer, error) { return customer{}, errors.New("bad record") }
+	got := collectPairs(j.Join(iterutil.FromSlice([]order{{1, 1}}), iterutil.FromSlice([]customer{{1, "ann"}})), false)
+	if len(got) != 0 || j.Err() == nil {
+		t.Errorf("Join with a failing decoder = %v, Err = %v", got, j.Err())
+	}
+	j = &ExternalMergeJoin[order, customer, int]{LeftKey: orderKey}
+	j.Join(nil, nil)(func(order, customer) bool { return true })
+	if j.Err() == nil {
+		t.Errorf("Join without encoders should fail")
+	}
+}
+
+func benchmarkJoin(b *testing.B, join func(orders []order, customers []customer) iterutil.Seq2[order, customer]) {
+	rnd := rand.New(rand.NewSource(1))
+	orders, customers := randomRecords(rnd, 20000, 2000, 2000)
+	sort.Slice(orders, func(i, j int) bool { return orders[i].customer < orders[j].customer })
+	sort.Slice(customers, func(i, j int) bool { return customers[i].id < customers[j].id })
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		join(orders, customers)(func(order, customer) bool { return true })
+	}
+}
+
+func BenchmarkHashJoin(b *testing.B) {
+	benchmarkJoin(b, func(orders []order, customers []customer) iterutil.Seq2[order, customer] {
+		hash := func(k int) uint64 { return uint64(k) }
+		return HashJoin(iterutil.FromSlice(orders), iterutil.FromSlice(customers), orderKey, customerKey, hash)
+	})
+}
+
+func BenchmarkSortMergeJoin(b *testing.B) {
+	benchmarkJoin(b, func(orders []order, customers []customer) iterutil.Seq2[order, customer] {
+		return SortMergeJoin(iterutil.FromSlice(orders), iterutil.FromSlice(customers), orderKey, customerKey)
+	})
+}
+
+func BenchmarkExternalMergeJoin(b *testing.B) {
+	dir := b.TempDir()
+	benchmarkJoin(b, func(orders []order, customers []customer) iterutil.Seq2[order, customer] {
+		return newExternalJoin(4096, dir).Join(iterutil.FromSlice(orders), iterutil.FromSlice(customers))
+	})
+}
@@ -0,0 +1,186 @@
+// mergejoin.go
+// description: Sort-merge join of two streams of keyed records
+// details:
+// When both streams are sorted by key, the join walks them in step like the
+// merge of merge sort: the stream with the smaller key advances, and on
+// equal keys the group of right records with that key is gathered and
+// paired with every left record of the key. Only one group is held in
+// memory and the output comes sorted by key. Inputs that are not sorted are
+// first sorted by the external merge sort of the sort package, which spills
+// sorted runs to temporary files and k-way merges them, so that the join
+// handles inputs larger than memory.
+// time complexity: O(n + m + k) for sorted inputs of n and m records and k
+// joined pairs, plus O(n log n + m log m) to sort them
+// space complexity: O(g) for a largest group of g right records, plus
+// RunSize records while sorting
+// reference: https://en.wikipedia.org/wiki/Sort-merge_join
+// see join_test.go
+
+package join
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"os"
+
+	"github.com/TheAlgorithms/Go/constraints"
+	"github.com/TheAlgorithms/Go/sort"
+	"github.com/TheAlgorithms/Go/structure/iterutil"
+)
+
+// SortMergeJoin returns the pairs of records of left and right with equal
+// keys, leftKey and rightKey giving the keys of the records. Both streams
+// must be sorted by increasing key, otherwise pairs are missed; the pairs
+// come in the same order.
+func SortMergeJoin[L, R any, K constraints.Ordered](left iterutil.Seq[L], right iterutil.Seq[R], leftKey func(L) K, rightKey func(R) K) iterutil.Seq2[L, R] {
+	return func(yield func(L, R) bool) {
+		nextLeft, stopLeft := iterutil.Pull(left)
+		defer stopLeft()
+		nextRight, stopRight := iterutil.Pull(right)
+		defer stopRight()
+		l, lok := nextLeft()
+		r, rok := nextRight()
+		for lok && rok {
+			kl, kr := leftKey(l), rightKey(r)
+			if kl < kr {
+				l, lok = nextLeft()
+				continue
+			}
+			if kr < kl {
+				r, rok = nextRight()
+				continue
+			}
+			group := []R{r}
+			for r, rok = nextRight(); rok && rightKey(r) == kl; r, rok = nextRight() {
+				group = append(group, r)
+			}
+			for ; lok && leftKey(l) == kl; l, lok = nextLeft() {
+				for _, g := range group {
+					if !yield(l, g) {
+						return
+					}
+				}
+			}
+		}
+	}
+}
+
+// ExternalMergeJoin joins streams in any order by sorting each of them with
+// an external merge sort before a sort-merge join. Records are written to
+// the temporary files by the Encode and read back by the Decode functions,
+// with the conventions of sort.ExternalSorter.
+type ExternalMergeJoin[L, R any, K constraints.Ordered] struct {
+	// LeftKey and RightKey give the keys of the records.
+	LeftKey  func(L) K
+	RightKey func(R) K
+	// EncodeLeft, DecodeLeft, EncodeRight and DecodeRight write and read a
+	// single record; the decoders return io.EOF at the end of the input.
+	EncodeLeft  func(w io.Writer, record L) error
+	DecodeLeft  func(r io.Reader) (L, error)
+	EncodeRight func(w io.Writer, record R) error
+	DecodeRight func(r io.Reader) (R, error)
+	// RunSize is the maximum number of records sorted in memory at once.
+	RunSize int
+	// TempDir is the directory for temporary files; os.TempDir is used if
+	// empty.
+	TempDir string
+
+	err error
+}
+
+// Join returns the pairs of records of left and right with equal keys, in
+// increasing key order. Both inputs are sorted when the iteration starts.
+// An error stops the iteration and is then returned by Err.
+func (j *ExternalMergeJoin[L, R, K]) Join(left iterutil.Seq[L], right iterutil.Seq[R]) iterutil.Seq2[L, R] {
+	return func(yield func(L, R) bool) {
+		j.err = nil
+		if j.LeftKey == nil || j.RightKey == nil || j.EncodeLeft == nil || j.DecodeLeft == nil || j.EncodeRight == nil || j.DecodeRight == nil {
+			j.err = errors.New("external merge join needs keys, encoders and decoders")
+			return
+		}
+		var files []*os.File
+		defer func() {
+			for _, f := range files {
+				f.Close()
+				os.Remove(f.Name())
+			}
+		}()
+		leftFile, err := sortToFile(left, j.TempDir, j.RunSize, &files, func(a, b L) bool { return j.LeftKey(a) < j.LeftKey(b) }, j.EncodeLeft, j.DecodeLeft)
+		if err != nil {
+			j.err = err
+			return
+		}
+		rightFile, err := sortToFile(right, j.TempDir, j.RunSize, &files, func(a, b R) bool { return j.RightKey(a) < j.RightKey(b) }, j.EncodeRight, j.DecodeRight)
+		if err != nil {
+			j.err = err
+			return
+		}
+		SortMergeJoin(readAll(leftFile, j.DecodeLeft, &j.err), readAll(rightFile, j.DecodeRight, &j.err), j.LeftKey, j.RightKey)(func(l L, r R) bool {
+			return j.err == nil && yield(l, r)
+		})
+	}
+}
+
+// Err returns the error that stopped the last iteration of Join, or nil.
+func (j *ExternalMergeJoin[L, R, K]) Err() error {
+	return j.err
+}
+
+// sortToFile writes the records of seq to a temporary file, sorts them into
+// another one and returns it. The files are added to files for removal.
+func sortToFile[T any](seq iterutil.Seq[T], dir string, runSize int, files *[]*os.File, less func(a, b T) bool, encode func(io.Writer, T) error, decode func(io.Reader) (T, error)) (*os.File, error) {
+	input, err := os.CreateTemp(dir, "join-input-*")
+	if err != nil {
+		return nil, err
+	}
+	*files = append(*files, input)
+	out := bufio.NewWriter(input)
+	seq(func(v T) bool {
+		err = encode(out, v)
+		return err == nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := out.Flush(); err != nil {
+		return nil, err
+	}
+	if _, err := input.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	sorted, err := os.CreateTemp(dir, "join-sorted-*")
+	if err != nil {
+		return nil, err
+	}
+	*files = append(*files, sorted)
+	sorter := sort.ExternalSorter[T]{Less: less, Encode: encode, Decode: decode, RunSize: runSize, TempDir: dir}
+	if err := sorter.Sort(input, sorted); err != nil {
+		return nil, err
+	}
+	if _, err := sorted.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	return sorted, nil
+}
+
+// readAll returns the sequence of the records decoded from f, stopping at
+// the first error, which is stored in err.
+func readAll[T any](f *os.File, decode func(io.Reader) (T, error), err *error) iterutil.Seq[T] {
+	return func(yield func(T) bool) {
+		in := bufio.NewReader(f)
+		for {
+			v, e := decode(in)
+			if e == io.EOF {
+				return
+			}
+			if e != nil {
+				*err = e
+				return
+			}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
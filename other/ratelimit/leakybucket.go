@@ -0,0 +1,76 @@
+// leakybucket.go
+// description: Leaky bucket rate limiter, as a queue
+// details:
+// Events leave the bucket one at a time, at a constant rate, like water
+// dripping from a hole: two events are always at least 1/rate apart, without
+// bursts. Waiting events queue in the bucket; an event arriving when capacity
+// events are already queued is rejected, as water overflowing the bucket.
+// Allow only lets an event through when it would not have to queue.
+// time complexity: O(1) per event
+// space complexity: O(1)
+// reference: https://en.wikipedia.org/wiki/Leaky_bucket#As_a_queue
+// see ratelimit_test.go
+
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// LeakyBucket is a leaky bucket rate limiter.
+type LeakyBucket struct {
+	mu       sync.Mutex
+	clock    Clock
+	interval time.Duration // time between two events
+	capacity int
+	next     time.Time // earliest time of the next event
+}
+
+// NewLeakyBucket returns an empty bucket letting rate events per second
+// through, with room for capacity waiting events, reading the time from clock
+// or from SystemClock if clock is nil.
+func NewLeakyBucket(rate float64, capacity int, clock Clock) (*LeakyBucket, error) {
+	if !(rate > 0) || capacity < 1 {
+		return nil, ErrInvalidLimit
+	}
+	clock = clockOrSystem(clock)
+	return &LeakyBucket{clock: clock, interval: duration(1 / rate), capacity: capacity, next: clock.Now()}, nil
+}
+
+// Allow lets an event through if the bucket is empty and the last event left
+// at least 1/rate ago.
+func (b *LeakyBucket) Allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	now := b.clock.Now()
+	if now.Before(b.next) {
+		return false
+	}
+	b.next = now.Add(b.interval)
+	return true
+}
+
+// Wait queues an event and waits for its turn. It returns ErrQueueFull
+// without waiting if capacity events are already waiting. The turn of an
+// event whose context is done while waiting is lost rather than given to the
+// next events, so the rate never exceeds the limit.
+func (b *LeakyBucket) Wait(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	b.mu.Lock()
+	now := b.clock.Now()
+	slot := b.next
+	if slot.Before(now) {
+		slot = now
+	}
+	if slot.Sub(now) > time.Duration(b.capacity)*b.interval {
+		b.mu.Unlock()
+		return ErrQueueFull
+	}
+	b.next = slot.Add(b.interval)
+	b.mu.Unlock()
+	return sleep(ctx, b.clock, slot.Sub(now))
+}
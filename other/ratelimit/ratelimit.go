@@ -0,0 +1,100 @@
+// ratelimit.go
+// description: Common interface and clock of the rate limiters
+// details:
+// A Limiter decides whether an event may happen now (Allow) or blocks until
+// it may (Wait). Limiters read the time from a Clock so that tests can drive
+// them with a fake one. Waiting polls the limiter: it sleeps until the time
+// at which the limiter said the event could be allowed and asks again, since
+// other goroutines may have taken the capacity in the meantime.
+// see ratelimit_test.go
+
+// Package ratelimit implements token bucket, leaky bucket and sliding window
+// rate limiters, safe for concurrent use.
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	// ErrInvalidLimit is returned by the constructors for a non-positive
+	// rate, capacity or window.
+	ErrInvalidLimit = errors.New("rate, capacity and window must be positive")
+	// ErrQueueFull is returned by LeakyBucket.Wait when the queue of waiting
+	// events is full.
+	ErrQueueFull = errors.New("leaky bucket queue is full")
+)
+
+// Limiter limits the rate of events.
+type Limiter interface {
+	// Allow reports whether an event may happen now, and counts it if so.
+	Allow() bool
+	// Wait blocks until an event may happen and counts it, or returns an
+	// error if ctx is done first.
+	Wait(ctx context.Context) error
+}
+
+// Clock tells the time and waits.
+type Clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time                         { return time.Now() }
+func (systemClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+// SystemClock is the clock of the system, used when a constructor is given a
+// nil clock.
+var SystemClock Clock = systemClock{}
+
+func clockOrSystem(c Clock) Clock {
+	if c == nil {
+		return SystemClock
+	}
+	return c
+}
+
+// sleep waits for d on clock, or returns the error of ctx if it is done
+// first.
+func sleep(ctx context.Context, clock Clock, d time.Duration) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if d <= 0 {
+		return nil
+	}
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-clock.After(d):
+		return nil
+	}
+}
+
+// wait calls try until it allows the event, sleeping for the delay it
+// returns in between.
+func wait(ctx context.Context, clock Clock, try func() (bool, time.Duration)) error {
+	for {
+		ok, delay := try()
+		if ok {
+			return nil
+		}
+		if err := sleep(ctx, clock, delay); err != nil {
+			return err
+		}
+	}
+}
+
+// duration converts seconds to a duration, rounding up so that waiting for
+// it is never too short.
+func duration(s float64) time.Duration {
+	d := time.Duration(s * float64(time.Second))
+	if float64(d) < s*float64(time.Second) {
+		d++
+	}
+	return d
+}
@@ -0,0 +1,248 @@
+package ratelimit_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/TheAlgorithms/Go/other/ratelimit"
+)
+
+// fakeClock only moves when Advance is called.
+type fakeClock struct {
+	mu      sync.Mutex
+	now     time.Time
+	timers  []fakeTimer
+	waiting chan struct{} // receives a value every time After is called
+}
+
+type fakeTimer struct {
+	at time.Time
+	c  chan time.Time
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Unix(1000, 0), waiting: make(chan struct{}, 100)}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	ch := make(chan time.Time, 1)
+	if d <= 0 {
+		ch <- c.now
+	} else {
+		c.timers = append(c.timers, fakeTimer{c.now.Add(d), ch})
+	}
+	select {
+	case c.waiting <- struct{}{}:
+	default:
+	}
+	return ch
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+	pending := c.timers[:0]
+	for _, t := range c.timers {
+		if t.at.After(c.now) {
+			pending = append(pending, t)
+		} else {
+			t.c <- c.now
+		}
+	}
+	c.timers = pending
+}
+
+// allowed counts the events let through by n calls of Allow.
+func allowed(l ratelimit.Limiter, n int) int {
+	count := 0
+	for i := 0; i < n; i++ {
+		if l.Allow() {
+			count++
+		}
+	}
+	return count
+}
+
+func TestInvalidLimits(t *testing.T) {
+	_, err1 := ratelimit.NewTokenBucket(0, 1, nil)
+	_, err2 := ratelimit.NewTokenBucket(1, 0, nil)
+	_, err3 := ratelimit.NewLeakyBucket(-1, 1, nil)
+	_, err4 := ratelimit.NewSlidingWindowLog(1, 0, nil)
+	_, err5 := ratelimit.NewSlidingWindowCounter(0, time.Second, nil)
+	for i, err := range []error{err1, err2, err3, err4, err5} {
+		if !errors.Is(err, ratelimit.ErrInvalidLimit) {
+			t.Errorf("constructor %d: err = %v, want ErrInvalidLimit", i+1, err)
+		}
+	}
+}
+
+func TestTokenBucket(t *testing.T) {
+	clock := newFakeClock()
+	b, _ := ratelimit.NewTokenBucket(2, 5, clock)
+	if got := allowed(b, 10); got != 5 {
+		t.Errorf("burst: %d allowed, want 5", got)
+	}
+	clock.Advance(1500 * time.Millisecond)
+	if got := b.Tokens(); got != 3 {
+		t.Errorf("Tokens() after 1.5s = %v, want 3", got)
+	}
+	if got := allowed(b, 10); got != 3 {
+		t.Errorf("after 1.5s: %d allowed, want 3", got)
+	}
+	clock.Advance(time.Hour)
+	if got := allowed(b, 10); got != 5 {
+		t.Errorf("after an hour: %d allowed, want the capacity 5", got)
+	}
+}
+
+func TestLeakyBucket(t *testing.T) {
+	clock := newFakeClock()
+	b, _ := ratelimit.NewLeakyBucket(10, 2, clock)
+	if got := allowed(b, 5); got != 1 {
+		t.Errorf("burst: %d allowed, want 1", got)
+	}
+	clock.Advance(99 * time.Millisecond)
+	if b.Allow() {
+		t.Error("event allowed before the interval")
+	}
+	clock.Advance(time.Millisecond)
+	if !b.Allow() {
+		t.Error("event not allowed after the interval")
+	}
+
+	// Wait queues up to the capacity
+	ctx := context.Background()
+	done := make(chan error, 3)
+	for i := 0; i < 3; i++ {
+		go func() { done <- b.Wait(ctx) }()
+	}
+	// two queue, one is rejected at once
+	if err := <-done; !errors.Is(err, ratelimit.ErrQueueFull) {
+		t.Fatalf("third waiting event: err = %v, want ErrQueueFull", err)
+	}
+	<-clock.waiting
+	<-clock.waiting
+	for i := 1; i <= 2; i++ {
+		clock.Advance(100 * time.Millisecond)
+		if err := <-done; err != nil {
+			t.Fatalf("waiting event %d: %v", i, err)
+		}
+	}
+}
+
+func TestSlidingWindowLog(t *testing.T) {
+	clock := newFakeClock()
+	l, _ := ratelimit.NewSlidingWindowLog(3, time.Second, clock)
+	if got := allowed(l, 2); got != 2 {
+		t.Fatalf("%d allowed, want 2", got)
+	}
+	clock.Advance(600 * time.Millisecond)
+	if got := allowed(l, 5); got != 1 {
+		t.Errorf("%d allowed at 0.6s, want 1", got)
+	}
+	clock.Advance(400 * time.Millisecond) // the first two leave the window
+	if got := allowed(l, 5); got != 2 {
+		t.Errorf("%d allowed at 1s, want 2", got)
+	}
+}
+
+func TestSlidingWindowCounter(t *testing.T) {
+	clock := newFakeClock()
+	c, _ := ratelimit.NewSlidingWindowCounter(10, time.Second, clock)
+	if got := allowed(c, 20); got != 10 {
+		t.Fatalf("first window: %d allowed, want 10", got)
+	}
+	// a quarter into the next window, the previous one weighs 7.5 events
+	clock.Advance(1250 * time.Millisecond)
+	if got := allowed(c, 20); got != 3 {
+		t.Errorf("at 1.25s: %d allowed, want 3", got)
+	}
+	clock.Advance(3 * time.Second)
+	if got := allowed(c, 20); got != 10 {
+		t.Errorf("after an idle window: %d allowed, want 10", got)
+	}
+}
+
+func TestWait(t *testing.T) {
+	clock := newFakeClock()
+	tokenBucket, _ := ratelimit.NewTokenBucket(1, 1, clock)
+	log, _ := ratelimit.NewSlidingWindowLog(1, time.Second, clock)
+	counter, _ := ratelimit.NewSlidingWindowCounter(1, time.Second, clock)
+	for name, l := range map[string]ratelimit.Limiter{"TokenBucket": tokenBucket, "SlidingWindowLog": log, "SlidingWindowCounter": counter} {
+		if err := l.Wait(context.Background()); err != nil {
+			t.Fatalf("%s: first Wait: %v", name, err)
+		}
+		done := make(chan error, 1)
+		go func() { done <- l.Wait(context.Background()) }()
+		<-clock.waiting
+		select {
+		case err := <-done:
+			t.Fatalf("%s: second Wait returned %v without waiting", name, err)
+		default:
+		}
+		// move the clock until the limiter lets the event through
+		for waited := false; !waited; {
+			clock.Advance(250 * time.Millisecond)
+			select {
+			case err := <-done:
+				if err != nil {
+					t.Fatalf("%s: second Wait: %v", name, err)
+				}
+				waited = true
+			case <-time.After(time.Millisecond):
+			}
+		}
+		if l.Allow() {
+			t.Errorf("%s: Allow right after Wait", name)
+		}
+		clock.Advance(10 * time.Second)
+	}
+}
+
+func TestWaitCanceled(t *testing.T) {
+	clock := newFakeClock()
+	b, _ := ratelimit.NewTokenBucket(1, 1, clock)
+	b.Allow()
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- b.Wait(ctx) }()
+	<-clock.waiting
+	cancel()
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Errorf("Wait after cancel: err = %v, want context.Canceled", err)
+	}
+}
+
+func TestConcurrentAllow(t *testing.T) {
+	clock := newFakeClock()
+	b, _ := ratelimit.NewTokenBucket(1, 100, clock)
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	total := 0
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			n := allowed(b, 50)
+			mu.Lock()
+			total += n
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+	if total != 100 {
+		t.Errorf("%d events allowed concurrently, want the capacity 100", total)
+	}
+}
@@ -0,0 +1,134 @@
+// slidingwindow.go
+// description: Sliding window log and sliding window counter rate limiters
+// details:
+// Both allow at most limit events in any window of time. The log keeps the
+// time of every allowed event of the last window, which is exact but takes
+// memory proportional to the limit. The counter only counts the events of
+// the current and of the previous fixed window, and estimates the events of
+// the sliding window assuming the previous ones were evenly spread; it takes
+// constant memory and is exact when traffic is steady.
+// time complexity: O(1) amortized per event
+// space complexity: O(limit) for the log, O(1) for the counter
+// reference: https://blog.cloudflare.com/counting-things-a-lot-of-different-things/
+// see ratelimit_test.go
+
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// SlidingWindowLog is a rate limiter remembering the time of every event of
+// the last window.
+type SlidingWindowLog struct {
+	mu     sync.Mutex
+	clock  Clock
+	window time.Duration
+	limit  int
+	log    []time.Time // times of the events, oldest first
+}
+
+// NewSlidingWindowLog returns a limiter allowing limit events in any window,
+// reading the time from clock or from SystemClock if clock is nil.
+func NewSlidingWindowLog(limit int, window time.Duration, clock Clock) (*SlidingWindowLog, error) {
+	if limit < 1 || window <= 0 {
+		return nil, ErrInvalidLimit
+	}
+	return &SlidingWindowLog{clock: clockOrSystem(clock), window: window, limit: limit}, nil
+}
+
+func (l *SlidingWindowLog) try() (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.clock.Now()
+	expired := 0
+	for expired < len(l.log) && !l.log[expired].After(now.Add(-l.window)) {
+		expired++
+	}
+	if expired > 0 {
+		l.log = append(l.log[:0], l.log[expired:]...)
+	}
+	if len(l.log) < l.limit {
+		l.log = append(l.log, now)
+		return true, 0
+	}
+	return false, l.log[0].Add(l.window).Sub(now)
+}
+
+// Allow lets an event through if fewer than limit events happened in the
+// last window.
+func (l *SlidingWindowLog) Allow() bool {
+	ok, _ := l.try()
+	return ok
+}
+
+// Wait waits until fewer than limit events happened in the last window.
+func (l *SlidingWindowLog) Wait(ctx context.Context) error {
+	return wait(ctx, l.clock, l.try)
+}
+
+// SlidingWindowCounter is a rate limiter estimating the events of the last
+// window from the counts of two fixed windows.
+type SlidingWindowCounter struct {
+	mu       sync.Mutex
+	clock    Clock
+	window   time.Duration
+	limit    int
+	start    time.Time // start of the current fixed window
+	current  int
+	previous int
+}
+
+// NewSlidingWindowCounter returns a limiter allowing about limit events in
+// any window, reading the time from clock or from SystemClock if clock is nil.
+func NewSlidingWindowCounter(limit int, window time.Duration, clock Clock) (*SlidingWindowCounter, error) {
+	if limit < 1 || window <= 0 {
+		return nil, ErrInvalidLimit
+	}
+	clock = clockOrSystem(clock)
+	return &SlidingWindowCounter{clock: clock, window: window, limit: limit, start: clock.Now()}, nil
+}
+
+func (c *SlidingWindowCounter) try() (bool, time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := c.clock.Now()
+	if elapsed := now.Sub(c.start); elapsed >= c.window {
+		windows := elapsed / c.window
+		c.previous = c.current
+		if windows > 1 {
+			c.previous = 0
+		}
+		c.current = 0
+		c.start = c.start.Add(windows * c.window)
+	}
+	// weight of the previous window still inside the sliding window
+	elapsed := now.Sub(c.start)
+	weight := 1 - elapsed.Seconds()/c.window.Seconds()
+	if float64(c.previous)*weight+float64(c.current) < float64(c.limit) {
+		c.current++
+		return true, 0
+	}
+	if c.current >= c.limit || c.previous == 0 {
+		return false, c.window - elapsed
+	}
+	// the estimate falls below the limit once the previous window weighs
+	// less than (limit-current)/previous
+	target := 1 - float64(c.limit-c.current)/float64(c.previous)
+	return false, duration(target*c.window.Seconds()) - elapsed + 1
+}
+
+// Allow lets an event through if the estimated number of events in the last
+// window is below the limit.
+func (c *SlidingWindowCounter) Allow() bool {
+	ok, _ := c.try()
+	return ok
+}
+
+// Wait waits until the estimated number of events in the last window is
+// below the limit.
+func (c *SlidingWindowCounter) Wait(ctx context.Context) error {
+	return wait(ctx, c.clock, c.try)
+}
@@ -0,0 +1,80 @@
+// tokenbucket.go
+// description: Token bucket rate limiter
+// details:
+// The bucket holds up to capacity tokens and is refilled at a constant rate.
+// Every event takes a token, so events are allowed in bursts of up to
+// capacity and at rate per second on average.
+// time complexity: O(1) per event
+// space complexity: O(1)
+// reference: https://en.wikipedia.org/wiki/Token_bucket
+// see ratelimit_test.go
+
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// TokenBucket is a token bucket rate limiter.
+type TokenBucket struct {
+	mu       sync.Mutex
+	clock    Clock
+	rate     float64 // tokens per second
+	capacity float64
+	tokens   float64
+	last     time.Time // time of the last refill
+}
+
+// NewTokenBucket returns a full bucket of capacity tokens refilled with rate
+// tokens per second, reading the time from clock or from SystemClock if
+// clock is nil.
+func NewTokenBucket(rate float64, capacity int, clock Clock) (*TokenBucket, error) {
+	if !(rate > 0) || capacity < 1 {
+		return nil, ErrInvalidLimit
+	}
+	clock = clockOrSystem(clock)
+	return &TokenBucket{clock: clock, rate: rate, capacity: float64(capacity), tokens: float64(capacity), last: clock.Now()}, nil
+}
+
+// try takes a token if there is one, or returns the time until there is.
+func (b *TokenBucket) try() (bool, time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	now := b.clock.Now()
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens += elapsed.Seconds() * b.rate
+		if b.tokens > b.capacity {
+			b.tokens = b.capacity
+		}
+		b.last = now
+	}
+	if b.tokens >= 1 {
+		b.tokens--
+		return true, 0
+	}
+	return false, duration((1 - b.tokens) / b.rate)
+}
+
+// Allow takes a token if there is one.
+func (b *TokenBucket) Allow() bool {
+	ok, _ := b.try()
+	return ok
+}
+
+// Wait takes a token, waiting for the bucket to refill if it is empty.
+func (b *TokenBucket) Wait(ctx context.Context) error {
+	return wait(ctx, b.clock, b.try)
+}
+
+// Tokens returns the number of tokens in the bucket, which may be fractional.
+func (b *TokenBucket) Tokens() float64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	tokens := b.tokens + b.clock.Now().Sub(b.last).Seconds()*b.rate
+	if tokens > b.capacity {
+		return b.capacity
+	}
+	return tokens
+}
@@ -0,0 +1,40 @@
+// rainwater.go
+// description: Trapping rain water with two pointers
+// details:
+// The water above a bar rises to the lower of the highest bars on its left
+// and on its right. Two pointers walk from both ends towards each other,
+// always moving the one on the lower side: the highest bar seen from that
+// side is then known to be the lower bound, since a bar at least as high
+// stands on the other side, and the water above the bar is settled without
+// the arrays of maxima of the dynamic programming version.
+// time complexity: O(n)
+// space complexity: O(1)
+// reference: https://leetcode.com/problems/trapping-rain-water/
+// see rainwater_test.go, dynamic/traprainwater.go
+
+package twopointer
+
+import "github.com/TheAlgorithms/Go/constraints"
+
+// TrapRainWater returns the amount of water held between bars of the given
+// heights, which must not be negative.
+func TrapRainWater[T constraints.Number](heights []T) T {
+	var water, leftMax, rightMax T
+	left, right := 0, len(heights)-1
+	for left < right {
+		if heights[left] < heights[right] {
+			if heights[left] > leftMax {
+				leftMax = heights[left]
+			}
+			water += leftMax - heights[left]
+			left++
+		} else {
+			if heights[right] > rightMax {
+				rightMax = heights[right]
+			}
+			water += rightMax - heights[right]
+			right--
+		}
+	}
+	return water
+}
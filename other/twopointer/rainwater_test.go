@@ -0,0 +1,43 @@
+package twopointer
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/dynamic"
+)
+
+func TestTrapRainWater(t *testing.T) {
+	tests := []struct {
+		heights []int
+		want    int
+	}{
+		{nil, 0},
+		{[]int{5}, 0},
+		{[]int{0, 1, 0, 2, 1, 0, 1, 3, 2, 1, 2, 1}, 6},
+		{[]int{4, 2, 0, 3, 2, 5}, 9},
+		{[]int{3, 1, 2, 4, 0, 1, 3, 2, 4}, 13},
+		{[]int{1, 2, 3, 2, 1}, 0},
+	}
+	for _, test := range tests {
+		if got := TrapRainWater(test.heights); got != test.want {
+			t.Errorf("TrapRainWater(%v) = %d, want %d", test.heights, got, test.want)
+		}
+	}
+	if got := TrapRainWater([]float64{2, 0.5, 1.5}); got != 1 {
+		t.Errorf("TrapRainWater of floats = %v, want 1", got)
+	}
+}
+
+func TestTrapRainWaterMatchesDynamic(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for iter := 0; iter < 200; iter++ {
+		heights := make([]int, rnd.Intn(40))
+		for i := range heights {
+			heights[i] = rnd.Intn(10)
+		}
+		if got, want := TrapRainWater(heights), dynamic.TrapRainWater(heights); got != want {
+			t.Fatalf("TrapRainWater(%v) = %d, want %d", heights, got, want)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+// subarraysum.go
+// description: Subarrays summing to a target
+// details:
+// When no value is negative, the sum of a window only grows as its end
+// advances and only shrinks as its start does, so a window summing to the
+// target is found with two pointers in O(1) extra space. With negative
+// values this monotonicity is lost, and the prefix sums are hashed instead:
+// the subarray (i, j] sums to the target exactly when prefix(j) - target is
+// the earlier prefix(i), which also counts the subarrays summing to it.
+// time complexity: O(n) for n values, expected for the hashed versions
+// space complexity: O(1) with two pointers, O(n) with hashing
+// reference: https://www.geeksforgeeks.org/find-subarray-with-given-sum/
+// reference: https://leetcode.com/problems/subarray-sum-equals-k/
+// see subarraysum_test.go
+
+package twopointer
+
+import "github.com/TheAlgorithms/Go/constraints"
+
+// SubarraySumNonNegative returns the first non-empty subarray
+// values[start:end] summing to target, and false when there is none. The
+// values must not be negative; SubarraySum handles any values.
+func SubarraySumNonNegative[T constraints.Integer](values []T, target T) (start, end int, ok bool) {
+	var sum T
+	for i, x := range values {
+		sum += x
+		for sum > target && start < i {
+			sum -= values[start]
+			start++
+		}
+		if sum == target {
+			return start, i + 1, true
+		}
+	}
+	return 0, 0, false
+}
+
+// SubarraySum returns the non-empty subarray values[start:end] summing to
+// target that ends first, the longest of them when several do, and false
+// when there is none.
+func SubarraySum[T constraints.Integer](values []T, target T) (start, end int, ok bool) {
+	// first maps each prefix sum to the length of the first prefix having it
+	first := map[T]int{0: 0}
+	var sum T
+	for i, x := range values {
+		sum += x
+		if j, ok := first[sum-target]; ok {
+			return j, i + 1, true
+		}
+		if _, ok := first[sum]; !ok {
+			first[sum] = i + 1
+		}
+	}
+	return 0, 0, false
+}
+
+// CountSubarraysWithSum returns the number of non-empty subarrays of values
+// summing to target.
+func CountSubarraysWithSum[T constraints.Integer](values []T, target T) int {
+	seen := map[T]int{0: 1}
+	var sum T
+	count := 0
+	for _, x := range values {
+		sum += x
+		count += seen[sum-target]
+		seen[sum]++
+	}
+	return count
+}
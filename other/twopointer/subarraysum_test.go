@@ -0,0 +1,88 @@
+package twopointer
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestSubarraySum(t *testing.T) {
+	tests := []struct {
+		name       string
+		values     []int
+		target     int
+		start, end int
+		ok         bool
+	}{
+		{"middle", []int{1, 4, 20, 3, 10, 5}, 33, 2, 5, true},
+		{"prefix", []int{1, 4, 0, 0, 3, 10, 5}, 7, 1, 5, true},
+		{"single", []int{1, 4}, 4, 1, 2, true},
+		{"none", []int{1, 4}, 3, 0, 0, false},
+		{"empty", nil, 0, 0, 0, false},
+		{"zero", []int{2, 0, 3}, 0, 1, 2, true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if start, end, ok := SubarraySumNonNegative(test.values, test.target); start != test.start || end != test.end || ok != test.ok {
+				t.Errorf("SubarraySumNonNegative = %d, %d, %v, want %d, %d, %v", start, end, ok, test.start, test.end, test.ok)
+			}
+		})
+	}
+
+	values := []int{10, 2, -2, -20, 10}
+	if start, end, ok := SubarraySum(values, -10); start != 0 || end != 4 || !ok {
+		t.Errorf("SubarraySum(%v, -10) = %d, %d, %v, want 0, 4, true", values, start, end, ok)
+	}
+	if got := CountSubarraysWithSum(values, -10); got != 3 {
+		t.Errorf("CountSubarraysWithSum(%v, -10) = %d, want 3", values, got)
+	}
+	if _, _, ok := SubarraySum([]int8{-1, -1}, 1); ok {
+		t.Errorf("SubarraySum found a subarray of negative values summing to 1")
+	}
+}
+
+func TestSubarraySumRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for iter := 0; iter < 500; iter++ {
+		values := make([]int, rnd.Intn(20))
+		nonNegative := iter%2 == 0
+		for i := range values {
+			if nonNegative {
+				values[i] = rnd.Intn(6)
+			} else {
+				values[i] = rnd.Intn(11) - 5
+			}
+		}
+		target := rnd.Intn(12)
+		count := 0
+		for i := range values {
+			sum := 0
+			for j := i; j < len(values); j++ {
+				sum += values[j]
+				if sum == target {
+					count++
+				}
+			}
+		}
+		check := func(name string, start, end int, ok bool) {
+			if ok != (count > 0) {
+				t.Fatalf("%s(%v, %d) found = %v with %d subarrays", name, values, target, ok, count)
+			}
+			sum := 0
+			for _, x := range values[start:end] {
+				sum += x
+			}
+			if ok && (start >= end || sum != target) {
+				t.Fatalf("%s(%v, %d) = [%d, %d)", name, values, target, start, end)
+			}
+		}
+		if nonNegative {
+			start, end, ok := SubarraySumNonNegative(values, target)
+			check("SubarraySumNonNegative", start, end, ok)
+		}
+		start, end, ok := SubarraySum(values, target)
+		check("SubarraySum", start, end, ok)
+		if got := CountSubarraysWithSum(values, target); got != count {
+			t.Fatalf("CountSubarraysWithSum(%v, %d) = %d, want %d", values, target, got, count)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+// window.go
+// description: Sliding windows over sequences: longest run without repeats and minimum covering window
+// details:
+// A sliding window is a range [start, end) of a sequence whose end advances
+// one element at a time while its start only ever moves forward, so that each
+// element enters and leaves the window at most once. Counts of the elements in
+// the window are updated as it slides, which keeps each step O(1) on average.
+// LongestWithoutRepeats grows the window and shrinks it past the previous
+// copy of an element seen twice. MinimumWindow grows the window until it
+// covers the target, then shrinks it as long as it still does.
+// time complexity: O(n + m) for a sequence of n and a target of m elements
+// space complexity: O(k) for k distinct elements
+// reference: https://leetcode.com/problems/longest-substring-without-repeating-characters/
+// reference: https://leetcode.com/problems/minimum-window-substring/
+// see window_test.go
+
+// Package twopointer gathers the algorithms that scan a sequence with two
+// indices moving in one direction, such as sliding windows.
+package twopointer
+
+// LongestWithoutRepeats returns the first longest window [start, end) of seq
+// in which no element appears twice.
+func LongestWithoutRepeats[T comparable](seq []T) (start, end int) {
+	last := make(map[T]int)
+	from := 0
+	for i, x := range seq {
+		if j, ok := last[x]; ok && j >= from {
+			from = j + 1
+		}
+		last[x] = i
+		if i+1-from > end-start {
+			start, end = from, i+1
+		}
+	}
+	return start, end
+}
+
+// LongestSubstringWithoutRepeats returns the first longest substring of s in
+// which no character appears twice.
+func LongestSubstringWithoutRepeats(s string) string {
+	runes := []rune(s)
+	start, end := LongestWithoutRepeats(runes)
+	return string(runes[start:end])
+}
+
+// MinimumWindow returns the first shortest window [start, end) of seq holding
+// every element of target, as many times as it appears in target, and false
+// when there is none. An empty target is covered by the empty window.
+func MinimumWindow[T comparable](seq, target []T) (start, end int, ok bool) {
+	if len(target) == 0 {
+		return 0, 0, true
+	}
+	// need counts the copies of each element the window still lacks, and
+	// missing the total
+	need := make(map[T]int)
+	for _, x := range target {
+		need[x]++
+	}
+	missing := len(target)
+	from := 0
+	for i, x := range seq {
+		if need[x] > 0 {
+			missing--
+		}
+		need[x]--
+		if missing > 0 {
+			continue
+		}
+		// drop the elements the window holds more copies of than needed
+		for need[seq[from]] < 0 {
+			need[seq[from]]++
+			from++
+		}
+		if !ok || i+1-from < end-start {
+			start, end, ok = from, i+1, true
+		}
+		// give up the first element to look for a shorter window
+		need[seq[from]]++
+		missing++
+		from++
+	}
+	return start, end, ok
+}
+
+// MinimumWindowSubstring returns the first shortest substring of s holding
+// every character of t, as many times as it appears in t, and false when
+// there is none.
+func MinimumWindowSubstring(s, t string) (string, bool) {
+	runes := []rune(s)
+	start, end, ok := MinimumWindow(runes, []rune(t))
+	return string(runes[start:end]), ok
+}
@@ -0,0 +1,107 @@
+package twopointer
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestLongestSubstringWithoutRepeats(t *testing.T) {
+	tests := []struct {
+		s, want string
+	}{
+		{"", ""},
+		{"bbbbb", "b"},
+		{"abcabcbb", "abc"},
+		{"pwwkew", "wke"},
+		{"abba", "ab"},
+		{"dvdf", "vdf"},
+		{"héllo wörld", "o wörld"},
+	}
+	for _, test := range tests {
+		if got := LongestSubstringWithoutRepeats(test.s); got != test.want {
+			t.Errorf("LongestSubstringWithoutRepeats(%q) = %q, want %q", test.s, got, test.want)
+		}
+	}
+}
+
+func TestMinimumWindowSubstring(t *testing.T) {
+	tests := []struct {
+		s, t string
+		want string
+		ok   bool
+	}{
+		{"ADOBECODEBANC", "ABC", "BANC", true},
+		{"a", "a", "a", true},
+		{"a", "aa", "", false},
+		{"aa", "aa", "aa", true},
+		{"abc", "", "", true},
+		{"", "a", "", false},
+		{"cabwefgewcwaefgcf", "cae", "cwae", true},
+	}
+	for _, test := range tests {
+		if got, ok := MinimumWindowSubstring(test.s, test.t); got != test.want || ok != test.ok {
+			t.Errorf("MinimumWindowSubstring(%q, %q) = %q, %v, want %q, %v", test.s, test.t, got, ok, test.want, test.ok)
+		}
+	}
+}
+
+// covers reports whether window holds every element of target, with
+// multiplicity.
+func covers(window, target []int) bool {
+	count := map[int]int{}
+	for _, x := range window {
+		count[x]++
+	}
+	for _, x := range target {
+		if count[x]--; count[x] < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// distinct reports whether no element of window appears twice.
+func distinct(window []int) bool {
+	seen := map[int]bool{}
+	for _, x := range window {
+		if seen[x] {
+			return false
+		}
+		seen[x] = true
+	}
+	return true
+}
+
+func TestWindowsRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for iter := 0; iter < 300; iter++ {
+		seq := make([]int, rnd.Intn(30))
+		for i := range seq {
+			seq[i] = rnd.Intn(6)
+		}
+		target := make([]int, 1+rnd.Intn(4))
+		for i := range target {
+			target[i] = rnd.Intn(6)
+		}
+		// the first shortest covering window and the first longest window
+		// without repeats, by brute force
+		best, longest := []int{-1, -1}, []int{0, 0}
+		for length := 0; length <= len(seq); length++ {
+			for i := 0; i+length <= len(seq); i++ {
+				if best[0] == -1 && covers(seq[i:i+length], target) {
+					best = []int{i, i + length}
+				}
+				if length > longest[1]-longest[0] && distinct(seq[i:i+length]) {
+					longest = []int{i, i + length}
+				}
+			}
+		}
+		start, end, ok := MinimumWindow(seq, target)
+		if ok != (best[0] != -1) || ok && (start != best[0] || end != best[1]) {
+			t.Fatalf("MinimumWindow(%v, %v) = %d, %d, %v, want %v", seq, target, start, end, ok, best)
+		}
+		if start, end := LongestWithoutRepeats(seq); start != longest[0] || end != longest[1] {
+			t.Fatalf("LongestWithoutRepeats(%v) = %d, %d, want %v", seq, start, end, longest)
+		}
+	}
+}
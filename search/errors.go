@@ -4,3 +4,6 @@ import "errors"
 
 // ErrNotFound is returned by search functions when target is not found
 var ErrNotFound = errors.New("target not found in array")
+
+// ErrNotSorted is returned when a list that must be sorted is not
+var ErrNotSorted = errors.New("list is not sorted")
@@ -0,0 +1,120 @@
+// fractionalcascading.go
+// description: Fractional cascading for searching one key in many sorted lists
+// details:
+// Looking up a key in k sorted lists by k binary searches takes O(k log n).
+// Fractional cascading augments each list with every second element of the
+// augmented list after it, the last list being kept as it is, and stores
+// with every element of an augmented list two positions: the lower bound of
+// its value in the original list, and in the next augmented list. One
+// binary search in the first augmented list then finds the answer for every
+// list: the lower bound of the key in the next augmented list is the bridge
+// of the element found, or the element just before it, since two
+// consecutive elements of the next list always include one copied into the
+// current one. The augmented lists are at most twice as long as the lists
+// altogether, since each copies half of the next one.
+// time complexity: O(n) to build for n elements in all, O(log n + k) to search
+// space complexity: O(n)
+// reference: https://en.wikipedia.org/wiki/Fractional_cascading
+// reference: Chazelle, Guibas, Fractional Cascading: I. A Data Structuring Technique, Algorithmica 1986
+// see fractionalcascading_test.go
+
+package search
+
+import (
+	"github.com/TheAlgorithms/Go/constraints"
+)
+
+// level is an augmented list. own and bridge have one more entry than
+// values, for the positions past the end.
+type level[T constraints.Ordered] struct {
+	values []T
+	own    []int // lower bound of each value in the original list
+	bridge []int // lower bound of each value in the next level
+}
+
+// Cascade searches a key in several sorted lists at once.
+type Cascade[T constraints.Ordered] struct {
+	levels []level[T]
+}
+
+// lowerBounds returns, for every value of sorted, the number of values of
+// list smaller than it, followed by len(list).
+func lowerBounds[T constraints.Ordered](sorted, list []T) []int {
+	bounds := make([]int, len(sorted)+1)
+	j := 0
+	for i, v := range sorted {
+		for j < len(list) && list[j] < v {
+			j++
+		}
+		bounds[i] = j
+	}
+	bounds[len(sorted)] = len(list)
+	return bounds
+}
+
+// NewCascade creates a cascade over lists, which must each be sorted in
+// non-decreasing order, or returns ErrNotSorted. It does not keep lists.
+func NewCascade[T constraints.Ordered](lists [][]T) (*Cascade[T], error) {
+	for _, list := range lists {
+		for i := 1; i < len(list); i++ {
+			if list[i] < list[i-1] {
+				return nil, ErrNotSorted
+			}
+		}
+	}
+	c := &Cascade[T]{levels: make([]level[T], len(lists))}
+	var next []T
+	for i := len(lists) - 1; i >= 0; i-- {
+		list := lists[i]
+		// merge the list with every second value of the next level
+		values := make([]T, 0, len(list)+len(next)/2)
+		a, b := 0, 1
+		for a < len(list) || b < len(next) {
+			if b >= len(next) || a < len(list) && list[a] <= next[b] {
+				values = append(values, list[a])
+				a++
+			} else {
+				values = append(values, next[b])
+				b += 2
+			}
+		}
+		c.levels[i] = level[T]{values: values, own: lowerBounds(values, list), bridge: lowerBounds(values, next)}
+		next = values
+	}
+	return c, nil
+}
+
+// Search returns, for every list, the position of the first value not below
+// key, the length of the list if there is none.
+func (c *Cascade[T]) Search(key T) []int {
+	positions := make([]int, len(c.levels))
+	if len(c.levels) == 0 {
+		return positions
+	}
+	// the one binary search
+	values := c.levels[0].values
+	low, high := 0, len(values)
+	for low < high {
+		mid := int(uint(low+high) >> 1)
+		if values[mid] < key {
+			low = mid + 1
+		} else {
+			high = mid
+		}
+	}
+	p := low
+	for i := range c.levels {
+		l := &c.levels[i]
+		positions[i] = l.own[p]
+		if i+1 == len(c.levels) {
+			break
+		}
+		p = l.bridge[p]
+		// at most one value of the next level lies between the key and the
+		// value bridged
+		if next := c.levels[i+1].values; p > 0 && !(next[p-1] < key) {
+			p--
+		}
+	}
+	return positions
+}
@@ -0,0 +1,109 @@
+package search
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// independentSearch returns the lower bound of key in every list by binary
+// search.
+func independentSearch(lists [][]int, key int) []int {
+	positions := make([]int, len(lists))
+	for i, list := range lists {
+		positions[i] = sort.SearchInts(list, key)
+	}
+	return positions
+}
+
+func TestCascade(t *testing.T) {
+	lists := [][]int{
+		{24, 64, 65, 80, 93},
+		{23, 25, 26},
+		{13, 44, 62, 66},
+		{11, 35, 46, 79, 81},
+	}
+	c, err := NewCascade(lists)
+	if err != nil {
+		t.Fatalf("NewCascade returned %v", err)
+	}
+	tests := []struct {
+		key  int
+		want []int
+	}{
+		{0, []int{0, 0, 0, 0}},
+		{25, []int{1, 1, 1, 1}},
+		{50, []int{1, 3, 2, 3}},
+		{65, []int{2, 3, 3, 3}},
+		{100, []int{5, 3, 4, 5}},
+	}
+	for _, test := range tests {
+		if got := c.Search(test.key); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Search(%d) = %v, want %v", test.key, got, test.want)
+		}
+	}
+	if _, err := NewCascade([][]int{{1, 2}, {3, 1}}); !errors.Is(err, ErrNotSorted) {
+		t.Errorf("NewCascade with an unsorted list returned %v, want ErrNotSorted", err)
+	}
+	empty, _ := NewCascade[int](nil)
+	if got := empty.Search(1); len(got) != 0 {
+		t.Errorf("Search without lists = %v", got)
+	}
+}
+
+func TestCascadeRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		lists := make([][]int, rnd.Intn(8))
+		for j := range lists {
+			// small values so that lists share values and repeat them
+			lists[j] = make([]int, rnd.Intn(20))
+			for k := range lists[j] {
+				lists[j][k] = rnd.Intn(30)
+			}
+			sort.Ints(lists[j])
+		}
+		c, err := NewCascade(lists)
+		if err != nil {
+			t.Fatalf("NewCascade returned %v", err)
+		}
+		for key := -1; key <= 31; key++ {
+			if got, want := c.Search(key), independentSearch(lists, key); !reflect.DeepEqual(got, want) {
+				t.Fatalf("Search(%d) on %v = %v, want %v", key, lists, got, want)
+			}
+		}
+	}
+}
+
+// generateCascadeBenchmarkLists returns 64 sorted lists of 4096 values.
+func generateCascadeBenchmarkLists() [][]int {
+	rnd := rand.New(rand.NewSource(1))
+	lists := make([][]int, 64)
+	for i := range lists {
+		lists[i] = make([]int, 4096)
+		for j := range lists[i] {
+			lists[i][j] = rnd.Intn(1 << 20)
+		}
+		sort.Ints(lists[i])
+	}
+	return lists
+}
+
+func BenchmarkCascadeSearch(b *testing.B) {
+	lists := generateCascadeBenchmarkLists()
+	c, _ := NewCascade(lists)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = c.Search(i & (1<<20 - 1))
+	}
+}
+
+func BenchmarkIndependentSearch(b *testing.B) {
+	lists := generateCascadeBenchmarkLists()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = independentSearch(lists, i&(1<<20-1))
+	}
+}
@@ -0,0 +1,55 @@
+// medianofmedians.go
+// description: Deterministic linear time selection with the median of medians pivot rule
+// details:
+// The slice is split into groups of five, the median of every group is found
+// and the median of those medians, computed recursively, is used as the pivot.
+// That pivot is guaranteed to have at least 30% of the elements on each side,
+// so the search range shrinks geometrically and the running time is linear in
+// the worst case, at the price of a larger constant than QuickSelect.
+// worst-case time complexity: O(n)
+// space complexity: O(log n) for the recursion
+// reference: https://en.wikipedia.org/wiki/Median_of_medians
+// see selection_test.go
+
+package selection
+
+// MedianOfMedians returns the k-th smallest element of s according to less,
+// with k starting from 1, in worst-case linear time. s is reordered the same
+// way Kth reorders it.
+func MedianOfMedians[T any](s []T, k int, less func(a, b T) bool) (T, error) {
+	if k < 1 || k > len(s) {
+		var zero T
+		return zero, ErrOutOfRange
+	}
+	return choose(s, k-1, less, medianOfMediansPivot[T]), nil
+}
+
+// medianOfMediansPivot gathers the medians of the groups of five at the front
+// of s and returns the index of their median.
+func medianOfMediansPivot[T any](s []T, less func(a, b T) bool) int {
+	if len(s) <= 5 {
+		insertionSort(s, less)
+		return len(s) / 2
+	}
+	medians := 0
+	for i := 0; i < len(s); i += 5 {
+		end := i + 5
+		if end > len(s) {
+			end = len(s)
+		}
+		insertionSort(s[i:end], less)
+		s[medians], s[i+(end-i)/2] = s[i+(end-i)/2], s[medians]
+		medians++
+	}
+	mid := medians / 2
+	choose(s[:medians], mid, less, medianOfMediansPivot[T])
+	return mid
+}
+
+func insertionSort[T any](s []T, less func(a, b T) bool) {
+	for i := 1; i < len(s); i++ {
+		for j := i; j > 0 && less(s[j], s[j-1]); j-- {
+			s[j], s[j-1] = s[j-1], s[j]
+		}
+	}
+}
@@ -0,0 +1,85 @@
+// quickselect.go
+// description: Randomized QuickSelect and a three-way partition helper
+// details:
+// QuickSelect finds the k-th smallest element of a slice by partitioning it
+// around a pivot, like quicksort, but only continuing into the side that holds
+// the wanted position. The partition is three-way, so runs of equal elements
+// are settled in a single pass instead of degrading to quadratic time.
+// With a random pivot the expected running time is linear. The pivots are
+// drawn from a generator given by the caller, so runs can be reproduced.
+// average time complexity: O(n)
+// worst-case time complexity: O(n^2)
+// space complexity: O(1)
+// reference: https://en.wikipedia.org/wiki/Quickselect
+// see selection_test.go
+
+// Package selection implements algorithms that find order statistics, such as
+// the k-th smallest element or the median, without sorting the whole input.
+package selection
+
+import (
+	"errors"
+	"math/rand"
+)
+
+// ErrOutOfRange is returned when the requested rank is not between 1 and the
+// length of the slice.
+var ErrOutOfRange = errors.New("rank out of range")
+
+// Partition rearranges s around the value of s[pivot] and returns lo and hi
+// such that s[:lo] are less than that value, s[lo:hi] are equal to it and
+// s[hi:] are greater than it, according to less.
+func Partition[T any](s []T, pivot int, less func(a, b T) bool) (lo, hi int) {
+	p := s[pivot]
+	lo, hi = 0, len(s)
+	for i := 0; i < hi; {
+		switch {
+		case less(s[i], p):
+			s[lo], s[i] = s[i], s[lo]
+			lo++
+			i++
+		case less(p, s[i]):
+			hi--
+			s[i], s[hi] = s[hi], s[i]
+		default:
+			i++
+		}
+	}
+	return lo, hi
+}
+
+// Kth returns the k-th smallest element of s according to less, with k
+// starting from 1, using QuickSelect with pivots drawn from rnd, or from a
+// generator seeded with 1 if rnd is nil. s is reordered so that the result
+// ends up at s[k-1], with no greater element before it and no smaller
+// element after it.
+func Kth[T any](s []T, k int, less func(a, b T) bool, rnd *rand.Rand) (T, error) {
+	if k < 1 || k > len(s) {
+		var zero T
+		return zero, ErrOutOfRange
+	}
+	if rnd == nil {
+		rnd = rand.New(rand.NewSource(1))
+	}
+	return choose(s, k-1, less, func(s []T, _ func(a, b T) bool) int {
+		return rnd.Intn(len(s))
+	}), nil
+}
+
+// choose moves the element belonging at index idx of the sorted s there and
+// returns it, picking pivots with the given function.
+func choose[T any](s []T, idx int, less func(a, b T) bool, pivot func([]T, func(a, b T) bool) int) T {
+	for len(s) > 1 {
+		lo, hi := Partition(s, pivot(s, less), less)
+		switch {
+		case idx < lo:
+			s = s[:lo]
+		case idx >= hi:
+			s = s[hi:]
+			idx -= hi
+		default:
+			return s[idx]
+		}
+	}
+	return s[idx]
+}
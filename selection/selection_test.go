@@ -0,0 +1,147 @@
+package selection_test
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/selection"
+)
+
+type selectFunc func(s []int, k int, less func(a, b int) bool) (int, error)
+
+var selectors = map[string]selectFunc{
+	"Kth":             kth(rand.New(rand.NewSource(7))),
+	"MedianOfMedians": selection.MedianOfMedians[int],
+}
+
+// kth returns selection.Kth drawing its pivots from rnd.
+func kth(rnd *rand.Rand) selectFunc {
+	return func(s []int, k int, less func(a, b int) bool) (int, error) {
+		return selection.Kth(s, k, less, rnd)
+	}
+}
+
+func lessInt(a, b int) bool { return a < b }
+
+func TestSelect(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []int
+		k     int
+		want  int
+	}{
+		{"single", []int{7}, 1, 7},
+		{"smallest", []int{5, 1, 4, 2, 3}, 1, 1},
+		{"largest", []int{5, 1, 4, 2, 3}, 5, 5},
+		{"middle", []int{9, 3, 7, 1, 5, 8, 2}, 4, 5},
+		{"duplicates", []int{2, 2, 2, 1, 2, 3, 2}, 6, 2},
+		{"all equal", []int{4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4}, 7, 4},
+		{"negative", []int{-3, 10, -8, 0, 4, -1}, 2, -3},
+	}
+	for name, f := range selectors {
+		for _, test := range tests {
+			t.Run(name+"/"+test.name, func(t *testing.T) {
+				got, err := f(append([]int(nil), test.input...), test.k, lessInt)
+				if err != nil || got != test.want {
+					t.Errorf("%s(%v, %d) = %d, %v, want %d", name, test.input, test.k, got, err, test.want)
+				}
+			})
+		}
+		for _, k := range []int{0, 4} {
+			if _, err := f([]int{1, 2, 3}, k, lessInt); err != selection.ErrOutOfRange {
+				t.Errorf("%s with k = %d: err = %v, want ErrOutOfRange", name, k, err)
+			}
+		}
+	}
+}
+
+func TestSelectAgainstSort(t *testing.T) {
+	rnd := rand.New(rand.NewSource(11))
+	for name, f := range selectors {
+		for round := 0; round < 200; round++ {
+			input := make([]int, 1+rnd.Intn(300))
+			for i := range input {
+				input[i] = rnd.Intn(100)
+			}
+			sorted := append([]int(nil), input...)
+			sort.Ints(sorted)
+			k := 1 + rnd.Intn(len(input))
+
+			got, err := f(input, k, lessInt)
+			if err != nil || got != sorted[k-1] {
+				t.Fatalf("%s: k = %d got %d, %v, want %d", name, k, got, err, sorted[k-1])
+			}
+			for i, v := range input {
+				if (i < k-1 && v > got) || (i > k-1 && v < got) || (i == k-1 && v != got) {
+					t.Fatalf("%s: slice is not partitioned around index %d: %v", name, k-1, input)
+				}
+			}
+		}
+	}
+}
+
+func TestPartition(t *testing.T) {
+	s := []string{"pear", "fig", "kiwi", "apple", "kiwi", "plum", "date"}
+	lo, hi := selection.Partition(s, 2, func(a, b string) bool { return a < b })
+	if lo != 3 || hi != 5 {
+		t.Fatalf("Partition() = %d, %d, want 3, 5", lo, hi)
+	}
+	for i, v := range s {
+		if (i < lo && v >= "kiwi") || (i >= lo && i < hi && v != "kiwi") || (i >= hi && v <= "kiwi") {
+			t.Fatalf("%v is not partitioned around kiwi", s)
+		}
+	}
+}
+
+// benchmarkSelect looks for the median of copies of input.
+func benchmarkSelect(b *testing.B, f selectFunc, input []int) {
+	s := make([]int, len(input))
+	for i := 0; i < b.N; i++ {
+		copy(s, input)
+		_, _ = f(s, len(s)/2, lessInt)
+	}
+}
+
+func TestKthSeeded(t *testing.T) {
+	input := randomInput(1000)
+	var runs [2][]int
+	for i := range runs {
+		runs[i] = append([]int(nil), input...)
+		if _, err := selection.Kth(runs[i], 300, lessInt, rand.New(rand.NewSource(3))); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for i := range input {
+		if runs[0][i] != runs[1][i] {
+			t.Fatalf("Kth with the same seed reordered the input differently at %d", i)
+		}
+	}
+	if _, err := selection.Kth(append([]int(nil), input...), 300, lessInt, nil); err != nil {
+		t.Errorf("Kth with a nil generator failed: %v", err)
+	}
+}
+
+func randomInput(n int) []int {
+	rnd := rand.New(rand.NewSource(1))
+	input := make([]int, n)
+	for i := range input {
+		input[i] = rnd.Int()
+	}
+	return input
+}
+
+func BenchmarkKth(b *testing.B) {
+	benchmarkSelect(b, kth(rand.New(rand.NewSource(1))), randomInput(1_000_000))
+}
+
+func BenchmarkMedianOfMedians(b *testing.B) {
+	benchmarkSelect(b, selection.MedianOfMedians[int], randomInput(1_000_000))
+}
+
+func BenchmarkSortThenIndex(b *testing.B) {
+	benchmarkSelect(b, func(s []int, k int, _ func(a, b int) bool) (int, error) {
+		sort.Ints(s)
+		return s[k-1], nil
+	}, randomInput(1_000_000))
+}
@@ -11,6 +11,12 @@ package sort
 import "github.com/TheAlgorithms/Go/constraints"
 
 func BinaryInsertion[T constraints.Ordered](arr []T) []T {
+	BinaryInsertionFunc(arr, orderedLess[T])
+	return arr
+}
+
+// BinaryInsertionFunc sorts arr in place according to less. It is stable.
+func BinaryInsertionFunc[T any](arr []T, less func(a, b T) bool) {
 	for currentIndex := 1; currentIndex < len(arr); currentIndex++ {
 		temporary := arr[currentIndex]
 		low := 0
@@ -18,7 +24,7 @@ func BinaryInsertion[T constraints.Ordered](arr []T) []T {
 
 		for low <= high {
 			mid := low + (high-low)/2
-			if arr[mid] > temporary {
+			if less(temporary, arr[mid]) {
 				high = mid - 1
 			} else {
 				low = mid + 1
@@ -31,5 +37,4 @@ func BinaryInsertion[T constraints.Ordered](arr []T) []T {
 
 		arr[low] = temporary
 	}
-	return arr
 }
@@ -0,0 +1,428 @@
+// blockmergesort.go
+// description: Stable in-place block merge sort
+// details:
+// A block merge sort in the style of GrailSort. It first collects about 2√n
+// distinct values at the front of the slice: √n of them tag blocks, the
+// other √n serve as an internal buffer. The rest of the slice is sorted
+// bottom-up. Runs of blockMergeRunSize elements are sorted by insertion
+// sort, and runs no longer than the buffer are merged by exchanging their
+// elements with the buffer. Longer runs are cut into blocks of the buffer
+// size. To merge two runs, their blocks are ordered by first element with a
+// selection sort, the tags breaking ties and recording which run each block
+// came from, and then every block is merged through the buffer with the
+// leftover of the blocks before it. At the end the collected values are
+// sorted and merged back with rotations. Equal elements never pass each
+// other, so the sort is stable, and nothing is copied outside the slice.
+// If the slice holds fewer distinct values than that, the ones found make a
+// smaller buffer and fewer tags, and runs too long for them are merged
+// block by block with rotations instead. With that few distinct values a
+// rotation-based merge pass stays linear.
+// worst-case time complexity: O(n log n)
+// best-case time complexity: O(n)
+// space complexity: O(1)
+// reference: https://github.com/Mrrl/GrailSort
+// see sortfunc.go
+
+package sort
+
+// blockMergeRunSize is the length of the runs sorted by insertion sort.
+const blockMergeRunSize = 16
+
+// BlockMergeSort sorts s in place according to less. It is stable and does
+// not allocate.
+func BlockMergeSort[T any](s []T, less func(a, b T) bool) {
+	BlockMergeSortWithSwap(s, less, nil)
+}
+
+// BlockMergeSortWithSwap is BlockMergeSort calling swap(i, j), if it is not
+// nil, after every exchange of s[i] and s[j]. It moves elements only by
+// exchanges.
+func BlockMergeSortWithSwap[T any](s []T, less func(a, b T) bool, swap func(i, j int)) {
+	n := len(s)
+	if n <= blockMergeRunSize {
+		insertionSortFunc(s, 0, n, less, swap)
+		return
+	}
+	if isSortedFunc(s, less) {
+		return
+	}
+
+	blockLen := 1
+	for blockLen*blockLen < n {
+		blockLen *= 2
+	}
+	tags := (n-1)/blockLen + 1
+	keys := findKeys(s, tags+blockLen, less, swap)
+	if keys == 1 {
+		// findKeys saw the whole slice, and all of it is equal.
+		return
+	}
+	bufLen := blockLen
+	if keys < tags+blockLen {
+		// findKeys saw the whole slice, which holds exactly keys distinct
+		// values. Half of them, rounded down to a power of two, make the
+		// buffer.
+		bufLen = 1
+		for 4*bufLen <= keys {
+			bufLen *= 2
+		}
+		tags = keys - bufLen
+	}
+
+	// s[:tags] holds the tags, s[tags:keys] the buffer and s[keys:] the data.
+	for a := keys; a < n; a += blockMergeRunSize {
+		insertionSortFunc(s, a, minInt(a+blockMergeRunSize, n), less, swap)
+	}
+	for run := blockMergeRunSize; run < n-keys; run *= 2 {
+		switch {
+		case run <= bufLen:
+			for a := keys; a+run < n; a += 2 * run {
+				mergeWithBuffer(s, a, a+run, minInt(a+2*run, n), keys-bufLen, less, swap)
+			}
+		case minInt(2*run, n-keys)/bufLen <= tags:
+			blockMergePass(s, keys, run, bufLen, true, less, swap)
+		default:
+			// Too few tags for blocks of the buffer size: use longer blocks,
+			// all the keys as tags and no buffer. The block length keeps
+			// both the selection sort of the blocks and the rotations linear.
+			blockLen = 1
+			for blockLen*keys < 2*run || blockLen*blockLen < 2*run {
+				blockLen *= 2
+			}
+			blockMergePass(s, keys, run, blockLen, false, less, swap)
+		}
+	}
+
+	insertionSortFunc(s, 0, keys, less, swap)
+	mergeWithoutBuffer(s, 0, keys, len(s), less, swap)
+}
+
+// isSortedFunc reports whether s is sorted according to less.
+func isSortedFunc[T any](s []T, less func(a, b T) bool) bool {
+	for i := 1; i < len(s); i++ {
+		if less(s[i], s[i-1]) {
+			return false
+		}
+	}
+	return true
+}
+
+// findKeys moves the first occurrences of up to want distinct values to the
+// front of s in increasing order, keeping the other elements in order, and
+// returns how many it found. If it finds fewer than want, s holds exactly
+// that many distinct values.
+func findKeys[T any](s []T, want int, less func(a, b T) bool, swap func(i, j int)) int {
+	// The keys found so far are s[first:first+count]; they travel along with
+	// the scan so that each new one is inserted next to them.
+	first, count := 0, 1
+	for u := 1; u < len(s) && count < want; u++ {
+		r := lowerBound(s, first, first+count, u, less)
+		if r == first+count || less(s[u], s[r]) {
+			rotate(s, first, first+count, u, swap)
+			r += u - count - first
+			first = u - count
+			rotate(s, r, u, u+1, swap)
+			count++
+		}
+	}
+	rotate(s, 0, first, first+count, swap)
+	return count
+}
+
+// blockMergePass merges the neighbouring runs of length run in s[data:]
+// block by block, with blocks of blockLen elements and the tags at the
+// front of s. If buffered, the merges go through the buffer of blockLen
+// elements just before s[data:].
+func blockMergePass[T any](s []T, data, run, blockLen int, buffered bool, less func(a, b T) bool, swap func(i, j int)) {
+	n := len(s)
+	for a := data; a < n; a += 2 * run {
+		m := a + run
+		if m >= n {
+			if buffered {
+				shiftLeft(s, a, n, blockLen, swap)
+			}
+			break
+		}
+		b := minInt(a+2*run, n)
+		full := m + (b-m)/blockLen*blockLen
+		if full > m {
+			mergeBlocks(s, a, m, full, blockLen, buffered, less, swap)
+		} else if buffered {
+			shiftLeft(s, a, m, blockLen, swap)
+		}
+		// The merged run is followed by a tail shorter than a block.
+		if full < b {
+			if buffered {
+				mergeTailWithBuffer(s, a-blockLen, full-blockLen, full, b, less, swap)
+			} else {
+				mergeWithoutBuffer(s, a, full, b, less, swap)
+			}
+		}
+	}
+	// Each merge through the buffer has moved its run one buffer length to
+	// the left; move the data back after the buffer.
+	if buffered {
+		for i := n - 1; i >= data; i-- {
+			swapAt(s, i, i-blockLen, swap)
+		}
+	}
+}
+
+// mergeBlocks merges the sorted runs s[a:m] and s[m:end], whose lengths are
+// multiples of blockLen. If buffered, the result is written one block to the
+// left and the buffer s[a-blockLen:a] ends up at s[end-blockLen:end].
+func mergeBlocks[T any](s []T, a, m, end, blockLen int, buffered bool, less func(a, b T) bool, swap func(i, j int)) {
+	// Tag the blocks in order, then sort them by first element, breaking
+	// ties by tag so that equal blocks keep their order and blocks of the
+	// first run go first. mid follows the tag of the first block of s[m:end].
+	count := (end - a) / blockLen
+	insertionSortFunc(s, 0, count, less, swap)
+	mid := (m - a) / blockLen
+	for i := 0; i < count-1; i++ {
+		min := i
+		for j := i + 1; j < count; j++ {
+			x, y := s[a+j*blockLen], s[a+min*blockLen]
+			if less(x, y) || !less(y, x) && less(s[j], s[min]) {
+				min = j
+			}
+		}
+		if min != i {
+			swapRanges(s, a+i*blockLen, a+min*blockLen, blockLen, swap)
+			swapAt(s, i, min, swap)
+			if mid == i {
+				mid = min
+			} else if mid == min {
+				mid = i
+			}
+		}
+	}
+
+	// Walk the blocks keeping the pending leftover of the previous ones,
+	// which all came from the same run. A block from that run makes the
+	// leftover final; a block from the other run is merged with it.
+	fromSecond := func(i int) bool { return !less(s[i], s[mid]) }
+	pending, pendingSecond := blockLen, fromSecond(0)
+	for i := 1; i < count; i++ {
+		start := a + i*blockLen
+		second := fromSecond(i)
+		switch {
+		case second == pendingSecond:
+			if buffered {
+				swapRanges(s, start-pending-blockLen, start-pending, pending, swap)
+			}
+			pending = blockLen
+		case buffered:
+			pending, pendingSecond = smartMergeWithBuffer(s, start-pending, pending, blockLen, pendingSecond, less, swap)
+		default:
+			pending, pendingSecond = smartMergeWithoutBuffer(s, start-pending, pending, blockLen, pendingSecond, less, swap)
+		}
+	}
+	if buffered {
+		swapRanges(s, end-pending-blockLen, end-pending, pending, swap)
+	}
+}
+
+// smartMergeWithBuffer merges the leftover s[lo:lo+len1] with the next
+// block s[lo+len1:lo+len1+len2] into the buffer of len2 elements before lo,
+// until one of them runs out. Ties go to the element of the first run. It
+// returns the length of what is left, now at the end of the block with the
+// buffer before it, and whether it came from the second run.
+func smartMergeWithBuffer[T any](s []T, lo, len1, len2 int, pendingSecond bool, less func(a, b T) bool, swap func(i, j int)) (int, bool) {
+	out, i, j := lo-len2, lo, lo+len1
+	iEnd, jEnd := j, j+len2
+	for i < iEnd && j < jEnd {
+		var takeLeft bool
+		if pendingSecond {
+			takeLeft = less(s[i], s[j])
+		} else {
+			takeLeft = !less(s[j], s[i])
+		}
+		if takeLeft {
+			swapAt(s, out, i, swap)
+			i++
+		} else {
+			swapAt(s, out, j, swap)
+			j++
+		}
+		out++
+	}
+	if i < iEnd {
+		left := iEnd - i
+		for i < iEnd {
+			iEnd--
+			jEnd--
+			swapAt(s, iEnd, jEnd, swap)
+		}
+		return left, pendingSecond
+	}
+	return jEnd - j, !pendingSecond
+}
+
+// smartMergeWithoutBuffer is smartMergeWithBuffer with rotations instead of
+// a buffer. Each rotation puts a group of block elements before an element
+// of the leftover greater than them, so a whole pass of it does at most one
+// rotation per distinct value.
+func smartMergeWithoutBuffer[T any](s []T, lo, len1, len2 int, pendingSecond bool, less func(a, b T) bool, swap func(i, j int)) (int, bool) {
+	leftFirst := func(x, y T) bool {
+		if pendingSecond {
+			return less(x, y)
+		}
+		return !less(y, x)
+	}
+	if leftFirst(s[lo+len1-1], s[lo+len1]) {
+		return len2, !pendingSecond
+	}
+	for len1 > 0 {
+		var h int
+		if pendingSecond {
+			h = upperBound(s, lo+len1, lo+len1+len2, lo, less) - (lo + len1)
+		} else {
+			h = lowerBound(s, lo+len1, lo+len1+len2, lo, less) - (lo + len1)
+		}
+		if h != 0 {
+			rotate(s, lo, lo+len1, lo+len1+h, swap)
+			lo += h
+			len2 -= h
+		}
+		if len2 == 0 {
+			return len1, pendingSecond
+		}
+		for {
+			lo++
+			len1--
+			if len1 == 0 || !leftFirst(s[lo], s[lo+len1]) {
+				break
+			}
+		}
+	}
+	return len2, !pendingSecond
+}
+
+// mergeWithBuffer merges the sorted runs s[a:m] and s[m:b] in place,
+// exchanging s[a:m] with the buffer s[buf:buf+m-a] first.
+func mergeWithBuffer[T any](s []T, a, m, b, buf int, less func(a, b T) bool, swap func(i, j int)) {
+	if !less(s[m], s[m-1]) {
+		return
+	}
+	swapRanges(s, a, buf, m-a, swap)
+	i, iEnd, j := buf, buf+m-a, m
+	for k := a; i < iEnd; k++ {
+		if j < b && less(s[j], s[i]) {
+			swapAt(s, k, j, swap)
+			j++
+		} else {
+			swapAt(s, k, i, swap)
+			i++
+		}
+	}
+}
+
+// mergeTailWithBuffer merges the sorted run s[lo:m] with the tail s[gap:hi]
+// across the buffer s[m:gap], which must be at least as long as the tail.
+// The result fills s[lo:lo+hi-gap+m-lo] and the buffer ends up after it.
+func mergeTailWithBuffer[T any](s []T, lo, m, gap, hi int, less func(a, b T) bool, swap func(i, j int)) {
+	k := m + (hi - gap) - 1
+	for i, j := m-1, hi-1; j >= gap; k-- {
+		if i >= lo && less(s[j], s[i]) {
+			swapAt(s, k, i, swap)
+			i--
+		} else {
+			swapAt(s, k, j, swap)
+			j--
+		}
+	}
+}
+
+// mergeWithoutBuffer merges the sorted runs s[lo:m] and s[m:hi] in place
+// with rotations, rotating the shorter run's leftover each time.
+func mergeWithoutBuffer[T any](s []T, lo, m, hi int, less func(a, b T) bool, swap func(i, j int)) {
+	len1, len2 := m-lo, hi-m
+	if len1 < len2 {
+		for len1 > 0 {
+			h := lowerBound(s, lo+len1, lo+len1+len2, lo, less) - (lo + len1)
+			if h != 0 {
+				rotate(s, lo, lo+len1, lo+len1+h, swap)
+				lo += h
+				len2 -= h
+			}
+			if len2 == 0 {
+				return
+			}
+			for {
+				lo++
+				len1--
+				if len1 == 0 || less(s[lo+len1], s[lo]) {
+					break
+				}
+			}
+		}
+		return
+	}
+	for len2 > 0 {
+		h := upperBound(s, lo, lo+len1, lo+len1+len2-1, less) - lo
+		if h != len1 {
+			rotate(s, lo+h, lo+len1, lo+len1+len2, swap)
+			len1 = h
+		}
+		if len1 == 0 {
+			return
+		}
+		for {
+			len2--
+			if len2 == 0 || less(s[lo+len1+len2-1], s[lo+len1-1]) {
+				break
+			}
+		}
+	}
+}
+
+// lowerBound returns the first index in s[lo:hi] whose element is not less
+// than s[key], or hi.
+func lowerBound[T any](s []T, lo, hi, key int, less func(a, b T) bool) int {
+	for lo < hi {
+		h := int(uint(lo+hi) >> 1)
+		if less(s[h], s[key]) {
+			lo = h + 1
+		} else {
+			hi = h
+		}
+	}
+	return lo
+}
+
+// upperBound returns the first index in s[lo:hi] whose element is greater
+// than s[key], or hi.
+func upperBound[T any](s []T, lo, hi, key int, less func(a, b T) bool) int {
+	for lo < hi {
+		h := int(uint(lo+hi) >> 1)
+		if !less(s[key], s[h]) {
+			lo = h + 1
+		} else {
+			hi = h
+		}
+	}
+	return lo
+}
+
+// swapRanges exchanges s[a:a+n] and s[b:b+n], which must not overlap.
+func swapRanges[T any](s []T, a, b, n int, swap func(i, j int)) {
+	for i := 0; i < n; i++ {
+		swapAt(s, a+i, b+i, swap)
+	}
+}
+
+// shiftLeft moves s[a:b] by elements to the left, exchanging it with the
+// elements before it, which end up at s[b-by:b] in some order.
+func shiftLeft[T any](s []T, a, b, by int, swap func(i, j int)) {
+	for i := a; i < b; i++ {
+		swapAt(s, i-by, i, swap)
+	}
+}
+
+// minInt returns the smaller of a and b.
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -7,15 +7,20 @@ import "github.com/TheAlgorithms/Go/constraints"
 
 // Bubble is a simple generic definition of Bubble sort algorithm.
 func Bubble[T constraints.Ordered](arr []T) []T {
+	BubbleFunc(arr, orderedLess[T])
+	return arr
+}
+
+// BubbleFunc sorts arr in place according to less. It is stable.
+func BubbleFunc[T any](arr []T, less func(a, b T) bool) {
 	swapped := true
 	for swapped {
 		swapped = false
 		for i := 0; i < len(arr)-1; i++ {
-			if arr[i+1] < arr[i] {
+			if less(arr[i+1], arr[i]) {
 				arr[i+1], arr[i] = arr[i], arr[i+1]
 				swapped = true
 			}
 		}
 	}
-	return arr
 }
@@ -5,16 +5,21 @@ import "github.com/TheAlgorithms/Go/constraints"
 
 // Circle sorts an array using the circle sort algorithm.
 func Circle[T constraints.Ordered](arr []T) []T {
+	CircleFunc(arr, orderedLess[T])
+	return arr
+}
+
+// CircleFunc sorts arr in place according to less using the circle sort algorithm.
+func CircleFunc[T any](arr []T, less func(a, b T) bool) {
 	if len(arr) == 0 {
-		return arr
+		return
 	}
-	for doSort(arr, 0, len(arr)-1) {
+	for doSort(arr, 0, len(arr)-1, less) {
 	}
-	return arr
 }
 
 // doSort is the recursive function that implements the circle sort algorithm.
-func doSort[T constraints.Ordered](arr []T, left, right int) bool {
+func doSort[T any](arr []T, left, right int, less func(a, b T) bool) bool {
 	if left == right {
 		return false
 	}
@@ -23,7 +28,7 @@ func doSort[T constraints.Ordered](arr []T, left, right int) bool {
 	high := right
 
 	for low < high {
-		if arr[low] > arr[high] {
+		if less(arr[high], arr[low]) {
 			arr[low], arr[high] = arr[high], arr[low]
 			swapped = true
 		}
@@ -31,14 +36,14 @@ func doSort[T constraints.Ordered](arr []T, left, right int) bool {
 		high--
 	}
 
-	if low == high && arr[low] > arr[high+1] {
+	if low == high && less(arr[high+1], arr[low]) {
 		arr[low], arr[high+1] = arr[high+1], arr[low]
 		swapped = true
 	}
 
 	mid := left + (right-left)/2
-	leftHalf := doSort(arr, left, mid)
-	rightHalf := doSort(arr, mid+1, right)
+	leftHalf := doSort(arr, left, mid, less)
+	rightHalf := doSort(arr, mid+1, right, less)
 
 	return swapped || leftHalf || rightHalf
 }
@@ -7,8 +7,14 @@ import "github.com/TheAlgorithms/Go/constraints"
 
 // Cocktail sort is a variation of bubble sort, operating in two directions (beginning to end, end to beginning)
 func Cocktail[T constraints.Ordered](arr []T) []T {
+	CocktailFunc(arr, orderedLess[T])
+	return arr
+}
+
+// CocktailFunc sorts arr in place according to less. It is stable.
+func CocktailFunc[T any](arr []T, less func(a, b T) bool) {
 	if len(arr) == 0 { // ignore 0 length arrays
-		return arr
+		return
 	}
 
 	swapped := true // true if swapped two or more elements in the last loop
@@ -24,7 +30,7 @@ func Cocktail[T constraints.Ordered](arr []T) []T {
 		var new_end int
 
 		for i := start; i < end; i++ { // first loop, from start to end
-			if arr[i] > arr[i+1] { // if current and next elements are unordered
+			if less(arr[i+1], arr[i]) { // if current and next elements are unordered
 				arr[i], arr[i+1] = arr[i+1], arr[i] // swap two elements
 				new_end = i
 				swapped = true
@@ -40,7 +46,7 @@ func Cocktail[T constraints.Ordered](arr []T) []T {
 		swapped = false
 
 		for i := end; i > start; i-- { // second loop, from end to start
-			if arr[i] < arr[i-1] { // same process of the first loop, now going 'backwards'
+			if less(arr[i], arr[i-1]) { // same process of the first loop, now going 'backwards'
 				arr[i], arr[i-1] = arr[i-1], arr[i]
 				new_start = i
 				swapped = true
@@ -49,6 +55,4 @@ func Cocktail[T constraints.Ordered](arr []T) []T {
 
 		start = new_start
 	}
-
-	return arr
 }
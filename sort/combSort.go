@@ -18,6 +18,12 @@ func getNextGap(gap int) int {
 
 // Comb is a simple sorting algorithm which is an improvement of the bubble sorting algorithm.
 func Comb[T constraints.Ordered](data []T) []T {
+	CombFunc(data, orderedLess[T])
+	return data
+}
+
+// CombFunc sorts data in place according to less using comb sort.
+func CombFunc[T any](data []T, less func(a, b T) bool) {
 	n := len(data)
 	gap := n
 	swapped := true
@@ -26,11 +32,10 @@ func Comb[T constraints.Ordered](data []T) []T {
 		gap = getNextGap(gap)
 		swapped = false
 		for i := 0; i < n-gap; i++ {
-			if data[i] > data[i+gap] {
+			if less(data[i+gap], data[i]) {
 				data[i], data[i+gap] = data[i+gap], data[i]
 				swapped = true
 			}
 		}
 	}
-	return data
 }
@@ -8,10 +8,18 @@ import (
 // when sorting arrays containing elements with a small range of values. It is theoretically
 // optimal in terms of the total number of writes to the original array.
 func Cycle[T constraints.Number](arr []T) []T {
+	CycleFunc(arr, orderedLess[T])
+	return arr
+}
+
+// CycleFunc sorts arr in place according to less using cycle sort. Two
+// elements are equal if neither is less than the other.
+func CycleFunc[T any](arr []T, less func(a, b T) bool) {
+	equal := func(a, b T) bool { return !less(a, b) && !less(b, a) }
 	counter, cycle, len := 0, 0, len(arr)
 	// Early return if the array too small
 	if len <= 1 {
-		return arr
+		return
 	}
 
 	for cycle = 0; cycle < len-1; cycle++ {
@@ -19,7 +27,7 @@ func Cycle[T constraints.Number](arr []T) []T {
 		// Find total smaller elements to right
 		pos := cycle
 		for counter = cycle + 1; counter < len; counter++ {
-			if arr[counter] < elem {
+			if less(arr[counter], elem) {
 				pos++
 			}
 		}
@@ -29,7 +37,7 @@ func Cycle[T constraints.Number](arr []T) []T {
 		}
 		// In case we have same elements, we want to skip to the end of that list as well, ignoring order
 		// This makes the algorithm unstable for composite elements
-		for elem == arr[pos] {
+		for equal(elem, arr[pos]) {
 			pos++
 		}
 		// Now let us put the item to it's right position
@@ -40,19 +48,17 @@ func Cycle[T constraints.Number](arr []T) []T {
 			pos = cycle
 			// Find smaller elements to right again
 			for counter = cycle + 1; counter < len; counter++ {
-				if arr[counter] < elem {
+				if less(arr[counter], elem) {
 					pos++
 				}
 			}
-			for elem == arr[pos] {
+			for equal(elem, arr[pos]) {
 				pos++
 			}
 			//We can do this unconditionally, but the check helps prevent redundant writes to the array
-			if elem != arr[pos] {
+			if !equal(elem, arr[pos]) {
 				arr[pos], elem = elem, arr[pos]
 			}
 		}
 	}
-
-	return arr
 }
@@ -9,13 +9,17 @@ package sort
 import "github.com/TheAlgorithms/Go/constraints"
 
 func Exchange[T constraints.Ordered](arr []T) []T {
+	ExchangeFunc(arr, orderedLess[T])
+	return arr
+}
+
+// ExchangeFunc sorts arr in place according to less using exchange sort.
+func ExchangeFunc[T any](arr []T, less func(a, b T) bool) {
 	for i := 0; i < len(arr)-1; i++ {
 		for j := i + 1; j < len(arr); j++ {
-			if arr[i] > arr[j] {
+			if less(arr[j], arr[i]) {
 				arr[i], arr[j] = arr[j], arr[i]
 			}
 		}
 	}
-
-	return arr
 }
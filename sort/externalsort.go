@@ -0,0 +1,188 @@
+// externalsort.go
+// description: External merge sort for data larger than memory
+// details:
+// Records are read from an io.Reader in chunks of at most RunSize records. Each
+// chunk is sorted in memory and spilled to a temporary file as a sorted run.
+// The runs are then merged in a single k-way pass, driven by a min-heap of the
+// heads of every run, and written to an io.Writer. Memory use is bounded by
+// RunSize records plus one buffered reader per run.
+// Records go through user supplied Encode and Decode functions, so any record
+// format can be sorted.
+// worst-case time complexity: O(n log n)
+// space complexity: O(RunSize) in memory, O(n) on disk
+// reference: https://en.wikipedia.org/wiki/External_sorting
+
+package sort
+
+import (
+	"bufio"
+	"container/heap"
+	"errors"
+	"io"
+	"os"
+)
+
+// ExternalSorter sorts a stream of records that does not fit in memory.
+type ExternalSorter[T any] struct {
+	// Less orders the records.
+	Less func(a, b T) bool
+	// Encode writes a single record to w.
+	Encode func(w io.Writer, record T) error
+	// Decode reads a single record from r. It must return io.EOF, and no
+	// record, once the input is exhausted.
+	Decode func(r io.Reader) (T, error)
+	// RunSize is the maximum number of records held in memory at once.
+	RunSize int
+	// TempDir is the directory for run files; os.TempDir is used if empty.
+	TempDir string
+}
+
+// Sort reads all records from r and writes them to w in sorted order.
+// Temporary run files are removed before Sort returns.
+func (s *ExternalSorter[T]) Sort(r io.Reader, w io.Writer) error {
+	if s.Less == nil || s.Encode == nil || s.Decode == nil {
+		return errors.New("external sort needs Less, Encode and Decode")
+	}
+	if s.RunSize < 1 {
+		return errors.New("external sort needs a positive RunSize")
+	}
+
+	var runs []*os.File
+	defer func() {
+		for _, f := range runs {
+			f.Close()
+			os.Remove(f.Name())
+		}
+	}()
+
+	in := bufio.NewReader(r)
+	chunk := make([]T, 0, s.RunSize)
+	for done := false; !done; {
+		chunk = chunk[:0]
+		for len(chunk) < s.RunSize {
+			record, err := s.Decode(in)
+			if err == io.EOF {
+				done = true
+				break
+			}
+			if err != nil {
+				return err
+			}
+			chunk = append(chunk, record)
+		}
+		if len(chunk) == 0 {
+			break
+		}
+		ParallelMergeFunc(chunk, s.Less, 0)
+		f, err := s.spill(chunk)
+		if f != nil {
+			runs = append(runs, f)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return s.merge(runs, w)
+}
+
+// spill writes a sorted chunk to a new temporary file.
+func (s *ExternalSorter[T]) spill(chunk []T) (*os.File, error) {
+	f, err := os.CreateTemp(s.TempDir, "externalsort-run-*")
+	if err != nil {
+		return nil, err
+	}
+	out := bufio.NewWriter(f)
+	for _, record := range chunk {
+		if err := s.Encode(out, record); err != nil {
+			return f, err
+		}
+	}
+	return f, out.Flush()
+}
+
+// merge performs the k-way merge of the sorted runs into w.
+func (s *ExternalSorter[T]) merge(runs []*os.File, w io.Writer) error {
+	h := &runHeap[T]{less: s.Less}
+	for i, f := range runs {
+		if _, err := f.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+		head := runHead[T]{reader: bufio.NewReader(f), index: i}
+		ok, err := s.advance(&head)
+		if err != nil {
+			return err
+		}
+		if ok {
+			h.items = append(h.items, head)
+		}
+	}
+	heap.Init(h)
+
+	out := bufio.NewWriter(w)
+	for h.Len() > 0 {
+		head := &h.items[0]
+		if err := s.Encode(out, head.record); err != nil {
+			return err
+		}
+		ok, err := s.advance(head)
+		if err != nil {
+			return err
+		}
+		if ok {
+			heap.Fix(h, 0)
+		} else {
+			heap.Pop(h)
+		}
+	}
+	return out.Flush()
+}
+
+// advance reads the next record of a run into head and reports whether
+// there was one.
+func (s *ExternalSorter[T]) advance(head *runHead[T]) (bool, error) {
+	record, err := s.Decode(head.reader)
+	if err == io.EOF {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	head.record = record
+	return true, nil
+}
+
+// runHead is the current record of one sorted run.
+type runHead[T any] struct {
+	record T
+	reader *bufio.Reader
+	index  int // position of the run, used to keep the merge stable
+}
+
+// runHeap is a min-heap of run heads implementing container/heap.Interface.
+type runHeap[T any] struct {
+	items []runHead[T]
+	less  func(a, b T) bool
+}
+
+func (h *runHeap[T]) Len() int { return len(h.items) }
+
+func (h *runHeap[T]) Less(i, j int) bool {
+	a, b := h.items[i], h.items[j]
+	if h.less(a.record, b.record) {
+		return true
+	}
+	if h.less(b.record, a.record) {
+		return false
+	}
+	return a.index < b.index
+}
+
+func (h *runHeap[T]) Swap(i, j int) { h.items[i], h.items[j] = h.items[j], h.items[i] }
+
+func (h *runHeap[T]) Push(x any) { h.items = append(h.items, x.(runHead[T])) }
+
+func (h *runHeap[T]) Pop() any {
+	last := h.items[len(h.items)-1]
+	h.items = h.items[:len(h.items)-1]
+	return last
+}
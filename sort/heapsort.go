@@ -120,10 +120,16 @@ type Comparable interface {
 }
 
 func HeapSort[T constraints.Ordered](slice []T) []T {
+	HeapSortFunc(slice, orderedLess[T])
+	return slice
+}
+
+// HeapSortFunc sorts slice in place according to less using heap sort.
+func HeapSortFunc[T any](slice []T, less func(a, b T) bool) {
 	N := len(slice)
 
 	moreFunc := func(i, j int) bool {
-		return slice[i] > slice[j]
+		return less(slice[j], slice[i])
 	}
 	swapFunc := func(i, j int) {
 		slice[i], slice[j] = slice[j], slice[i]
@@ -138,6 +144,4 @@ func HeapSort[T constraints.Ordered](slice []T) []T {
 		slice[i], slice[0] = slice[0], slice[i]
 		heapifyDown(slice, i, 0, moreFunc, swapFunc)
 	}
-
-	return slice
 }
@@ -9,13 +9,18 @@ package sort
 import "github.com/TheAlgorithms/Go/constraints"
 
 func Insertion[T constraints.Ordered](arr []T) []T {
+	InsertionFunc(arr, orderedLess[T])
+	return arr
+}
+
+// InsertionFunc sorts arr in place according to less. It is stable.
+func InsertionFunc[T any](arr []T, less func(a, b T) bool) {
 	for currentIndex := 1; currentIndex < len(arr); currentIndex++ {
 		temporary := arr[currentIndex]
 		iterator := currentIndex
-		for ; iterator > 0 && arr[iterator-1] > temporary; iterator-- {
+		for ; iterator > 0 && less(temporary, arr[iterator-1]); iterator-- {
 			arr[iterator] = arr[iterator-1]
 		}
 		arr[iterator] = temporary
 	}
-	return arr
 }
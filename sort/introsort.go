@@ -0,0 +1,84 @@
+// introsort.go
+// description: Introsort, quicksort with a heapsort fallback
+// details:
+// Introsort runs quicksort with a median-of-three pivot, but gives up on a
+// range once the recursion gets deeper than 2*log2(n) and heapsorts it
+// instead, which caps the worst case at O(n log n). Short ranges are finished
+// with insertion sort.
+// worst-case time complexity: O(n log n)
+// average-case time complexity: O(n log n)
+// space complexity: O(log n)
+// reference: https://en.wikipedia.org/wiki/Introsort
+// see sortfunc.go
+
+package sort
+
+import "math/bits"
+
+// introInsertionThreshold is the range length below which Intro uses insertion sort.
+const introInsertionThreshold = 16
+
+// Intro sorts s in place according to less using introsort. It is not stable.
+func Intro[T any](s []T, less func(a, b T) bool) {
+	IntroWithSwap(s, less, nil)
+}
+
+// IntroWithSwap is Intro calling swap(i, j), if it is not nil, after every
+// exchange of s[i] and s[j].
+func IntroWithSwap[T any](s []T, less func(a, b T) bool, swap func(i, j int)) {
+	introSort(s, 0, len(s), 2*bits.Len(uint(len(s))), less, swap)
+}
+
+func introSort[T any](s []T, a, b, depth int, less func(a, b T) bool, swap func(i, j int)) {
+	for b-a > introInsertionThreshold {
+		if depth == 0 {
+			heapSortFunc(s, a, b, less, swap)
+			return
+		}
+		depth--
+		p := introPartition(s, a, b, less, swap)
+		// Recurse into the smaller side to bound the stack depth.
+		if p-a < b-p {
+			introSort(s, a, p, depth, less, swap)
+			a = p + 1
+		} else {
+			introSort(s, p+1, b, depth, less, swap)
+			b = p
+		}
+	}
+	insertionSortFunc(s, a, b, less, swap)
+}
+
+// introPartition partitions s[a:b] around the median of its first, middle
+// and last elements and returns the final position of the pivot.
+func introPartition[T any](s []T, a, b int, less func(a, b T) bool, swap func(i, j int)) int {
+	m := a + (b-a)/2
+	if less(s[m], s[a]) {
+		swapAt(s, m, a, swap)
+	}
+	if less(s[b-1], s[m]) {
+		swapAt(s, b-1, m, swap)
+		if less(s[m], s[a]) {
+			swapAt(s, m, a, swap)
+		}
+	}
+	// The median becomes the pivot at a.
+	swapAt(s, a, m, swap)
+	i, j := a+1, b-1
+	for {
+		for i <= j && less(s[i], s[a]) {
+			i++
+		}
+		for i <= j && less(s[a], s[j]) {
+			j--
+		}
+		if i >= j {
+			break
+		}
+		swapAt(s, i, j, swap)
+		i++
+		j--
+	}
+	swapAt(s, a, j, swap)
+	return j
+}
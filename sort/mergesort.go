@@ -12,7 +12,7 @@ import (
 	"sync"
 )
 
-func merge[T constraints.Ordered](a []T, b []T) []T {
+func merge[T any](a []T, b []T, less func(a, b T) bool) []T {
 
 	var r = make([]T, len(a)+len(b))
 	var i = 0
@@ -20,7 +20,7 @@ func merge[T constraints.Ordered](a []T, b []T) []T {
 
 	for i < len(a) && j < len(b) {
 
-		if a[i] <= b[j] {
+		if !less(b[j], a[i]) {
 			r[i+j] = a[i]
 			i++
 		} else {
@@ -45,27 +45,45 @@ func merge[T constraints.Ordered](a []T, b []T) []T {
 
 // Merge Perform merge sort on a slice
 func Merge[T constraints.Ordered](items []T) []T {
-
 	if len(items) < 2 {
 		return items
+	}
+
+	sorted := append([]T(nil), items...)
+	MergeFunc(sorted, orderedLess[T])
+	return sorted
+}
+
+// MergeFunc sorts items in place according to less using merge sort. It is
+// stable.
+func MergeFunc[T any](items []T, less func(a, b T) bool) {
+
+	if len(items) < 2 {
+		return
 
 	}
 
 	var middle = len(items) / 2
-	var a = Merge(items[:middle])
-	var b = Merge(items[middle:])
-	return merge(a, b)
+	MergeFunc(items[:middle], less)
+	MergeFunc(items[middle:], less)
+	copy(items, merge(items[:middle], items[middle:], less))
 
 }
 
 func MergeIter[T constraints.Ordered](items []T) []T {
+	MergeIterFunc(items, orderedLess[T])
+	return items
+}
+
+// MergeIterFunc sorts items in place according to less using bottom-up
+// merge sort. It is stable.
+func MergeIterFunc[T any](items []T, less func(a, b T) bool) {
 	for step := 1; step < len(items); step += step {
 		for i := 0; i+step < len(items); i += 2 * step {
-			tmp := merge(items[i:i+step], items[i+step:min.Int(i+2*step, len(items))])
+			tmp := merge(items[i:i+step], items[i+step:min.Int(i+2*step, len(items))], less)
 			copy(items[i:], tmp)
 		}
 	}
-	return items
 }
 
 // ParallelMerge Perform merge sort on a slice using goroutines
@@ -90,5 +108,5 @@ func ParallelMerge[T constraints.Ordered](items []T) []T {
 	var b = ParallelMerge(items[middle:])
 
 	wg.Wait()
-	return merge(a, b)
+	return merge(a, b, orderedLess[T])
 }
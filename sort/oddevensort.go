@@ -10,8 +10,14 @@ import "github.com/TheAlgorithms/Go/constraints"
 // It is a variation of bubble sort that compares adjacent pairs, alternating
 // between odd and even indexed elements in each pass until the array is sorted.
 func OddEvenSort[T constraints.Ordered](arr []T) []T {
+	OddEvenSortFunc(arr, orderedLess[T])
+	return arr
+}
+
+// OddEvenSortFunc sorts arr in place according to less. It is stable.
+func OddEvenSortFunc[T any](arr []T, less func(a, b T) bool) {
 	if len(arr) == 0 { // handle empty array
-		return arr
+		return
 	}
 
 	swapped := true
@@ -20,7 +26,7 @@ func OddEvenSort[T constraints.Ordered](arr []T) []T {
 
 		// Perform "odd" indexed pass
 		for i := 1; i < len(arr)-1; i += 2 {
-			if arr[i] > arr[i+1] {
+			if less(arr[i+1], arr[i]) {
 				arr[i], arr[i+1] = arr[i+1], arr[i]
 				swapped = true
 			}
@@ -28,12 +34,10 @@ func OddEvenSort[T constraints.Ordered](arr []T) []T {
 
 		// Perform "even" indexed pass
 		for i := 0; i < len(arr)-1; i += 2 {
-			if arr[i] > arr[i+1] {
+			if less(arr[i+1], arr[i]) {
 				arr[i], arr[i+1] = arr[i+1], arr[i]
 				swapped = true
 			}
 		}
 	}
-
-	return arr
 }
@@ -6,9 +6,15 @@ import "github.com/TheAlgorithms/Go/constraints"
 // where flip refers to the idea of reversing the
 // slice from index `0` to `i`.
 func Pancake[T constraints.Ordered](arr []T) []T {
+	PancakeFunc(arr, orderedLess[T])
+	return arr
+}
+
+// PancakeFunc sorts arr in place according to less using flip operations.
+func PancakeFunc[T any](arr []T, less func(a, b T) bool) {
 	// early return if the array too small
 	if len(arr) <= 1 {
-		return arr
+		return
 	}
 
 	// start from the end of the array
@@ -16,7 +22,7 @@ func Pancake[T constraints.Ordered](arr []T) []T {
 		// find the index of the maximum element in arr
 		max := 0
 		for j := 1; j <= i; j++ {
-			if arr[j] > arr[max] {
+			if less(arr[max], arr[j]) {
 				max = j
 			}
 		}
@@ -30,12 +36,10 @@ func Pancake[T constraints.Ordered](arr []T) []T {
 			arr = flip(arr, i)
 		}
 	}
-
-	return arr
 }
 
 // flip reverses the input slice from `0` to `i`.
-func flip[T constraints.Ordered](arr []T, i int) []T {
+func flip[T any](arr []T, i int) []T {
 	for j := 0; j < i; j++ {
 		arr[j], arr[i] = arr[i], arr[j]
 		i--
@@ -0,0 +1,80 @@
+// parallelmergesort.go
+// description: Parallel merge sort for any type with a configurable threshold
+// details:
+// The slice is split in halves which are sorted concurrently as long as they
+// are larger than the threshold; smaller pieces are sorted sequentially. Each
+// level merges through a single scratch buffer allocated once up front, so the
+// sort allocates O(n) memory in total instead of a new slice on every merge.
+// The sort is stable.
+// worst-case time complexity: O(n log n), O(n log n / p) wall time on p cores
+// space complexity: O(n)
+
+package sort
+
+import "sync"
+
+// DefaultParallelThreshold is the slice length below which ParallelMergeFunc
+// stops spawning goroutines when it is given a threshold smaller than 1.
+const DefaultParallelThreshold = 1 << 13
+
+// ParallelMergeFunc sorts items in place with a stable merge sort ordered by
+// less, sorting halves longer than threshold on separate goroutines.
+func ParallelMergeFunc[T any](items []T, less func(a, b T) bool, threshold int) {
+	if threshold < 1 {
+		threshold = DefaultParallelThreshold
+	}
+	buf := make([]T, len(items))
+	parallelMergeSort(items, buf, less, threshold)
+}
+
+// parallelMergeSort sorts items using buf, which has the same length, as
+// scratch space.
+func parallelMergeSort[T any](items, buf []T, less func(a, b T) bool, threshold int) {
+	if len(items) <= 16 {
+		for i := 1; i < len(items); i++ {
+			for j := i; j > 0 && less(items[j], items[j-1]); j-- {
+				items[j], items[j-1] = items[j-1], items[j]
+			}
+		}
+		return
+	}
+
+	middle := len(items) / 2
+	if len(items) > threshold {
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			parallelMergeSort(items[:middle], buf[:middle], less, threshold)
+		}()
+		parallelMergeSort(items[middle:], buf[middle:], less, threshold)
+		wg.Wait()
+	} else {
+		parallelMergeSort(items[:middle], buf[:middle], less, threshold)
+		parallelMergeSort(items[middle:], buf[middle:], less, threshold)
+	}
+
+	// Already in order, nothing to merge.
+	if !less(items[middle], items[middle-1]) {
+		return
+	}
+	copy(buf, items)
+	mergeFunc(items, buf[:middle], buf[middle:], less)
+}
+
+// mergeFunc merges the sorted slices a and b into dst, taking from a on ties.
+func mergeFunc[T any](dst, a, b []T, less func(a, b T) bool) {
+	i, j, k := 0, 0, 0
+	for i < len(a) && j < len(b) {
+		if less(b[j], a[i]) {
+			dst[k] = b[j]
+			j++
+		} else {
+			dst[k] = a[i]
+			i++
+		}
+		k++
+	}
+	k += copy(dst[k:], a[i:])
+	copy(dst[k:], b[j:])
+}
@@ -18,13 +18,24 @@ func Patience[T constraints.Ordered](arr []T) []T {
 		return arr
 	}
 
+	sorted := append([]T(nil), arr...)
+	PatienceFunc(sorted, orderedLess[T])
+	return sorted
+}
+
+// PatienceFunc sorts arr in place according to less using patience sorting.
+func PatienceFunc[T any](arr []T, less func(a, b T) bool) {
+	if len(arr) <= 1 {
+		return
+	}
+
 	var piles [][]T
 
 	for _, card := range arr {
 		left, right := 0, len(piles)
 		for left < right {
 			mid := left + (right-left)/2
-			if piles[mid][len(piles[mid])-1] >= card {
+			if !less(piles[mid][len(piles[mid])-1], card) {
 				right = mid
 			} else {
 				left = mid + 1
@@ -38,10 +49,10 @@ func Patience[T constraints.Ordered](arr []T) []T {
 		}
 	}
 
-	return mergePiles(piles)
+	copy(arr, mergePiles(piles, less))
 }
 
-func mergePiles[T constraints.Ordered](piles [][]T) []T {
+func mergePiles[T any](piles [][]T, less func(a, b T) bool) []T {
 	var ret []T
 
 	for len(piles) > 0 {
@@ -49,7 +60,7 @@ func mergePiles[T constraints.Ordered](piles [][]T) []T {
 		minValue := piles[minID][len(piles[minID])-1]
 
 		for i := 1; i < len(piles); i++ {
-			if minValue <= piles[i][len(piles[i])-1] {
+			if !less(piles[i][len(piles[i])-1], minValue) {
 				continue
 			}
 
@@ -0,0 +1,272 @@
+// pdqsort.go
+// description: Pattern-defeating quicksort
+// details:
+// Pattern-defeating quicksort (pdqsort) is the quicksort variant used by the
+// standard libraries of Go, Rust and Boost. On top of introsort it
+//   - picks the pivot as the median of three, or the median of three medians
+//     (Tukey's ninther) on long ranges,
+//   - notices ranges that look sorted or reversed and finishes them with a
+//     bounded insertion sort, which makes sorted inputs O(n),
+//   - groups elements equal to the pivot together, which makes inputs with
+//     few distinct values O(n log k) for k distinct values,
+//   - shuffles a few elements after an unbalanced partition to break up
+//     adversarial patterns, and falls back to heapsort when that keeps failing.
+// worst-case time complexity: O(n log n)
+// best-case time complexity: O(n)
+// space complexity: O(log n)
+// reference: https://arxiv.org/abs/2106.05123
+// see sortfunc.go
+
+// Copyright 2009 The Go Authors. All rights reserved.
+// Adapted from the pdqsort of src/sort/zsortfunc.go in the Go standard
+// library. Use of this source code is governed by a BSD-style license that
+// can be found in the LICENSE_GO file.
+
+package sort
+
+import "math/bits"
+
+// pdqInsertionThreshold is the range length below which Pdq uses insertion sort.
+const pdqInsertionThreshold = 12
+
+type sortedHint int
+
+const (
+	unknownHint sortedHint = iota
+	increasingHint
+	decreasingHint
+)
+
+// Pdq sorts s in place according to less using pattern-defeating quicksort.
+// It is not stable.
+func Pdq[T any](s []T, less func(a, b T) bool) {
+	PdqWithSwap(s, less, nil)
+}
+
+// PdqWithSwap is Pdq calling swap(i, j), if it is not nil, after every
+// exchange of s[i] and s[j].
+func PdqWithSwap[T any](s []T, less func(a, b T) bool, swap func(i, j int)) {
+	pdqSort(s, 0, len(s), bits.Len(uint(len(s))), less, swap)
+}
+
+func pdqSort[T any](s []T, a, b, limit int, less func(a, b T) bool, swap func(i, j int)) {
+	wasBalanced, wasPartitioned := true, true
+	for {
+		length := b - a
+		if length <= pdqInsertionThreshold {
+			insertionSortFunc(s, a, b, less, swap)
+			return
+		}
+		// Too many bad pivots: switch to heapsort.
+		if limit == 0 {
+			heapSortFunc(s, a, b, less, swap)
+			return
+		}
+		if !wasBalanced {
+			breakPatterns(s, a, b, swap)
+			limit--
+		}
+
+		pivot, hint := choosePivot(s, a, b, less)
+		if hint == decreasingHint {
+			reverseRange(s, a, b, swap)
+			pivot = (b - 1) - (pivot - a)
+			hint = increasingHint
+		}
+		// The range looks sorted, try to finish it with few moves.
+		if wasBalanced && wasPartitioned && hint == increasingHint {
+			if partialInsertionSort(s, a, b, less, swap) {
+				return
+			}
+		}
+		// The element before the range is a lower bound for it. If it equals
+		// the pivot, everything equal to the pivot can be set aside at once.
+		if a > 0 && !less(s[a-1], s[pivot]) {
+			a = partitionEqual(s, a, b, pivot, less, swap)
+			continue
+		}
+
+		mid, alreadyPartitioned := pdqPartition(s, a, b, pivot, less, swap)
+		wasPartitioned = alreadyPartitioned
+		leftLen, rightLen := mid-a, b-mid
+		balanceThreshold := length / 8
+		if leftLen < rightLen {
+			wasBalanced = leftLen >= balanceThreshold
+			pdqSort(s, a, mid, limit, less, swap)
+			a = mid + 1
+		} else {
+			wasBalanced = rightLen >= balanceThreshold
+			pdqSort(s, mid+1, b, limit, less, swap)
+			b = mid
+		}
+	}
+}
+
+// pdqPartition partitions s[a:b] around s[pivot] and returns its final
+// position. It also reports whether no element had to be moved.
+func pdqPartition[T any](s []T, a, b, pivot int, less func(a, b T) bool, swap func(i, j int)) (int, bool) {
+	swapAt(s, a, pivot, swap)
+	i, j := a+1, b-1
+	for i <= j && less(s[i], s[a]) {
+		i++
+	}
+	for i <= j && !less(s[j], s[a]) {
+		j--
+	}
+	if i > j {
+		swapAt(s, j, a, swap)
+		return j, true
+	}
+	swapAt(s, i, j, swap)
+	i++
+	j--
+	for {
+		for i <= j && less(s[i], s[a]) {
+			i++
+		}
+		for i <= j && !less(s[j], s[a]) {
+			j--
+		}
+		if i > j {
+			break
+		}
+		swapAt(s, i, j, swap)
+		i++
+		j--
+	}
+	swapAt(s, j, a, swap)
+	return j, false
+}
+
+// partitionEqual moves the elements of s[a:b] equal to s[pivot] to the front,
+// knowing none is smaller, and returns the start of the greater ones.
+func partitionEqual[T any](s []T, a, b, pivot int, less func(a, b T) bool, swap func(i, j int)) int {
+	swapAt(s, a, pivot, swap)
+	i, j := a+1, b-1
+	for {
+		for i <= j && !less(s[a], s[i]) {
+			i++
+		}
+		for i <= j && less(s[a], s[j]) {
+			j--
+		}
+		if i > j {
+			break
+		}
+		swapAt(s, i, j, swap)
+		i++
+		j--
+	}
+	return i
+}
+
+// partialInsertionSort fixes up to a few out-of-order elements of s[a:b] and
+// reports whether the range ended up sorted.
+func partialInsertionSort[T any](s []T, a, b int, less func(a, b T) bool, swap func(i, j int)) bool {
+	const (
+		maxSteps         = 5  // maximum number of adjacent out-of-order pairs that get shifted
+		shortestShifting = 50 // don't shift any elements on short ranges
+	)
+	i := a + 1
+	for step := 0; step < maxSteps; step++ {
+		for i < b && !less(s[i], s[i-1]) {
+			i++
+		}
+		if i == b {
+			return true
+		}
+		if b-a < shortestShifting {
+			return false
+		}
+		swapAt(s, i, i-1, swap)
+		// Shift the smaller element to the left.
+		for j := i - 1; j > a && less(s[j], s[j-1]); j-- {
+			swapAt(s, j, j-1, swap)
+		}
+		// Shift the greater element to the right.
+		for j := i + 1; j < b && less(s[j], s[j-1]); j++ {
+			swapAt(s, j, j-1, swap)
+		}
+	}
+	return false
+}
+
+// breakPatterns swaps a few elements around the middle of s[a:b] with
+// pseudo-random positions.
+func breakPatterns[T any](s []T, a, b int, swap func(i, j int)) {
+	length := b - a
+	if length < 8 {
+		return
+	}
+	random := uint64(length)
+	modulus := uint64(1) << bits.Len(uint(length))
+	idx := a + (length/4)*2 - 1
+	for i := 0; i < 3; i++ {
+		// xorshift64
+		random ^= random << 13
+		random ^= random >> 7
+		random ^= random << 17
+		other := int(random & (modulus - 1))
+		if other >= length {
+			other -= length
+		}
+		swapAt(s, idx-1+i, a+other, swap)
+	}
+}
+
+// choosePivot returns a pivot index for s[a:b] along with a hint about the
+// order of the sampled elements.
+func choosePivot[T any](s []T, a, b int, less func(a, b T) bool) (int, sortedHint) {
+	const (
+		shortestNinther = 50
+		maxSwaps        = 4 * 3
+	)
+	l := b - a
+	swaps := 0
+	i, j, k := a+l/4*1, a+l/4*2, a+l/4*3
+	if l >= 8 {
+		if l >= shortestNinther {
+			i = medianAdjacent(s, i, &swaps, less)
+			j = medianAdjacent(s, j, &swaps, less)
+			k = medianAdjacent(s, k, &swaps, less)
+		}
+		j = median(s, i, j, k, &swaps, less)
+	}
+	switch swaps {
+	case 0:
+		return j, increasingHint
+	case maxSwaps:
+		return j, decreasingHint
+	default:
+		return j, unknownHint
+	}
+}
+
+// order2 returns a and b ordered so that s[a] <= s[b], counting swaps.
+func order2[T any](s []T, a, b int, swaps *int, less func(a, b T) bool) (int, int) {
+	if less(s[b], s[a]) {
+		*swaps++
+		return b, a
+	}
+	return a, b
+}
+
+// median returns the index of the median of s[a], s[b] and s[c].
+func median[T any](s []T, a, b, c int, swaps *int, less func(a, b T) bool) int {
+	a, b = order2(s, a, b, swaps, less)
+	b, c = order2(s, b, c, swaps, less)
+	_, b = order2(s, a, b, swaps, less)
+	return b
+}
+
+// medianAdjacent returns the index of the median of s[a-1], s[a] and s[a+1].
+func medianAdjacent[T any](s []T, a int, swaps *int, less func(a, b T) bool) int {
+	return median(s, a-1, a, a+1, swaps, less)
+}
+
+// reverseRange reverses s[a:b].
+func reverseRange[T any](s []T, a, b int, swap func(i, j int)) {
+	for i, j := a, b-1; i < j; i, j = i+1, j-1 {
+		swapAt(s, i, j, swap)
+	}
+}
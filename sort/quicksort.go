@@ -13,10 +13,15 @@ package sort
 import "github.com/TheAlgorithms/Go/constraints"
 
 func Partition[T constraints.Ordered](arr []T, low, high int) int {
+	return partitionFunc(arr, low, high, orderedLess[T])
+}
+
+// partitionFunc is Partition according to less.
+func partitionFunc[T any](arr []T, low, high int, less func(a, b T) bool) int {
 	index := low - 1
 	pivotElement := arr[high]
 	for i := low; i < high; i++ {
-		if arr[i] <= pivotElement {
+		if !less(pivotElement, arr[i]) {
 			index += 1
 			arr[index], arr[i] = arr[i], arr[index]
 		}
@@ -27,19 +32,29 @@ func Partition[T constraints.Ordered](arr []T, low, high int) int {
 
 // QuicksortRange Sorts the specified range within the array
 func QuicksortRange[T constraints.Ordered](arr []T, low, high int) {
+	quicksortRangeFunc(arr, low, high, orderedLess[T])
+}
+
+// quicksortRangeFunc is QuicksortRange according to less.
+func quicksortRangeFunc[T any](arr []T, low, high int, less func(a, b T) bool) {
 	if len(arr) <= 1 {
 		return
 	}
 
 	if low < high {
-		pivot := Partition(arr, low, high)
-		QuicksortRange(arr, low, pivot-1)
-		QuicksortRange(arr, pivot+1, high)
+		pivot := partitionFunc(arr, low, high, less)
+		quicksortRangeFunc(arr, low, pivot-1, less)
+		quicksortRangeFunc(arr, pivot+1, high, less)
 	}
 }
 
 // Quicksort Sorts the entire array
 func Quicksort[T constraints.Ordered](arr []T) []T {
-	QuicksortRange(arr, 0, len(arr)-1)
+	QuicksortFunc(arr, orderedLess[T])
 	return arr
 }
+
+// QuicksortFunc sorts arr in place according to less.
+func QuicksortFunc[T any](arr []T, less func(a, b T) bool) {
+	quicksortRangeFunc(arr, 0, len(arr)-1, less)
+}
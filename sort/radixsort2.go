@@ -0,0 +1,225 @@
+// radixsort2.go
+// description: Byte-wise LSD and MSD radix sorts for integers and strings
+// details:
+// Unlike RadixSort, which works on decimal digits and splits off the
+// negative numbers, these sorts process one byte at a time with 256 buckets.
+// Signed integers are handled by flipping their sign bit, which maps them onto
+// unsigned integers in the same order.
+// LSD (least significant digit first) makes one stable counting pass per byte,
+// and skips passes over bytes that are equal in every element.
+// MSD (most significant digit first) splits the input into buckets on the
+// leading byte and recurses into each bucket, finishing small buckets with
+// insertion sort. For strings, a string that ends sorts before any extension.
+// worst-case time complexity: O(n * w) where w is the number of bytes per key
+// space complexity: O(n)
+// reference: https://en.wikipedia.org/wiki/Radix_sort
+// see sorts_test.go
+
+package sort
+
+import "github.com/TheAlgorithms/Go/constraints"
+
+// radixInsertionThreshold is the bucket size below which MSD radix sorts
+// switch to insertion sort.
+const radixInsertionThreshold = 32
+
+// radixKey maps the integer type T onto uint64 preserving its order, and
+// returns the number of bytes of T.
+func radixKey[T constraints.Integer]() (func(T) uint64, int) {
+	width := 0
+	for x := T(1); x != 0; x <<= 1 {
+		width++
+	}
+	if T(0)-1 > 0 {
+		return func(x T) uint64 { return uint64(x) }, width / 8
+	}
+	sign := uint64(1) << uint(width-1)
+	mask := sign<<1 - 1
+	return func(x T) uint64 { return uint64(x)&mask ^ sign }, width / 8
+}
+
+// RadixLSD sorts s in place with a least significant byte first radix sort
+// and returns it.
+func RadixLSD[T constraints.Integer](s []T) []T {
+	if len(s) < 2 {
+		return s
+	}
+	key, width := radixKey[T]()
+	buf := make([]T, len(s))
+	src, dst := s, buf
+	for b := 0; b < width; b++ {
+		shift := uint(8 * b)
+		var count [257]int
+		for _, x := range src {
+			count[key(x)>>shift&0xff+1]++
+		}
+		// Every element has the same byte here, the pass would not move anything.
+		if count[key(src[0])>>shift&0xff+1] == len(src) {
+			continue
+		}
+		for i := 1; i < 257; i++ {
+			count[i] += count[i-1]
+		}
+		for _, x := range src {
+			d := key(x) >> shift & 0xff
+			dst[count[d]] = x
+			count[d]++
+		}
+		src, dst = dst, src
+	}
+	if &src[0] != &s[0] {
+		copy(s, src)
+	}
+	return s
+}
+
+// RadixMSD sorts s in place with a most significant byte first radix sort
+// and returns it.
+func RadixMSD[T constraints.Integer](s []T) []T {
+	key, width := radixKey[T]()
+	buf := make([]T, len(s))
+	radixMSD(s, buf, key, uint(8*(width-1)))
+	return s
+}
+
+func radixMSD[T constraints.Integer](s, buf []T, key func(T) uint64, shift uint) {
+	if len(s) <= radixInsertionThreshold {
+		insertionSortFunc(s, 0, len(s), func(a, b T) bool { return a < b }, nil)
+		return
+	}
+	var count [257]int
+	for _, x := range s {
+		count[key(x)>>shift&0xff+1]++
+	}
+	for i := 1; i < 257; i++ {
+		count[i] += count[i-1]
+	}
+	start := count
+	for _, x := range s {
+		d := key(x) >> shift & 0xff
+		buf[count[d]] = x
+		count[d]++
+	}
+	copy(s, buf)
+	if shift == 0 {
+		return
+	}
+	for d := 0; d < 256; d++ {
+		if lo, hi := start[d], start[d+1]; hi-lo > 1 {
+			radixMSD(s[lo:hi], buf[lo:hi], key, shift-8)
+		}
+	}
+}
+
+// RadixStringsLSD sorts s in place with a least significant byte first radix
+// sort and returns it. Every pass looks at one position, from the length of
+// the longest string down to the first byte, so it suits strings of similar
+// lengths.
+func RadixStringsLSD(s []string) []string {
+	maxLen := 0
+	for _, str := range s {
+		if len(str) > maxLen {
+			maxLen = len(str)
+		}
+	}
+	buf := make([]string, len(s))
+	src, dst := s, buf
+	for pos := maxLen - 1; pos >= 0; pos-- {
+		var count [258]int
+		for _, str := range src {
+			count[stringDigit(str, pos)+1]++
+		}
+		for i := 1; i < 258; i++ {
+			count[i] += count[i-1]
+		}
+		for _, str := range src {
+			d := stringDigit(str, pos)
+			dst[count[d]] = str
+			count[d]++
+		}
+		src, dst = dst, src
+	}
+	if len(s) > 0 && &src[0] != &s[0] {
+		copy(s, src)
+	}
+	return s
+}
+
+// RadixStringsMSD sorts s in place with a most significant byte first radix
+// sort and returns it. It only looks at the bytes needed to tell the strings
+// apart.
+func RadixStringsMSD(s []string) []string {
+	buf := make([]string, len(s))
+	radixStringsMSD(s, buf, 0)
+	return s
+}
+
+// stringDigit returns 0 past the end of str and the byte at pos plus one
+// otherwise.
+func stringDigit(str string, pos int) int {
+	if pos >= len(str) {
+		return 0
+	}
+	return int(str[pos]) + 1
+}
+
+func radixStringsMSD(s, buf []string, pos int) {
+	if len(s) <= radixInsertionThreshold {
+		insertionSortFunc(s, 0, len(s), func(a, b string) bool { return a[pos:] < b[pos:] }, nil)
+		return
+	}
+	var count [258]int
+	for _, str := range s {
+		count[stringDigit(str, pos)+1]++
+	}
+	for i := 1; i < 258; i++ {
+		count[i] += count[i-1]
+	}
+	start := count
+	for _, str := range s {
+		d := stringDigit(str, pos)
+		buf[count[d]] = str
+		count[d]++
+	}
+	copy(s, buf)
+	// Bucket 0 holds strings that ended and are all equal.
+	for d := 1; d < 257; d++ {
+		if lo, hi := start[d], start[d+1]; hi-lo > 1 {
+			radixStringsMSD(s[lo:hi], buf[lo:hi], pos+1)
+		}
+	}
+}
+
+// CountFunc sorts s in place by the integer key of every element with a
+// stable counting sort and returns it. It takes O(n + k) time and memory,
+// where k is the difference between the largest and the smallest key.
+func CountFunc[T any](s []T, key func(T) int) []T {
+	if len(s) == 0 {
+		return s
+	}
+	keys := make([]int, len(s))
+	lo, hi := key(s[0]), key(s[0])
+	for i, x := range s {
+		keys[i] = key(x)
+		if keys[i] < lo {
+			lo = keys[i]
+		}
+		if keys[i] > hi {
+			hi = keys[i]
+		}
+	}
+	count := make([]int, hi-lo+2)
+	for _, k := range keys {
+		count[k-lo+1]++
+	}
+	for i := 1; i < len(count); i++ {
+		count[i] += count[i-1]
+	}
+	out := make([]T, len(s))
+	for i, x := range s {
+		out[count[keys[i]-lo]] = x
+		count[keys[i]-lo]++
+	}
+	copy(s, out)
+	return s
+}
@@ -3,15 +3,20 @@ package sort
 import "github.com/TheAlgorithms/Go/constraints"
 
 func Selection[T constraints.Ordered](arr []T) []T {
+	SelectionFunc(arr, orderedLess[T])
+	return arr
+}
+
+// SelectionFunc sorts arr in place according to less using selection sort.
+func SelectionFunc[T any](arr []T, less func(a, b T) bool) {
 	for i := 0; i < len(arr); i++ {
 		min := i
 		for j := i + 1; j < len(arr); j++ {
-			if arr[j] < arr[min] {
+			if less(arr[j], arr[min]) {
 				min = j
 			}
 		}
 
 		arr[i], arr[min] = arr[min], arr[i]
 	}
-	return arr
 }
@@ -3,12 +3,17 @@ package sort
 import "github.com/TheAlgorithms/Go/constraints"
 
 func Shell[T constraints.Ordered](arr []T) []T {
+	ShellFunc(arr, orderedLess[T])
+	return arr
+}
+
+// ShellFunc sorts arr in place according to less using Shell sort.
+func ShellFunc[T any](arr []T, less func(a, b T) bool) {
 	for d := int(len(arr) / 2); d > 0; d /= 2 {
 		for i := d; i < len(arr); i++ {
-			for j := i; j >= d && arr[j-d] > arr[j]; j -= d {
+			for j := i; j >= d && less(arr[j], arr[j-d]); j -= d {
 				arr[j], arr[j-d] = arr[j-d], arr[j]
 			}
 		}
 	}
-	return arr
 }
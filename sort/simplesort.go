@@ -14,27 +14,37 @@ package sort
 import "github.com/TheAlgorithms/Go/constraints"
 
 func Simple[T constraints.Ordered](arr []T) []T {
+	SimpleFunc(arr, orderedLess[T])
+	return arr
+}
+
+// SimpleFunc sorts arr in place according to less using the simple sort.
+func SimpleFunc[T any](arr []T, less func(a, b T) bool) {
 	for i := 0; i < len(arr); i++ {
 		for j := 0; j < len(arr); j++ {
-			if arr[i] < arr[j] {
+			if less(arr[i], arr[j]) {
 				// swap arr[i] and arr[j]
 				arr[i], arr[j] = arr[j], arr[i]
 			}
 		}
 	}
-	return arr
 }
 
 // ImprovedSimple is a improve SimpleSort by skipping an unnecessary comparison of the first and last.
 // This improved version is more similar to implementation of insertion sort
 func ImprovedSimple[T constraints.Ordered](arr []T) []T {
+	ImprovedSimpleFunc(arr, orderedLess[T])
+	return arr
+}
+
+// ImprovedSimpleFunc sorts arr in place according to less using the improved simple sort.
+func ImprovedSimpleFunc[T any](arr []T, less func(a, b T) bool) {
 	for i := 1; i < len(arr); i++ {
 		for j := 0; j < len(arr)-1; j++ {
-			if arr[i] < arr[j] {
+			if less(arr[i], arr[j]) {
 				// swap arr[i] and arr[j]
 				arr[i], arr[j] = arr[j], arr[i]
 			}
 		}
 	}
-	return arr
 }
@@ -0,0 +1,140 @@
+// sortfunc.go
+// description: Common interface of the comparison sorts working on any type
+// details:
+// The sorts in this file family take a less function instead of requiring an
+// ordered element type, sort in place and do not allocate:
+// Pdq (pattern-defeating quicksort), Intro (introsort), BlockMergeSort and
+// SymMergeSort (both stable).
+// Sort and Stable pick the recommended one for unstable and stable sorting.
+// Each of them has a variant of type SwapFunc that also reports every
+// exchange of two elements to a hook, so that the exchanges can be counted
+// or replayed.
+// The classic comparison sorts of this package have a Func variant too,
+// BubbleFunc for Bubble and so on, which the versions for ordered element
+// types call with <. Some of those allocate, like MergeFunc, TimsortFunc
+// and PatienceFunc.
+// see pdqsort.go, introsort.go, blockmergesort.go, symmergesort.go, sorts_test.go
+
+// Copyright 2009 The Go Authors. All rights reserved.
+// The insertion sort and heapsort helpers are adapted from
+// src/sort/zsortfunc.go in the Go standard library. Use of this source code
+// is governed by a BSD-style license that can be found in the LICENSE_GO
+// file.
+
+package sort
+
+import "github.com/TheAlgorithms/Go/constraints"
+
+// Func is the signature shared by the in-place comparison sorts that accept
+// any element type. less must be a strict weak ordering.
+type Func[T any] func(s []T, less func(a, b T) bool)
+
+// Verify that every implementation shares the common signature.
+var (
+	_ Func[int] = Sort[int]
+	_ Func[int] = Stable[int]
+	_ Func[int] = Pdq[int]
+	_ Func[int] = Intro[int]
+	_ Func[int] = BlockMergeSort[int]
+	_ Func[int] = SymMergeSort[int]
+
+	_ Func[int] = BinaryInsertionFunc[int]
+	_ Func[int] = BubbleFunc[int]
+	_ Func[int] = CircleFunc[int]
+	_ Func[int] = CocktailFunc[int]
+	_ Func[int] = CombFunc[int]
+	_ Func[int] = CycleFunc[int]
+	_ Func[int] = ExchangeFunc[int]
+	_ Func[int] = HeapSortFunc[int]
+	_ Func[int] = InsertionFunc[int]
+	_ Func[int] = MergeFunc[int]
+	_ Func[int] = MergeIterFunc[int]
+	_ Func[int] = OddEvenSortFunc[int]
+	_ Func[int] = PancakeFunc[int]
+	_ Func[int] = PatienceFunc[int]
+	_ Func[int] = QuicksortFunc[int]
+	_ Func[int] = SelectionFunc[int]
+	_ Func[int] = ShellFunc[int]
+	_ Func[int] = SimpleFunc[int]
+	_ Func[int] = ImprovedSimpleFunc[int]
+	_ Func[int] = TimsortFunc[int]
+)
+
+// SwapFunc is the signature of the variants of the sorts of type Func that
+// call swap(i, j), if it is not nil, after every exchange of s[i] and s[j].
+type SwapFunc[T any] func(s []T, less func(a, b T) bool, swap func(i, j int))
+
+// Verify that every variant shares the common signature.
+var (
+	_ SwapFunc[int] = PdqWithSwap[int]
+	_ SwapFunc[int] = IntroWithSwap[int]
+	_ SwapFunc[int] = BlockMergeSortWithSwap[int]
+	_ SwapFunc[int] = SymMergeSortWithSwap[int]
+)
+
+// Sort sorts s in place in increasing order according to less. It is not
+// stable. It uses pattern-defeating quicksort, see Pdq.
+func Sort[T any](s []T, less func(a, b T) bool) {
+	Pdq(s, less)
+}
+
+// Stable sorts s in place in increasing order according to less, keeping
+// equal elements in their original order. It uses BlockMergeSort and
+// therefore needs no extra memory.
+func Stable[T any](s []T, less func(a, b T) bool) {
+	BlockMergeSort(s, less)
+}
+
+// orderedLess is the less function the sorts of ordered element types pass
+// to their Func variant.
+func orderedLess[T constraints.Ordered](a, b T) bool {
+	return a < b
+}
+
+// swapAt exchanges s[i] and s[j] and tells swap, if it is not nil.
+func swapAt[T any](s []T, i, j int, swap func(i, j int)) {
+	s[i], s[j] = s[j], s[i]
+	if swap != nil {
+		swap(i, j)
+	}
+}
+
+// insertionSortFunc sorts s[a:b] by insertion.
+func insertionSortFunc[T any](s []T, a, b int, less func(a, b T) bool, swap func(i, j int)) {
+	for i := a + 1; i < b; i++ {
+		for j := i; j > a && less(s[j], s[j-1]); j-- {
+			swapAt(s, j, j-1, swap)
+		}
+	}
+}
+
+// heapSortFunc sorts s[a:b] with a max-heap.
+func heapSortFunc[T any](s []T, a, b int, less func(a, b T) bool, swap func(i, j int)) {
+	first, hi := a, b-a
+	for i := (hi - 1) / 2; i >= 0; i-- {
+		siftDownFunc(s, i, hi, first, less, swap)
+	}
+	for i := hi - 1; i >= 0; i-- {
+		swapAt(s, first, first+i, swap)
+		siftDownFunc(s, 0, i, first, less, swap)
+	}
+}
+
+// siftDownFunc restores the max-heap s[first+lo:first+hi] below lo.
+func siftDownFunc[T any](s []T, lo, hi, first int, less func(a, b T) bool, swap func(i, j int)) {
+	root := lo
+	for {
+		child := 2*root + 1
+		if child >= hi {
+			return
+		}
+		if child+1 < hi && less(s[first+child], s[first+child+1]) {
+			child++
+		}
+		if !less(s[first+root], s[first+child]) {
+			return
+		}
+		swapAt(s, first+root, first+child, swap)
+		root = child
+	}
+}
@@ -1,8 +1,12 @@
 package sort_test
 
 import (
+	"bytes"
+	"encoding/binary"
+	"io"
 	"math/rand"
 	"reflect"
+	stdsort "sort"
 	"testing"
 	"time"
 
@@ -134,6 +138,311 @@ func TestMergeParallel(t *testing.T) {
 	})
 }
 
+func TestParallelMergeFunc(t *testing.T) {
+	testFramework(t, func(items []int) []int {
+		sort.ParallelMergeFunc(items, func(a, b int) bool { return a < b }, 4)
+		return items
+	})
+
+	t.Run("ParallelMergeFunc is stable", func(t *testing.T) {
+		type pair struct{ key, position int }
+		rnd := rand.New(rand.NewSource(1))
+		items := make([]pair, 50000)
+		for i := range items {
+			items[i] = pair{rnd.Intn(100), i}
+		}
+		sort.ParallelMergeFunc(items, func(a, b pair) bool { return a.key < b.key }, 1000)
+		for i := 1; i < len(items); i++ {
+			prev, curr := items[i-1], items[i]
+			if prev.key > curr.key || (prev.key == curr.key && prev.position > curr.position) {
+				t.Fatalf("ParallelMergeFunc is not stable at %d: %v, %v", i, prev, curr)
+			}
+		}
+	})
+}
+
+func encodeInt64(w io.Writer, v int64) error {
+	return binary.Write(w, binary.LittleEndian, v)
+}
+
+func decodeInt64(r io.Reader) (int64, error) {
+	var v int64
+	err := binary.Read(r, binary.LittleEndian, &v)
+	return v, err
+}
+
+func TestExternalSorter(t *testing.T) {
+	rnd := rand.New(rand.NewSource(7))
+	for _, size := range []int{0, 1, 99, 100, 101, 2500} {
+		var input bytes.Buffer
+		values := make([]int64, size)
+		for i := range values {
+			values[i] = rnd.Int63n(1000) - 500
+			if err := encodeInt64(&input, values[i]); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		sorter := sort.ExternalSorter[int64]{
+			Less:    func(a, b int64) bool { return a < b },
+			Encode:  encodeInt64,
+			Decode:  decodeInt64,
+			RunSize: 100,
+			TempDir: t.TempDir(),
+		}
+		var output bytes.Buffer
+		if err := sorter.Sort(&input, &output); err != nil {
+			t.Fatalf("Sort of %d records failed: %v", size, err)
+		}
+
+		stdsort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+		for i, want := range values {
+			got, err := decodeInt64(&output)
+			if err != nil || got != want {
+				t.Fatalf("record %d of %d = %d, %v, want %d", i, size, got, err, want)
+			}
+		}
+		if output.Len() != 0 {
+			t.Errorf("Sort of %d records wrote %d extra bytes", size, output.Len())
+		}
+	}
+
+	t.Run("ExternalSorter rejects bad configuration", func(t *testing.T) {
+		sorter := sort.ExternalSorter[int64]{Encode: encodeInt64, Decode: decodeInt64, RunSize: 10}
+		if err := sorter.Sort(&bytes.Buffer{}, io.Discard); err == nil {
+			t.Errorf("Sort without Less should fail")
+		}
+	})
+}
+
+// funcSorts lists the sorts that share the sort.Func signature.
+var funcSorts = map[string]sort.Func[int]{
+	"Sort":           sort.Sort[int],
+	"Stable":         sort.Stable[int],
+	"Pdq":            sort.Pdq[int],
+	"Intro":          sort.Intro[int],
+	"BlockMergeSort": sort.BlockMergeSort[int],
+	"SymMergeSort":   sort.SymMergeSort[int],
+}
+
+// classicFuncSorts lists the Func variants of the classic sorts, most of
+// them quadratic, which only get short inputs.
+var classicFuncSorts = map[string]sort.Func[int]{
+	"BinaryInsertionFunc": sort.BinaryInsertionFunc[int],
+	"BubbleFunc":          sort.BubbleFunc[int],
+	"CircleFunc":          sort.CircleFunc[int],
+	"CocktailFunc":        sort.CocktailFunc[int],
+	"CombFunc":            sort.CombFunc[int],
+	"CycleFunc":           sort.CycleFunc[int],
+	"ExchangeFunc":        sort.ExchangeFunc[int],
+	"HeapSortFunc":        sort.HeapSortFunc[int],
+	"InsertionFunc":       sort.InsertionFunc[int],
+	"MergeFunc":           sort.MergeFunc[int],
+	"MergeIterFunc":       sort.MergeIterFunc[int],
+	"OddEvenSortFunc":     sort.OddEvenSortFunc[int],
+	"PancakeFunc":         sort.PancakeFunc[int],
+	"PatienceFunc":        sort.PatienceFunc[int],
+	"QuicksortFunc":       sort.QuicksortFunc[int],
+	"SelectionFunc":       sort.SelectionFunc[int],
+	"ShellFunc":           sort.ShellFunc[int],
+	"SimpleFunc":          sort.SimpleFunc[int],
+	"ImprovedSimpleFunc":  sort.ImprovedSimpleFunc[int],
+	"TimsortFunc":         sort.TimsortFunc[int],
+}
+
+func lessInt(a, b int) bool { return a < b }
+
+func TestClassicFuncSorts(t *testing.T) {
+	greater := func(a, b int) bool { return a > b }
+	rnd := rand.New(rand.NewSource(6))
+	for name, f := range classicFuncSorts {
+		f := f
+		t.Run(name, func(t *testing.T) {
+			testFramework(t, func(items []int) []int {
+				f(items, lessInt)
+				return items
+			})
+			// a less function other than < sorts the other way
+			items := make([]int, 300)
+			for i := range items {
+				items[i] = rnd.Intn(50)
+			}
+			f(items, greater)
+			if !stdsort.SliceIsSorted(items, func(i, j int) bool { return greater(items[i], items[j]) }) {
+				t.Errorf("%s did not sort in decreasing order", name)
+			}
+		})
+	}
+}
+
+func TestFuncSorts(t *testing.T) {
+	for name, f := range funcSorts {
+		f := f
+		t.Run(name, func(t *testing.T) {
+			testFramework(t, func(items []int) []int {
+				f(items, lessInt)
+				return items
+			})
+		})
+	}
+
+	// Inputs that trigger the special paths: sorted, reversed, few distinct
+	// values, organ pipe and long random ranges.
+	rnd := rand.New(rand.NewSource(3))
+	inputs := map[string]func(i, n int) int{
+		"sorted":   func(i, n int) int { return i },
+		"reversed": func(i, n int) int { return n - i },
+		"few":      func(i, n int) int { return rnd.Intn(4) },
+		"pipe": func(i, n int) int {
+			if i < n/2 {
+				return i
+			}
+			return n - i
+		},
+		"random": func(i, n int) int { return rnd.Int() },
+	}
+	for inputName, gen := range inputs {
+		for _, n := range []int{5, 49, 50, 1000, 20000} {
+			input := make([]int, n)
+			for i := range input {
+				input[i] = gen(i, n)
+			}
+			expected := append([]int(nil), input...)
+			stdsort.Ints(expected)
+			for name, f := range funcSorts {
+				actual := append([]int(nil), input...)
+				f(actual, lessInt)
+				if !reflect.DeepEqual(actual, expected) {
+					t.Errorf("%s failed on %s input of length %d", name, inputName, n)
+				}
+			}
+		}
+	}
+}
+
+// TestSwapFuncSorts replays the exchanges reported by the sorts on a copy of
+// their input, which must end up sorted like the input itself.
+func TestSwapFuncSorts(t *testing.T) {
+	sorts := map[string]sort.SwapFunc[int]{
+		"PdqWithSwap":            sort.PdqWithSwap[int],
+		"IntroWithSwap":          sort.IntroWithSwap[int],
+		"BlockMergeSortWithSwap": sort.BlockMergeSortWithSwap[int],
+		"SymMergeSortWithSwap":   sort.SymMergeSortWithSwap[int],
+	}
+	rnd := rand.New(rand.NewSource(4))
+	for _, n := range []int{0, 5, 49, 1000, 5000} {
+		input := rnd.Perm(n)
+		for name, f := range sorts {
+			actual := append([]int(nil), input...)
+			replay := append([]int(nil), input...)
+			f(actual, lessInt, func(i, j int) { replay[i], replay[j] = replay[j], replay[i] })
+			if !stdsort.IntsAreSorted(actual) || !reflect.DeepEqual(replay, actual) {
+				t.Errorf("%s on %d elements: the replayed exchanges do not sort", name, n)
+			}
+			// a nil hook is allowed, and only introsort moves its pivots
+			// in sorted input
+			f(actual, lessInt, nil)
+			if name != "IntroWithSwap" {
+				f(actual, lessInt, func(i, j int) { t.Fatalf("%s exchanged %d and %d in sorted input", name, i, j) })
+			}
+		}
+	}
+}
+
+// TestStableFuncSorts checks the stable sorts on inputs with from one to n
+// distinct keys, which take the different merge paths of BlockMergeSort.
+func TestStableFuncSorts(t *testing.T) {
+	type item struct{ key, position int }
+	less := func(a, b item) bool { return a.key < b.key }
+	sorts := map[string]sort.Func[item]{"BlockMergeSort": sort.BlockMergeSort[item], "SymMergeSort": sort.SymMergeSort[item]}
+	rnd := rand.New(rand.NewSource(5))
+	for _, n := range []int{17, 100, 1000, 20000} {
+		for _, distinct := range []int{1, 2, 3, 5, 17, 60, 150, 400, n} {
+			items := make([]item, n)
+			for i := range items {
+				items[i] = item{rnd.Intn(distinct), i}
+			}
+			expected := append([]item(nil), items...)
+			stdsort.SliceStable(expected, func(i, j int) bool { return less(expected[i], expected[j]) })
+			for name, f := range sorts {
+				actual := append([]item(nil), items...)
+				f(actual, less)
+				if !reflect.DeepEqual(actual, expected) {
+					t.Errorf("%s is not a stable sort of %d elements with %d distinct keys", name, n, distinct)
+				}
+			}
+		}
+	}
+}
+
+// FuzzFuncSorts compares the sort.Func implementations with the standard
+// library, checking the stable ones for stability.
+func FuzzFuncSorts(f *testing.F) {
+	f.Add([]byte{})
+	f.Add([]byte{3, 1, 2})
+	f.Add([]byte("the quick brown fox jumps over the lazy dog, again and again and again"))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		type item struct {
+			key      byte
+			position int
+		}
+		items := make([]item, len(data))
+		for i, b := range data {
+			items[i] = item{b % 16, i}
+		}
+		less := func(a, b item) bool { return a.key < b.key }
+		expected := append([]item(nil), items...)
+		stdsort.SliceStable(expected, func(i, j int) bool { return less(expected[i], expected[j]) })
+
+		stable := map[string]sort.Func[item]{
+			"Stable":              sort.Stable[item],
+			"BlockMergeSort":      sort.BlockMergeSort[item],
+			"SymMergeSort":        sort.SymMergeSort[item],
+			"BinaryInsertionFunc": sort.BinaryInsertionFunc[item],
+			"BubbleFunc":          sort.BubbleFunc[item],
+			"CocktailFunc":        sort.CocktailFunc[item],
+			"InsertionFunc":       sort.InsertionFunc[item],
+			"MergeFunc":           sort.MergeFunc[item],
+			"MergeIterFunc":       sort.MergeIterFunc[item],
+			"OddEvenSortFunc":     sort.OddEvenSortFunc[item],
+			"TimsortFunc":         sort.TimsortFunc[item],
+		}
+		unstable := map[string]sort.Func[item]{
+			"Sort":               sort.Sort[item],
+			"Pdq":                sort.Pdq[item],
+			"Intro":              sort.Intro[item],
+			"CircleFunc":         sort.CircleFunc[item],
+			"CombFunc":           sort.CombFunc[item],
+			"CycleFunc":          sort.CycleFunc[item],
+			"ExchangeFunc":       sort.ExchangeFunc[item],
+			"HeapSortFunc":       sort.HeapSortFunc[item],
+			"PancakeFunc":        sort.PancakeFunc[item],
+			"PatienceFunc":       sort.PatienceFunc[item],
+			"QuicksortFunc":      sort.QuicksortFunc[item],
+			"SelectionFunc":      sort.SelectionFunc[item],
+			"ShellFunc":          sort.ShellFunc[item],
+			"SimpleFunc":         sort.SimpleFunc[item],
+			"ImprovedSimpleFunc": sort.ImprovedSimpleFunc[item],
+		}
+		for name, sortFunc := range stable {
+			actual := append([]item(nil), items...)
+			sortFunc(actual, less)
+			if !reflect.DeepEqual(actual, expected) {
+				t.Errorf("%s is not a stable sort of %v", name, data)
+			}
+		}
+		for name, sortFunc := range unstable {
+			actual := append([]item(nil), items...)
+			sortFunc(actual, less)
+			for i := range actual {
+				if actual[i].key != expected[i].key {
+					t.Fatalf("%s did not sort %v", name, data)
+				}
+			}
+		}
+	})
+}
+
 func TestHeap(t *testing.T) {
 	testFramework(t, sort.HeapSort[int])
 }
@@ -154,6 +463,88 @@ func TestRadix(t *testing.T) {
 	testFramework(t, sort.RadixSort[int])
 }
 
+func TestRadixLSD(t *testing.T) {
+	testFramework(t, sort.RadixLSD[int])
+}
+
+func TestRadixMSD(t *testing.T) {
+	testFramework(t, sort.RadixMSD[int])
+}
+
+func TestRadixIntegerTypes(t *testing.T) {
+	rnd := rand.New(rand.NewSource(3))
+	i8 := make([]int8, 500)
+	u16 := make([]uint16, 500)
+	i64 := make([]int64, 500)
+	for i := range i8 {
+		i8[i] = int8(rnd.Intn(256) - 128)
+		u16[i] = uint16(rnd.Intn(1 << 16))
+		i64[i] = rnd.Int63() - rnd.Int63()
+	}
+	i64[0], i64[1] = -1<<63, 1<<63-1
+
+	for name, f := range map[string]func([]int8) []int8{"LSD": sort.RadixLSD[int8], "MSD": sort.RadixMSD[int8]} {
+		got := f(append([]int8(nil), i8...))
+		if !stdsort.SliceIsSorted(got, func(i, j int) bool { return got[i] < got[j] }) {
+			t.Errorf("%s int8: %v is not sorted", name, got)
+		}
+	}
+	for name, f := range map[string]func([]uint16) []uint16{"LSD": sort.RadixLSD[uint16], "MSD": sort.RadixMSD[uint16]} {
+		got := f(append([]uint16(nil), u16...))
+		if !stdsort.SliceIsSorted(got, func(i, j int) bool { return got[i] < got[j] }) {
+			t.Errorf("%s uint16: %v is not sorted", name, got)
+		}
+	}
+	for name, f := range map[string]func([]int64) []int64{"LSD": sort.RadixLSD[int64], "MSD": sort.RadixMSD[int64]} {
+		got := f(append([]int64(nil), i64...))
+		if !stdsort.SliceIsSorted(got, func(i, j int) bool { return got[i] < got[j] }) {
+			t.Errorf("%s int64: slice is not sorted", name)
+		}
+	}
+}
+
+func TestRadixStrings(t *testing.T) {
+	rnd := rand.New(rand.NewSource(4))
+	input := []string{"", "b", "ab", "a", "", "abc", "ba", "a\x00", "\xff", "abd"}
+	for i := 0; i < 1000; i++ {
+		b := make([]byte, rnd.Intn(6))
+		for j := range b {
+			b[j] = "abc\x00\xff"[rnd.Intn(5)]
+		}
+		input = append(input, string(b))
+	}
+	want := append([]string(nil), input...)
+	stdsort.Strings(want)
+
+	for name, f := range map[string]func([]string) []string{
+		"LSD": sort.RadixStringsLSD,
+		"MSD": sort.RadixStringsMSD,
+	} {
+		if got := f(append([]string(nil), input...)); !reflect.DeepEqual(got, want) {
+			t.Errorf("RadixStrings%s did not sort the input", name)
+		}
+		if got := f(nil); len(got) != 0 {
+			t.Errorf("RadixStrings%s(nil) = %v", name, got)
+		}
+	}
+}
+
+func TestCountFunc(t *testing.T) {
+	type person struct {
+		name string
+		age  int
+	}
+	people := []person{{"ann", 30}, {"bob", -2}, {"cid", 30}, {"dan", 7}, {"eve", -2}, {"fay", 7}}
+	want := []person{{"bob", -2}, {"eve", -2}, {"dan", 7}, {"fay", 7}, {"ann", 30}, {"cid", 30}}
+	got := sort.CountFunc(people, func(p person) int { return p.age })
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("CountFunc() = %v, want %v", got, want)
+	}
+	if got := sort.CountFunc([]person{}, func(p person) int { return p.age }); len(got) != 0 {
+		t.Errorf("CountFunc() of an empty slice = %v", got)
+	}
+}
+
 func TestSimple(t *testing.T) {
 	testFramework(t, sort.Simple[int])
 }
@@ -188,6 +579,12 @@ func TestCycle(t *testing.T) {
 
 func TestTimsort(t *testing.T) {
 	testFramework(t, sort.Timsort[int])
+
+	// the last run is left alone when it has no partner
+	input := rand.New(rand.NewSource(7)).Perm(33)
+	if got := sort.Timsort(input); !stdsort.IntsAreSorted(got) {
+		t.Errorf("Timsort of 33 elements = %v", got)
+	}
 }
 
 func TestCircle(t *testing.T) {
@@ -280,6 +677,90 @@ func BenchmarkMergeParallel(b *testing.B) {
 	benchmarkFramework(b, sort.ParallelMerge[int])
 }
 
+func BenchmarkMergeParallelFunc(b *testing.B) {
+	benchmarkFramework(b, func(items []int) []int {
+		sort.ParallelMergeFunc(items, func(a, b int) bool { return a < b }, 0)
+		return items
+	})
+}
+
+// benchmarkLarge sorts copies of a random slice of 10M elements.
+func benchmarkLarge(b *testing.B, f func(items []int)) {
+	rnd := rand.New(rand.NewSource(1))
+	input := make([]int, 10_000_000)
+	for i := range input {
+		input[i] = rnd.Int()
+	}
+	items := make([]int, len(input))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		copy(items, input)
+		b.StartTimer()
+		f(items)
+	}
+}
+
+func BenchmarkLargeMergeParallelFunc(b *testing.B) {
+	benchmarkLarge(b, func(items []int) {
+		sort.ParallelMergeFunc(items, func(a, b int) bool { return a < b }, 0)
+	})
+}
+
+func BenchmarkLargeStdSortSlice(b *testing.B) {
+	benchmarkLarge(b, func(items []int) {
+		stdsort.Slice(items, func(i, j int) bool { return items[i] < items[j] })
+	})
+}
+
+func BenchmarkLargePdq(b *testing.B) {
+	benchmarkLarge(b, func(items []int) { sort.Pdq(items, lessInt) })
+}
+
+func BenchmarkLargeIntro(b *testing.B) {
+	benchmarkLarge(b, func(items []int) { sort.Intro(items, lessInt) })
+}
+
+func BenchmarkLargeBlockMergeSort(b *testing.B) {
+	benchmarkLarge(b, func(items []int) { sort.BlockMergeSort(items, lessInt) })
+}
+
+func BenchmarkLargeSymMergeSort(b *testing.B) {
+	benchmarkLarge(b, func(items []int) { sort.SymMergeSort(items, lessInt) })
+}
+
+func BenchmarkLargeRadixLSD(b *testing.B) {
+	benchmarkLarge(b, func(items []int) { sort.RadixLSD(items) })
+}
+
+func BenchmarkLargeRadixMSD(b *testing.B) {
+	benchmarkLarge(b, func(items []int) { sort.RadixMSD(items) })
+}
+
+func BenchmarkLargeCountFunc(b *testing.B) {
+	benchmarkLarge(b, func(items []int) {
+		sort.CountFunc(items, func(x int) int { return x & 0xffff })
+	})
+}
+
+func BenchmarkRadixStringsMSD(b *testing.B) {
+	rnd := rand.New(rand.NewSource(1))
+	input := make([]string, 1_000_000)
+	for i := range input {
+		buf := make([]byte, 8+rnd.Intn(8))
+		rnd.Read(buf)
+		input[i] = string(buf)
+	}
+	items := make([]string, len(input))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		copy(items, input)
+		b.StartTimer()
+		sort.RadixStringsMSD(items)
+	}
+}
+
 func BenchmarkHeap(b *testing.B) {
 	benchmarkFramework(b, sort.HeapSort[int])
 }
@@ -300,6 +781,10 @@ func BenchmarkRadix(b *testing.B) {
 	benchmarkFramework(b, sort.RadixSort[int])
 }
 
+func BenchmarkRadixLSD(b *testing.B) {
+	benchmarkFramework(b, sort.RadixLSD[int])
+}
+
 func BenchmarkSimple(b *testing.B) {
 	benchmarkFramework(b, sort.Simple[int])
 }
@@ -0,0 +1,132 @@
+// symmergesort.go
+// description: Stable in-place merge sort with SymMerge
+// details:
+// The slice is cut into runs of symMergeRunSize elements which are sorted by
+// insertion sort. Neighbouring runs are then merged pairwise, doubling the
+// run length every pass. Merging is done in place with SymMerge (Kim and
+// Kutzner): the longer run is split at its middle, the matching split point
+// of the other run is found by binary search, the two middle pieces are
+// swapped with a rotation and both halves are merged recursively. Nothing is
+// ever copied to a buffer, so only O(log n) stack space is used, and
+// elements only move past unequal ones, which keeps the sort stable. Unlike
+// BlockMergeSort, it does not move blocks through an internal buffer, which
+// is what brings that one down to O(n log n) moves.
+// worst-case time complexity: O(n log² n) moves, O(n log n) comparisons
+// space complexity: O(1) heap memory, O(log n) stack
+// reference: https://doi.org/10.1007/978-3-540-30140-0_63
+// see sortfunc.go
+
+// Copyright 2009 The Go Authors. All rights reserved.
+// Adapted from the stable sort and symMerge of src/sort/zsortfunc.go in the
+// Go standard library. Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE_GO file.
+
+package sort
+
+// symMergeRunSize is the length of the runs sorted by insertion sort.
+const symMergeRunSize = 20
+
+// SymMergeSort sorts s in place according to less. It is stable and does
+// not allocate.
+func SymMergeSort[T any](s []T, less func(a, b T) bool) {
+	SymMergeSortWithSwap(s, less, nil)
+}
+
+// SymMergeSortWithSwap is SymMergeSort calling swap(i, j), if it is not nil,
+// after every exchange of s[i] and s[j]. It moves elements only by exchanges.
+func SymMergeSortWithSwap[T any](s []T, less func(a, b T) bool, swap func(i, j int)) {
+	n := len(s)
+	a, b := 0, symMergeRunSize
+	for b <= n {
+		insertionSortFunc(s, a, b, less, swap)
+		a, b = b, b+symMergeRunSize
+	}
+	insertionSortFunc(s, a, n, less, swap)
+
+	for runSize := symMergeRunSize; runSize < n; runSize *= 2 {
+		a, b = 0, 2*runSize
+		for b <= n {
+			symMerge(s, a, a+runSize, b, less, swap)
+			a, b = b, b+2*runSize
+		}
+		if m := a + runSize; m < n {
+			symMerge(s, a, m, n, less, swap)
+		}
+	}
+}
+
+// symMerge merges the sorted runs s[a:m] and s[m:b] in place.
+func symMerge[T any](s []T, a, m, b int, less func(a, b T) bool, swap func(i, j int)) {
+	// A single element is inserted with a binary search and a rotation.
+	if m-a == 1 {
+		i, j := m, b
+		for i < j {
+			h := int(uint(i+j) >> 1)
+			if less(s[h], s[a]) {
+				i = h + 1
+			} else {
+				j = h
+			}
+		}
+		for k := a; k < i-1; k++ {
+			swapAt(s, k, k+1, swap)
+		}
+		return
+	}
+	if b-m == 1 {
+		i, j := a, m
+		for i < j {
+			h := int(uint(i+j) >> 1)
+			if !less(s[m], s[h]) {
+				i = h + 1
+			} else {
+				j = h
+			}
+		}
+		for k := m; k > i; k-- {
+			swapAt(s, k, k-1, swap)
+		}
+		return
+	}
+
+	mid := int(uint(a+b) >> 1)
+	n := mid + m
+	var start, r int
+	if m > mid {
+		start = n - b
+		r = mid
+	} else {
+		start = a
+		r = m
+	}
+	p := n - 1
+	for start < r {
+		c := int(uint(start+r) >> 1)
+		if !less(s[p-c], s[c]) {
+			start = c + 1
+		} else {
+			r = c
+		}
+	}
+
+	end := n - start
+	if start < m && m < end {
+		rotate(s, start, m, end, swap)
+	}
+	if a < start && start < mid {
+		symMerge(s, a, start, mid, less, swap)
+	}
+	if mid < end && end < b {
+		symMerge(s, mid, end, b, less, swap)
+	}
+}
+
+// rotate swaps the adjacent blocks s[a:m] and s[m:b] in place.
+func rotate[T any](s []T, a, m, b int, swap func(i, j int)) {
+	if a == m || m == b {
+		return
+	}
+	reverseRange(s, a, m, swap)
+	reverseRange(s, m, b, swap)
+	reverseRange(s, a, b, swap)
+}
@@ -11,12 +11,17 @@ const runSizeThreshold = 8
 
 // Timsort is a simple generic implementation of Timsort algorithm.
 func Timsort[T constraints.Ordered](data []T) []T {
-	runSize := calculateRunSize(len(data))
-	insertionSortRuns(data, runSize)
-	mergeRuns(data, runSize)
+	TimsortFunc(data, orderedLess[T])
 	return data
 }
 
+// TimsortFunc sorts data in place according to less. It is stable.
+func TimsortFunc[T any](data []T, less func(a, b T) bool) {
+	runSize := calculateRunSize(len(data))
+	insertionSortRuns(data, runSize, less)
+	mergeRuns(data, runSize, less)
+}
+
 // calculateRunSize returns a run size parameter that is further used
 // to slice the data slice.
 func calculateRunSize(dataLength int) int {
@@ -33,37 +38,41 @@ func calculateRunSize(dataLength int) int {
 }
 
 // insertionSortRuns runs insertion sort on all the data runs one by one.
-func insertionSortRuns[T constraints.Ordered](data []T, runSize int) {
+func insertionSortRuns[T any](data []T, runSize int, less func(a, b T) bool) {
 	for lower := 0; lower < len(data); lower += runSize {
 		upper := lower + runSize
 		if upper >= len(data) {
 			upper = len(data)
 		}
 
-		Insertion(data[lower:upper])
+		InsertionFunc(data[lower:upper], less)
 	}
 }
 
 // mergeRuns merge sorts all the data runs into a single sorted data slice.
-func mergeRuns[T constraints.Ordered](data []T, runSize int) {
+func mergeRuns[T any](data []T, runSize int, less func(a, b T) bool) {
 	for size := runSize; size < len(data); size *= 2 {
 		for lowerBound := 0; lowerBound < len(data); lowerBound += size * 2 {
 			middleBound := lowerBound + size - 1
+			if middleBound >= len(data)-1 {
+				// the last run has nothing to merge with
+				break
+			}
 			upperBound := lowerBound + 2*size - 1
 			if len(data)-1 < upperBound {
 				upperBound = len(data) - 1
 			}
 
-			mergeRun(data, lowerBound, middleBound, upperBound)
+			mergeRun(data, lowerBound, middleBound, upperBound, less)
 		}
 	}
 }
 
 // mergeRun uses merge sort to sort adjacent data runs.
-func mergeRun[T constraints.Ordered](data []T, lower, mid, upper int) {
+func mergeRun[T any](data []T, lower, mid, upper int, less func(a, b T) bool) {
 	left := data[lower : mid+1]
 	right := data[mid+1 : upper+1]
-	merged := merge(left, right)
+	merged := merge(left, right, less)
 	// rewrite original data slice values with sorted values from merged slice
 	for i, value := range merged {
 		data[lower+i] = value
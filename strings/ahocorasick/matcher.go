@@ -0,0 +1,145 @@
+// matcher.go
+// description: Reusable Aho-Corasick automaton with streaming input
+// details:
+// Matcher compiles a set of patterns once into an Aho-Corasick automaton: a trie
+// of the patterns extended with failure links, which point from every state to
+// the state of its longest proper suffix that is also in the trie, and
+// dictionary links, which point to the nearest terminal state along the failure
+// chain. Text is then scanned in a single pass, in time linear in its length plus
+// the number of reported matches, whatever the number of patterns.
+// A Stream feeds text to the automaton in chunks through io.Writer, so inputs
+// that do not fit in memory can be matched as well.
+// Build: O(total length of patterns)
+// FindAll: O(len(text) + number of matches)
+// reference: https://en.wikipedia.org/wiki/Aho%E2%80%93Corasick_algorithm
+// see matcher_test.go
+
+package ahocorasick
+
+// Match is a single occurrence of a pattern in the scanned text.
+type Match struct {
+	Pattern int // index of the pattern in the slice given to Build
+	Offset  int // byte offset of the first byte of the occurrence
+}
+
+// Matcher is a compiled Aho-Corasick automaton.
+type Matcher struct {
+	patterns []string
+	next     []map[byte]int // trie transitions of every state
+	fail     []int          // failure link of every state
+	dict     []int          // nearest terminal state on the failure chain, -1 if none
+	output   [][]int        // patterns ending exactly at every state
+}
+
+// Build compiles patterns into a Matcher. Empty patterns never match.
+func Build(patterns []string) *Matcher {
+	m := &Matcher{patterns: append([]string(nil), patterns...)}
+	m.newState()
+	for i, p := range patterns {
+		if len(p) == 0 {
+			continue
+		}
+		state := 0
+		for j := 0; j < len(p); j++ {
+			to, ok := m.next[state][p[j]]
+			if !ok {
+				to = m.newState()
+				m.next[state][p[j]] = to
+			}
+			state = to
+		}
+		m.output[state] = append(m.output[state], i)
+	}
+
+	// Breadth-first order guarantees that the failure link of a state is
+	// computed before the state itself is used as a failure target.
+	queue := make([]int, 0, len(m.next))
+	for _, to := range m.next[0] {
+		queue = append(queue, to)
+	}
+	for len(queue) > 0 {
+		state := queue[0]
+		queue = queue[1:]
+		for c, to := range m.next[state] {
+			m.fail[to] = m.step(m.fail[state], c)
+			if len(m.output[m.fail[to]]) > 0 {
+				m.dict[to] = m.fail[to]
+			} else {
+				m.dict[to] = m.dict[m.fail[to]]
+			}
+			queue = append(queue, to)
+		}
+	}
+	return m
+}
+
+func (m *Matcher) newState() int {
+	m.next = append(m.next, map[byte]int{})
+	m.fail = append(m.fail, 0)
+	m.dict = append(m.dict, -1)
+	m.output = append(m.output, nil)
+	return len(m.next) - 1
+}
+
+// step returns the state reached from state on byte c.
+func (m *Matcher) step(state int, c byte) int {
+	for {
+		if to, ok := m.next[state][c]; ok {
+			return to
+		}
+		if state == 0 {
+			return 0
+		}
+		state = m.fail[state]
+	}
+}
+
+// report calls emit for every pattern ending at state, where end is the
+// offset just past the last matched byte.
+func (m *Matcher) report(state, end int, emit func(Match)) {
+	for s := state; s > 0; s = m.dict[s] {
+		for _, p := range m.output[s] {
+			emit(Match{Pattern: p, Offset: end - len(m.patterns[p])})
+		}
+	}
+}
+
+// FindAll returns every occurrence of every pattern in text, ordered by the
+// offset at which the occurrence ends. Overlapping occurrences are all reported.
+func (m *Matcher) FindAll(text string) []Match {
+	var matches []Match
+	s := m.NewStream(func(match Match) { matches = append(matches, match) })
+	_, _ = s.Write([]byte(text))
+	return matches
+}
+
+// Stream scans text handed to it in consecutive chunks. Offsets of reported
+// matches are relative to the start of the whole stream, and occurrences that
+// span chunk boundaries are found.
+type Stream struct {
+	m      *Matcher
+	state  int
+	offset int
+	emit   func(Match)
+}
+
+// NewStream returns a Stream that calls emit for every match found.
+func (m *Matcher) NewStream(emit func(Match)) *Stream {
+	return &Stream{m: m, emit: emit}
+}
+
+// Write feeds p to the automaton. It implements io.Writer and never fails.
+func (s *Stream) Write(p []byte) (int, error) {
+	for _, c := range p {
+		s.state = s.m.step(s.state, c)
+		s.offset++
+		s.m.report(s.state, s.offset, s.emit)
+	}
+	return len(p), nil
+}
+
+// Reset rewinds the stream to the beginning of a new input.
+func (s *Stream) Reset() {
+	s.state = 0
+	s.offset = 0
+}
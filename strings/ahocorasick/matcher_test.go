@@ -0,0 +1,84 @@
+package ahocorasick
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestMatcherFindAll(t *testing.T) {
+	m := Build([]string{"he", "she", "his", "hers", ""})
+	got := m.FindAll("ushers")
+	want := []Match{{Pattern: 1, Offset: 1}, {Pattern: 0, Offset: 2}, {Pattern: 3, Offset: 2}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FindAll(\"ushers\") = %v, want %v", got, want)
+	}
+	if got := m.FindAll("xyz"); got != nil {
+		t.Errorf("FindAll(\"xyz\") = %v, want nil", got)
+	}
+}
+
+func TestMatcherStream(t *testing.T) {
+	m := Build([]string{"abc", "bcab", "c"})
+	text := "abcabcab"
+	want := m.FindAll(text)
+
+	var got []Match
+	s := m.NewStream(func(match Match) { got = append(got, match) })
+	for _, chunk := range []string{"ab", "c", "", "abca", "b"} {
+		if n, err := s.Write([]byte(chunk)); n != len(chunk) || err != nil {
+			t.Fatalf("Write(%q) = %d, %v", chunk, n, err)
+		}
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("streamed matches = %v, want %v", got, want)
+	}
+
+	s.Reset()
+	got = nil
+	_, _ = s.Write([]byte("abc"))
+	if want := []Match{{Pattern: 0, Offset: 0}, {Pattern: 2, Offset: 2}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("matches after Reset = %v, want %v", got, want)
+	}
+}
+
+func TestMatcherAgainstBruteForce(t *testing.T) {
+	rnd := rand.New(rand.NewSource(9))
+	randomString := func(n int) string {
+		b := make([]byte, n)
+		for i := range b {
+			b[i] = "ab"[rnd.Intn(2)]
+		}
+		return string(b)
+	}
+	patterns := make([]string, 30)
+	for i := range patterns {
+		patterns[i] = randomString(1 + rnd.Intn(5))
+	}
+	text := randomString(500)
+
+	var want []Match
+	for i, p := range patterns {
+		for j := 0; j+len(p) <= len(text); j++ {
+			if strings.HasPrefix(text[j:], p) {
+				want = append(want, Match{Pattern: i, Offset: j})
+			}
+		}
+	}
+	got := Build(patterns).FindAll(text)
+	less := func(ms []Match) func(i, j int) bool {
+		return func(i, j int) bool {
+			if ms[i].Offset != ms[j].Offset {
+				return ms[i].Offset < ms[j].Offset
+			}
+			return ms[i].Pattern < ms[j].Pattern
+		}
+	}
+	sort.Slice(got, less(got))
+	sort.Slice(want, less(want))
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FindAll disagrees with brute force: got %d matches, want %d", len(got), len(want))
+	}
+}
@@ -0,0 +1,139 @@
+// diff.go
+// description: Edit scripts between two sequences
+// details:
+// A diff describes how to turn a sequence a into a sequence b with as few
+// deletions and insertions as possible; the elements that are kept form a
+// longest common subsequence. Diff computes it with Myers' greedy algorithm,
+// which takes O((n+m)*d) time for d differences and so is fast for similar
+// sequences, and switches to the linear space refinement of the algorithm for
+// large inputs.
+// time complexity: O((n+m)*d) where d is the number of deleted and inserted elements
+// space complexity: O(d^2) for Myers, O(n+m) for MyersLinear
+// reference: Eugene W. Myers, "An O(ND) Difference Algorithm and Its Variations", Algorithmica 1 (1986)
+// see diff_test.go
+
+// Package diff computes the differences between two sequences, or two texts
+// line by line, renders them in the unified format and merges the changes
+// of two versions of a common base.
+package diff
+
+// Op is the operation of an Edit.
+type Op int
+
+const (
+	// Equal keeps the elements, which are the same in both sequences.
+	Equal Op = iota
+	// Delete removes elements of the old sequence.
+	Delete
+	// Insert adds elements of the new sequence.
+	Insert
+)
+
+func (op Op) String() string {
+	switch op {
+	case Equal:
+		return "Equal"
+	case Delete:
+		return "Delete"
+	case Insert:
+		return "Insert"
+	}
+	return "Op(?)"
+}
+
+// Edit applies Op to the elements OldStart to OldEnd (excluded) of the old
+// sequence and NewStart to NewEnd (excluded) of the new one. The range of the
+// sequence an operation does not use is empty, at the position of the edit.
+type Edit struct {
+	Op       Op
+	OldStart int
+	OldEnd   int
+	NewStart int
+	NewEnd   int
+}
+
+// DefaultLinearThreshold is the total length of the sequences above which
+// Diff uses MyersLinear.
+const DefaultLinearThreshold = 1 << 14
+
+// Diff returns an edit script turning a into b with as few deleted and
+// inserted elements as possible. The edits cover both sequences in order;
+// consecutive edits have different operations, and in a change Delete comes
+// before Insert.
+func Diff[T comparable](a, b []T) []Edit {
+	if len(a)+len(b) > DefaultLinearThreshold {
+		return MyersLinear(a, b)
+	}
+	return Myers(a, b)
+}
+
+// Distance returns the number of deleted and inserted elements of edits.
+func Distance(edits []Edit) int {
+	d := 0
+	for _, e := range edits {
+		if e.Op != Equal {
+			d += e.OldEnd - e.OldStart + e.NewEnd - e.NewStart
+		}
+	}
+	return d
+}
+
+// script builds edit scripts from the operations in order. Deletions and
+// insertions are held back until the next kept element so that every change
+// is one Delete followed by one Insert.
+type script struct {
+	edits   []Edit
+	a, b    int // position in the sequences
+	deleted int
+	added   int
+}
+
+func (s *script) equal(n int) {
+	if n == 0 {
+		return
+	}
+	s.flush()
+	if k := len(s.edits); k > 0 && s.edits[k-1].Op == Equal {
+		s.edits[k-1].OldEnd += n
+		s.edits[k-1].NewEnd += n
+	} else {
+		s.edits = append(s.edits, Edit{Op: Equal, OldStart: s.a, OldEnd: s.a + n, NewStart: s.b, NewEnd: s.b + n})
+	}
+	s.a += n
+	s.b += n
+}
+
+func (s *script) delete(n int) { s.deleted += n }
+func (s *script) insert(n int) { s.added += n }
+
+// add appends the operations of edits.
+func (s *script) add(edits []Edit) {
+	for _, e := range edits {
+		switch e.Op {
+		case Equal:
+			s.equal(e.OldEnd - e.OldStart)
+		case Delete:
+			s.delete(e.OldEnd - e.OldStart)
+		case Insert:
+			s.insert(e.NewEnd - e.NewStart)
+		}
+	}
+}
+
+func (s *script) flush() {
+	if s.deleted > 0 {
+		s.edits = append(s.edits, Edit{Op: Delete, OldStart: s.a, OldEnd: s.a + s.deleted, NewStart: s.b, NewEnd: s.b})
+		s.a += s.deleted
+		s.deleted = 0
+	}
+	if s.added > 0 {
+		s.edits = append(s.edits, Edit{Op: Insert, OldStart: s.a, OldEnd: s.a, NewStart: s.b, NewEnd: s.b + s.added})
+		s.b += s.added
+		s.added = 0
+	}
+}
+
+func (s *script) done() []Edit {
+	s.flush()
+	return s.edits
+}
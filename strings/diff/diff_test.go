@@ -0,0 +1,211 @@
+package diff
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// lcsLength is the dynamic programming reference for the distance.
+func lcsLength(a, b []byte) int {
+	dp := make([][]int, len(a)+1)
+	for i := range dp {
+		dp[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			switch {
+			case a[i] == b[j]:
+				dp[i][j] = dp[i+1][j+1] + 1
+			case dp[i+1][j] > dp[i][j+1]:
+				dp[i][j] = dp[i+1][j]
+			default:
+				dp[i][j] = dp[i][j+1]
+			}
+		}
+	}
+	return dp[0][0]
+}
+
+// checkScript verifies that edits are a shortest edit script from a to b.
+func checkScript(t *testing.T, a, b []byte, edits []Edit) {
+	t.Helper()
+	checkEdits(t, a, b, edits)
+	if d, want := Distance(edits), len(a)+len(b)-2*lcsLength(a, b); d != want {
+		t.Fatalf("distance between %q and %q = %d, want %d", a, b, d, want)
+	}
+}
+
+// checkEdits verifies that edits turn a into b and have the documented shape.
+func checkEdits(t *testing.T, a, b []byte, edits []Edit) {
+	t.Helper()
+	var got []byte
+	oldPos, newPos := 0, 0
+	for i, e := range edits {
+		if e.OldStart != oldPos || e.NewStart != newPos || e.OldEnd < e.OldStart || e.NewEnd < e.NewStart {
+			t.Fatalf("edit %d = %+v does not follow position (%d, %d)", i, e, oldPos, newPos)
+		}
+		if i > 0 && (edits[i-1].Op == e.Op || (edits[i-1].Op == Insert && e.Op == Delete)) {
+			t.Fatalf("edit %d: %v follows %v", i, e.Op, edits[i-1].Op)
+		}
+		switch e.Op {
+		case Equal:
+			if e.OldEnd-e.OldStart != e.NewEnd-e.NewStart || string(a[e.OldStart:e.OldEnd]) != string(b[e.NewStart:e.NewEnd]) {
+				t.Fatalf("edit %d = %+v keeps different elements", i, e)
+			}
+			got = append(got, a[e.OldStart:e.OldEnd]...)
+		case Delete:
+			if e.NewEnd != e.NewStart || e.OldEnd == e.OldStart {
+				t.Fatalf("edit %d = %+v is not a deletion", i, e)
+			}
+		case Insert:
+			if e.OldEnd != e.OldStart || e.NewEnd == e.NewStart {
+				t.Fatalf("edit %d = %+v is not an insertion", i, e)
+			}
+			got = append(got, b[e.NewStart:e.NewEnd]...)
+		}
+		oldPos, newPos = e.OldEnd, e.NewEnd
+	}
+	if oldPos != len(a) || newPos != len(b) || string(got) != string(b) {
+		t.Fatalf("edits %+v do not turn %q into %q", edits, a, b)
+	}
+}
+
+func TestMyers(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want []Edit
+	}{
+		{"", "", nil},
+		{"abc", "abc", []Edit{{Equal, 0, 3, 0, 3}}},
+		{"", "ab", []Edit{{Insert, 0, 0, 0, 2}}},
+		{"ab", "", []Edit{{Delete, 0, 2, 0, 0}}},
+		{"abcabba", "cbabac", nil}, // the example of the paper, distance 5
+		{"kitten", "sitting", nil},
+	}
+	for _, test := range tests {
+		for name, f := range map[string]func(a, b []byte) []Edit{"Myers": Myers[byte], "MyersLinear": MyersLinear[byte]} {
+			edits := f([]byte(test.a), []byte(test.b))
+			checkScript(t, []byte(test.a), []byte(test.b), edits)
+			if test.want != nil && !reflect.DeepEqual(edits, test.want) {
+				t.Errorf("%s(%q, %q) = %v, want %v", name, test.a, test.b, edits, test.want)
+			}
+		}
+	}
+	if d := Distance(Myers([]byte("abcabba"), []byte("cbabac"))); d != 5 {
+		t.Errorf("distance of the paper's example = %d, want 5", d)
+	}
+}
+
+func TestRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	random := func(n int) []byte {
+		s := make([]byte, n)
+		for i := range s {
+			s[i] = byte('a' + rnd.Intn(3))
+		}
+		return s
+	}
+	for i := 0; i < 500; i++ {
+		a, b := random(rnd.Intn(30)), random(rnd.Intn(30))
+		checkScript(t, a, b, Myers(a, b))
+		checkScript(t, a, b, MyersLinear(a, b))
+	}
+}
+
+func TestLarge(t *testing.T) {
+	rnd := rand.New(rand.NewSource(2))
+	a := make([]int, 20000)
+	for i := range a {
+		a[i] = rnd.Intn(1000)
+	}
+	// b is a with a few scattered changes
+	b := append([]int(nil), a...)
+	for i := 0; i < 50; i++ {
+		b[rnd.Intn(len(b))] = -1
+	}
+	b = append(b[:100], b[150:]...)
+	edits := Diff(a, b)
+	if d := Distance(edits); d > 150 {
+		t.Errorf("distance = %d, want at most 150", d)
+	}
+	if !reflect.DeepEqual(edits, MyersLinear(a, b)) {
+		t.Error("Diff does not use MyersLinear for large inputs")
+	}
+}
+
+func TestUnified(t *testing.T) {
+	a := "one\ntwo\nthree\nfour\nfive\nsix\nseven\neight\nnine\nten\n"
+	b := "zero\none\ntwo\nthree\nfour\nfive\nsix\nseven\nEIGHT\nnine\nten"
+	want := `--- a.txt
++++ b.txt
+@@ -1,2 +1,3 @@
++zero
+ one
+ two
+@@ -6,5 +7,5 @@
+ six
+ seven
+-eight
++EIGHT
+ nine
+-ten
++ten
+\ No newline at end of file
+`
+	if got := Unified("a.txt", "b.txt", a, b, 2); got != want {
+		t.Errorf("Unified =\n%s\nwant\n%s", got, want)
+	}
+
+	want = `--- a.txt
++++ b.txt
+@@ -1,10 +1,11 @@
++zero
+ one
+ two
+ three
+ four
+ five
+ six
+ seven
+-eight
++EIGHT
+ nine
+-ten
++ten
+\ No newline at end of file
+`
+	if got := Unified("a.txt", "b.txt", a, b, 4); got != want {
+		t.Errorf("Unified with more context =\n%s\nwant\n%s", got, want)
+	}
+	if got := Unified("a", "b", a, a, 3); got != "" {
+		t.Errorf("Unified of equal texts = %q", got)
+	}
+	if got, want := Unified("a", "b", "", "x\n", 3), "--- a\n+++ b\n@@ -0,0 +1 @@\n+x\n"; got != want {
+		t.Errorf("Unified from an empty text = %q, want %q", got, want)
+	}
+}
+
+func TestSplitLines(t *testing.T) {
+	if got, want := SplitLines("a\n\nb"), []string{"a\n", "\n", "b"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SplitLines = %q, want %q", got, want)
+	}
+	if got := Lines("a\nb\n", "a\nc\n"); !reflect.DeepEqual(got, []Edit{{Equal, 0, 1, 0, 1}, {Delete, 1, 2, 1, 1}, {Insert, 2, 2, 1, 2}}) {
+		t.Errorf("Lines = %v", got)
+	}
+}
+
+func BenchmarkDiff(b *testing.B) {
+	rnd := rand.New(rand.NewSource(3))
+	x := make([]int, 100000)
+	for i := range x {
+		x[i] = rnd.Intn(1 << 20)
+	}
+	y := append([]int(nil), x...)
+	for i := 0; i < 100; i++ {
+		y[rnd.Intn(len(y))] = -1
+	}
+	for i := 0; i < b.N; i++ {
+		Diff(x, y)
+	}
+}
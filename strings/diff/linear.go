@@ -0,0 +1,109 @@
+// linear.go
+// description: Linear space refinement of Myers' diff algorithm
+// details:
+// Running the greedy algorithm from both ends of the edit graph at once, the
+// two searches meet on a middle snake of an optimal path after about d/2
+// rounds each. The snake splits the problem in two smaller ones that are
+// solved recursively, so only the furthest points of the current round have
+// to be kept. Common prefixes and suffixes are removed before every search.
+// time complexity: O((n+m)*d)
+// space complexity: O(n+m)
+// reference: Eugene W. Myers, "An O(ND) Difference Algorithm and Its Variations", Algorithmica 1 (1986), section 4b
+// see diff_test.go
+
+package diff
+
+// MyersLinear returns a shortest edit script turning a into b, as Diff
+// describes, in linear space. It may differ from the script of Myers when
+// there are several shortest ones.
+func MyersLinear[T comparable](a, b []T) []Edit {
+	size := 2*(len(a)+len(b)) + 4
+	l := linear[T]{a: a, b: b, forward: make([]int, size), backward: make([]int, size)}
+	l.compare(0, len(a), 0, len(b))
+	return l.s.done()
+}
+
+type linear[T comparable] struct {
+	a, b              []T
+	forward, backward []int // furthest points of the two searches
+	s                 script
+}
+
+// compare adds the edits turning a[a0:a1] into b[b0:b1] to the script.
+func (l *linear[T]) compare(a0, a1, b0, b1 int) {
+	prefix := 0
+	for a0+prefix < a1 && b0+prefix < b1 && l.a[a0+prefix] == l.b[b0+prefix] {
+		prefix++
+	}
+	l.s.equal(prefix)
+	a0, b0 = a0+prefix, b0+prefix
+	suffix := 0
+	for a0 < a1-suffix && b0 < b1-suffix && l.a[a1-suffix-1] == l.b[b1-suffix-1] {
+		suffix++
+	}
+	a1, b1 = a1-suffix, b1-suffix
+
+	switch {
+	case a0 == a1:
+		l.s.insert(b1 - b0)
+	case b0 == b1:
+		l.s.delete(a1 - a0)
+	default:
+		// Both ranges are non-empty and differ at both ends, so the
+		// distance is at least 2 and both halves are smaller problems.
+		x, y, u, v := l.middleSnake(a0, a1, b0, b1)
+		l.compare(a0, a0+x, b0, b0+y)
+		l.s.equal(u - x)
+		l.compare(a0+u, a1, b0+v, b1)
+	}
+	l.s.equal(suffix)
+}
+
+// middleSnake returns the start (x, y) and end (u, v) of the middle snake of
+// a shortest path from (0, 0) to (n, m) in the edit graph of a[a0:a1] and
+// b[b0:b1].
+func (l *linear[T]) middleSnake(a0, a1, b0, b1 int) (x, y, u, v int) {
+	n, m := a1-a0, b1-b0
+	delta := n - m
+	odd := delta%2 != 0
+	offset := n + m + 1
+	// forward[offset+k] is the furthest x on diagonal k from (0, 0);
+	// backward[offset+k] is the furthest distance from the end on the
+	// diagonal k of the reversed sequences, which is diagonal delta-k.
+	l.forward[offset+1], l.backward[offset+1] = 0, 0
+	for d := 0; d <= (n+m+1)/2; d++ {
+		for k := -d; k <= d; k += 2 {
+			x := l.forward[offset+k+1]
+			if !(k == -d || (k != d && l.forward[offset+k-1] < l.forward[offset+k+1])) {
+				x = l.forward[offset+k-1] + 1
+			}
+			y := x - k
+			startX, startY := x, y
+			for x < n && y < m && l.a[a0+x] == l.b[b0+y] {
+				x++
+				y++
+			}
+			l.forward[offset+k] = x
+			if rk := delta - k; odd && rk >= -(d-1) && rk <= d-1 && x+l.backward[offset+rk] >= n {
+				return startX, startY, x, y
+			}
+		}
+		for k := -d; k <= d; k += 2 {
+			x := l.backward[offset+k+1]
+			if !(k == -d || (k != d && l.backward[offset+k-1] < l.backward[offset+k+1])) {
+				x = l.backward[offset+k-1] + 1
+			}
+			y := x - k
+			startX, startY := x, y
+			for x < n && y < m && l.a[a1-1-x] == l.b[b1-1-y] {
+				x++
+				y++
+			}
+			l.backward[offset+k] = x
+			if fk := delta - k; !odd && fk >= -d && fk <= d && x+l.forward[offset+fk] >= n {
+				return n - x, m - y, n - startX, m - startY
+			}
+		}
+	}
+	panic("diff: no middle snake")
+}
@@ -0,0 +1,148 @@
+// merge.go
+// description: Three-way merge with conflict markers
+// details:
+// Two versions, ours and theirs, derived from a common base are merged by
+// diffing each of them against the base. The base elements kept by both
+// versions at matching positions are stable; between them, a chunk changed
+// by one version only takes that change, a chunk changed the same way by both
+// takes it once, and any other chunk is a conflict that needs a human. This
+// is the diff3 algorithm used by version control systems, here on top of
+// patience diff.
+// time complexity: the cost of the two diffs plus O(n) for the merge
+// space complexity: O(n)
+// reference: Sanjeev Khanna, Keshav Kunal and Benjamin C. Pierce, "A Formal Investigation of Diff3" (2007)
+// see merge_test.go
+
+package diff
+
+import "strings"
+
+// MergeChunk is a part of a three-way merge: either merged elements, or a
+// conflict between the elements of the three versions.
+type MergeChunk[T any] struct {
+	Conflict bool
+	Merged   []T // the merged elements when there is no conflict
+	Base     []T // the elements of each version in a conflict
+	Ours     []T
+	Theirs   []T
+}
+
+// matches returns, for every element of base, its position in other or -1 if
+// the edits from base to other delete it.
+func matches(n int, edits []Edit) []int {
+	m := make([]int, n)
+	for _, e := range edits {
+		for i := e.OldStart; i < e.OldEnd; i++ {
+			m[i] = -1
+			if e.Op == Equal {
+				m[i] = e.NewStart + i - e.OldStart
+			}
+		}
+	}
+	return m
+}
+
+func equalSlices[T comparable](a, b []T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Merge3 merges the changes from base to ours and from base to theirs. The
+// chunks alternate between merged elements and conflicts.
+func Merge3[T comparable](base, ours, theirs []T) []MergeChunk[T] {
+	toOurs := matches(len(base), Patience(base, ours))
+	toTheirs := matches(len(base), Patience(base, theirs))
+
+	var chunks []MergeChunk[T]
+	merged := func(elements []T) {
+		if len(elements) == 0 {
+			return
+		}
+		if k := len(chunks); k > 0 && !chunks[k-1].Conflict {
+			chunks[k-1].Merged = append(chunks[k-1].Merged, elements...)
+			return
+		}
+		chunks = append(chunks, MergeChunk[T]{Merged: append([]T(nil), elements...)})
+	}
+
+	b, o, t := 0, 0, 0
+	for b < len(base) || o < len(ours) || t < len(theirs) {
+		// stable elements, kept by both versions right here
+		k := 0
+		for b+k < len(base) && toOurs[b+k] == o+k && toTheirs[b+k] == t+k {
+			k++
+		}
+		if k > 0 {
+			merged(base[b : b+k])
+			b, o, t = b+k, o+k, t+k
+			continue
+		}
+
+		// the unstable chunk ends at the next element kept by both
+		end, oursEnd, theirsEnd := b, len(ours), len(theirs)
+		for end < len(base) && (toOurs[end] < 0 || toTheirs[end] < 0) {
+			end++
+		}
+		if end < len(base) {
+			oursEnd, theirsEnd = toOurs[end], toTheirs[end]
+		}
+		baseChunk, oursChunk, theirsChunk := base[b:end], ours[o:oursEnd], theirs[t:theirsEnd]
+		switch {
+		case equalSlices(oursChunk, baseChunk):
+			merged(theirsChunk)
+		case equalSlices(theirsChunk, baseChunk), equalSlices(oursChunk, theirsChunk):
+			merged(oursChunk)
+		default:
+			chunks = append(chunks, MergeChunk[T]{Conflict: true, Base: baseChunk, Ours: oursChunk, Theirs: theirsChunk})
+		}
+		b, o, t = end, oursEnd, theirsEnd
+	}
+	return chunks
+}
+
+// MergeText merges the texts ours and theirs, both derived from base, line
+// by line. Conflicts are written between the markers used by git, with the
+// version labels oursLabel and theirsLabel:
+//
+//	<<<<<<< oursLabel
+//	lines of ours
+//	=======
+//	lines of theirs
+//	>>>>>>> theirsLabel
+//
+// It returns the merged text and the number of conflicts.
+func MergeText(base, ours, theirs, oursLabel, theirsLabel string) (string, int) {
+	var sb strings.Builder
+	// write keeps every marker at the start of a line.
+	write := func(lines []string) {
+		for _, l := range lines {
+			sb.WriteString(l)
+		}
+		if len(lines) > 0 && !strings.HasSuffix(lines[len(lines)-1], "\n") {
+			sb.WriteByte('\n')
+		}
+	}
+	conflicts := 0
+	for _, c := range Merge3(SplitLines(base), SplitLines(ours), SplitLines(theirs)) {
+		if !c.Conflict {
+			for _, l := range c.Merged {
+				sb.WriteString(l)
+			}
+			continue
+		}
+		conflicts++
+		sb.WriteString("<<<<<<< " + oursLabel + "\n")
+		write(c.Ours)
+		sb.WriteString("=======\n")
+		write(c.Theirs)
+		sb.WriteString(">>>>>>> " + theirsLabel + "\n")
+	}
+	return sb.String(), conflicts
+}
@@ -0,0 +1,44 @@
+package diff
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMerge3(t *testing.T) {
+	split := func(s string) []string { return SplitLines(s) }
+	tests := []struct {
+		name               string
+		base, ours, theirs string
+		want               string
+		conflicts          int
+	}{
+		{"unchanged", "a\nb\n", "a\nb\n", "a\nb\n", "a\nb\n", 0},
+		{"ours only", "a\nb\nc\n", "a\nB\nc\n", "a\nb\nc\n", "a\nB\nc\n", 0},
+		{"theirs only", "a\nb\nc\n", "a\nb\nc\n", "a\nb\nC\n", "a\nb\nC\n", 0},
+		{"separate changes", "a\nb\nc\nd\ne\n", "A\nb\nc\nd\ne\n", "a\nb\nc\nd\nE\n", "A\nb\nc\nd\nE\n", 0},
+		{"same change", "a\nb\nc\n", "a\nX\nc\n", "a\nX\nc\n", "a\nX\nc\n", 0},
+		{"deletion and insertion", "a\nb\nc\nd\n", "a\nc\nd\n", "a\nb\nc\nd\ne\n", "a\nc\nd\ne\n", 0},
+		{"conflict", "a\nb\nc\n", "a\nours\nc\n", "a\ntheirs\nc\n",
+			"a\n<<<<<<< mine\nours\n=======\ntheirs\n>>>>>>> yours\nc\n", 1},
+		{"conflicting appends", "a\n", "a\nb", "a\nc\n",
+			"a\n<<<<<<< mine\nb\n=======\nc\n>>>>>>> yours\n", 1},
+		{"from empty base", "", "x\n", "x\n", "x\n", 0},
+	}
+	for _, test := range tests {
+		got, conflicts := MergeText(test.base, test.ours, test.theirs, "mine", "yours")
+		if got != test.want || conflicts != test.conflicts {
+			t.Errorf("%s: MergeText = %q, %d, want %q, %d", test.name, got, conflicts, test.want, test.conflicts)
+		}
+	}
+
+	chunks := Merge3(split("a\nb\nc\n"), split("a\nours\nc\n"), split("a\ntheirs\nc\n"))
+	want := []MergeChunk[string]{
+		{Merged: []string{"a\n"}},
+		{Conflict: true, Base: []string{"b\n"}, Ours: []string{"ours\n"}, Theirs: []string{"theirs\n"}},
+		{Merged: []string{"c\n"}},
+	}
+	if !reflect.DeepEqual(chunks, want) {
+		t.Errorf("Merge3 = %+v, want %+v", chunks, want)
+	}
+}
@@ -0,0 +1,89 @@
+// myers.go
+// description: Myers' greedy O(ND) diff algorithm
+// details:
+// The edit graph of a and b has a point (x, y) for every pair of prefixes
+// a[:x] and b[:y]; moving right deletes a[x], moving down inserts b[y] and
+// moving diagonally keeps a[x] == b[y] for free. The algorithm finds, for
+// d = 0, 1, ..., the furthest point reachable on every diagonal k = x - y with
+// d deletions and insertions, following diagonals as far as possible (a
+// snake), until it reaches (len(a), len(b)). Keeping the furthest points of
+// every round allows walking the shortest path back.
+// time complexity: O((n+m)*d)
+// space complexity: O(d^2)
+// reference: Eugene W. Myers, "An O(ND) Difference Algorithm and Its Variations", Algorithmica 1 (1986)
+// see diff_test.go
+
+package diff
+
+// Myers returns a shortest edit script turning a into b, as Diff describes,
+// computed with Myers' greedy algorithm.
+func Myers[T comparable](a, b []T) []Edit {
+	n, m := len(a), len(b)
+	limit := n + m
+	// v[limit+k] is the furthest x reached on diagonal k.
+	v := make([]int, 2*limit+2)
+	// trace[d][d+k] is v[limit+k] after round d.
+	var trace [][]int
+	for d := 0; ; d++ {
+		done := false
+		for k := -d; k <= d && !done; k += 2 {
+			var x int
+			if k == -d || (k != d && v[limit+k-1] < v[limit+k+1]) {
+				x = v[limit+k+1] // down from diagonal k+1
+			} else {
+				x = v[limit+k-1] + 1 // right from diagonal k-1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[limit+k] = x
+			done = x >= n && y >= m
+		}
+		trace = append(trace, append([]int(nil), v[limit-d:limit+d+1]...))
+		if done {
+			return backtrack(trace, n, m)
+		}
+	}
+}
+
+// step is a move of the shortest path followed by a snake.
+type step struct {
+	op    Op // Delete or Insert, Equal for the start of the path
+	snake int
+}
+
+// backtrack walks the path found by Myers back from (n, m).
+func backtrack(trace [][]int, n, m int) []Edit {
+	steps := make([]step, len(trace))
+	x, y := n, m
+	for d := len(trace) - 1; d > 0; d-- {
+		previous := trace[d-1] // previous[d-1+k] is the furthest x on diagonal k
+		k := x - y
+		prevK, op := k-1, Delete
+		if k == -d || (k != d && previous[d-1+k-1] < previous[d-1+k+1]) {
+			prevK, op = k+1, Insert
+		}
+		prevX := previous[d-1+prevK]
+		startX := prevX // x after the move
+		if op == Delete {
+			startX++
+		}
+		steps[d] = step{op: op, snake: x - startX}
+		x, y = prevX, prevX-prevK
+	}
+	steps[0] = step{op: Equal, snake: x}
+
+	var s script
+	for _, st := range steps {
+		switch st.op {
+		case Delete:
+			s.delete(1)
+		case Insert:
+			s.insert(1)
+		}
+		s.equal(st.snake)
+	}
+	return s.done()
+}
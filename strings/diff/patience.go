@@ -0,0 +1,101 @@
+// patience.go
+// description: Patience diff
+// details:
+// Patience diff first matches the elements that occur exactly once in both
+// sequences, keeping the longest increasing subsequence of their positions
+// so that the matches do not cross. These unique elements, such as function
+// signatures in source code, are the landmarks a reader expects to be kept;
+// the ranges between them are diffed recursively, and with Myers' algorithm
+// when they have no unique common element. The result is not always the
+// shortest, but changes are rarely aligned on repeated lines such as braces.
+// time complexity: O(n log n) for the matching, plus Myers on the ranges without unique elements
+// space complexity: O(n+m)
+// reference: https://bramcohen.livejournal.com/73318.html
+// see patience_test.go
+
+package diff
+
+import "github.com/TheAlgorithms/Go/dynamic"
+
+// Patience returns an edit script turning a into b computed with the
+// patience diff algorithm. The edits have the shape Diff describes.
+func Patience[T comparable](a, b []T) []Edit {
+	var s script
+	patience(&s, a, b, 0, len(a), 0, len(b))
+	return s.done()
+}
+
+// patience adds the edits turning a[a0:a1] into b[b0:b1] to s.
+func patience[T comparable](s *script, a, b []T, a0, a1, b0, b1 int) {
+	prefix := 0
+	for a0+prefix < a1 && b0+prefix < b1 && a[a0+prefix] == b[b0+prefix] {
+		prefix++
+	}
+	s.equal(prefix)
+	a0, b0 = a0+prefix, b0+prefix
+	suffix := 0
+	for a0 < a1-suffix && b0 < b1-suffix && a[a1-suffix-1] == b[b1-suffix-1] {
+		suffix++
+	}
+	a1, b1 = a1-suffix, b1-suffix
+
+	switch anchors := uniqueMatches(a[a0:a1], b[b0:b1]); {
+	case a0 == a1:
+		s.insert(b1 - b0)
+	case b0 == b1:
+		s.delete(a1 - a0)
+	case len(anchors) == 0:
+		s.add(Myers(a[a0:a1], b[b0:b1]))
+	default:
+		startA, startB := a0, b0
+		for _, m := range anchors {
+			i, j := startA+m[0], startB+m[1]
+			patience(s, a, b, a0, i, b0, j)
+			s.equal(1)
+			a0, b0 = i+1, j+1
+		}
+		patience(s, a, b, a0, a1, b0, b1)
+	}
+	s.equal(suffix)
+}
+
+// uniqueMatches returns the positions in a and b of the elements occurring
+// once in each of them, restricted to the longest chain increasing in both.
+func uniqueMatches[T comparable](a, b []T) [][2]int {
+	count := make(map[T]int)
+	for _, x := range a {
+		count[x]++
+	}
+	// elements unique in a have count 1, unique in b as well end up at -1
+	inB := make(map[T]int)
+	for j, x := range b {
+		switch count[x] {
+		case 1:
+			count[x] = -1
+			inB[x] = j
+		case -1:
+			count[x] = 0
+		}
+	}
+	var matches [][2]int
+	var positions []int
+	for i, x := range a {
+		if count[x] == -1 {
+			matches = append(matches, [2]int{i, inB[x]})
+			positions = append(positions, inB[x])
+		}
+	}
+	// positions in b are distinct, so the subsequence identifies the matches
+	_, chain := dynamic.LongestIncreasingSubsequenceTrace(positions)
+	kept := make(map[int]bool, len(chain))
+	for _, j := range chain {
+		kept[j] = true
+	}
+	anchors := matches[:0]
+	for _, m := range matches {
+		if kept[m[1]] {
+			anchors = append(anchors, m)
+		}
+	}
+	return anchors
+}
@@ -0,0 +1,46 @@
+package diff
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestPatience(t *testing.T) {
+	a := []string{"func1", "{", "x1", "}", "func2", "{", "x2", "}"}
+	b := []string{"func1", "{", "x1", "}", "func3", "{", "x3", "}", "func2", "{", "x2", "}"}
+	want := []Edit{{Equal, 0, 4, 0, 4}, {Insert, 4, 4, 4, 8}, {Equal, 4, 8, 8, 12}}
+	if got := Patience(a, b); !reflect.DeepEqual(got, want) {
+		t.Errorf("Patience = %v, want %v", got, want)
+	}
+
+	// The unique lines are matched even when a shorter script exists.
+	a = []string{"a", "b", "c", "x", "x", "x"}
+	b = []string{"x", "x", "x", "c", "a", "b"}
+	edits := Patience(a, b)
+	kept := 0
+	for _, e := range edits {
+		if e.Op == Equal {
+			kept += e.OldEnd - e.OldStart
+		}
+	}
+	if kept != 2 {
+		t.Errorf("Patience(%v, %v) = %v keeps %d lines, want a and b", a, b, edits, kept)
+	}
+}
+
+func TestPatienceRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(4))
+	for i := 0; i < 500; i++ {
+		// a few unique symbols among repeated ones
+		random := func(n int) []byte {
+			s := make([]byte, n)
+			for i := range s {
+				s[i] = byte('a' + rnd.Intn(10))
+			}
+			return s
+		}
+		a, b := random(rnd.Intn(30)), random(rnd.Intn(30))
+		checkEdits(t, a, b, Patience(a, b))
+	}
+}
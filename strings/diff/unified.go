@@ -0,0 +1,145 @@
+// unified.go
+// description: Line diffs and the unified diff format
+// details:
+// Texts are compared line by line. The unified format shows every group of
+// nearby changes as a hunk: a header with the position and length of the
+// lines of both texts it covers, then the lines, prefixed with ' ' for kept
+// lines, '-' for deleted lines and '+' for inserted lines. Changes closer
+// than twice the number of context lines share a hunk.
+// time complexity: O((n+m)*d) for texts of n and m lines with d changed lines
+// space complexity: O(n+m)
+// reference: https://www.gnu.org/software/diffutils/manual/html_node/Detailed-Unified.html
+// see diff_test.go
+
+package diff
+
+import (
+	"fmt"
+	"strings"
+)
+
+// noNewline marks a last line without a line break.
+const noNewline = "\n\\ No newline at end of file\n"
+
+// SplitLines returns the lines of s, each with its line break except maybe
+// the last one.
+func SplitLines(s string) []string {
+	var lines []string
+	for len(s) > 0 {
+		i := strings.IndexByte(s, '\n') + 1
+		if i == 0 {
+			i = len(s)
+		}
+		lines = append(lines, s[:i])
+		s = s[i:]
+	}
+	return lines
+}
+
+// Lines returns the edits turning the lines of a into the lines of b.
+func Lines(a, b string) []Edit {
+	return Diff(SplitLines(a), SplitLines(b))
+}
+
+// Unified returns the unified diff of the texts a and b, named oldName and
+// newName, with context unchanged lines around every change. It returns ""
+// when the texts are equal.
+func Unified(oldName, newName, a, b string, context int) string {
+	oldLines, newLines := SplitLines(a), SplitLines(b)
+	return UnifiedEdits(oldName, newName, oldLines, newLines, Diff(oldLines, newLines), context)
+}
+
+// UnifiedEdits renders edits between the lines oldLines and newLines, as
+// returned by SplitLines, in the unified format.
+func UnifiedEdits(oldName, newName string, oldLines, newLines []string, edits []Edit, context int) string {
+	if context < 0 {
+		context = 0
+	}
+	var sb strings.Builder
+	line := func(prefix byte, text string) {
+		sb.WriteByte(prefix)
+		sb.WriteString(text)
+		if !strings.HasSuffix(text, "\n") {
+			sb.WriteString(noNewline)
+		}
+	}
+	for start := 0; start < len(edits); {
+		// find the first change and the last one of its hunk
+		for start < len(edits) && edits[start].Op == Equal {
+			start++
+		}
+		if start == len(edits) {
+			break
+		}
+		end := start + 1
+		for end < len(edits) {
+			if edits[end].Op == Equal {
+				if end+1 == len(edits) || edits[end].OldEnd-edits[end].OldStart > 2*context {
+					break
+				}
+			}
+			end++
+		}
+
+		oldStart, newStart := edits[start].OldStart, edits[start].NewStart
+		if start > 0 {
+			before := edits[start-1].OldEnd - edits[start-1].OldStart
+			if before > context {
+				before = context
+			}
+			oldStart -= before
+			newStart -= before
+		}
+		oldEnd, newEnd := edits[end-1].OldEnd, edits[end-1].NewEnd
+		if end < len(edits) {
+			after := edits[end].OldEnd - edits[end].OldStart
+			if after > context {
+				after = context
+			}
+			oldEnd += after
+			newEnd += after
+		}
+
+		if sb.Len() == 0 {
+			fmt.Fprintf(&sb, "--- %s\n+++ %s\n", oldName, newName)
+		}
+		fmt.Fprintf(&sb, "@@ -%s +%s @@\n", hunkRange(oldStart, oldEnd), hunkRange(newStart, newEnd))
+		for i := oldStart; i < edits[start].OldStart; i++ {
+			line(' ', oldLines[i])
+		}
+		for _, e := range edits[start:end] {
+			switch e.Op {
+			case Equal:
+				for i := e.OldStart; i < e.OldEnd; i++ {
+					line(' ', oldLines[i])
+				}
+			case Delete:
+				for i := e.OldStart; i < e.OldEnd; i++ {
+					line('-', oldLines[i])
+				}
+			case Insert:
+				for i := e.NewStart; i < e.NewEnd; i++ {
+					line('+', newLines[i])
+				}
+			}
+		}
+		for i := edits[end-1].OldEnd; i < oldEnd; i++ {
+			line(' ', oldLines[i])
+		}
+		start = end
+	}
+	return sb.String()
+}
+
+// hunkRange formats the lines start to end (excluded) as a hunk header does:
+// the first line counting from 1 and the number of lines if it is not 1. An
+// empty range starts at the line before it.
+func hunkRange(start, end int) string {
+	switch end - start {
+	case 0:
+		return fmt.Sprintf("%d,0", start)
+	case 1:
+		return fmt.Sprintf("%d", start+1)
+	}
+	return fmt.Sprintf("%d,%d", start+1, end-start)
+}
@@ -0,0 +1,174 @@
+// automaton.go
+// description: Deterministic Levenshtein automaton
+// details:
+// The Levenshtein automaton of a word w and a distance k accepts exactly the
+// strings within edit distance k of w. While a string s is read, the state is
+// the last row of the edit distance table of s against every prefix of w, with
+// values above k clipped to k+1 since they can never recover. Only finitely
+// many such rows exist, and two strings ending in the same row behave the same
+// from then on, so the rows reachable from the start are the states of a DFA.
+// Characters that do not occur in w all act the same, so the transitions are
+// built for the distinct characters of w plus one class for every other one.
+// Walking a trie in step with the automaton, and dropping every branch where
+// the automaton dies, finds all dictionary words within distance k while
+// visiting only a small part of the trie.
+// time complexity: O(S * (a+1) * n) to build, with S states, a distinct characters
+// and n = len(w); O(1) per character to run
+// space complexity: O(S * (a+1))
+// reference: Schulz, K. U., Mihov, S. "Fast string correction with Levenshtein automata", IJDAR 2002
+// see automaton_test.go
+
+package levenshtein
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// ErrNegativeDistance is returned when the maximum distance of an automaton is negative.
+var ErrNegativeDistance = errors.New("maximum distance must not be negative")
+
+// Dead is the state reached once no continuation can be accepted anymore.
+const Dead = -1
+
+// Automaton is a deterministic automaton accepting the strings within a
+// maximum Levenshtein distance of a word, counting edits in runes.
+type Automaton struct {
+	maxDistance int
+	classes     map[rune]int // class of each rune of the word, the others get len(classes)
+	transitions [][]int      // transitions[state][class]
+	distances   []int        // distance to the word in each state, maxDistance+1 when above it
+}
+
+// NewAutomaton builds the automaton accepting the strings within
+// maxDistance edits of word.
+func NewAutomaton(word string, maxDistance int) (*Automaton, error) {
+	if maxDistance < 0 {
+		return nil, ErrNegativeDistance
+	}
+	w := []rune(word)
+	a := &Automaton{maxDistance: maxDistance, classes: make(map[rune]int)}
+	var symbols []rune
+	for _, r := range w {
+		if _, ok := a.classes[r]; !ok {
+			a.classes[r] = len(symbols)
+			symbols = append(symbols, r)
+		}
+	}
+	// other never equals a rune of the word; it stands for the last class.
+	classCount := len(symbols) + 1
+
+	clip := func(v int) int {
+		if v > maxDistance {
+			return maxDistance + 1
+		}
+		return v
+	}
+	start := make([]int, len(w)+1)
+	for i := range start {
+		start[i] = clip(i)
+	}
+
+	ids := map[string]int{}
+	var rows [][]int
+	add := func(row []int) int {
+		key := rowKey(row)
+		if id, ok := ids[key]; ok {
+			return id
+		}
+		id := len(rows)
+		ids[key] = id
+		rows = append(rows, row)
+		a.transitions = append(a.transitions, nil)
+		a.distances = append(a.distances, row[len(w)])
+		return id
+	}
+	add(start)
+	for s := 0; s < len(rows); s++ {
+		row := rows[s]
+		a.transitions[s] = make([]int, classCount)
+		for class := 0; class < classCount; class++ {
+			next := make([]int, len(w)+1)
+			next[0] = clip(row[0] + 1)
+			alive := next[0] <= maxDistance
+			for i := 1; i <= len(w); i++ {
+				cost := 1
+				if class < len(symbols) && w[i-1] == symbols[class] {
+					cost = 0
+				}
+				v := row[i-1] + cost
+				if row[i]+1 < v {
+					v = row[i] + 1
+				}
+				if next[i-1]+1 < v {
+					v = next[i-1] + 1
+				}
+				next[i] = clip(v)
+				alive = alive || next[i] <= maxDistance
+			}
+			if alive {
+				a.transitions[s][class] = add(next)
+			} else {
+				a.transitions[s][class] = Dead
+			}
+		}
+	}
+	return a, nil
+}
+
+// rowKey encodes a row of the distance table as a map key.
+func rowKey(row []int) string {
+	b := make([]byte, 0, 4*len(row))
+	for _, v := range row {
+		b = binary.LittleEndian.AppendUint32(b, uint32(v))
+	}
+	return string(b)
+}
+
+// MaxDistance returns the maximum distance the automaton accepts.
+func (a *Automaton) MaxDistance() int {
+	return a.maxDistance
+}
+
+// States returns the number of live states of the automaton.
+func (a *Automaton) States() int {
+	return len(a.transitions)
+}
+
+// Start returns the initial state, before any rune is read.
+func (a *Automaton) Start() int {
+	return 0
+}
+
+// Step returns the state reached from state after reading r, or Dead.
+func (a *Automaton) Step(state int, r rune) int {
+	if state == Dead {
+		return Dead
+	}
+	class, ok := a.classes[r]
+	if !ok {
+		class = len(a.classes)
+	}
+	return a.transitions[state][class]
+}
+
+// Accepts reports whether the string read so far to reach state is within
+// the maximum distance, and returns its distance to the word if so.
+func (a *Automaton) Accepts(state int) (int, bool) {
+	if state == Dead || a.distances[state] > a.maxDistance {
+		return 0, false
+	}
+	return a.distances[state], true
+}
+
+// Match returns the Levenshtein distance between s and the word, and
+// reports whether it is within the maximum distance.
+func (a *Automaton) Match(s string) (int, bool) {
+	state := a.Start()
+	for _, r := range s {
+		if state = a.Step(state, r); state == Dead {
+			return 0, false
+		}
+	}
+	return a.Accepts(state)
+}
@@ -0,0 +1,79 @@
+package levenshtein
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestAutomatonMatch(t *testing.T) {
+	a, err := NewAutomaton("kitten", 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		s        string
+		distance int
+		ok       bool
+	}{
+		{"kitten", 0, true},
+		{"sitten", 1, true},
+		{"sitting", 0, false},
+		{"kitte", 1, true},
+		{"kittens", 1, true},
+		{"itten", 1, true},
+		{"kitxyn", 2, true},
+		{"", 0, false},
+		{"dog", 0, false},
+	}
+	for _, test := range tests {
+		distance, ok := a.Match(test.s)
+		if ok != test.ok || distance != test.distance {
+			t.Errorf("Match(%q) = %d, %v, want %d, %v", test.s, distance, ok, test.distance, test.ok)
+		}
+	}
+
+	// Edits count runes, not bytes.
+	u, _ := NewAutomaton("naïve", 1)
+	if d, ok := u.Match("naive"); !ok || d != 1 {
+		t.Errorf("Match(naive) = %d, %v, want 1, true", d, ok)
+	}
+
+	if _, err := NewAutomaton("a", -1); err != ErrNegativeDistance {
+		t.Errorf("NewAutomaton with a negative distance: err = %v, want ErrNegativeDistance", err)
+	}
+}
+
+func TestAutomatonAgainstDistance(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	randomString := func(n int) string {
+		b := make([]byte, rnd.Intn(n+1))
+		for i := range b {
+			b[i] = "abcd"[rnd.Intn(4)]
+		}
+		return string(b)
+	}
+	for round := 0; round < 50; round++ {
+		word := randomString(8)
+		k := rnd.Intn(4)
+		a, _ := NewAutomaton(word, k)
+		for q := 0; q < 100; q++ {
+			s := randomString(10)
+			want := Distance(s, word, 1, 1, 1)
+			got, ok := a.Match(s)
+			if ok != (want <= k) || (ok && got != want) {
+				t.Fatalf("automaton of %q within %d: Match(%q) = %d, %v, want distance %d", word, k, s, got, ok, want)
+			}
+		}
+	}
+}
+
+func TestAutomatonStates(t *testing.T) {
+	// With distance 0 the automaton is a chain spelling the word.
+	a, _ := NewAutomaton("abc", 0)
+	if a.States() != 4 {
+		t.Errorf("States() = %d, want 4", a.States())
+	}
+	if a.Step(a.Start(), 'x') != Dead || a.Step(Dead, 'a') != Dead {
+		t.Errorf("reading a wrong rune should lead to Dead")
+	}
+}
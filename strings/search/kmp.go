@@ -0,0 +1,44 @@
+package search
+
+// FailureFunction returns the failure function of the Knuth-Morris-Pratt
+// algorithm: failure[i] is the length of the longest proper prefix of
+// pattern[:i+1] which is also a suffix of it.
+// O(m) where m=len(pattern)
+func FailureFunction(pattern string) []int {
+	failure := make([]int, len(pattern))
+	k := 0
+	for i := 1; i < len(pattern); i++ {
+		for k > 0 && pattern[i] != pattern[k] {
+			k = failure[k-1]
+		}
+		if pattern[i] == pattern[k] {
+			k++
+		}
+		failure[i] = k
+	}
+	return failure
+}
+
+// KMP is an implementation of the Knuth-Morris-Pratt string search.
+// O(n+m) where n=len(text) and m=len(pattern)
+func KMP(text string, pattern string) []int {
+	if len(pattern) == 0 {
+		return everyOffset(text)
+	}
+	var positions []int
+	failure := FailureFunction(pattern)
+	k := 0
+	for i := 0; i < len(text); i++ {
+		for k > 0 && text[i] != pattern[k] {
+			k = failure[k-1]
+		}
+		if text[i] == pattern[k] {
+			k++
+		}
+		if k == len(pattern) {
+			positions = append(positions, i-k+1)
+			k = failure[k-1]
+		}
+	}
+	return positions
+}
@@ -0,0 +1,25 @@
+package search
+
+// MatchFunc is the signature shared by every single-pattern search in this
+// package: it returns the offsets of all, possibly overlapping, occurrences
+// of pattern in text in increasing order, or nil if there is none.
+type MatchFunc func(text string, pattern string) []int
+
+// Verify that every implementation shares the common signature.
+var (
+	_ MatchFunc = Naive
+	_ MatchFunc = BoyerMoore
+	_ MatchFunc = KMP
+	_ MatchFunc = Z
+	_ MatchFunc = RabinKarp
+)
+
+// everyOffset is the result of searching for the empty pattern, which occurs
+// at every offset of text including the end, like in Naive.
+func everyOffset(text string) []int {
+	positions := make([]int, len(text)+1)
+	for i := range positions {
+		positions[i] = i
+	}
+	return positions
+}
@@ -0,0 +1,32 @@
+package search
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// benchmarkText is a long text over a small alphabet, which produces many
+// partial matches and is the worst case for naive scanning.
+func benchmarkText(n int) string {
+	rnd := rand.New(rand.NewSource(1))
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = "AB"[rnd.Intn(2)]
+	}
+	return string(b)
+}
+
+func benchmarkMatch(b *testing.B, match MatchFunc) {
+	text := benchmarkText(1 << 16)
+	pattern := text[1000:1032]
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		match(text, pattern)
+	}
+}
+
+func BenchmarkNaive(b *testing.B)      { benchmarkMatch(b, Naive) }
+func BenchmarkBoyerMoore(b *testing.B) { benchmarkMatch(b, BoyerMoore) }
+func BenchmarkKMP(b *testing.B)        { benchmarkMatch(b, KMP) }
+func BenchmarkZ(b *testing.B)          { benchmarkMatch(b, Z) }
+func BenchmarkRabinKarp(b *testing.B)  { benchmarkMatch(b, RabinKarp) }
@@ -70,3 +70,100 @@ func TestBooyerMoore(t *testing.T) {
 		})
 	}
 }
+
+func TestKMP(t *testing.T) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := KMP(tc.input, tc.pattern)
+			if !reflect.DeepEqual(actual, tc.expected) {
+				t.Errorf("Expected matches for pattern '%s' for string '%s' are: %v, but actual matches are: %v", tc.pattern, tc.input, tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestZ(t *testing.T) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := Z(tc.input, tc.pattern)
+			if !reflect.DeepEqual(actual, tc.expected) {
+				t.Errorf("Expected matches for pattern '%s' for string '%s' are: %v, but actual matches are: %v", tc.pattern, tc.input, tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestRabinKarp(t *testing.T) {
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := RabinKarp(tc.input, tc.pattern)
+			if !reflect.DeepEqual(actual, tc.expected) {
+				t.Errorf("Expected matches for pattern '%s' for string '%s' are: %v, but actual matches are: %v", tc.pattern, tc.input, tc.expected, actual)
+			}
+		})
+	}
+}
+
+func TestRabinKarpMulti(t *testing.T) {
+	patterns := []string{"ABC", "BC", "XYZ", "ABC", "DDEBCABCX"}
+	expected := [][]int{{4, 10, 18}, {5, 11, 16, 19}, nil, {4, 10, 18}, nil}
+	actual := RabinKarpMulti("ABAAABCDBBABCDDEBCABC", patterns)
+	if !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Expected matches %v, but actual matches are: %v", expected, actual)
+	}
+}
+
+func TestRollingHash(t *testing.T) {
+	text := "the quick brown fox jumps over the lazy dog, the quick brown cat"
+	const size = 5
+	r := NewRollingHash(size)
+	for i := 0; i < len(text); i++ {
+		got := r.Roll(text[i])
+		start := i + 1 - size
+		if start < 0 {
+			start = 0
+		}
+		if want := rollingHash(text[start:i+1], i+1-start).Sum(); got != want || r.Sum() != want {
+			t.Fatalf("hash after %q = %d, want the hash %d of %q", text[:i+1], got, want, text[start:i+1])
+		}
+	}
+	// equal windows hash alike wherever they are
+	a, b := rollingHash(text[4:], size).Sum(), rollingHash(text[49:], size).Sum()
+	if text[4:4+size] != text[49:49+size] || a != b {
+		t.Errorf("the windows %q and %q hash to %d and %d", text[4:4+size], text[49:49+size], a, b)
+	}
+}
+
+func TestFailureFunction(t *testing.T) {
+	expected := []int{0, 0, 1, 2, 0, 1, 2, 3, 4}
+	if actual := FailureFunction("ABABCABAB"); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Expected failure function %v, but got %v", expected, actual)
+	}
+}
+
+func TestZArray(t *testing.T) {
+	expected := []int{7, 0, 1, 0, 3, 0, 1}
+	if actual := ZArray("abacaba"); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("Expected Z-array %v, but got %v", expected, actual)
+	}
+}
+
+func TestEmptyPattern(t *testing.T) {
+	for name, match := range map[string]MatchFunc{"Naive": Naive, "KMP": KMP, "Z": Z, "RabinKarp": RabinKarp} {
+		if actual := match("AB", ""); !reflect.DeepEqual(actual, []int{0, 1, 2}) {
+			t.Errorf("%s: expected the empty pattern at every offset, got %v", name, actual)
+		}
+	}
+}
+
+func TestMatchFuncsAgree(t *testing.T) {
+	text := benchmarkText(2000)
+	for _, pattern := range []string{"A", "AB", "ABBA", "BABAB", text[100:140]} {
+		expected := Naive(text, pattern)
+		for name, match := range map[string]MatchFunc{"KMP": KMP, "Z": Z, "RabinKarp": RabinKarp} {
+			if actual := match(text, pattern); !reflect.DeepEqual(actual, expected) {
+				t.Errorf("%s disagrees with Naive for pattern '%s'", name, pattern)
+			}
+		}
+	}
+}
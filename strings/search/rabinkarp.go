@@ -0,0 +1,107 @@
+package search
+
+// base and modulus of the polynomial rolling hash used by Rabin-Karp.
+const (
+	rabinKarpBase    = 256
+	rabinKarpModulus = 1_000_000_007
+)
+
+// RollingHash is the polynomial hash of the last bytes of a stream, in a
+// window of fixed size, updated in O(1) as the window slides by a byte. It
+// is the hash Rabin-Karp compares, and can cut a stream where the content
+// matches, as in content-defined chunking. Before the window is full, the
+// hash is that of the bytes seen so far.
+type RollingHash struct {
+	window []byte // the last bytes, as a ring starting at next
+	next   int    // index in window of the oldest byte, replaced next
+	sum    uint64
+	pow    uint64 // rabinKarpBase^len(window), the weight of the byte leaving
+}
+
+// NewRollingHash returns the hash of an empty stream, with a window of size
+// bytes, which must be positive.
+func NewRollingHash(size int) *RollingHash {
+	pow := uint64(1)
+	for i := 0; i < size; i++ {
+		pow = pow * rabinKarpBase % rabinKarpModulus
+	}
+	return &RollingHash{window: make([]byte, size), pow: pow}
+}
+
+// Roll slides the window over the next byte b of the stream and returns the
+// new hash.
+func (r *RollingHash) Roll(b byte) uint64 {
+	out := uint64(r.window[r.next])
+	r.window[r.next] = b
+	r.next = (r.next + 1) % len(r.window)
+	r.sum = (r.sum*rabinKarpBase + uint64(b)) % rabinKarpModulus
+	r.sum = (r.sum + rabinKarpModulus - out*r.pow%rabinKarpModulus) % rabinKarpModulus
+	return r.sum
+}
+
+// Sum returns the hash of the bytes in the window.
+func (r *RollingHash) Sum() uint64 {
+	return r.sum
+}
+
+// rollingHash returns the hash of the first size bytes of s, ready to slide
+// further along s.
+func rollingHash(s string, size int) *RollingHash {
+	r := NewRollingHash(size)
+	for i := 0; i < size; i++ {
+		r.Roll(s[i])
+	}
+	return r
+}
+
+// RabinKarp is an implementation of the Rabin-Karp string search, which
+// compares rolling hashes of text windows with the hash of pattern and only
+// checks the bytes when the hashes are equal.
+// O(n+m) expected where n=len(text) and m=len(pattern)
+func RabinKarp(text string, pattern string) []int {
+	if len(pattern) == 0 {
+		return everyOffset(text)
+	}
+	return RabinKarpMulti(text, []string{pattern})[0]
+}
+
+// RabinKarpMulti searches text for several patterns in one pass per distinct
+// pattern length. result[i] holds the offsets of patterns[i] as RabinKarp
+// would return them.
+// O(k*n + M) expected where k=number of distinct lengths and M=total length of patterns
+func RabinKarpMulti(text string, patterns []string) [][]int {
+	result := make([][]int, len(patterns))
+	// group patterns by length, then by hash
+	byLength := make(map[int]map[uint64][]int)
+	for i, p := range patterns {
+		if len(p) == 0 {
+			result[i] = everyOffset(text)
+			continue
+		}
+		if len(p) > len(text) {
+			continue
+		}
+		h := rollingHash(p, len(p)).Sum()
+		if byLength[len(p)] == nil {
+			byLength[len(p)] = make(map[uint64][]int)
+		}
+		byLength[len(p)][h] = append(byLength[len(p)][h], i)
+	}
+
+	for m, byHash := range byLength {
+		r := rollingHash(text, m)
+		for i := 0; ; i++ {
+			for _, p := range byHash[r.Sum()] {
+				if text[i:i+m] == patterns[p] {
+					result[p] = append(result[p], i)
+				}
+			}
+			if i+m == len(text) {
+				break
+			}
+			// slide the window one byte to the right
+			r.Roll(text[i+m])
+		}
+	}
+	return result
+}
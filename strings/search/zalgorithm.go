@@ -0,0 +1,69 @@
+package search
+
+// ZArray returns the Z-array of s: z[i] is the length of the longest common
+// prefix of s and s[i:]. By convention z[0] is len(s).
+// O(n) where n=len(s)
+func ZArray(s string) []int {
+	z := make([]int, len(s))
+	if len(s) == 0 {
+		return z
+	}
+	z[0] = len(s)
+	// [l, r) is the rightmost segment known to match a prefix of s.
+	l, r := 0, 0
+	for i := 1; i < len(s); i++ {
+		if i < r {
+			z[i] = r - i
+			if z[i-l] < z[i] {
+				z[i] = z[i-l]
+			}
+		}
+		for i+z[i] < len(s) && s[z[i]] == s[i+z[i]] {
+			z[i]++
+		}
+		if i+z[i] > r {
+			l, r = i, i+z[i]
+		}
+	}
+	return z
+}
+
+// Z is a string search built on the Z-array of pattern+text. The two are
+// compared by index instead of being joined with a separator byte, so any
+// byte may appear in either string.
+// O(n+m) where n=len(text) and m=len(pattern)
+func Z(text string, pattern string) []int {
+	m := len(pattern)
+	if m == 0 {
+		return everyOffset(text)
+	}
+	at := func(i int) byte {
+		if i < m {
+			return pattern[i]
+		}
+		return text[i-m]
+	}
+	n := m + len(text)
+	z := make([]int, n)
+	var positions []int
+	l, r := 0, 0
+	for i := 1; i < n; i++ {
+		if i < r {
+			z[i] = r - i
+			if z[i-l] < z[i] {
+				z[i] = z[i-l]
+			}
+		}
+		// a match never extends past the pattern itself
+		for z[i] < m && i+z[i] < n && at(z[i]) == at(i+z[i]) {
+			z[i]++
+		}
+		if i+z[i] > r {
+			l, r = i, i+z[i]
+		}
+		if i >= m && z[i] == m {
+			positions = append(positions, i-m)
+		}
+	}
+	return positions
+}
@@ -0,0 +1,111 @@
+// lcpindex.go
+// description: Constant time longest common prefix queries between suffixes
+// details:
+// The longest common prefix of two suffixes is the minimum of the LCP array
+// between their positions in the suffix array. A sparse table holding the
+// minimum of every range of 2^k entries answers it with two overlapping
+// ranges. Comparing two substrings then takes O(1): past their common prefix,
+// they are ordered by the first differing byte, or by length when one is a
+// prefix of the other, so substrings can be sorted with O(1) comparisons.
+// Build: O(n log n)
+// LCP, Compare: O(1)
+// SortSubstrings: O(k log k) for k substrings
+// reference: https://cp-algorithms.com/data_structures/sparse-table.html
+// see lcpindex_test.go
+
+package suffixarray
+
+import (
+	"math/bits"
+	"sort"
+)
+
+// Substring is the part text[Start:End] of the indexed text.
+type Substring struct {
+	Start, End int
+}
+
+// LCPIndex answers longest common prefix queries between suffixes of a text.
+type LCPIndex struct {
+	s    *SuffixArray
+	rank []int // rank[p] is the position of the suffix p in the suffix array
+	// table[k][i] is the minimum of LCP()[i : i+2^k]
+	table [][]int
+}
+
+// NewLCPIndex builds the LCP index of the text of s.
+func NewLCPIndex(s *SuffixArray) *LCPIndex {
+	n := len(s.sa)
+	x := &LCPIndex{s: s, rank: make([]int, n)}
+	for i, p := range s.sa {
+		x.rank[p] = i
+	}
+	x.table = append(x.table, s.lcp)
+	for k := 1; 1<<k <= n; k++ {
+		previous, half := x.table[k-1], 1<<(k-1)
+		level := make([]int, n-1<<k+1)
+		for i := range level {
+			level[i] = previous[i]
+			if previous[i+half] < level[i] {
+				level[i] = previous[i+half]
+			}
+		}
+		x.table = append(x.table, level)
+	}
+	return x
+}
+
+// LCP returns the length of the longest common prefix of the suffixes
+// starting at offsets i and j, which must be between 0 and the length of the
+// text.
+func (x *LCPIndex) LCP(i, j int) int {
+	n := len(x.s.text)
+	if i == j {
+		return n - i
+	}
+	if i == n || j == n {
+		return 0
+	}
+	lo, hi := x.rank[i], x.rank[j]
+	if lo > hi {
+		lo, hi = hi, lo
+	}
+	// minimum of lcp[lo+1 : hi+1]
+	lo++
+	k := bits.Len(uint(hi-lo+1)) - 1
+	a, b := x.table[k][lo], x.table[k][hi+1-1<<k]
+	if b < a {
+		return b
+	}
+	return a
+}
+
+// Compare returns -1, 0 or 1 as the substring a is lexicographically smaller
+// than, equal to or greater than the substring b.
+func (x *LCPIndex) Compare(a, b Substring) int {
+	la, lb := a.End-a.Start, b.End-b.Start
+	common := x.LCP(a.Start, b.Start)
+	if common >= la || common >= lb {
+		// one is a prefix of the other
+		switch {
+		case la < lb:
+			return -1
+		case la > lb:
+			return 1
+		}
+		return 0
+	}
+	// they differ within both, where the suffixes differ
+	if x.rank[a.Start] < x.rank[b.Start] {
+		return -1
+	}
+	return 1
+}
+
+// SortSubstrings sorts substrings in lexicographic order of their content,
+// keeping the order of equal substrings.
+func (x *LCPIndex) SortSubstrings(substrings []Substring) {
+	sort.SliceStable(substrings, func(i, j int) bool {
+		return x.Compare(substrings[i], substrings[j]) < 0
+	})
+}
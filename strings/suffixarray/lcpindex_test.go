@@ -0,0 +1,81 @@
+package suffixarray
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+// randomText returns n bytes drawn from the first letters of the alphabet.
+func randomText(rnd *rand.Rand, n, letters int) string {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = byte('a' + rnd.Intn(letters))
+	}
+	return string(b)
+}
+
+func TestLCPIndex(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, text := range []string{"", "a", "banana", "abababab", randomText(rnd, 300, 2), randomText(rnd, 500, 4)} {
+		x := NewLCPIndex(New(text))
+		for i := 0; i <= len(text); i++ {
+			for j := 0; j <= len(text); j++ {
+				want := 0
+				for i+want < len(text) && j+want < len(text) && text[i+want] == text[j+want] {
+					want++
+				}
+				if got := x.LCP(i, j); got != want {
+					t.Fatalf("LCP(%d, %d) of %q = %d, want %d", i, j, text, got, want)
+				}
+			}
+		}
+	}
+}
+
+func TestSortSubstrings(t *testing.T) {
+	rnd := rand.New(rand.NewSource(2))
+	text := randomText(rnd, 400, 3)
+	x := NewLCPIndex(New(text))
+	substrings := make([]Substring, 2000)
+	for i := range substrings {
+		start := rnd.Intn(len(text) + 1)
+		substrings[i] = Substring{start, start + rnd.Intn(len(text)-start+1)}
+		if i%10 == 0 {
+			substrings[i].End = start // some empty ones
+		}
+	}
+	want := append([]Substring(nil), substrings...)
+	sort.SliceStable(want, func(i, j int) bool {
+		return text[want[i].Start:want[i].End] < text[want[j].Start:want[j].End]
+	})
+	x.SortSubstrings(substrings)
+	for i := range want {
+		if substrings[i] != want[i] {
+			t.Fatalf("position %d: got %v, want %v", i, substrings[i], want[i])
+		}
+	}
+
+	for _, test := range []struct {
+		a, b Substring
+		want int
+	}{
+		{Substring{1, 3}, Substring{3, 5}, 0},  // "an", "an" of banana
+		{Substring{1, 3}, Substring{1, 4}, -1}, // "an" < "ana"
+		{Substring{0, 1}, Substring{5, 6}, 1},  // "b" > "a"
+		{Substring{2, 2}, Substring{0, 0}, 0},  // empty
+		{Substring{2, 4}, Substring{0, 6}, 1},  // "na" > "banana"
+	} {
+		if got := NewLCPIndex(New("banana")).Compare(test.a, test.b); got != test.want {
+			t.Errorf("Compare(%v, %v) = %d, want %d", test.a, test.b, got, test.want)
+		}
+	}
+}
+
+func BenchmarkLCPIndex(b *testing.B) {
+	text := randomText(rand.New(rand.NewSource(3)), 100000, 4)
+	s := New(text)
+	for i := 0; i < b.N; i++ {
+		NewLCPIndex(s)
+	}
+}
@@ -0,0 +1,189 @@
+// suffixarray.go
+// description: Suffix array and LCP array construction with substring search
+// details:
+// The suffix array of a text is the list of starting positions of all of its
+// suffixes, sorted lexicographically. It is built here by prefix doubling:
+// suffixes are ranked by their first 2^k bytes, and the ranks of round k are
+// combined pairwise to get the ranks of round k+1, using a counting sort on
+// every round. The LCP array is then computed with Kasai's algorithm.
+// All occurrences of a pattern form a contiguous block of the suffix array,
+// which is located with two binary searches.
+// Build: O(n log n)
+// LCP: O(n)
+// Search: O(m log n), m being the length of the pattern
+// reference: https://cp-algorithms.com/string/suffix-array.html
+// see suffixarray_test.go
+
+// Package suffixarray implements suffix array construction, Kasai's LCP
+// array, binary-search based substring lookup and constant time LCP queries
+// between suffixes.
+package suffixarray
+
+import "sort"
+
+// SuffixArray is a text indexed by its sorted suffixes.
+type SuffixArray struct {
+	text []byte
+	sa   []int
+	lcp  []int
+}
+
+// New builds the suffix array and the LCP array of text.
+func New(text string) *SuffixArray {
+	s := &SuffixArray{text: []byte(text)}
+	s.sa = build(s.text)
+	s.lcp = kasai(s.text, s.sa)
+	return s
+}
+
+// Suffixes returns the suffix array: the starting offsets of the suffixes of
+// the text in lexicographic order. The returned slice must not be modified.
+func (s *SuffixArray) Suffixes() []int {
+	return s.sa
+}
+
+// LCP returns the LCP array, where LCP()[i] is the length of the longest
+// common prefix of the suffixes starting at Suffixes()[i-1] and Suffixes()[i].
+// LCP()[0] is always 0. The returned slice must not be modified.
+func (s *SuffixArray) LCP() []int {
+	return s.lcp
+}
+
+// Search returns the offsets of every occurrence of pattern in the text in
+// increasing order. An empty pattern matches nothing.
+func (s *SuffixArray) Search(pattern string) []int {
+	if len(pattern) == 0 {
+		return nil
+	}
+	n := len(s.sa)
+	// first suffix that is not smaller than pattern
+	lo := sort.Search(n, func(i int) bool {
+		return compare(s.text[s.sa[i]:], pattern) >= 0
+	})
+	// first suffix that does not start with pattern
+	hi := lo + sort.Search(n-lo, func(i int) bool {
+		return compare(s.text[s.sa[lo+i]:], pattern) > 0
+	})
+	if lo == hi {
+		return nil
+	}
+	result := append([]int(nil), s.sa[lo:hi]...)
+	sort.Ints(result)
+	return result
+}
+
+// compare compares pattern with the prefix of suffix of the same length.
+func compare(suffix []byte, pattern string) int {
+	for i := 0; i < len(pattern); i++ {
+		if i == len(suffix) {
+			return -1
+		}
+		if suffix[i] != pattern[i] {
+			if suffix[i] < pattern[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+// build sorts the suffixes of text by prefix doubling.
+func build(text []byte) []int {
+	n := len(text)
+	if n == 0 {
+		return []int{}
+	}
+	sa := make([]int, n)
+	rank := make([]int, n)
+	tmp := make([]int, n)
+
+	// Initial ranking by single bytes.
+	for i := range sa {
+		sa[i] = i
+		rank[i] = int(text[i])
+	}
+	sort.Slice(sa, func(i, j int) bool { return text[sa[i]] < text[sa[j]] })
+
+	classes := 256
+	for k := 1; ; k <<= 1 {
+		// Order suffixes by the second half first: suffixes shorter than k
+		// have an empty second half and come first, the rest follow the
+		// order of sa shifted by k.
+		second := make([]int, 0, n)
+		for i := n - k; i < n; i++ {
+			if i >= 0 {
+				second = append(second, i)
+			}
+		}
+		for _, p := range sa {
+			if p >= k {
+				second = append(second, p-k)
+			}
+		}
+
+		// Stable counting sort by the rank of the first half.
+		count := make([]int, classes+1)
+		for _, r := range rank {
+			count[r+1]++
+		}
+		for i := 1; i <= classes; i++ {
+			count[i] += count[i-1]
+		}
+		for _, p := range second {
+			sa[count[rank[p]]] = p
+			count[rank[p]]++
+		}
+
+		// Re-rank the pairs.
+		tmp[sa[0]] = 0
+		for i := 1; i < n; i++ {
+			tmp[sa[i]] = tmp[sa[i-1]]
+			if pairRank(rank, sa[i-1], k) != pairRank(rank, sa[i], k) {
+				tmp[sa[i]]++
+			}
+		}
+		rank, tmp = tmp, rank
+		classes = rank[sa[n-1]] + 1
+		if classes == n {
+			break
+		}
+	}
+	return sa
+}
+
+// pairRank returns the ranks of the two halves of the 2k-prefix at p.
+// A missing second half is ranked -1 so that it sorts first.
+func pairRank(rank []int, p, k int) [2]int {
+	second := -1
+	if p+k < len(rank) {
+		second = rank[p+k]
+	}
+	return [2]int{rank[p], second}
+}
+
+// kasai computes the LCP array of text from its suffix array.
+func kasai(text []byte, sa []int) []int {
+	n := len(text)
+	lcp := make([]int, n)
+	rank := make([]int, n)
+	for i, p := range sa {
+		rank[p] = i
+	}
+	h := 0
+	for p := 0; p < n; p++ {
+		if rank[p] == 0 {
+			h = 0
+			continue
+		}
+		q := sa[rank[p]-1]
+		for p+h < n && q+h < n && text[p+h] == text[q+h] {
+			h++
+		}
+		lcp[rank[p]] = h
+		if h > 0 {
+			h--
+		}
+	}
+	return lcp
+}
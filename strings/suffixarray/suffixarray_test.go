@@ -0,0 +1,92 @@
+package suffixarray
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestBanana(t *testing.T) {
+	s := New("banana")
+	if got, want := s.Suffixes(), []int{5, 3, 1, 0, 4, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Suffixes() = %v, want %v", got, want)
+	}
+	if got, want := s.LCP(), []int{0, 1, 3, 0, 0, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("LCP() = %v, want %v", got, want)
+	}
+
+	tests := []struct {
+		pattern string
+		want    []int
+	}{
+		{"ana", []int{1, 3}},
+		{"a", []int{1, 3, 5}},
+		{"banana", []int{0}},
+		{"bananas", nil},
+		{"nab", nil},
+		{"", nil},
+	}
+	for _, test := range tests {
+		if got := s.Search(test.pattern); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Search(%q) = %v, want %v", test.pattern, got, test.want)
+		}
+	}
+}
+
+func TestEmpty(t *testing.T) {
+	s := New("")
+	if len(s.Suffixes()) != 0 || len(s.LCP()) != 0 {
+		t.Errorf("empty text should have empty arrays")
+	}
+	if got := s.Search("a"); got != nil {
+		t.Errorf("Search on empty text = %v, want nil", got)
+	}
+}
+
+func TestAgainstBruteForce(t *testing.T) {
+	rnd := rand.New(rand.NewSource(3))
+	for round := 0; round < 50; round++ {
+		b := make([]byte, 1+rnd.Intn(200))
+		for i := range b {
+			b[i] = "abc"[rnd.Intn(3)]
+		}
+		text := string(b)
+		s := New(text)
+
+		want := make([]int, len(text))
+		for i := range want {
+			want[i] = i
+		}
+		sort.Slice(want, func(i, j int) bool { return text[want[i]:] < text[want[j]:] })
+		if !reflect.DeepEqual(s.Suffixes(), want) {
+			t.Fatalf("Suffixes(%q) = %v, want %v", text, s.Suffixes(), want)
+		}
+
+		for i := 1; i < len(want); i++ {
+			a, b := text[want[i-1]:], text[want[i]:]
+			h := 0
+			for h < len(a) && h < len(b) && a[h] == b[h] {
+				h++
+			}
+			if s.LCP()[i] != h {
+				t.Fatalf("LCP(%q)[%d] = %d, want %d", text, i, s.LCP()[i], h)
+			}
+		}
+
+		pattern := text[rnd.Intn(len(text)):]
+		if len(pattern) > 4 {
+			pattern = pattern[:4]
+		}
+		var occurrences []int
+		for i := 0; i+len(pattern) <= len(text); i++ {
+			if strings.HasPrefix(text[i:], pattern) {
+				occurrences = append(occurrences, i)
+			}
+		}
+		if got := s.Search(pattern); !reflect.DeepEqual(got, occurrences) {
+			t.Fatalf("Search(%q) in %q = %v, want %v", pattern, text, got, occurrences)
+		}
+	}
+}
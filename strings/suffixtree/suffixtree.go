@@ -0,0 +1,279 @@
+// suffixtree.go
+// description: Suffix tree built online with Ukkonen's algorithm
+// details:
+// A suffix tree stores every suffix of a text in a compressed trie whose edges
+// are labelled by ranges of the text. Ukkonen's algorithm adds one character at
+// a time and keeps the tree valid for the text read so far. Three tricks make
+// it linear: leaf edges end at a shared, ever growing end, so they extend for
+// free; the active point remembers where the next insertion happens; and
+// suffix links jump from the node of a string xα to the node of α instead of
+// walking down again from the root.
+// The tree is kept implicit, without a terminator, so characters can be
+// appended at any time. Suffixes that also occur elsewhere then end inside
+// the tree instead of at a leaf, and the longest one is the active point.
+// Build: O(n) for a constant alphabet, amortized O(1) per appended character
+// Contains: O(m), m being the length of the pattern
+// LongestRepeatedSubstring, LongestCommonSubstring: O(n)
+// reference: Ukkonen, E. "On-line construction of suffix trees", Algorithmica 1995
+// https://en.wikipedia.org/wiki/Ukkonen%27s_algorithm
+// see suffixtree_test.go
+
+// Package suffixtree implements Ukkonen's online suffix tree construction
+// with substring search, longest repeated substring and longest common
+// substring queries.
+package suffixtree
+
+import "sort"
+
+// leafEnd marks a leaf, whose edge ends at the current end of the text.
+const leafEnd = -1
+
+const root = 0
+
+type node struct {
+	start, end int // the edge into the node is labelled text[start..end]
+	link       int // suffix link, internal nodes only
+	suffix     int // start of the suffix spelled out by a leaf
+	children   map[int]int
+}
+
+// tree is the suffix tree of a sequence of symbols. Bytes are symbols 0 to 255
+// and larger values serve as unique terminators.
+type tree struct {
+	text  []int
+	nodes []node
+
+	// The active point: the insertion position is activeLength symbols
+	// along the edge starting with text[activeEdge] below activeNode.
+	activeNode, activeEdge, activeLength int
+	// remaining is the number of suffixes still to be inserted explicitly.
+	remaining int
+}
+
+func newTree() *tree {
+	return &tree{nodes: []node{{start: -1, end: -1, children: map[int]int{}}}}
+}
+
+func (t *tree) newNode(start, end, suffix int) int {
+	t.nodes = append(t.nodes, node{start: start, end: end, link: root, suffix: suffix, children: map[int]int{}})
+	return len(t.nodes) - 1
+}
+
+// edgeLength returns the length of the label of the edge into n.
+func (t *tree) edgeLength(n int) int {
+	end := t.nodes[n].end
+	if end == leafEnd {
+		end = len(t.text) - 1
+	}
+	return end - t.nodes[n].start + 1
+}
+
+// extend appends symbol c to the text and updates the tree.
+func (t *tree) extend(c int) {
+	t.text = append(t.text, c)
+	pos := len(t.text) - 1
+	t.remaining++
+	lastInternal := -1
+	for t.remaining > 0 {
+		if t.activeLength == 0 {
+			t.activeEdge = pos
+		}
+		next, ok := t.nodes[t.activeNode].children[t.text[t.activeEdge]]
+		if !ok {
+			leaf := t.newNode(pos, leafEnd, pos-t.remaining+1)
+			t.nodes[t.activeNode].children[t.text[t.activeEdge]] = leaf
+			if lastInternal >= 0 {
+				t.nodes[lastInternal].link = t.activeNode
+				lastInternal = -1
+			}
+		} else {
+			// Walk down when the active point is past the end of the edge.
+			if length := t.edgeLength(next); t.activeLength >= length {
+				t.activeEdge += length
+				t.activeLength -= length
+				t.activeNode = next
+				continue
+			}
+			// The suffix is already in the tree: stop for this round.
+			if t.text[t.nodes[next].start+t.activeLength] == c {
+				if lastInternal >= 0 && t.activeNode != root {
+					t.nodes[lastInternal].link = t.activeNode
+				}
+				t.activeLength++
+				break
+			}
+			// Split the edge and hang a new leaf on the split.
+			start := t.nodes[next].start
+			split := t.newNode(start, start+t.activeLength-1, 0)
+			t.nodes[t.activeNode].children[t.text[t.activeEdge]] = split
+			t.nodes[split].children[c] = t.newNode(pos, leafEnd, pos-t.remaining+1)
+			t.nodes[next].start += t.activeLength
+			t.nodes[split].children[t.text[t.nodes[next].start]] = next
+			if lastInternal >= 0 {
+				t.nodes[lastInternal].link = split
+			}
+			lastInternal = split
+		}
+		t.remaining--
+		if t.activeNode == root && t.activeLength > 0 {
+			t.activeLength--
+			t.activeEdge = pos - t.remaining + 1
+		} else if t.activeNode != root {
+			t.activeNode = t.nodes[t.activeNode].link
+		}
+	}
+}
+
+// sortedChildren returns the children of n ordered by their first symbol.
+func (t *tree) sortedChildren(n int) []int {
+	keys := make([]int, 0, len(t.nodes[n].children))
+	for k := range t.nodes[n].children {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+	children := make([]int, len(keys))
+	for i, k := range keys {
+		children[i] = t.nodes[n].children[k]
+	}
+	return children
+}
+
+// SuffixTree is the suffix tree of a byte string that can be extended
+// one byte at a time.
+type SuffixTree struct {
+	t *tree
+}
+
+// New builds the suffix tree of text.
+func New(text string) *SuffixTree {
+	s := &SuffixTree{t: newTree()}
+	for i := 0; i < len(text); i++ {
+		s.t.extend(int(text[i]))
+	}
+	return s
+}
+
+// Append adds b at the end of the text.
+func (s *SuffixTree) Append(b byte) {
+	s.t.extend(int(b))
+}
+
+// Len returns the length of the text.
+func (s *SuffixTree) Len() int {
+	return len(s.t.text)
+}
+
+// Contains reports whether pattern is a substring of the text.
+func (s *SuffixTree) Contains(pattern string) bool {
+	t := s.t
+	n, offset := root, 0 // offset symbols matched along the edge into n
+	for i := 0; i < len(pattern); i++ {
+		c := int(pattern[i])
+		if offset == 0 || offset == t.edgeLength(n) {
+			next, ok := t.nodes[n].children[c]
+			if !ok {
+				return false
+			}
+			n, offset = next, 1
+			continue
+		}
+		if t.text[t.nodes[n].start+offset] != c {
+			return false
+		}
+		offset++
+	}
+	return true
+}
+
+// LongestRepeatedSubstring returns the longest substring occurring at least
+// twice in the text, occurrences being allowed to overlap. The
+// lexicographically smallest one is returned on ties.
+func (s *SuffixTree) LongestRepeatedSubstring() string {
+	t := s.t
+	best, bestStart := 0, 0
+	consider := func(depth, start int) {
+		if depth > best || (depth == best && depth > 0 && string(toBytes(t.text[start:start+depth])) < string(toBytes(t.text[bestStart:bestStart+best]))) {
+			best, bestStart = depth, start
+		}
+	}
+
+	// Internal nodes are the branching, hence repeated, substrings. A
+	// substring repeated without branching runs into the end of the text, so
+	// it is a repeated suffix, the longest of which is the active point.
+	var activeDepth int
+	var walk func(n, depth int) int
+	walk = func(n, depth int) int {
+		if n == t.activeNode {
+			activeDepth = depth + t.activeLength
+		}
+		if len(t.nodes[n].children) == 0 {
+			return t.nodes[n].suffix
+		}
+		start := -1
+		for _, child := range t.sortedChildren(n) {
+			if leaf := walk(child, depth+t.edgeLength(child)); start < 0 {
+				start = leaf
+			}
+		}
+		if n != root {
+			consider(depth, start)
+		}
+		return start
+	}
+	walk(root, 0)
+	consider(activeDepth, len(t.text)-activeDepth)
+	return string(toBytes(t.text[bestStart : bestStart+best]))
+}
+
+// LongestCommonSubstring returns the longest string that is a substring of
+// both a and b, the lexicographically smallest one on ties. It builds the
+// generalized suffix tree of a and b, the suffix tree of a#b$ with two unique
+// terminators, where the common substrings are the nodes having leaves of
+// suffixes from both strings below them.
+func LongestCommonSubstring(a, b string) string {
+	t := newTree()
+	for i := 0; i < len(a); i++ {
+		t.extend(int(a[i]))
+	}
+	t.extend(256)
+	for i := 0; i < len(b); i++ {
+		t.extend(int(b[i]))
+	}
+	t.extend(257)
+
+	best, bestStart := 0, 0
+	// walk returns a mask of the strings whose suffixes end below n, 1 for a
+	// and 2 for b, along with the start of one such suffix.
+	var walk func(n, depth int) (int, int)
+	walk = func(n, depth int) (int, int) {
+		if len(t.nodes[n].children) == 0 {
+			if t.nodes[n].suffix <= len(a) {
+				return 1, t.nodes[n].suffix
+			}
+			return 2, t.nodes[n].suffix
+		}
+		mask, start := 0, -1
+		for _, child := range t.sortedChildren(n) {
+			m, leaf := walk(child, depth+t.edgeLength(child))
+			mask |= m
+			if start < 0 {
+				start = leaf
+			}
+		}
+		if mask == 3 && depth > best {
+			best, bestStart = depth, start
+		}
+		return mask, start
+	}
+	walk(root, 0)
+	return string(toBytes(t.text[bestStart : bestStart+best]))
+}
+
+// toBytes converts symbols below 256 back to bytes.
+func toBytes(symbols []int) []byte {
+	b := make([]byte, len(symbols))
+	for i, c := range symbols {
+		b[i] = byte(c)
+	}
+	return b
+}
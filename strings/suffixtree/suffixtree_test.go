@@ -0,0 +1,141 @@
+package suffixtree
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func bruteLongestRepeated(text string) string {
+	for length := len(text) - 1; length > 0; length-- {
+		best := ""
+		for i := 0; i+length <= len(text); i++ {
+			s := text[i : i+length]
+			if strings.Index(text[i+1:], s) >= 0 || strings.Index(text, s) < i {
+				if best == "" || s < best {
+					best = s
+				}
+			}
+		}
+		if best != "" {
+			return best
+		}
+	}
+	return ""
+}
+
+func bruteLongestCommon(a, b string) string {
+	for length := len(a); length > 0; length-- {
+		best := ""
+		for i := 0; i+length <= len(a); i++ {
+			if s := a[i : i+length]; strings.Contains(b, s) && (best == "" || s < best) {
+				best = s
+			}
+		}
+		if best != "" {
+			return best
+		}
+	}
+	return ""
+}
+
+func TestBanana(t *testing.T) {
+	s := New("banana")
+	for _, p := range []string{"", "b", "ban", "anan", "nana", "banana", "a"} {
+		if !s.Contains(p) {
+			t.Errorf("Contains(%q) = false, want true", p)
+		}
+	}
+	for _, p := range []string{"bananas", "nab", "aa", "c", "nb"} {
+		if s.Contains(p) {
+			t.Errorf("Contains(%q) = true, want false", p)
+		}
+	}
+	if got := s.LongestRepeatedSubstring(); got != "ana" {
+		t.Errorf("LongestRepeatedSubstring() = %q, want %q", got, "ana")
+	}
+	if s.Len() != 6 {
+		t.Errorf("Len() = %d, want 6", s.Len())
+	}
+}
+
+func TestLongestRepeatedSubstring(t *testing.T) {
+	tests := map[string]string{
+		"":                "",
+		"a":               "",
+		"abc":             "",
+		"aaaa":            "aaa",
+		"abcabc":          "abc",
+		"mississippi":     "issi",
+		"abcpqrabpqpq":    "ab",
+		"GEEKSFORGEEKS":   "GEEKS",
+		"xabxac":          "xa",
+		"abcdefabcdefabc": "abcdefabc",
+	}
+	for text, want := range tests {
+		if got := New(text).LongestRepeatedSubstring(); got != want {
+			t.Errorf("LongestRepeatedSubstring(%q) = %q, want %q", text, got, want)
+		}
+	}
+}
+
+func TestLongestCommonSubstring(t *testing.T) {
+	tests := []struct{ a, b, want string }{
+		{"xabxac", "abcabxabcd", "abxa"},
+		{"GeeksforGeeks", "GeeksQuiz", "Geeks"},
+		{"abc", "def", ""},
+		{"", "abc", ""},
+		{"same", "same", "same"},
+		{"ab", "ba", "a"},
+	}
+	for _, test := range tests {
+		if got := LongestCommonSubstring(test.a, test.b); got != test.want {
+			t.Errorf("LongestCommonSubstring(%q, %q) = %q, want %q", test.a, test.b, got, test.want)
+		}
+	}
+}
+
+func TestAgainstBruteForce(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	randomString := func(n int) string {
+		b := make([]byte, rnd.Intn(n+1))
+		for i := range b {
+			b[i] = "abc"[rnd.Intn(3)]
+		}
+		return string(b)
+	}
+	for round := 0; round < 200; round++ {
+		text := randomString(40)
+		// Grow the tree online and check it after every byte.
+		s := New("")
+		for i := 0; i < len(text); i++ {
+			s.Append(text[i])
+			prefix := text[:i+1]
+			if got, want := s.LongestRepeatedSubstring(), bruteLongestRepeated(prefix); got != want {
+				t.Fatalf("LongestRepeatedSubstring(%q) = %q, want %q", prefix, got, want)
+			}
+		}
+		for q := 0; q < 20; q++ {
+			p := randomString(6)
+			if got, want := s.Contains(p), strings.Contains(text, p); got != want {
+				t.Fatalf("Contains(%q) in %q = %v, want %v", p, text, got, want)
+			}
+		}
+		other := randomString(30)
+		if got, want := LongestCommonSubstring(text, other), bruteLongestCommon(text, other); got != want {
+			t.Fatalf("LongestCommonSubstring(%q, %q) = %q, want %q", text, other, got, want)
+		}
+	}
+}
+
+func BenchmarkNew(b *testing.B) {
+	rnd := rand.New(rand.NewSource(1))
+	text := make([]byte, 1<<16)
+	for i := range text {
+		text[i] = "acgt"[rnd.Intn(4)]
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		New(string(text))
+	}
+}
@@ -0,0 +1,95 @@
+// render.go
+// description: Wrapping and justifying text along the breaks of Greedy or KnuthPlass
+// details:
+// The text is split into words at white space, the words are broken into
+// lines and every line is rendered either ragged, with single spaces, or
+// justified, with the spaces of the line widened until it reaches the width.
+// Justified lines share the extra spaces as evenly as possible, the leftmost
+// gaps getting one more; the last line and lines with a single word stay
+// ragged. Widths are counted in runes.
+// time complexity: O(n + l*w) for KnuthPlass, O(n + l) for greedy, n being
+// the length of the text, l the number of words and w the width
+// space complexity: O(n)
+// reference: https://en.wikipedia.org/wiki/Typographic_alignment#Justified
+// see render_test.go
+
+package wordwrap
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// split returns the words of text and their widths in runes.
+func split(text string) ([]string, []int) {
+	words := strings.Fields(text)
+	lengths := make([]int, len(words))
+	for i, w := range words {
+		lengths[i] = utf8.RuneCountInString(w)
+	}
+	return words, lengths
+}
+
+// lines breaks text into lines no wider than width, with KnuthPlass if
+// balanced is true and Greedy otherwise, and justifies them if asked to.
+func lines(text string, width int, balanced, justify bool) []string {
+	words, lengths := split(text)
+	var breaks []int
+	if balanced {
+		breaks, _ = KnuthPlass(lengths, width)
+	} else {
+		breaks = Greedy(lengths, width)
+	}
+	result := make([]string, len(breaks))
+	start := 0
+	for k, end := range breaks {
+		if justify && k < len(breaks)-1 && end-start > 1 {
+			result[k] = justifyLine(words[start:end], width-lineWidth(lengths, start, end))
+		} else {
+			result[k] = strings.Join(words[start:end], " ")
+		}
+		start = end
+	}
+	return result
+}
+
+// justifyLine joins words, adding extra spaces to their gaps from the left.
+func justifyLine(words []string, extra int) string {
+	gaps := len(words) - 1
+	var b strings.Builder
+	for i, w := range words {
+		if i > 0 {
+			n := 1 + extra/gaps
+			if i <= extra%gaps {
+				n++
+			}
+			b.WriteString(strings.Repeat(" ", n))
+		}
+		b.WriteString(w)
+	}
+	return b.String()
+}
+
+// Wrap breaks text into lines no wider than width with minimum raggedness
+// and returns them joined by newlines.
+func Wrap(text string, width int) string {
+	return strings.Join(lines(text, width, true, false), "\n")
+}
+
+// WrapGreedy breaks text into lines no wider than width, filling every line
+// as much as possible, and returns them joined by newlines.
+func WrapGreedy(text string, width int) string {
+	return strings.Join(lines(text, width, false, false), "\n")
+}
+
+// Justify breaks text into lines with minimum raggedness and widens the
+// spaces of every line but the last so that it is exactly width runes wide.
+func Justify(text string, width int) []string {
+	return lines(text, width, true, true)
+}
+
+// JustifyGreedy is like Justify but fills every line as much as possible,
+// which is the classic text justification exercise.
+func JustifyGreedy(text string, width int) []string {
+	return lines(text, width, false, true)
+}
@@ -0,0 +1,51 @@
+package wordwrap
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestWrap(t *testing.T) {
+	text := "aaa bb cc ddddd"
+	if got, want := WrapGreedy(text, 6), "aaa bb\ncc\nddddd"; got != want {
+		t.Errorf("WrapGreedy = %q, want %q", got, want)
+	}
+	if got, want := Wrap(text, 6), "aaa\nbb cc\nddddd"; got != want {
+		t.Errorf("Wrap = %q, want %q", got, want)
+	}
+	if got := Wrap("  \n ", 6); got != "" {
+		t.Errorf("Wrap of blank text = %q, want empty", got)
+	}
+	// widths are counted in runes
+	if got, want := Wrap("héhé hé", 7), "héhé hé"; got != want {
+		t.Errorf("Wrap = %q, want %q", got, want)
+	}
+}
+
+func TestJustify(t *testing.T) {
+	text := "This is an example of text justification."
+	want := []string{
+		"This    is    an",
+		"example  of text",
+		"justification.",
+	}
+	if got := JustifyGreedy(text, 16); !reflect.DeepEqual(got, want) {
+		t.Errorf("JustifyGreedy = %q, want %q", got, want)
+	}
+
+	got := Justify("What must be acknowledgment shall be", 16)
+	want = []string{
+		"What   must   be",
+		"acknowledgment",
+		"shall be",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Justify = %q, want %q", got, want)
+	}
+	for _, line := range got[:len(got)-1] {
+		if len(line) != 16 && strings.Contains(line, " ") {
+			t.Errorf("justified line %q is not 16 wide", line)
+		}
+	}
+}
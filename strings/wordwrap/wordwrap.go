@@ -0,0 +1,101 @@
+// wordwrap.go
+// description: Line breaking by the greedy method and by minimum raggedness (Knuth-Plass)
+// details:
+// A paragraph is a sequence of words of known lengths separated by single
+// spaces, to be broken into lines no wider than a given width. The greedy
+// method fills every line with as many words as fit. It uses the fewest lines
+// but may leave a very short line followed by long ones.
+// The Knuth-Plass approach instead minimizes the total badness of the lines,
+// here the sum of the squares of the space left at the end of every line but
+// the last one. best[i], the least badness for the words from i on, is the
+// minimum over the possible first lines i..j-1 of their badness plus best[j],
+// so it is computed from the end of the paragraph backwards.
+// A word longer than the width is put on a line of its own, and overflows it.
+// Greedy: O(n)
+// KnuthPlass: O(n*w), n being the number of words and w the width
+// reference: https://en.wikipedia.org/wiki/Line_wrap_and_word_wrap
+// reference: Knuth, Plass - Breaking Paragraphs into Lines (1981)
+// see wordwrap_test.go
+
+// Package wordwrap breaks text into lines of bounded width, greedily or with
+// the minimum raggedness dynamic program of Knuth and Plass, and renders the
+// lines ragged or fully justified.
+package wordwrap
+
+// lineWidth returns the width of a line holding the words i..j-1.
+func lineWidth(lengths []int, i, j int) int {
+	w := j - i - 1
+	for _, l := range lengths[i:j] {
+		w += l
+	}
+	return w
+}
+
+// Greedy breaks words of the given lengths into lines no wider than width,
+// filling every line as much as possible. It returns, for every line, the
+// index one past its last word; the last break is len(lengths).
+func Greedy(lengths []int, width int) []int {
+	var breaks []int
+	lineLen := -1 // no word on the line yet
+	for i, l := range lengths {
+		if lineLen >= 0 && lineLen+1+l > width {
+			breaks = append(breaks, i)
+			lineLen = -1
+		}
+		lineLen += 1 + l
+	}
+	if len(lengths) > 0 {
+		breaks = append(breaks, len(lengths))
+	}
+	return breaks
+}
+
+// KnuthPlass breaks words of the given lengths into lines no wider than
+// width so that the sum of the squared trailing space of all lines but the
+// last is minimal. It returns the breaks, as Greedy does, and that sum.
+func KnuthPlass(lengths []int, width int) ([]int, int) {
+	n := len(lengths)
+	if n == 0 {
+		return nil, 0
+	}
+	best := make([]int, n+1) // best[n] = 0: no words left
+	next := make([]int, n)   // next[i] is the break after the line starting at i
+	for i := n - 1; i >= 0; i-- {
+		best[i], next[i] = -1, -1
+		lineLen := -1
+		for j := i + 1; j <= n; j++ {
+			lineLen += 1 + lengths[j-1]
+			if lineLen > width && j > i+1 {
+				break
+			}
+			cost := 0
+			if j < n && lineLen < width {
+				cost = (width - lineLen) * (width - lineLen)
+			}
+			if best[i] < 0 || cost+best[j] < best[i] {
+				best[i], next[i] = cost+best[j], j
+			}
+		}
+	}
+	var breaks []int
+	for i := 0; i < n; i = next[i] {
+		breaks = append(breaks, next[i])
+	}
+	return breaks, best[0]
+}
+
+// Cost returns the badness that KnuthPlass minimizes for the given breaks:
+// the sum of the squared trailing space of all lines but the last. Overfull
+// lines count as full.
+func Cost(lengths []int, breaks []int, width int) int {
+	cost, start := 0, 0
+	for k, end := range breaks {
+		if k < len(breaks)-1 {
+			if slack := width - lineWidth(lengths, start, end); slack > 0 {
+				cost += slack * slack
+			}
+		}
+		start = end
+	}
+	return cost
+}
@@ -0,0 +1,99 @@
+package wordwrap
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestGreedy(t *testing.T) {
+	tests := []struct {
+		lengths []int
+		width   int
+		want    []int
+	}{
+		{nil, 10, nil},
+		{[]int{3}, 10, []int{1}},
+		{[]int{3, 2, 2, 5}, 6, []int{2, 3, 4}},
+		{[]int{12, 1, 1}, 10, []int{1, 3}},
+		{[]int{1, 12, 1}, 10, []int{1, 2, 3}},
+	}
+	for _, test := range tests {
+		if got := Greedy(test.lengths, test.width); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Greedy(%v, %d) = %v, want %v", test.lengths, test.width, got, test.want)
+		}
+	}
+}
+
+func TestKnuthPlass(t *testing.T) {
+	// "aaa bb cc ddddd" at width 6: greedy leaves 0 and 4 spaces (cost 16),
+	// the best breaks leave 3 and 1 spaces (cost 10).
+	lengths := []int{3, 2, 2, 5}
+	if got := Cost(lengths, Greedy(lengths, 6), 6); got != 16 {
+		t.Errorf("greedy cost = %d, want 16", got)
+	}
+	breaks, cost := KnuthPlass(lengths, 6)
+	if want := []int{1, 3, 4}; !reflect.DeepEqual(breaks, want) {
+		t.Errorf("KnuthPlass breaks = %v, want %v", breaks, want)
+	}
+	if cost != 10 || Cost(lengths, breaks, 6) != 10 {
+		t.Errorf("KnuthPlass cost = %d, want 10", cost)
+	}
+
+	// an overlong word gets a line of its own
+	breaks, _ = KnuthPlass([]int{1, 12, 1}, 10)
+	if want := []int{1, 2, 3}; !reflect.DeepEqual(breaks, want) {
+		t.Errorf("KnuthPlass breaks = %v, want %v", breaks, want)
+	}
+
+	if breaks, cost := KnuthPlass(nil, 5); breaks != nil || cost != 0 {
+		t.Errorf("KnuthPlass(nil) = %v, %d, want nil, 0", breaks, cost)
+	}
+}
+
+// bruteForce returns the least cost of all valid ways to break the words.
+func bruteForce(lengths []int, width int) int {
+	n := len(lengths)
+	best := -1
+	for mask := 0; mask < 1<<(n-1); mask++ {
+		var breaks []int
+		for i := 1; i < n; i++ {
+			if mask&(1<<(i-1)) != 0 {
+				breaks = append(breaks, i)
+			}
+		}
+		breaks = append(breaks, n)
+		valid, start := true, 0
+		for _, end := range breaks {
+			if end-start > 1 && lineWidth(lengths, start, end) > width {
+				valid = false
+			}
+			start = end
+		}
+		if cost := Cost(lengths, breaks, width); valid && (best < 0 || cost < best) {
+			best = cost
+		}
+	}
+	return best
+}
+
+func TestKnuthPlassAgainstBruteForce(t *testing.T) {
+	rnd := rand.New(rand.NewSource(4))
+	for round := 0; round < 200; round++ {
+		lengths := make([]int, 1+rnd.Intn(10))
+		for i := range lengths {
+			lengths[i] = 1 + rnd.Intn(8)
+		}
+		width := 4 + rnd.Intn(12)
+		breaks, cost := KnuthPlass(lengths, width)
+		if got := Cost(lengths, breaks, width); got != cost {
+			t.Fatalf("KnuthPlass(%v, %d) reports cost %d for breaks %v costing %d", lengths, width, cost, breaks, got)
+		}
+		if want := bruteForce(lengths, width); cost != want {
+			t.Fatalf("KnuthPlass(%v, %d) cost = %d, want %d", lengths, width, cost, want)
+		}
+		if greedy := Cost(lengths, Greedy(lengths, width), width); greedy < cost {
+			t.Fatalf("greedy beats KnuthPlass on %v, %d: %d < %d", lengths, width, greedy, cost)
+		}
+	}
+}
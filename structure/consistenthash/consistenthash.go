@@ -0,0 +1,178 @@
+// consistenthash.go
+// description: Consistent hashing ring with virtual nodes
+// details:
+// Consistent hashing maps both nodes and keys onto a ring of hash values; a key
+// belongs to the first node found clockwise from its hash. When a node joins or
+// leaves, only the keys between it and its predecessor change owner, about
+// K/N of K keys spread over N nodes, instead of almost all of them as with
+// hash(key) mod N. Every node is placed on the ring several times (virtual
+// nodes, or replicas) so that keys are spread evenly between nodes.
+// AddNode, RemoveNode: O(r * n) for r replicas and n nodes, GetNode: O(log(r * n))
+// reference: https://en.wikipedia.org/wiki/Consistent_hashing
+// see consistenthash_test.go
+
+// Package consistenthash implements a consistent hashing ring that assigns
+// keys to nodes, for example to shard data between servers.
+package consistenthash
+
+import (
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+	"sort"
+)
+
+// HashFunc maps data onto the ring.
+type HashFunc func(data []byte) uint32
+
+// point is a virtual node on the ring.
+type point struct {
+	hash uint32
+	node string
+}
+
+// ConsistentHash is a hash ring assigning string keys to named nodes.
+type ConsistentHash struct {
+	hash     HashFunc
+	replicas int
+	ring     []point // sorted by hash, then by node
+	nodes    map[string]bool
+}
+
+// New creates an empty ring that places every node replicas times and
+// hashes with hash, or with CRC-32 (IEEE) when hash is nil.
+func New(replicas int, hash HashFunc) (*ConsistentHash, error) {
+	if replicas < 1 {
+		return nil, errors.New("replicas must be positive")
+	}
+	if hash == nil {
+		hash = crc32.ChecksumIEEE
+	}
+	return &ConsistentHash{hash: hash, replicas: replicas, nodes: make(map[string]bool)}, nil
+}
+
+// Len returns the number of nodes on the ring.
+func (c *ConsistentHash) Len() int {
+	return len(c.nodes)
+}
+
+// Nodes returns the names of the nodes on the ring in increasing order.
+func (c *ConsistentHash) Nodes() []string {
+	nodes := make([]string, 0, len(c.nodes))
+	for node := range c.nodes {
+		nodes = append(nodes, node)
+	}
+	sort.Strings(nodes)
+	return nodes
+}
+
+// AddNode places the given nodes on the ring. Nodes already present are ignored.
+// Replica i of a node is placed at the hash of its name followed by i as
+// four big-endian bytes. The suffix has a fixed width, so the hashed data
+// always tells the node and the replica apart, whatever the node names.
+func (c *ConsistentHash) AddNode(nodes ...string) {
+	for _, node := range nodes {
+		if c.nodes[node] {
+			continue
+		}
+		c.nodes[node] = true
+		data := make([]byte, len(node), len(node)+4)
+		copy(data, node)
+		for i := 0; i < c.replicas; i++ {
+			c.ring = append(c.ring, point{hash: c.hash(binary.BigEndian.AppendUint32(data, uint32(i))), node: node})
+		}
+	}
+	// Ties between hashes are broken by name, so the owner of every key does
+	// not depend on the order the nodes were added in.
+	sort.Slice(c.ring, func(i, j int) bool {
+		if c.ring[i].hash != c.ring[j].hash {
+			return c.ring[i].hash < c.ring[j].hash
+		}
+		return c.ring[i].node < c.ring[j].node
+	})
+}
+
+// RemoveNode takes node off the ring and reports whether it was there.
+func (c *ConsistentHash) RemoveNode(node string) bool {
+	if !c.nodes[node] {
+		return false
+	}
+	delete(c.nodes, node)
+	kept := c.ring[:0]
+	for _, p := range c.ring {
+		if p.node != node {
+			kept = append(kept, p)
+		}
+	}
+	c.ring = kept
+	return true
+}
+
+// GetNode returns the node owning key. It reports false when the ring is empty.
+func (c *ConsistentHash) GetNode(key string) (string, bool) {
+	if len(c.ring) == 0 {
+		return "", false
+	}
+	h := c.hash([]byte(key))
+	i := sort.Search(len(c.ring), func(i int) bool { return c.ring[i].hash >= h })
+	if i == len(c.ring) {
+		// Past the last point, wrap around to the start of the ring.
+		i = 0
+	}
+	return c.ring[i].node, true
+}
+
+// Clone returns an independent copy of the ring, for example to compare key
+// placement before and after a membership change with Compare.
+func (c *ConsistentHash) Clone() *ConsistentHash {
+	clone := &ConsistentHash{
+		hash:     c.hash,
+		replicas: c.replicas,
+		ring:     append([]point(nil), c.ring...),
+		nodes:    make(map[string]bool, len(c.nodes)),
+	}
+	for node := range c.nodes {
+		clone.nodes[node] = true
+	}
+	return clone
+}
+
+// Move is a change of owner for keys.
+type Move struct {
+	From, To string
+}
+
+// Report describes how keys are placed on a ring after a change of membership
+// and how many of them moved.
+type Report struct {
+	Keys  int            // number of keys placed
+	Moved int            // number of keys whose owner changed
+	Moves map[Move]int   // number of keys for every change of owner
+	Load  map[string]int // number of keys owned by every node afterwards
+}
+
+// MovedFraction returns the fraction of keys that changed owner.
+func (r Report) MovedFraction() float64 {
+	if r.Keys == 0 {
+		return 0
+	}
+	return float64(r.Moved) / float64(r.Keys)
+}
+
+// Compare places keys on both rings and reports how they move from before
+// to after. A key with no owner on an empty ring has the owner "".
+func Compare(before, after *ConsistentHash, keys []string) Report {
+	report := Report{Keys: len(keys), Moves: make(map[Move]int), Load: make(map[string]int)}
+	for _, key := range keys {
+		from, _ := before.GetNode(key)
+		to, _ := after.GetNode(key)
+		if to != "" {
+			report.Load[to]++
+		}
+		if from != to {
+			report.Moved++
+			report.Moves[Move{From: from, To: to}]++
+		}
+	}
+	return report
+}
@@ -0,0 +1,126 @@
+package consistenthash_test
+
+import (
+	"encoding/binary"
+	"strconv"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/structure/consistenthash"
+)
+
+func keys(n int) []string {
+	result := make([]string, n)
+	for i := range result {
+		result[i] = "key-" + strconv.Itoa(i)
+	}
+	return result
+}
+
+func TestCustomHash(t *testing.T) {
+	// Hash replicas, a name and a four-byte index, and plain numeric keys to
+	// their numeric value, so the ring is easy to reason about: node "2"
+	// sits at 20, 21 and 22.
+	hash := func(data []byte) uint32 {
+		if n := len(data) - 4; n > 0 && data[n] == 0 {
+			name, _ := strconv.Atoi(string(data[:n]))
+			return uint32(name*10) + binary.BigEndian.Uint32(data[n:])
+		}
+		n, _ := strconv.Atoi(string(data))
+		return uint32(n)
+	}
+	ring, err := consistenthash.New(3, hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := ring.GetNode("5"); ok {
+		t.Errorf("GetNode on an empty ring should report false")
+	}
+	ring.AddNode("6", "4", "2")
+
+	tests := map[string]string{"2": "2", "11": "2", "23": "4", "45": "6", "63": "2"}
+	for key, want := range tests {
+		if got, _ := ring.GetNode(key); got != want {
+			t.Errorf("GetNode(%q) = %q, want %q", key, got, want)
+		}
+	}
+
+	ring.AddNode("8")
+	if got, _ := ring.GetNode("63"); got != "8" {
+		t.Errorf("GetNode(\"63\") = %q after adding 8, want \"8\"", got)
+	}
+	if !ring.RemoveNode("4") || ring.RemoveNode("4") {
+		t.Errorf("RemoveNode(\"4\") should succeed once")
+	}
+	if got, _ := ring.GetNode("23"); got != "6" {
+		t.Errorf("GetNode(\"23\") = %q after removing 4, want \"6\"", got)
+	}
+	if got := ring.Len(); got != 3 {
+		t.Errorf("Len() = %d, want 3", got)
+	}
+}
+
+func TestInvalidReplicas(t *testing.T) {
+	if _, err := consistenthash.New(0, nil); err == nil {
+		t.Errorf("New(0, nil) should fail")
+	}
+}
+
+func TestAddOrderDoesNotMatter(t *testing.T) {
+	a, _ := consistenthash.New(50, nil)
+	b, _ := consistenthash.New(50, nil)
+	a.AddNode("alpha", "beta", "gamma")
+	b.AddNode("gamma")
+	b.AddNode("alpha", "beta", "alpha")
+	if report := consistenthash.Compare(a, b, keys(5000)); report.Moved != 0 {
+		t.Errorf("%d keys placed differently", report.Moved)
+	}
+}
+
+func TestRebalance(t *testing.T) {
+	ring, _ := consistenthash.New(200, nil)
+	for i := 0; i < 4; i++ {
+		ring.AddNode("node-" + strconv.Itoa(i))
+	}
+	all := keys(20000)
+
+	before := ring.Clone()
+	ring.AddNode("node-4")
+	report := consistenthash.Compare(before, ring, all)
+	for move, n := range report.Moves {
+		if move.To != "node-4" {
+			t.Errorf("%d keys moved from %s to %s, only node-4 should gain keys", n, move.From, move.To)
+		}
+	}
+	// Ideally a fifth of the keys move to the new node.
+	if f := report.MovedFraction(); f < 0.12 || f > 0.28 {
+		t.Errorf("MovedFraction() = %.3f after adding a fifth node", f)
+	}
+	for node, n := range report.Load {
+		if n < 2500 || n > 5500 {
+			t.Errorf("%s owns %d of %d keys", node, n, len(all))
+		}
+	}
+
+	before = ring.Clone()
+	ring.RemoveNode("node-1")
+	report = consistenthash.Compare(before, ring, all)
+	if report.Moved != load(before, all, "node-1") {
+		t.Errorf("Moved = %d, want the keys of node-1 only", report.Moved)
+	}
+	for move := range report.Moves {
+		if move.From != "node-1" {
+			t.Errorf("keys moved from %s, only node-1 should lose keys", move.From)
+		}
+	}
+}
+
+// load returns the number of keys owned by node.
+func load(ring *consistenthash.ConsistentHash, keys []string, node string) int {
+	n := 0
+	for _, key := range keys {
+		if owner, _ := ring.GetNode(key); owner == node {
+			n++
+		}
+	}
+	return n
+}
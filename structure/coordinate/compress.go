@@ -0,0 +1,107 @@
+// compress.go
+// description: Coordinate compression of ordered values into dense ranks
+// details:
+// Coordinate compression maps the distinct values of a collection to the
+// ranks 0, 1, ..., k-1 in increasing order, so that a structure indexed by
+// position, such as a Fenwick tree or a segment tree, can be used on values
+// that are large, negative or not integers at all. The order of the values is
+// kept: a < b exactly when Rank(a) < Rank(b). Values that were not
+// compressed can still be located between the ranks with LowerBound and
+// UpperBound, which is what range queries on arbitrary bounds need.
+// time complexity: O(n log n) to build from n values, O(log k) per lookup
+// and O(1) per decompression, for k distinct values
+// space complexity: O(k)
+// reference: https://en.wikipedia.org/wiki/Coordinate_compression
+// see compress_test.go
+
+// Package coordinate maps arbitrary ordered values to dense ranks and back.
+package coordinate
+
+import (
+	"sort"
+
+	"github.com/TheAlgorithms/Go/constraints"
+)
+
+// Compression holds the sorted distinct values of a collection, the value
+// of rank r being the r-th smallest.
+type Compression[T constraints.Ordered] struct {
+	values []T
+}
+
+// New compresses the given values, ignoring duplicates.
+func New[T constraints.Ordered](values ...T) *Compression[T] {
+	sorted := make([]T, len(values))
+	copy(sorted, values)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	distinct := sorted[:0]
+	for i, v := range sorted {
+		if i == 0 || v != distinct[len(distinct)-1] {
+			distinct = append(distinct, v)
+		}
+	}
+	return &Compression[T]{values: distinct}
+}
+
+// Compress returns the rank of each of the values, along with the
+// compression that decompresses them.
+func Compress[T constraints.Ordered](values []T) ([]int, *Compression[T]) {
+	c := New(values...)
+	ranks := make([]int, len(values))
+	for i, v := range values {
+		ranks[i] = c.LowerBound(v)
+	}
+	return ranks, c
+}
+
+// Len returns the number of distinct values, which is one more than the
+// largest rank.
+func (c *Compression[T]) Len() int {
+	return len(c.values)
+}
+
+// Rank returns the rank of value and whether it was compressed.
+func (c *Compression[T]) Rank(value T) (int, bool) {
+	r := c.LowerBound(value)
+	return r, r < len(c.values) && c.values[r] == value
+}
+
+// LowerBound returns the number of compressed values smaller than value,
+// which is its rank when it was compressed.
+func (c *Compression[T]) LowerBound(value T) int {
+	return sort.Search(len(c.values), func(i int) bool { return c.values[i] >= value })
+}
+
+// UpperBound returns the number of compressed values smaller than or equal
+// to value. The ranks of the values in [lo, hi] are those in
+// [LowerBound(lo), UpperBound(hi)).
+func (c *Compression[T]) UpperBound(value T) int {
+	return sort.Search(len(c.values), func(i int) bool { return c.values[i] > value })
+}
+
+// Value returns the value of the given rank, and false when there is no such
+// rank.
+func (c *Compression[T]) Value(rank int) (T, bool) {
+	if rank < 0 || rank >= len(c.values) {
+		var zero T
+		return zero, false
+	}
+	return c.values[rank], true
+}
+
+// Decompress returns the values of the given ranks, which must all be in
+// [0, Len()).
+func (c *Compression[T]) Decompress(ranks []int) []T {
+	values := make([]T, len(ranks))
+	for i, r := range ranks {
+		values[i] = c.values[r]
+	}
+	return values
+}
+
+// Values returns the distinct values in increasing order, that is by rank.
+func (c *Compression[T]) Values() []T {
+	values := make([]T, len(c.values))
+	copy(values, c.values)
+	return values
+}
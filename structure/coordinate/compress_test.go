@@ -0,0 +1,113 @@
+package coordinate_test
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/structure/coordinate"
+	"github.com/TheAlgorithms/Go/structure/fenwicktree"
+)
+
+func TestCompress(t *testing.T) {
+	values := []int{1_000_000, -5, 42, -5, 7, 1_000_000}
+	ranks, c := coordinate.Compress(values)
+	if want := []int{3, 0, 2, 0, 1, 3}; !reflect.DeepEqual(ranks, want) {
+		t.Errorf("Compress ranks = %v, want %v", ranks, want)
+	}
+	if c.Len() != 4 {
+		t.Errorf("Len() = %d, want 4", c.Len())
+	}
+	if got := c.Decompress(ranks); !reflect.DeepEqual(got, values) {
+		t.Errorf("Decompress = %v, want %v", got, values)
+	}
+	if got, want := c.Values(), []int{-5, 7, 42, 1_000_000}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Values() = %v, want %v", got, want)
+	}
+	if r, ok := c.Rank(42); r != 2 || !ok {
+		t.Errorf("Rank(42) = %d, %v, want 2, true", r, ok)
+	}
+	if r, ok := c.Rank(8); r != 2 || ok {
+		t.Errorf("Rank(8) = %d, %v, want 2, false", r, ok)
+	}
+	if v, ok := c.Value(4); ok {
+		t.Errorf("Value(4) = %d, want no value", v)
+	}
+}
+
+func TestBounds(t *testing.T) {
+	c := coordinate.New("pear", "apple", "fig", "kiwi")
+	tests := []struct {
+		lo, hi   string
+		from, to int
+	}{
+		{"a", "z", 0, 4},
+		{"apple", "fig", 0, 2},
+		{"b", "kiwi", 1, 3},
+		{"g", "h", 2, 2},
+		{"q", "r", 4, 4},
+	}
+	for _, test := range tests {
+		if from, to := c.LowerBound(test.lo), c.UpperBound(test.hi); from != test.from || to != test.to {
+			t.Errorf("ranks of [%q, %q] = [%d, %d), want [%d, %d)", test.lo, test.hi, from, to, test.from, test.to)
+		}
+	}
+	if got := coordinate.New[float64]().Len(); got != 0 {
+		t.Errorf("Len() of an empty compression = %d", got)
+	}
+}
+
+func TestRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	values := make([]int, 500)
+	for i := range values {
+		values[i] = rnd.Intn(200) - 100
+	}
+	ranks, c := coordinate.Compress(values)
+	for i := range values {
+		for j := range values {
+			if (values[i] < values[j]) != (ranks[i] < ranks[j]) {
+				t.Fatalf("order of %d and %d is not kept by ranks %d and %d", values[i], values[j], ranks[i], ranks[j])
+			}
+		}
+	}
+	distinct := map[int]bool{}
+	for _, v := range values {
+		distinct[v] = true
+	}
+	for x := -110; x <= 110; x++ {
+		below := 0
+		for v := range distinct {
+			if v < x {
+				below++
+			}
+		}
+		if got := c.LowerBound(x); got != below {
+			t.Fatalf("LowerBound(%d) = %d, want %d", x, got, below)
+		}
+		if got, want := c.UpperBound(x), below; distinct[x] && got != want+1 || !distinct[x] && got != want {
+			t.Fatalf("UpperBound(%d) = %d with %d smaller values", x, got, below)
+		}
+	}
+}
+
+// countInversions counts the pairs i < j with values[i] > values[j] with a
+// Fenwick tree indexed by rank.
+func countInversions(values []int) int {
+	ranks, c := coordinate.Compress(values)
+	f := fenwicktree.NewFenwickTree(make([]int, c.Len()))
+	inversions := 0
+	for i, r := range ranks {
+		// ranks are 0-based and the Fenwick tree is 1-based
+		inversions += i - f.PrefixSum(r+1)
+		f.Add(r+1, 1)
+	}
+	return inversions
+}
+
+func TestInversions(t *testing.T) {
+	values := []int{1 << 30, -3, 1 << 20, -3, 0}
+	if got := countInversions(values); got != 6 {
+		t.Errorf("countInversions(%v) = %d, want 6", values, got)
+	}
+}
@@ -0,0 +1,239 @@
+// dancinglinks.go
+// description: Circular quad-linked sparse 0/1 matrix with cover and uncover operations
+// details:
+// Every 1 of the matrix is a node linked to its neighbours on the left, right,
+// above and below, and every column has a header node that keeps the number of
+// 1s in it. Links are circular, so a node removed from its lists still knows
+// its neighbours and can be put back in O(1); this is the "dancing links"
+// technique Knuth used for Algorithm X.
+// Cover removes a column from the header list and every row that has a 1 in it
+// from the other columns. Uncover undoes a Cover, and covers must be undone in
+// the reverse order they were done.
+// Nodes are kept in parallel slices and referenced by index instead of pointers.
+// Cover, Uncover: O(number of 1s in the rows of the column)
+// reference: https://arxiv.org/abs/cs/0011047
+// reference: https://en.wikipedia.org/wiki/Dancing_Links
+// see dancinglinks_test.go
+
+// Package dancinglinks implements the sparse matrix used by dancing links
+// and an exact cover search on top of it.
+package dancinglinks
+
+import "errors"
+
+// root is the index of the header of the column header list.
+const root = 0
+
+// Matrix is a sparse 0/1 matrix whose columns and rows can be covered and
+// uncovered. Columns and rows are numbered from 0 in the order they were
+// created.
+type Matrix struct {
+	left, right, up, down []int
+	column, row           []int // column and row of every node
+	size                  []int // number of 1s in every uncovered row of a column, by header
+	rowStart              []int // first node of every row
+	columns               int
+}
+
+// New creates a matrix with the given number of columns and no rows.
+func New(columns int) *Matrix {
+	m := &Matrix{columns: columns}
+	for h := 0; h <= columns; h++ {
+		m.left = append(m.left, h-1)
+		m.right = append(m.right, h+1)
+		m.up = append(m.up, h)
+		m.down = append(m.down, h)
+		m.column = append(m.column, h)
+		m.row = append(m.row, -1)
+		m.size = append(m.size, 0)
+	}
+	m.left[root] = columns
+	m.right[columns] = root
+	return m
+}
+
+// Columns returns the number of columns of the matrix.
+func (m *Matrix) Columns() int {
+	return m.columns
+}
+
+// Rows returns the number of rows of the matrix.
+func (m *Matrix) Rows() int {
+	return len(m.rowStart)
+}
+
+// AddRow appends a row with a 1 in every listed column and returns its index.
+// The columns must be valid and distinct, and the row must not be empty.
+func (m *Matrix) AddRow(columns []int) (int, error) {
+	if len(columns) == 0 {
+		return 0, errors.New("a row needs at least one column")
+	}
+	seen := make(map[int]bool, len(columns))
+	for _, c := range columns {
+		if c < 0 || c >= m.columns {
+			return 0, errors.New("column out of range")
+		}
+		if seen[c] {
+			return 0, errors.New("duplicate column in row")
+		}
+		seen[c] = true
+	}
+
+	r := len(m.rowStart)
+	first := len(m.column)
+	m.rowStart = append(m.rowStart, first)
+	for i, c := range columns {
+		h := c + 1
+		n := first + i
+		m.left = append(m.left, n-1)
+		m.right = append(m.right, n+1)
+		// Link at the bottom of the column.
+		m.up = append(m.up, m.up[h])
+		m.down = append(m.down, h)
+		m.down[m.up[h]] = n
+		m.up[h] = n
+		m.column = append(m.column, h)
+		m.row = append(m.row, r)
+		m.size[h]++
+	}
+	last := len(m.column) - 1
+	m.left[first] = last
+	m.right[last] = first
+	return r, nil
+}
+
+// ColumnSize returns the number of uncovered rows with a 1 in column c.
+func (m *Matrix) ColumnSize(c int) int {
+	return m.size[c+1]
+}
+
+// Active returns the uncovered columns in increasing order.
+func (m *Matrix) Active() []int {
+	var columns []int
+	for h := m.right[root]; h != root; h = m.right[h] {
+		columns = append(columns, h-1)
+	}
+	return columns
+}
+
+// Smallest returns the uncovered column with the fewest 1s, preferring the
+// leftmost one on ties. It reports false if every column is covered.
+func (m *Matrix) Smallest() (int, bool) {
+	best := -1
+	for h := m.right[root]; h != root; h = m.right[h] {
+		if best == -1 || m.size[h] < m.size[best] {
+			best = h
+		}
+	}
+	return best - 1, best != -1
+}
+
+// RowsInColumn returns the uncovered rows with a 1 in column c, from top to bottom.
+func (m *Matrix) RowsInColumn(c int) []int {
+	var rows []int
+	for n := m.down[c+1]; n != c+1; n = m.down[n] {
+		rows = append(rows, m.row[n])
+	}
+	return rows
+}
+
+// RowColumns returns the columns of row r in the order they were added.
+func (m *Matrix) RowColumns(r int) []int {
+	first := m.rowStart[r]
+	columns := []int{m.column[first] - 1}
+	for n := m.right[first]; n != first; n = m.right[n] {
+		columns = append(columns, m.column[n]-1)
+	}
+	return columns
+}
+
+// Cover removes column c from the list of columns and every row with a 1
+// in c from the other columns.
+func (m *Matrix) Cover(c int) {
+	m.cover(c + 1)
+}
+
+// Uncover restores column c and its rows. It must undo the most recent
+// Cover that has not been undone yet.
+func (m *Matrix) Uncover(c int) {
+	m.uncover(c + 1)
+}
+
+// CoverRow selects row r by covering every column it has a 1 in, which also
+// removes every row clashing with r.
+func (m *Matrix) CoverRow(r int) {
+	first := m.rowStart[r]
+	m.cover(m.column[first])
+	for n := m.right[first]; n != first; n = m.right[n] {
+		m.cover(m.column[n])
+	}
+}
+
+// UncoverRow undoes CoverRow(r), with the same ordering rules as Uncover.
+func (m *Matrix) UncoverRow(r int) {
+	first := m.rowStart[r]
+	for n := m.left[first]; n != first; n = m.left[n] {
+		m.uncover(m.column[n])
+	}
+	m.uncover(m.column[first])
+}
+
+func (m *Matrix) cover(h int) {
+	m.right[m.left[h]] = m.right[h]
+	m.left[m.right[h]] = m.left[h]
+	for i := m.down[h]; i != h; i = m.down[i] {
+		for j := m.right[i]; j != i; j = m.right[j] {
+			m.down[m.up[j]] = m.down[j]
+			m.up[m.down[j]] = m.up[j]
+			m.size[m.column[j]]--
+		}
+	}
+}
+
+func (m *Matrix) uncover(h int) {
+	for i := m.up[h]; i != h; i = m.up[i] {
+		for j := m.left[i]; j != i; j = m.left[j] {
+			m.size[m.column[j]]++
+			m.down[m.up[j]] = j
+			m.up[m.down[j]] = j
+		}
+	}
+	m.right[m.left[h]] = h
+	m.left[m.right[h]] = h
+}
+
+// Search runs Knuth's Algorithm X on the uncovered part of the matrix and
+// calls visit with the rows of every exact cover it finds, that is every set
+// of rows with exactly one 1 in each uncovered column. The search stops early
+// when visit returns false. Columns or rows covered before the call stay
+// covered, which allows fixing part of the solution up front, and the matrix
+// is left as it was found.
+func (m *Matrix) Search(visit func(rows []int) bool) {
+	var partial []int
+	var search func() bool
+	search = func() bool {
+		c, ok := m.Smallest()
+		if !ok {
+			return visit(append([]int(nil), partial...))
+		}
+		h := c + 1
+		m.cover(h)
+		defer m.uncover(h)
+		for i := m.down[h]; i != h; i = m.down[i] {
+			partial = append(partial, m.row[i])
+			for j := m.right[i]; j != i; j = m.right[j] {
+				m.cover(m.column[j])
+			}
+			more := search()
+			for j := m.left[i]; j != i; j = m.left[j] {
+				m.uncover(m.column[j])
+			}
+			partial = partial[:len(partial)-1]
+			if !more {
+				return false
+			}
+		}
+		return true
+	}
+	search()
+}
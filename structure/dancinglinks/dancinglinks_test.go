@@ -0,0 +1,151 @@
+package dancinglinks_test
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/structure/dancinglinks"
+)
+
+// knuth builds the example matrix from Knuth's Dancing Links paper.
+func knuth(t *testing.T) *dancinglinks.Matrix {
+	m := dancinglinks.New(7)
+	for _, row := range [][]int{{2, 4, 5}, {0, 3, 6}, {1, 2, 5}, {0, 3}, {1, 6}, {3, 4, 6}} {
+		if _, err := m.AddRow(row); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return m
+}
+
+func TestAddRow(t *testing.T) {
+	m := knuth(t)
+	if m.Rows() != 6 || m.Columns() != 7 {
+		t.Fatalf("got %d rows and %d columns, want 6 and 7", m.Rows(), m.Columns())
+	}
+	if got, want := m.RowColumns(2), []int{1, 2, 5}; !reflect.DeepEqual(got, want) {
+		t.Errorf("RowColumns(2) = %v, want %v", got, want)
+	}
+	if got, want := m.RowsInColumn(3), []int{1, 3, 5}; !reflect.DeepEqual(got, want) {
+		t.Errorf("RowsInColumn(3) = %v, want %v", got, want)
+	}
+	for _, row := range [][]int{nil, {7}, {-1}, {1, 1}} {
+		if _, err := m.AddRow(row); err == nil {
+			t.Errorf("AddRow(%v) should fail", row)
+		}
+	}
+}
+
+func TestCoverUncover(t *testing.T) {
+	m := knuth(t)
+	m.Cover(0)
+	if got, want := m.Active(), []int{1, 2, 3, 4, 5, 6}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Active() = %v, want %v", got, want)
+	}
+	// Rows 1 and 3 have a 1 in column 0 and leave every other column.
+	if got, want := m.RowsInColumn(3), []int{5}; !reflect.DeepEqual(got, want) {
+		t.Errorf("RowsInColumn(3) = %v, want %v", got, want)
+	}
+	if got := m.ColumnSize(6); got != 2 {
+		t.Errorf("ColumnSize(6) = %d, want 2", got)
+	}
+	if c, _ := m.Smallest(); c != 3 {
+		t.Errorf("Smallest() = %d, want 3", c)
+	}
+
+	m.CoverRow(0)
+	if got, want := m.Active(), []int{1, 3, 6}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Active() = %v, want %v", got, want)
+	}
+	m.UncoverRow(0)
+	m.Uncover(0)
+
+	fresh := knuth(t)
+	for c := 0; c < 7; c++ {
+		if got, want := m.RowsInColumn(c), fresh.RowsInColumn(c); !reflect.DeepEqual(got, want) {
+			t.Errorf("after uncover RowsInColumn(%d) = %v, want %v", c, got, want)
+		}
+	}
+}
+
+func TestSearch(t *testing.T) {
+	m := knuth(t)
+	var solutions [][]int
+	m.Search(func(rows []int) bool {
+		sort.Ints(rows)
+		solutions = append(solutions, rows)
+		return true
+	})
+	if want := [][]int{{0, 3, 4}}; !reflect.DeepEqual(solutions, want) {
+		t.Errorf("Search() found %v, want %v", solutions, want)
+	}
+
+	// Fixing a row that is not in the only solution leaves nothing to find.
+	m.CoverRow(1)
+	m.Search(func(rows []int) bool {
+		t.Errorf("unexpected solution %v", rows)
+		return true
+	})
+	m.UncoverRow(1)
+	if got := len(m.Active()); got != 7 {
+		t.Errorf("%d active columns after Search, want 7", got)
+	}
+}
+
+func TestSearchAgainstBruteForce(t *testing.T) {
+	rnd := rand.New(rand.NewSource(9))
+	for round := 0; round < 30; round++ {
+		const columns, rows = 6, 12
+		m := dancinglinks.New(columns)
+		masks := make([]int, rows)
+		for r := range masks {
+			for masks[r] == 0 {
+				masks[r] = rnd.Intn(1 << columns)
+			}
+			var cols []int
+			for c := 0; c < columns; c++ {
+				if masks[r]>>c&1 == 1 {
+					cols = append(cols, c)
+				}
+			}
+			if _, err := m.AddRow(cols); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		want := 0
+		for set := 0; set < 1<<rows; set++ {
+			covered, ok := 0, true
+			for r := 0; r < rows && ok; r++ {
+				if set>>r&1 == 1 {
+					ok = covered&masks[r] == 0
+					covered |= masks[r]
+				}
+			}
+			if ok && covered == 1<<columns-1 {
+				want++
+			}
+		}
+		got := 0
+		m.Search(func([]int) bool {
+			got++
+			return true
+		})
+		if got != want {
+			t.Fatalf("round %d: Search found %d covers, want %d", round, got, want)
+		}
+
+		if want > 1 {
+			got = 0
+			m.Search(func([]int) bool {
+				got++
+				return false
+			})
+			if got != 1 {
+				t.Errorf("Search did not stop after visit returned false")
+			}
+		}
+	}
+}
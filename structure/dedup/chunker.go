@@ -0,0 +1,108 @@
+// chunker.go
+// description: Content-defined chunking with a rolling Rabin-Karp hash
+// details:
+// A rolling hash, the search.RollingHash of Rabin-Karp, is computed over the
+// last windowSize bytes of the stream and
+// a chunk ends where the hash has its low bits all set, so the boundaries
+// depend on the content around them and not on their offset. Inserting or
+// removing bytes only moves the boundaries close to the edit; the chunks
+// further away are the same as before and can be found again by their
+// digest. The minimum and maximum sizes bound the chunks when the content is
+// degenerate, for example a long run of one byte.
+// time complexity: O(n) for a stream of n bytes
+// space complexity: O(max) for chunks of at most max bytes
+// reference: https://en.wikipedia.org/wiki/Rolling_hash#Content-based_slicing_using_a_rolling_hash
+// see dedup_test.go
+
+// Package dedup finds the data shared between byte streams by cutting them
+// into content-defined chunks and indexing the chunks by digest.
+package dedup
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+
+	"github.com/TheAlgorithms/Go/hashing/sha256"
+	"github.com/TheAlgorithms/Go/strings/search"
+)
+
+// windowSize is the number of bytes the rolling hash covers.
+const windowSize = 48
+
+// maxAverageSize keeps the boundary mask below the modulus of the rolling
+// hash, 1e9 + 7.
+const maxAverageSize = 1 << 29
+
+// ErrInvalidSizes is returned by NewChunker when the chunk sizes are not
+// 0 < min <= average <= max with average a power of two.
+var ErrInvalidSizes = errors.New("chunk sizes must satisfy 0 < min <= average <= max with average a power of two")
+
+// Chunk is a piece of a stream.
+type Chunk struct {
+	Offset int      // position of the first byte in the stream
+	Length int      // number of bytes
+	Sum    [32]byte // SHA-256 digest of the bytes
+}
+
+// Chunker cuts streams into content-defined chunks.
+type Chunker struct {
+	min, max int
+	mask     uint64
+}
+
+// NewChunker creates a Chunker making chunks of minSize to maxSize bytes. On
+// random data a chunk ends after averageSize bytes on average once it has
+// minSize bytes.
+func NewChunker(minSize, averageSize, maxSize int) (*Chunker, error) {
+	if minSize <= 0 || minSize > averageSize || averageSize > maxSize || averageSize > maxAverageSize || averageSize&(averageSize-1) != 0 {
+		return nil, ErrInvalidSizes
+	}
+	return &Chunker{min: minSize, max: maxSize, mask: uint64(averageSize - 1)}, nil
+}
+
+// Split reads r to the end and returns its chunks in order. The chunks cover
+// the stream without gaps; an empty stream has no chunks.
+func (c *Chunker) Split(r io.Reader) ([]Chunk, error) {
+	var chunks []Chunk
+	err := c.split(r, func(offset int, data []byte) {
+		chunks = append(chunks, Chunk{Offset: offset, Length: len(data), Sum: sha256.Hash(data)})
+	})
+	return chunks, err
+}
+
+// SplitBytes returns the chunks of data.
+func (c *Chunker) SplitBytes(data []byte) []Chunk {
+	chunks, _ := c.Split(bytes.NewReader(data))
+	return chunks
+}
+
+// split calls emit with the offset and the bytes of every chunk of r. The
+// bytes are only valid during the call.
+func (c *Chunker) split(r io.Reader, emit func(offset int, data []byte)) error {
+	br := bufio.NewReader(r)
+	hash := search.NewRollingHash(windowSize)
+	buffer := make([]byte, 0, c.max)
+	offset := 0
+	for {
+		b, err := br.ReadByte()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		h := hash.Roll(b)
+		buffer = append(buffer, b)
+		if len(buffer) >= c.max || (len(buffer) >= c.min && h&c.mask == c.mask) {
+			emit(offset, buffer)
+			offset += len(buffer)
+			buffer = buffer[:0]
+		}
+	}
+	if len(buffer) > 0 {
+		emit(offset, buffer)
+	}
+	return nil
+}
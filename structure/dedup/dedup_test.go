@@ -0,0 +1,155 @@
+package dedup_test
+
+import (
+	"bytes"
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/hashing/sha256"
+	"github.com/TheAlgorithms/Go/structure/dedup"
+)
+
+func randomBytes(rnd *rand.Rand, n int) []byte {
+	data := make([]byte, n)
+	rnd.Read(data)
+	return data
+}
+
+func newChunker(t *testing.T) *dedup.Chunker {
+	t.Helper()
+	c, err := dedup.NewChunker(64, 256, 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestNewChunkerInvalid(t *testing.T) {
+	for _, sizes := range [][3]int{{0, 256, 1024}, {512, 256, 1024}, {64, 2048, 1024}, {64, 300, 1024}} {
+		if _, err := dedup.NewChunker(sizes[0], sizes[1], sizes[2]); !errors.Is(err, dedup.ErrInvalidSizes) {
+			t.Errorf("NewChunker%v: err = %v, want ErrInvalidSizes", sizes, err)
+		}
+	}
+}
+
+func TestSplit(t *testing.T) {
+	c := newChunker(t)
+	rnd := rand.New(rand.NewSource(1))
+	for _, data := range [][]byte{nil, []byte("short"), make([]byte, 5000), randomBytes(rnd, 100000)} {
+		chunks := c.SplitBytes(data)
+		offset := 0
+		for i, ch := range chunks {
+			if ch.Offset != offset {
+				t.Fatalf("chunk %d starts at %d, want %d", i, ch.Offset, offset)
+			}
+			if ch.Length > 1024 || (ch.Length < 64 && i != len(chunks)-1) {
+				t.Fatalf("chunk %d has %d bytes", i, ch.Length)
+			}
+			// sha256.Hash appends its padding to the message, so cap the slice.
+			end := ch.Offset + ch.Length
+			if ch.Sum != sha256.Hash(data[ch.Offset:end:end]) {
+				t.Fatalf("chunk %d has a wrong digest", i)
+			}
+			offset += ch.Length
+		}
+		if offset != len(data) {
+			t.Fatalf("chunks cover %d of %d bytes", offset, len(data))
+		}
+		if len(data) == 100000 && (len(chunks) < 100000/1024 || len(chunks) > 100000/64/2) {
+			t.Errorf("random data gave %d chunks", len(chunks))
+		}
+	}
+}
+
+func TestSplitShiftResistant(t *testing.T) {
+	c := newChunker(t)
+	rnd := rand.New(rand.NewSource(2))
+	data := randomBytes(rnd, 50000)
+	shifted := append([]byte("a few inserted bytes"), data...)
+	sums := make(map[[32]byte]bool)
+	for _, ch := range c.SplitBytes(data) {
+		sums[ch.Sum] = true
+	}
+	chunks := c.SplitBytes(shifted)
+	same := 0
+	for _, ch := range chunks {
+		if sums[ch.Sum] {
+			same++
+		}
+	}
+	// Only the first chunks may differ.
+	if same < len(chunks)-3 {
+		t.Errorf("%d of %d chunks survive a shift", same, len(chunks))
+	}
+}
+
+func TestIndex(t *testing.T) {
+	rnd := rand.New(rand.NewSource(3))
+	first := randomBytes(rnd, 40000)
+	second := append(append(append([]byte{}, first[:20000]...), randomBytes(rnd, 3000)...), first[20000:]...)
+	streams := [][]byte{first, second, randomBytes(rnd, 10000)}
+
+	ix := dedup.NewIndex(newChunker(t))
+	if d := ix.AddBytes(first); len(d) != 0 {
+		t.Fatalf("first stream has duplicates %v", d)
+	}
+	duplicates := ix.AddBytes(second)
+	covered, end := 0, -1
+	for _, d := range duplicates {
+		if d.Offset < end {
+			t.Fatalf("duplicates overlap or are out of order: %v", duplicates)
+		}
+		end = d.Offset + d.Length
+		o := d.Original
+		if o.Length != d.Length || !bytes.Equal(second[d.Offset:end], streams[o.Stream][o.Offset:o.Offset+o.Length]) {
+			t.Fatalf("duplicate %+v does not match its original", d)
+		}
+		covered += d.Length
+	}
+	if covered < len(first)-4*1024 || len(duplicates) > 3 {
+		t.Errorf("%d duplicate ranges cover %d bytes of %d shared", len(duplicates), covered, len(first))
+	}
+	if d := ix.AddBytes(streams[2]); len(d) != 0 {
+		t.Errorf("unrelated stream has duplicates %v", d)
+	}
+
+	stats := ix.Stats()
+	if stats.Streams != 3 || stats.Bytes != len(first)+len(second)+10000 {
+		t.Errorf("stats = %+v", stats)
+	}
+	if stats.UniqueBytes != stats.Bytes-covered || stats.UniqueChunks >= stats.Chunks {
+		t.Errorf("stats = %+v with %d duplicate bytes", stats, covered)
+	}
+
+	for _, ch := range newChunker(t).SplitBytes(first) {
+		if l, ok := ix.Contains(ch.Sum); !ok || l.Stream != 0 || l.Offset != ch.Offset {
+			t.Fatalf("Contains(chunk at %d) = %+v, %v", ch.Offset, l, ok)
+		}
+	}
+}
+
+func TestIndexRepeatsWithinStream(t *testing.T) {
+	rnd := rand.New(rand.NewSource(4))
+	block := randomBytes(rnd, 8000)
+	data := append(append([]byte{}, block...), block...)
+	ix := dedup.NewIndex(newChunker(t))
+	duplicates := ix.AddBytes(data)
+	if len(duplicates) == 0 || duplicates[0].Original.Stream != 0 {
+		t.Fatalf("duplicates = %v", duplicates)
+	}
+	// The first and last chunks of the copy may be cut differently.
+	last := duplicates[len(duplicates)-1]
+	if len(duplicates) != 1 || last.Offset+last.Length < len(data)-1024 || last.Offset-last.Original.Offset != len(block) {
+		t.Errorf("duplicates = %v", duplicates)
+	}
+}
+
+func BenchmarkIndexAdd(b *testing.B) {
+	data := randomBytes(rand.New(rand.NewSource(5)), 1<<20)
+	c, _ := dedup.NewChunker(2048, 8192, 65536)
+	b.SetBytes(int64(len(data)))
+	for i := 0; i < b.N; i++ {
+		dedup.NewIndex(c).AddBytes(data)
+	}
+}
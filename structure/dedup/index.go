@@ -0,0 +1,117 @@
+// index.go
+// description: Deduplication index of the chunks of several byte streams
+// details:
+// Every stream added to the index is cut into content-defined chunks by a
+// Chunker. The first occurrence of every chunk digest is stored in a hash map;
+// later chunks with a known digest are reported as duplicates of it, and
+// consecutive duplicates continuing the same original are merged into one
+// range. Chunks are compared by SHA-256 digest only, as storage systems do.
+// time complexity: O(n) expected for a stream of n bytes
+// space complexity: O(c) for c distinct chunks
+// reference: https://en.wikipedia.org/wiki/Data_deduplication
+// see dedup_test.go
+
+package dedup
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+
+	"github.com/TheAlgorithms/Go/structure/hashmap"
+)
+
+// Location is a range of bytes of a stream of the index.
+type Location struct {
+	Stream int // number of the stream, in order of addition from 0
+	Offset int
+	Length int
+}
+
+// Duplicate is a range of an added stream whose chunks were already in the
+// index, at Original.
+type Duplicate struct {
+	Offset   int
+	Length   int
+	Original Location
+}
+
+// Stats counts the bytes and chunks seen by an index.
+type Stats struct {
+	Streams      int
+	Chunks       int
+	UniqueChunks int
+	Bytes        int
+	UniqueBytes  int
+}
+
+// Index remembers the chunks of the streams added to it.
+type Index struct {
+	chunker *Chunker
+	chunks  *hashmap.ChainedHashMap[[32]byte, Location]
+	stats   Stats
+}
+
+// digestHash uses the first bytes of a SHA-256 digest, already uniformly
+// distributed, as its hash.
+func digestHash(sum [32]byte) uint64 {
+	return binary.LittleEndian.Uint64(sum[:8])
+}
+
+// NewIndex creates an empty index cutting streams with chunker.
+func NewIndex(chunker *Chunker) *Index {
+	return &Index{chunker: chunker, chunks: hashmap.NewChained[[32]byte, Location](digestHash)}
+}
+
+// Add reads r to the end as the next stream of the index, numbered
+// Stats().Streams before the call, and returns its ranges, in order, that
+// repeat chunks of earlier streams or of earlier parts of r.
+func (ix *Index) Add(r io.Reader) ([]Duplicate, error) {
+	chunks, err := ix.chunker.Split(r)
+	if err != nil {
+		return nil, err
+	}
+	stream := ix.stats.Streams
+	ix.stats.Streams++
+	var duplicates []Duplicate
+	for _, c := range chunks {
+		ix.stats.Chunks++
+		ix.stats.Bytes += c.Length
+		original, ok := ix.chunks.Get(c.Sum)
+		if !ok {
+			ix.stats.UniqueChunks++
+			ix.stats.UniqueBytes += c.Length
+			ix.chunks.Put(c.Sum, Location{Stream: stream, Offset: c.Offset, Length: c.Length})
+			continue
+		}
+		if n := len(duplicates); n > 0 {
+			last := &duplicates[n-1]
+			if last.Offset+last.Length == c.Offset && last.Original.Stream == original.Stream &&
+				last.Original.Offset+last.Original.Length == original.Offset {
+				last.Length += c.Length
+				last.Original.Length += c.Length
+				continue
+			}
+		}
+		duplicates = append(duplicates, Duplicate{Offset: c.Offset, Length: c.Length, Original: original})
+	}
+	return duplicates, nil
+}
+
+// AddBytes adds data as the next stream of the index, as Add does.
+func (ix *Index) AddBytes(data []byte) []Duplicate {
+	duplicates, _ := ix.Add(bytes.NewReader(data))
+	return duplicates
+}
+
+// Contains reports whether a chunk with digest sum is in the index, and where
+// it was first seen.
+func (ix *Index) Contains(sum [32]byte) (Location, bool) {
+	return ix.chunks.Get(sum)
+}
+
+// Stats returns the counters of the index. Bytes/UniqueBytes is the
+// deduplication ratio.
+func (ix *Index) Stats() Stats {
+	return ix.stats
+}
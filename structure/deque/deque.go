@@ -1,4 +1,5 @@
 // description: Double Ended Queue is a generalized version of Queue data structure that allows insert and delete at both ends.
+// The items are kept in a ring buffer which doubles when full, so that every operation takes amortized O(1) time at either end.
 // References:
 //	Wikipedia : https://en.wikipedia.org/wiki/Double-ended_queue
 //	Github: https://www.geeksforgeeks.org/deque-set-1-introduction-applications/
@@ -9,76 +10,130 @@ package deque
 
 import (
 	"errors"
+
+	"github.com/TheAlgorithms/Go/structure/iterutil"
 )
 
 // ErrEmptyDequeue is a custom error for handling cases when some dequeuing operation is performed on an empty deque.
 var ErrEmptyDequeue = errors.New("DoublyEnded queue is empty, so can't perform this operation")
 
+// DoublyEndedQueue is a deque of items of type T. Its zero value is an empty
+// deque ready to use.
 type DoublyEndedQueue[T any] struct {
-	deque []T
+	buf  []T // ring buffer holding the items from head on, wrapping around
+	head int // index in buf of the front item
+	size int // number of items
 }
 
 // New returns a new DoublyEndedQueue.
 func New[T any]() *DoublyEndedQueue[T] {
-	return &DoublyEndedQueue[T]{deque: make([]T, 0)}
+	return &DoublyEndedQueue[T]{}
+}
+
+// at returns the index in the buffer of the i-th item from the front.
+func (dq *DoublyEndedQueue[T]) at(i int) int {
+	return (dq.head + i) % len(dq.buf)
+}
+
+// grow doubles the buffer if it is full, moving the front item to index 0.
+func (dq *DoublyEndedQueue[T]) grow() {
+	if dq.size < len(dq.buf) {
+		return
+	}
+	buf := make([]T, 2*len(dq.buf)+1)
+	for i := 0; i < dq.size; i++ {
+		buf[i] = dq.buf[dq.at(i)]
+	}
+	dq.buf, dq.head = buf, 0
 }
 
 // EnqueueFront adds an item at the front of Deque.
 func (dq *DoublyEndedQueue[T]) EnqueueFront(item T) {
-	dq.deque = append([]T{item}, dq.deque...)
+	dq.grow()
+	dq.head = (dq.head + len(dq.buf) - 1) % len(dq.buf)
+	dq.buf[dq.head] = item
+	dq.size++
 }
 
 // EnqueueRear adds an item at the rear of Deque.
 func (dq *DoublyEndedQueue[T]) EnqueueRear(item T) {
-	dq.deque = append(dq.deque, item)
+	dq.grow()
+	dq.buf[dq.at(dq.size)] = item
+	dq.size++
 }
 
 // DequeueFront deletes an item from front of Deque and returns it.
 func (dq *DoublyEndedQueue[T]) DequeueFront() (T, error) {
-	if len(dq.deque) == 0 {
-		var zeroVal T
+	var zeroVal T
+	if dq.size == 0 {
 		return zeroVal, ErrEmptyDequeue
 	}
-	frontElement := dq.deque[0]
-	dq.deque = dq.deque[1:]
+	frontElement := dq.buf[dq.head]
+	dq.buf[dq.head] = zeroVal
+	dq.head = dq.at(1)
+	dq.size--
 	return frontElement, nil
 }
 
 // DequeueRear deletes an item from rear of Deque and returns it.
 func (dq *DoublyEndedQueue[T]) DequeueRear() (T, error) {
-	if len(dq.deque) == 0 {
-		var zeroVal T
+	var zeroVal T
+	if dq.size == 0 {
 		return zeroVal, ErrEmptyDequeue
 	}
-	rearElement := dq.deque[len(dq.deque)-1]
-	dq.deque = dq.deque[:len(dq.deque)-1]
+	last := dq.at(dq.size - 1)
+	rearElement := dq.buf[last]
+	dq.buf[last] = zeroVal
+	dq.size--
 	return rearElement, nil
 }
 
 // Front gets the front item from queue.
 func (dq *DoublyEndedQueue[T]) Front() (T, error) {
-	if (len(dq.deque)) == 0 {
+	if dq.size == 0 {
 		var zeroVal T
 		return zeroVal, ErrEmptyDequeue
 	}
-	return dq.deque[0], nil
+	return dq.buf[dq.head], nil
 }
 
 // Rear gets the last item from queue.
 func (dq *DoublyEndedQueue[T]) Rear() (T, error) {
-	if (len(dq.deque)) == 0 {
+	if dq.size == 0 {
 		var zeroVal T
 		return zeroVal, ErrEmptyDequeue
 	}
-	return dq.deque[len(dq.deque)-1], nil
+	return dq.buf[dq.at(dq.size-1)], nil
 }
 
 // IsEmpty checks whether Deque is empty or not.
 func (dq *DoublyEndedQueue[T]) IsEmpty() bool {
-	return len(dq.deque) == 0
+	return dq.size == 0
 }
 
 // Length gets the length of Deque.
 func (dq *DoublyEndedQueue[T]) Length() int {
-	return len(dq.deque)
+	return dq.size
+}
+
+// All returns the items from front to rear as a lazy sequence.
+func (dq *DoublyEndedQueue[T]) All() iterutil.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := 0; i < dq.size; i++ {
+			if !yield(dq.buf[dq.at(i)]) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns the items from rear to front as a lazy sequence.
+func (dq *DoublyEndedQueue[T]) Backward() iterutil.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := dq.size - 1; i >= 0; i-- {
+			if !yield(dq.buf[dq.at(i)]) {
+				return
+			}
+		}
+	}
 }
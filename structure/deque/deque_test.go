@@ -12,9 +12,12 @@
 package deque_test
 
 import (
+	"math/rand"
+	"reflect"
 	"testing"
 
 	"github.com/TheAlgorithms/Go/structure/deque"
+	"github.com/TheAlgorithms/Go/structure/iterutil"
 )
 
 type QueryStructure[T any] struct {
@@ -404,3 +407,69 @@ func TestDeque(t *testing.T) {
 		})
 	}
 }
+
+func TestDequeSeqs(t *testing.T) {
+	dq := deque.New[int]()
+	if got := iterutil.Collect(dq.All()); len(got) != 0 {
+		t.Errorf("All of an empty deque = %v", got)
+	}
+	dq.EnqueueRear(2)
+	dq.EnqueueRear(3)
+	dq.EnqueueFront(1)
+	if got := iterutil.Collect(dq.All()); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("All = %v, want [1 2 3]", got)
+	}
+	if got := iterutil.Collect(dq.Backward()); !reflect.DeepEqual(got, []int{3, 2, 1}) {
+		t.Errorf("Backward = %v, want [3 2 1]", got)
+	}
+	if got := iterutil.Collect(iterutil.Take(dq.Backward(), 2)); !reflect.DeepEqual(got, []int{3, 2}) {
+		t.Errorf("taking 2 from Backward = %v, want [3 2]", got)
+	}
+}
+
+func TestDequeRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	var dq deque.DoublyEndedQueue[int]
+	var model []int
+	for i := 0; i < 5000; i++ {
+		switch rnd.Intn(4) {
+		case 0:
+			dq.EnqueueFront(i)
+			model = append([]int{i}, model...)
+		case 1:
+			dq.EnqueueRear(i)
+			model = append(model, i)
+		case 2:
+			got, err := dq.DequeueFront()
+			if len(model) == 0 {
+				if err != deque.ErrEmptyDequeue {
+					t.Fatalf("DequeueFront on an empty deque: err = %v", err)
+				}
+				continue
+			}
+			if err != nil || got != model[0] {
+				t.Fatalf("DequeueFront = %d, %v, want %d", got, err, model[0])
+			}
+			model = model[1:]
+		default:
+			got, err := dq.DequeueRear()
+			if len(model) == 0 {
+				if err != deque.ErrEmptyDequeue {
+					t.Fatalf("DequeueRear on an empty deque: err = %v", err)
+				}
+				continue
+			}
+			if err != nil || got != model[len(model)-1] {
+				t.Fatalf("DequeueRear = %d, %v, want %d", got, err, model[len(model)-1])
+			}
+			model = model[:len(model)-1]
+		}
+		if dq.Length() != len(model) {
+			t.Fatalf("Length = %d, want %d", dq.Length(), len(model))
+		}
+	}
+	got := iterutil.Collect(dq.All())
+	if len(got) != len(model) || len(model) > 0 && !reflect.DeepEqual(got, model) {
+		t.Errorf("All = %v, want %v", got, model)
+	}
+}
@@ -0,0 +1,113 @@
+// Range Fenwick Tree, a Fenwick tree supporting both range updates and range
+// queries on an array of integers.
+// The basic Fenwick tree adds to a single position and sums a range, and the
+// same tree over the differences of the array adds to a range but then only
+// reads a single position. Summing a prefix after range updates needs a second
+// tree: with d the differences of the array, the sum of its first p elements is
+//
+//	sum(d[i] * (p - i + 1)) = (p + 1) * sum(d[i]) - sum(d[i] * i)   for i <= p
+//
+// so one tree keeps d[i] and the other d[i] * i, and adding v to the range
+// l to r changes both at positions l and r + 1 only.
+// Build: O(N)
+// Query: O(log(N))
+// Update: O(log(N))
+// reference: https://cp-algorithms.com/data_structures/fenwick.html#range-update-and-range-query
+package fenwicktree
+
+// RangeFenwickTree represents a pair of Fenwick trees over the differences of
+// an array, adding to and summing any range of it.
+type RangeFenwickTree struct {
+	n    int   // n: Size of the input array.
+	diff []int // diff: Fenwick tree of the differences d[i] of the array.
+	idx  []int // idx: Fenwick tree of d[i] * i.
+}
+
+// NewRangeFenwickTree creates a new range Fenwick tree holding the values of
+// the array. Like FenwickTree, the queries and updates use one based indexing.
+func NewRangeFenwickTree(array []int) *RangeFenwickTree {
+	n := len(array)
+	f := &RangeFenwickTree{
+		n:    n,
+		diff: make([]int, n+1),
+		idx:  make([]int, n+1),
+	}
+	prev := 0
+	for i := 1; i <= n; i++ {
+		d := array[i-1] - prev
+		prev = array[i-1]
+		f.diff[i] += d
+		f.idx[i] += d * i
+		if nextPos := i + (i & -i); nextPos <= n {
+			f.diff[nextPos] += f.diff[i]
+			f.idx[nextPos] += f.idx[i]
+		}
+	}
+	return f
+}
+
+// add adds value to the difference at position pos in both trees.
+func (f *RangeFenwickTree) add(pos int, value int) {
+	for i := pos; i <= f.n; i += (i & -i) {
+		f.diff[i] += value
+		f.idx[i] += value * pos
+	}
+}
+
+// RangeAdd adds value to every element in the range l to r both inclusive.
+// The part of the range outside the array is ignored.
+func (f *RangeFenwickTree) RangeAdd(l int, r int, value int) {
+	if l < 1 {
+		l = 1
+	}
+	if r > f.n {
+		r = f.n
+	}
+	if l > r {
+		return
+	}
+	f.add(l, value)
+	f.add(r+1, -value)
+}
+
+// Add adds value to the element at position pos.
+func (f *RangeFenwickTree) Add(pos int, value int) {
+	f.RangeAdd(pos, pos, value)
+}
+
+// PrefixSum returns the sum of the prefix ending at position pos.
+func (f *RangeFenwickTree) PrefixSum(pos int) int {
+	if pos > f.n {
+		pos = f.n
+	}
+	diffSum, idxSum := 0, 0
+	for i := pos; i > 0; i -= (i & -i) {
+		diffSum += f.diff[i]
+		idxSum += f.idx[i]
+	}
+	return (pos+1)*diffSum - idxSum
+}
+
+// RangeSum returns the sum of the elements in the range l to r
+// both inclusive.
+func (f *RangeFenwickTree) RangeSum(l int, r int) int {
+	if l < 1 {
+		l = 1
+	}
+	if l > r {
+		return 0
+	}
+	return f.PrefixSum(r) - f.PrefixSum(l-1)
+}
+
+// Get returns the element at position pos, or 0 outside the array.
+func (f *RangeFenwickTree) Get(pos int) int {
+	if pos < 1 || pos > f.n {
+		return 0
+	}
+	value := 0
+	for i := pos; i > 0; i -= (i & -i) {
+		value += f.diff[i]
+	}
+	return value
+}
@@ -0,0 +1,66 @@
+package fenwicktree_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/structure/fenwicktree"
+)
+
+func TestRangeFenwickTree(t *testing.T) {
+	tree := fenwicktree.NewRangeFenwickTree([]int{1, 2, 3, 4, 5})
+	if got := tree.RangeSum(2, 4); got != 9 {
+		t.Errorf("RangeSum(2, 4) = %d, want 9", got)
+	}
+	tree.RangeAdd(2, 4, 10)
+	tree.Add(5, -5)
+	tree.RangeAdd(4, 9, 1) // clipped to 4 to 5
+	for pos, want := range []int{0, 1, 12, 13, 15, 1, 0} {
+		if got := tree.Get(pos); got != want {
+			t.Errorf("Get(%d) = %d, want %d", pos, got, want)
+		}
+	}
+	if got := tree.PrefixSum(5); got != 42 {
+		t.Errorf("PrefixSum(5) = %d, want 42", got)
+	}
+	if got := tree.RangeSum(3, 4); got != 28 {
+		t.Errorf("RangeSum(3, 4) = %d, want 28", got)
+	}
+	if got := tree.RangeSum(4, 3); got != 0 {
+		t.Errorf("RangeSum(4, 3) = %d, want 0", got)
+	}
+
+	empty := fenwicktree.NewRangeFenwickTree(nil)
+	empty.RangeAdd(1, 3, 7)
+	if got := empty.RangeSum(1, 3); got != 0 {
+		t.Errorf("RangeSum of an empty tree = %d", got)
+	}
+}
+
+func TestRangeFenwickTreeRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	model := make([]int, 100)
+	for i := range model {
+		model[i] = rnd.Intn(200) - 100
+	}
+	tree := fenwicktree.NewRangeFenwickTree(model)
+	for i := 0; i < 2000; i++ {
+		l := 1 + rnd.Intn(len(model))
+		r := l + rnd.Intn(len(model)-l+1)
+		if rnd.Intn(2) == 0 {
+			value := rnd.Intn(200) - 100
+			tree.RangeAdd(l, r, value)
+			for j := l; j <= r; j++ {
+				model[j-1] += value
+			}
+			continue
+		}
+		want := 0
+		for j := l; j <= r; j++ {
+			want += model[j-1]
+		}
+		if got := tree.RangeSum(l, r); got != want {
+			t.Fatalf("RangeSum(%d, %d) = %d, want %d", l, r, got, want)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+// filtered.go
+// description: Bloom filter in front of an ordered set or map
+// details:
+// Looking up a missing key in a search tree or a skip list walks a whole
+// path of pointers. A Bloom filter of the keys answers most such lookups
+// at once: when it reports a key absent, the key is certainly absent and the
+// structure is not touched, and only the keys it reports, the present ones
+// and a small rate of false positives, go through to the structure. The
+// wrapper owns the structure, so that every key inserted goes to the filter
+// too; it works with any structure having Has and Delete methods, such as
+// the trees of structure/tree, the treap or the skip list, and counts how
+// its lookups were answered.
+// A Bloom filter cannot forget a key, so keys deleted from the structure
+// stay in the filter and only cost false positives; Rebuild makes a new
+// filter from the keys left, for instance after many deletions or once more
+// keys were added than the filter was sized for.
+// Has: O(k) for a key the filter rules out, plus the lookup in the structure
+// otherwise
+// reference: https://en.wikipedia.org/wiki/Bloom_filter#Examples
+// see filtered_test.go
+
+// Package filtered fronts sets and maps with a Bloom filter to answer
+// lookups of missing keys without searching them.
+package filtered
+
+import (
+	"github.com/TheAlgorithms/Go/structure/iterutil"
+	"github.com/TheAlgorithms/Go/structure/sketch"
+)
+
+// Map is the part of a set or map needed by Filtered.
+type Map[K any] interface {
+	Has(key K) bool
+	Delete(key K) bool
+}
+
+// Stats counts how the lookups of a Filtered were answered.
+type Stats struct {
+	// Lookups is the number of calls of Has.
+	Lookups int
+	// Filtered is the number of lookups answered by the filter alone.
+	Filtered int
+	// FalsePositives is the number of lookups passed on to the structure
+	// for a key it does not hold.
+	FalsePositives int
+	// Hits is the number of lookups of keys the structure holds.
+	Hits int
+}
+
+// FilterRate returns the fraction of the lookups answered by the filter
+// alone, or 0 before any lookup.
+func (s Stats) FilterRate() float64 {
+	if s.Lookups == 0 {
+		return 0
+	}
+	return float64(s.Filtered) / float64(s.Lookups)
+}
+
+// FalsePositiveRate returns the fraction of the lookups of missing keys that
+// the filter let through, or 0 before any such lookup.
+func (s Stats) FalsePositiveRate() float64 {
+	misses := s.Filtered + s.FalsePositives
+	if misses == 0 {
+		return 0
+	}
+	return float64(s.FalsePositives) / float64(misses)
+}
+
+// Filtered is a set or map M whose lookups first go through a Bloom filter.
+type Filtered[K any, M Map[K]] struct {
+	m        M
+	insert   func(m M, key K)
+	encode   func(K) []byte
+	filter   *sketch.BloomFilter
+	expected int
+	rate     float64
+	stats    Stats
+}
+
+// New wraps m, which must be empty or have its keys given to Rebuild, with a
+// Bloom filter sized for expected keys and a false positive rate of p. From
+// then on m must only be changed through the wrapper, which inserts keys in
+// m with insert, such as the Push method of the trees or the Insert method
+// of the skip list. Keys are turned into the bytes hashed by the filter with
+// encode, which must give equal bytes for equal keys. It returns an error
+// when expected is not positive or p is not between 0 and 1.
+func New[K any, M Map[K]](m M, insert func(m M, key K), encode func(K) []byte, expected int, p float64) (*Filtered[K, M], error) {
+	filter, err := sketch.NewBloomFilter(expected, p)
+	if err != nil {
+		return nil, err
+	}
+	return &Filtered[K, M]{m: m, insert: insert, encode: encode, filter: filter, expected: expected, rate: p}, nil
+}
+
+// Insert inserts key in the structure and records it in the filter.
+func (f *Filtered[K, M]) Insert(key K) {
+	f.insert(f.m, key)
+	f.filter.Add(f.encode(key))
+}
+
+// Delete removes key from the structure and reports whether it was present.
+// The key stays in the filter until Rebuild.
+func (f *Filtered[K, M]) Delete(key K) bool {
+	return f.m.Delete(key)
+}
+
+// Has reports whether the structure holds key, asking it only when the
+// filter does not rule the key out.
+func (f *Filtered[K, M]) Has(key K) bool {
+	f.stats.Lookups++
+	if !f.filter.Test(f.encode(key)) {
+		f.stats.Filtered++
+		return false
+	}
+	if f.m.Has(key) {
+		f.stats.Hits++
+		return true
+	}
+	f.stats.FalsePositives++
+	return false
+}
+
+// Rebuild replaces the filter by a filter of the keys of the structure,
+// listed by keys, such as the InOrderSeq method of the trees or the All
+// method of the skip list. The filter is sized for the larger of their
+// number and the expected number of keys given to New. The statistics are
+// kept.
+func (f *Filtered[K, M]) Rebuild(keys func(m M) iterutil.Seq[K]) {
+	encoded := iterutil.Collect(iterutil.Map(keys(f.m), f.encode))
+	n := f.expected
+	if len(encoded) > n {
+		n = len(encoded)
+	}
+	filter, _ := sketch.NewBloomFilter(n, f.rate)
+	for _, e := range encoded {
+		filter.Add(e)
+	}
+	f.filter = filter
+}
+
+// Stats returns the counts of the lookups so far.
+func (f *Filtered[K, M]) Stats() Stats {
+	return f.stats
+}
+
+// ResetStats sets the counts of the lookups back to zero.
+func (f *Filtered[K, M]) ResetStats() {
+	f.stats = Stats{}
+}
@@ -0,0 +1,128 @@
+package filtered_test
+
+import (
+	"encoding/binary"
+	"math/rand"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/structure/filtered"
+	"github.com/TheAlgorithms/Go/structure/skiplist"
+	"github.com/TheAlgorithms/Go/structure/tree"
+)
+
+func encodeInt(k int) []byte {
+	return binary.LittleEndian.AppendUint64(nil, uint64(k))
+}
+
+func pushRB(rb *tree.RB[int], k int) {
+	rb.Push(k)
+}
+
+func TestFiltered(t *testing.T) {
+	if _, err := filtered.New[int](tree.NewRB[int](), pushRB, encodeInt, 0, 0.01); err == nil {
+		t.Errorf("New with no expected key should fail")
+	}
+	f, err := filtered.New[int](tree.NewRB[int](), pushRB, encodeInt, 1000, 0.01)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for k := 0; k < 2000; k += 2 {
+		f.Insert(k)
+	}
+	for k := 0; k < 2000; k++ {
+		if got := f.Has(k); got != (k%2 == 0) {
+			t.Fatalf("Has(%d) = %t", k, got)
+		}
+	}
+	s := f.Stats()
+	if s.Lookups != 2000 || s.Hits != 1000 || s.Filtered+s.FalsePositives != 1000 {
+		t.Errorf("Stats() = %+v", s)
+	}
+	if rate := s.FalsePositiveRate(); rate > 0.03 {
+		t.Errorf("false positive rate %v, want about 0.01", rate)
+	}
+	if rate := s.FilterRate(); rate < 0.45 {
+		t.Errorf("filter rate %v, want about 0.5", rate)
+	}
+
+	// deleted keys stay in the filter until it is rebuilt
+	for k := 0; k < 2000; k += 4 {
+		if !f.Delete(k) {
+			t.Fatalf("Delete(%d) = false for a key inserted", k)
+		}
+	}
+	f.ResetStats()
+	for k := 0; k < 2000; k += 4 {
+		if f.Has(k) {
+			t.Fatalf("Has(%d) after deleting it", k)
+		}
+	}
+	if s := f.Stats(); s.FalsePositives != 500 {
+		t.Errorf("%d false positives on deleted keys before Rebuild, want 500", s.FalsePositives)
+	}
+	f.Rebuild((*tree.RB[int]).InOrderSeq)
+	f.ResetStats()
+	for k := 0; k < 2000; k += 4 {
+		f.Has(k)
+	}
+	if s := f.Stats(); s.FalsePositives > 20 {
+		t.Errorf("%d false positives on deleted keys after Rebuild", s.FalsePositives)
+	}
+}
+
+func TestFilteredSkipList(t *testing.T) {
+	insert := func(s *skiplist.SkipList[int], k int) { s.Insert(k) }
+	f, _ := filtered.New[int](skiplist.New[int](1), insert, encodeInt, 100, 0.05)
+	keys := map[int]bool{}
+	for _, k := range []int{3, 1, 4, 1, 5, 9, 2, 6} {
+		f.Insert(k)
+		keys[k] = true
+	}
+	for k := 0; k < 10; k++ {
+		if got := f.Has(k); got != keys[k] {
+			t.Errorf("Has(%d) = %t, want %t", k, got, keys[k])
+		}
+	}
+	f.Delete(4)
+	f.Rebuild((*skiplist.SkipList[int]).All)
+	if f.Has(4) || !f.Has(5) {
+		t.Errorf("Has after deleting 4 and rebuilding disagrees with the keys")
+	}
+}
+
+// lookups returns keys of which a fraction hit are in [0, n) and the others
+// are missing.
+func lookups(n int, hit float64) []int {
+	rnd := rand.New(rand.NewSource(2))
+	keys := make([]int, 1<<16)
+	for i := range keys {
+		keys[i] = rnd.Intn(n)
+		if rnd.Float64() >= hit {
+			keys[i] += n
+		}
+	}
+	return keys
+}
+
+func benchmarkLookups(b *testing.B, useFilter bool) {
+	const n = 1 << 18
+	rb := tree.NewRB[int]()
+	f, _ := filtered.New[int](rb, pushRB, encodeInt, n, 0.01)
+	for _, k := range rand.New(rand.NewSource(1)).Perm(n) {
+		f.Insert(k)
+	}
+	keys := lookups(n, 0.1)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		k := keys[i&(len(keys)-1)]
+		if useFilter {
+			f.Has(k)
+		} else {
+			rb.Has(k)
+		}
+	}
+}
+
+// The lookups are 90% misses, the case the filter speeds up.
+func BenchmarkMissesRB(b *testing.B)         { benchmarkLookups(b, false) }
+func BenchmarkMissesFilteredRB(b *testing.B) { benchmarkLookups(b, true) }
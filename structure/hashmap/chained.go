@@ -0,0 +1,122 @@
+// chained.go
+// description: Generic hash map with separate chaining
+// details:
+// Every bucket of the table holds a linked list of the entries whose hash
+// selects it. The table doubles when the load factor (entries per bucket)
+// goes above 0.75 and halves when it drops below 0.125, so the chains stay
+// short on average.
+// average time complexity: O(1) for Put, Get and Delete, O(n) in the worst case
+// space complexity: O(n)
+// reference: https://en.wikipedia.org/wiki/Hash_table#Separate_chaining
+// see maps_test.go
+
+package hashmap
+
+import "github.com/TheAlgorithms/Go/structure/iterutil"
+
+type chainedEntry[K comparable, V any] struct {
+	key   K
+	value V
+	next  *chainedEntry[K, V]
+}
+
+// ChainedHashMap is a hash map resolving collisions with separate chaining.
+type ChainedHashMap[K comparable, V any] struct {
+	hash    HashFunc[K]
+	buckets []*chainedEntry[K, V]
+	size    int
+}
+
+// NewChained creates an empty ChainedHashMap hashing keys with hash, or with
+// DefaultHash when hash is nil.
+func NewChained[K comparable, V any](hash HashFunc[K]) *ChainedHashMap[K, V] {
+	if hash == nil {
+		hash = DefaultHash[K]
+	}
+	return &ChainedHashMap[K, V]{hash: hash, buckets: make([]*chainedEntry[K, V], minGenericCapacity)}
+}
+
+// Len returns the number of entries in the map.
+func (m *ChainedHashMap[K, V]) Len() int {
+	return m.size
+}
+
+func (m *ChainedHashMap[K, V]) bucket(key K) int {
+	return int(mix(m.hash(key)) & uint64(len(m.buckets)-1))
+}
+
+// Get returns the value stored for key and whether it was present.
+func (m *ChainedHashMap[K, V]) Get(key K) (V, bool) {
+	for e := m.buckets[m.bucket(key)]; e != nil; e = e.next {
+		if e.key == key {
+			return e.value, true
+		}
+	}
+	var zero V
+	return zero, false
+}
+
+// Put stores value for key, replacing any previous value.
+func (m *ChainedHashMap[K, V]) Put(key K, value V) {
+	b := m.bucket(key)
+	for e := m.buckets[b]; e != nil; e = e.next {
+		if e.key == key {
+			e.value = value
+			return
+		}
+	}
+	m.buckets[b] = &chainedEntry[K, V]{key: key, value: value, next: m.buckets[b]}
+	m.size++
+	if float64(m.size) > maxLoadFactor*float64(len(m.buckets)) {
+		m.resize(2 * len(m.buckets))
+	}
+}
+
+// Delete removes key and reports whether it was present.
+func (m *ChainedHashMap[K, V]) Delete(key K) bool {
+	b := m.bucket(key)
+	for link := &m.buckets[b]; *link != nil; link = &(*link).next {
+		if (*link).key == key {
+			*link = (*link).next
+			m.size--
+			if len(m.buckets) > minGenericCapacity && float64(m.size) < minLoadFactor*float64(len(m.buckets)) {
+				m.resize(len(m.buckets) / 2)
+			}
+			return true
+		}
+	}
+	return false
+}
+
+// Range calls f for every entry in an unspecified order, until f returns
+// false. The map must not be modified during the iteration.
+func (m *ChainedHashMap[K, V]) Range(f func(key K, value V) bool) {
+	for _, head := range m.buckets {
+		for e := head; e != nil; e = e.next {
+			if !f(e.key, e.value) {
+				return
+			}
+		}
+	}
+}
+
+// All returns the entries in an unspecified order as a lazy sequence of
+// keys and values. The map must not be modified during the iteration.
+func (m *ChainedHashMap[K, V]) All() iterutil.Seq2[K, V] {
+	return m.Range
+}
+
+// resize moves every entry to a table of the given number of buckets.
+func (m *ChainedHashMap[K, V]) resize(capacity int) {
+	old := m.buckets
+	m.buckets = make([]*chainedEntry[K, V], capacity)
+	for _, head := range old {
+		for e := head; e != nil; {
+			next := e.next
+			b := m.bucket(e.key)
+			e.next = m.buckets[b]
+			m.buckets[b] = e
+			e = next
+		}
+	}
+}
@@ -0,0 +1,38 @@
+// hashfunc.go
+// description: Hash functions and load factor limits shared by the generic hash maps
+// see maps_test.go
+
+package hashmap
+
+import (
+	"fmt"
+	"hash/fnv"
+)
+
+// HashFunc computes the hash of a key for the generic hash maps. Equal keys
+// must have equal hashes.
+type HashFunc[K comparable] func(key K) uint64
+
+// DefaultHash hashes the printed form of key with FNV-1a, the same way
+// HashMap does. It works for any comparable type, but a hash function written
+// for the key type is much faster.
+func DefaultHash[K comparable](key K) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(fmt.Sprintf("%v", key)))
+	return h.Sum64()
+}
+
+const (
+	minGenericCapacity = 8
+	maxLoadFactor      = 0.75
+	minLoadFactor      = 0.125
+)
+
+// mix spreads the bits of a hash so that its low bits, used to pick a bucket,
+// depend on all of them.
+func mix(h uint64) uint64 {
+	h ^= h >> 33
+	h *= 0xff51afd7ed558ccd
+	h ^= h >> 33
+	return h
+}
@@ -3,6 +3,8 @@ package hashmap
 import (
 	"fmt"
 	"hash/fnv"
+
+	"github.com/TheAlgorithms/Go/structure/iterutil"
 )
 
 var defaultCapacity uint64 = 1 << 10
@@ -76,6 +78,20 @@ func (hm *HashMap) Contains(key any) bool {
 	return hm.getNodeByKey(key) != nil
 }
 
+// All returns the key-value pairs in an unspecified order as a lazy
+// sequence. The hashmap must not be modified during the iteration.
+func (hm *HashMap) All() iterutil.Seq2[any, any] {
+	return func(yield func(key, value any) bool) {
+		for _, head := range hm.table {
+			for current := head; current != nil; current = current.next {
+				if !yield(current.key, current.value) {
+					return
+				}
+			}
+		}
+	}
+}
+
 // getNodeByKey finds the node associated with the given key
 func (hm *HashMap) getNodeByKey(key any) *node {
 	index := hm.hash(key)
@@ -77,4 +77,22 @@ func TestHashMap(t *testing.T) {
 			t.Errorf("Put: %v, Got: %v", got, 40)
 		}
 	})
+
+	t.Run("Test 9: Iterating over all the pairs", func(t *testing.T) {
+		mp := hashmap.New(0, 4)
+		for i := 0; i < 20; i++ {
+			mp.Put(i, i*10)
+		}
+		seen := make(map[any]bool)
+		mp.All()(func(key, value any) bool {
+			if value != key.(int)*10 {
+				t.Errorf("All gave %v = %v", key, value)
+			}
+			seen[key] = true
+			return true
+		})
+		if len(seen) != 20 {
+			t.Errorf("All visited %d keys, want 20", len(seen))
+		}
+	})
 }
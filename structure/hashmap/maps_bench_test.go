@@ -0,0 +1,83 @@
+package hashmap_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/structure/hashmap"
+	"github.com/TheAlgorithms/Go/structure/iterutil"
+)
+
+const benchmarkKeys = 1 << 16
+
+func benchmarkInput() []int {
+	rnd := rand.New(rand.NewSource(1))
+	keys := make([]int, benchmarkKeys)
+	for i := range keys {
+		keys[i] = rnd.Int()
+	}
+	return keys
+}
+
+func benchmarkGeneric(b *testing.B, newMap func() genericMap[int, int]) {
+	keys := benchmarkInput()
+	b.Run("Put", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			m := newMap()
+			for _, k := range keys {
+				m.Put(k, k)
+			}
+		}
+	})
+	m := newMap()
+	for _, k := range keys {
+		m.Put(k, k)
+	}
+	b.Run("Get", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			for _, k := range keys {
+				m.Get(k)
+			}
+		}
+	})
+}
+
+func BenchmarkChainedHashMap(b *testing.B) {
+	benchmarkGeneric(b, func() genericMap[int, int] { return hashmap.NewChained[int, int](intHash) })
+}
+
+func BenchmarkOpenAddressHashMap(b *testing.B) {
+	benchmarkGeneric(b, func() genericMap[int, int] { return hashmap.NewOpenAddress[int, int](intHash) })
+}
+
+// builtinMap adapts the built-in map to genericMap for comparison.
+type builtinMap map[int]int
+
+func (m builtinMap) Put(key, value int) { m[key] = value }
+
+func (m builtinMap) Get(key int) (int, bool) {
+	v, ok := m[key]
+	return v, ok
+}
+
+func (m builtinMap) Delete(key int) bool {
+	_, ok := m[key]
+	delete(m, key)
+	return ok
+}
+
+func (m builtinMap) Len() int { return len(m) }
+
+func (m builtinMap) Range(f func(key, value int) bool) {
+	for k, v := range m {
+		if !f(k, v) {
+			return
+		}
+	}
+}
+
+func (m builtinMap) All() iterutil.Seq2[int, int] { return m.Range }
+
+func BenchmarkBuiltinMap(b *testing.B) {
+	benchmarkGeneric(b, func() genericMap[int, int] { return builtinMap{} })
+}
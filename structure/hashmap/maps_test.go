@@ -0,0 +1,170 @@
+package hashmap_test
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/structure/hashmap"
+	"github.com/TheAlgorithms/Go/structure/iterutil"
+)
+
+// genericMap is the API shared by the generic hash maps.
+type genericMap[K comparable, V any] interface {
+	Put(key K, value V)
+	Get(key K) (V, bool)
+	Delete(key K) bool
+	Len() int
+	Range(f func(key K, value V) bool)
+	All() iterutil.Seq2[K, V]
+}
+
+func intHash(key int) uint64 { return uint64(key) }
+
+var intMaps = map[string]func(hash hashmap.HashFunc[int]) genericMap[int, int]{
+	"Chained": func(hash hashmap.HashFunc[int]) genericMap[int, int] {
+		return hashmap.NewChained[int, int](hash)
+	},
+	"OpenAddress": func(hash hashmap.HashFunc[int]) genericMap[int, int] {
+		return hashmap.NewOpenAddress[int, int](hash)
+	},
+}
+
+func TestGenericMapBasics(t *testing.T) {
+	for name, newMap := range intMaps {
+		t.Run(name, func(t *testing.T) {
+			m := newMap(nil)
+			m.Put(1, 10)
+			m.Put(2, 20)
+			m.Put(1, 11)
+			if v, ok := m.Get(1); !ok || v != 11 {
+				t.Errorf("Get(1) = %d, %v, want 11, true", v, ok)
+			}
+			if _, ok := m.Get(3); ok {
+				t.Errorf("Get(3) reported a missing key as present")
+			}
+			if m.Len() != 2 {
+				t.Errorf("Len() = %d, want 2", m.Len())
+			}
+			if !m.Delete(1) || m.Delete(1) {
+				t.Errorf("Delete(1) should succeed once")
+			}
+			if _, ok := m.Get(1); ok {
+				t.Errorf("Get(1) found a deleted key")
+			}
+			if v, ok := m.Get(2); !ok || v != 20 {
+				t.Errorf("Get(2) = %d, %v, want 20, true", v, ok)
+			}
+		})
+	}
+}
+
+func TestGenericMapStringKeys(t *testing.T) {
+	maps := map[string]genericMap[string, int]{
+		"Chained":     hashmap.NewChained[string, int](nil),
+		"OpenAddress": hashmap.NewOpenAddress[string, int](nil),
+	}
+	words := []string{"alpha", "beta", "gamma", "delta", "epsilon", "zeta", "eta", "theta", "iota", "kappa"}
+	for name, m := range maps {
+		for i, w := range words {
+			m.Put(w, i)
+		}
+		var got []string
+		m.Range(func(key string, value int) bool {
+			if words[value] != key {
+				t.Errorf("%s: Range gave %q = %d", name, key, value)
+			}
+			got = append(got, key)
+			return true
+		})
+		sort.Strings(got)
+		want := append([]string(nil), words...)
+		sort.Strings(want)
+		if len(got) != len(want) {
+			t.Fatalf("%s: Range visited %v, want %v", name, got, want)
+		}
+		for i := range got {
+			if got[i] != want[i] {
+				t.Fatalf("%s: Range visited %v, want %v", name, got, want)
+			}
+		}
+
+		visited := 0
+		m.Range(func(string, int) bool {
+			visited++
+			return visited < 3
+		})
+		if visited != 3 {
+			t.Errorf("%s: Range did not stop, visited %d entries", name, visited)
+		}
+	}
+}
+
+func TestGenericMapAll(t *testing.T) {
+	for name, newMap := range intMaps {
+		m := newMap(intHash)
+		for i := 0; i < 100; i++ {
+			m.Put(i, -i)
+		}
+		keys := iterutil.Collect(iterutil.Keys(m.All()))
+		sort.Ints(keys)
+		if len(keys) != 100 || keys[0] != 0 || keys[99] != 99 {
+			t.Errorf("%s: All gave the keys %v", name, keys)
+		}
+		for _, v := range iterutil.Collect(iterutil.Values(m.All())) {
+			if v > 0 || v <= -100 {
+				t.Errorf("%s: All gave the value %d", name, v)
+			}
+		}
+		if got := iterutil.Collect(iterutil.Take(iterutil.Keys(m.All()), 5)); len(got) != 5 {
+			t.Errorf("%s: taking 5 keys from All gave %v", name, got)
+		}
+	}
+}
+
+func TestGenericMapAgainstBuiltin(t *testing.T) {
+	// A weak hash makes many keys collide, which exercises long probe
+	// sequences and chains.
+	weak := func(key int) uint64 { return uint64(key % 64) }
+	for name, newMap := range intMaps {
+		for _, hash := range []hashmap.HashFunc[int]{nil, intHash, weak} {
+			rnd := rand.New(rand.NewSource(8))
+			m := newMap(hash)
+			want := make(map[int]int)
+			for op := 0; op < 20000; op++ {
+				key := rnd.Intn(2000)
+				switch rnd.Intn(3) {
+				case 0, 1:
+					m.Put(key, op)
+					want[key] = op
+				case 2:
+					_, present := want[key]
+					if got := m.Delete(key); got != present {
+						t.Fatalf("%s: Delete(%d) = %v, want %v", name, key, got, present)
+					}
+					delete(want, key)
+				}
+				wantValue, wantOK := want[key]
+				if v, ok := m.Get(key); v != wantValue || ok != wantOK {
+					t.Fatalf("%s: Get(%d) = %d, %v, want %d, %v", name, key, v, ok, wantValue, wantOK)
+				}
+			}
+			if m.Len() != len(want) {
+				t.Fatalf("%s: Len() = %d, want %d", name, m.Len(), len(want))
+			}
+			for key, value := range want {
+				if v, ok := m.Get(key); !ok || v != value {
+					t.Fatalf("%s: Get(%d) = %d, %v, want %d, true", name, key, v, ok, value)
+				}
+			}
+
+			// Emptying the map shrinks it without losing entries.
+			for key := range want {
+				m.Delete(key)
+			}
+			if m.Len() != 0 {
+				t.Errorf("%s: Len() = %d after deleting everything", name, m.Len())
+			}
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package hashmap_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/internal/testutil"
+	"github.com/TheAlgorithms/Go/structure/hashmap"
+)
+
+// mapModel checks a hash map against a built-in map. A poor hash makes
+// collisions frequent.
+func mapModel(newMap func(hash hashmap.HashFunc[int]) genericMap[int, int]) testutil.Model[genericMap[int, int], map[int]int] {
+	type op = testutil.Op[genericMap[int, int], map[int]int]
+	poorHash := func(key int) uint64 { return uint64(key % 4) }
+	return testutil.Model[genericMap[int, int], map[int]int]{
+		New: func() (genericMap[int, int], map[int]int) { return newMap(poorHash), map[int]int{} },
+		Ops: []op{
+			{Name: "Put", Run: func(src *testutil.Source, m genericMap[int, int], model map[int]int) (string, error) {
+				k, v := src.Intn(100), src.Intn(1000)
+				m.Put(k, v)
+				model[k] = v
+				return fmt.Sprintf("Put(%d, %d)", k, v), nil
+			}},
+			{Name: "Get", Run: func(src *testutil.Source, m genericMap[int, int], model map[int]int) (string, error) {
+				k := src.Intn(100)
+				applied := fmt.Sprintf("Get(%d)", k)
+				got, ok := m.Get(k)
+				if want, wantOK := model[k]; got != want || ok != wantOK {
+					return applied, fmt.Errorf("Get(%d) = %d, %v, want %d, %v", k, got, ok, want, wantOK)
+				}
+				return applied, nil
+			}},
+			{Name: "Delete", Run: func(src *testutil.Source, m genericMap[int, int], model map[int]int) (string, error) {
+				k := src.Intn(100)
+				applied := fmt.Sprintf("Delete(%d)", k)
+				_, want := model[k]
+				if got := m.Delete(k); got != want {
+					return applied, fmt.Errorf("Delete(%d) = %v, want %v", k, got, want)
+				}
+				delete(model, k)
+				return applied, nil
+			}},
+		},
+		Invariant: func(m genericMap[int, int], model map[int]int) error {
+			if m.Len() != len(model) {
+				return fmt.Errorf("Len() = %d, want %d", m.Len(), len(model))
+			}
+			seen := 0
+			var err error
+			m.Range(func(k, v int) bool {
+				seen++
+				if want, ok := model[k]; !ok || v != want {
+					err = fmt.Errorf("Range visited %d: %d, want %d, %v", k, v, want, ok)
+				}
+				return err == nil
+			})
+			if err == nil && seen != len(model) {
+				err = fmt.Errorf("Range visited %d entries, want %d", seen, len(model))
+			}
+			return err
+		},
+	}
+}
+
+func TestMapModel(t *testing.T) {
+	for name, newMap := range intMaps {
+		t.Run(name, func(t *testing.T) {
+			for seed := int64(0); seed < 20; seed++ {
+				mapModel(newMap).Check(t, seed, 500)
+			}
+		})
+	}
+}
+
+func FuzzHashMap(f *testing.F) {
+	f.Add([]byte{})
+	f.Add([]byte{0, 4, 1, 0, 8, 2, 2, 4, 1, 8, 1, 4})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		for _, newMap := range intMaps {
+			mapModel(newMap).Fuzz(t, data)
+		}
+	})
+}
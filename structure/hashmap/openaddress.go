@@ -0,0 +1,175 @@
+// openaddress.go
+// description: Generic hash map with open addressing and Robin Hood probing
+// details:
+// All entries live in the table itself. An entry is placed in the first free
+// slot after the one its hash selects (linear probing), and Robin Hood hashing
+// keeps the distance from that home slot balanced: while probing, an entry that
+// is further from home than the one occupying a slot takes the slot, and the
+// displaced entry continues the search. This keeps probe lengths short and
+// lets a lookup stop as soon as it meets an entry closer to home than the key
+// it looks for would be.
+// Deleted entries leave a tombstone that keeps its distance, so lookups still
+// probe past it; tombstones are reused by insertions and cleared when the
+// table is rebuilt. The table doubles when entries and tombstones take more
+// than 75% of it, and halves when entries take less than 12.5% of it.
+// average time complexity: O(1) for Put, Get and Delete, O(n) in the worst case
+// space complexity: O(n)
+// reference: https://en.wikipedia.org/wiki/Hash_table#Robin_Hood_hashing
+// reference: https://programming.guide/robin-hood-hashing.html
+// see maps_test.go
+
+package hashmap
+
+import "github.com/TheAlgorithms/Go/structure/iterutil"
+
+type slotState uint8
+
+const (
+	slotEmpty slotState = iota
+	slotFull
+	slotTombstone
+)
+
+type slot[K comparable, V any] struct {
+	key      K
+	value    V
+	distance int // distance from the home slot of key
+	state    slotState
+}
+
+// OpenAddressHashMap is a hash map resolving collisions with open addressing
+// and Robin Hood probing.
+type OpenAddressHashMap[K comparable, V any] struct {
+	hash       HashFunc[K]
+	slots      []slot[K, V]
+	size       int
+	tombstones int
+}
+
+// NewOpenAddress creates an empty OpenAddressHashMap hashing keys with hash,
+// or with DefaultHash when hash is nil.
+func NewOpenAddress[K comparable, V any](hash HashFunc[K]) *OpenAddressHashMap[K, V] {
+	if hash == nil {
+		hash = DefaultHash[K]
+	}
+	return &OpenAddressHashMap[K, V]{hash: hash, slots: make([]slot[K, V], minGenericCapacity)}
+}
+
+// Len returns the number of entries in the map.
+func (m *OpenAddressHashMap[K, V]) Len() int {
+	return m.size
+}
+
+// find returns the index of the slot holding key, or -1.
+func (m *OpenAddressHashMap[K, V]) find(key K) int {
+	mask := len(m.slots) - 1
+	i := int(mix(m.hash(key))) & mask
+	for distance := 0; ; distance++ {
+		s := &m.slots[i]
+		// key would have taken this slot if it were stored further on.
+		if s.state == slotEmpty || s.distance < distance {
+			return -1
+		}
+		if s.state == slotFull && s.key == key {
+			return i
+		}
+		i = (i + 1) & mask
+	}
+}
+
+// Get returns the value stored for key and whether it was present.
+func (m *OpenAddressHashMap[K, V]) Get(key K) (V, bool) {
+	if i := m.find(key); i >= 0 {
+		return m.slots[i].value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Put stores value for key, replacing any previous value.
+func (m *OpenAddressHashMap[K, V]) Put(key K, value V) {
+	if i := m.find(key); i >= 0 {
+		m.slots[i].value = value
+		return
+	}
+	if float64(m.size+m.tombstones+1) > maxLoadFactor*float64(len(m.slots)) {
+		capacity := len(m.slots)
+		// Only grow when the entries need it, otherwise rebuilding at the
+		// same size is enough to clear the tombstones.
+		if float64(m.size+1) > maxLoadFactor*float64(capacity)/2 {
+			capacity *= 2
+		}
+		m.resize(capacity)
+	}
+	m.insert(key, value)
+	m.size++
+}
+
+// insert places a key that is not in the table yet.
+func (m *OpenAddressHashMap[K, V]) insert(key K, value V) {
+	mask := len(m.slots) - 1
+	i := int(mix(m.hash(key))) & mask
+	carried := slot[K, V]{key: key, value: value, state: slotFull}
+	for {
+		s := &m.slots[i]
+		switch {
+		case s.state == slotEmpty:
+			*s = carried
+			return
+		case s.distance < carried.distance:
+			if s.state == slotTombstone {
+				*s = carried
+				m.tombstones--
+				return
+			}
+			// Take from the rich: the entry closer to home moves on.
+			*s, carried = carried, *s
+		}
+		carried.distance++
+		i = (i + 1) & mask
+	}
+}
+
+// Delete removes key and reports whether it was present.
+func (m *OpenAddressHashMap[K, V]) Delete(key K) bool {
+	i := m.find(key)
+	if i < 0 {
+		return false
+	}
+	m.slots[i] = slot[K, V]{distance: m.slots[i].distance, state: slotTombstone}
+	m.size--
+	m.tombstones++
+	if len(m.slots) > minGenericCapacity && float64(m.size) < minLoadFactor*float64(len(m.slots)) {
+		m.resize(len(m.slots) / 2)
+	}
+	return true
+}
+
+// Range calls f for every entry in an unspecified order, until f returns
+// false. The map must not be modified during the iteration.
+func (m *OpenAddressHashMap[K, V]) Range(f func(key K, value V) bool) {
+	for i := range m.slots {
+		if m.slots[i].state == slotFull && !f(m.slots[i].key, m.slots[i].value) {
+			return
+		}
+	}
+}
+
+// All returns the entries in an unspecified order as a lazy sequence of
+// keys and values. The map must not be modified during the iteration.
+func (m *OpenAddressHashMap[K, V]) All() iterutil.Seq2[K, V] {
+	return m.Range
+}
+
+// resize rebuilds the table with the given number of slots, dropping the
+// tombstones.
+func (m *OpenAddressHashMap[K, V]) resize(capacity int) {
+	old := m.slots
+	m.slots = make([]slot[K, V], capacity)
+	m.tombstones = 0
+	for i := range old {
+		if old[i].state == slotFull {
+			m.insert(old[i].key, old[i].value)
+		}
+	}
+}
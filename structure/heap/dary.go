@@ -0,0 +1,151 @@
+// D-ary heap is an array-based heap in which every node has d children
+// instead of two. The tree is only log_d(n) levels deep, which makes Push
+// cheaper, while Pop compares d children on every level; with d = 4 the
+// children of a node usually share a cache line, which tends to make it
+// faster than the binary heap in practice.
+//
+// For more details check out those links below here:
+// Wikipedia article: https://en.wikipedia.org/wiki/D-ary_heap
+
+package heap
+
+import (
+	"errors"
+
+	"github.com/TheAlgorithms/Go/constraints"
+	"github.com/TheAlgorithms/Go/structure/iterutil"
+)
+
+// DAry represents an array-based d-ary heap.
+type DAry[T any] struct {
+	elements []T
+	d        int
+	lessFunc func(a, b T) bool
+}
+
+// NewDAry creates a new DAry heap with d children per node for ordered types.
+func NewDAry[T constraints.Ordered](d int) (*DAry[T], error) {
+	return NewDAryAny[T](d, func(a, b T) bool { return a < b })
+}
+
+// NewDAryAny creates a new DAry heap with d children per node, d being at
+// least 2, ordered by less.
+func NewDAryAny[T any](d int, less func(a, b T) bool) (*DAry[T], error) {
+	if d < 2 {
+		return nil, errors.New("a d-ary heap needs at least 2 children per node")
+	}
+	if less == nil {
+		return nil, errors.New("less function is required to define heap ordering")
+	}
+	return &DAry[T]{d: d, lessFunc: less}, nil
+}
+
+// Push adds a new element to the heap.
+// Complexity: O(log_d n)
+func (h *DAry[T]) Push(element T) {
+	h.elements = append(h.elements, element)
+	h.up(len(h.elements) - 1)
+}
+
+// Top returns the smallest element of the heap.
+// Panics if the heap is empty.
+func (h *DAry[T]) Top() T {
+	if h.Empty() {
+		panic("cannot retrieve top element from an empty heap")
+	}
+	return h.elements[0]
+}
+
+// Pop removes the smallest element of the heap, if any.
+// Complexity: O(d log_d n)
+func (h *DAry[T]) Pop() {
+	n := len(h.elements) - 1
+	if n < 0 {
+		return
+	}
+	h.elements[0] = h.elements[n]
+	var zero T
+	h.elements[n] = zero
+	h.elements = h.elements[:n]
+	if n > 0 {
+		h.down(0)
+	}
+}
+
+// Empty reports whether the heap holds no elements.
+func (h *DAry[T]) Empty() bool {
+	return len(h.elements) == 0
+}
+
+// Size returns the number of elements in the heap.
+func (h *DAry[T]) Size() int {
+	return len(h.elements)
+}
+
+// Drain returns a lazy sequence popping the elements of the heap in order,
+// smallest first for the default comparator. Elements that are not asked
+// for stay in the heap.
+// Complexity: O(log n) per element
+func (h *DAry[T]) Drain() iterutil.Seq[T] {
+	return drainSeq[T](h)
+}
+
+// Merge moves every element of other into the heap, leaving other empty.
+// When other is a *DAry the elements are appended and the heap is rebuilt
+// bottom-up. Complexity: O(n + m)
+func (h *DAry[T]) Merge(other MergeableHeap[T]) {
+	o, ok := other.(*DAry[T])
+	if !ok {
+		drain[T](h, other)
+		return
+	}
+	if o == h {
+		return
+	}
+	h.elements = append(h.elements, o.elements...)
+	if n := len(h.elements); n > 1 {
+		// (n-2)/d is the parent of the last element
+		for i := (n - 2) / h.d; i >= 0; i-- {
+			h.down(i)
+		}
+	}
+	o.elements = nil
+}
+
+// up moves the element at index i up until its parent is not larger.
+func (h *DAry[T]) up(i int) {
+	x := h.elements[i]
+	for i > 0 {
+		parent := (i - 1) / h.d
+		if !h.lessFunc(x, h.elements[parent]) {
+			break
+		}
+		h.elements[i] = h.elements[parent]
+		i = parent
+	}
+	h.elements[i] = x
+}
+
+// down moves the element at index i down until none of its children is
+// smaller.
+func (h *DAry[T]) down(i int) {
+	x, n := h.elements[i], len(h.elements)
+	for {
+		first := i*h.d + 1
+		if first >= n {
+			break
+		}
+		smallest := first
+		for c := first + 1; c < first+h.d && c < n; c++ {
+			if h.lessFunc(h.elements[c], h.elements[smallest]) {
+				smallest = c
+			}
+		}
+		if !h.lessFunc(h.elements[smallest], x) {
+			break
+		}
+		h.elements[i] = h.elements[smallest]
+		i = smallest
+	}
+	h.elements[i] = x
+}
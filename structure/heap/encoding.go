@@ -0,0 +1,165 @@
+// encoding.go
+// description: JSON and gob encoding of the heaps
+// details:
+// A heap is encoded as the list of its elements, a JSON array in JSON. The
+// ordering function cannot be encoded: decoding goes into a heap created
+// with one of the constructors, whose ordering is kept, and the heap property
+// is restored with that ordering, so the encoded order of the elements does
+// not matter. Heap is rebuilt bottom-up in O(n), Leftist and Weak by pushing
+// the elements one by one in O(n log n).
+// see encoding_test.go
+
+package heap
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+)
+
+// ErrNoOrdering is returned when decoding into a heap that was not created
+// with a constructor, and therefore has no ordering.
+var ErrNoOrdering = errors.New("heap has no ordering: create it with a constructor before decoding")
+
+// gobEncode encodes the elements with gob.
+func gobEncode[T any](elements []T) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(elements); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// gobDecode decodes elements encoded by gobEncode.
+func gobDecode[T any](data []byte) ([]T, error) {
+	var elements []T
+	err := gob.NewDecoder(bytes.NewReader(data)).Decode(&elements)
+	return elements, err
+}
+
+// load replaces the elements of h and restores the heap property.
+func (h *Heap[T]) load(elements []T) error {
+	if h.lessFunc == nil {
+		return ErrNoOrdering
+	}
+	h.heaps = elements
+	for i := len(h.heaps)/2 - 1; i >= 0; i-- {
+		h.down(i)
+	}
+	return nil
+}
+
+// MarshalJSON encodes the elements of the heap as a JSON array.
+func (h *Heap[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(cloneElements(h.heaps, nil))
+}
+
+// UnmarshalJSON replaces the elements of the heap with a JSON array of
+// elements, in any order.
+func (h *Heap[T]) UnmarshalJSON(data []byte) error {
+	var elements []T
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return err
+	}
+	return h.load(elements)
+}
+
+// GobEncode encodes the elements of the heap with gob.
+func (h *Heap[T]) GobEncode() ([]byte, error) {
+	return gobEncode(h.heaps)
+}
+
+// GobDecode replaces the elements of the heap with elements encoded by
+// GobEncode.
+func (h *Heap[T]) GobDecode(data []byte) error {
+	elements, err := gobDecode[T](data)
+	if err != nil {
+		return err
+	}
+	return h.load(elements)
+}
+
+// load replaces the elements of h by pushing them one by one.
+func (h *Leftist[T]) load(elements []T) error {
+	if h.lessFunc == nil {
+		return ErrNoOrdering
+	}
+	h.root, h.size = nil, 0
+	for _, e := range elements {
+		h.Push(e)
+	}
+	return nil
+}
+
+// MarshalJSON encodes the elements of the heap as a JSON array.
+func (h *Leftist[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(h.elements())
+}
+
+// UnmarshalJSON replaces the elements of the heap with a JSON array of
+// elements, in any order.
+func (h *Leftist[T]) UnmarshalJSON(data []byte) error {
+	var elements []T
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return err
+	}
+	return h.load(elements)
+}
+
+// GobEncode encodes the elements of the heap with gob.
+func (h *Leftist[T]) GobEncode() ([]byte, error) {
+	return gobEncode(h.elements())
+}
+
+// GobDecode replaces the elements of the heap with elements encoded by
+// GobEncode.
+func (h *Leftist[T]) GobDecode(data []byte) error {
+	elements, err := gobDecode[T](data)
+	if err != nil {
+		return err
+	}
+	return h.load(elements)
+}
+
+// load replaces the elements of h by pushing them one by one.
+func (h *Weak[T]) load(elements []T) error {
+	if h.lessFunc == nil {
+		return ErrNoOrdering
+	}
+	h.elements, h.reverse = nil, nil
+	for _, e := range elements {
+		h.Push(e)
+	}
+	return nil
+}
+
+// MarshalJSON encodes the elements of the heap as a JSON array.
+func (h *Weak[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(cloneElements(h.elements, nil))
+}
+
+// UnmarshalJSON replaces the elements of the heap with a JSON array of
+// elements, in any order.
+func (h *Weak[T]) UnmarshalJSON(data []byte) error {
+	var elements []T
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return err
+	}
+	return h.load(elements)
+}
+
+// GobEncode encodes the elements of the heap with gob.
+func (h *Weak[T]) GobEncode() ([]byte, error) {
+	return gobEncode(h.elements)
+}
+
+// GobDecode replaces the elements of the heap with elements encoded by
+// GobEncode.
+func (h *Weak[T]) GobDecode(data []byte) error {
+	elements, err := gobDecode[T](data)
+	if err != nil {
+		return err
+	}
+	return h.load(elements)
+}
@@ -0,0 +1,105 @@
+package heap_test
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/structure/heap"
+)
+
+// codec is a heap that can be encoded with both encodings.
+type codec interface {
+	heap.MergeableHeap[int]
+	json.Marshaler
+	json.Unmarshaler
+	gob.GobEncoder
+	gob.GobDecoder
+}
+
+var codecHeaps = map[string]func() codec{
+	"Heap":    func() codec { return heap.New[int]() },
+	"Leftist": func() codec { return heap.NewLeftist[int]() },
+	"Weak":    func() codec { return heap.NewWeak[int]() },
+}
+
+func TestEncodingRoundTrip(t *testing.T) {
+	for name, newHeap := range codecHeaps {
+		t.Run(name, func(t *testing.T) {
+			rnd := rand.New(rand.NewSource(1))
+			h := newHeap()
+			for i := 0; i < 200; i++ {
+				h.Push(rnd.Intn(100))
+			}
+
+			data, err := json.Marshal(h)
+			if err != nil {
+				t.Fatalf("json.Marshal: %v", err)
+			}
+			fromJSON := newHeap()
+			if err := json.Unmarshal(data, fromJSON); err != nil {
+				t.Fatalf("json.Unmarshal: %v", err)
+			}
+
+			var buf bytes.Buffer
+			if err := gob.NewEncoder(&buf).Encode(h); err != nil {
+				t.Fatalf("gob encode: %v", err)
+			}
+			fromGob := newHeap()
+			if err := gob.NewDecoder(&buf).Decode(fromGob); err != nil {
+				t.Fatalf("gob decode: %v", err)
+			}
+
+			want := popAll(t, h)
+			if got := popAll(t, fromJSON); !reflect.DeepEqual(got, want) {
+				t.Errorf("JSON round trip popped %v, want %v", got, want)
+			}
+			if got := popAll(t, fromGob); !reflect.DeepEqual(got, want) {
+				t.Errorf("gob round trip popped %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestEncodingRestoresHeapProperty(t *testing.T) {
+	for name, newHeap := range codecHeaps {
+		t.Run(name, func(t *testing.T) {
+			// the elements of the encoding may come in any order
+			h := newHeap()
+			h.Push(100) // replaced by the decoded elements
+			if err := json.Unmarshal([]byte("[5, 9, 1, 7, 3, 3, 8]"), h); err != nil {
+				t.Fatalf("json.Unmarshal: %v", err)
+			}
+			if got, want := popAll(t, h), []int{1, 3, 3, 5, 7, 8, 9}; !reflect.DeepEqual(got, want) {
+				t.Errorf("popped %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestEncodingCustomOrdering(t *testing.T) {
+	greater := func(a, b int) bool { return a > b }
+	h, _ := heap.NewAny(greater)
+	if err := json.Unmarshal([]byte("[2, 7, 4]"), h); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+	if got := h.Top(); got != 7 {
+		t.Errorf("Top() = %d, want 7: the ordering of the heap should be kept", got)
+	}
+}
+
+func TestEncodingErrors(t *testing.T) {
+	if err := json.Unmarshal([]byte("[1, 2]"), new(heap.Heap[int])); !errors.Is(err, heap.ErrNoOrdering) {
+		t.Errorf("decoding into a zero Heap: got %v, want ErrNoOrdering", err)
+	}
+	if err := json.Unmarshal([]byte("[1, 2]"), new(heap.Leftist[int])); !errors.Is(err, heap.ErrNoOrdering) {
+		t.Errorf("decoding into a zero Leftist: got %v, want ErrNoOrdering", err)
+	}
+	if err := json.Unmarshal([]byte(`{"a": 1}`), heap.NewWeak[int]()); err == nil {
+		t.Errorf("decoding an object should fail")
+	}
+}
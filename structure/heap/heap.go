@@ -4,6 +4,7 @@ import (
 	"errors"
 
 	"github.com/TheAlgorithms/Go/constraints"
+	"github.com/TheAlgorithms/Go/structure/iterutil"
 )
 
 // Heap represents a generic binary heap implementation.
@@ -11,8 +12,13 @@ import (
 type Heap[T any] struct {
 	heaps    []T               // Slice to store heap elements.
 	lessFunc func(a, b T) bool // Comparator function to define heap ordering.
+	minCap   int               // Capacity the heap never shrinks below.
 }
 
+// shrinkFactor controls the shrink policy: once fewer than 1/shrinkFactor of
+// the allocated slots are in use, the backing slice is halved.
+const shrinkFactor = 4
+
 // New creates a new Heap instance for ordered types.
 // It uses the default comparator (a < b) for the heap's ordering.
 func New[T constraints.Ordered]() *Heap[T] {
@@ -23,6 +29,13 @@ func New[T constraints.Ordered]() *Heap[T] {
 	return h
 }
 
+// NewWithCapacity creates a new Heap instance for ordered types with room for
+// capacity elements allocated up front. The heap never shrinks below capacity.
+func NewWithCapacity[T constraints.Ordered](capacity int) *Heap[T] {
+	h, _ := NewAnyWithCapacity[T](func(a, b T) bool { return a < b }, capacity)
+	return h
+}
+
 // NewAny creates a new Heap instance for any type T.
 // The caller must provide a valid comparator function (less).
 func NewAny[T any](less func(a, b T) bool) (*Heap[T], error) {
@@ -34,6 +47,19 @@ func NewAny[T any](less func(a, b T) bool) (*Heap[T], error) {
 	}, nil
 }
 
+// NewAnyWithCapacity creates a new Heap instance for any type T ordered by
+// less, with room for capacity elements allocated up front. The heap never
+// shrinks below capacity.
+func NewAnyWithCapacity[T any](less func(a, b T) bool, capacity int) (*Heap[T], error) {
+	h, err := NewAny[T](less)
+	if err != nil {
+		return nil, err
+	}
+	h.Reserve(capacity)
+	h.minCap = cap(h.heaps)
+	return h, nil
+}
+
 // Push adds a new element to the heap.
 // Complexity: O(log n), where n is the number of elements in the heap.
 func (h *Heap[T]) Push(element T) {
@@ -57,14 +83,92 @@ func (h *Heap[T]) Pop() {
 		return
 	}
 
-	// Replace the root with the last element and shrink the slice.
-	h.swap(0, len(h.heaps)-1)
-	h.heaps = h.heaps[:len(h.heaps)-1]
+	// Replace the root with the last element and shrink the slice, zeroing
+	// the vacated slot so that the backing array does not keep the popped
+	// element reachable.
+	n := len(h.heaps) - 1
+	h.swap(0, n)
+	var zero T
+	h.heaps[n] = zero
+	h.heaps = h.heaps[:n]
 
 	// Restore the heap property by "sinking down" the root.
 	if len(h.heaps) > 0 {
 		h.down(0)
 	}
+	h.shrink()
+}
+
+// Merge moves every element of other into the heap, leaving other empty.
+// When other is a *Heap the elements are appended and the heap is rebuilt
+// bottom-up. Complexity: O(n + m).
+func (h *Heap[T]) Merge(other MergeableHeap[T]) {
+	o, ok := other.(*Heap[T])
+	if !ok {
+		drain[T](h, other)
+		return
+	}
+	if o == h {
+		return
+	}
+	h.heaps = append(h.heaps, o.heaps...)
+	for i := len(h.heaps)/2 - 1; i >= 0; i-- {
+		h.down(i)
+	}
+	var zero T
+	for i := range o.heaps {
+		o.heaps[i] = zero
+	}
+	o.heaps = o.heaps[:0]
+	o.shrink()
+}
+
+// Clone returns an independent copy of the heap with the same ordering.
+// Every element is passed through cloneElement, if it is not nil, so that
+// elements holding pointers can be deep copied.
+// Complexity: O(n)
+func (h *Heap[T]) Clone(cloneElement func(T) T) *Heap[T] {
+	return &Heap[T]{
+		heaps:    cloneElements(h.heaps, cloneElement),
+		lessFunc: h.lessFunc,
+		minCap:   h.minCap,
+	}
+}
+
+// Equal reports whether h and other hold the same elements, whatever their
+// layout. Elements are compared with eq or, if eq is nil, considered equal
+// when neither is less than the other.
+// Complexity: O(n log n)
+func (h *Heap[T]) Equal(other *Heap[T], eq func(a, b T) bool) bool {
+	return sameElements(h.heaps, other.heaps, h.lessFunc, eq)
+}
+
+// Reserve makes sure the heap can hold at least n elements in total without
+// reallocating. It never reduces the capacity.
+func (h *Heap[T]) Reserve(n int) {
+	if n <= cap(h.heaps) {
+		return
+	}
+	heaps := make([]T, len(h.heaps), n)
+	copy(heaps, h.heaps)
+	h.heaps = heaps
+}
+
+// Cap returns the number of elements the heap can hold without reallocating.
+func (h *Heap[T]) Cap() int {
+	return cap(h.heaps)
+}
+
+// shrink halves the backing slice when occupancy drops below 1/shrinkFactor,
+// so that memory taken during a burst of pushes is given back.
+func (h *Heap[T]) shrink() {
+	c := cap(h.heaps)
+	if c/2 < h.minCap || len(h.heaps)*shrinkFactor >= c {
+		return
+	}
+	heaps := make([]T, len(h.heaps), c/2)
+	copy(heaps, h.heaps)
+	h.heaps = heaps
 }
 
 // Empty checks whether the heap is empty.
@@ -77,6 +181,14 @@ func (h *Heap[T]) Size() int {
 	return len(h.heaps)
 }
 
+// Drain returns a lazy sequence popping the elements of the heap in order,
+// smallest first for the default comparator. The heap is emptied as the
+// sequence is consumed: elements that are not asked for stay in the heap.
+// Complexity: O(log n) per element
+func (h *Heap[T]) Drain() iterutil.Seq[T] {
+	return drainSeq[T](h)
+}
+
 // swap exchanges elements at indices i and j in the heap.
 func (h *Heap[T]) swap(i, j int) {
 	h.heaps[i], h.heaps[j] = h.heaps[j], h.heaps[i]
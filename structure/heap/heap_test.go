@@ -2,6 +2,7 @@ package heap_test
 
 import (
 	"github.com/TheAlgorithms/Go/structure/heap"
+	"github.com/TheAlgorithms/Go/structure/iterutil"
 	"reflect"
 	"testing"
 )
@@ -112,6 +113,82 @@ func TestHeapExample2(t *testing.T) {
 	testFunc(t, tests1, testStudent.Less)
 }
 
+func TestHeapCapacity(t *testing.T) {
+	h := heap.NewWithCapacity[int](16)
+	if h.Cap() < 16 {
+		t.Fatalf("Cap() = %d, want at least 16", h.Cap())
+	}
+	for i := 0; i < 16; i++ {
+		h.Push(16 - i)
+	}
+	if h.Cap() != 16 {
+		t.Errorf("Cap() = %d after filling reserved slots, want 16", h.Cap())
+	}
+
+	h.Reserve(1024)
+	if h.Cap() < 1024 {
+		t.Errorf("Cap() = %d after Reserve(1024), want at least 1024", h.Cap())
+	}
+	h.Reserve(10)
+	if h.Cap() < 1024 {
+		t.Errorf("Reserve must not reduce capacity, got %d", h.Cap())
+	}
+
+	for i := 1; i <= 16; i++ {
+		if got := h.Top(); got != i {
+			t.Fatalf("Top() = %d, want %d", got, i)
+		}
+		h.Pop()
+	}
+	if !h.Empty() {
+		t.Errorf("heap should be empty")
+	}
+	if h.Cap() != 16 {
+		t.Errorf("Cap() = %d after draining, want it shrunk back to 16", h.Cap())
+	}
+}
+
+func TestHeapAnyCapacity(t *testing.T) {
+	if _, err := heap.NewAnyWithCapacity[int](nil, 8); err == nil {
+		t.Errorf("NewAnyWithCapacity without a less function should fail")
+	}
+	h, err := heap.NewAnyWithCapacity(func(a, b string) bool { return len(a) > len(b) }, 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if h.Cap() < 8 {
+		t.Fatalf("Cap() = %d, want at least 8", h.Cap())
+	}
+	for _, s := range []string{"a", "abc", "ab", "abcd"} {
+		h.Push(s)
+	}
+	for _, want := range []string{"abcd", "abc", "ab", "a"} {
+		if got := h.Top(); got != want {
+			t.Fatalf("Top() = %q, want %q", got, want)
+		}
+		h.Pop()
+	}
+	if h.Cap() < 8 {
+		t.Errorf("Cap() = %d after draining, want at least 8", h.Cap())
+	}
+}
+
+func TestHeapShrink(t *testing.T) {
+	h := heap.New[int]()
+	for i := 0; i < 10000; i++ {
+		h.Push(i)
+	}
+	for i := 0; i < 9990; i++ {
+		h.Pop()
+	}
+	if h.Cap() > 4*h.Size()*2 {
+		t.Errorf("Cap() = %d with %d elements, memory was not released", h.Cap(), h.Size())
+	}
+	if got := h.Top(); got != 9990 {
+		t.Errorf("Top() = %d, want 9990", got)
+	}
+}
+
 func testFunc[T any](t *testing.T, tests []testStruct[T], less func(a, b T) bool) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -148,3 +225,22 @@ func testFunc[T any](t *testing.T, tests []testStruct[T], less func(a, b T) bool
 		})
 	}
 }
+
+func TestHeapDrain(t *testing.T) {
+	h := heap.New[int]()
+	for _, v := range []int{5, 3, 8, 1, 9, 2, 7} {
+		h.Push(v)
+	}
+	if got := iterutil.Collect(iterutil.Take(h.Drain(), 3)); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("taking 3 from Drain = %v, want [1 2 3]", got)
+	}
+	if h.Size() != 4 {
+		t.Errorf("Size after taking 3 of 7 = %d, want 4", h.Size())
+	}
+	if got := iterutil.Collect(h.Drain()); !reflect.DeepEqual(got, []int{5, 7, 8, 9}) {
+		t.Errorf("Drain = %v, want [5 7 8 9]", got)
+	}
+	if !h.Empty() {
+		t.Errorf("heap not empty after Drain")
+	}
+}
@@ -0,0 +1,178 @@
+// Leftist heap is a mergeable heap stored as a binary tree in which the
+// shortest path to an empty subtree is always found on the right. The right
+// spine therefore has O(log n) nodes, and two heaps are merged by walking
+// down their right spines.
+//
+// For more details check out those links below here:
+// Wikipedia article: https://en.wikipedia.org/wiki/Leftist_tree
+
+package heap
+
+import (
+	"errors"
+
+	"github.com/TheAlgorithms/Go/constraints"
+	"github.com/TheAlgorithms/Go/structure/iterutil"
+)
+
+// leftistNode is a single node of a Leftist heap.
+type leftistNode[T any] struct {
+	element T
+	rank    int // length of the right spine of the subtree
+	left    *leftistNode[T]
+	right   *leftistNode[T]
+}
+
+func leftistRank[T any](n *leftistNode[T]) int {
+	if n == nil {
+		return 0
+	}
+	return n.rank
+}
+
+// Leftist represents a leftist heap.
+type Leftist[T any] struct {
+	root     *leftistNode[T]
+	size     int
+	lessFunc func(a, b T) bool
+}
+
+// NewLeftist creates a new Leftist heap for ordered types.
+func NewLeftist[T constraints.Ordered]() *Leftist[T] {
+	h, _ := NewLeftistAny[T](func(a, b T) bool { return a < b })
+	return h
+}
+
+// NewLeftistAny creates a new Leftist heap for any type T ordered by less.
+func NewLeftistAny[T any](less func(a, b T) bool) (*Leftist[T], error) {
+	if less == nil {
+		return nil, errors.New("less function is required to define heap ordering")
+	}
+	return &Leftist[T]{lessFunc: less}, nil
+}
+
+// Push adds a new element to the heap.
+// Complexity: O(log n)
+func (h *Leftist[T]) Push(element T) {
+	h.root = h.meld(h.root, &leftistNode[T]{element: element, rank: 1})
+	h.size++
+}
+
+// Top returns the smallest element of the heap.
+// Panics if the heap is empty.
+func (h *Leftist[T]) Top() T {
+	if h.Empty() {
+		panic("cannot retrieve top element from an empty heap")
+	}
+	return h.root.element
+}
+
+// Pop removes the smallest element of the heap.
+// Complexity: O(log n)
+func (h *Leftist[T]) Pop() {
+	if h.Empty() {
+		return
+	}
+	h.root = h.meld(h.root.left, h.root.right)
+	h.size--
+}
+
+// Empty checks whether the heap is empty.
+func (h *Leftist[T]) Empty() bool {
+	return h.root == nil
+}
+
+// Size returns the number of elements currently in the heap.
+func (h *Leftist[T]) Size() int {
+	return h.size
+}
+
+// Drain returns a lazy sequence popping the elements of the heap in order,
+// smallest first for the default comparator. Elements that are not asked
+// for stay in the heap.
+// Complexity: O(log n) per element
+func (h *Leftist[T]) Drain() iterutil.Seq[T] {
+	return drainSeq[T](h)
+}
+
+// Merge moves every element of other into the heap, leaving other empty.
+// Merging two Leftist heaps takes O(log n + log m); the ordering of h is kept.
+func (h *Leftist[T]) Merge(other MergeableHeap[T]) {
+	o, ok := other.(*Leftist[T])
+	if !ok {
+		drain[T](h, other)
+		return
+	}
+	if o == h {
+		return
+	}
+	h.root = h.meld(h.root, o.root)
+	h.size += o.size
+	o.root, o.size = nil, 0
+}
+
+// Clone returns an independent copy of the heap with the same ordering and
+// shape. Every element is passed through cloneElement, if it is not nil.
+// Complexity: O(n)
+func (h *Leftist[T]) Clone(cloneElement func(T) T) *Leftist[T] {
+	var clone func(n *leftistNode[T]) *leftistNode[T]
+	clone = func(n *leftistNode[T]) *leftistNode[T] {
+		if n == nil {
+			return nil
+		}
+		c := &leftistNode[T]{element: n.element, rank: n.rank, left: clone(n.left), right: clone(n.right)}
+		if cloneElement != nil {
+			c.element = cloneElement(c.element)
+		}
+		return c
+	}
+	return &Leftist[T]{root: clone(h.root), size: h.size, lessFunc: h.lessFunc}
+}
+
+// Equal reports whether h and other hold the same elements, compared with
+// eq or, if eq is nil, by the ordering of h.
+// Complexity: O(n log n)
+func (h *Leftist[T]) Equal(other *Leftist[T], eq func(a, b T) bool) bool {
+	return sameElements(h.elements(), other.elements(), h.lessFunc, eq)
+}
+
+// elements returns the elements of the heap in no particular order.
+func (h *Leftist[T]) elements() []T {
+	elements := make([]T, 0, h.size)
+	stack := []*leftistNode[T]{}
+	if h.root != nil {
+		stack = append(stack, h.root)
+	}
+	for len(stack) > 0 {
+		n := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		elements = append(elements, n.element)
+		if n.left != nil {
+			stack = append(stack, n.left)
+		}
+		if n.right != nil {
+			stack = append(stack, n.right)
+		}
+	}
+	return elements
+}
+
+// meld merges the trees rooted at a and b and returns the new root.
+func (h *Leftist[T]) meld(a, b *leftistNode[T]) *leftistNode[T] {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	if h.lessFunc(b.element, a.element) {
+		a, b = b, a
+	}
+	a.right = h.meld(a.right, b)
+	// Restore the leftist property: the left spine must not be shorter.
+	if leftistRank(a.left) < leftistRank(a.right) {
+		a.left, a.right = a.right, a.left
+	}
+	a.rank = leftistRank(a.right) + 1
+	return a
+}
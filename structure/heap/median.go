@@ -0,0 +1,69 @@
+// Running median keeps the median of a stream of numbers with two heaps: a
+// max-heap holding the lower half of the values and a min-heap holding the
+// upper half, the lower half having as many values as the upper half or one
+// more. The median is then at the top of one heap or between both tops.
+// Add takes O(log n) and Median O(1).
+//
+// For more details check out those links below here:
+// https://leetcode.com/problems/find-median-from-data-stream/
+
+package heap
+
+import "github.com/TheAlgorithms/Go/constraints"
+
+// RunningMedian is the median of the numbers added so far.
+type RunningMedian[T constraints.Number] struct {
+	lower *Heap[T] // max-heap of the smaller half
+	upper *Heap[T] // min-heap of the larger half
+}
+
+// NewRunningMedian creates a running median of no number.
+func NewRunningMedian[T constraints.Number]() *RunningMedian[T] {
+	lower, _ := NewAny[T](func(a, b T) bool { return a > b })
+	return &RunningMedian[T]{lower: lower, upper: New[T]()}
+}
+
+// Add adds x to the numbers.
+// Complexity: O(log n)
+func (m *RunningMedian[T]) Add(x T) {
+	if m.lower.Empty() || x <= m.lower.Top() {
+		m.lower.Push(x)
+	} else {
+		m.upper.Push(x)
+	}
+	if m.lower.Size() > m.upper.Size()+1 {
+		m.upper.Push(m.lower.Top())
+		m.lower.Pop()
+	} else if m.upper.Size() > m.lower.Size() {
+		m.lower.Push(m.upper.Top())
+		m.upper.Pop()
+	}
+}
+
+// Len returns the number of numbers added.
+func (m *RunningMedian[T]) Len() int {
+	return m.lower.Size() + m.upper.Size()
+}
+
+// Median returns the median of the numbers, the mean of the two middle ones
+// when there is an even number of them, and false when there is none.
+// Complexity: O(1)
+func (m *RunningMedian[T]) Median() (float64, bool) {
+	if m.lower.Empty() {
+		return 0, false
+	}
+	if m.lower.Size() > m.upper.Size() {
+		return float64(m.lower.Top()), true
+	}
+	return (float64(m.lower.Top()) + float64(m.upper.Top())) / 2, true
+}
+
+// Lower returns the lower median, the middle number or the smaller of the
+// two middle ones, and false when there is none.
+func (m *RunningMedian[T]) Lower() (T, bool) {
+	if m.lower.Empty() {
+		var zero T
+		return zero, false
+	}
+	return m.lower.Top(), true
+}
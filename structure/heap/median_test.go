@@ -0,0 +1,58 @@
+package heap_test
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/structure/heap"
+)
+
+func TestRunningMedian(t *testing.T) {
+	m := heap.NewRunningMedian[int]()
+	if _, ok := m.Median(); ok {
+		t.Errorf("Median() of no number found one")
+	}
+	tests := []struct {
+		x      int
+		median float64
+		lower  int
+	}{
+		{5, 5, 5},
+		{15, 10, 5},
+		{1, 5, 5},
+		{3, 4, 3},
+		{8, 5, 5},
+		{-7, 4, 3},
+	}
+	for _, test := range tests {
+		m.Add(test.x)
+		if got, _ := m.Median(); got != test.median {
+			t.Errorf("after Add(%d) Median() = %v, want %v", test.x, got, test.median)
+		}
+		if got, _ := m.Lower(); got != test.lower {
+			t.Errorf("after Add(%d) Lower() = %v, want %v", test.x, got, test.lower)
+		}
+	}
+	if m.Len() != len(tests) {
+		t.Errorf("Len() = %d, want %d", m.Len(), len(tests))
+	}
+}
+
+func TestRunningMedianRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	m := heap.NewRunningMedian[float64]()
+	var values []float64
+	for i := 0; i < 1000; i++ {
+		x := float64(rnd.Intn(100))
+		m.Add(x)
+		values = append(values, x)
+		sorted := append([]float64(nil), values...)
+		sort.Float64s(sorted)
+		n := len(sorted)
+		want := (sorted[(n-1)/2] + sorted[n/2]) / 2
+		if got, _ := m.Median(); got != want {
+			t.Fatalf("Median() of %d numbers = %v, want %v", n, got, want)
+		}
+	}
+}
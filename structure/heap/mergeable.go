@@ -0,0 +1,93 @@
+package heap
+
+import (
+	"sort"
+
+	"github.com/TheAlgorithms/Go/structure/iterutil"
+)
+
+// MergeableHeap is the interface shared by the heap implementations of this
+// package. Top and Pop act on the smallest element according to the less
+// function the heap was created with.
+type MergeableHeap[T any] interface {
+	// Push adds an element to the heap.
+	Push(element T)
+	// Top returns the smallest element. It panics if the heap is empty.
+	Top() T
+	// Pop removes the smallest element, if any.
+	Pop()
+	// Empty reports whether the heap holds no elements.
+	Empty() bool
+	// Size returns the number of elements in the heap.
+	Size() int
+	// Merge moves every element of other into the heap, leaving other empty.
+	Merge(other MergeableHeap[T])
+	// Drain returns a lazy sequence popping the elements in order.
+	Drain() iterutil.Seq[T]
+}
+
+// Verify Interface Compliance
+var (
+	_ MergeableHeap[int] = &Heap[int]{}
+	_ MergeableHeap[int] = &Leftist[int]{}
+	_ MergeableHeap[int] = &Weak[int]{}
+	_ MergeableHeap[int] = &DAry[int]{}
+)
+
+// drain moves every element of src into dst one at a time. It is the
+// fallback used when two heaps of different kinds are merged.
+func drain[T any](dst, src MergeableHeap[T]) {
+	for !src.Empty() {
+		dst.Push(src.Top())
+		src.Pop()
+	}
+}
+
+// drainSeq returns the sequence popping the elements of h in order, which
+// leaves the elements that are not asked for in h. It is shared by the
+// Drain methods of the heaps.
+func drainSeq[T any](h MergeableHeap[T]) iterutil.Seq[T] {
+	return func(yield func(T) bool) {
+		for !h.Empty() {
+			top := h.Top()
+			h.Pop()
+			if !yield(top) {
+				return
+			}
+		}
+	}
+}
+
+// cloneElements returns a copy of elements, passing every element through
+// clone when it is not nil.
+func cloneElements[T any](elements []T, clone func(T) T) []T {
+	c := make([]T, len(elements))
+	copy(c, elements)
+	if clone != nil {
+		for i := range c {
+			c[i] = clone(c[i])
+		}
+	}
+	return c
+}
+
+// sameElements reports whether a and b hold the same elements in any order.
+// Both are sorted with less and compared pairwise with eq or, when eq is nil,
+// two elements are equal if neither is less than the other.
+func sameElements[T any](a, b []T, less, eq func(a, b T) bool) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	if eq == nil {
+		eq = func(x, y T) bool { return !less(x, y) && !less(y, x) }
+	}
+	a, b = cloneElements(a, nil), cloneElements(b, nil)
+	sort.Slice(a, func(i, j int) bool { return less(a[i], a[j]) })
+	sort.Slice(b, func(i, j int) bool { return less(b[i], b[j]) })
+	for i := range a {
+		if !eq(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
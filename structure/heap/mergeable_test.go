@@ -0,0 +1,187 @@
+package heap_test
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/structure/heap"
+	"github.com/TheAlgorithms/Go/structure/iterutil"
+)
+
+var mergeableHeaps = map[string]func() heap.MergeableHeap[int]{
+	"Heap":    func() heap.MergeableHeap[int] { return heap.New[int]() },
+	"Leftist": func() heap.MergeableHeap[int] { return heap.NewLeftist[int]() },
+	"Weak":    func() heap.MergeableHeap[int] { return heap.NewWeak[int]() },
+	"DAry":    func() heap.MergeableHeap[int] { h, _ := heap.NewDAry[int](4); return h },
+}
+
+// popAll drains h and checks that the elements come out sorted.
+func popAll(t *testing.T, h heap.MergeableHeap[int]) []int {
+	t.Helper()
+	var out []int
+	for !h.Empty() {
+		out = append(out, h.Top())
+		h.Pop()
+	}
+	if !sort.IntsAreSorted(out) {
+		t.Fatalf("elements popped out of order: %v", out)
+	}
+	return out
+}
+
+func TestMergeableHeapConformance(t *testing.T) {
+	for name, newHeap := range mergeableHeaps {
+		t.Run(name, func(t *testing.T) {
+			rnd := rand.New(rand.NewSource(1))
+			h := newHeap()
+			h.Pop() // popping an empty heap is a no-op
+			var want []int
+			for i := 0; i < 1000; i++ {
+				if rnd.Intn(3) == 0 && !h.Empty() {
+					sort.Ints(want)
+					if got := h.Top(); got != want[0] {
+						t.Fatalf("Top() = %d, want %d", got, want[0])
+					}
+					h.Pop()
+					want = want[1:]
+				} else {
+					x := rnd.Intn(500)
+					h.Push(x)
+					want = append(want, x)
+				}
+				if h.Size() != len(want) {
+					t.Fatalf("Size() = %d, want %d", h.Size(), len(want))
+				}
+			}
+			sort.Ints(want)
+			got := popAll(t, h)
+			if len(got) != len(want) {
+				t.Fatalf("popped %d elements, want %d", len(got), len(want))
+			}
+		})
+	}
+}
+
+func TestMergeableHeapMerge(t *testing.T) {
+	for dstName, newDst := range mergeableHeaps {
+		for srcName, newSrc := range mergeableHeaps {
+			t.Run(dstName+"<-"+srcName, func(t *testing.T) {
+				dst, src := newDst(), newSrc()
+				for i := 0; i < 50; i++ {
+					dst.Push(2 * i)
+					src.Push(2*i + 1)
+				}
+				dst.Merge(src)
+				if !src.Empty() {
+					t.Errorf("source heap should be empty after Merge")
+				}
+				got := popAll(t, dst)
+				for i, x := range got {
+					if x != i {
+						t.Fatalf("merged heap popped %d at position %d", x, i)
+					}
+				}
+				if len(got) != 100 {
+					t.Errorf("merged heap has %d elements, want 100", len(got))
+				}
+			})
+		}
+	}
+}
+
+func TestMergeableHeapDrain(t *testing.T) {
+	for name, newHeap := range mergeableHeaps {
+		t.Run(name, func(t *testing.T) {
+			h := newHeap()
+			for _, v := range rand.New(rand.NewSource(2)).Perm(100) {
+				h.Push(v)
+			}
+			got := iterutil.Collect(iterutil.Take(h.Drain(), 10))
+			if !reflect.DeepEqual(got, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}) || h.Size() != 90 {
+				t.Fatalf("taking 10 from Drain = %v, leaving %d", got, h.Size())
+			}
+			if got := iterutil.Collect(h.Drain()); len(got) != 90 || got[0] != 10 || !sort.IntsAreSorted(got) || !h.Empty() {
+				t.Errorf("Drain = %v, leaving %d", got, h.Size())
+			}
+		})
+	}
+}
+
+func TestMergeableHeapAny(t *testing.T) {
+	if _, err := heap.NewLeftistAny[testStudent](nil); err == nil {
+		t.Errorf("NewLeftistAny(nil) should fail")
+	}
+	if _, err := heap.NewWeakAny[testStudent](nil); err == nil {
+		t.Errorf("NewWeakAny(nil) should fail")
+	}
+	if _, err := heap.NewDAryAny[testStudent](4, nil); err == nil {
+		t.Errorf("NewDAryAny(4, nil) should fail")
+	}
+	if _, err := heap.NewDAry[int](1); err == nil {
+		t.Errorf("NewDAry(1) should fail")
+	}
+
+	h, _ := heap.NewWeakAny(testStudent.Less)
+	h.Push(testStudent{Name: "b", Score: 10})
+	h.Push(testStudent{Name: "a", Score: 10})
+	h.Push(testStudent{Name: "c", Score: 30})
+	if got := h.Top(); got.Name != "c" {
+		t.Errorf("Top() = %v, want c", got)
+	}
+}
+
+func TestClone(t *testing.T) {
+	type job struct{ priority int }
+	less := func(a, b *job) bool { return a.priority < b.priority }
+	cloneJob := func(j *job) *job { c := *j; return &c }
+	samePriority := func(a, b *job) bool { return a.priority == b.priority }
+
+	h, _ := heap.NewAny(less)
+	l, _ := heap.NewLeftistAny(less)
+	w, _ := heap.NewWeakAny(less)
+	for _, p := range []int{5, 3, 8, 1, 9, 2} {
+		h.Push(&job{p})
+		l.Push(&job{p})
+		w.Push(&job{p})
+	}
+	hc, lc, wc := h.Clone(cloneJob), l.Clone(cloneJob), w.Clone(cloneJob)
+	if !h.Equal(hc, samePriority) || !l.Equal(lc, samePriority) || !w.Equal(wc, nil) {
+		t.Fatalf("clones should equal their originals")
+	}
+
+	// the clones are deep: changing an original element leaves them alone
+	h.Top().priority, l.Top().priority, w.Top().priority = 0, 0, 0
+	if h.Equal(hc, samePriority) || l.Equal(lc, samePriority) || w.Equal(wc, samePriority) {
+		t.Errorf("changing an original element should not affect the clone")
+	}
+
+	// and independent: popping the clones leaves the originals alone
+	for _, c := range []heap.MergeableHeap[*job]{hc, lc, wc} {
+		var got []int
+		for !c.Empty() {
+			got = append(got, c.Top().priority)
+			c.Pop()
+		}
+		if !sort.IntsAreSorted(got) || len(got) != 6 || got[0] != 1 {
+			t.Errorf("clone popped %v", got)
+		}
+	}
+	if h.Size() != 6 || l.Size() != 6 || w.Size() != 6 {
+		t.Errorf("popping a clone should not affect the original")
+	}
+
+	a, b := heap.New[int](), heap.New[int]()
+	for i := 0; i < 10; i++ {
+		a.Push(i)
+		b.Push(9 - i)
+	}
+	if !a.Equal(b, nil) {
+		t.Errorf("heaps with the same elements pushed in different orders should be equal")
+	}
+	b.Push(3)
+	if a.Equal(b, nil) {
+		t.Errorf("heaps of different sizes should not be equal")
+	}
+}
@@ -0,0 +1,82 @@
+package heap_test
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/internal/testutil"
+	"github.com/TheAlgorithms/Go/structure/heap"
+)
+
+// heapModel checks a heap against a sorted slice.
+func heapModel(newHeap func() heap.MergeableHeap[int]) testutil.Model[heap.MergeableHeap[int], *[]int] {
+	type op = testutil.Op[heap.MergeableHeap[int], *[]int]
+	return testutil.Model[heap.MergeableHeap[int], *[]int]{
+		New: func() (heap.MergeableHeap[int], *[]int) { return newHeap(), &[]int{} },
+		Ops: []op{
+			{Name: "Push", Run: func(src *testutil.Source, h heap.MergeableHeap[int], m *[]int) (string, error) {
+				x := src.Intn(64)
+				h.Push(x)
+				i := sort.SearchInts(*m, x)
+				*m = append(*m, 0)
+				copy((*m)[i+1:], (*m)[i:])
+				(*m)[i] = x
+				return fmt.Sprintf("Push(%d)", x), nil
+			}},
+			{Name: "Pop", Run: func(src *testutil.Source, h heap.MergeableHeap[int], m *[]int) (string, error) {
+				if len(*m) == 0 {
+					h.Pop()
+					return "", nil
+				}
+				if got := h.Top(); got != (*m)[0] {
+					return "", fmt.Errorf("Top() = %d, want %d", got, (*m)[0])
+				}
+				h.Pop()
+				*m = (*m)[1:]
+				return "", nil
+			}},
+			{Name: "Merge", Run: func(src *testutil.Source, h heap.MergeableHeap[int], m *[]int) (string, error) {
+				other := newHeap()
+				n := src.Intn(4)
+				for i := 0; i < n; i++ {
+					x := src.Intn(64)
+					other.Push(x)
+					*m = append(*m, x)
+				}
+				sort.Ints(*m)
+				h.Merge(other)
+				return fmt.Sprintf("Merge(%d elements)", n), nil
+			}},
+		},
+		Invariant: func(h heap.MergeableHeap[int], m *[]int) error {
+			if h.Size() != len(*m) || h.Empty() != (len(*m) == 0) {
+				return fmt.Errorf("Size() = %d, want %d", h.Size(), len(*m))
+			}
+			if len(*m) > 0 && h.Top() != (*m)[0] {
+				return fmt.Errorf("Top() = %d, want %d", h.Top(), (*m)[0])
+			}
+			return nil
+		},
+	}
+}
+
+func TestHeapModel(t *testing.T) {
+	for name, newHeap := range mergeableHeaps {
+		t.Run(name, func(t *testing.T) {
+			for seed := int64(0); seed < 20; seed++ {
+				heapModel(newHeap).Check(t, seed, 300)
+			}
+		})
+	}
+}
+
+func FuzzHeap(f *testing.F) {
+	f.Add([]byte{})
+	f.Add([]byte{0, 5, 0, 3, 1, 2, 3, 1, 9, 1})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		for _, newHeap := range mergeableHeaps {
+			heapModel(newHeap).Fuzz(t, data)
+		}
+	})
+}
@@ -0,0 +1,169 @@
+// Weak heap is a binary heap relaxation: every element is only required to
+// be no smaller than its distinguished ancestor, the parent of the closest
+// ancestor that is a right child. Each node carries a reverse bit which swaps
+// the roles of its children, so subtrees can be exchanged in O(1). This lowers
+// the number of comparisons of Pop to about log2(n), close to the optimum.
+//
+// For more details check out those links below here:
+// Wikipedia article: https://en.wikipedia.org/wiki/Weak_heap
+
+package heap
+
+import (
+	"errors"
+
+	"github.com/TheAlgorithms/Go/constraints"
+	"github.com/TheAlgorithms/Go/structure/iterutil"
+)
+
+// Weak represents an array-based weak heap.
+type Weak[T any] struct {
+	elements []T
+	reverse  []bool // reverse[i] swaps the left and right child of node i
+	lessFunc func(a, b T) bool
+}
+
+// NewWeak creates a new Weak heap for ordered types.
+func NewWeak[T constraints.Ordered]() *Weak[T] {
+	h, _ := NewWeakAny[T](func(a, b T) bool { return a < b })
+	return h
+}
+
+// NewWeakAny creates a new Weak heap for any type T ordered by less.
+func NewWeakAny[T any](less func(a, b T) bool) (*Weak[T], error) {
+	if less == nil {
+		return nil, errors.New("less function is required to define heap ordering")
+	}
+	return &Weak[T]{lessFunc: less}, nil
+}
+
+// Push adds a new element to the heap.
+// Complexity: O(log n)
+func (h *Weak[T]) Push(element T) {
+	i := len(h.elements)
+	h.elements = append(h.elements, element)
+	h.reverse = append(h.reverse, false)
+	if i&1 == 0 && i > 0 {
+		// A new left child: its parent had no children so far.
+		h.reverse[i>>1] = false
+	}
+	for i != 0 {
+		j := h.ancestor(i)
+		if h.join(j, i) {
+			break
+		}
+		i = j
+	}
+}
+
+// Top returns the smallest element of the heap.
+// Panics if the heap is empty.
+func (h *Weak[T]) Top() T {
+	if h.Empty() {
+		panic("cannot retrieve top element from an empty heap")
+	}
+	return h.elements[0]
+}
+
+// Pop removes the smallest element of the heap.
+// Complexity: O(log n)
+func (h *Weak[T]) Pop() {
+	n := len(h.elements) - 1
+	if n < 0 {
+		return
+	}
+	// Zero the vacated slot so that the backing array does not keep the
+	// popped element reachable.
+	var zero T
+	h.elements[0] = h.elements[n]
+	h.elements[n] = zero
+	h.elements = h.elements[:n]
+	h.reverse = h.reverse[:n]
+	if n <= 1 {
+		return
+	}
+	// Walk down the left spine of the root's right subtree, then join every
+	// node on the way back up with the root.
+	j := 1
+	for 2*j+h.child(j) < n {
+		j = 2*j + h.child(j)
+	}
+	for j != 0 {
+		h.join(0, j)
+		j >>= 1
+	}
+}
+
+// Empty checks whether the heap is empty.
+func (h *Weak[T]) Empty() bool {
+	return len(h.elements) == 0
+}
+
+// Size returns the number of elements currently in the heap.
+func (h *Weak[T]) Size() int {
+	return len(h.elements)
+}
+
+// Drain returns a lazy sequence popping the elements of the heap in order,
+// smallest first for the default comparator. Elements that are not asked
+// for stay in the heap.
+// Complexity: O(log n) per element
+func (h *Weak[T]) Drain() iterutil.Seq[T] {
+	return drainSeq[T](h)
+}
+
+// Merge moves every element of other into the heap, leaving other empty.
+// Complexity: O(m log(n + m))
+func (h *Weak[T]) Merge(other MergeableHeap[T]) {
+	if o, ok := other.(*Weak[T]); ok && o == h {
+		return
+	}
+	drain[T](h, other)
+}
+
+// Clone returns an independent copy of the heap with the same ordering.
+// Every element is passed through cloneElement, if it is not nil.
+// Complexity: O(n)
+func (h *Weak[T]) Clone(cloneElement func(T) T) *Weak[T] {
+	reverse := make([]bool, len(h.reverse))
+	copy(reverse, h.reverse)
+	return &Weak[T]{
+		elements: cloneElements(h.elements, cloneElement),
+		reverse:  reverse,
+		lessFunc: h.lessFunc,
+	}
+}
+
+// Equal reports whether h and other hold the same elements, compared with
+// eq or, if eq is nil, by the ordering of h.
+// Complexity: O(n log n)
+func (h *Weak[T]) Equal(other *Weak[T], eq func(a, b T) bool) bool {
+	return sameElements(h.elements, other.elements, h.lessFunc, eq)
+}
+
+// child returns 0 or 1: the offset of the left child of i from 2*i.
+func (h *Weak[T]) child(i int) int {
+	if h.reverse[i] {
+		return 1
+	}
+	return 0
+}
+
+// ancestor returns the distinguished ancestor of i.
+func (h *Weak[T]) ancestor(i int) int {
+	for i&1 == h.child(i>>1) {
+		i >>= 1
+	}
+	return i >> 1
+}
+
+// join restores the order between i and its distinguished ancestor a. It
+// reports whether they were already in order.
+func (h *Weak[T]) join(a, i int) bool {
+	if h.lessFunc(h.elements[i], h.elements[a]) {
+		h.elements[a], h.elements[i] = h.elements[i], h.elements[a]
+		h.reverse[i] = !h.reverse[i]
+		return false
+	}
+	return true
+}
@@ -0,0 +1,236 @@
+// intervaltree.go
+// description: Interval tree for overlap queries
+// details:
+// An interval tree is a balanced binary search tree keyed by the low endpoint of
+// closed intervals, where every node is augmented with the largest high endpoint
+// found in its subtree. That extra value allows whole subtrees to be skipped
+// when looking for intervals that intersect a point or another interval.
+// The tree is kept balanced with AVL rotations.
+// Insert: O(log n)
+// Delete: O(log n)
+// QueryPoint, QueryOverlap: O(log n + k), k being the number of reported intervals
+// reference: https://en.wikipedia.org/wiki/Interval_tree#Augmented_tree
+// see intervaltree_test.go
+
+// Package intervaltree implements an augmented AVL tree that stores closed
+// intervals and reports the ones overlapping a point or a range.
+package intervaltree
+
+import "github.com/TheAlgorithms/Go/constraints"
+
+// Interval is the closed interval [Low, High].
+type Interval[T constraints.Ordered] struct {
+	Low  T
+	High T
+}
+
+// Overlaps reports whether the closed intervals i and o share at least one point.
+func (i Interval[T]) Overlaps(o Interval[T]) bool {
+	return !(i.High < o.Low || o.High < i.Low)
+}
+
+// Contains reports whether p lies inside the closed interval i.
+func (i Interval[T]) Contains(p T) bool {
+	return !(p < i.Low || i.High < p)
+}
+
+// less orders intervals by low endpoint, then by high endpoint.
+func (i Interval[T]) less(o Interval[T]) bool {
+	if i.Low != o.Low {
+		return i.Low < o.Low
+	}
+	return i.High < o.High
+}
+
+type node[T constraints.Ordered] struct {
+	interval Interval[T]
+	maxHigh  T
+	height   int
+	left     *node[T]
+	right    *node[T]
+}
+
+// IntervalTree stores closed intervals. The same interval may be stored
+// more than once.
+type IntervalTree[T constraints.Ordered] struct {
+	root *node[T]
+	size int
+}
+
+// New creates an empty interval tree.
+func New[T constraints.Ordered]() *IntervalTree[T] {
+	return &IntervalTree[T]{}
+}
+
+// Len returns the number of intervals stored in the tree.
+func (t *IntervalTree[T]) Len() int {
+	return t.size
+}
+
+// Insert adds the interval to the tree. An interval whose Low is greater
+// than its High is normalized by swapping its endpoints.
+func (t *IntervalTree[T]) Insert(interval Interval[T]) {
+	if interval.High < interval.Low {
+		interval.Low, interval.High = interval.High, interval.Low
+	}
+	t.root = insert(t.root, interval)
+	t.size++
+}
+
+// Delete removes one copy of interval and reports whether it was present.
+func (t *IntervalTree[T]) Delete(interval Interval[T]) bool {
+	if interval.High < interval.Low {
+		interval.Low, interval.High = interval.High, interval.Low
+	}
+	var deleted bool
+	t.root, deleted = remove(t.root, interval)
+	if deleted {
+		t.size--
+	}
+	return deleted
+}
+
+// QueryPoint returns every stored interval containing p, ordered by low endpoint.
+func (t *IntervalTree[T]) QueryPoint(p T) []Interval[T] {
+	return t.QueryOverlap(p, p)
+}
+
+// QueryOverlap returns every stored interval that intersects the closed
+// interval [lo, hi], ordered by low endpoint.
+func (t *IntervalTree[T]) QueryOverlap(lo, hi T) []Interval[T] {
+	if hi < lo {
+		lo, hi = hi, lo
+	}
+	var result []Interval[T]
+	query(t.root, Interval[T]{Low: lo, High: hi}, &result)
+	return result
+}
+
+// Intervals returns all stored intervals ordered by low endpoint.
+func (t *IntervalTree[T]) Intervals() []Interval[T] {
+	result := make([]Interval[T], 0, t.size)
+	var walk func(n *node[T])
+	walk = func(n *node[T]) {
+		if n == nil {
+			return
+		}
+		walk(n.left)
+		result = append(result, n.interval)
+		walk(n.right)
+	}
+	walk(t.root)
+	return result
+}
+
+func query[T constraints.Ordered](n *node[T], q Interval[T], result *[]Interval[T]) {
+	// No interval in this subtree reaches the query.
+	if n == nil || n.maxHigh < q.Low {
+		return
+	}
+	query(n.left, q, result)
+	// Every interval to the right starts after this one, so they start after q too.
+	if q.High < n.interval.Low {
+		return
+	}
+	if n.interval.Overlaps(q) {
+		*result = append(*result, n.interval)
+	}
+	query(n.right, q, result)
+}
+
+func height[T constraints.Ordered](n *node[T]) int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+func (n *node[T]) update() {
+	n.height = 1 + height(n.left)
+	if h := height(n.right); h >= n.height {
+		n.height = 1 + h
+	}
+	n.maxHigh = n.interval.High
+	if n.left != nil && n.maxHigh < n.left.maxHigh {
+		n.maxHigh = n.left.maxHigh
+	}
+	if n.right != nil && n.maxHigh < n.right.maxHigh {
+		n.maxHigh = n.right.maxHigh
+	}
+}
+
+func rotateLeft[T constraints.Ordered](n *node[T]) *node[T] {
+	r := n.right
+	n.right = r.left
+	r.left = n
+	n.update()
+	r.update()
+	return r
+}
+
+func rotateRight[T constraints.Ordered](n *node[T]) *node[T] {
+	l := n.left
+	n.left = l.right
+	l.right = n
+	n.update()
+	l.update()
+	return l
+}
+
+func rebalance[T constraints.Ordered](n *node[T]) *node[T] {
+	n.update()
+	balance := height(n.left) - height(n.right)
+	switch {
+	case balance > 1:
+		if height(n.left.left) < height(n.left.right) {
+			n.left = rotateLeft(n.left)
+		}
+		return rotateRight(n)
+	case balance < -1:
+		if height(n.right.right) < height(n.right.left) {
+			n.right = rotateRight(n.right)
+		}
+		return rotateLeft(n)
+	}
+	return n
+}
+
+func insert[T constraints.Ordered](n *node[T], interval Interval[T]) *node[T] {
+	if n == nil {
+		return &node[T]{interval: interval, maxHigh: interval.High, height: 1}
+	}
+	if interval.less(n.interval) {
+		n.left = insert(n.left, interval)
+	} else {
+		n.right = insert(n.right, interval)
+	}
+	return rebalance(n)
+}
+
+func remove[T constraints.Ordered](n *node[T], interval Interval[T]) (*node[T], bool) {
+	if n == nil {
+		return nil, false
+	}
+	var deleted bool
+	switch {
+	case interval.less(n.interval):
+		n.left, deleted = remove(n.left, interval)
+	case n.interval.less(interval):
+		n.right, deleted = remove(n.right, interval)
+	default:
+		if n.left == nil {
+			return n.right, true
+		}
+		if n.right == nil {
+			return n.left, true
+		}
+		successor := n.right
+		for successor.left != nil {
+			successor = successor.left
+		}
+		n.interval = successor.interval
+		n.right, _ = remove(n.right, successor.interval)
+		deleted = true
+	}
+	return rebalance(n), deleted
+}
@@ -0,0 +1,103 @@
+package intervaltree_test
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/structure/intervaltree"
+)
+
+type interval = intervaltree.Interval[int]
+
+func TestQuery(t *testing.T) {
+	tree := intervaltree.New[int]()
+	for _, iv := range []interval{{15, 20}, {10, 30}, {17, 19}, {5, 20}, {12, 15}, {30, 40}} {
+		tree.Insert(iv)
+	}
+
+	tests := []struct {
+		name   string
+		lo, hi int
+		want   []interval
+	}{
+		{"point inside several", 14, 14, []interval{{5, 20}, {10, 30}, {12, 15}}},
+		{"touching endpoint", 30, 30, []interval{{10, 30}, {30, 40}}},
+		{"range", 18, 25, []interval{{5, 20}, {10, 30}, {15, 20}, {17, 19}}},
+		{"reversed range", 25, 18, []interval{{5, 20}, {10, 30}, {15, 20}, {17, 19}}},
+		{"nothing", 41, 50, nil},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := tree.QueryOverlap(test.lo, test.hi)
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("QueryOverlap(%d, %d) = %v, want %v", test.lo, test.hi, got, test.want)
+			}
+		})
+	}
+
+	if got, want := tree.QueryPoint(35), []interval{{30, 40}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("QueryPoint(35) = %v, want %v", got, want)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	tree := intervaltree.New[int]()
+	tree.Insert(interval{1, 5})
+	tree.Insert(interval{1, 5})
+	tree.Insert(interval{3, 8})
+
+	if !tree.Delete(interval{1, 5}) {
+		t.Errorf("Delete({1, 5}) = false, want true")
+	}
+	if tree.Delete(interval{2, 5}) {
+		t.Errorf("Delete({2, 5}) = true, want false")
+	}
+	if got, want := tree.Intervals(), []interval{{1, 5}, {3, 8}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Intervals() = %v, want %v", got, want)
+	}
+	if tree.Len() != 2 {
+		t.Errorf("Len() = %d, want 2", tree.Len())
+	}
+}
+
+func TestAgainstBruteForce(t *testing.T) {
+	rnd := rand.New(rand.NewSource(5))
+	tree := intervaltree.New[int]()
+	var stored []interval
+	for i := 0; i < 400; i++ {
+		lo := rnd.Intn(1000)
+		iv := interval{lo, lo + rnd.Intn(50)}
+		stored = append(stored, iv)
+		tree.Insert(iv)
+	}
+	rnd.Shuffle(len(stored), func(i, j int) { stored[i], stored[j] = stored[j], stored[i] })
+	for _, iv := range stored[:150] {
+		if !tree.Delete(iv) {
+			t.Fatalf("Delete(%v) = false, want true", iv)
+		}
+	}
+	stored = stored[150:]
+
+	for q := 0; q < 200; q++ {
+		lo := rnd.Intn(1000)
+		hi := lo + rnd.Intn(30)
+		var want []interval
+		for _, iv := range stored {
+			if iv.Overlaps(interval{lo, hi}) {
+				want = append(want, iv)
+			}
+		}
+		got := tree.QueryOverlap(lo, hi)
+		sort.Slice(want, func(i, j int) bool {
+			if want[i].Low != want[j].Low {
+				return want[i].Low < want[j].Low
+			}
+			return want[i].High < want[j].High
+		})
+		if len(got) != len(want) || (len(want) > 0 && !reflect.DeepEqual(got, want)) {
+			t.Fatalf("QueryOverlap(%d, %d) = %v, want %v", lo, hi, got, want)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+// channel.go
+// description: Adapters between sequences and channels, and pipeline stages
+// details:
+// ToChannel runs a sequence in a goroutine sending its values on a channel,
+// and FromChannel turns a channel back into a sequence, so that the values
+// of any structure can feed a pipeline of goroutines and its output be
+// stored in another structure. The stages MapChannel, FilterChannel and
+// BatchChannel each run in a goroutine between an input and an output
+// channel, which buffers at most a given number of values: a slow stage
+// blocks the ones before it instead of letting values pile up.
+// Every goroutine stops and closes its output channel as soon as its context
+// is done, instead of blocking forever on a send nobody receives, so
+// cancelling the context shared by a pipeline releases all of its stages
+// even when the consumer stops reading early. A stage also stops when its
+// input channel is closed, after sending what it holds.
+// time complexity: O(1) per value for every stage
+// reference: https://go.dev/blog/pipelines
+// see channel_test.go
+
+package iterutil
+
+import "context"
+
+// send sends v on out, reporting false if ctx is done first.
+func send[T any](ctx context.Context, out chan<- T, v T) bool {
+	select {
+	case out <- v:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// receive receives a value from in, reporting false if in is closed or ctx
+// is done first.
+func receive[T any](ctx context.Context, in <-chan T) (T, bool) {
+	select {
+	case v, ok := <-in:
+		return v, ok
+	case <-ctx.Done():
+		var zero T
+		return zero, false
+	}
+}
+
+// ToChannel returns an unbuffered channel receiving the values of seq, sent
+// from a goroutine, and closed after the last one or once ctx is done. seq
+// must stop when yield returns false.
+func ToChannel[T any](ctx context.Context, seq Seq[T]) <-chan T {
+	out := make(chan T)
+	go func() {
+		defer close(out)
+		if ctx.Err() != nil {
+			return
+		}
+		seq(func(v T) bool { return send(ctx, out, v) })
+	}()
+	return out
+}
+
+// FromChannel returns the sequence of the values received from ch until it
+// is closed. A consumer stopping early leaves the remaining values in ch,
+// and the sender must then be stopped another way, such as by cancelling its
+// context.
+func FromChannel[T any](ch <-chan T) Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range ch {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// MapChannel returns a channel buffering up to buffer values, receiving f
+// applied to each value received from in, in order.
+func MapChannel[T, U any](ctx context.Context, in <-chan T, f func(T) U, buffer int) <-chan U {
+	out := make(chan U, buffer)
+	go func() {
+		defer close(out)
+		for {
+			v, ok := receive(ctx, in)
+			if !ok || !send(ctx, out, f(v)) {
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// FilterChannel returns a channel buffering up to buffer values, receiving
+// the values received from in satisfying keep, in order.
+func FilterChannel[T any](ctx context.Context, in <-chan T, keep func(T) bool, buffer int) <-chan T {
+	out := make(chan T, buffer)
+	go func() {
+		defer close(out)
+		for {
+			v, ok := receive(ctx, in)
+			if !ok || keep(v) && !send(ctx, out, v) {
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// BatchChannel returns a channel buffering up to buffer batches, receiving
+// the values received from in grouped in slices of size values, the last one
+// holding those left when in is closed. A size below 1 is taken as 1.
+func BatchChannel[T any](ctx context.Context, in <-chan T, size, buffer int) <-chan []T {
+	if size < 1 {
+		size = 1
+	}
+	out := make(chan []T, buffer)
+	go func() {
+		defer close(out)
+		batch := make([]T, 0, size)
+		for {
+			v, ok := receive(ctx, in)
+			if !ok {
+				if len(batch) > 0 && ctx.Err() == nil {
+					send(ctx, out, batch)
+				}
+				return
+			}
+			batch = append(batch, v)
+			if len(batch) == size {
+				if !send(ctx, out, batch) {
+					return
+				}
+				batch = make([]T, 0, size)
+			}
+		}
+	}()
+	return out
+}
@@ -0,0 +1,100 @@
+package iterutil_test
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/TheAlgorithms/Go/structure/iterutil"
+	"github.com/TheAlgorithms/Go/structure/tree"
+)
+
+// naturals returns the endless sequence 0, 1, 2, ...
+func naturals() iterutil.Seq[int] {
+	return func(yield func(int) bool) {
+		for i := 0; yield(i); i++ {
+		}
+	}
+}
+
+// drained reports whether ch is closed within a second, discarding the
+// values left in it.
+func drained[T any](ch <-chan T) bool {
+	timeout := time.After(time.Second)
+	for {
+		select {
+		case _, ok := <-ch:
+			if !ok {
+				return true
+			}
+		case <-timeout:
+			return false
+		}
+	}
+}
+
+func TestPipeline(t *testing.T) {
+	ctx := context.Background()
+	rb := tree.NewRB[int]()
+	for v := 1; v <= 10; v++ {
+		rb.Push(v)
+	}
+	values := iterutil.ToChannel(ctx, rb.InOrderSeq())
+	odd := iterutil.FilterChannel(ctx, values, func(v int) bool { return v%2 == 1 }, 2)
+	squares := iterutil.MapChannel(ctx, odd, func(v int) int { return v * v }, 2)
+	batches := iterutil.BatchChannel(ctx, squares, 2, 1)
+	got := iterutil.Collect(iterutil.FromChannel(batches))
+	want := [][]int{{1, 9}, {25, 49}, {81}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("pipeline = %v, want %v", got, want)
+	}
+
+	if got := iterutil.Collect(iterutil.FromChannel(iterutil.BatchChannel(ctx, iterutil.ToChannel(ctx, iterutil.FromSlice([]int{1, 2})), 0, 0))); !reflect.DeepEqual(got, [][]int{{1}, {2}}) {
+		t.Errorf("batches of size 0 = %v", got)
+	}
+	empty := iterutil.ToChannel(ctx, iterutil.FromSlice([]int(nil)))
+	if got := iterutil.Collect(iterutil.FromChannel(iterutil.BatchChannel(ctx, empty, 3, 0))); len(got) != 0 {
+		t.Errorf("batches of nothing = %v", got)
+	}
+}
+
+func TestFromChannelStopsEarly(t *testing.T) {
+	ch := make(chan int, 5)
+	for v := 0; v < 5; v++ {
+		ch <- v
+	}
+	close(ch)
+	if got := iterutil.Collect(iterutil.Take(iterutil.FromChannel(ch), 2)); !reflect.DeepEqual(got, []int{0, 1}) {
+		t.Errorf("first 2 values = %v", got)
+	}
+	if got := iterutil.Collect(iterutil.FromChannel(ch)); !reflect.DeepEqual(got, []int{2, 3, 4}) {
+		t.Errorf("values left = %v", got)
+	}
+}
+
+func TestPipelineCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	values := iterutil.ToChannel(ctx, naturals())
+	doubled := iterutil.MapChannel(ctx, values, func(v int) int { return 2 * v }, 4)
+	batches := iterutil.BatchChannel(ctx, doubled, 3, 0)
+	if got := <-batches; !reflect.DeepEqual(got, []int{0, 2, 4}) {
+		t.Errorf("first batch = %v", got)
+	}
+	// the consumer stops reading: cancelling releases every stage, down to
+	// the endless source
+	cancel()
+	for name, ch := range map[string]<-chan int{"ToChannel": values, "MapChannel": doubled} {
+		if !drained(ch) {
+			t.Errorf("%s did not close its channel", name)
+		}
+	}
+	if !drained(batches) {
+		t.Errorf("BatchChannel did not close its channel")
+	}
+
+	// nothing is sent once the context is done
+	if got := iterutil.Collect(iterutil.FromChannel(iterutil.ToChannel(ctx, naturals()))); len(got) != 0 {
+		t.Errorf("ToChannel with a cancelled context sent %v", got)
+	}
+}
@@ -0,0 +1,180 @@
+// iterutil.go
+// description: Push iterators and composable adapters
+// details:
+// A sequence is a function calling yield with each of its values in turn,
+// and stopping as soon as yield returns false. Adapters such as Map and
+// Filter wrap a sequence into another one without building an intermediate
+// slice, so that values flow lazily from one structure to the next: only the
+// values actually consumed are produced.
+// Seq and Seq2 have the same shape as iter.Seq and iter.Seq2 of Go 1.23, and
+// convert to them directly, but are declared here as the module targets Go
+// 1.19. Until then a sequence is consumed by calling it with the loop body:
+//
+//	seq(func(v T) bool { ...; return true })
+//
+// Pull turns a sequence into a pull iterator, for algorithms walking several
+// sequences in step such as merges; it runs the sequence in a goroutine
+// where iter.Pull uses coroutines.
+// time complexity: O(1) per value for every adapter
+// reference: https://pkg.go.dev/iter
+// see iterutil_test.go
+
+// Package iterutil provides push iterators over the values of a structure,
+// and adapters to transform and combine them lazily.
+package iterutil
+
+// Seq is a sequence of values of type T.
+type Seq[T any] func(yield func(T) bool)
+
+// Seq2 is a sequence of pairs of values, such as keys and values or indexes
+// and values.
+type Seq2[K, V any] func(yield func(K, V) bool)
+
+// FromSlice returns the sequence of the elements of s.
+func FromSlice[T any](s []T) Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range s {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Enumerate returns the sequence of the values of seq paired with their
+// index, counting from 0.
+func Enumerate[T any](seq Seq[T]) Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		seq(func(v T) bool {
+			if !yield(i, v) {
+				return false
+			}
+			i++
+			return true
+		})
+	}
+}
+
+// Keys returns the sequence of the first values of the pairs of seq.
+func Keys[K, V any](seq Seq2[K, V]) Seq[K] {
+	return func(yield func(K) bool) {
+		seq(func(k K, _ V) bool { return yield(k) })
+	}
+}
+
+// Values returns the sequence of the second values of the pairs of seq.
+func Values[K, V any](seq Seq2[K, V]) Seq[V] {
+	return func(yield func(V) bool) {
+		seq(func(_ K, v V) bool { return yield(v) })
+	}
+}
+
+// Map returns the sequence of f applied to each value of seq.
+func Map[T, U any](seq Seq[T], f func(T) U) Seq[U] {
+	return func(yield func(U) bool) {
+		seq(func(v T) bool { return yield(f(v)) })
+	}
+}
+
+// Filter returns the sequence of the values of seq satisfying keep.
+func Filter[T any](seq Seq[T], keep func(T) bool) Seq[T] {
+	return func(yield func(T) bool) {
+		seq(func(v T) bool { return !keep(v) || yield(v) })
+	}
+}
+
+// Take returns the sequence of the first n values of seq, or all of them
+// when there are fewer. seq is not asked for more values than needed.
+func Take[T any](seq Seq[T], n int) Seq[T] {
+	return func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+		taken := 0
+		seq(func(v T) bool {
+			taken++
+			return yield(v) && taken < n
+		})
+	}
+}
+
+// Concat returns the sequence of the values of each of seqs in turn.
+func Concat[T any](seqs ...Seq[T]) Seq[T] {
+	return func(yield func(T) bool) {
+		for _, seq := range seqs {
+			stopped := false
+			seq(func(v T) bool {
+				if !yield(v) {
+					stopped = true
+					return false
+				}
+				return true
+			})
+			if stopped {
+				return
+			}
+		}
+	}
+}
+
+// Pull turns seq into a pull iterator: each call of next returns the next
+// value of seq, with false once there is none left. stop ends the iteration
+// early and must be called, or next called until it returns false, to
+// release the goroutine running seq. next and stop must not be called
+// concurrently, and seq must stop when yield returns false.
+func Pull[T any](seq Seq[T]) (next func() (T, bool), stop func()) {
+	values := make(chan T)
+	requests := make(chan bool) // true for the next value, false to stop
+	done := false
+	go func() {
+		defer close(values)
+		if !<-requests {
+			return
+		}
+		seq(func(v T) bool {
+			values <- v
+			return <-requests
+		})
+	}()
+	next = func() (T, bool) {
+		if done {
+			var zero T
+			return zero, false
+		}
+		requests <- true
+		v, ok := <-values
+		done = !ok
+		return v, ok
+	}
+	stop = func() {
+		if done {
+			return
+		}
+		done = true
+		requests <- false
+		for range values {
+		}
+	}
+	return next, stop
+}
+
+// Collect returns the values of seq in a slice.
+func Collect[T any](seq Seq[T]) []T {
+	var s []T
+	seq(func(v T) bool {
+		s = append(s, v)
+		return true
+	})
+	return s
+}
+
+// Reduce folds the values of seq into an accumulator, starting from init.
+func Reduce[T, A any](seq Seq[T], init A, f func(A, T) A) A {
+	acc := init
+	seq(func(v T) bool {
+		acc = f(acc, v)
+		return true
+	})
+	return acc
+}
@@ -0,0 +1,113 @@
+package iterutil_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/structure/heap"
+	"github.com/TheAlgorithms/Go/structure/iterutil"
+	"github.com/TheAlgorithms/Go/structure/tree"
+)
+
+// counting returns the sequence 0, 1, 2, ... up to n-1, and the number of
+// values it has produced so far.
+func counting(n int) (iterutil.Seq[int], *int) {
+	produced := new(int)
+	return func(yield func(int) bool) {
+		for i := 0; i < n; i++ {
+			*produced++
+			if !yield(i) {
+				return
+			}
+		}
+	}, produced
+}
+
+func TestAdapters(t *testing.T) {
+	seq, produced := counting(100)
+	even := iterutil.Filter(seq, func(v int) bool { return v%2 == 0 })
+	squares := iterutil.Map(even, func(v int) int { return v * v })
+	if got := iterutil.Collect(iterutil.Take(squares, 4)); !reflect.DeepEqual(got, []int{0, 4, 16, 36}) {
+		t.Errorf("first 4 even squares = %v", got)
+	}
+	// the source is not asked for more values than needed
+	if *produced != 7 {
+		t.Errorf("produced %d values, want 7", *produced)
+	}
+
+	if got := iterutil.Collect(iterutil.Take(iterutil.FromSlice([]int{1, 2}), 5)); !reflect.DeepEqual(got, []int{1, 2}) {
+		t.Errorf("Take beyond the end = %v", got)
+	}
+	seq, produced = counting(10)
+	if got := iterutil.Collect(iterutil.Take(seq, 0)); len(got) != 0 || *produced != 0 {
+		t.Errorf("Take 0 = %v, after producing %d values", got, *produced)
+	}
+
+	words := iterutil.FromSlice([]string{"a", "b", "c"})
+	pairs := iterutil.Enumerate(words)
+	if got := iterutil.Collect(iterutil.Keys(pairs)); !reflect.DeepEqual(got, []int{0, 1, 2}) {
+		t.Errorf("Keys = %v", got)
+	}
+	if got := iterutil.Collect(iterutil.Values(pairs)); !reflect.DeepEqual(got, []string{"a", "b", "c"}) {
+		t.Errorf("Values = %v", got)
+	}
+	joined := iterutil.Reduce(iterutil.Concat(words, iterutil.FromSlice([]string{"d"})), "", func(acc, w string) string { return acc + w })
+	if joined != "abcd" {
+		t.Errorf("Concat = %q, want abcd", joined)
+	}
+	if got := iterutil.Collect(iterutil.Take(iterutil.Concat(words, words), 4)); !reflect.DeepEqual(got, []string{"a", "b", "c", "a"}) {
+		t.Errorf("Take of Concat = %v", got)
+	}
+}
+
+func TestBetweenStructures(t *testing.T) {
+	h := heap.New[int]()
+	for _, v := range []int{42, 7, 19, 3, 88, 61, 25} {
+		h.Push(v)
+	}
+	// the three smallest values of the heap go into a tree, without an
+	// intermediate slice
+	avl := tree.NewAVL[int]()
+	iterutil.Take(h.Drain(), 3)(func(v int) bool {
+		avl.Push(v)
+		return true
+	})
+	if got := avl.InOrder(); !reflect.DeepEqual(got, []int{3, 7, 19}) {
+		t.Errorf("tree keys = %v, want [3 7 19]", got)
+	}
+	labels := iterutil.Map(avl.InOrderSeq(), func(v int) string { return strings.Repeat("*", v%4) })
+	if got := iterutil.Collect(labels); !reflect.DeepEqual(got, []string{"***", "***", "***"}) {
+		t.Errorf("labels = %q", got)
+	}
+}
+
+func TestPull(t *testing.T) {
+	next, stop := iterutil.Pull(iterutil.FromSlice([]int{1, 2, 3}))
+	for want := 1; want <= 3; want++ {
+		if v, ok := next(); !ok || v != want {
+			t.Fatalf("next() = %d, %t, want %d, true", v, ok, want)
+		}
+	}
+	if _, ok := next(); ok {
+		t.Errorf("next() past the end reported a value")
+	}
+	stop()
+
+	seq, produced := counting(100)
+	next, stop = iterutil.Pull(seq)
+	next()
+	next()
+	stop()
+	stop()
+	if _, ok := next(); ok || *produced != 2 {
+		t.Errorf("next() after stop = %t, after producing %d values", ok, *produced)
+	}
+	// stopping before the first value does not run the sequence
+	seq, produced = counting(100)
+	_, stop = iterutil.Pull(seq)
+	stop()
+	if *produced != 0 {
+		t.Errorf("produced %d values without any next", *produced)
+	}
+}
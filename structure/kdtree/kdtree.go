@@ -0,0 +1,269 @@
+// kdtree.go
+// description: k-d tree with balanced bulk construction and nearest neighbour search
+// details:
+// A k-d tree stores points of k dimensions in a binary tree whose nodes
+// split space along one axis each, cycling through the axes with the depth:
+// the points of the left subtree of a node are not above it on the axis of
+// the node, and those of the right subtree not below. The nearest neighbour
+// of a query is searched down the side of the query first, and the other
+// side is only visited when the splitting plane is closer than the best
+// point found, which on well spread points visits O(log n) nodes.
+// Inserting points one at a time puts them at the leaves, so skewed inserts,
+// such as points sorted along an axis, grow the tree into long paths along
+// which every query walks. Bentley's bulk construction instead splits the
+// points at their median along the axis of each level, selected in linear
+// time by the median of medians, which gives a tree of depth log2(n) in
+// O(n log n). RebuildIfUnbalanced rebuilds the tree that way once its depth
+// exceeds twice the depth of a balanced one, and counting the nodes visited
+// by the queries shows how much skewed inserts cost.
+// time complexity: O(n log n) to build, O(depth) to insert, O(log n) expected
+// for a nearest neighbour query on a balanced tree, O(n) in the worst case
+// space complexity: O(n)
+// reference: https://en.wikipedia.org/wiki/K-d_tree
+// reference: Bentley, Multidimensional binary search trees used for associative searching, CACM 1975
+// see kdtree_test.go
+
+// Package kdtree implements a k-d tree of points answering nearest
+// neighbour queries.
+package kdtree
+
+import (
+	"errors"
+	"math"
+	"math/bits"
+
+	"github.com/TheAlgorithms/Go/selection"
+)
+
+// ErrDimension is returned for a point whose number of coordinates is not
+// the dimension of the tree.
+var ErrDimension = errors.New("point has the wrong dimension")
+
+type node struct {
+	point       []float64
+	axis        int
+	left, right *node
+}
+
+// Stats counts the work of the nearest neighbour queries of a tree.
+type Stats struct {
+	// Queries is the number of queries answered.
+	Queries int
+	// Visited is the number of nodes visited by the queries.
+	Visited int
+}
+
+// KDTree is a k-d tree of points of a fixed dimension. The same point may be
+// stored more than once.
+type KDTree struct {
+	k          int
+	root       *node
+	size       int
+	depth      int // the number of nodes on the longest path from the root
+	instrument bool
+	stats      Stats
+}
+
+// New creates an empty tree of points of k dimensions. It returns an error
+// unless k is positive.
+func New(k int) (*KDTree, error) {
+	if k < 1 {
+		return nil, errors.New("dimension must be positive")
+	}
+	return &KDTree{k: k}, nil
+}
+
+// Build creates a balanced tree of the points of k dimensions, which it
+// copies. It returns an error unless k is positive and every point has k
+// coordinates.
+func Build(k int, points [][]float64) (*KDTree, error) {
+	t, err := New(k)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range points {
+		if len(p) != k {
+			return nil, ErrDimension
+		}
+	}
+	copies := make([][]float64, len(points))
+	for i, p := range points {
+		copies[i] = append([]float64(nil), p...)
+	}
+	t.root, t.depth = t.build(copies, 0)
+	t.size = len(points)
+	return t, nil
+}
+
+// build returns the balanced tree of points, which it reorders, splitting
+// first along axis, and its depth.
+func (t *KDTree) build(points [][]float64, axis int) (*node, int) {
+	if len(points) == 0 {
+		return nil, 0
+	}
+	mid := len(points) / 2
+	// no point before mid is above the median, none after it below
+	_, _ = selection.MedianOfMedians(points, mid+1, func(a, b []float64) bool { return a[axis] < b[axis] })
+	next := (axis + 1) % t.k
+	n := &node{point: points[mid], axis: axis}
+	var left, right int
+	n.left, left = t.build(points[:mid], next)
+	n.right, right = t.build(points[mid+1:], next)
+	if right > left {
+		left = right
+	}
+	return n, left + 1
+}
+
+// Len returns the number of points of the tree.
+func (t *KDTree) Len() int {
+	return t.size
+}
+
+// Dimension returns the number of coordinates of the points of the tree.
+func (t *KDTree) Dimension() int {
+	return t.k
+}
+
+// Depth returns the number of nodes on the longest path from the root, 0
+// for an empty tree.
+func (t *KDTree) Depth() int {
+	return t.depth
+}
+
+// Insert adds p, which it copies, to the tree. It returns ErrDimension
+// unless p has the dimension of the tree.
+func (t *KDTree) Insert(p []float64) error {
+	if len(p) != t.k {
+		return ErrDimension
+	}
+	n := &node{point: append([]float64(nil), p...)}
+	link, depth := &t.root, 1
+	for *link != nil {
+		parent := *link
+		if p[parent.axis] < parent.point[parent.axis] {
+			link = &parent.left
+		} else {
+			link = &parent.right
+		}
+		n.axis = (parent.axis + 1) % t.k
+		depth++
+	}
+	*link = n
+	t.size++
+	if depth > t.depth {
+		t.depth = depth
+	}
+	return nil
+}
+
+// collect returns the points of the tree, shared with its nodes.
+func (t *KDTree) collect() [][]float64 {
+	points := make([][]float64, 0, t.size)
+	var stack []*node
+	if t.root != nil {
+		stack = append(stack, t.root)
+	}
+	for len(stack) > 0 {
+		n := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		points = append(points, n.point)
+		for _, c := range []*node{n.left, n.right} {
+			if c != nil {
+				stack = append(stack, c)
+			}
+		}
+	}
+	return points
+}
+
+// Points returns copies of the points of the tree, in no particular order.
+func (t *KDTree) Points() [][]float64 {
+	points := t.collect()
+	for i, p := range points {
+		points[i] = append([]float64(nil), p...)
+	}
+	return points
+}
+
+// Balanced reports whether the depth of the tree is at most twice the depth
+// of a balanced tree of as many points.
+func (t *KDTree) Balanced() bool {
+	return t.depth <= 2*bits.Len(uint(t.size))
+}
+
+// RebuildIfUnbalanced rebuilds the tree balanced, as Build does, unless it is
+// Balanced, and reports whether it did.
+func (t *KDTree) RebuildIfUnbalanced() bool {
+	if t.Balanced() {
+		return false
+	}
+	points := t.collect()
+	t.root, t.depth = t.build(points, 0)
+	return true
+}
+
+// Instrument turns on or off the counting of the nodes visited by the
+// queries.
+func (t *KDTree) Instrument(on bool) {
+	t.instrument = on
+}
+
+// Stats returns the work of the queries answered while instrumented.
+func (t *KDTree) Stats() Stats {
+	return t.stats
+}
+
+// ResetStats sets the counts of Stats back to 0.
+func (t *KDTree) ResetStats() {
+	t.stats = Stats{}
+}
+
+// squaredDistance returns the square of the Euclidean distance of a and b.
+func squaredDistance(a, b []float64) float64 {
+	d := 0.0
+	for i := range a {
+		d += (a[i] - b[i]) * (a[i] - b[i])
+	}
+	return d
+}
+
+// Nearest returns a point of the tree closest to q and its Euclidean
+// distance to q, with false if the tree is empty. It returns ErrDimension
+// unless q has the dimension of the tree.
+func (t *KDTree) Nearest(q []float64) ([]float64, float64, bool, error) {
+	if len(q) != t.k {
+		return nil, 0, false, ErrDimension
+	}
+	if t.root == nil {
+		return nil, 0, false, nil
+	}
+	var best *node
+	bestDistance := math.Inf(1)
+	visited := 0
+	var search func(n *node)
+	search = func(n *node) {
+		visited++
+		if d := squaredDistance(q, n.point); d < bestDistance {
+			best, bestDistance = n, d
+		}
+		diff := q[n.axis] - n.point[n.axis]
+		near, far := n.left, n.right
+		if diff >= 0 {
+			near, far = far, near
+		}
+		if near != nil {
+			search(near)
+		}
+		// the other side lies beyond the splitting plane
+		if far != nil && diff*diff < bestDistance {
+			search(far)
+		}
+	}
+	search(t.root)
+	if t.instrument {
+		t.stats.Queries++
+		t.stats.Visited += visited
+	}
+	return append([]float64(nil), best.point...), math.Sqrt(bestDistance), true, nil
+}
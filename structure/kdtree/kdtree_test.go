@@ -0,0 +1,184 @@
+package kdtree
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+// bruteNearest returns the distance from q to the closest point.
+func bruteNearest(points [][]float64, q []float64) float64 {
+	best := math.Inf(1)
+	for _, p := range points {
+		best = math.Min(best, squaredDistance(p, q))
+	}
+	return math.Sqrt(best)
+}
+
+func randomPoints(rnd *rand.Rand, n, k int) [][]float64 {
+	points := make([][]float64, n)
+	for i := range points {
+		points[i] = make([]float64, k)
+		for j := range points[i] {
+			// few distinct coordinates, so that ties are frequent
+			points[i][j] = float64(rnd.Intn(20))
+		}
+	}
+	return points
+}
+
+func TestKDTree(t *testing.T) {
+	points := [][]float64{{2, 3}, {5, 4}, {9, 6}, {4, 7}, {8, 1}, {7, 2}}
+	tree, err := Build(2, points)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tree.Len() != 6 || tree.Dimension() != 2 || tree.Depth() != 3 {
+		t.Errorf("Len, Dimension, Depth = %d, %d, %d, want 6, 2, 3", tree.Len(), tree.Dimension(), tree.Depth())
+	}
+	p, d, ok, err := tree.Nearest([]float64{9, 2})
+	if err != nil || !ok || p[0] != 8 || p[1] != 1 || d != math.Sqrt2 {
+		t.Errorf("Nearest(9, 2) = %v, %v, %v, %v, want (8, 1) at sqrt(2)", p, d, ok, err)
+	}
+	// the tree does not share the points given
+	points[4][0] = 100
+	if p, _, _, _ := tree.Nearest([]float64{9, 2}); p[0] != 8 {
+		t.Errorf("changing the points given changed the tree")
+	}
+	if len(tree.Points()) != 6 {
+		t.Errorf("Points() = %v", tree.Points())
+	}
+	empty, _ := New(3)
+	if _, _, ok, err := empty.Nearest([]float64{1, 2, 3}); ok || err != nil {
+		t.Errorf("Nearest in an empty tree = %v, %v", ok, err)
+	}
+	if _, _, _, err := empty.Nearest([]float64{1, 2}); !errors.Is(err, ErrDimension) {
+		t.Errorf("Nearest with the wrong dimension error = %v", err)
+	}
+	if err := empty.Insert([]float64{1}); !errors.Is(err, ErrDimension) {
+		t.Errorf("Insert with the wrong dimension error = %v", err)
+	}
+	if _, err := Build(2, [][]float64{{1, 2}, {3}}); !errors.Is(err, ErrDimension) {
+		t.Errorf("Build with the wrong dimension error = %v", err)
+	}
+	if _, err := New(0); err == nil {
+		t.Errorf("New(0) should fail")
+	}
+}
+
+func TestKDTreeRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for round := 0; round < 100; round++ {
+		k := 1 + rnd.Intn(3)
+		points := randomPoints(rnd, rnd.Intn(200), k)
+		built, _ := Build(k, points)
+		inserted, _ := New(k)
+		for _, p := range points {
+			_ = inserted.Insert(p)
+		}
+		if bound := 1 + int(math.Log2(float64(len(points)+1))); built.Depth() > bound {
+			t.Fatalf("Build of %d points has depth %d, want at most %d", len(points), built.Depth(), bound)
+		}
+		for i := 0; i < 20; i++ {
+			q := randomPoints(rnd, 1, k)[0]
+			q[0] += 0.5
+			want := bruteNearest(points, q)
+			for name, tree := range map[string]*KDTree{"built": built, "inserted": inserted} {
+				p, d, ok, _ := tree.Nearest(q)
+				if len(points) == 0 {
+					if ok {
+						t.Fatalf("%s: Nearest in an empty tree found %v", name, p)
+					}
+					continue
+				}
+				if !ok || d != want || math.Sqrt(squaredDistance(p, q)) != d {
+					t.Fatalf("%s: Nearest(%v) = %v, %v, want distance %v", name, q, p, d, want)
+				}
+			}
+		}
+	}
+}
+
+func TestRebuildIfUnbalanced(t *testing.T) {
+	tree, _ := New(2)
+	// points sorted along both axes make a path
+	for i := 0; i < 1000; i++ {
+		_ = tree.Insert([]float64{float64(i), float64(i)})
+	}
+	if tree.Depth() != 1000 || tree.Balanced() {
+		t.Fatalf("sorted inserts gave depth %d", tree.Depth())
+	}
+	tree.Instrument(true)
+	_, _, _, _ = tree.Nearest([]float64{999, 999})
+	skewed := tree.Stats().Visited
+	if !tree.RebuildIfUnbalanced() || tree.Depth() != 10 || tree.Len() != 1000 {
+		t.Fatalf("RebuildIfUnbalanced left depth %d and %d points", tree.Depth(), tree.Len())
+	}
+	if tree.RebuildIfUnbalanced() {
+		t.Errorf("a balanced tree was rebuilt")
+	}
+	tree.ResetStats()
+	p, _, _, _ := tree.Nearest([]float64{999, 999})
+	if stats := tree.Stats(); stats.Queries != 1 || stats.Visited >= skewed/10 || p[0] != 999 {
+		t.Errorf("after the rebuild, the query visited %d nodes, %d before, and found %v", stats.Visited, skewed, p)
+	}
+	tree.Instrument(false)
+	_, _, _, _ = tree.Nearest([]float64{0, 0})
+	if tree.Stats().Queries != 1 {
+		t.Errorf("a query was counted with instrumentation off")
+	}
+}
+
+// benchmarkNearest reports the nodes visited per query on a tree of the
+// points built with build.
+func benchmarkNearest(b *testing.B, build func(points [][]float64) *KDTree) {
+	rnd := rand.New(rand.NewSource(1))
+	points := make([][]float64, 10000)
+	for i := range points {
+		// skewed: sorted along the first axis
+		points[i] = []float64{float64(i), rnd.Float64() * 100}
+	}
+	tree := build(points)
+	tree.Instrument(true)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _, _, _ = tree.Nearest([]float64{rnd.Float64() * 10000, rnd.Float64() * 100})
+	}
+	b.ReportMetric(float64(tree.Stats().Visited)/float64(tree.Stats().Queries), "visited/op")
+}
+
+func BenchmarkNearestSkewedInserts(b *testing.B) {
+	benchmarkNearest(b, func(points [][]float64) *KDTree {
+		tree, _ := New(2)
+		for _, p := range points {
+			_ = tree.Insert(p)
+		}
+		return tree
+	})
+}
+
+func BenchmarkNearestRebuilt(b *testing.B) {
+	benchmarkNearest(b, func(points [][]float64) *KDTree {
+		tree, _ := New(2)
+		for _, p := range points {
+			_ = tree.Insert(p)
+		}
+		tree.RebuildIfUnbalanced()
+		return tree
+	})
+}
+
+func BenchmarkNearestBuilt(b *testing.B) {
+	benchmarkNearest(b, func(points [][]float64) *KDTree {
+		tree, _ := Build(2, points)
+		return tree
+	})
+}
+
+func BenchmarkBuild(b *testing.B) {
+	points := randomPoints(rand.New(rand.NewSource(1)), 10000, 3)
+	for i := 0; i < b.N; i++ {
+		_, _ = Build(3, points)
+	}
+}
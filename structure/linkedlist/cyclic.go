@@ -1,6 +1,10 @@
 package linkedlist
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/TheAlgorithms/Go/structure/iterutil"
+)
 
 // Cyclic Struct which cycles the linked list in this implementation.
 type Cyclic[T any] struct {
@@ -103,6 +107,49 @@ func (cl *Cyclic[T]) Destroy() {
 	}
 }
 
+// Clone returns an independent copy of the list, starting at the same
+// element. Every value is passed through cloneValue, if it is not nil.
+func (cl *Cyclic[T]) Clone(cloneValue func(T) T) *Cyclic[T] {
+	c := NewCyclic[T]()
+	cur := cl.Head
+	for i := 0; i < cl.Size; i++ {
+		c.Add(cloneValueOf(cur.Val, cloneValue))
+		cur = cur.Next
+	}
+	return c
+}
+
+// Equal reports whether the two lists hold equal values, according to eq
+// or to reflect.DeepEqual if eq is
+// nil, in the same order from their current heads.
+func (cl *Cyclic[T]) Equal(other *Cyclic[T], eq func(a, b T) bool) bool {
+	if cl.Size != other.Size {
+		return false
+	}
+	a, b := cl.Head, other.Head
+	for i := 0; i < cl.Size; i++ {
+		if !equalValues(a.Val, b.Val, eq) {
+			return false
+		}
+		a, b = a.Next, b.Next
+	}
+	return true
+}
+
+// All returns the values of the list once around, starting at the head, as
+// a lazy sequence.
+func (cl *Cyclic[T]) All() iterutil.Seq[T] {
+	return func(yield func(T) bool) {
+		cur := cl.Head
+		for i := 0; i < cl.Size; i++ {
+			if !yield(cur.Val) {
+				return
+			}
+			cur = cur.Next
+		}
+	}
+}
+
 // Show list body.
 func (cl *Cyclic[T]) Walk() *Node[T] {
 	var start *Node[T]
@@ -1,6 +1,10 @@
 package linkedlist
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/TheAlgorithms/Go/structure/iterutil"
+)
 
 // Doubly structure with just the Head Node
 // We call it `Doubly` to make it easier to
@@ -187,6 +191,66 @@ func (ll *Doubly[T]) DisplayReverse() {
 	fmt.Print("\n")
 }
 
+// Clone returns an independent copy of the list. Every value is passed
+// through cloneValue, if it is not nil.
+func (ll *Doubly[T]) Clone(cloneValue func(T) T) *Doubly[T] {
+	c := NewDoubly[T]()
+	if ll.Head == nil || ll.Head.Next == nil {
+		return c
+	}
+	for cur := ll.Head.Next; cur != ll.Head; cur = cur.Next {
+		c.AddAtEnd(cloneValueOf(cur.Val, cloneValue))
+	}
+	return c
+}
+
+// Equal reports whether the two lists hold equal values, according to eq
+// or to reflect.DeepEqual if eq is
+// nil, in the same order.
+func (ll *Doubly[T]) Equal(other *Doubly[T], eq func(a, b T) bool) bool {
+	if ll.Count() != other.Count() {
+		return false
+	}
+	if ll.Count() == 0 {
+		return true
+	}
+	for a, b := ll.Head.Next, other.Head.Next; a != ll.Head; a, b = a.Next, b.Next {
+		if !equalValues(a.Val, b.Val, eq) {
+			return false
+		}
+	}
+	return true
+}
+
+// All returns the values of the list from front to back as a lazy sequence.
+func (ll *Doubly[T]) All() iterutil.Seq[T] {
+	return func(yield func(T) bool) {
+		if ll.Head == nil || ll.Head.Next == nil {
+			return
+		}
+		for cur := ll.Head.Next; cur != ll.Head; cur = cur.Next {
+			if !yield(cur.Val) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns the values of the list from back to front as a lazy
+// sequence.
+func (ll *Doubly[T]) Backward() iterutil.Seq[T] {
+	return func(yield func(T) bool) {
+		if ll.Head == nil || ll.Head.Prev == nil {
+			return
+		}
+		for cur := ll.Head.Prev; cur != ll.Head; cur = cur.Prev {
+			if !yield(cur.Val) {
+				return
+			}
+		}
+	}
+}
+
 func (ll *Doubly[T]) Front() *Node[T] {
 	if ll.Count() == 0 {
 		return nil
@@ -0,0 +1,353 @@
+package linkedlist
+
+import "github.com/TheAlgorithms/Go/structure/iterutil"
+
+// List is a generic doubly linked list in the spirit of container/list.
+// A sentinel element closes the list into a ring, so that inserting and
+// removing never has to deal with a missing neighbour, and the zero value is
+// an empty list ready to use.
+// Elements are stable handles: an *Element stays valid while its value moves
+// around in the list, or even into another list through Splice, so that a
+// structure such as an LRU cache can keep pointers to them. Operations given
+// an element that does not belong to the list leave the list untouched.
+// Every operation takes O(1) time. Splice moves a whole list in O(1) too: the
+// elements of the spliced list do not record their list directly but an
+// owner, and the owner of the spliced list is merged into the owner of the
+// receiving one, union-find style.
+type List[T any] struct {
+	root Element[T] // sentinel: root.next is the front, root.prev the back
+	len  int
+	own  *owner[T]
+}
+
+// owner identifies the list of an element. An owner whose list has been
+// spliced into another one forwards to the owner of that list.
+type owner[T any] struct {
+	list   *List[T]
+	parent *owner[T]
+}
+
+// find returns the owner at the end of the forwarding chain, halving the
+// chain on the way.
+func (o *owner[T]) find() *owner[T] {
+	for o.parent != nil {
+		if o.parent.parent != nil {
+			o.parent = o.parent.parent
+		}
+		o = o.parent
+	}
+	return o
+}
+
+// Element is an element of a List.
+type Element[T any] struct {
+	Value T
+
+	next, prev *Element[T]
+	own        *owner[T] // nil once the element is removed
+}
+
+// list returns the list holding e, or nil if e was removed.
+func (e *Element[T]) list() *List[T] {
+	if e.own == nil {
+		return nil
+	}
+	return e.own.find().list
+}
+
+// Next returns the element after e, or nil if e is the last one or was
+// removed.
+func (e *Element[T]) Next() *Element[T] {
+	l := e.list()
+	if l == nil || e.next == &l.root {
+		return nil
+	}
+	return e.next
+}
+
+// Prev returns the element before e, or nil if e is the first one or was
+// removed.
+func (e *Element[T]) Prev() *Element[T] {
+	l := e.list()
+	if l == nil || e.prev == &l.root {
+		return nil
+	}
+	return e.prev
+}
+
+// NewList returns an empty list.
+func NewList[T any]() *List[T] {
+	return new(List[T]).lazyInit()
+}
+
+// lazyInit links the sentinel to itself the first time the list is used.
+func (l *List[T]) lazyInit() *List[T] {
+	if l.own == nil {
+		l.root.next = &l.root
+		l.root.prev = &l.root
+		l.own = &owner[T]{list: l}
+	}
+	return l
+}
+
+// Len returns the number of elements of l.
+func (l *List[T]) Len() int {
+	return l.len
+}
+
+// Front returns the first element of l, or nil if l is empty.
+func (l *List[T]) Front() *Element[T] {
+	if l.len == 0 {
+		return nil
+	}
+	return l.root.next
+}
+
+// Back returns the last element of l, or nil if l is empty.
+func (l *List[T]) Back() *Element[T] {
+	if l.len == 0 {
+		return nil
+	}
+	return l.root.prev
+}
+
+// Contains reports whether e is an element of l.
+func (l *List[T]) Contains(e *Element[T]) bool {
+	return e != nil && l.own != nil && e.list() == l
+}
+
+// link inserts e after at.
+func (l *List[T]) link(e, at *Element[T]) *Element[T] {
+	e.prev = at
+	e.next = at.next
+	e.prev.next = e
+	e.next.prev = e
+	e.own = l.own
+	l.len++
+	return e
+}
+
+// unlink removes e from its neighbours.
+func (l *List[T]) unlink(e *Element[T]) {
+	e.prev.next = e.next
+	e.next.prev = e.prev
+	l.len--
+}
+
+// PushFront inserts v at the front of l and returns its element.
+func (l *List[T]) PushFront(v T) *Element[T] {
+	l.lazyInit()
+	return l.link(&Element[T]{Value: v}, &l.root)
+}
+
+// PushBack inserts v at the back of l and returns its element.
+func (l *List[T]) PushBack(v T) *Element[T] {
+	l.lazyInit()
+	return l.link(&Element[T]{Value: v}, l.root.prev)
+}
+
+// InsertBefore inserts v right before mark and returns its element. It
+// returns nil if mark is not an element of l.
+func (l *List[T]) InsertBefore(v T, mark *Element[T]) *Element[T] {
+	if !l.Contains(mark) {
+		return nil
+	}
+	return l.link(&Element[T]{Value: v}, mark.prev)
+}
+
+// InsertAfter inserts v right after mark and returns its element. It
+// returns nil if mark is not an element of l.
+func (l *List[T]) InsertAfter(v T, mark *Element[T]) *Element[T] {
+	if !l.Contains(mark) {
+		return nil
+	}
+	return l.link(&Element[T]{Value: v}, mark)
+}
+
+// Remove removes e from l if it is an element of l, and returns its value.
+// The removed element keeps its value but no longer has neighbours.
+func (l *List[T]) Remove(e *Element[T]) T {
+	if l.Contains(e) {
+		l.unlink(e)
+		e.next, e.prev, e.own = nil, nil, nil
+	}
+	return e.Value
+}
+
+// move moves e, an element of l, right after at.
+func (l *List[T]) move(e, at *Element[T]) {
+	if e == at || e.prev == at {
+		return
+	}
+	l.unlink(e)
+	l.link(e, at)
+}
+
+// MoveToFront moves e to the front of l.
+func (l *List[T]) MoveToFront(e *Element[T]) {
+	if l.Contains(e) {
+		l.move(e, &l.root)
+	}
+}
+
+// MoveToBack moves e to the back of l.
+func (l *List[T]) MoveToBack(e *Element[T]) {
+	if l.Contains(e) {
+		l.move(e, l.root.prev)
+	}
+}
+
+// MoveBefore moves e right before mark. Both must be elements of l.
+func (l *List[T]) MoveBefore(e, mark *Element[T]) {
+	if l.Contains(e) && l.Contains(mark) && e != mark {
+		l.move(e, mark.prev)
+	}
+}
+
+// MoveAfter moves e right after mark. Both must be elements of l.
+func (l *List[T]) MoveAfter(e, mark *Element[T]) {
+	if l.Contains(e) && l.Contains(mark) {
+		l.move(e, mark)
+	}
+}
+
+// Splice moves all the elements of other to the back of l, in O(1) time,
+// leaving other empty. The elements keep their identity and now belong to l.
+func (l *List[T]) Splice(other *List[T]) {
+	l.lazyInit()
+	if other == l || other.len == 0 {
+		return
+	}
+	first, last := other.root.next, other.root.prev
+	first.prev = l.root.prev
+	last.next = &l.root
+	l.root.prev.next = first
+	l.root.prev = last
+	l.len += other.len
+
+	// hand the elements of other over to l and give other a fresh owner
+	other.own.parent = l.own.find()
+	other.own.list = nil
+	other.own = nil
+	other.len = 0
+	other.lazyInit()
+}
+
+// Values returns the values of l from front to back.
+func (l *List[T]) Values() []T {
+	values := make([]T, 0, l.len)
+	for it := l.Iterator(); it.Next(); {
+		values = append(values, it.Value())
+	}
+	return values
+}
+
+// Clone returns an independent copy of l with new elements. Every value is
+// passed through cloneValue, if it is not nil, so that values holding
+// pointers can be deep copied.
+func (l *List[T]) Clone(cloneValue func(T) T) *List[T] {
+	c := NewList[T]()
+	for e := l.Front(); e != nil; e = e.Next() {
+		c.PushBack(cloneValueOf(e.Value, cloneValue))
+	}
+	return c
+}
+
+// Equal reports whether l and other hold equal values, according to eq or
+// to reflect.DeepEqual if eq is nil, in the same order.
+func (l *List[T]) Equal(other *List[T], eq func(a, b T) bool) bool {
+	if l.Len() != other.Len() {
+		return false
+	}
+	for a, b := l.Front(), other.Front(); a != nil; a, b = a.Next(), b.Next() {
+		if !equalValues(a.Value, b.Value, eq) {
+			return false
+		}
+	}
+	return true
+}
+
+// All returns the values of l from front to back as a lazy sequence. Like
+// Iterator, it lets the current element be removed or moved meanwhile.
+func (l *List[T]) All() iterutil.Seq[T] {
+	return l.seq(l.Iterator)
+}
+
+// Backward returns the values of l from back to front as a lazy sequence.
+func (l *List[T]) Backward() iterutil.Seq[T] {
+	return l.seq(l.ReverseIterator)
+}
+
+// seq returns the sequence of the values of the iterators made by newIt.
+func (l *List[T]) seq(newIt func() *Iterator[T]) iterutil.Seq[T] {
+	return func(yield func(T) bool) {
+		for it := newIt(); it.Next(); {
+			if !yield(it.Value()) {
+				return
+			}
+		}
+	}
+}
+
+// Iterator walks over a List in either direction. It fetches the element to
+// continue with before returning the current one, so the current element
+// may be removed or moved, even to another list, without disturbing the
+// iteration. If the element it would continue with is removed meanwhile,
+// the iteration ends.
+type Iterator[T any] struct {
+	list     *List[T]
+	current  *Element[T]
+	upcoming *Element[T]
+	backward bool
+	started  bool
+}
+
+// Iterator returns an iterator over l from front to back.
+func (l *List[T]) Iterator() *Iterator[T] {
+	return &Iterator[T]{list: l}
+}
+
+// ReverseIterator returns an iterator over l from back to front.
+func (l *List[T]) ReverseIterator() *Iterator[T] {
+	return &Iterator[T]{list: l, backward: true}
+}
+
+// step returns the neighbour of e in the direction of the iteration, or nil
+// at the end of the list.
+func (it *Iterator[T]) step(e *Element[T]) *Element[T] {
+	if it.backward {
+		return e.Prev()
+	}
+	return e.Next()
+}
+
+// Next advances the iterator and reports whether there is a current element.
+func (it *Iterator[T]) Next() bool {
+	if !it.started {
+		it.started = true
+		if it.backward {
+			it.current = it.list.Back()
+		} else {
+			it.current = it.list.Front()
+		}
+	} else if it.upcoming != nil && it.list.Contains(it.upcoming) {
+		it.current = it.upcoming
+	} else {
+		it.current = nil
+	}
+	it.upcoming = nil
+	if it.current != nil {
+		it.upcoming = it.step(it.current)
+	}
+	return it.current != nil
+}
+
+// Element returns the current element.
+func (it *Iterator[T]) Element() *Element[T] {
+	return it.current
+}
+
+// Value returns the value of the current element.
+func (it *Iterator[T]) Value() T {
+	return it.current.Value
+}
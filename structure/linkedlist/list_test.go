@@ -0,0 +1,330 @@
+package linkedlist
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/structure/iterutil"
+)
+
+// checkList verifies the links of l in both directions against want.
+func checkList(t *testing.T, l *List[int], want []int) {
+	t.Helper()
+	if l.Len() != len(want) {
+		t.Fatalf("Len() = %d, want %d", l.Len(), len(want))
+	}
+	var got []int
+	for e := l.Front(); e != nil; e = e.Next() {
+		got = append(got, e.Value)
+	}
+	var back []int
+	for e := l.Back(); e != nil; e = e.Prev() {
+		back = append([]int{e.Value}, back...)
+	}
+	if len(want) == 0 {
+		want = nil
+	}
+	if !reflect.DeepEqual(got, want) || !reflect.DeepEqual(back, want) {
+		t.Fatalf("list is %v forwards and %v backwards, want %v", got, back, want)
+	}
+}
+
+func TestList(t *testing.T) {
+	var l List[int] // the zero value is ready to use
+	checkList(t, &l, nil)
+	if l.Front() != nil || l.Back() != nil {
+		t.Errorf("Front and Back of an empty list should be nil")
+	}
+
+	two := l.PushBack(2)
+	one := l.PushFront(1)
+	four := l.PushBack(4)
+	three := l.InsertBefore(3, four)
+	five := l.InsertAfter(5, four)
+	checkList(t, &l, []int{1, 2, 3, 4, 5})
+
+	l.MoveToFront(five)
+	checkList(t, &l, []int{5, 1, 2, 3, 4})
+	l.MoveToBack(one)
+	checkList(t, &l, []int{5, 2, 3, 4, 1})
+	l.MoveBefore(one, two)
+	checkList(t, &l, []int{5, 1, 2, 3, 4})
+	l.MoveAfter(five, four)
+	checkList(t, &l, []int{1, 2, 3, 4, 5})
+	l.MoveAfter(three, two) // already in place
+	l.MoveBefore(three, three)
+	checkList(t, &l, []int{1, 2, 3, 4, 5})
+
+	if v := l.Remove(three); v != 3 {
+		t.Errorf("Remove returned %d, want 3", v)
+	}
+	checkList(t, &l, []int{1, 2, 4, 5})
+	if three.Next() != nil || three.Prev() != nil || l.Contains(three) {
+		t.Errorf("a removed element should have no neighbours")
+	}
+
+	// operations with foreign or removed elements are ignored
+	other := NewList[int]()
+	foreign := other.PushBack(9)
+	l.Remove(three)
+	l.Remove(foreign)
+	l.MoveToFront(foreign)
+	l.MoveAfter(one, foreign)
+	if l.InsertBefore(7, foreign) != nil || l.InsertAfter(7, three) != nil {
+		t.Errorf("inserting next to a foreign element should fail")
+	}
+	checkList(t, &l, []int{1, 2, 4, 5})
+	checkList(t, other, []int{9})
+}
+
+func TestSplice(t *testing.T) {
+	a, b := NewList[int](), NewList[int]()
+	a.PushBack(1)
+	a.PushBack(2)
+	three := b.PushBack(3)
+	four := b.PushBack(4)
+
+	a.Splice(b)
+	checkList(t, a, []int{1, 2, 3, 4})
+	checkList(t, b, nil)
+	if !a.Contains(three) || b.Contains(three) {
+		t.Fatalf("spliced elements should belong to the receiving list")
+	}
+
+	// the spliced handles keep working in their new list
+	a.MoveToFront(four)
+	b.MoveToFront(three) // no longer b's
+	checkList(t, a, []int{4, 1, 2, 3})
+
+	// b is still usable, and splicing chains of lists keeps ownership right
+	b.PushBack(5)
+	c := NewList[int]()
+	six := c.PushBack(6)
+	b.Splice(c)
+	a.Splice(b)
+	checkList(t, a, []int{4, 1, 2, 3, 5, 6})
+	if !a.Contains(six) || !a.Contains(three) {
+		t.Fatalf("elements spliced twice should belong to the final list")
+	}
+	a.Remove(six)
+	checkList(t, a, []int{4, 1, 2, 3, 5})
+
+	a.Splice(a)
+	a.Splice(NewList[int]())
+	var empty List[int]
+	empty.Splice(a)
+	checkList(t, &empty, []int{4, 1, 2, 3, 5})
+	checkList(t, a, nil)
+}
+
+func TestIterator(t *testing.T) {
+	l := NewList[int]()
+	for i := 1; i <= 6; i++ {
+		l.PushBack(i)
+	}
+	if got := l.Values(); !reflect.DeepEqual(got, []int{1, 2, 3, 4, 5, 6}) {
+		t.Fatalf("Values() = %v", got)
+	}
+
+	var backward []int
+	for it := l.ReverseIterator(); it.Next(); {
+		backward = append(backward, it.Value())
+	}
+	if !reflect.DeepEqual(backward, []int{6, 5, 4, 3, 2, 1}) {
+		t.Errorf("backward iteration = %v", backward)
+	}
+
+	// removing and moving the current element is safe
+	var seen []int
+	for it := l.Iterator(); it.Next(); {
+		seen = append(seen, it.Value())
+		switch it.Value() % 3 {
+		case 0:
+			l.Remove(it.Element())
+		case 1:
+			l.MoveToFront(it.Element())
+		}
+	}
+	if !reflect.DeepEqual(seen, []int{1, 2, 3, 4, 5, 6}) {
+		t.Errorf("iteration while modifying saw %v", seen)
+	}
+	checkList(t, l, []int{4, 1, 2, 5})
+
+	// removing the upcoming element ends the iteration
+	it := l.Iterator()
+	it.Next()
+	l.Remove(l.Front().Next())
+	if it.Next() {
+		t.Errorf("iteration should end when the upcoming element is removed")
+	}
+
+	var empty List[int]
+	if empty.Iterator().Next() || empty.ReverseIterator().Next() {
+		t.Errorf("an empty list should have nothing to iterate")
+	}
+}
+
+func TestListAgainstSlice(t *testing.T) {
+	rnd := rand.New(rand.NewSource(6))
+	l := NewList[int]()
+	var want []int
+	elements := map[int]*Element[int]{}
+	for i := 0; i < 2000; i++ {
+		switch op := rnd.Intn(4); {
+		case op == 0 || len(want) == 0:
+			elements[i] = l.PushBack(i)
+			want = append(want, i)
+		case op == 1:
+			k := rnd.Intn(len(want))
+			v := want[k]
+			l.MoveToFront(elements[v])
+			want = append([]int{v}, append(want[:k:k], want[k+1:]...)...)
+		case op == 2:
+			k := rnd.Intn(len(want))
+			l.Remove(elements[want[k]])
+			want = append(want[:k:k], want[k+1:]...)
+		default:
+			elements[i] = l.PushFront(i)
+			want = append([]int{i}, want...)
+		}
+	}
+	checkList(t, l, want)
+}
+
+func TestListClone(t *testing.T) {
+	type point struct{ x, y int }
+	eq := func(a, b *point) bool { return *a == *b }
+	l := NewList[*point]()
+	for i := 0; i < 5; i++ {
+		l.PushBack(&point{i, -i})
+	}
+
+	clone := l.Clone(func(p *point) *point { c := *p; return &c })
+	if !l.Equal(clone, eq) {
+		t.Fatalf("a clone should equal its original")
+	}
+	l.Front().Value.x = 100
+	if l.Equal(clone, eq) {
+		t.Errorf("a deep clone should not share values with its original")
+	}
+
+	shallow := l.Clone(nil)
+	if shallow.Front().Value != l.Front().Value {
+		t.Errorf("a clone without a value callback should share the values")
+	}
+	shallow.Remove(shallow.Front())
+	if l.Len() != 5 || l.Equal(shallow, eq) {
+		t.Errorf("removing from a clone should not affect the original")
+	}
+	var empty List[*point]
+	if !empty.Equal(empty.Clone(nil), eq) {
+		t.Errorf("an empty list should equal its clone")
+	}
+
+	slices := NewList[[]int]()
+	slices.PushBack([]int{1, 2})
+	if !slices.Equal(slices.Clone(nil), nil) {
+		t.Errorf("lists of slices should compare without an eq callback")
+	}
+}
+
+func TestOtherListsClone(t *testing.T) {
+	eq := func(a, b int) bool { return a == b }
+	double := func(v int) int { return 2 * v }
+
+	d := NewDoubly[int]()
+	s := NewSingly[int]()
+	c := NewCyclic[int]()
+	for i := 1; i <= 4; i++ {
+		d.AddAtEnd(i)
+		s.AddAtEnd(i)
+		c.Add(i)
+	}
+
+	dc, sc, cc := d.Clone(nil), s.Clone(nil), c.Clone(nil)
+	if !d.Equal(dc, eq) || !s.Equal(sc, eq) || !c.Equal(cc, eq) {
+		t.Fatalf("clones should equal their originals")
+	}
+	dc.DelAtEnd()
+	sc.DelAtEnd()
+	cc.Delete()
+	if d.Equal(dc, eq) || s.Equal(sc, eq) || c.Equal(cc, eq) {
+		t.Errorf("lists of different lengths should not be equal")
+	}
+	if d.Count() != 4 || s.Count() != 4 || c.Size != 4 {
+		t.Errorf("deleting from a clone should not affect the original")
+	}
+
+	sd := s.Clone(double)
+	var got []int
+	for cur := sd.Head; cur != nil; cur = cur.Next {
+		got = append(got, cur.Val)
+	}
+	if !reflect.DeepEqual(got, []int{2, 4, 6, 8}) || sd.Count() != 4 {
+		t.Errorf("Singly clone with a callback = %v", got)
+	}
+	if d.Clone(double).Equal(d, eq) || c.Clone(double).Equal(c, eq) {
+		t.Errorf("clones with changed values should not be equal")
+	}
+	if !NewDoubly[int]().Equal(new(Doubly[int]).Clone(nil), eq) {
+		t.Errorf("empty lists should be equal")
+	}
+
+	// without eq the values are compared with ==
+	if !d.Equal(d.Clone(nil), nil) || !s.Equal(s.Clone(nil), nil) || !c.Equal(c.Clone(nil), nil) {
+		t.Errorf("clones should equal their originals with a nil eq")
+	}
+	if d.Equal(d.Clone(double), nil) || s.Equal(sd, nil) || c.Equal(c.Clone(double), nil) {
+		t.Errorf("clones with changed values should not be equal with a nil eq")
+	}
+	l, lc := NewList[int](), NewList[int]()
+	l.PushBack(1)
+	lc.PushBack(1)
+	if !l.Equal(lc, nil) {
+		t.Errorf("lists holding the same values should be equal with a nil eq")
+	}
+	lc.Front().Value = 2
+	if l.Equal(lc, nil) {
+		t.Errorf("lists holding different values should not be equal with a nil eq")
+	}
+}
+
+func TestListSeqs(t *testing.T) {
+	l, d, s, c := NewList[int](), NewDoubly[int](), NewSingly[int](), NewCyclic[int]()
+	for i := 1; i <= 4; i++ {
+		l.PushBack(i)
+		d.AddAtEnd(i)
+		s.AddAtEnd(i)
+		c.Add(i)
+	}
+	want, backward := []int{1, 2, 3, 4}, []int{4, 3, 2, 1}
+	for name, got := range map[string][]int{
+		"List":    iterutil.Collect(l.All()),
+		"Doubly":  iterutil.Collect(d.All()),
+		"Singly":  iterutil.Collect(s.All()),
+		"Cyclic":  iterutil.Collect(c.All()),
+		"Partial": iterutil.Collect(iterutil.Take(c.All(), 4)),
+	} {
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s.All() = %v, want %v", name, got, want)
+		}
+	}
+	if got := iterutil.Collect(l.Backward()); !reflect.DeepEqual(got, backward) {
+		t.Errorf("List.Backward() = %v, want %v", got, backward)
+	}
+	if got := iterutil.Collect(d.Backward()); !reflect.DeepEqual(got, backward) {
+		t.Errorf("Doubly.Backward() = %v, want %v", got, backward)
+	}
+	if got := iterutil.Collect(iterutil.Take(s.All(), 2)); !reflect.DeepEqual(got, []int{1, 2}) {
+		t.Errorf("taking 2 from Singly.All() = %v", got)
+	}
+	// removing the current element does not stop the iteration
+	l.All()(func(v int) bool {
+		l.Remove(l.Front())
+		return true
+	})
+	if l.Len() != 0 || len(iterutil.Collect(new(Doubly[int]).All())) != 0 {
+		t.Errorf("removing while iterating left %v", l.Values())
+	}
+}
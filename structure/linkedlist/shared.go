@@ -1,5 +1,7 @@
 package linkedlist
 
+import "reflect"
+
 // Node Structure representing the linkedlist node.
 // This node is shared across different implementations.
 type Node[T any] struct {
@@ -12,3 +14,22 @@ type Node[T any] struct {
 func NewNode[T any](val T) *Node[T] {
 	return &Node[T]{val, nil, nil}
 }
+
+// cloneValueOf returns val passed through clone, or val itself if clone is nil.
+// It is shared by the Clone methods of the different lists.
+func cloneValueOf[T any](val T, clone func(T) T) T {
+	if clone == nil {
+		return val
+	}
+	return clone(val)
+}
+
+// equalValues compares two values with eq, or with reflect.DeepEqual if eq
+// is nil, so values that are not comparable with == do not panic. It is
+// shared by the Equal methods of the different lists.
+func equalValues[T any](a, b T, eq func(a, b T) bool) bool {
+	if eq == nil {
+		return reflect.DeepEqual(a, b)
+	}
+	return eq(a, b)
+}
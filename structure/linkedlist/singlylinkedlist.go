@@ -4,6 +4,8 @@ package linkedlist
 import (
 	"errors"
 	"fmt"
+
+	"github.com/TheAlgorithms/Go/structure/iterutil"
 )
 
 // Singly structure with length of the list and its head
@@ -123,6 +125,42 @@ func (ll *Singly[T]) Count() int {
 	return ll.length
 }
 
+// Clone returns an independent copy of the list. Every value is passed
+// through cloneValue, if it is not nil.
+func (ll *Singly[T]) Clone(cloneValue func(T) T) *Singly[T] {
+	c := &Singly[T]{length: ll.length}
+	tail := &c.Head
+	for cur := ll.Head; cur != nil; cur = cur.Next {
+		*tail = NewNode(cloneValueOf(cur.Val, cloneValue))
+		tail = &(*tail).Next
+	}
+	return c
+}
+
+// Equal reports whether the two lists hold equal values, according to eq
+// or to reflect.DeepEqual if eq is
+// nil, in the same order.
+func (ll *Singly[T]) Equal(other *Singly[T], eq func(a, b T) bool) bool {
+	a, b := ll.Head, other.Head
+	for ; a != nil && b != nil; a, b = a.Next, b.Next {
+		if !equalValues(a.Val, b.Val, eq) {
+			return false
+		}
+	}
+	return a == nil && b == nil
+}
+
+// All returns the values of the list from head to tail as a lazy sequence.
+func (ll *Singly[T]) All() iterutil.Seq[T] {
+	return func(yield func(T) bool) {
+		for cur := ll.Head; cur != nil; cur = cur.Next {
+			if !yield(cur.Val) {
+				return
+			}
+		}
+	}
+}
+
 // Reverse reverses the list.
 func (ll *Singly[T]) Reverse() {
 	var prev, Next *Node[T]
@@ -0,0 +1,219 @@
+// compaction.go
+// description: Merging and leveled compaction of the sorted tables
+// details:
+// Flushed tables go to level 0, where their key ranges may overlap, so that
+// a lookup checks them from the newest to the oldest. Every deeper level is a
+// single sorted run split in tables of disjoint key ranges, each level
+// allowed LevelSizeRatio times the size of the previous one. When level 0
+// holds L0Tables tables, they are merged with the tables of level 1 they
+// overlap into new tables of level 1. When a deeper level outgrows its size,
+// one of its tables, taken in turn around the key space, is merged the same
+// way into the next level. The merge is a k-way merge of sorted runs driven
+// by the binary heap of structure/heap, keeping the newest entry of every
+// key; tombstones are dropped once no deeper level may hold the key.
+// Every key is thus rewritten about LevelSizeRatio times per level, in
+// exchange for a lookup reading at most one table per level below level 0.
+// reference: https://github.com/google/leveldb/blob/main/doc/impl.md
+// see lsm_test.go
+
+package lsm
+
+import (
+	"os"
+	"sort"
+
+	"github.com/TheAlgorithms/Go/structure/heap"
+)
+
+// mergeEntries merges sorted runs into one, keeping for every key the entry
+// of the first run holding it, so runs go from the newest to the oldest.
+// Tombstones are left out when dropDeleted is set.
+func mergeEntries(runs [][]entry, dropDeleted bool) []entry {
+	type cursor struct{ run, pos int }
+	cursors, _ := heap.NewAny[cursor](func(a, b cursor) bool {
+		ka, kb := runs[a.run][a.pos].key, runs[b.run][b.pos].key
+		return ka < kb || ka == kb && a.run < b.run
+	})
+	for i, run := range runs {
+		if len(run) > 0 {
+			cursors.Push(cursor{i, 0})
+		}
+	}
+	var merged []entry
+	last, seen := "", false
+	for !cursors.Empty() {
+		c := cursors.Top()
+		cursors.Pop()
+		e := runs[c.run][c.pos]
+		if c.pos+1 < len(runs[c.run]) {
+			cursors.Push(cursor{c.run, c.pos + 1})
+		}
+		if seen && e.key == last {
+			continue // an older entry of the same key
+		}
+		last, seen = e.key, true
+		if e.deleted && dropDeleted {
+			continue
+		}
+		merged = append(merged, e)
+	}
+	return merged
+}
+
+// levelLimit returns the size in bytes above which level, from 1 on, is
+// compacted.
+func (db *DB) levelLimit(level int) int64 {
+	limit := int64(db.opts.LevelSizeRatio) * int64(db.opts.TableSize)
+	for i := 1; i < level; i++ {
+		limit *= int64(db.opts.LevelSizeRatio)
+	}
+	return limit
+}
+
+func levelSize(tables []*table) int64 {
+	var size int64
+	for _, t := range tables {
+		size += t.size
+	}
+	return size
+}
+
+// maybeCompact compacts the levels until every one is within its limits.
+func (db *DB) maybeCompact() error {
+	for {
+		if len(db.levels[0]) >= db.opts.L0Tables {
+			if err := db.compact(0, db.levels[0]); err != nil {
+				return err
+			}
+			continue
+		}
+		level := 0
+		for i := 1; i < len(db.levels); i++ {
+			if levelSize(db.levels[i]) > db.levelLimit(i) {
+				level = i
+				break
+			}
+		}
+		if level == 0 {
+			return nil
+		}
+		if err := db.compact(level, []*table{db.pickTable(level)}); err != nil {
+			return err
+		}
+	}
+}
+
+// pickTable returns the table of level following the last one compacted,
+// wrapping around at the end of the key space.
+func (db *DB) pickTable(level int) *table {
+	tables := db.levels[level]
+	pointer, ok := db.compactPointer[level]
+	for _, t := range tables {
+		if !ok || t.minKey > pointer {
+			db.compactPointer[level] = t.maxKey
+			return t
+		}
+	}
+	db.compactPointer[level] = tables[0].maxKey
+	return tables[0]
+}
+
+// compact merges the inputs of level with the tables of the next level
+// overlapping them into new tables of the next level.
+func (db *DB) compact(level int, inputs []*table) error {
+	next := level + 1
+	if next == len(db.levels) {
+		db.levels = append(db.levels, nil)
+	}
+	min, max := inputs[0].minKey, inputs[0].maxKey
+	for _, t := range inputs[1:] {
+		if t.minKey < min {
+			min = t.minKey
+		}
+		if t.maxKey > max {
+			max = t.maxKey
+		}
+	}
+	var overlapping, kept []*table
+	for _, t := range db.levels[next] {
+		if t.overlaps(min, max) {
+			overlapping = append(overlapping, t)
+		} else {
+			kept = append(kept, t)
+		}
+	}
+
+	// the newest inputs come first; the tables of the next level are older
+	sources := append([]*table(nil), inputs...)
+	sort.Slice(sources, func(i, j int) bool { return sources[i].num > sources[j].num })
+	sources = append(sources, overlapping...)
+	runs := make([][]entry, len(sources))
+	for i, t := range sources {
+		run, err := t.scan("", "")
+		if err != nil {
+			return err
+		}
+		runs[i] = run
+	}
+	bottom := true
+	for _, tables := range db.levels[next+1:] {
+		if len(tables) > 0 {
+			bottom = false
+		}
+	}
+	merged := mergeEntries(runs, bottom)
+
+	// the merged run is cut into tables of about TableSize bytes
+	var outputs []*table
+	for start := 0; start < len(merged); {
+		end, size := start, 0
+		for end < len(merged) && (end == start || size < db.opts.TableSize) {
+			size += len(merged[end].key) + len(merged[end].value)
+			end++
+		}
+		t, err := db.newTable(merged[start:end])
+		if err != nil {
+			for _, t := range outputs {
+				t.close()
+				os.Remove(t.path)
+			}
+			return err
+		}
+		outputs = append(outputs, t)
+		start = end
+	}
+
+	previous := db.levels
+	db.levels = append([][]*table(nil), db.levels...)
+	db.levels[level] = removeTables(db.levels[level], inputs)
+	db.levels[next] = append(kept, outputs...)
+	sort.Slice(db.levels[next], func(i, j int) bool { return db.levels[next][i].minKey < db.levels[next][j].minKey })
+	if err := db.writeManifest(); err != nil {
+		db.levels = previous
+		for _, t := range outputs {
+			t.close()
+			os.Remove(t.path)
+		}
+		return err
+	}
+	for _, t := range append(inputs, overlapping...) {
+		t.close()
+		os.Remove(t.path)
+	}
+	return nil
+}
+
+// removeTables returns tables without the ones in removed.
+func removeTables(tables, removed []*table) []*table {
+	gone := map[*table]bool{}
+	for _, t := range removed {
+		gone[t] = true
+	}
+	var left []*table
+	for _, t := range tables {
+		if !gone[t] {
+			left = append(left, t)
+		}
+	}
+	return left
+}
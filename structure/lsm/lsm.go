@@ -0,0 +1,382 @@
+// lsm.go
+// description: Log-structured merge-tree key-value store
+// details:
+// A log-structured merge-tree turns random writes into sequential ones:
+// writes go to an in-memory sorted buffer, the memtable, which is written
+// out as an immutable sorted table file once it holds MemtableSize bytes.
+// Tables are never modified; they are merged by background compaction, see
+// compaction.go, which also discards overwritten values and deletions. A
+// lookup checks the memtable and then the tables from the newest to the
+// oldest, and the first entry found for the key wins, a deletion being a
+// tombstone entry hiding the older values.
+// The set of live tables and their levels is recorded in a MANIFEST file,
+// replaced atomically by renaming a new version over it, so that a crash
+// during a compaction leaves either the old or the new tables in use; the
+// table files it does not list are removed on Open.
+// This store is a teaching model: it is not safe for concurrent use and,
+// lacking the write-ahead log of a real engine, loses the writes still in
+// the memtable if the process dies before Flush or Close.
+// Put, Delete: O(log n) expected, plus the amortized cost of compaction
+// Get: O(log n) in the memtable, then one block read per table checked
+// reference: O'Neil et al., "The Log-Structured Merge-Tree (LSM-Tree)", Acta
+// Informatica 1996
+// reference: https://en.wikipedia.org/wiki/Log-structured_merge-tree
+// see lsm_test.go
+
+// Package lsm implements a small log-structured merge-tree key-value store,
+// with a skip list memtable, sorted table files with a block index and a
+// Bloom filter, and leveled compaction.
+package lsm
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrClosed is returned by the operations of a closed store.
+	ErrClosed = errors.New("lsm store is closed")
+	// ErrCorruptManifest is returned by Open when the MANIFEST file is
+	// malformed or lists a missing table.
+	ErrCorruptManifest = errors.New("corrupt lsm manifest")
+)
+
+// Options tunes a store. A zero field takes the default value.
+type Options struct {
+	// MemtableSize is the size in bytes of keys and values from which the
+	// memtable is flushed to a table. Default 4 MiB.
+	MemtableSize int
+	// BlockSize is the size in bytes of the data blocks of tables.
+	// Default 4 KiB.
+	BlockSize int
+	// TableSize is the size in bytes of keys and values of the tables
+	// written by compaction. Default 2 MiB.
+	TableSize int
+	// L0Tables is the number of tables of level 0 triggering its
+	// compaction. Default 4.
+	L0Tables int
+	// LevelSizeRatio is the growth in size from one level to the next, and
+	// level 1 holds LevelSizeRatio tables. Default 10.
+	LevelSizeRatio int
+	// FalsePositiveRate is the target false positive rate of the Bloom
+	// filters of the tables. Default 0.01.
+	FalsePositiveRate float64
+	// Seed seeds the skip list of the memtable.
+	Seed int64
+}
+
+// withDefaults returns a copy of opts with the zero fields set to their
+// default.
+func (opts Options) withDefaults() Options {
+	if opts.MemtableSize <= 0 {
+		opts.MemtableSize = 4 << 20
+	}
+	if opts.BlockSize <= 0 {
+		opts.BlockSize = 4 << 10
+	}
+	if opts.TableSize <= 0 {
+		opts.TableSize = 2 << 20
+	}
+	if opts.L0Tables <= 0 {
+		opts.L0Tables = 4
+	}
+	if opts.LevelSizeRatio <= 1 {
+		opts.LevelSizeRatio = 10
+	}
+	if !(opts.FalsePositiveRate > 0 && opts.FalsePositiveRate < 1) {
+		opts.FalsePositiveRate = 0.01
+	}
+	return opts
+}
+
+// manifestName is the name of the file listing the live tables.
+const manifestName = "MANIFEST"
+
+// tableExt is the extension of the table files.
+const tableExt = ".sst"
+
+// DB is a key-value store keeping string keys in order.
+type DB struct {
+	dir            string
+	opts           Options
+	mem            *memtable
+	levels         [][]*table // level 0 from the oldest table, the others by key
+	compactPointer map[int]string
+	nextNum        uint64
+	closed         bool
+}
+
+// Open opens the store in dir, creating the directory if needed. opts may be
+// nil for the default options.
+func Open(dir string, opts *Options) (*DB, error) {
+	var o Options
+	if opts != nil {
+		o = *opts
+	}
+	db := &DB{
+		dir:            dir,
+		opts:           o.withDefaults(),
+		levels:         [][]*table{nil},
+		compactPointer: map[int]string{},
+		nextNum:        1,
+	}
+	db.mem = newMemtable(db.opts.Seed)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	if err := db.load(); err != nil {
+		db.closeTables()
+		return nil, err
+	}
+	return db, nil
+}
+
+// tablePath returns the path of the table numbered num.
+func (db *DB) tablePath(num uint64) string {
+	return filepath.Join(db.dir, fmt.Sprintf("%08d%s", num, tableExt))
+}
+
+// load opens the tables listed by the manifest and removes the others.
+func (db *DB) load() error {
+	live := map[string]bool{}
+	data, err := os.ReadFile(filepath.Join(db.dir, manifestName))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	scanner := bufio.NewScanner(strings.NewReader(string(data)))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 {
+			return ErrCorruptManifest
+		}
+		level, err1 := strconv.Atoi(fields[0])
+		num, err2 := strconv.ParseUint(fields[1], 10, 64)
+		if err1 != nil || err2 != nil || level < 0 {
+			return ErrCorruptManifest
+		}
+		path := db.tablePath(num)
+		t, err := openTable(path, num)
+		if os.IsNotExist(err) {
+			return fmt.Errorf("%w: missing table %s", ErrCorruptManifest, path)
+		}
+		if err != nil {
+			return err
+		}
+		for len(db.levels) <= level {
+			db.levels = append(db.levels, nil)
+		}
+		db.levels[level] = append(db.levels[level], t)
+		live[path] = true
+		if num >= db.nextNum {
+			db.nextNum = num + 1
+		}
+	}
+	sort.Slice(db.levels[0], func(i, j int) bool { return db.levels[0][i].num < db.levels[0][j].num })
+	for _, tables := range db.levels[1:] {
+		sort.Slice(tables, func(i, j int) bool { return tables[i].minKey < tables[j].minKey })
+	}
+
+	// tables left over by an interrupted flush or compaction
+	paths, err := filepath.Glob(filepath.Join(db.dir, "*"+tableExt))
+	if err != nil {
+		return err
+	}
+	for _, path := range paths {
+		if !live[path] {
+			os.Remove(path)
+		}
+	}
+	return nil
+}
+
+// writeManifest records the live tables, replacing the MANIFEST file by
+// renaming a complete new version over it.
+func (db *DB) writeManifest() error {
+	var b strings.Builder
+	for level, tables := range db.levels {
+		for _, t := range tables {
+			fmt.Fprintf(&b, "%d %d\n", level, t.num)
+		}
+	}
+	path := filepath.Join(db.dir, manifestName)
+	f, err := os.Create(path + ".tmp")
+	if err != nil {
+		return err
+	}
+	if _, err := f.WriteString(b.String()); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(path+".tmp", path)
+}
+
+// newTable writes sorted entries to a table with a new number.
+func (db *DB) newTable(entries []entry) (*table, error) {
+	num := db.nextNum
+	db.nextNum++
+	return writeTable(db.tablePath(num), num, entries, &db.opts)
+}
+
+// Put sets the value of key. The value is copied.
+func (db *DB) Put(key string, value []byte) error {
+	return db.write(entry{key: key, value: append([]byte{}, value...)})
+}
+
+// Delete removes key from the store. Deleting a missing key is not an error.
+func (db *DB) Delete(key string) error {
+	return db.write(entry{key: key, deleted: true})
+}
+
+func (db *DB) write(e entry) error {
+	if db.closed {
+		return ErrClosed
+	}
+	db.mem.put(e)
+	if db.mem.size >= db.opts.MemtableSize {
+		return db.Flush()
+	}
+	return nil
+}
+
+// Get returns a copy of the value of key. The second return value is false
+// when key is not in the store.
+func (db *DB) Get(key string) ([]byte, bool, error) {
+	if db.closed {
+		return nil, false, ErrClosed
+	}
+	e, ok := db.mem.get(key)
+	if !ok {
+		var err error
+		if e, ok, err = db.getTables(key); err != nil {
+			return nil, false, err
+		}
+	}
+	if !ok || e.deleted {
+		return nil, false, nil
+	}
+	return append([]byte{}, e.value...), true, nil
+}
+
+// getTables returns the newest entry of key in the tables.
+func (db *DB) getTables(key string) (entry, bool, error) {
+	level0 := db.levels[0]
+	for i := len(level0) - 1; i >= 0; i-- {
+		if e, ok, err := level0[i].get(key); ok || err != nil {
+			return e, ok, err
+		}
+	}
+	for _, tables := range db.levels[1:] {
+		i := sort.Search(len(tables), func(i int) bool { return tables[i].maxKey >= key })
+		if i < len(tables) {
+			if e, ok, err := tables[i].get(key); ok || err != nil {
+				return e, ok, err
+			}
+		}
+	}
+	return entry{}, false, nil
+}
+
+// Scan calls fn with the keys at least from and below to, with no upper
+// bound if to is empty, and their values, in increasing key order, until fn
+// returns false.
+func (db *DB) Scan(from, to string, fn func(key string, value []byte) bool) error {
+	if db.closed {
+		return ErrClosed
+	}
+	var runs [][]entry
+	var mem []entry
+	for _, e := range db.mem.sorted() {
+		if e.key >= from && (to == "" || e.key < to) {
+			mem = append(mem, e)
+		}
+	}
+	runs = append(runs, mem)
+	var sources []*table
+	for i := len(db.levels[0]) - 1; i >= 0; i-- {
+		sources = append(sources, db.levels[0][i])
+	}
+	for _, tables := range db.levels[1:] {
+		sources = append(sources, tables...)
+	}
+	for _, t := range sources {
+		if to != "" && t.minKey >= to || t.maxKey < from {
+			continue
+		}
+		run, err := t.scan(from, to)
+		if err != nil {
+			return err
+		}
+		runs = append(runs, run)
+	}
+	for _, e := range mergeEntries(runs, true) {
+		if !fn(e.key, append([]byte{}, e.value...)) {
+			break
+		}
+	}
+	return nil
+}
+
+// Flush writes the memtable to a new table of level 0, and compacts the
+// levels if needed.
+func (db *DB) Flush() error {
+	if db.closed {
+		return ErrClosed
+	}
+	if db.mem.len() == 0 {
+		return nil
+	}
+	t, err := db.newTable(db.mem.sorted())
+	if err != nil {
+		return err
+	}
+	db.levels[0] = append(db.levels[0], t)
+	if err := db.writeManifest(); err != nil {
+		db.levels[0] = db.levels[0][:len(db.levels[0])-1]
+		t.close()
+		os.Remove(t.path)
+		return err
+	}
+	db.mem = newMemtable(db.opts.Seed)
+	return db.maybeCompact()
+}
+
+// Levels returns the number of tables of every level.
+func (db *DB) Levels() []int {
+	counts := make([]int, len(db.levels))
+	for i, tables := range db.levels {
+		counts[i] = len(tables)
+	}
+	return counts
+}
+
+// Close flushes the memtable and closes the store.
+func (db *DB) Close() error {
+	if db.closed {
+		return ErrClosed
+	}
+	err := db.Flush()
+	db.closeTables()
+	db.closed = true
+	return err
+}
+
+func (db *DB) closeTables() {
+	for _, tables := range db.levels {
+		for _, t := range tables {
+			t.close()
+		}
+	}
+}
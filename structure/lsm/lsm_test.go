@@ -0,0 +1,203 @@
+package lsm
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// smallOptions make the store flush and compact after a few writes.
+var smallOptions = &Options{MemtableSize: 512, BlockSize: 128, TableSize: 512, L0Tables: 3, LevelSizeRatio: 3}
+
+func TestDB(t *testing.T) {
+	dir := t.TempDir()
+	db, err := Open(dir, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	value := []byte("one")
+	if err := db.Put("a", value); err != nil {
+		t.Fatal(err)
+	}
+	value[0] = 'X' // the store keeps its own copy
+	db.Put("b", []byte("two"))
+	db.Delete("b")
+	if got, ok, err := db.Get("a"); err != nil || !ok || string(got) != "one" {
+		t.Errorf("Get(a) = %q, %t, %v, want one", got, ok, err)
+	}
+	if _, ok, _ := db.Get("b"); ok {
+		t.Errorf("Get(b) found a deleted key")
+	}
+	if err := db.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := db.Get("a"); !errors.Is(err, ErrClosed) {
+		t.Errorf("Get after Close error = %v", err)
+	}
+
+	db, err = Open(dir, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	if got, ok, err := db.Get("a"); err != nil || !ok || string(got) != "one" {
+		t.Errorf("reopened Get(a) = %q, %t, %v, want one", got, ok, err)
+	}
+	if _, ok, _ := db.Get("b"); ok {
+		t.Errorf("reopened Get(b) found a deleted key")
+	}
+}
+
+// scanAll returns the keys and values of db between from and to.
+func scanAll(t *testing.T, db *DB, from, to string) map[string]string {
+	t.Helper()
+	got := map[string]string{}
+	last := ""
+	err := db.Scan(from, to, func(key string, value []byte) bool {
+		if len(got) > 0 && key <= last {
+			t.Fatalf("Scan gave %q after %q", key, last)
+		}
+		last = key
+		got[key] = string(value)
+		return true
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return got
+}
+
+func TestDBRandom(t *testing.T) {
+	dir := t.TempDir()
+	db, err := Open(dir, smallOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rnd := rand.New(rand.NewSource(1))
+	model := map[string]string{}
+	for i := 0; i < 5000; i++ {
+		key := fmt.Sprintf("k%03d", rnd.Intn(400))
+		switch r := rnd.Intn(20); {
+		case r < 12:
+			value := fmt.Sprint(i)
+			if err := db.Put(key, []byte(value)); err != nil {
+				t.Fatal(err)
+			}
+			model[key] = value
+		case r < 16:
+			if err := db.Delete(key); err != nil {
+				t.Fatal(err)
+			}
+			delete(model, key)
+		case r < 19:
+			got, ok, err := db.Get(key)
+			want, wantOK := model[key]
+			if err != nil || ok != wantOK || string(got) != want {
+				t.Fatalf("Get(%q) = %q, %t, %v, want %q, %t", key, got, ok, err, want, wantOK)
+			}
+		default:
+			if err := db.Close(); err != nil {
+				t.Fatal(err)
+			}
+			if db, err = Open(dir, smallOptions); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if levels := db.Levels(); len(levels) < 3 {
+		t.Errorf("tables per level %v, want compactions down to level 2", levels)
+	}
+	if got := scanAll(t, db, "", ""); !reflect.DeepEqual(got, model) {
+		t.Errorf("Scan of everything differs from the model")
+	}
+	want := map[string]string{}
+	for k, v := range model {
+		if k >= "k100" && k < "k200" {
+			want[k] = v
+		}
+	}
+	if got := scanAll(t, db, "k100", "k200"); !reflect.DeepEqual(got, want) {
+		t.Errorf("Scan(k100, k200) = %v, want %v", got, want)
+	}
+	if err := db.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestDBLevels(t *testing.T) {
+	db, err := Open(t.TempDir(), smallOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	for i := 0; i < 3000; i++ {
+		db.Put(fmt.Sprintf("key%05d", i), []byte("some value"))
+	}
+	if err := db.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if n := len(db.levels[0]); n >= smallOptions.L0Tables {
+		t.Errorf("%d tables left in level 0", n)
+	}
+	for level := 1; level < len(db.levels); level++ {
+		tables := db.levels[level]
+		if !sort.SliceIsSorted(tables, func(i, j int) bool { return tables[i].minKey < tables[j].minKey }) {
+			t.Fatalf("level %d is not sorted", level)
+		}
+		for i := 1; i < len(tables); i++ {
+			if tables[i-1].maxKey >= tables[i].minKey {
+				t.Fatalf("tables %d and %d of level %d overlap", i-1, i, level)
+			}
+		}
+		if level < len(db.levels)-1 && levelSize(tables) > db.levelLimit(level) {
+			t.Errorf("level %d holds %d bytes, above its limit %d", level, levelSize(tables), db.levelLimit(level))
+		}
+	}
+	stopped := 0
+	db.Scan("key00100", "", func(key string, value []byte) bool {
+		stopped++
+		return stopped < 5
+	})
+	if stopped != 5 {
+		t.Errorf("Scan went on for %d keys after fn returned false", stopped)
+	}
+}
+
+func TestDBCleanup(t *testing.T) {
+	dir := t.TempDir()
+	db, err := Open(dir, smallOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 200; i++ {
+		db.Put(fmt.Sprintf("key%03d", i), []byte("value"))
+	}
+	db.Close()
+	// a table written by an interrupted compaction is not in the manifest
+	stray := filepath.Join(dir, "99999999"+tableExt)
+	if err := os.WriteFile(stray, []byte("partial"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	db, err = Open(dir, smallOptions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	if _, err := os.Stat(stray); !os.IsNotExist(err) {
+		t.Errorf("stray table was not removed: %v", err)
+	}
+	if got := len(scanAll(t, db, "", "")); got != 200 {
+		t.Errorf("%d keys after reopening, want 200", got)
+	}
+	if err := os.Remove(db.levels[len(db.levels)-1][0].path); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Open(dir, smallOptions); !errors.Is(err, ErrCorruptManifest) {
+		t.Errorf("Open with a missing table error = %v", err)
+	}
+}
@@ -0,0 +1,58 @@
+// memtable.go
+// description: In-memory write buffer of the LSM store
+// details:
+// The memtable takes every write until it is big enough to be flushed to a
+// sorted table. Its entries are kept in order of key by the skip list map of
+// structure/skiplist, which finds them by key and gives the sorted run to
+// flush. A deletion is stored as a tombstone entry, as it must hide the older
+// values of the key in the tables.
+// put, get: O(log n) expected
+// see lsm_test.go
+
+package lsm
+
+import "github.com/TheAlgorithms/Go/structure/skiplist"
+
+// entry is a key with its value or, when deleted is set, a tombstone.
+type entry struct {
+	key     string
+	value   []byte
+	deleted bool
+}
+
+// memtable is the sorted buffer of the latest writes.
+type memtable struct {
+	entries *skiplist.Map[string, entry]
+	size    int // approximate size in bytes of the entries
+}
+
+func newMemtable(seed int64) *memtable {
+	return &memtable{entries: skiplist.NewMap[string, entry](seed)}
+}
+
+// put records e, replacing any entry of the same key.
+func (m *memtable) put(e entry) {
+	if old, ok := m.entries.Get(e.key); ok {
+		m.size -= len(old.key) + len(old.value)
+	}
+	m.entries.Put(e.key, e)
+	m.size += len(e.key) + len(e.value)
+}
+
+func (m *memtable) get(key string) (entry, bool) {
+	return m.entries.Get(key)
+}
+
+func (m *memtable) len() int {
+	return m.entries.Len()
+}
+
+// sorted returns the entries in increasing key order.
+func (m *memtable) sorted() []entry {
+	sorted := make([]entry, 0, m.entries.Len())
+	m.entries.All()(func(_ string, e entry) bool {
+		sorted = append(sorted, e)
+		return true
+	})
+	return sorted
+}
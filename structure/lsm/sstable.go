@@ -0,0 +1,305 @@
+// sstable.go
+// description: Immutable sorted table files of the LSM store
+// details:
+// A sorted table (SSTable) holds entries in increasing key order, split into
+// data blocks of about BlockSize bytes. After the blocks come a block index,
+// holding the last key and the position of every block, a Bloom filter of the
+// keys from structure/sketch, and a fixed size footer giving the positions of
+// the index and of the filter. Every block, the index and the filter are
+// followed by their CRC-32. Opening a table loads its index and filter, so a
+// lookup reads a single block, and none when the filter rules the key out.
+// An entry is encoded as its key length, key, a tombstone flag, value length
+// and value, lengths being unsigned varints.
+// get: O(log b + B) for b blocks of B bytes, one block read
+// reference: https://www.igvita.com/2012/02/06/sstable-and-log-structured-storage-leveldb/
+// see sstable_test.go
+
+package lsm
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/TheAlgorithms/Go/hashing/crc32"
+	"github.com/TheAlgorithms/Go/structure/sketch"
+)
+
+// ErrCorruptTable is returned when a table file does not decode or fails its
+// checksums.
+var ErrCorruptTable = errors.New("corrupt sorted table")
+
+// tableMagic ends every table file.
+const tableMagic = "LSM1"
+
+// footerSize is the size of the four offsets and lengths and of the magic.
+const footerSize = 4*8 + len(tableMagic)
+
+// blockHandle locates a data block and gives its last key.
+type blockHandle struct {
+	lastKey        string
+	offset, length uint64
+}
+
+// table is an open sorted table.
+type table struct {
+	num            uint64 // file number, higher for newer tables
+	path           string
+	f              *os.File
+	size           int64
+	minKey, maxKey string
+	blocks         []blockHandle
+	filter         *sketch.BloomFilter
+}
+
+// appendEntry encodes e at the end of buf.
+func appendEntry(buf []byte, e entry) []byte {
+	buf = binary.AppendUvarint(buf, uint64(len(e.key)))
+	buf = append(buf, e.key...)
+	if e.deleted {
+		buf = append(buf, 1)
+	} else {
+		buf = append(buf, 0)
+	}
+	buf = binary.AppendUvarint(buf, uint64(len(e.value)))
+	return append(buf, e.value...)
+}
+
+// appendChecked appends data and its CRC-32 at the end of buf.
+func appendChecked(buf, data []byte) []byte {
+	buf = append(buf, data...)
+	return binary.BigEndian.AppendUint32(buf, crc32.ChecksumIEEE(data))
+}
+
+// writeTable writes the sorted, non-empty entries to a new table file at path
+// and opens it.
+func writeTable(path string, num uint64, entries []entry, opts *Options) (*table, error) {
+	filter, err := sketch.NewBloomFilter(len(entries), opts.FalsePositiveRate)
+	if err != nil {
+		return nil, err
+	}
+	var file, block, index []byte
+	flush := func(lastKey string) {
+		index = binary.AppendUvarint(index, uint64(len(lastKey)))
+		index = append(index, lastKey...)
+		index = binary.AppendUvarint(index, uint64(len(file)))
+		index = binary.AppendUvarint(index, uint64(len(block)))
+		file = appendChecked(file, block)
+		block = block[:0]
+	}
+	// the index starts with the first key of the table
+	index = binary.AppendUvarint(index, uint64(len(entries[0].key)))
+	index = append(index, entries[0].key...)
+	for i, e := range entries {
+		filter.Add([]byte(e.key))
+		block = appendEntry(block, e)
+		if len(block) >= opts.BlockSize || i == len(entries)-1 {
+			flush(e.key)
+		}
+	}
+	indexOffset := len(file)
+	file = appendChecked(file, index)
+	filterData, _ := filter.MarshalBinary()
+	filterOffset := len(file)
+	file = appendChecked(file, filterData)
+	for _, v := range []int{indexOffset, len(index), filterOffset, len(filterData)} {
+		file = binary.BigEndian.AppendUint64(file, uint64(v))
+	}
+	file = append(file, tableMagic...)
+
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := f.Write(file); err != nil {
+		f.Close()
+		return nil, err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return nil, err
+	}
+	if err := f.Close(); err != nil {
+		return nil, err
+	}
+	return openTable(path, num)
+}
+
+// openTable opens the table file at path and loads its index and filter.
+func openTable(path string, num uint64) (*table, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	t, err := loadTable(f, num)
+	if err != nil {
+		f.Close()
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return t, nil
+}
+
+func loadTable(f *os.File, num uint64) (*table, error) {
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	t := &table{num: num, path: f.Name(), f: f, size: info.Size()}
+	if t.size < int64(footerSize) {
+		return nil, ErrCorruptTable
+	}
+	footer := make([]byte, footerSize)
+	if _, err := f.ReadAt(footer, t.size-int64(footerSize)); err != nil {
+		return nil, err
+	}
+	if string(footer[32:]) != tableMagic {
+		return nil, ErrCorruptTable
+	}
+	var pos [4]uint64
+	for i := range pos {
+		pos[i] = binary.BigEndian.Uint64(footer[8*i:])
+	}
+	index, err := t.readChecked(pos[0], pos[1])
+	if err != nil {
+		return nil, err
+	}
+	filterData, err := t.readChecked(pos[2], pos[3])
+	if err != nil {
+		return nil, err
+	}
+	t.filter = &sketch.BloomFilter{}
+	if err := t.filter.UnmarshalBinary(filterData); err != nil {
+		return nil, ErrCorruptTable
+	}
+
+	var ok bool
+	if t.minKey, index, ok = readString(index); !ok {
+		return nil, ErrCorruptTable
+	}
+	for len(index) > 0 {
+		var h blockHandle
+		var n int
+		if h.lastKey, index, ok = readString(index); !ok {
+			return nil, ErrCorruptTable
+		}
+		if h.offset, n = binary.Uvarint(index); n <= 0 {
+			return nil, ErrCorruptTable
+		}
+		index = index[n:]
+		if h.length, n = binary.Uvarint(index); n <= 0 {
+			return nil, ErrCorruptTable
+		}
+		index = index[n:]
+		t.blocks = append(t.blocks, h)
+	}
+	if len(t.blocks) == 0 {
+		return nil, ErrCorruptTable
+	}
+	t.maxKey = t.blocks[len(t.blocks)-1].lastKey
+	return t, nil
+}
+
+// readString decodes a length-prefixed string at the start of data and
+// returns it with the rest of data.
+func readString(data []byte) (string, []byte, bool) {
+	length, n := binary.Uvarint(data)
+	if n <= 0 || length > uint64(len(data)-n) {
+		return "", nil, false
+	}
+	end := n + int(length)
+	return string(data[n:end]), data[end:], true
+}
+
+// readChecked reads length bytes at offset and checks the CRC-32 following
+// them.
+func (t *table) readChecked(offset, length uint64) ([]byte, error) {
+	if size := uint64(t.size); offset > size || size-offset < 4 || length > size-offset-4 {
+		return nil, ErrCorruptTable
+	}
+	buf := make([]byte, length+4)
+	if _, err := t.f.ReadAt(buf, int64(offset)); err != nil {
+		return nil, err
+	}
+	data := buf[:length]
+	if crc32.ChecksumIEEE(data) != binary.BigEndian.Uint32(buf[length:]) {
+		return nil, ErrCorruptTable
+	}
+	return data, nil
+}
+
+// readBlock returns the entries of the i-th block.
+func (t *table) readBlock(i int) ([]entry, error) {
+	data, err := t.readChecked(t.blocks[i].offset, t.blocks[i].length)
+	if err != nil {
+		return nil, err
+	}
+	var entries []entry
+	for len(data) > 0 {
+		var e entry
+		var ok bool
+		if e.key, data, ok = readString(data); !ok || len(data) == 0 {
+			return nil, ErrCorruptTable
+		}
+		e.deleted = data[0] == 1
+		var value string
+		if value, data, ok = readString(data[1:]); !ok {
+			return nil, ErrCorruptTable
+		}
+		if value != "" {
+			e.value = []byte(value)
+		}
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
+
+// get returns the entry of key, which may be a tombstone. The second return
+// value is false when the table has no entry for key.
+func (t *table) get(key string) (entry, bool, error) {
+	if key < t.minKey || key > t.maxKey || !t.filter.Test([]byte(key)) {
+		return entry{}, false, nil
+	}
+	i := sort.Search(len(t.blocks), func(i int) bool { return t.blocks[i].lastKey >= key })
+	entries, err := t.readBlock(i)
+	if err != nil {
+		return entry{}, false, err
+	}
+	j := sort.Search(len(entries), func(j int) bool { return entries[j].key >= key })
+	if j < len(entries) && entries[j].key == key {
+		return entries[j], true, nil
+	}
+	return entry{}, false, nil
+}
+
+// scan returns the entries whose key is at least from and below to, with no
+// upper bound if to is empty.
+func (t *table) scan(from, to string) ([]entry, error) {
+	var entries []entry
+	for i := sort.Search(len(t.blocks), func(i int) bool { return t.blocks[i].lastKey >= from }); i < len(t.blocks); i++ {
+		block, err := t.readBlock(i)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range block {
+			if to != "" && e.key >= to {
+				return entries, nil
+			}
+			if e.key >= from {
+				entries = append(entries, e)
+			}
+		}
+	}
+	return entries, nil
+}
+
+// overlaps reports whether the table may hold keys between min and max
+// included.
+func (t *table) overlaps(min, max string) bool {
+	return t.minKey <= max && min <= t.maxKey
+}
+
+func (t *table) close() error {
+	return t.f.Close()
+}
@@ -0,0 +1,109 @@
+package lsm
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func testEntries(n int) []entry {
+	entries := make([]entry, n)
+	for i := range entries {
+		entries[i] = entry{key: fmt.Sprintf("key%05d", 2*i), value: []byte(fmt.Sprint(i))}
+		if i%7 == 3 {
+			entries[i] = entry{key: entries[i].key, deleted: true}
+		}
+	}
+	return entries
+}
+
+func TestTable(t *testing.T) {
+	opts := Options{BlockSize: 256}.withDefaults()
+	entries := testEntries(1000)
+	path := filepath.Join(t.TempDir(), "1.sst")
+	tbl, err := writeTable(path, 1, entries, &opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tbl.close()
+	if len(tbl.blocks) < 10 {
+		t.Errorf("%d blocks of 256 bytes for 1000 entries", len(tbl.blocks))
+	}
+	if tbl.minKey != "key00000" || tbl.maxKey != "key01998" {
+		t.Errorf("key range %q to %q", tbl.minKey, tbl.maxKey)
+	}
+	for i, want := range entries {
+		got, ok, err := tbl.get(want.key)
+		if err != nil || !ok || got.key != want.key || got.deleted != want.deleted || string(got.value) != string(want.value) {
+			t.Fatalf("get(%q) = %+v, %t, %v, want %+v", want.key, got, ok, err, want)
+		}
+		missing := fmt.Sprintf("key%05d", 2*i+1)
+		if _, ok, err := tbl.get(missing); ok || err != nil {
+			t.Fatalf("get(%q) = %t, %v for a missing key", missing, ok, err)
+		}
+	}
+
+	got, err := tbl.scan("key00100", "key00120")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, entries[50:60]) {
+		t.Errorf("scan = %+v, want %+v", got, entries[50:60])
+	}
+	if all, err := tbl.scan("", ""); err != nil || len(all) != len(entries) {
+		t.Errorf("scan of the whole table = %d entries, %v", len(all), err)
+	}
+}
+
+func TestTableCorruption(t *testing.T) {
+	opts := Options{BlockSize: 64}.withDefaults()
+	path := filepath.Join(t.TempDir(), "1.sst")
+	tbl, err := writeTable(path, 1, testEntries(50), &opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tbl.close()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// a flipped bit in a data block is caught on read
+	data[3] ^= 1
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	tbl, err = openTable(path, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := tbl.get("key00000"); !errors.Is(err, ErrCorruptTable) {
+		t.Errorf("get from a corrupt block error = %v", err)
+	}
+	tbl.close()
+
+	// a truncated file is caught on open
+	if err := os.WriteFile(path, data[:len(data)-1], 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := openTable(path, 1); !errors.Is(err, ErrCorruptTable) {
+		t.Errorf("openTable of a truncated file error = %v", err)
+	}
+}
+
+func TestMergeEntries(t *testing.T) {
+	newer := []entry{{key: "a", value: []byte("new")}, {key: "c", deleted: true}}
+	older := []entry{{key: "a", value: []byte("old")}, {key: "b", value: []byte("b")}, {key: "c", value: []byte("c")}}
+	got := mergeEntries([][]entry{newer, older}, false)
+	want := []entry{{key: "a", value: []byte("new")}, {key: "b", value: []byte("b")}, {key: "c", deleted: true}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("mergeEntries = %+v, want %+v", got, want)
+	}
+	got = mergeEntries([][]entry{newer, older}, true)
+	if !reflect.DeepEqual(got, want[:2]) {
+		t.Errorf("mergeEntries dropping tombstones = %+v, want %+v", got, want[:2])
+	}
+}
@@ -0,0 +1,75 @@
+// indexed.go
+// description: Indexed multiset with order statistics
+// details:
+// An indexed multiset keeps a sorted collection of values, duplicates included,
+// and answers order-statistic questions on it: which value is the k-th smallest
+// and how many values are smaller than a given one. It is backed by the
+// randomized treap from structure/treap, so every operation takes O(log n)
+// expected time. Keeping the median of a changing collection is a common use.
+// see indexed_test.go, structure/treap/treap.go
+
+// Package multiset implements multiset and multimap containers.
+package multiset
+
+import (
+	"github.com/TheAlgorithms/Go/constraints"
+	"github.com/TheAlgorithms/Go/structure/treap"
+)
+
+// Indexed is a sorted multiset supporting order-statistic queries.
+type Indexed[T constraints.Ordered] struct {
+	tree *treap.Treap[T]
+}
+
+// NewIndexed creates an indexed multiset holding the given values. The seed
+// draws the priorities of the treap, as in treap.New: equal seeds and equal
+// operations give equal trees.
+func NewIndexed[T constraints.Ordered](seed int64, values ...T) *Indexed[T] {
+	m := &Indexed[T]{tree: treap.New[T](seed)}
+	for _, v := range values {
+		m.Insert(v)
+	}
+	return m
+}
+
+// Len returns the number of values in the multiset, counting duplicates.
+func (m *Indexed[T]) Len() int {
+	return m.tree.Len()
+}
+
+// Insert adds one copy of value.
+func (m *Indexed[T]) Insert(value T) {
+	m.tree.Insert(value)
+}
+
+// Erase removes one copy of value and reports whether there was one.
+func (m *Indexed[T]) Erase(value T) bool {
+	return m.tree.Delete(value)
+}
+
+// Has reports whether at least one copy of value is stored.
+func (m *Indexed[T]) Has(value T) bool {
+	return m.tree.Has(value)
+}
+
+// KthSmallest returns the k-th smallest value, counting from 1 and including
+// duplicates. The second return value is false when k is outside [1, Len()].
+func (m *Indexed[T]) KthSmallest(k int) (T, bool) {
+	return m.tree.Kth(k)
+}
+
+// CountLessThan returns the number of stored values strictly smaller than x.
+func (m *Indexed[T]) CountLessThan(x T) int {
+	return m.tree.Rank(x)
+}
+
+// Median returns the lower median, that is the value at position (Len()+1)/2
+// in sorted order. The second return value is false for an empty multiset.
+func (m *Indexed[T]) Median() (T, bool) {
+	return m.tree.Kth((m.Len() + 1) / 2)
+}
+
+// Values returns every stored value in ascending order.
+func (m *Indexed[T]) Values() []T {
+	return m.tree.InOrder()
+}
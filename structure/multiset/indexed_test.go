@@ -0,0 +1,61 @@
+package multiset_test
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/structure/multiset"
+)
+
+func TestIndexed(t *testing.T) {
+	m := multiset.NewIndexed[int](1, 4, 1, 4, 7)
+	if got, want := m.Values(), []int{1, 4, 4, 7}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Values() = %v, want %v", got, want)
+	}
+	if got := m.CountLessThan(4); got != 1 {
+		t.Errorf("CountLessThan(4) = %d, want 1", got)
+	}
+	if got := m.CountLessThan(5); got != 3 {
+		t.Errorf("CountLessThan(5) = %d, want 3", got)
+	}
+	if got, _ := m.KthSmallest(3); got != 4 {
+		t.Errorf("KthSmallest(3) = %d, want 4", got)
+	}
+	if !m.Erase(4) || !m.Has(4) {
+		t.Errorf("Erase(4) should remove a single copy")
+	}
+	if m.Erase(5) {
+		t.Errorf("Erase(5) = true, want false")
+	}
+	if _, ok := m.KthSmallest(4); ok {
+		t.Errorf("KthSmallest(4) should be out of range after erase")
+	}
+}
+
+func TestIndexedMedian(t *testing.T) {
+	m := multiset.NewIndexed[int](1)
+	if _, ok := m.Median(); ok {
+		t.Errorf("Median() of an empty multiset should report false")
+	}
+
+	rnd := rand.New(rand.NewSource(11))
+	var values []int
+	for i := 0; i < 200; i++ {
+		v := rnd.Intn(100)
+		values = append(values, v)
+		m.Insert(v)
+		if i%3 == 2 {
+			m.Erase(values[0])
+			values = values[1:]
+		}
+
+		sorted := append([]int(nil), values...)
+		sort.Ints(sorted)
+		want := sorted[(len(sorted)-1)/2]
+		if got, _ := m.Median(); got != want {
+			t.Fatalf("Median() = %d, want %d", got, want)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+// multimap.go
+// description: Ordered multimap holding several values per key
+// details:
+// A multimap associates each key with a list of values instead of a single
+// one. MultiMap keeps its keys sorted in an AVL tree, from structure/tree,
+// and their values in a hash map of slices, in the order they were put:
+// iteration visits the keys in increasing order, and the values of each key
+// in insertion order. Finding the values of a key takes O(1) expected time,
+// and adding or removing a key O(log k) for k distinct keys.
+// time complexity: O(log k) to add or remove a key, O(1) expected otherwise
+// space complexity: O(k + n) for k distinct keys and n values
+// reference: https://en.wikipedia.org/wiki/Multimap
+// see multimap_test.go
+
+package multiset
+
+import (
+	"github.com/TheAlgorithms/Go/constraints"
+	"github.com/TheAlgorithms/Go/structure/iterutil"
+	"github.com/TheAlgorithms/Go/structure/tree"
+)
+
+// MultiMap maps ordered keys to lists of values.
+type MultiMap[K constraints.Ordered, V any] struct {
+	keys   *tree.AVL[K]
+	values map[K][]V
+	size   int
+}
+
+// NewMultiMap creates an empty multimap.
+func NewMultiMap[K constraints.Ordered, V any]() *MultiMap[K, V] {
+	return &MultiMap[K, V]{keys: tree.NewAVL[K](), values: make(map[K][]V)}
+}
+
+// Len returns the number of values in the multimap, over all keys.
+func (m *MultiMap[K, V]) Len() int {
+	return m.size
+}
+
+// KeyCount returns the number of distinct keys in the multimap.
+func (m *MultiMap[K, V]) KeyCount() int {
+	return len(m.values)
+}
+
+// Has reports whether key has at least one value.
+func (m *MultiMap[K, V]) Has(key K) bool {
+	_, ok := m.values[key]
+	return ok
+}
+
+// Get returns the values of key in insertion order, nil if it has none. The
+// slice must not be changed.
+func (m *MultiMap[K, V]) Get(key K) []V {
+	return m.values[key]
+}
+
+// Put appends values to those of key.
+func (m *MultiMap[K, V]) Put(key K, values ...V) {
+	if len(values) == 0 {
+		return
+	}
+	if _, ok := m.values[key]; !ok {
+		m.keys.Push(key)
+	}
+	m.values[key] = append(m.values[key], values...)
+	m.size += len(values)
+}
+
+// RemoveKey removes key with all its values and returns how many there were.
+func (m *MultiMap[K, V]) RemoveKey(key K) int {
+	n := len(m.values[key])
+	if n > 0 {
+		delete(m.values, key)
+		m.keys.Delete(key)
+		m.size -= n
+	}
+	return n
+}
+
+// RemoveFunc removes the values of key for which match returns true, keeping
+// the order of the others, and returns how many were removed.
+func (m *MultiMap[K, V]) RemoveFunc(key K, match func(V) bool) int {
+	values, ok := m.values[key]
+	if !ok {
+		return 0
+	}
+	kept := make([]V, 0, len(values))
+	for _, v := range values {
+		if !match(v) {
+			kept = append(kept, v)
+		}
+	}
+	removed := len(values) - len(kept)
+	if len(kept) == 0 {
+		return m.RemoveKey(key)
+	}
+	m.values[key] = kept
+	m.size -= removed
+	return removed
+}
+
+// MinKey returns the smallest key, with false if the multimap is empty.
+func (m *MultiMap[K, V]) MinKey() (K, bool) {
+	return m.keys.Min()
+}
+
+// MaxKey returns the largest key, with false if the multimap is empty.
+func (m *MultiMap[K, V]) MaxKey() (K, bool) {
+	return m.keys.Max()
+}
+
+// Keys returns the sequence of the distinct keys in increasing order.
+func (m *MultiMap[K, V]) Keys() iterutil.Seq[K] {
+	return m.keys.InOrderSeq()
+}
+
+// All returns the sequence of the pairs of a key and one of its values, by
+// increasing key and then in insertion order. The multimap must not be
+// changed during the iteration.
+func (m *MultiMap[K, V]) All() iterutil.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		m.keys.InOrderSeq()(func(k K) bool {
+			for _, v := range m.values[k] {
+				if !yield(k, v) {
+					return false
+				}
+			}
+			return true
+		})
+	}
+}
+
+// Counts returns the multiset of the keys, each with the number of its
+// values as multiplicity.
+func (m *MultiMap[K, V]) Counts() *Multiset[K] {
+	counts := New[K]()
+	for k, values := range m.values {
+		counts.AddN(k, len(values))
+	}
+	return counts
+}
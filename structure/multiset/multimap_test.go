@@ -0,0 +1,60 @@
+package multiset_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/structure/iterutil"
+	"github.com/TheAlgorithms/Go/structure/multiset"
+)
+
+func TestMultiMap(t *testing.T) {
+	m := multiset.NewMultiMap[string, int]()
+	if _, ok := m.MinKey(); ok {
+		t.Errorf("MinKey of an empty multimap reported a key")
+	}
+	m.Put("pear", 3)
+	m.Put("apple", 1, 2)
+	m.Put("fig")
+	m.Put("pear", 1, 3)
+	if m.Len() != 5 || m.KeyCount() != 2 || m.Has("fig") {
+		t.Errorf("Len, KeyCount = %d, %d, Has(fig) = %t", m.Len(), m.KeyCount(), m.Has("fig"))
+	}
+	if got := m.Get("pear"); !reflect.DeepEqual(got, []int{3, 1, 3}) {
+		t.Errorf("Get(pear) = %v", got)
+	}
+	var pairs []string
+	m.All()(func(k string, v int) bool {
+		pairs = append(pairs, k+strings.Repeat("+", v))
+		return true
+	})
+	if want := []string{"apple+", "apple++", "pear+++", "pear+", "pear+++"}; !reflect.DeepEqual(pairs, want) {
+		t.Errorf("All = %v, want %v", pairs, want)
+	}
+	if keys := iterutil.Collect(m.Keys()); !reflect.DeepEqual(keys, []string{"apple", "pear"}) {
+		t.Errorf("Keys = %v", keys)
+	}
+	if min, _ := m.MinKey(); min != "apple" {
+		t.Errorf("MinKey = %q", min)
+	}
+	if max, _ := m.MaxKey(); max != "pear" {
+		t.Errorf("MaxKey = %q", max)
+	}
+	if counts := m.Counts(); counts.Count("pear") != 3 || counts.Count("apple") != 2 {
+		t.Errorf("Counts = %d pears, %d apples", counts.Count("pear"), counts.Count("apple"))
+	}
+
+	if n := m.RemoveFunc("pear", func(v int) bool { return v == 3 }); n != 2 || !reflect.DeepEqual(m.Get("pear"), []int{1}) {
+		t.Errorf("RemoveFunc = %d, leaving %v", n, m.Get("pear"))
+	}
+	if n := m.RemoveFunc("pear", func(int) bool { return true }); n != 1 || m.Has("pear") {
+		t.Errorf("removing every value of pear = %d, Has = %t", n, m.Has("pear"))
+	}
+	if n := m.RemoveKey("apple"); n != 2 || m.Len() != 0 || m.KeyCount() != 0 {
+		t.Errorf("RemoveKey(apple) = %d, leaving %d values", n, m.Len())
+	}
+	if keys := iterutil.Collect(m.Keys()); len(keys) != 0 {
+		t.Errorf("keys are left in the tree")
+	}
+}
@@ -0,0 +1,194 @@
+// multiset.go
+// description: Multiset counting the copies of each value
+// details:
+// A multiset, or bag, is a set in which a value may appear several times:
+// its multiplicity. Multiset stores the multiplicity of every value in a
+// hash map, so adding or removing copies takes O(1) expected time whatever
+// their number. The set operations extend to multiplicities: the union
+// keeps the larger multiplicity of each value, the intersection the smaller
+// one, the sum adds them and the difference subtracts them, down to 0.
+// Values are visited in no particular order; Indexed keeps them sorted.
+// time complexity: O(1) expected per update, O(n + m) for set operations on
+// n and m distinct values
+// space complexity: O(n) for n distinct values
+// reference: https://en.wikipedia.org/wiki/Multiset
+// see multiset_test.go
+
+package multiset
+
+import "github.com/TheAlgorithms/Go/structure/iterutil"
+
+// Multiset is an unordered multiset of comparable values.
+type Multiset[T comparable] struct {
+	counts map[T]int
+	size   int
+}
+
+// New creates a multiset holding the given values.
+func New[T comparable](values ...T) *Multiset[T] {
+	m := &Multiset[T]{counts: make(map[T]int)}
+	for _, v := range values {
+		m.Add(v)
+	}
+	return m
+}
+
+// Len returns the number of values in the multiset, counting duplicates.
+func (m *Multiset[T]) Len() int {
+	return m.size
+}
+
+// Distinct returns the number of distinct values in the multiset.
+func (m *Multiset[T]) Distinct() int {
+	return len(m.counts)
+}
+
+// Count returns the multiplicity of value.
+func (m *Multiset[T]) Count(value T) int {
+	return m.counts[value]
+}
+
+// Has reports whether at least one copy of value is stored.
+func (m *Multiset[T]) Has(value T) bool {
+	return m.counts[value] > 0
+}
+
+// Add adds one copy of value.
+func (m *Multiset[T]) Add(value T) {
+	m.AddN(value, 1)
+}
+
+// AddN adds n copies of value, none if n is not positive.
+func (m *Multiset[T]) AddN(value T, n int) {
+	if n <= 0 {
+		return
+	}
+	m.counts[value] += n
+	m.size += n
+}
+
+// Remove removes one copy of value and reports whether there was one.
+func (m *Multiset[T]) Remove(value T) bool {
+	return m.RemoveN(value, 1) == 1
+}
+
+// RemoveN removes up to n copies of value and returns the number removed.
+func (m *Multiset[T]) RemoveN(value T, n int) int {
+	c := m.counts[value]
+	if n <= 0 || c == 0 {
+		return 0
+	}
+	if n >= c {
+		n = c
+		delete(m.counts, value)
+	} else {
+		m.counts[value] = c - n
+	}
+	m.size -= n
+	return n
+}
+
+// All returns the sequence of the distinct values of the multiset with
+// their multiplicities. The multiset must not be changed during the
+// iteration.
+func (m *Multiset[T]) All() iterutil.Seq2[T, int] {
+	return func(yield func(T, int) bool) {
+		for v, c := range m.counts {
+			if !yield(v, c) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns the sequence of the values of the multiset, each repeated
+// as many times as its multiplicity.
+func (m *Multiset[T]) Values() iterutil.Seq[T] {
+	return func(yield func(T) bool) {
+		for v, c := range m.counts {
+			for i := 0; i < c; i++ {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Clone returns an independent copy of the multiset.
+func (m *Multiset[T]) Clone() *Multiset[T] {
+	c := &Multiset[T]{counts: make(map[T]int, len(m.counts)), size: m.size}
+	for v, n := range m.counts {
+		c.counts[v] = n
+	}
+	return c
+}
+
+// Union returns the multiset holding every value with the larger of its
+// multiplicities in m and other.
+func (m *Multiset[T]) Union(other *Multiset[T]) *Multiset[T] {
+	u := m.Clone()
+	for v, n := range other.counts {
+		if c := u.counts[v]; n > c {
+			u.AddN(v, n-c)
+		}
+	}
+	return u
+}
+
+// Intersection returns the multiset holding every value with the smaller of
+// its multiplicities in m and other.
+func (m *Multiset[T]) Intersection(other *Multiset[T]) *Multiset[T] {
+	small, large := m, other
+	if small.Distinct() > large.Distinct() {
+		small, large = large, small
+	}
+	i := New[T]()
+	for v, n := range small.counts {
+		if c := large.counts[v]; c < n {
+			n = c
+		}
+		i.AddN(v, n)
+	}
+	return i
+}
+
+// Sum returns the multiset holding every value with the sum of its
+// multiplicities in m and other.
+func (m *Multiset[T]) Sum(other *Multiset[T]) *Multiset[T] {
+	s := m.Clone()
+	for v, n := range other.counts {
+		s.AddN(v, n)
+	}
+	return s
+}
+
+// Difference returns the multiset holding every value with its multiplicity
+// in m minus its multiplicity in other, the values with none left removed.
+func (m *Multiset[T]) Difference(other *Multiset[T]) *Multiset[T] {
+	d := m.Clone()
+	for v, n := range other.counts {
+		d.RemoveN(v, n)
+	}
+	return d
+}
+
+// SubsetOf reports whether every value of m has at most its multiplicity in
+// other.
+func (m *Multiset[T]) SubsetOf(other *Multiset[T]) bool {
+	if m.size > other.size {
+		return false
+	}
+	for v, n := range m.counts {
+		if other.counts[v] < n {
+			return false
+		}
+	}
+	return true
+}
+
+// Equal reports whether m and other hold the same values with the same
+// multiplicities.
+func (m *Multiset[T]) Equal(other *Multiset[T]) bool {
+	return m.size == other.size && m.Distinct() == other.Distinct() && m.SubsetOf(other)
+}
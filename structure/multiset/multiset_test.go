@@ -0,0 +1,95 @@
+package multiset_test
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/structure/iterutil"
+	"github.com/TheAlgorithms/Go/structure/multiset"
+)
+
+func TestMultiset(t *testing.T) {
+	m := multiset.New("a", "b", "a", "c", "a")
+	if m.Len() != 5 || m.Distinct() != 3 || m.Count("a") != 3 || m.Count("z") != 0 {
+		t.Errorf("Len, Distinct, Count(a) = %d, %d, %d", m.Len(), m.Distinct(), m.Count("a"))
+	}
+	m.AddN("z", 0)
+	if m.Has("z") {
+		t.Errorf("AddN with 0 copies added a value")
+	}
+	if !m.Remove("b") || m.Remove("b") || m.Has("b") {
+		t.Errorf("Remove should remove the single copy of b")
+	}
+	if n := m.RemoveN("a", 2); n != 2 || m.Count("a") != 1 {
+		t.Errorf("RemoveN(a, 2) = %d, leaving %d", n, m.Count("a"))
+	}
+	if n := m.RemoveN("a", 5); n != 1 || m.Has("a") || m.Len() != 1 {
+		t.Errorf("RemoveN(a, 5) = %d, leaving %d values", n, m.Len())
+	}
+	values := iterutil.Collect(multiset.New(3, 1, 3).Values())
+	sort.Ints(values)
+	if len(values) != 3 || values[0] != 1 || values[1] != 3 || values[2] != 3 {
+		t.Errorf("Values = %v", values)
+	}
+}
+
+func TestMultisetAlgebra(t *testing.T) {
+	a := multiset.New(1, 1, 1, 2, 3)
+	b := multiset.New(1, 2, 2, 4)
+	tests := []struct {
+		name string
+		got  *multiset.Multiset[int]
+		want map[int]int
+	}{
+		{"Union", a.Union(b), map[int]int{1: 3, 2: 2, 3: 1, 4: 1}},
+		{"Intersection", a.Intersection(b), map[int]int{1: 1, 2: 1}},
+		{"Sum", a.Sum(b), map[int]int{1: 4, 2: 3, 3: 1, 4: 1}},
+		{"Difference", a.Difference(b), map[int]int{1: 2, 3: 1}},
+	}
+	for _, test := range tests {
+		total := 0
+		for v, n := range test.want {
+			total += n
+			if c := test.got.Count(v); c != n {
+				t.Errorf("%s: Count(%d) = %d, want %d", test.name, v, c, n)
+			}
+		}
+		if test.got.Len() != total || test.got.Distinct() != len(test.want) {
+			t.Errorf("%s: Len, Distinct = %d, %d, want %d, %d", test.name, test.got.Len(), test.got.Distinct(), total, len(test.want))
+		}
+	}
+	if a.Len() != 5 || b.Len() != 4 {
+		t.Errorf("the operations changed their operands")
+	}
+	if !a.Intersection(b).SubsetOf(a) || a.SubsetOf(b) || !a.SubsetOf(a.Union(b)) {
+		t.Errorf("SubsetOf is wrong")
+	}
+	if !a.Equal(multiset.New(3, 2, 1, 1, 1)) || a.Equal(b) || a.Equal(a.Sum(b)) {
+		t.Errorf("Equal is wrong")
+	}
+}
+
+func TestMultisetAlgebraRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	random := func() *multiset.Multiset[int] {
+		m := multiset.New[int]()
+		for i := rnd.Intn(20); i > 0; i-- {
+			m.Add(rnd.Intn(8))
+		}
+		return m
+	}
+	for i := 0; i < 100; i++ {
+		a, b := random(), random()
+		// |a ∪ b| + |a ∩ b| = |a| + |b|, and a - b is disjoint from a ∩ b
+		if a.Union(b).Len()+a.Intersection(b).Len() != a.Len()+b.Len() {
+			t.Fatalf("union and intersection sizes do not add up")
+		}
+		if !a.Difference(b).Sum(a.Intersection(b)).Equal(a) {
+			t.Fatalf("(a - b) + (a ∩ b) != a")
+		}
+		if !a.Union(b).Equal(b.Union(a)) || !a.Intersection(b).Equal(b.Intersection(a)) {
+			t.Fatalf("union or intersection is not commutative")
+		}
+	}
+}
@@ -0,0 +1,136 @@
+// prioritysearchtree.go
+// description: Priority search tree for 3-sided range queries
+// details:
+// A priority search tree stores points of the plane to report those with x
+// in [a, b] and y <= c, a query open on one side, in O(log n + k) for k
+// points reported. It is a heap on y and a search tree on x at once: the
+// root holds the point of smallest y, and the other points are split at
+// their median x between the two subtrees, built the same way. A query walks
+// down the subtrees whose x range meets [a, b], and stops at any node whose
+// y is above c, since the heap order puts every point below it higher
+// still. Each node visited is either reported or next to the paths to a
+// and b, hence the bound. A k-d tree needs O(sqrt(n) + k) for such queries,
+// and an interval tree answers queries on one dimension. The lowest point
+// with x in a range is found the same way, pruning the subtrees whose root
+// is not lower than the best point found.
+// time complexity: O(n log n) to build, O(log n + k) to query
+// space complexity: O(n)
+// reference: https://en.wikipedia.org/wiki/Priority_search_tree
+// reference: McCreight, Priority Search Trees, SIAM Journal on Computing, 1985
+// see prioritysearchtree_test.go
+
+// Package prioritysearchtree implements a static priority search tree that
+// reports the points of a set lying in a 3-sided range.
+package prioritysearchtree
+
+import (
+	"sort"
+
+	"github.com/TheAlgorithms/Go/constraints"
+)
+
+// Point is a point of the plane.
+type Point[T constraints.Ordered] struct {
+	X, Y T
+}
+
+type node[T constraints.Ordered] struct {
+	point Point[T]
+	split T // largest x of the left subtree, smallest of the right one
+	left  *node[T]
+	right *node[T]
+}
+
+// PrioritySearchTree stores a fixed set of points. The same point may be
+// stored more than once.
+type PrioritySearchTree[T constraints.Ordered] struct {
+	root *node[T]
+	size int
+}
+
+// New creates a priority search tree holding points, which it does not
+// change.
+func New[T constraints.Ordered](points []Point[T]) *PrioritySearchTree[T] {
+	sorted := append([]Point[T](nil), points...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].X < sorted[j].X })
+	return &PrioritySearchTree[T]{root: build(sorted), size: len(points)}
+}
+
+// build returns the tree of points, sorted by x, which it overwrites.
+func build[T constraints.Ordered](points []Point[T]) *node[T] {
+	if len(points) == 0 {
+		return nil
+	}
+	lowest := 0
+	for i, p := range points {
+		if p.Y < points[lowest].Y {
+			lowest = i
+		}
+	}
+	n := &node[T]{point: points[lowest]}
+	// the other points, still sorted by x
+	copy(points[lowest:], points[lowest+1:])
+	rest := points[:len(points)-1]
+	if len(rest) == 0 {
+		return n
+	}
+	mid := (len(rest) + 1) / 2
+	n.split = rest[mid-1].X
+	n.left = build(rest[:mid])
+	n.right = build(rest[mid:])
+	return n
+}
+
+// Len returns the number of points stored in the tree.
+func (t *PrioritySearchTree[T]) Len() int {
+	return t.size
+}
+
+// Query returns the points with x in [a, b] and y <= c, in no particular
+// order.
+func (t *PrioritySearchTree[T]) Query(a, b, c T) []Point[T] {
+	var result []Point[T]
+	var query func(n *node[T])
+	query = func(n *node[T]) {
+		if n == nil || c < n.point.Y {
+			return
+		}
+		if !(n.point.X < a || b < n.point.X) {
+			result = append(result, n.point)
+		}
+		if !(n.split < a) {
+			query(n.left)
+		}
+		if !(b < n.split) {
+			query(n.right)
+		}
+	}
+	query(t.root)
+	return result
+}
+
+// Lowest returns a point of smallest y among those with x in [a, b], with
+// false if there is none.
+func (t *PrioritySearchTree[T]) Lowest(a, b T) (Point[T], bool) {
+	var best Point[T]
+	found := false
+	var search func(n *node[T])
+	search = func(n *node[T]) {
+		if n == nil || found && !(n.point.Y < best.Y) {
+			return
+		}
+		if !(n.point.X < a || b < n.point.X) {
+			// every point below n is at least as high
+			best, found = n.point, true
+			return
+		}
+		if !(n.split < a) {
+			search(n.left)
+		}
+		if !(b < n.split) {
+			search(n.right)
+		}
+	}
+	search(t.root)
+	return best, found
+}
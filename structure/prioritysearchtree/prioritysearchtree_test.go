@@ -0,0 +1,104 @@
+package prioritysearchtree_test
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/structure/prioritysearchtree"
+)
+
+type point = prioritysearchtree.Point[int]
+
+func sortPoints(points []point) {
+	sort.Slice(points, func(i, j int) bool {
+		if points[i].X != points[j].X {
+			return points[i].X < points[j].X
+		}
+		return points[i].Y < points[j].Y
+	})
+}
+
+func TestQuery(t *testing.T) {
+	points := []point{{1, 5}, {2, 1}, {3, 8}, {4, 3}, {5, 2}, {6, 7}, {7, 4}, {4, 3}}
+	tree := prioritysearchtree.New(points)
+	if tree.Len() != 8 {
+		t.Errorf("Len() = %d, want 8", tree.Len())
+	}
+	tests := []struct {
+		a, b, c int
+		want    []point
+	}{
+		{2, 6, 3, []point{{2, 1}, {4, 3}, {4, 3}, {5, 2}}},
+		{1, 7, 0, nil},
+		{3, 3, 8, []point{{3, 8}}},
+		{6, 2, 10, nil},
+		{0, 100, 100, []point{{1, 5}, {2, 1}, {3, 8}, {4, 3}, {4, 3}, {5, 2}, {6, 7}, {7, 4}}},
+	}
+	for _, test := range tests {
+		got := tree.Query(test.a, test.b, test.c)
+		sortPoints(got)
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Query(%d, %d, %d) = %v, want %v", test.a, test.b, test.c, got, test.want)
+		}
+	}
+	if p, ok := tree.Lowest(3, 7); !ok || p != (point{5, 2}) {
+		t.Errorf("Lowest(3, 7) = %v, %t", p, ok)
+	}
+	if _, ok := tree.Lowest(8, 9); ok {
+		t.Errorf("Lowest(8, 9) found a point")
+	}
+	if got := prioritysearchtree.New[int](nil).Query(0, 10, 10); got != nil {
+		t.Errorf("Query of an empty tree = %v", got)
+	}
+}
+
+func TestQueryRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		points := make([]point, rnd.Intn(60))
+		for j := range points {
+			points[j] = point{rnd.Intn(30), rnd.Intn(30)}
+		}
+		tree := prioritysearchtree.New(points)
+		for q := 0; q < 20; q++ {
+			a, b, c := rnd.Intn(32)-1, rnd.Intn(32)-1, rnd.Intn(32)-1
+			var want []point
+			lowest, found := point{}, false
+			for _, p := range points {
+				if a <= p.X && p.X <= b {
+					if p.Y <= c {
+						want = append(want, p)
+					}
+					if !found || p.Y < lowest.Y {
+						lowest, found = p, true
+					}
+				}
+			}
+			got := tree.Query(a, b, c)
+			sortPoints(got)
+			sortPoints(want)
+			if !reflect.DeepEqual(got, want) {
+				t.Fatalf("Query(%d, %d, %d) = %v, want %v", a, b, c, got, want)
+			}
+			if p, ok := tree.Lowest(a, b); ok != found || ok && p.Y != lowest.Y {
+				t.Fatalf("Lowest(%d, %d) = %v, %t, want y = %d", a, b, p, ok, lowest.Y)
+			}
+		}
+	}
+}
+
+func BenchmarkQuery(b *testing.B) {
+	rnd := rand.New(rand.NewSource(1))
+	points := make([]point, 1<<16)
+	for i := range points {
+		points[i] = point{rnd.Intn(1 << 20), rnd.Intn(1 << 20)}
+	}
+	tree := prioritysearchtree.New(points)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a := rnd.Intn(1 << 20)
+		tree.Query(a, a+1<<14, 1<<12)
+	}
+}
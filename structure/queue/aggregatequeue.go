@@ -0,0 +1,111 @@
+// Aggregate Queue
+// description: Sliding-window aggregation (SWAG) with two stacks.
+// details:
+// 	A FIFO queue which can return the aggregate of all of its elements under any
+// 	associative operation, such as sum, product, min, max, gcd or matrix product.
+// 	The operation does not have to be commutative or invertible, which makes it
+// 	more general than a monotonic queue or a running sum.
+// 	Elements are pushed on a back stack and popped from a front stack. Each
+// 	stack entry caches the aggregate of itself and everything below it, and when
+// 	the front stack runs empty the back stack is moved onto it in one go.
+// 	Push, Pop and Aggregate take O(1) amortized time.
+// 	Sliding window aggregation : https://www.hirzels.com/martin/papers/debs17-tutorial.pdf
+// see aggregatequeue_test.go
+
+package queue
+
+// aggregated is a stack entry along with the aggregate it caches.
+type aggregated[T any] struct {
+	value T
+	agg   T
+}
+
+// AggregateQueue is a FIFO queue that maintains the aggregate of its elements.
+type AggregateQueue[T any] struct {
+	front []aggregated[T] // oldest element on top; agg covers the entry and all newer ones below it
+	back  []aggregated[T] // newest element on top; agg covers the entry and all older ones below it
+	op    func(a, b T) T
+}
+
+// NewAggregateQueue creates an empty queue aggregating its elements with op,
+// which must be associative. Aggregate returns op applied to the elements in
+// queue order, from the oldest to the newest.
+func NewAggregateQueue[T any](op func(a, b T) T) *AggregateQueue[T] {
+	return &AggregateQueue[T]{op: op}
+}
+
+// Push adds v to the back of the queue.
+func (q *AggregateQueue[T]) Push(v T) {
+	agg := v
+	if len(q.back) > 0 {
+		agg = q.op(q.back[len(q.back)-1].agg, v)
+	}
+	q.back = append(q.back, aggregated[T]{value: v, agg: agg})
+}
+
+// Pop removes the element at the front of the queue and returns it.
+// The second return value is false when the queue is empty.
+func (q *AggregateQueue[T]) Pop() (T, bool) {
+	if len(q.front) == 0 {
+		q.transfer()
+	}
+	if len(q.front) == 0 {
+		var zero T
+		return zero, false
+	}
+	// Zero the vacated slot so that the backing array does not keep the
+	// popped element reachable.
+	n := len(q.front) - 1
+	top := q.front[n]
+	q.front[n] = aggregated[T]{}
+	q.front = q.front[:n]
+	return top.value, true
+}
+
+// Front returns the element at the front of the queue without removing it.
+// The second return value is false when the queue is empty.
+func (q *AggregateQueue[T]) Front() (T, bool) {
+	if len(q.front) == 0 {
+		q.transfer()
+	}
+	if len(q.front) == 0 {
+		var zero T
+		return zero, false
+	}
+	return q.front[len(q.front)-1].value, true
+}
+
+// Aggregate returns the aggregate of all elements in the queue.
+// The second return value is false when the queue is empty.
+func (q *AggregateQueue[T]) Aggregate() (T, bool) {
+	switch {
+	case len(q.front) == 0 && len(q.back) == 0:
+		var zero T
+		return zero, false
+	case len(q.front) == 0:
+		return q.back[len(q.back)-1].agg, true
+	case len(q.back) == 0:
+		return q.front[len(q.front)-1].agg, true
+	}
+	return q.op(q.front[len(q.front)-1].agg, q.back[len(q.back)-1].agg), true
+}
+
+// Len returns the number of elements in the queue.
+func (q *AggregateQueue[T]) Len() int {
+	return len(q.front) + len(q.back)
+}
+
+// transfer moves the back stack onto the front stack, reversing its order and
+// recomputing the cached aggregates.
+func (q *AggregateQueue[T]) transfer() {
+	for i := len(q.back) - 1; i >= 0; i-- {
+		v := q.back[i].value
+		agg := v
+		if len(q.front) > 0 {
+			agg = q.op(v, q.front[len(q.front)-1].agg)
+		}
+		q.front = append(q.front, aggregated[T]{value: v, agg: agg})
+		q.back[i] = aggregated[T]{}
+	}
+	q.back = q.back[:0]
+}
@@ -0,0 +1,69 @@
+package queue
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/math/gcd"
+)
+
+func TestAggregateQueue(t *testing.T) {
+	t.Run("Test Empty", func(t *testing.T) {
+		q := NewAggregateQueue(func(a, b int) int { return a + b })
+		if _, ok := q.Aggregate(); ok {
+			t.Errorf("Aggregate of an empty queue should report false")
+		}
+		if _, ok := q.Pop(); ok {
+			t.Errorf("Pop of an empty queue should report false")
+		}
+		if _, ok := q.Front(); ok {
+			t.Errorf("Front of an empty queue should report false")
+		}
+	})
+
+	t.Run("Test Non-Commutative", func(t *testing.T) {
+		q := NewAggregateQueue(func(a, b string) string { return a + b })
+		var window []string
+		rnd := rand.New(rand.NewSource(4))
+		for i := 0; i < 300; i++ {
+			if rnd.Intn(3) == 0 && len(window) > 0 {
+				got, _ := q.Pop()
+				if got != window[0] {
+					t.Fatalf("Pop() = %q, want %q", got, window[0])
+				}
+				window = window[1:]
+			} else {
+				s := string(rune('a' + rnd.Intn(26)))
+				q.Push(s)
+				window = append(window, s)
+			}
+			want := ""
+			for _, s := range window {
+				want += s
+			}
+			if got, _ := q.Aggregate(); got != want {
+				t.Fatalf("Aggregate() = %q, want %q", got, want)
+			}
+			if q.Len() != len(window) {
+				t.Fatalf("Len() = %d, want %d", q.Len(), len(window))
+			}
+		}
+	})
+
+	t.Run("Test Sliding GCD", func(t *testing.T) {
+		q := NewAggregateQueue(func(a, b int64) int64 { return gcd.Iterative(a, b) })
+		values := []int64{12, 18, 24, 7, 14, 21, 28}
+		want := []int64{6, 1, 1, 7, 7}
+		for i, v := range values {
+			q.Push(v)
+			if q.Len() > 3 {
+				q.Pop()
+			}
+			if i >= 2 {
+				if got, _ := q.Aggregate(); got != want[i-2] {
+					t.Errorf("gcd of window ending at %d = %d, want %d", i, got, want[i-2])
+				}
+			}
+		}
+	})
+}
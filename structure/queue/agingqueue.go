@@ -0,0 +1,147 @@
+// Aging Queue
+// description: Priority queue whose waiting elements gain priority over time.
+// details:
+// 	A plain priority queue may starve its low priority elements: as long as
+// 	higher priority ones keep coming, they are never served. Aging fixes this
+// 	by serving the element with the highest effective priority, a function of
+// 	its priority and of how long it has waited, which the caller supplies and
+// 	which must not decrease as the wait grows.
+// 	The queue has a logical clock, moved by one tick on every Pop and by
+// 	Advance. Elements are kept in one FIFO queue per priority level, so that
+// 	the front of a level is its element that has waited the longest and,
+// 	with a non-decreasing aging function, has the highest effective priority
+// 	of the level. The aging function is thus only evaluated lazily, when the
+// 	front is asked for, on the fronts of the levels, and never needs to
+// 	reorder the elements as time passes. Ties go to the element pushed first.
+// 	Push takes O(1) time, Pop and Front O(L) for L distinct priority levels
+// 	in the queue.
+// 	Aging (scheduling) : https://en.wikipedia.org/wiki/Aging_(scheduling)
+// see agingqueue_test.go
+
+package queue
+
+import "errors"
+
+// AgingFunc returns the effective priority of an element of the given
+// priority that has waited for waited ticks. It must not decrease as waited
+// grows.
+type AgingFunc func(priority int, waited int64) float64
+
+// LinearAging returns the aging function adding rate to the priority for
+// every tick of waiting.
+func LinearAging(rate float64) AgingFunc {
+	return func(priority int, waited int64) float64 {
+		return float64(priority) + rate*float64(waited)
+	}
+}
+
+// waiting is an element of an AgingQueue with the time it was pushed.
+type waiting[T any] struct {
+	value T
+	since int64
+	seq   uint64 // order of the pushes, to break ties
+}
+
+// AgingQueue is a queue popping the element of highest effective priority
+// first, which prevents starvation.
+type AgingQueue[T any] struct {
+	levels   map[int]*TwoStackQueue[waiting[T]]
+	priority func(T) int
+	aging    AgingFunc
+	now      int64
+	seq      uint64
+	size     int
+}
+
+// NewAgingQueue creates an empty queue where an element v has priority
+// priority(v), higher priorities being served first, and gains priority
+// while waiting according to aging. A nil aging function leaves priorities
+// unchanged. It returns an error if priority is nil.
+func NewAgingQueue[T any](priority func(T) int, aging AgingFunc) (*AgingQueue[T], error) {
+	if priority == nil {
+		return nil, errors.New("aging queue needs a priority function")
+	}
+	if aging == nil {
+		aging = LinearAging(0)
+	}
+	return &AgingQueue[T]{levels: map[int]*TwoStackQueue[waiting[T]]{}, priority: priority, aging: aging}, nil
+}
+
+// Push adds v to the queue at the current time.
+func (q *AgingQueue[T]) Push(v T) {
+	p := q.priority(v)
+	level, ok := q.levels[p]
+	if !ok {
+		level = NewTwoStackQueue[waiting[T]]()
+		q.levels[p] = level
+	}
+	level.Push(waiting[T]{value: v, since: q.now, seq: q.seq})
+	q.seq++
+	q.size++
+}
+
+// best returns the priority level whose front has the highest effective
+// priority now.
+func (q *AgingQueue[T]) best() (int, bool) {
+	var bestLevel int
+	var bestFront waiting[T]
+	var bestPriority float64
+	found := false
+	for p, level := range q.levels {
+		front, _ := level.Front()
+		effective := q.aging(p, q.now-front.since)
+		if !found || effective > bestPriority || effective == bestPriority && front.seq < bestFront.seq {
+			bestLevel, bestFront, bestPriority, found = p, front, effective, true
+		}
+	}
+	return bestLevel, found
+}
+
+// Pop removes the element of highest effective priority and returns it, then
+// moves the clock by one tick. The second return value is false when the
+// queue is empty.
+func (q *AgingQueue[T]) Pop() (T, bool) {
+	p, ok := q.best()
+	if !ok {
+		var zero T
+		return zero, false
+	}
+	level := q.levels[p]
+	w, _ := level.Pop()
+	if level.Len() == 0 {
+		delete(q.levels, p)
+	}
+	q.size--
+	q.now++
+	return w.value, true
+}
+
+// Front returns the element of highest effective priority without removing
+// it. The second return value is false when the queue is empty.
+func (q *AgingQueue[T]) Front() (T, bool) {
+	p, ok := q.best()
+	if !ok {
+		var zero T
+		return zero, false
+	}
+	w, _ := q.levels[p].Front()
+	return w.value, true
+}
+
+// Len returns the number of elements in the queue.
+func (q *AgingQueue[T]) Len() int {
+	return q.size
+}
+
+// Now returns the current time of the queue's clock, in ticks.
+func (q *AgingQueue[T]) Now() int64 {
+	return q.now
+}
+
+// Advance moves the clock forward by ticks, which must not be negative, as
+// when time passes between pops.
+func (q *AgingQueue[T]) Advance(ticks int64) {
+	if ticks > 0 {
+		q.now += ticks
+	}
+}
@@ -0,0 +1,116 @@
+package queue
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// task is a job of a scheduler with its priority.
+type task struct {
+	name     string
+	priority int
+}
+
+func taskPriority(t task) int { return t.priority }
+
+func TestAgingQueue(t *testing.T) {
+	if _, err := NewAgingQueue[task](nil, nil); err == nil {
+		t.Errorf("NewAgingQueue without priority function should fail")
+	}
+	q, err := NewAgingQueue(taskPriority, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := q.Pop(); ok {
+		t.Errorf("Pop of an empty queue should report false")
+	}
+	for _, tk := range []task{{"b", 1}, {"a", 5}, {"c", 1}, {"d", 3}} {
+		q.Push(tk)
+	}
+	// without aging, by priority and then in push order
+	for _, want := range []string{"a", "d", "b", "c"} {
+		if got, ok := q.Pop(); !ok || got.name != want {
+			t.Errorf("Pop() = %v, %t, want %s", got, ok, want)
+		}
+	}
+	if q.Now() != 4 {
+		t.Errorf("Now() = %d after 4 pops", q.Now())
+	}
+}
+
+// served returns the number of pops before the low priority task waiting
+// from the start is served, while a high priority task arrives before every
+// pop, or -1 if it is not served in 1000 pops.
+func served(aging AgingFunc) int {
+	q, _ := NewAgingQueue(taskPriority, aging)
+	q.Push(task{"low", 0})
+	for i := 0; i < 1000; i++ {
+		q.Push(task{"high", 10})
+		if got, _ := q.Pop(); got.name == "low" {
+			return i
+		}
+	}
+	return -1
+}
+
+func TestAgingQueueStarvation(t *testing.T) {
+	if n := served(nil); n != -1 {
+		t.Errorf("without aging, the low priority task was served after %d pops", n)
+	}
+	// the low priority task catches up 10 priority levels at one level per
+	// tick, while the other tasks are served at once
+	if n := served(LinearAging(1)); n != 10 {
+		t.Errorf("with linear aging, the low priority task was served after %d pops, want 10", n)
+	}
+	q, _ := NewAgingQueue(taskPriority, LinearAging(0.5))
+	q.Push(task{"low", 0})
+	q.Advance(10)
+	q.Push(task{"high", 4})
+	if got, _ := q.Front(); got.name != "low" {
+		t.Errorf("Front() = %v after waiting 10 ticks, want low", got)
+	}
+}
+
+func TestAgingQueueRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	aging := func(priority int, waited int64) float64 {
+		// a convex aging function, so that the order between levels changes
+		return float64(priority) + float64(waited*waited)/50
+	}
+	q, _ := NewAgingQueue(taskPriority, aging)
+	var model []waiting[task]
+	var seq uint64
+	for i := 0; i < 3000; i++ {
+		if rnd.Intn(2) == 0 {
+			tk := task{name: string(rune('a' + i%26)), priority: rnd.Intn(8)}
+			q.Push(tk)
+			model = append(model, waiting[task]{value: tk, since: q.Now(), seq: seq})
+			seq++
+			continue
+		}
+		if rnd.Intn(5) == 0 {
+			q.Advance(int64(rnd.Intn(4)))
+		}
+		best := -1
+		var bestPriority float64
+		for j, w := range model {
+			if p := aging(w.value.priority, q.Now()-w.since); best == -1 || p > bestPriority {
+				best, bestPriority = j, p
+			}
+		}
+		got, ok := q.Pop()
+		if best == -1 {
+			if ok {
+				t.Fatalf("Pop() = %v from an empty queue", got)
+			}
+			continue
+		}
+		if !ok || got != model[best].value {
+			t.Fatalf("Pop() = %v, %t, want %v", got, ok, model[best].value)
+		}
+		model = append(model[:best], model[best+1:]...)
+		if q.Len() != len(model) {
+			t.Fatalf("Len() = %d, want %d", q.Len(), len(model))
+		}
+	}
+}
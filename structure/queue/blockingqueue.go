@@ -0,0 +1,182 @@
+// Blocking Queue
+// description: Bounded FIFO queue whose operations wait for room or for elements.
+// details:
+// 	The elements live in a ring buffer of fixed capacity guarded by a mutex.
+// 	Producers wait on a condition variable until the queue is not full and
+// 	consumers on another one until it is not empty; every Push wakes a consumer
+// 	and every Pop wakes a producer. The Try variants never wait and the
+// 	Timeout variants wait at most the given time, a timer waking the waiters
+// 	when it expires. Closing the queue wakes everybody: consumers drain the
+// 	remaining elements and then get false.
+// 	All operations take O(1) time besides waiting.
+// 	Producer-consumer problem : https://en.wikipedia.org/wiki/Producer%E2%80%93consumer_problem
+// see blockingqueue_test.go
+
+package queue
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrInvalidCapacity is returned by NewBlockingQueue for a capacity below 1.
+var ErrInvalidCapacity = errors.New("queue capacity must be positive")
+
+// BlockingQueue is a bounded FIFO queue safe for concurrent use.
+type BlockingQueue[T any] struct {
+	mu       sync.Mutex
+	notEmpty *sync.Cond
+	notFull  *sync.Cond
+	items    []T // ring buffer
+	head     int // index of the front element
+	size     int
+	closed   bool
+}
+
+// NewBlockingQueue creates an empty queue holding up to capacity elements.
+func NewBlockingQueue[T any](capacity int) (*BlockingQueue[T], error) {
+	if capacity < 1 {
+		return nil, ErrInvalidCapacity
+	}
+	q := &BlockingQueue[T]{items: make([]T, capacity)}
+	q.notEmpty = sync.NewCond(&q.mu)
+	q.notFull = sync.NewCond(&q.mu)
+	return q, nil
+}
+
+// await waits on c until ready returns true, the queue is closed or the
+// deadline passes, if it is not zero. It reports whether ready returned true.
+// The caller holds the lock.
+func (q *BlockingQueue[T]) await(c *sync.Cond, ready func() bool, deadline time.Time) bool {
+	if !deadline.IsZero() && !ready() {
+		timer := time.AfterFunc(time.Until(deadline), func() {
+			q.mu.Lock()
+			c.Broadcast()
+			q.mu.Unlock()
+		})
+		defer timer.Stop()
+	}
+	for !ready() {
+		if q.closed || (!deadline.IsZero() && !time.Now().Before(deadline)) {
+			return false
+		}
+		c.Wait()
+	}
+	return true
+}
+
+func (q *BlockingQueue[T]) hasRoom() bool     { return q.size < len(q.items) }
+func (q *BlockingQueue[T]) hasElements() bool { return q.size > 0 }
+
+// push adds v if there is room and the queue is open, waiting until the
+// deadline, forever if it is zero, or not at all if wait is false.
+func (q *BlockingQueue[T]) push(v T, wait bool, deadline time.Time) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closed {
+		return false
+	}
+	if wait && !q.await(q.notFull, q.hasRoom, deadline) || !q.hasRoom() || q.closed {
+		return false
+	}
+	q.items[(q.head+q.size)%len(q.items)] = v
+	q.size++
+	q.notEmpty.Signal()
+	return true
+}
+
+// pop removes the front element if there is one, waiting like push.
+func (q *BlockingQueue[T]) pop(wait bool, deadline time.Time) (T, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	var zero T
+	if wait && !q.await(q.notEmpty, q.hasElements, deadline) || !q.hasElements() {
+		return zero, false
+	}
+	v := q.items[q.head]
+	q.items[q.head] = zero
+	q.head = (q.head + 1) % len(q.items)
+	q.size--
+	q.notFull.Signal()
+	return v, true
+}
+
+// Push adds v to the back of the queue, waiting for room if it is full. It
+// panics if the queue is closed, before or while waiting, like a send on a
+// closed channel.
+func (q *BlockingQueue[T]) Push(v T) {
+	if !q.push(v, true, time.Time{}) {
+		panic("queue: Push on a closed BlockingQueue")
+	}
+}
+
+// TryPush adds v to the back of the queue if there is room right away. It
+// reports whether v was added.
+func (q *BlockingQueue[T]) TryPush(v T) bool {
+	return q.push(v, false, time.Time{})
+}
+
+// PushTimeout adds v to the back of the queue, waiting at most timeout for
+// room. It reports whether v was added.
+func (q *BlockingQueue[T]) PushTimeout(v T, timeout time.Duration) bool {
+	if timeout <= 0 {
+		return q.TryPush(v)
+	}
+	return q.push(v, true, time.Now().Add(timeout))
+}
+
+// Pop removes the element at the front of the queue and returns it, waiting
+// for one if the queue is empty. The second return value is false when the
+// queue is closed and empty.
+func (q *BlockingQueue[T]) Pop() (T, bool) {
+	return q.pop(true, time.Time{})
+}
+
+// TryPop removes and returns the front element if there is one right away.
+func (q *BlockingQueue[T]) TryPop() (T, bool) {
+	return q.pop(false, time.Time{})
+}
+
+// PopTimeout removes and returns the front element, waiting at most timeout
+// for one.
+func (q *BlockingQueue[T]) PopTimeout(timeout time.Duration) (T, bool) {
+	if timeout <= 0 {
+		return q.TryPop()
+	}
+	return q.pop(true, time.Now().Add(timeout))
+}
+
+// Front returns the element at the front of the queue without removing it or
+// waiting. The second return value is false when the queue is empty.
+func (q *BlockingQueue[T]) Front() (T, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.size == 0 {
+		var zero T
+		return zero, false
+	}
+	return q.items[q.head], true
+}
+
+// Len returns the number of elements in the queue.
+func (q *BlockingQueue[T]) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.size
+}
+
+// Cap returns the capacity of the queue.
+func (q *BlockingQueue[T]) Cap() int {
+	return len(q.items)
+}
+
+// Close closes the queue: pushing fails and popping returns the remaining
+// elements, then false. Waiting goroutines are woken up.
+func (q *BlockingQueue[T]) Close() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.closed = true
+	q.notEmpty.Broadcast()
+	q.notFull.Broadcast()
+}
@@ -0,0 +1,136 @@
+package queue
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestBlockingQueue(t *testing.T) {
+	if _, err := NewBlockingQueue[int](0); err != ErrInvalidCapacity {
+		t.Errorf("NewBlockingQueue(0) error = %v, want %v", err, ErrInvalidCapacity)
+	}
+
+	t.Run("Test Try", func(t *testing.T) {
+		q, _ := NewBlockingQueue[int](2)
+		if _, ok := q.TryPop(); ok {
+			t.Errorf("TryPop of an empty queue should report false")
+		}
+		if !q.TryPush(1) || !q.TryPush(2) {
+			t.Fatalf("TryPush should succeed while there is room")
+		}
+		if q.TryPush(3) {
+			t.Errorf("TryPush on a full queue should report false")
+		}
+		if front, _ := q.Front(); front != 1 {
+			t.Errorf("Front() = %d, want 1", front)
+		}
+		if v, _ := q.TryPop(); v != 1 {
+			t.Errorf("TryPop() = %d, want 1", v)
+		}
+		// wrap around the ring buffer
+		q.TryPush(3)
+		for _, want := range []int{2, 3} {
+			if v, ok := q.TryPop(); !ok || v != want {
+				t.Errorf("TryPop() = %d, %v, want %d, true", v, ok, want)
+			}
+		}
+	})
+
+	t.Run("Test Timeout", func(t *testing.T) {
+		q, _ := NewBlockingQueue[int](1)
+		start := time.Now()
+		if _, ok := q.PopTimeout(20 * time.Millisecond); ok {
+			t.Errorf("PopTimeout of an empty queue should report false")
+		}
+		if elapsed := time.Since(start); elapsed < 20*time.Millisecond {
+			t.Errorf("PopTimeout returned after %v, before its timeout", elapsed)
+		}
+		q.Push(1)
+		if q.PushTimeout(2, 10*time.Millisecond) {
+			t.Errorf("PushTimeout on a full queue should report false")
+		}
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			q.Pop()
+		}()
+		if !q.PushTimeout(2, time.Minute) {
+			t.Errorf("PushTimeout should succeed once an element is popped")
+		}
+	})
+
+	t.Run("Test Producers And Consumers", func(t *testing.T) {
+		const producers, perProducer = 4, 500
+		q, _ := NewBlockingQueue[int](3)
+		var wg sync.WaitGroup
+		for p := 0; p < producers; p++ {
+			wg.Add(1)
+			go func(p int) {
+				defer wg.Done()
+				for i := 0; i < perProducer; i++ {
+					q.Push(p*perProducer + i)
+				}
+			}(p)
+		}
+		go func() {
+			wg.Wait()
+			q.Close()
+		}()
+
+		results := make(chan []int, 2)
+		for c := 0; c < 2; c++ {
+			go func() {
+				var got []int
+				for {
+					v, ok := q.Pop()
+					if !ok {
+						results <- got
+						return
+					}
+					got = append(got, v)
+				}
+			}()
+		}
+		count := 0
+		for c := 0; c < 2; c++ {
+			got := <-results
+			count += len(got)
+			// every consumer sees the elements of a producer in order
+			last := make([]int, producers)
+			for p := range last {
+				last[p] = p*perProducer - 1
+			}
+			for _, v := range got {
+				p := v / perProducer
+				if v <= last[p] {
+					t.Fatalf("element %d popped after %d", v, last[p])
+				}
+				last[p] = v
+			}
+		}
+		if count != producers*perProducer {
+			t.Errorf("popped %d elements, want %d", count, producers*perProducer)
+		}
+	})
+
+	t.Run("Test Close", func(t *testing.T) {
+		q, _ := NewBlockingQueue[int](1)
+		q.Push(1)
+		q.Close()
+		if q.TryPush(2) {
+			t.Errorf("TryPush on a closed queue should report false")
+		}
+		if v, ok := q.Pop(); !ok || v != 1 {
+			t.Errorf("Pop() = %d, %v, want the remaining element", v, ok)
+		}
+		if _, ok := q.Pop(); ok {
+			t.Errorf("Pop of a closed empty queue should report false")
+		}
+		defer func() {
+			if recover() == nil {
+				t.Errorf("Push on a closed queue should panic")
+			}
+		}()
+		q.Push(3)
+	})
+}
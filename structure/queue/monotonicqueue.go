@@ -0,0 +1,114 @@
+// Monotonic Queue
+// description: FIFO window keeping track of its minimum (or maximum) in O(1).
+// details:
+// 	A monotonic queue stores only the elements that can still become the
+// 	minimum of the window: when an element is pushed, every stored element
+// 	that is not less than it is dropped from the back, since it leaves the
+// 	window earlier and is never smaller. The stored elements are therefore
+// 	increasing from front to back and the front is the minimum. Every element
+// 	is stored and dropped at most once, so Push and Pop take O(1) amortized
+// 	time. Unlike AggregateQueue, it needs an ordering rather than any
+// 	associative operation, but it keeps fewer elements.
+// 	Sliding window minimum : https://cp-algorithms.com/data_structures/stack_queue_modification.html
+// see monotonicqueue_test.go
+
+package queue
+
+import "github.com/TheAlgorithms/Go/constraints"
+
+// sequenced is an element with its position in the order of pushes.
+type sequenced[T any] struct {
+	value T
+	seq   int
+}
+
+// MonotonicQueue is a FIFO window of elements that returns its minimum
+// according to less.
+type MonotonicQueue[T any] struct {
+	less   func(a, b T) bool
+	kept   []sequenced[T] // candidates, increasing according to less
+	head   int            // index of the front candidate in kept
+	pushed int
+	popped int
+}
+
+// NewMonotonicQueue creates an empty window whose Min returns the smallest
+// element according to less. Passing a greater function tracks the maximum.
+func NewMonotonicQueue[T any](less func(a, b T) bool) *MonotonicQueue[T] {
+	return &MonotonicQueue[T]{less: less}
+}
+
+// Push adds v to the back of the window.
+func (q *MonotonicQueue[T]) Push(v T) {
+	for len(q.kept) > q.head && !q.less(q.kept[len(q.kept)-1].value, v) {
+		q.kept = q.kept[:len(q.kept)-1]
+	}
+	q.kept = append(q.kept, sequenced[T]{value: v, seq: q.pushed})
+	q.pushed++
+}
+
+// Pop removes the oldest element of the window. It reports false if the
+// window is empty. The element itself may have been dropped already, so it
+// is not returned.
+func (q *MonotonicQueue[T]) Pop() bool {
+	if q.popped == q.pushed {
+		return false
+	}
+	if q.kept[q.head].seq == q.popped {
+		q.head++
+		// reclaim the dropped prefix once it is half of the slice
+		if q.head*2 >= len(q.kept) {
+			q.kept = append(q.kept[:0], q.kept[q.head:]...)
+			q.head = 0
+		}
+	}
+	q.popped++
+	return true
+}
+
+// Min returns the smallest element of the window. The second return value is
+// false when the window is empty.
+func (q *MonotonicQueue[T]) Min() (T, bool) {
+	if q.popped == q.pushed {
+		var zero T
+		return zero, false
+	}
+	return q.kept[q.head].value, true
+}
+
+// Len returns the number of elements in the window.
+func (q *MonotonicQueue[T]) Len() int {
+	return q.pushed - q.popped
+}
+
+// SlidingWindowMin returns the minimum of every window of k consecutive
+// values: result[i] is the minimum of values[i:i+k]. It returns nil when k is
+// not between 1 and len(values).
+func SlidingWindowMin[T constraints.Ordered](values []T, k int) []T {
+	return slidingWindow(values, k, func(a, b T) bool { return a < b })
+}
+
+// SlidingWindowMax returns the maximum of every window of k consecutive
+// values, as SlidingWindowMin does for the minimum.
+func SlidingWindowMax[T constraints.Ordered](values []T, k int) []T {
+	return slidingWindow(values, k, func(a, b T) bool { return a > b })
+}
+
+func slidingWindow[T any](values []T, k int, less func(a, b T) bool) []T {
+	if k < 1 || k > len(values) {
+		return nil
+	}
+	q := NewMonotonicQueue(less)
+	result := make([]T, 0, len(values)-k+1)
+	for i, v := range values {
+		q.Push(v)
+		if i >= k {
+			q.Pop()
+		}
+		if i >= k-1 {
+			m, _ := q.Min()
+			result = append(result, m)
+		}
+	}
+	return result
+}
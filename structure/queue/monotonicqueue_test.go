@@ -0,0 +1,67 @@
+package queue
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+func TestMonotonicQueue(t *testing.T) {
+	q := NewMonotonicQueue(func(a, b int) bool { return a < b })
+	if _, ok := q.Min(); ok {
+		t.Errorf("Min of an empty queue should report false")
+	}
+	if q.Pop() {
+		t.Errorf("Pop of an empty queue should report false")
+	}
+
+	rnd := rand.New(rand.NewSource(3))
+	var window []int
+	for i := 0; i < 1000; i++ {
+		if rnd.Intn(3) > 0 {
+			v := rnd.Intn(20)
+			q.Push(v)
+			window = append(window, v)
+		} else {
+			if q.Pop() != (len(window) > 0) {
+				t.Fatalf("Pop reported the wrong result with %d elements", len(window))
+			}
+			if len(window) > 0 {
+				window = window[1:]
+			}
+		}
+		if q.Len() != len(window) {
+			t.Fatalf("Len() = %d, want %d", q.Len(), len(window))
+		}
+		if len(window) > 0 {
+			want := window[0]
+			for _, v := range window {
+				if v < want {
+					want = v
+				}
+			}
+			if got, _ := q.Min(); got != want {
+				t.Fatalf("Min() = %d, want %d", got, want)
+			}
+		}
+	}
+}
+
+func TestSlidingWindow(t *testing.T) {
+	values := []int{1, 3, -1, -3, 5, 3, 6, 7}
+	if got, want := SlidingWindowMax(values, 3), []int{3, 3, 5, 5, 6, 7}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SlidingWindowMax = %v, want %v", got, want)
+	}
+	if got, want := SlidingWindowMin(values, 3), []int{-1, -3, -3, -3, 3, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("SlidingWindowMin = %v, want %v", got, want)
+	}
+	if got, want := SlidingWindowMin(values, 1), values; !reflect.DeepEqual(got, want) {
+		t.Errorf("SlidingWindowMin with k = 1 = %v, want %v", got, want)
+	}
+	if got := SlidingWindowMin(values, 0); got != nil {
+		t.Errorf("SlidingWindowMin with k = 0 = %v, want nil", got)
+	}
+	if got := SlidingWindowMax(values, 9); got != nil {
+		t.Errorf("SlidingWindowMax with k > len = %v, want nil", got)
+	}
+}
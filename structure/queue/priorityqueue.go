@@ -0,0 +1,66 @@
+// Priority Queue
+// description: Queue returning its elements in priority order, on top of a binary heap.
+// details:
+// 	PriorityQueue adapts the binary heap of the heap package to the queue
+// 	Interface, so that code written against a queue can switch between FIFO and
+// 	priority order. Pop returns the smallest element according to less.
+// 	Push and Pop take O(log n) time, Front O(1).
+// 	Priority queue : https://en.wikipedia.org/wiki/Priority_queue
+// see priorityqueue_test.go
+
+package queue
+
+import (
+	"github.com/TheAlgorithms/Go/constraints"
+	"github.com/TheAlgorithms/Go/structure/heap"
+)
+
+// PriorityQueue is a queue popping its smallest element first.
+type PriorityQueue[T any] struct {
+	h *heap.Heap[T]
+}
+
+// NewPriorityQueue creates an empty queue popping the smallest element
+// according to less first. It returns an error if less is nil.
+func NewPriorityQueue[T any](less func(a, b T) bool) (*PriorityQueue[T], error) {
+	h, err := heap.NewAny(less)
+	if err != nil {
+		return nil, err
+	}
+	return &PriorityQueue[T]{h: h}, nil
+}
+
+// NewMinQueue creates an empty queue popping its smallest element first.
+func NewMinQueue[T constraints.Ordered]() *PriorityQueue[T] {
+	return &PriorityQueue[T]{h: heap.New[T]()}
+}
+
+// Push adds v to the queue.
+func (q *PriorityQueue[T]) Push(v T) {
+	q.h.Push(v)
+}
+
+// Pop removes the smallest element and returns it. The second return value
+// is false when the queue is empty.
+func (q *PriorityQueue[T]) Pop() (T, bool) {
+	v, ok := q.Front()
+	if ok {
+		q.h.Pop()
+	}
+	return v, ok
+}
+
+// Front returns the smallest element without removing it. The second return
+// value is false when the queue is empty.
+func (q *PriorityQueue[T]) Front() (T, bool) {
+	if q.h.Empty() {
+		var zero T
+		return zero, false
+	}
+	return q.h.Top(), true
+}
+
+// Len returns the number of elements in the queue.
+func (q *PriorityQueue[T]) Len() int {
+	return q.h.Size()
+}
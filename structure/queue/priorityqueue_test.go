@@ -0,0 +1,51 @@
+package queue
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestPriorityQueue(t *testing.T) {
+	if _, err := NewPriorityQueue[int](nil); err == nil {
+		t.Errorf("NewPriorityQueue(nil) should fail")
+	}
+
+	q, err := NewPriorityQueue(func(a, b string) bool { return len(a) < len(b) })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := q.Pop(); ok {
+		t.Errorf("Pop of an empty queue should report false")
+	}
+	for _, s := range []string{"ccc", "a", "dddd", "bb"} {
+		q.Push(s)
+	}
+	if front, _ := q.Front(); front != "a" {
+		t.Errorf("Front() = %q, want %q", front, "a")
+	}
+	for _, want := range []string{"a", "bb", "ccc", "dddd"} {
+		if got, ok := q.Pop(); !ok || got != want {
+			t.Errorf("Pop() = %q, %v, want %q, true", got, ok, want)
+		}
+	}
+	if q.Len() != 0 {
+		t.Errorf("Len() = %d after popping everything", q.Len())
+	}
+}
+
+func TestMinQueue(t *testing.T) {
+	rnd := rand.New(rand.NewSource(2))
+	var q Interface[int] = NewMinQueue[int]()
+	values := make([]int, 200)
+	for i := range values {
+		values[i] = rnd.Intn(50)
+		q.Push(values[i])
+	}
+	sort.Ints(values)
+	for _, want := range values {
+		if got, _ := q.Pop(); got != want {
+			t.Fatalf("Pop() = %d, want %d", got, want)
+		}
+	}
+}
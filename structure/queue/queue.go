@@ -0,0 +1,39 @@
+// Queue Interface
+// description: The generic FIFO queue interface shared by the queues of this package.
+// details:
+// 	Push adds an element at the back and Pop removes the element at the front,
+// 	reporting false when there is none. Queue implements it for values of
+// 	any type and LQueue does through its AsInterface method; AggregateQueue,
+// 	TwoStackQueue, BlockingQueue, PriorityQueue, AgingQueue and WALQueue
+// 	implement it for values of type T. The priority queue pops the smallest
+// 	element instead of the oldest, and the aging queue the element of highest
+// 	priority after aging.
+// 	Queue (abstract data type) : https://en.wikipedia.org/wiki/Queue_(abstract_data_type)
+// see queuelinkedlist.go, queuelinklistwithlist.go, queue_test.go
+
+package queue
+
+// Interface is a queue of elements of type T.
+type Interface[T any] interface {
+	// Push adds v to the queue.
+	Push(v T)
+	// Pop removes the next element of the queue and returns it. The second
+	// return value is false when there is no element to return.
+	Pop() (T, bool)
+	// Front returns the next element without removing it. The second return
+	// value is false when the queue is empty.
+	Front() (T, bool)
+	// Len returns the number of elements in the queue.
+	Len() int
+}
+
+var (
+	_ Interface[any] = (*Queue)(nil)
+	_ Interface[any] = lqueueInterface{}
+	_ Interface[int] = (*AggregateQueue[int])(nil)
+	_ Interface[int] = (*TwoStackQueue[int])(nil)
+	_ Interface[int] = (*BlockingQueue[int])(nil)
+	_ Interface[int] = (*PriorityQueue[int])(nil)
+	_ Interface[int] = (*AgingQueue[int])(nil)
+	_ Interface[int] = (*WALQueue[int])(nil)
+)
@@ -245,3 +245,31 @@ func TestQueue(t *testing.T) {
 	})
 
 }
+
+// TestInterface runs the linked-list queues through Interface.
+func TestInterface(t *testing.T) {
+	for name, q := range map[string]Interface[any]{"Queue": &Queue{}, "LQueue": (&LQueue{}).AsInterface()} {
+		t.Run(name, func(t *testing.T) {
+			if _, ok := q.Pop(); ok {
+				t.Errorf("Pop of an empty queue reported a value")
+			}
+			if _, ok := q.Front(); ok {
+				t.Errorf("Front of an empty queue reported a value")
+			}
+			for _, v := range []any{1, "two", 3.0} {
+				q.Push(v)
+			}
+			if v, ok := q.Front(); !ok || v != 1 || q.Len() != 3 {
+				t.Errorf("Front() = %v, %t with Len() = %d, want 1, true with 3", v, ok, q.Len())
+			}
+			for _, want := range []any{1, "two", 3.0} {
+				if v, ok := q.Pop(); !ok || v != want {
+					t.Errorf("Pop() = %v, %t, want %v, true", v, ok, want)
+				}
+			}
+			if q.Len() != 0 {
+				t.Errorf("Len() = %d after popping everything", q.Len())
+			}
+		})
+	}
+}
@@ -75,3 +75,29 @@ func (ll *Queue) frontQueue() any {
 func (ll *Queue) backQueue() any {
 	return ll.tail.Data
 }
+
+// Push adds n at the back of the queue.
+func (ll *Queue) Push(n any) {
+	ll.enqueue(n)
+}
+
+// Pop removes the front value and returns it, with false if the queue is empty.
+func (ll *Queue) Pop() (any, bool) {
+	if ll.isEmpty() {
+		return nil, false
+	}
+	return ll.dequeue(), true
+}
+
+// Front returns the front value, with false if the queue is empty.
+func (ll *Queue) Front() (any, bool) {
+	if ll.isEmpty() {
+		return nil, false
+	}
+	return ll.frontQueue(), true
+}
+
+// Len returns the length of the queue.
+func (ll *Queue) Len() int {
+	return ll.length
+}
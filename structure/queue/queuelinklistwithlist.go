@@ -17,15 +17,32 @@ import (
 )
 
 // LQueue will be store the value into the list
+// Its zero value is an empty queue.
 type LQueue struct {
 	queue *list.List
 }
 
 // Enqueue will be added new value
 func (lq *LQueue) Enqueue(value any) {
+	if lq.queue == nil {
+		lq.queue = list.New()
+	}
 	lq.queue.PushBack(value)
 }
 
+// Push adds value at the back of the queue, like Enqueue.
+func (lq *LQueue) Push(value any) {
+	lq.Enqueue(value)
+}
+
+// Pop removes the front value and returns it, with false if the queue is empty.
+func (lq *LQueue) Pop() (any, bool) {
+	if lq.Empty() {
+		return nil, false
+	}
+	return lq.queue.Remove(lq.queue.Front()), true
+}
+
 // Dequeue will be removed the first value that input (First In First Out - FIFO)
 func (lq *LQueue) Dequeue() error {
 
@@ -61,10 +78,32 @@ func (lq *LQueue) Back() (any, error) {
 
 // Len it will return the length of list
 func (lq *LQueue) Len() int {
+	if lq.queue == nil {
+		return 0
+	}
 	return lq.queue.Len()
 }
 
 // Empty is check our list is empty or not
 func (lq *LQueue) Empty() bool {
-	return lq.queue.Len() == 0
+	return lq.Len() == 0
+}
+
+// AsInterface returns lq as an Interface, whose Front reports false instead
+// of an error when the queue is empty.
+func (lq *LQueue) AsInterface() Interface[any] {
+	return lqueueInterface{lq}
+}
+
+// lqueueInterface adapts LQueue to Interface.
+type lqueueInterface struct {
+	*LQueue
+}
+
+// Front returns the front value, with false if the queue is empty.
+func (q lqueueInterface) Front() (any, bool) {
+	if q.Empty() {
+		return nil, false
+	}
+	return q.queue.Front().Value, true
 }
@@ -0,0 +1,61 @@
+// Two-Stack Queue
+// description: FIFO queue built from two stacks.
+// details:
+// 	Elements are pushed on a back stack and popped from a front stack. When the
+// 	front stack runs empty, the back stack is reversed onto it, which puts the
+// 	oldest element on top. Every element moves once from the back stack to the
+// 	front stack, so Push and Pop take O(1) amortized time, and the queue never
+// 	keeps a prefix of dead elements the way a sliced array does.
+// 	Queue using stacks : https://www.geeksforgeeks.org/queue-using-stacks/
+// see twostackqueue_test.go
+
+package queue
+
+// TwoStackQueue is a FIFO queue made of two stacks.
+type TwoStackQueue[T any] struct {
+	front []T // oldest element on top
+	back  []T // newest element on top
+}
+
+// NewTwoStackQueue creates an empty queue.
+func NewTwoStackQueue[T any]() *TwoStackQueue[T] {
+	return &TwoStackQueue[T]{}
+}
+
+// Push adds v to the back of the queue.
+func (q *TwoStackQueue[T]) Push(v T) {
+	q.back = append(q.back, v)
+}
+
+// Pop removes the element at the front of the queue and returns it.
+// The second return value is false when the queue is empty.
+func (q *TwoStackQueue[T]) Pop() (T, bool) {
+	v, ok := q.Front()
+	if ok {
+		var zero T
+		q.front[len(q.front)-1] = zero // let the element be collected
+		q.front = q.front[:len(q.front)-1]
+	}
+	return v, ok
+}
+
+// Front returns the element at the front of the queue without removing it.
+// The second return value is false when the queue is empty.
+func (q *TwoStackQueue[T]) Front() (T, bool) {
+	if len(q.front) == 0 {
+		for len(q.back) > 0 {
+			q.front = append(q.front, q.back[len(q.back)-1])
+			q.back = q.back[:len(q.back)-1]
+		}
+	}
+	if len(q.front) == 0 {
+		var zero T
+		return zero, false
+	}
+	return q.front[len(q.front)-1], true
+}
+
+// Len returns the number of elements in the queue.
+func (q *TwoStackQueue[T]) Len() int {
+	return len(q.front) + len(q.back)
+}
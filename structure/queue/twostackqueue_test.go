@@ -0,0 +1,43 @@
+package queue
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestTwoStackQueue(t *testing.T) {
+	q := NewTwoStackQueue[int]()
+	if _, ok := q.Pop(); ok {
+		t.Errorf("Pop of an empty queue should report false")
+	}
+	if _, ok := q.Front(); ok {
+		t.Errorf("Front of an empty queue should report false")
+	}
+
+	// compare against a plain slice under random operations
+	rnd := rand.New(rand.NewSource(1))
+	var want []int
+	for i := 0; i < 1000; i++ {
+		if rnd.Intn(3) > 0 {
+			q.Push(i)
+			want = append(want, i)
+		} else {
+			got, ok := q.Pop()
+			if ok != (len(want) > 0) {
+				t.Fatalf("Pop reported %v with %d elements", ok, len(want))
+			}
+			if ok {
+				if got != want[0] {
+					t.Fatalf("Pop() = %d, want %d", got, want[0])
+				}
+				want = want[1:]
+			}
+		}
+		if q.Len() != len(want) {
+			t.Fatalf("Len() = %d, want %d", q.Len(), len(want))
+		}
+		if front, ok := q.Front(); ok && front != want[0] {
+			t.Fatalf("Front() = %d, want %d", front, want[0])
+		}
+	}
+}
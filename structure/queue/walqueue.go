@@ -0,0 +1,371 @@
+// Write-Ahead-Log Queue
+// description: Durable FIFO queue persisted in a segmented append-only log.
+// details:
+// 	Every Push and Pop is appended to a log file before it takes effect, so
+// 	that the queue can be rebuilt by replaying the log after the process
+// 	stops, gracefully or not. Each record is framed by its length and a CRC-32
+// 	of its content. A crash in the middle of a write leaves a torn record at
+// 	the end of the log, which recovery detects with the checksum and cuts off;
+// 	a bad record anywhere else is reported as corruption.
+// 	Pushed elements are numbered in sequence and a pop record holds the number
+// 	of the element it removes. The log is split in segment files named after
+// 	the number of their first push, and a new segment is started once the
+// 	current one reaches the segment size. A segment is deleted as soon as every
+// 	element it pushed has been popped: the pop records it may hold only refer
+// 	to elements of deleted segments, so replaying the remaining ones gives the
+// 	same queue.
+// 	The elements are also kept in memory, the log being there for durability.
+// 	Records are written to the operating system at once; Sync flushes them to
+// 	the disk to survive a power loss as well.
+// 	Push, Pop and Front take O(1) time besides the writes, recovery O(n) for
+// 	a log of n bytes.
+// 	Write-ahead logging : https://en.wikipedia.org/wiki/Write-ahead_logging
+// see walqueue_test.go
+
+package queue
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/TheAlgorithms/Go/hashing/crc32"
+)
+
+var (
+	// ErrCorruptLog is returned when a segment of the log holds a bad record
+	// other than a torn one at the end of the last segment.
+	ErrCorruptLog = errors.New("queue log is corrupt")
+	// ErrClosed is reported by a WALQueue used after Close.
+	ErrClosed = errors.New("queue is closed")
+)
+
+// DefaultSegmentSize is the size in bytes from which OpenWALQueue starts a
+// new segment when given a segment size below 1.
+const DefaultSegmentSize = 1 << 20
+
+// The kinds of the log records.
+const (
+	recordPush byte = 1
+	recordPop  byte = 2
+)
+
+// recordHeader is the size of the checksum and length preceding every record.
+const recordHeader = 8
+
+// segmentExt is the extension of the segment files.
+const segmentExt = ".wal"
+
+// segment is a file of the log.
+type segment struct {
+	base uint64 // number of the first element pushed in the segment
+	path string
+}
+
+// WALQueue is a FIFO queue whose operations are recorded in a log on disk.
+// Push and Pop implement Interface and cannot return an error: the first
+// error of the log stops the queue, which then no longer changes, and is
+// reported by Err.
+type WALQueue[T any] struct {
+	dir         string
+	segmentSize int64
+	encode      func(T) ([]byte, error)
+	decode      func([]byte) (T, error)
+
+	segments []segment
+	active   *os.File // file of the last segment
+	size     int64    // size of the active segment
+
+	items []T
+	head  uint64 // number of the element at the front
+	tail  uint64 // number of the next element pushed
+	err   error
+}
+
+// OpenWALQueue opens the queue logged in dir, creating the directory if
+// needed, and recovers its elements. Elements are turned into log records by
+// encode and back by decode. A new segment is started once the current one
+// holds segmentSize bytes, or DefaultSegmentSize if segmentSize is below 1.
+func OpenWALQueue[T any](dir string, encode func(T) ([]byte, error), decode func([]byte) (T, error), segmentSize int64) (*WALQueue[T], error) {
+	if segmentSize < 1 {
+		segmentSize = DefaultSegmentSize
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	q := &WALQueue[T]{dir: dir, segmentSize: segmentSize, encode: encode, decode: decode}
+	if err := q.recover(); err != nil {
+		return nil, err
+	}
+	return q, nil
+}
+
+// recover replays the segments of the log.
+func (q *WALQueue[T]) recover() error {
+	entries, err := os.ReadDir(q.dir)
+	if err != nil {
+		return err
+	}
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasSuffix(name, segmentExt) {
+			continue
+		}
+		base, err := strconv.ParseUint(strings.TrimSuffix(name, segmentExt), 16, 64)
+		if err != nil {
+			continue
+		}
+		q.segments = append(q.segments, segment{base, filepath.Join(q.dir, name)})
+	}
+	sort.Slice(q.segments, func(i, j int) bool { return q.segments[i].base < q.segments[j].base })
+	if len(q.segments) == 0 {
+		return q.startSegment()
+	}
+
+	q.head, q.tail = q.segments[0].base, q.segments[0].base
+	var live [][]byte // records of the elements numbered head to tail-1
+	for i, s := range q.segments {
+		if s.base != q.tail {
+			return fmt.Errorf("%w: segment %s starts at element %d, want %d", ErrCorruptLog, s.path, s.base, q.tail)
+		}
+		data, err := os.ReadFile(s.path)
+		if err != nil {
+			return err
+		}
+		last := i == len(q.segments)-1
+		end := 0
+		for end < len(data) {
+			kind, payload, n := readRecord(data[end:])
+			if n == 0 {
+				if !last {
+					return fmt.Errorf("%w: bad record at offset %d of %s", ErrCorruptLog, end, s.path)
+				}
+				break
+			}
+			switch kind {
+			case recordPush:
+				live = append(live, payload)
+				q.tail++
+			case recordPop:
+				seq := binary.BigEndian.Uint64(payload)
+				// pops of elements of deleted segments are skipped
+				if seq >= q.head {
+					if seq != q.head || len(live) == 0 {
+						return fmt.Errorf("%w: pop of element %d at offset %d of %s", ErrCorruptLog, seq, end, s.path)
+					}
+					live = live[1:]
+					q.head++
+				}
+			}
+			end += n
+		}
+		if last {
+			// cut off the torn tail, if any, and append after it
+			f, err := os.OpenFile(s.path, os.O_RDWR, 0o644)
+			if err != nil {
+				return err
+			}
+			if err := f.Truncate(int64(end)); err != nil {
+				f.Close()
+				return err
+			}
+			if _, err := f.Seek(int64(end), 0); err != nil {
+				f.Close()
+				return err
+			}
+			q.active, q.size = f, int64(end)
+		}
+	}
+	for _, record := range live {
+		v, err := q.decode(record)
+		if err != nil {
+			q.active.Close()
+			return err
+		}
+		q.items = append(q.items, v)
+	}
+	if err := q.dropSegments(); err != nil {
+		q.active.Close()
+		return err
+	}
+	return nil
+}
+
+// readRecord decodes the record at the start of data and returns its kind,
+// its payload and its size, or a size of 0 if data does not start with a
+// whole valid record.
+func readRecord(data []byte) (byte, []byte, int) {
+	if len(data) < recordHeader+1 {
+		return 0, nil, 0
+	}
+	sum := binary.BigEndian.Uint32(data)
+	length := binary.BigEndian.Uint32(data[4:])
+	if length < 1 || uint64(length) > uint64(len(data)-recordHeader) {
+		return 0, nil, 0
+	}
+	n := recordHeader + int(length)
+	if crc32.ChecksumIEEE(data[4:n]) != sum {
+		return 0, nil, 0
+	}
+	kind, payload := data[recordHeader], data[recordHeader+1:n]
+	if kind != recordPush && (kind != recordPop || len(payload) != 8) {
+		return 0, nil, 0
+	}
+	return kind, payload, n
+}
+
+// startSegment creates a new segment starting at the next element pushed and
+// makes it the active one.
+func (q *WALQueue[T]) startSegment() error {
+	path := filepath.Join(q.dir, fmt.Sprintf("%016x%s", q.tail, segmentExt))
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0o644)
+	if err != nil {
+		return err
+	}
+	if q.active != nil {
+		if err := q.active.Close(); err != nil {
+			f.Close()
+			return err
+		}
+	}
+	q.segments = append(q.segments, segment{q.tail, path})
+	q.active, q.size = f, 0
+	return nil
+}
+
+// dropSegments deletes the segments all of whose elements have been popped.
+func (q *WALQueue[T]) dropSegments() error {
+	for len(q.segments) > 1 && q.segments[1].base <= q.head {
+		if err := os.Remove(q.segments[0].path); err != nil {
+			return err
+		}
+		q.segments = q.segments[1:]
+	}
+	return nil
+}
+
+// write appends a record to the active segment. On failure the segment is
+// cut back to its previous size so that no torn record is left in the middle
+// of the log.
+func (q *WALQueue[T]) write(kind byte, payload []byte) error {
+	record := make([]byte, recordHeader+1+len(payload))
+	binary.BigEndian.PutUint32(record[4:], uint32(1+len(payload)))
+	record[recordHeader] = kind
+	copy(record[recordHeader+1:], payload)
+	binary.BigEndian.PutUint32(record, crc32.ChecksumIEEE(record[4:]))
+	if _, err := q.active.Write(record); err != nil {
+		if terr := q.active.Truncate(q.size); terr == nil {
+			q.active.Seek(q.size, 0)
+		}
+		return err
+	}
+	q.size += int64(len(record))
+	return nil
+}
+
+// fail records the first error of the queue.
+func (q *WALQueue[T]) fail(err error) {
+	if q.err == nil {
+		q.err = err
+	}
+}
+
+// Push appends v to the log and adds it to the back of the queue. Nothing is
+// done once the queue has failed.
+func (q *WALQueue[T]) Push(v T) {
+	if q.err != nil {
+		return
+	}
+	payload, err := q.encode(v)
+	if err != nil {
+		q.fail(err)
+		return
+	}
+	// a segment holding no push yet is never left, so that segments have
+	// distinct names
+	if q.size >= q.segmentSize && q.segments[len(q.segments)-1].base < q.tail {
+		if err := q.startSegment(); err != nil {
+			q.fail(err)
+			return
+		}
+	}
+	if err := q.write(recordPush, payload); err != nil {
+		q.fail(err)
+		return
+	}
+	q.items = append(q.items, v)
+	q.tail++
+}
+
+// Pop logs the removal of the element at the front of the queue, removes it
+// and returns it. The second return value is false when the queue is empty or
+// has failed.
+func (q *WALQueue[T]) Pop() (T, bool) {
+	v, ok := q.Front()
+	if !ok {
+		return v, false
+	}
+	var seq [8]byte
+	binary.BigEndian.PutUint64(seq[:], q.head)
+	if err := q.write(recordPop, seq[:]); err != nil {
+		q.fail(err)
+		var zero T
+		return zero, false
+	}
+	var zero T
+	q.items[0] = zero // let the element be collected
+	q.items = q.items[1:]
+	q.head++
+	if err := q.dropSegments(); err != nil {
+		q.fail(err)
+	}
+	return v, true
+}
+
+// Front returns the element at the front of the queue without removing it.
+// The second return value is false when the queue is empty or has failed.
+func (q *WALQueue[T]) Front() (T, bool) {
+	if q.err != nil || len(q.items) == 0 {
+		var zero T
+		return zero, false
+	}
+	return q.items[0], true
+}
+
+// Len returns the number of elements in the queue.
+func (q *WALQueue[T]) Len() int {
+	return len(q.items)
+}
+
+// Err returns the first error met by the queue, or nil. The queue stops
+// changing after an error; it may be reopened to recover from its log.
+func (q *WALQueue[T]) Err() error {
+	return q.err
+}
+
+// Sync flushes the log to the disk.
+func (q *WALQueue[T]) Sync() error {
+	if q.err != nil {
+		return q.err
+	}
+	return q.active.Sync()
+}
+
+// Close flushes the log to the disk and closes it. The queue is not usable
+// afterwards.
+func (q *WALQueue[T]) Close() error {
+	if q.err == ErrClosed {
+		return ErrClosed
+	}
+	err := q.active.Sync()
+	if cerr := q.active.Close(); err == nil {
+		err = cerr
+	}
+	q.err = ErrClosed
+	return err
+}
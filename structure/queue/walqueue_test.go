@@ -0,0 +1,186 @@
+package queue
+
+import (
+	"errors"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+)
+
+func encodeInt(v int) ([]byte, error) {
+	return []byte(strconv.Itoa(v)), nil
+}
+
+func decodeInt(b []byte) (int, error) {
+	return strconv.Atoi(string(b))
+}
+
+func openIntQueue(t *testing.T, dir string, segmentSize int64) *WALQueue[int] {
+	t.Helper()
+	q, err := OpenWALQueue(dir, encodeInt, decodeInt, segmentSize)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return q
+}
+
+// segmentFiles returns the paths of the segments in dir.
+func segmentFiles(t *testing.T, dir string) []string {
+	t.Helper()
+	paths, err := filepath.Glob(filepath.Join(dir, "*"+segmentExt))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return paths
+}
+
+func TestWALQueue(t *testing.T) {
+	dir := t.TempDir()
+	q := openIntQueue(t, dir, 0)
+	if _, ok := q.Pop(); ok {
+		t.Errorf("Pop of an empty queue should report false")
+	}
+	for i := 1; i <= 5; i++ {
+		q.Push(i)
+	}
+	if v, ok := q.Pop(); !ok || v != 1 {
+		t.Errorf("Pop() = %d, %t, want 1, true", v, ok)
+	}
+	if err := q.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := q.Front(); ok || !errors.Is(q.Err(), ErrClosed) {
+		t.Errorf("Front after Close = %t, Err = %v", ok, q.Err())
+	}
+
+	q = openIntQueue(t, dir, 0)
+	if q.Len() != 4 {
+		t.Fatalf("reopened Len() = %d, want 4", q.Len())
+	}
+	for want := 2; want <= 5; want++ {
+		if v, ok := q.Pop(); !ok || v != want {
+			t.Fatalf("reopened Pop() = %d, %t, want %d, true", v, ok, want)
+		}
+	}
+	if err := q.Close(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestWALQueueSegments(t *testing.T) {
+	dir := t.TempDir()
+	q := openIntQueue(t, dir, 64)
+	for i := 0; i < 100; i++ {
+		q.Push(i)
+	}
+	if n := len(segmentFiles(t, dir)); n < 5 {
+		t.Fatalf("%d segments for 100 pushes of 64-byte segments", n)
+	}
+	for i := 0; i < 95; i++ {
+		q.Pop()
+	}
+	if n := len(segmentFiles(t, dir)); n > 2 {
+		t.Errorf("%d segments left with 5 elements in the queue", n)
+	}
+	if err := q.Err(); err != nil {
+		t.Fatal(err)
+	}
+	q.Close()
+
+	q = openIntQueue(t, dir, 64)
+	defer q.Close()
+	for want := 95; want < 100; want++ {
+		if v, ok := q.Pop(); !ok || v != want {
+			t.Fatalf("reopened Pop() = %d, %t, want %d, true", v, ok, want)
+		}
+	}
+	if q.Len() != 0 {
+		t.Errorf("Len() = %d after popping everything", q.Len())
+	}
+}
+
+func TestWALQueueTornTail(t *testing.T) {
+	dir := t.TempDir()
+	q := openIntQueue(t, dir, 0)
+	q.Push(10)
+	q.Push(20)
+	q.Close()
+	files := segmentFiles(t, dir)
+	info, err := os.Stat(files[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	// a crash in the middle of the second push
+	if err := os.Truncate(files[0], info.Size()-1); err != nil {
+		t.Fatal(err)
+	}
+	q = openIntQueue(t, dir, 0)
+	if q.Len() != 1 {
+		t.Fatalf("Len() = %d after a torn push, want 1", q.Len())
+	}
+	// pushes go after the cut
+	q.Push(30)
+	q.Close()
+	q = openIntQueue(t, dir, 0)
+	defer q.Close()
+	for _, want := range []int{10, 30} {
+		if v, ok := q.Pop(); !ok || v != want {
+			t.Fatalf("Pop() = %d, %t, want %d, true", v, ok, want)
+		}
+	}
+}
+
+func TestWALQueueCorruption(t *testing.T) {
+	dir := t.TempDir()
+	q := openIntQueue(t, dir, 16)
+	for i := 0; i < 10; i++ {
+		q.Push(i)
+	}
+	q.Close()
+	files := segmentFiles(t, dir)
+	data, err := os.ReadFile(files[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	data[len(data)-1] ^= 1
+	if err := os.WriteFile(files[0], data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := OpenWALQueue(dir, encodeInt, decodeInt, 16); !errors.Is(err, ErrCorruptLog) {
+		t.Errorf("OpenWALQueue of a corrupt segment error = %v, want ErrCorruptLog", err)
+	}
+}
+
+func TestWALQueueRandom(t *testing.T) {
+	dir := t.TempDir()
+	rnd := rand.New(rand.NewSource(1))
+	q := openIntQueue(t, dir, 100)
+	model := NewTwoStackQueue[int]()
+	for i := 0; i < 3000; i++ {
+		switch r := rnd.Intn(10); {
+		case r < 5:
+			q.Push(i)
+			model.Push(i)
+		case r < 9:
+			got, gotOK := q.Pop()
+			want, wantOK := model.Pop()
+			if got != want || gotOK != wantOK {
+				t.Fatalf("Pop() = %d, %t, want %d, %t", got, gotOK, want, wantOK)
+			}
+		default:
+			if err := q.Close(); err != nil {
+				t.Fatal(err)
+			}
+			q = openIntQueue(t, dir, 100)
+		}
+		if q.Len() != model.Len() {
+			t.Fatalf("Len() = %d, want %d", q.Len(), model.Len())
+		}
+	}
+	if err := q.Err(); err != nil {
+		t.Fatal(err)
+	}
+	q.Close()
+}
@@ -0,0 +1,202 @@
+// rangemode.go
+// description: Range majority and range mode queries on a static array
+// details:
+// A segment tree answers queries whose answer for a range is computed from
+// the answers for two halves, like a sum or a minimum. The most frequent
+// value of a range, its mode, is not: it may be frequent in neither half.
+// - The majority of a range, a value filling more than half of it, is found
+// with the Boyer-Moore vote: every half has a candidate and a margin of
+// votes, and two halves combine by cancelling the votes of different
+// candidates, which no majority survives losing. A segment tree of votes
+// gives the only possible candidate of any range in O(log n), and counting
+// its occurrences in the range, by binary search in the sorted list of its
+// positions, tells whether it is one.
+// - The mode is found exactly in O(sqrt(n)) with linear space, by Chan et
+// al.: the array is cut into about sqrt(n) blocks, and the mode of every run
+// of whole blocks is stored. The mode of a range is either the mode of the
+// whole blocks it covers or one of the O(sqrt(n)) values of its partial
+// blocks at the ends. Each of those can only beat the best count f found so
+// far by occurring f + 1 times, which is checked in O(1) with the rank of
+// each position in the list of positions of its value, and since f only
+// grows, the checks cost O(sqrt(n)) in all.
+// time complexity: O(n sqrt(n)) to build, O(log n) per majority query, O(sqrt(n)) per mode query
+// space complexity: O(n)
+// reference: https://en.wikipedia.org/wiki/Range_mode_query
+// reference: Chan, Durocher, Larsen, Morrison, Wilkinson, Linear-Space Data Structures for Range Mode Query in Arrays, 2014
+// see rangemode_test.go
+
+// Package rangemode answers majority and mode queries on the ranges of a
+// static array.
+package rangemode
+
+import (
+	"errors"
+	"sort"
+)
+
+// ErrRange is returned for a range that is empty or not within the array.
+var ErrRange = errors.New("invalid range")
+
+// vote is the outcome of the Boyer-Moore vote over a range: the candidate
+// and its margin over the other values.
+type vote struct {
+	candidate, margin int
+}
+
+// combine returns the vote of two adjacent ranges.
+func combine(a, b vote) vote {
+	switch {
+	case a.candidate == b.candidate:
+		return vote{a.candidate, a.margin + b.margin}
+	case a.margin >= b.margin:
+		return vote{a.candidate, a.margin - b.margin}
+	}
+	return vote{b.candidate, b.margin - a.margin}
+}
+
+// Array answers range queries on a fixed array of values.
+type Array[T comparable] struct {
+	values    []T       // the distinct values, by id
+	ids       []int     // the id of the value at each index
+	index     map[T]int // the id of each value
+	positions [][]int   // the sorted indices of each id
+	rank      []int     // the rank of each index in the positions of its id
+	votes     []vote    // the segment tree of votes, leaves from len(ids)
+	block     int       // the length of a block
+	modes     []vote    // the mode and count of blocks i to j at i*blocks+j
+}
+
+// New creates the structure of values, which it copies.
+func New[T comparable](values []T) *Array[T] {
+	n := len(values)
+	a := &Array[T]{ids: make([]int, n), index: map[T]int{}, rank: make([]int, n)}
+	for i, v := range values {
+		id, ok := a.index[v]
+		if !ok {
+			id = len(a.values)
+			a.index[v] = id
+			a.values = append(a.values, v)
+			a.positions = append(a.positions, nil)
+		}
+		a.ids[i] = id
+		a.rank[i] = len(a.positions[id])
+		a.positions[id] = append(a.positions[id], i)
+	}
+
+	a.votes = make([]vote, 2*n)
+	for i, id := range a.ids {
+		a.votes[n+i] = vote{id, 1}
+	}
+	for i := n - 1; i > 0; i-- {
+		a.votes[i] = combine(a.votes[2*i], a.votes[2*i+1])
+	}
+
+	a.block = 1
+	for a.block*a.block < n {
+		a.block++
+	}
+	blocks := (n + a.block - 1) / a.block
+	a.modes = make([]vote, blocks*blocks)
+	count := make([]int, len(a.values))
+	for i := 0; i < blocks; i++ {
+		best := vote{}
+		for j := i * a.block; j < n; j++ {
+			id := a.ids[j]
+			count[id]++
+			if count[id] > best.margin {
+				best = vote{id, count[id]}
+			}
+			if (j+1)%a.block == 0 || j == n-1 {
+				a.modes[i*blocks+j/a.block] = best
+			}
+		}
+		for j := i * a.block; j < n; j++ {
+			count[a.ids[j]] = 0
+		}
+	}
+	return a
+}
+
+// Len returns the length of the array.
+func (a *Array[T]) Len() int {
+	return len(a.ids)
+}
+
+// Frequency returns the number of occurrences of v at the indices from l to
+// r-1. It returns ErrRange unless 0 <= l <= r <= Len().
+func (a *Array[T]) Frequency(v T, l, r int) (int, error) {
+	if l < 0 || l > r || r > len(a.ids) {
+		return 0, ErrRange
+	}
+	id, ok := a.index[v]
+	if !ok {
+		return 0, nil
+	}
+	return a.count(id, l, r), nil
+}
+
+// count returns the number of occurrences of id in [l, r).
+func (a *Array[T]) count(id, l, r int) int {
+	p := a.positions[id]
+	return sort.SearchInts(p, r) - sort.SearchInts(p, l)
+}
+
+// Majority returns the value filling more than half of the indices from l
+// to r-1, and false if there is none. It returns ErrRange unless
+// 0 <= l < r <= Len().
+func (a *Array[T]) Majority(l, r int) (T, bool, error) {
+	var zero T
+	if l < 0 || l >= r || r > len(a.ids) {
+		return zero, false, ErrRange
+	}
+	// the votes of the left and right ends, combined in order
+	var left, right vote
+	n := len(a.ids)
+	for i, j := l+n, r+n; i < j; i, j = i/2, j/2 {
+		if i&1 == 1 {
+			left = combine(left, a.votes[i])
+			i++
+		}
+		if j&1 == 1 {
+			j--
+			right = combine(a.votes[j], right)
+		}
+	}
+	candidate := combine(left, right).candidate
+	if 2*a.count(candidate, l, r) > r-l {
+		return a.values[candidate], true, nil
+	}
+	return zero, false, nil
+}
+
+// Mode returns a most frequent value at the indices from l to r-1 and its
+// number of occurrences there. It returns ErrRange unless
+// 0 <= l < r <= Len().
+func (a *Array[T]) Mode(l, r int) (T, int, error) {
+	if l < 0 || l >= r || r > len(a.ids) {
+		var zero T
+		return zero, 0, ErrRange
+	}
+	blocks := (len(a.ids) + a.block - 1) / a.block
+	// the whole blocks first to last, and the partial ones around them
+	first, last := (l+a.block-1)/a.block, r/a.block-1
+	best := vote{a.ids[l], 0}
+	prefixEnd, suffixStart := r, r
+	if first <= last {
+		best = a.modes[first*blocks+last]
+		prefixEnd, suffixStart = first*a.block, (last+1)*a.block
+	}
+	for i := l; i < prefixEnd; i++ {
+		id, p := a.ids[i], a.positions[a.ids[i]]
+		for a.rank[i]+best.margin < len(p) && p[a.rank[i]+best.margin] < r {
+			best = vote{id, best.margin + 1}
+		}
+	}
+	for i := suffixStart; i < r; i++ {
+		id, p := a.ids[i], a.positions[a.ids[i]]
+		for a.rank[i]-best.margin >= 0 && p[a.rank[i]-best.margin] >= l {
+			best = vote{id, best.margin + 1}
+		}
+	}
+	return a.values[best.candidate], best.margin, nil
+}
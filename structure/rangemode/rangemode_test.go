@@ -0,0 +1,117 @@
+package rangemode
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+func TestMajority(t *testing.T) {
+	a := New([]string{"a", "b", "a", "a", "c", "b", "b", "b"})
+	tests := []struct {
+		l, r int
+		want string
+		ok   bool
+	}{
+		{0, 4, "a", true},
+		{0, 8, "", false},
+		{4, 8, "b", true},
+		{1, 3, "", false},
+		{2, 3, "a", true},
+		{3, 6, "", false},
+	}
+	for _, test := range tests {
+		got, ok, err := a.Majority(test.l, test.r)
+		if err != nil || got != test.want || ok != test.ok {
+			t.Errorf("Majority(%d, %d) = %q, %v, %v, want %q, %v", test.l, test.r, got, ok, err, test.want, test.ok)
+		}
+	}
+}
+
+func TestMode(t *testing.T) {
+	a := New([]int{1, 2, 2, 3, 3, 3, 1, 1, 1, 1})
+	tests := []struct{ l, r, want, count int }{
+		{0, 10, 1, 5},
+		{0, 6, 3, 3},
+		{1, 3, 2, 2},
+		{5, 6, 3, 1},
+	}
+	for _, test := range tests {
+		got, count, err := a.Mode(test.l, test.r)
+		if err != nil || got != test.want || count != test.count {
+			t.Errorf("Mode(%d, %d) = %d, %d, %v, want %d, %d", test.l, test.r, got, count, err, test.want, test.count)
+		}
+	}
+}
+
+func TestInvalidRange(t *testing.T) {
+	a := New([]int{1, 2, 3})
+	for _, r := range [][2]int{{-1, 2}, {2, 2}, {2, 1}, {0, 4}} {
+		if _, _, err := a.Majority(r[0], r[1]); !errors.Is(err, ErrRange) {
+			t.Errorf("Majority(%d, %d) error = %v", r[0], r[1], err)
+		}
+		if _, _, err := a.Mode(r[0], r[1]); !errors.Is(err, ErrRange) {
+			t.Errorf("Mode(%d, %d) error = %v", r[0], r[1], err)
+		}
+	}
+	if n, err := a.Frequency(1, 2, 2); n != 0 || err != nil {
+		t.Errorf("Frequency on an empty range = %d, %v", n, err)
+	}
+	if _, err := a.Frequency(1, 0, 4); !errors.Is(err, ErrRange) {
+		t.Errorf("Frequency(1, 0, 4) error = %v", err)
+	}
+	if _, _, err := New[int](nil).Mode(0, 0); !errors.Is(err, ErrRange) {
+		t.Errorf("Mode on an empty array error = %v", err)
+	}
+}
+
+func TestRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for iter := 0; iter < 200; iter++ {
+		values := make([]int, 1+rnd.Intn(60))
+		distinct := 1 + rnd.Intn(6)
+		for i := range values {
+			values[i] = rnd.Intn(distinct)
+		}
+		a := New(values)
+		if a.Len() != len(values) {
+			t.Fatalf("Len() = %d, want %d", a.Len(), len(values))
+		}
+		for l := 0; l < len(values); l++ {
+			count := make(map[int]int)
+			best := 0
+			for r := l + 1; r <= len(values); r++ {
+				count[values[r-1]]++
+				if count[values[r-1]] > best {
+					best = count[values[r-1]]
+				}
+				mode, n, err := a.Mode(l, r)
+				if err != nil || n != best || count[mode] != best {
+					t.Fatalf("Mode(%d, %d) of %v = %d, %d, %v, want a count of %d", l, r, values, mode, n, err, best)
+				}
+				majority, ok, err := a.Majority(l, r)
+				if want := 2*best > r-l; err != nil || ok != want || ok && majority != mode {
+					t.Fatalf("Majority(%d, %d) of %v = %d, %v, %v, want %v", l, r, values, majority, ok, err, want)
+				}
+				v := rnd.Intn(distinct + 1)
+				if f, err := a.Frequency(v, l, r); err != nil || f != count[v] {
+					t.Fatalf("Frequency(%d, %d, %d) of %v = %d, %v, want %d", v, l, r, values, f, err, count[v])
+				}
+			}
+		}
+	}
+}
+
+func BenchmarkMode(b *testing.B) {
+	rnd := rand.New(rand.NewSource(1))
+	values := make([]int, 1<<16)
+	for i := range values {
+		values[i] = rnd.Intn(1000)
+	}
+	a := New(values)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		l := rnd.Intn(len(values))
+		_, _, _ = a.Mode(l, l+1+rnd.Intn(len(values)-l))
+	}
+}
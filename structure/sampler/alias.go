@@ -0,0 +1,101 @@
+// alias.go
+// description: Walker's alias method for sampling from a fixed discrete distribution
+// details:
+// Every index gets a column of height 1 in a table of n columns. Column i keeps
+// probability prob[i] for index i itself and gives the rest to alias[i]. The table
+// is built with Vose's method: weights are scaled so that they average 1, then
+// a column under 1 is repeatedly topped up by one over 1, until every column is full.
+// Sampling picks a column uniformly and then either its own index or its alias.
+// Build: O(n)
+// Sample: O(1)
+// reference: https://en.wikipedia.org/wiki/Alias_method, https://www.keithschwarz.com/darts-dice-coins/
+// see sampler_test.go
+
+package sampler
+
+import "math/rand"
+
+// Alias samples from a fixed weighted distribution in constant time.
+type Alias struct {
+	prob    []float64
+	alias   []int
+	weights []float64
+	rnd     *rand.Rand
+}
+
+// NewAlias builds the alias table of weights. Random numbers are drawn from
+// a source seeded with seed.
+func NewAlias(weights []float64, seed int64) (*Alias, error) {
+	n := len(weights)
+	var total float64
+	for _, w := range weights {
+		if !validWeight(w) {
+			return nil, ErrNegativeWeight
+		}
+		total += w
+	}
+	if total <= 0 {
+		return nil, ErrZeroWeight
+	}
+
+	a := &Alias{
+		prob:    make([]float64, n),
+		alias:   make([]int, n),
+		weights: append([]float64(nil), weights...),
+		rnd:     rand.New(rand.NewSource(seed)),
+	}
+	scaled := make([]float64, n)
+	var small, large []int
+	for i, w := range weights {
+		scaled[i] = w * float64(n) / total
+		if scaled[i] < 1 {
+			small = append(small, i)
+		} else {
+			large = append(large, i)
+		}
+	}
+	for len(small) > 0 && len(large) > 0 {
+		s, l := small[len(small)-1], large[len(large)-1]
+		small = small[:len(small)-1]
+		a.prob[s] = scaled[s]
+		a.alias[s] = l
+		// l gives away what s lacks and may drop below 1 itself.
+		scaled[l] -= 1 - scaled[s]
+		if scaled[l] < 1 {
+			large = large[:len(large)-1]
+			small = append(small, l)
+		}
+	}
+	// Whatever is left is full up to rounding errors.
+	for _, i := range large {
+		a.prob[i] = 1
+		a.alias[i] = i
+	}
+	for _, i := range small {
+		a.prob[i] = 1
+		a.alias[i] = i
+	}
+	return a, nil
+}
+
+// Sample returns a random index drawn with probability proportional to its weight.
+func (a *Alias) Sample() int {
+	i := a.rnd.Intn(len(a.prob))
+	if a.rnd.Float64() < a.prob[i] {
+		return i
+	}
+	return a.alias[i]
+}
+
+// Len returns the number of weights.
+func (a *Alias) Len() int {
+	return len(a.weights)
+}
+
+// Weight returns the weight of index i, or 0 when i is out of range.
+func (a *Alias) Weight(i int) float64 {
+	if i < 0 || i >= len(a.weights) {
+		return 0
+	}
+	return a.weights[i]
+}
@@ -0,0 +1,116 @@
+// fenwick.go
+// description: Weighted random sampler supporting weight updates
+// details:
+// The weights are stored in a Fenwick tree (binary indexed tree) of prefix sums.
+// To sample, a uniform value u in [0, total) is drawn and the first index whose
+// prefix sum exceeds u is found by descending the tree one power of two at a
+// time, which takes O(log n) without a separate binary search.
+// Build: O(n)
+// Sample, Update: O(log n)
+// reference: https://en.wikipedia.org/wiki/Fenwick_tree
+// see sampler_test.go
+
+package sampler
+
+import "math/rand"
+
+// Fenwick samples from a weighted distribution whose weights may change.
+type Fenwick struct {
+	tree    []float64 // tree[i] holds the sum of weights (i - i&-i, i], one based.
+	weights []float64
+	rnd     *rand.Rand
+}
+
+// NewFenwick builds a sampler over weights. Unlike NewAlias all weights may
+// be zero, in which case Sample returns -1 until one is raised. Random numbers
+// are drawn from a source seeded with seed.
+func NewFenwick(weights []float64, seed int64) (*Fenwick, error) {
+	n := len(weights)
+	f := &Fenwick{
+		tree:    make([]float64, n+1),
+		weights: append([]float64(nil), weights...),
+		rnd:     rand.New(rand.NewSource(seed)),
+	}
+	for i, w := range weights {
+		if !validWeight(w) {
+			return nil, ErrNegativeWeight
+		}
+		f.tree[i+1] += w
+		if next := (i + 1) + ((i + 1) & -(i + 1)); next <= n {
+			f.tree[next] += f.tree[i+1]
+		}
+	}
+	return f, nil
+}
+
+// Len returns the number of weights.
+func (f *Fenwick) Len() int {
+	return len(f.weights)
+}
+
+// Weight returns the weight of index i, or 0 when i is out of range.
+func (f *Fenwick) Weight(i int) float64 {
+	if i < 0 || i >= len(f.weights) {
+		return 0
+	}
+	return f.weights[i]
+}
+
+// Total returns the sum of all weights.
+func (f *Fenwick) Total() float64 {
+	var sum float64
+	for i := len(f.weights); i > 0; i -= i & -i {
+		sum += f.tree[i]
+	}
+	return sum
+}
+
+// Update sets the weight of index i to w.
+func (f *Fenwick) Update(i int, w float64) error {
+	if i < 0 || i >= len(f.weights) {
+		return ErrIndexOutOfRange
+	}
+	if !validWeight(w) {
+		return ErrNegativeWeight
+	}
+	delta := w - f.weights[i]
+	f.weights[i] = w
+	for j := i + 1; j < len(f.tree); j += j & -j {
+		f.tree[j] += delta
+	}
+	return nil
+}
+
+// Sample returns a random index drawn with probability proportional to its
+// weight, or -1 when every weight is zero.
+func (f *Fenwick) Sample() int {
+	total := f.Total()
+	if total <= 0 {
+		return -1
+	}
+	return f.find(f.rnd.Float64() * total)
+}
+
+// find returns the first index whose prefix sum of weights exceeds u.
+func (f *Fenwick) find(u float64) int {
+	n := len(f.weights)
+	step := 1
+	for step*2 <= n {
+		step *= 2
+	}
+	pos := 0
+	for ; step > 0; step /= 2 {
+		if next := pos + step; next <= n && f.tree[next] <= u {
+			pos = next
+			u -= f.tree[next]
+		}
+	}
+	// Rounding may carry u past the last positive weight.
+	for pos >= n || f.weights[pos] == 0 {
+		pos--
+		if pos < 0 {
+			return -1
+		}
+	}
+	return pos
+}
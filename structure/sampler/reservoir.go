@@ -0,0 +1,151 @@
+// reservoir.go
+// description: Reservoir sampling of a stream of unknown length
+// details:
+// A reservoir keeps k items of a stream such that, after n items, every set
+// of k of them is equally likely to be kept, without knowing n in advance
+// and with O(k) memory.
+// Algorithm R keeps the first k items, then keeps item i (counting from 1)
+// with probability k/i, in place of a random kept item. It draws a random
+// number for every item.
+// Algorithm L computes how many items will be rejected before the next one
+// is kept: the largest of k uniform random numbers attached to the items
+// evolves as w *= U^(1/k), and the gap to the next item beating it follows a
+// geometric distribution with parameter w. Only O(k (1 + log(n/k))) random
+// numbers are drawn, so skipping is nearly free.
+// Add: O(1) each
+// space complexity: O(k)
+// reference: https://en.wikipedia.org/wiki/Reservoir_sampling
+// reference: Li, "Reservoir-Sampling Algorithms of Time Complexity O(n(1+log(N/n)))", 1994
+// see reservoir_test.go
+
+package sampler
+
+import (
+	"math"
+	"math/rand"
+)
+
+// ReservoirSampler keeps a uniform random sample of the items added to it.
+type ReservoirSampler[T any] interface {
+	// Add offers the next item of the stream.
+	Add(item T)
+	// Sample returns a copy of the items kept: min(k, Seen()) of them.
+	Sample() []T
+	// Seen returns the number of items added so far.
+	Seen() int
+}
+
+var (
+	_ ReservoirSampler[int] = (*Reservoir[int])(nil)
+	_ ReservoirSampler[int] = (*SkipReservoir[int])(nil)
+)
+
+// SampleStream adds every item returned by next to r, until next reports
+// false, and returns the sample.
+func SampleStream[T any](r ReservoirSampler[T], next func() (T, bool)) []T {
+	for item, ok := next(); ok; item, ok = next() {
+		r.Add(item)
+	}
+	return r.Sample()
+}
+
+// Reservoir samples k items of a stream with algorithm R.
+type Reservoir[T any] struct {
+	items []T
+	k     int
+	seen  int
+	rnd   *rand.Rand
+}
+
+// NewReservoir returns an empty reservoir of k items. Random numbers are
+// drawn from a source seeded with seed.
+func NewReservoir[T any](k int, seed int64) (*Reservoir[T], error) {
+	if k <= 0 {
+		return nil, ErrInvalidSize
+	}
+	return &Reservoir[T]{items: make([]T, 0, k), k: k, rnd: rand.New(rand.NewSource(seed))}, nil
+}
+
+// Add offers the next item of the stream.
+func (r *Reservoir[T]) Add(item T) {
+	r.seen++
+	if len(r.items) < r.k {
+		r.items = append(r.items, item)
+		return
+	}
+	if j := r.rnd.Intn(r.seen); j < r.k {
+		r.items[j] = item
+	}
+}
+
+// Sample returns a copy of the items kept.
+func (r *Reservoir[T]) Sample() []T {
+	return append([]T(nil), r.items...)
+}
+
+// Seen returns the number of items added so far.
+func (r *Reservoir[T]) Seen() int {
+	return r.seen
+}
+
+// SkipReservoir samples k items of a stream with algorithm L.
+type SkipReservoir[T any] struct {
+	items []T
+	k     int
+	seen  int
+	next  int     // number of the next item to keep, counting from 0
+	w     float64 // largest random key among the kept items
+	rnd   *rand.Rand
+}
+
+// NewSkipReservoir returns an empty reservoir of k items. Random numbers are
+// drawn from a source seeded with seed.
+func NewSkipReservoir[T any](k int, seed int64) (*SkipReservoir[T], error) {
+	if k <= 0 {
+		return nil, ErrInvalidSize
+	}
+	return &SkipReservoir[T]{items: make([]T, 0, k), k: k, rnd: rand.New(rand.NewSource(seed))}, nil
+}
+
+// uniform returns a random number in (0, 1].
+func (r *SkipReservoir[T]) uniform() float64 {
+	return 1 - r.rnd.Float64()
+}
+
+// skip draws the next random key and the number of the next item to keep.
+func (r *SkipReservoir[T]) skip() {
+	r.w *= math.Exp(math.Log(r.uniform()) / float64(r.k))
+	gap := math.Floor(math.Log(r.uniform())/math.Log1p(-r.w)) + 1
+	if gap > math.MaxInt32 {
+		// Far enough for any stream, and clear of overflows.
+		gap = math.MaxInt32
+	}
+	r.next += int(gap)
+}
+
+// Add offers the next item of the stream.
+func (r *SkipReservoir[T]) Add(item T) {
+	i := r.seen
+	r.seen++
+	switch {
+	case i < r.k:
+		r.items = append(r.items, item)
+		if i == r.k-1 {
+			r.w, r.next = 1, i
+			r.skip()
+		}
+	case i == r.next:
+		r.items[r.rnd.Intn(r.k)] = item
+		r.skip()
+	}
+}
+
+// Sample returns a copy of the items kept.
+func (r *SkipReservoir[T]) Sample() []T {
+	return append([]T(nil), r.items...)
+}
+
+// Seen returns the number of items added so far.
+func (r *SkipReservoir[T]) Seen() int {
+	return r.seen
+}
@@ -0,0 +1,108 @@
+package sampler_test
+
+import (
+	"math"
+	"sort"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/structure/sampler"
+)
+
+// reservoirs builds a reservoir of every kind.
+var reservoirs = map[string]func(k int, seed int64) (sampler.ReservoirSampler[int], error){
+	"R": func(k int, seed int64) (sampler.ReservoirSampler[int], error) {
+		return sampler.NewReservoir[int](k, seed)
+	},
+	"L": func(k int, seed int64) (sampler.ReservoirSampler[int], error) {
+		return sampler.NewSkipReservoir[int](k, seed)
+	},
+}
+
+// count returns a stream of the numbers from 0 to n-1.
+func count(n int) func() (int, bool) {
+	i := 0
+	return func() (int, bool) {
+		if i == n {
+			return 0, false
+		}
+		i++
+		return i - 1, true
+	}
+}
+
+func TestReservoirUniform(t *testing.T) {
+	const n, k, trials = 50, 5, 40000
+	for name, newReservoir := range reservoirs {
+		counts := make([]int, n)
+		for seed := int64(0); seed < trials; seed++ {
+			r, err := newReservoir(k, seed)
+			if err != nil {
+				t.Fatal(err)
+			}
+			sample := sampler.SampleStream(r, count(n))
+			if len(sample) != k || r.Seen() != n {
+				t.Fatalf("%s: sample %v of %d items", name, sample, r.Seen())
+			}
+			sort.Ints(sample)
+			for i, x := range sample {
+				if i > 0 && x == sample[i-1] {
+					t.Fatalf("%s: %d kept twice", name, x)
+				}
+				counts[x]++
+			}
+		}
+		// Every item is kept with probability k/n.
+		p := float64(k) / n
+		sigma := math.Sqrt(trials * p * (1 - p))
+		for x, c := range counts {
+			if math.Abs(float64(c)-trials*p) > 5*sigma {
+				t.Errorf("%s: %d kept %d times, want about %.0f", name, x, c, trials*p)
+			}
+		}
+	}
+}
+
+func TestReservoirShortStream(t *testing.T) {
+	for name, newReservoir := range reservoirs {
+		r, _ := newReservoir(10, 1)
+		if got := sampler.SampleStream(r, count(4)); len(got) != 4 {
+			t.Errorf("%s: sample %v, want the 4 items", name, got)
+		}
+		r, _ = newReservoir(3, 1)
+		if got := r.Sample(); len(got) != 0 {
+			t.Errorf("%s: empty stream gave %v", name, got)
+		}
+		if _, err := newReservoir(0, 1); err != sampler.ErrInvalidSize {
+			t.Errorf("%s: error %v, want %v", name, err, sampler.ErrInvalidSize)
+		}
+	}
+}
+
+func TestSkipReservoirLongStream(t *testing.T) {
+	r, _ := sampler.NewSkipReservoir[int](3, 7)
+	sample := sampler.SampleStream[int](r, count(1000000))
+	for _, x := range sample {
+		if x < 0 || x >= 1000000 {
+			t.Errorf("%d was never added", x)
+		}
+	}
+	if r.Seen() != 1000000 {
+		t.Errorf("seen %d items", r.Seen())
+	}
+}
+
+func benchmarkReservoir(b *testing.B, r sampler.ReservoirSampler[int]) {
+	for i := 0; i < b.N; i++ {
+		r.Add(i)
+	}
+}
+
+func BenchmarkReservoirR(b *testing.B) {
+	r, _ := sampler.NewReservoir[int](100, 1)
+	benchmarkReservoir(b, r)
+}
+
+func BenchmarkReservoirL(b *testing.B) {
+	r, _ := sampler.NewSkipReservoir[int](100, 1)
+	benchmarkReservoir(b, r)
+}
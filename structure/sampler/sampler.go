@@ -0,0 +1,49 @@
+// sampler.go
+// description: Common interface of the weighted random samplers
+// details:
+// A weighted sampler draws index i out of n with probability w[i] / sum(w).
+// Alias is built once in O(n) and samples in O(1), but its weights are fixed.
+// Fenwick keeps prefix sums in a binary indexed tree, so a weight can be changed
+// in O(log n) at the price of O(log n) sampling.
+// Uniform sampling without weights lives in reservoir.go, for streams, and in
+// shuffle.go, for slices.
+// see sampler_test.go
+
+// Package sampler implements structures that draw indices at random with
+// probability proportional to their weights, reservoir sampling of streams
+// and shuffling.
+package sampler
+
+import "errors"
+
+var (
+	// ErrNegativeWeight is returned when a weight is negative, infinite or NaN.
+	ErrNegativeWeight = errors.New("weights must be finite and non-negative")
+	// ErrZeroWeight is returned when the weights do not add up to a positive total.
+	ErrZeroWeight = errors.New("at least one weight must be positive")
+	// ErrIndexOutOfRange is returned when an index does not name a weight.
+	ErrIndexOutOfRange = errors.New("index out of range")
+	// ErrInvalidSize is returned when a sample size is not positive.
+	ErrInvalidSize = errors.New("sample size must be positive")
+)
+
+// Sampler draws indices in [0, Len()) with probability proportional to
+// their weights.
+type Sampler interface {
+	// Sample returns a random index, or -1 when every weight is zero.
+	Sample() int
+	// Len returns the number of weights.
+	Len() int
+	// Weight returns the weight of index i, or 0 when i is out of range.
+	Weight(i int) float64
+}
+
+var (
+	_ Sampler = (*Alias)(nil)
+	_ Sampler = (*Fenwick)(nil)
+)
+
+func validWeight(w float64) bool {
+	// NaN fails both comparisons and +Inf fails the second.
+	return w >= 0 && w-w == 0
+}
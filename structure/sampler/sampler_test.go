@@ -0,0 +1,168 @@
+package sampler_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/structure/sampler"
+)
+
+// checkDistribution draws many samples and compares the observed frequencies
+// with the expected ones.
+func checkDistribution(t *testing.T, s sampler.Sampler, weights []float64) {
+	t.Helper()
+	var total float64
+	for _, w := range weights {
+		total += w
+	}
+	const draws = 200000
+	counts := make([]int, len(weights))
+	for i := 0; i < draws; i++ {
+		idx := s.Sample()
+		if idx < 0 || idx >= len(weights) {
+			t.Fatalf("Sample() = %d, out of range", idx)
+		}
+		counts[idx]++
+	}
+	for i, w := range weights {
+		p := w / total
+		if w == 0 {
+			if counts[i] != 0 {
+				t.Errorf("index %d has weight 0 but was sampled %d times", i, counts[i])
+			}
+			continue
+		}
+		// Allow five standard deviations of the binomial count.
+		sigma := math.Sqrt(draws * p * (1 - p))
+		if diff := math.Abs(float64(counts[i]) - draws*p); diff > 5*sigma+1 {
+			t.Errorf("index %d sampled %d times, want about %.0f", i, counts[i], draws*p)
+		}
+	}
+}
+
+func TestAlias(t *testing.T) {
+	tests := [][]float64{
+		{1},
+		{1, 1, 1, 1},
+		{0.1, 0.2, 0.3, 0.4},
+		{5, 0, 1, 0, 10, 3},
+		{1e-3, 1000, 1, 1},
+	}
+	for _, weights := range tests {
+		a, err := sampler.NewAlias(weights, 1)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if a.Len() != len(weights) || a.Weight(len(weights)-1) != weights[len(weights)-1] {
+			t.Errorf("Len or Weight do not match %v", weights)
+		}
+		checkDistribution(t, a, weights)
+	}
+}
+
+func TestAliasErrors(t *testing.T) {
+	tests := []struct {
+		weights []float64
+		err     error
+	}{
+		{nil, sampler.ErrZeroWeight},
+		{[]float64{0, 0}, sampler.ErrZeroWeight},
+		{[]float64{1, -1}, sampler.ErrNegativeWeight},
+		{[]float64{1, math.NaN()}, sampler.ErrNegativeWeight},
+		{[]float64{1, math.Inf(1)}, sampler.ErrNegativeWeight},
+	}
+	for _, test := range tests {
+		if _, err := sampler.NewAlias(test.weights, 1); err != test.err {
+			t.Errorf("NewAlias(%v) error = %v, want %v", test.weights, err, test.err)
+		}
+	}
+}
+
+func TestFenwick(t *testing.T) {
+	weights := []float64{3, 0, 1, 6, 2, 0, 0, 8}
+	f, err := sampler.NewFenwick(weights, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f.Total() != 20 {
+		t.Errorf("Total() = %v, want 20", f.Total())
+	}
+	checkDistribution(t, f, weights)
+
+	updates := []struct {
+		i int
+		w float64
+	}{{0, 0}, {1, 5}, {7, 1}, {6, 2.5}}
+	for _, u := range updates {
+		if err := f.Update(u.i, u.w); err != nil {
+			t.Fatal(err)
+		}
+		weights[u.i] = u.w
+	}
+	if f.Weight(1) != 5 || f.Total() != 17.5 {
+		t.Errorf("Weight(1) = %v, Total() = %v, want 5 and 17.5", f.Weight(1), f.Total())
+	}
+	checkDistribution(t, f, weights)
+}
+
+func TestFenwickZeroAndErrors(t *testing.T) {
+	f, err := sampler.NewFenwick([]float64{0, 0, 0}, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := f.Sample(); got != -1 {
+		t.Errorf("Sample() with zero weights = %d, want -1", got)
+	}
+	_ = f.Update(2, 1)
+	for i := 0; i < 100; i++ {
+		if got := f.Sample(); got != 2 {
+			t.Fatalf("Sample() = %d, want 2", got)
+		}
+	}
+	if err := f.Update(3, 1); err != sampler.ErrIndexOutOfRange {
+		t.Errorf("Update(3) error = %v, want ErrIndexOutOfRange", err)
+	}
+	if err := f.Update(0, -2); err != sampler.ErrNegativeWeight {
+		t.Errorf("Update(0, -2) error = %v, want ErrNegativeWeight", err)
+	}
+	if _, err := sampler.NewFenwick([]float64{-1}, 1); err != sampler.ErrNegativeWeight {
+		t.Errorf("NewFenwick([-1]) error = %v, want ErrNegativeWeight", err)
+	}
+
+	empty, _ := sampler.NewFenwick(nil, 1)
+	if got := empty.Sample(); got != -1 {
+		t.Errorf("Sample() on an empty sampler = %d, want -1", got)
+	}
+}
+
+func benchmarkWeights(n int) []float64 {
+	weights := make([]float64, n)
+	for i := range weights {
+		weights[i] = float64(i%17 + 1)
+	}
+	return weights
+}
+
+func BenchmarkAliasSample(b *testing.B) {
+	a, _ := sampler.NewAlias(benchmarkWeights(1<<16), 1)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Sample()
+	}
+}
+
+func BenchmarkFenwickSample(b *testing.B) {
+	f, _ := sampler.NewFenwick(benchmarkWeights(1<<16), 1)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		f.Sample()
+	}
+}
+
+func BenchmarkFenwickUpdate(b *testing.B) {
+	f, _ := sampler.NewFenwick(benchmarkWeights(1<<16), 1)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = f.Update(i&(1<<16-1), float64(i%5))
+	}
+}
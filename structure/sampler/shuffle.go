@@ -0,0 +1,37 @@
+// shuffle.go
+// description: Fisher-Yates shuffle
+// details:
+// The Fisher-Yates shuffle walks the slice from the end and swaps every
+// position i with a position drawn uniformly from [0, i]. Each of the n!
+// permutations comes out with the same probability, given a uniform source
+// of random numbers. Shuffled uses the inside-out variant, which builds the
+// shuffled copy in a single pass while reading the input.
+// time complexity: O(n)
+// space complexity: O(1) for Shuffle, O(n) for Shuffled
+// reference: https://en.wikipedia.org/wiki/Fisher%E2%80%93Yates_shuffle
+// see shuffle_test.go
+
+package sampler
+
+import "math/rand"
+
+// Shuffle permutes items in place uniformly at random, drawing random
+// numbers from rnd. Seeding rnd makes the permutation repeatable.
+func Shuffle[T any](items []T, rnd *rand.Rand) {
+	for i := len(items) - 1; i > 0; i-- {
+		j := rnd.Intn(i + 1)
+		items[i], items[j] = items[j], items[i]
+	}
+}
+
+// Shuffled returns a uniformly random permutation of items, leaving items
+// untouched.
+func Shuffled[T any](items []T, rnd *rand.Rand) []T {
+	shuffled := make([]T, len(items))
+	for i, item := range items {
+		j := rnd.Intn(i + 1)
+		shuffled[i] = shuffled[j]
+		shuffled[j] = item
+	}
+	return shuffled
+}
@@ -0,0 +1,61 @@
+package sampler_test
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/structure/sampler"
+)
+
+func TestShuffleUniform(t *testing.T) {
+	const trials = 60000
+	shuffles := map[string]func(items []int, rnd *rand.Rand) []int{
+		"Shuffle": func(items []int, rnd *rand.Rand) []int {
+			sampler.Shuffle(items, rnd)
+			return items
+		},
+		"Shuffled": sampler.Shuffled[int],
+	}
+	for name, shuffle := range shuffles {
+		rnd := rand.New(rand.NewSource(1))
+		counts := map[string]int{}
+		for i := 0; i < trials; i++ {
+			counts[fmt.Sprint(shuffle([]int{1, 2, 3}, rnd))]++
+		}
+		if len(counts) != 6 {
+			t.Errorf("%s: %d permutations of 3 items seen", name, len(counts))
+		}
+		// Each permutation has probability 1/6.
+		p := 1.0 / 6
+		sigma := math.Sqrt(trials * p * (1 - p))
+		for perm, c := range counts {
+			if math.Abs(float64(c)-trials*p) > 5*sigma {
+				t.Errorf("%s: %s seen %d times, want about %.0f", name, perm, c, trials*p)
+			}
+		}
+	}
+}
+
+func TestShuffleSeeded(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	a := sampler.Shuffled(items, rand.New(rand.NewSource(42)))
+	b := sampler.Shuffled(items, rand.New(rand.NewSource(42)))
+	if !reflect.DeepEqual(a, b) {
+		t.Errorf("same seed gave %v and %v", a, b)
+	}
+	if !reflect.DeepEqual(items, []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}) {
+		t.Errorf("Shuffled changed its input to %v", items)
+	}
+	sampler.Shuffle(items, rand.New(rand.NewSource(42)))
+	seen := map[int]bool{}
+	for _, x := range items {
+		seen[x] = true
+	}
+	if len(seen) != 10 {
+		t.Errorf("%v is not a permutation", items)
+	}
+	sampler.Shuffle([]int{}, rand.New(rand.NewSource(1)))
+}
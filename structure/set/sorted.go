@@ -0,0 +1,204 @@
+// sorted.go
+// description: Immutable sorted set built in bulk
+// details:
+// A set that is built once and then only queried does not need a tree of
+// linked nodes rebalanced on every insertion. Sorted is built in O(n) from
+// keys already in increasing order, as a perfectly balanced binary search
+// tree stored in breadth-first order in an array: node i has children 2i and
+// 2i + 1, the Eytzinger layout. A search walks down from node 1 without any
+// pointer to follow, and the top levels, which every search reads, share a
+// few cache lines at the start of the array. The node where the search
+// leaves the tree to the left is the first key not below the one searched,
+// so the same walk gives the rank of any key. The keys are also kept sorted
+// for Select and iteration, and the set operations merge the sorted keys of
+// both sets into a new set.
+// time complexity: O(n) to build, O(log n) to search, O(n + m) for set
+// operations on sets of n and m keys
+// space complexity: O(n)
+// reference: https://en.wikipedia.org/wiki/Binary_search_tree#Optimal_binary_search_trees
+// reference: Khuong, Morin, Array Layouts for Comparison-Based Searching, 2017
+// see sorted_test.go
+
+package set
+
+import (
+	"errors"
+	"math/bits"
+
+	"github.com/TheAlgorithms/Go/constraints"
+	"github.com/TheAlgorithms/Go/structure/iterutil"
+)
+
+// ErrNotSorted is returned by NewSorted for keys out of order.
+var ErrNotSorted = errors.New("keys are not in increasing order")
+
+// Sorted is an immutable set of ordered keys.
+type Sorted[T constraints.Ordered] struct {
+	keys   []T   // in increasing order
+	layout []T   // the tree in breadth-first order, from index 1
+	rank   []int // the position in keys of every node of layout
+}
+
+// NewSorted creates the set of keys, which must be in non-decreasing order,
+// repeated keys being stored once. It copies keys, and returns ErrNotSorted
+// if a key is smaller than the one before it.
+func NewSorted[T constraints.Ordered](keys []T) (*Sorted[T], error) {
+	distinct := make([]T, 0, len(keys))
+	for i, k := range keys {
+		if i > 0 && k < keys[i-1] {
+			return nil, ErrNotSorted
+		}
+		if i == 0 || k != keys[i-1] {
+			distinct = append(distinct, k)
+		}
+	}
+	return build(distinct), nil
+}
+
+// build returns the set of keys, which must be increasing, without copying
+// them.
+func build[T constraints.Ordered](keys []T) *Sorted[T] {
+	s := &Sorted[T]{keys: keys, layout: make([]T, len(keys)+1), rank: make([]int, len(keys)+1)}
+	// an in-order walk of the tree visits the keys in increasing order
+	next := 0
+	var fill func(i int)
+	fill = func(i int) {
+		if i > len(keys) {
+			return
+		}
+		fill(2 * i)
+		s.layout[i], s.rank[i] = keys[next], next
+		next++
+		fill(2*i + 1)
+	}
+	fill(1)
+	return s
+}
+
+// Len returns the number of keys in the set.
+func (s *Sorted[T]) Len() int {
+	return len(s.keys)
+}
+
+// Rank returns the number of keys of the set smaller than key.
+func (s *Sorted[T]) Rank(key T) int {
+	i := 1
+	for i < len(s.layout) {
+		if s.layout[i] < key {
+			i = 2*i + 1
+		} else {
+			i = 2 * i
+		}
+	}
+	// back up past the moves to the right made after the last move to the
+	// left, to the node where the search went left
+	i >>= uint(bits.TrailingZeros(^uint(i))) + 1
+	if i == 0 {
+		return len(s.keys)
+	}
+	return s.rank[i]
+}
+
+// Contains reports whether key is in the set.
+func (s *Sorted[T]) Contains(key T) bool {
+	r := s.Rank(key)
+	return r < len(s.keys) && s.keys[r] == key
+}
+
+// Select returns the key of rank i, the (i+1)-th smallest, with false if i
+// is outside [0, Len()).
+func (s *Sorted[T]) Select(i int) (T, bool) {
+	if i < 0 || i >= len(s.keys) {
+		var zero T
+		return zero, false
+	}
+	return s.keys[i], true
+}
+
+// Keys returns the keys of the set in increasing order. The slice must not
+// be changed.
+func (s *Sorted[T]) Keys() []T {
+	return s.keys
+}
+
+// All returns the sequence of the keys of the set in increasing order.
+func (s *Sorted[T]) All() iterutil.Seq[T] {
+	return iterutil.FromSlice(s.keys)
+}
+
+// Range returns the sequence of the keys of the set from low, included, to
+// high, excluded, in increasing order.
+func (s *Sorted[T]) Range(low, high T) iterutil.Seq[T] {
+	from, to := s.Rank(low), s.Rank(high)
+	if to < from {
+		to = from
+	}
+	return iterutil.FromSlice(s.keys[from:to])
+}
+
+// merge returns the keys found only in a, in both sets and only in b that
+// the flags ask for, in increasing order.
+func merge[T constraints.Ordered](a, b []T, onlyA, both, onlyB bool) []T {
+	var keys []T
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case j == len(b) || i < len(a) && a[i] < b[j]:
+			if onlyA {
+				keys = append(keys, a[i])
+			}
+			i++
+		case i == len(a) || b[j] < a[i]:
+			if onlyB {
+				keys = append(keys, b[j])
+			}
+			j++
+		default:
+			if both {
+				keys = append(keys, a[i])
+			}
+			i++
+			j++
+		}
+	}
+	return keys
+}
+
+// Union returns the set of the keys in s or other.
+func (s *Sorted[T]) Union(other *Sorted[T]) *Sorted[T] {
+	return build(merge(s.keys, other.keys, true, true, true))
+}
+
+// Intersection returns the set of the keys in both s and other.
+func (s *Sorted[T]) Intersection(other *Sorted[T]) *Sorted[T] {
+	return build(merge(s.keys, other.keys, false, true, false))
+}
+
+// Difference returns the set of the keys in s but not in other.
+func (s *Sorted[T]) Difference(other *Sorted[T]) *Sorted[T] {
+	return build(merge(s.keys, other.keys, true, false, false))
+}
+
+// SymmetricDifference returns the set of the keys in exactly one of s and
+// other.
+func (s *Sorted[T]) SymmetricDifference(other *Sorted[T]) *Sorted[T] {
+	return build(merge(s.keys, other.keys, true, false, true))
+}
+
+// IsSubsetOf reports whether every key of s is in other.
+func (s *Sorted[T]) IsSubsetOf(other *Sorted[T]) bool {
+	return len(s.keys) <= len(other.keys) && len(merge(s.keys, other.keys, true, false, false)) == 0
+}
+
+// Equal reports whether s and other hold the same keys.
+func (s *Sorted[T]) Equal(other *Sorted[T]) bool {
+	if len(s.keys) != len(other.keys) {
+		return false
+	}
+	for i, k := range s.keys {
+		if other.keys[i] != k {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,144 @@
+package set
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/structure/iterutil"
+)
+
+// randomSorted returns up to n distinct random keys below limit in
+// increasing order, and the set of them.
+func randomSorted(rnd *rand.Rand, n, limit int) ([]int, *Sorted[int]) {
+	seen := map[int]bool{}
+	var keys []int
+	for i := 0; i < n; i++ {
+		if k := rnd.Intn(limit); !seen[k] {
+			seen[k] = true
+			keys = append(keys, k)
+		}
+	}
+	sort.Ints(keys)
+	s, _ := NewSorted(keys)
+	return keys, s
+}
+
+func TestNewSorted(t *testing.T) {
+	if _, err := NewSorted([]int{1, 3, 2}); err != ErrNotSorted {
+		t.Errorf("NewSorted of unsorted keys: err = %v", err)
+	}
+	keys := []int{1, 1, 2, 5, 5, 5}
+	s, err := NewSorted(keys)
+	if err != nil || !reflect.DeepEqual(s.Keys(), []int{1, 2, 5}) {
+		t.Fatalf("NewSorted = %v, %v", s.Keys(), err)
+	}
+	keys[0] = 100
+	if !s.Contains(1) {
+		t.Errorf("changing the keys given changed the set")
+	}
+	empty, _ := NewSorted[int](nil)
+	if empty.Len() != 0 || empty.Contains(0) || empty.Rank(3) != 0 {
+		t.Errorf("empty set: Len, Contains, Rank = %d, %t, %d", empty.Len(), empty.Contains(0), empty.Rank(3))
+	}
+	if _, ok := empty.Select(0); ok {
+		t.Errorf("Select(0) of an empty set reported a key")
+	}
+}
+
+func TestSortedQueries(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		keys, s := randomSorted(rnd, rnd.Intn(100), 200)
+		if s.Len() != len(keys) {
+			t.Fatalf("Len = %d, want %d", s.Len(), len(keys))
+		}
+		for k := -1; k <= 201; k++ {
+			want := sort.SearchInts(keys, k)
+			if r := s.Rank(k); r != want {
+				t.Fatalf("Rank(%d) = %d, want %d in %v", k, r, want, keys)
+			}
+			if s.Contains(k) != (want < len(keys) && keys[want] == k) {
+				t.Fatalf("Contains(%d) = %t in %v", k, s.Contains(k), keys)
+			}
+		}
+		for r, k := range keys {
+			if got, ok := s.Select(r); !ok || got != k {
+				t.Fatalf("Select(%d) = %d, %t, want %d", r, got, ok, k)
+			}
+		}
+		if _, ok := s.Select(len(keys)); ok {
+			t.Fatalf("Select(Len()) reported a key")
+		}
+	}
+
+	s, _ := NewSorted([]int{2, 4, 6, 8, 10})
+	if got := iterutil.Collect(s.Range(3, 9)); !reflect.DeepEqual(got, []int{4, 6, 8}) {
+		t.Errorf("Range(3, 9) = %v", got)
+	}
+	if got := iterutil.Collect(s.Range(9, 3)); len(got) != 0 {
+		t.Errorf("Range(9, 3) = %v", got)
+	}
+	if got := iterutil.Collect(s.All()); !reflect.DeepEqual(got, []int{2, 4, 6, 8, 10}) {
+		t.Errorf("All = %v", got)
+	}
+}
+
+func TestSortedOperations(t *testing.T) {
+	rnd := rand.New(rand.NewSource(2))
+	for i := 0; i < 50; i++ {
+		a, sa := randomSorted(rnd, rnd.Intn(30), 40)
+		b, sb := randomSorted(rnd, rnd.Intn(30), 40)
+		ma, mb := New(a...), New(b...)
+		tests := []struct {
+			name string
+			got  *Sorted[int]
+			want Set[int]
+		}{
+			{"Union", sa.Union(sb), ma.Union(mb)},
+			{"Intersection", sa.Intersection(sb), ma.Intersection(mb)},
+			{"Difference", sa.Difference(sb), ma.Difference(mb)},
+			{"SymmetricDifference", sa.SymmetricDifference(sb), ma.SymmetricDifference(mb)},
+		}
+		for _, test := range tests {
+			want := test.want.GetItems()
+			sort.Ints(want)
+			if got := test.got.Keys(); len(got) != len(want) || len(got) > 0 && !reflect.DeepEqual(got, want) {
+				t.Fatalf("%s of %v and %v = %v, want %v", test.name, a, b, got, want)
+			}
+			for _, k := range want {
+				if !test.got.Contains(k) {
+					t.Fatalf("%s does not contain %d", test.name, k)
+				}
+			}
+		}
+		if sa.IsSubsetOf(sb) != ma.IsSubsetOf(mb) {
+			t.Fatalf("IsSubsetOf(%v, %v) = %t", a, b, sa.IsSubsetOf(sb))
+		}
+		if !sa.Intersection(sb).IsSubsetOf(sa) || !sa.Union(sb).Equal(sb.Union(sa)) {
+			t.Fatalf("set operations are inconsistent on %v and %v", a, b)
+		}
+	}
+}
+
+func BenchmarkSortedContains(b *testing.B) {
+	_, s := randomSorted(rand.New(rand.NewSource(1)), 1<<20, 1<<30)
+	rnd := rand.New(rand.NewSource(2))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		s.Contains(rnd.Intn(1 << 30))
+	}
+}
+
+func BenchmarkSortedBinarySearch(b *testing.B) {
+	keys, _ := randomSorted(rand.New(rand.NewSource(1)), 1<<20, 1<<30)
+	rnd := rand.New(rand.NewSource(2))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		k := rnd.Intn(1 << 30)
+		if j := sort.SearchInts(keys, k); j < len(keys) && keys[j] == k {
+			continue
+		}
+	}
+}
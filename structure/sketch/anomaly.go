@@ -0,0 +1,87 @@
+// anomaly.go
+// description: Streaming anomaly detection by quantile bands over a sliding window
+// details:
+// AnomalyDetector flags the values of a stream falling outside the band
+// between a low and a high quantile of the recent values, such as the 1st
+// and 99th percentiles of the last ten thousand. A t-digest cannot forget
+// values, so the window is split into blocks of consecutive values, each
+// summarized by its own digest. The digests of the full blocks sit in an
+// AggregateQueue merging them, which keeps the digest of the whole window up
+// to date with O(1) amortized merges as the oldest block leaves and a new
+// one arrives. The band is read from that digest once per block: a value is
+// compared with the band of the full blocks before it, so the window slides
+// by whole blocks, and nothing is flagged until the first block is full.
+// Observe: O(1) amortized, plus O(compression) when a block is full
+// space complexity: O(blocks * compression)
+// reference: https://en.wikipedia.org/wiki/Anomaly_detection
+// see anomaly_test.go
+
+package sketch
+
+import (
+	"errors"
+
+	"github.com/TheAlgorithms/Go/structure/queue"
+)
+
+// anomalyCompression is the compression of the digests of an
+// AnomalyDetector.
+const anomalyCompression = 100
+
+// AnomalyDetector flags the values outside a quantile band of a sliding
+// window of a stream.
+type AnomalyDetector struct {
+	low, high float64
+	blockSize int
+	blocks    int
+	window    *queue.AggregateQueue[*TDigest] // digests of the full blocks
+	current   *TDigest                        // digest of the block being filled
+	lower     float64                         // the band of the full blocks
+	upper     float64
+}
+
+// NewAnomalyDetector creates a detector of the values below the low-quantile
+// or above the high-quantile of a window of the last blocks blocks of
+// blockSize values. It returns an error unless 0 <= low < high <= 1 and
+// both blockSize and blocks are positive.
+func NewAnomalyDetector(low, high float64, blockSize, blocks int) (*AnomalyDetector, error) {
+	if !(0 <= low && low < high && high <= 1) {
+		return nil, errors.New("quantile band must satisfy 0 <= low < high <= 1")
+	}
+	if blockSize < 1 || blocks < 1 {
+		return nil, errors.New("window must have at least one block of one value")
+	}
+	current, _ := NewTDigest(anomalyCompression)
+	return &AnomalyDetector{
+		low:       low,
+		high:      high,
+		blockSize: blockSize,
+		blocks:    blocks,
+		window:    queue.NewAggregateQueue(func(a, b *TDigest) *TDigest { return a.Merge(b) }),
+		current:   current,
+	}, nil
+}
+
+// Band returns the bounds of the values considered normal, and false until
+// the first block is full.
+func (a *AnomalyDetector) Band() (low, high float64, ok bool) {
+	return a.lower, a.upper, a.window.Len() > 0
+}
+
+// Observe reports whether x lies outside the band of the window, then adds
+// it to the window.
+func (a *AnomalyDetector) Observe(x float64) bool {
+	anomaly := a.window.Len() > 0 && (x < a.lower || x > a.upper)
+	a.current.Add(x)
+	if a.current.Count() == a.blockSize {
+		a.window.Push(a.current)
+		if a.window.Len() > a.blocks {
+			a.window.Pop()
+		}
+		digest, _ := a.window.Aggregate()
+		a.lower, _ = digest.Quantile(a.low)
+		a.upper, _ = digest.Quantile(a.high)
+		a.current, _ = NewTDigest(anomalyCompression)
+	}
+	return anomaly
+}
@@ -0,0 +1,80 @@
+package sketch
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestNewAnomalyDetectorInvalid(t *testing.T) {
+	tests := []struct {
+		low, high        float64
+		blockSize, count int
+	}{
+		{0.5, 0.5, 10, 1},
+		{-0.1, 0.9, 10, 1},
+		{0.1, 1.1, 10, 1},
+		{0.1, 0.9, 0, 1},
+		{0.1, 0.9, 10, 0},
+	}
+	for _, test := range tests {
+		if _, err := NewAnomalyDetector(test.low, test.high, test.blockSize, test.count); err == nil {
+			t.Errorf("NewAnomalyDetector(%v, %v, %d, %d) should fail", test.low, test.high, test.blockSize, test.count)
+		}
+	}
+}
+
+func TestAnomalyDetector(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	a, _ := NewAnomalyDetector(0.005, 0.995, 1000, 5)
+	// nothing is flagged before the first block is full
+	for i := 0; i < 1000; i++ {
+		if a.Observe(1e9 * rnd.NormFloat64()) {
+			t.Fatalf("value %d was flagged during warm-up", i)
+		}
+	}
+	if _, _, ok := a.Band(); !ok {
+		t.Fatalf("Band is not ready after the first block")
+	}
+	// once the window holds normal values only, about 1% of them are flagged
+	// and rare spikes always are
+	for i := 0; i < 5000; i++ {
+		a.Observe(rnd.NormFloat64())
+	}
+	flagged := 0
+	for i := 0; i < 20000; i++ {
+		if a.Observe(rnd.NormFloat64()) {
+			flagged++
+		}
+		if i%1000 == 0 && !a.Observe(10+rnd.Float64()) {
+			t.Fatalf("spike at step %d was not flagged", i)
+		}
+	}
+	if flagged < 100 || flagged > 400 {
+		t.Errorf("%d of 20000 normal values were flagged, want about 200", flagged)
+	}
+	if low, high, _ := a.Band(); low > -2 || low < -3.5 || high < 2 || high > 3.5 {
+		t.Errorf("Band() = %v, %v, want about -2.6, 2.6", low, high)
+	}
+}
+
+func TestAnomalyDetectorSlides(t *testing.T) {
+	rnd := rand.New(rand.NewSource(2))
+	a, _ := NewAnomalyDetector(0.01, 0.99, 100, 4)
+	for i := 0; i < 1000; i++ {
+		a.Observe(rnd.NormFloat64())
+	}
+	// the level shifts: the new values are anomalies until the window forgets
+	// the old ones, which then become anomalies
+	if !a.Observe(100) {
+		t.Errorf("the first shifted value was not flagged")
+	}
+	for i := 0; i < 400; i++ {
+		a.Observe(100 + rnd.NormFloat64())
+	}
+	if a.Observe(100) {
+		t.Errorf("a shifted value was flagged after the window slid")
+	}
+	if !a.Observe(0) {
+		t.Errorf("an old value was not flagged after the window slid")
+	}
+}
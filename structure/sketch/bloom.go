@@ -0,0 +1,118 @@
+// bloom.go
+// description: Bloom filter, an approximate set membership summary
+// details:
+// A Bloom filter is an array of m bits and k hash functions. Adding an item
+// sets the k bits its hashes point to, and an item is reported as present
+// when all of its k bits are set. Items that were added are always reported,
+// while an item that was not added is reported with a probability of about
+// (1 - e^(-kn/m))^k after n additions. For a target rate p, this is smallest
+// with m = -n ln p / (ln 2)^2 bits and k = (m/n) ln 2 hashes, about 9.6 bits
+// and 7 hashes per item for p = 1%. The k hashes are derived from the two
+// halves of a single 128-bit MurmurHash3, h1 + i*h2 (double hashing).
+// Add, Test: O(k)
+// reference: https://en.wikipedia.org/wiki/Bloom_filter
+// reference: Kirsch, Mitzenmacher, "Less Hashing, Same Performance: Building a
+// Better Bloom Filter", ESA 2006
+// see bloom_test.go
+
+package sketch
+
+import (
+	"encoding/binary"
+	"errors"
+	"math"
+
+	"github.com/TheAlgorithms/Go/hashing/murmur3"
+)
+
+// ErrInvalidBloomFilter is returned when decoding malformed Bloom filter data.
+var ErrInvalidBloomFilter = errors.New("invalid Bloom filter encoding")
+
+// BloomFilter is an approximate set of byte strings, with false positives but
+// no false negatives.
+type BloomFilter struct {
+	bits []uint64
+	m    uint64 // number of bits
+	k    int    // number of hashes
+}
+
+// NewBloomFilter creates an empty filter sized for n items with a false
+// positive rate of p, which must be between 0 and 1 excluded.
+func NewBloomFilter(n int, p float64) (*BloomFilter, error) {
+	if n < 1 {
+		return nil, errors.New("expected number of items must be positive")
+	}
+	if !(p > 0 && p < 1) {
+		return nil, errors.New("false positive rate must be between 0 and 1")
+	}
+	m := uint64(math.Ceil(-float64(n) * math.Log(p) / (math.Ln2 * math.Ln2)))
+	k := int(math.Round(float64(m) / float64(n) * math.Ln2))
+	if k < 1 {
+		k = 1
+	}
+	return &BloomFilter{bits: make([]uint64, (m+63)/64), m: m, k: k}, nil
+}
+
+// Bits returns the number of bits of the filter.
+func (b *BloomFilter) Bits() int {
+	return int(b.m)
+}
+
+// Hashes returns the number of hashes of the filter.
+func (b *BloomFilter) Hashes() int {
+	return b.k
+}
+
+// Add adds item to the filter.
+func (b *BloomFilter) Add(item []byte) {
+	h1, h2 := murmur3.Sum128(item, 0)
+	for i := 0; i < b.k; i++ {
+		bit := (h1 + uint64(i)*h2) % b.m
+		b.bits[bit/64] |= 1 << (bit % 64)
+	}
+}
+
+// Test reports whether item may have been added to the filter. A false
+// result is certain, a true one is wrong with the false positive rate.
+func (b *BloomFilter) Test(item []byte) bool {
+	h1, h2 := murmur3.Sum128(item, 0)
+	for i := 0; i < b.k; i++ {
+		bit := (h1 + uint64(i)*h2) % b.m
+		if b.bits[bit/64]&(1<<(bit%64)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// MarshalBinary encodes the filter as its number of bits and of hashes
+// followed by the bits, in little-endian words.
+func (b *BloomFilter) MarshalBinary() ([]byte, error) {
+	data := make([]byte, 12, 12+8*len(b.bits))
+	binary.LittleEndian.PutUint64(data, b.m)
+	binary.LittleEndian.PutUint32(data[8:], uint32(b.k))
+	for _, w := range b.bits {
+		data = binary.LittleEndian.AppendUint64(data, w)
+	}
+	return data, nil
+}
+
+// UnmarshalBinary decodes a filter encoded by MarshalBinary, replacing the
+// content of b.
+func (b *BloomFilter) UnmarshalBinary(data []byte) error {
+	if len(data) < 12 {
+		return ErrInvalidBloomFilter
+	}
+	m := binary.LittleEndian.Uint64(data)
+	k := binary.LittleEndian.Uint32(data[8:])
+	words := (m + 63) / 64
+	if m == 0 || k == 0 || k > 64 || uint64(len(data)-12) != 8*words {
+		return ErrInvalidBloomFilter
+	}
+	bits := make([]uint64, words)
+	for i := range bits {
+		bits[i] = binary.LittleEndian.Uint64(data[12+8*i:])
+	}
+	b.bits, b.m, b.k = bits, m, int(k)
+	return nil
+}
@@ -0,0 +1,65 @@
+package sketch
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"testing"
+)
+
+func TestNewBloomFilterInvalid(t *testing.T) {
+	for _, c := range []struct {
+		n int
+		p float64
+	}{{0, 0.01}, {10, 0}, {10, 1}, {10, math.NaN()}} {
+		if _, err := NewBloomFilter(c.n, c.p); err == nil {
+			t.Errorf("NewBloomFilter(%d, %v) should fail", c.n, c.p)
+		}
+	}
+}
+
+func TestBloomFilter(t *testing.T) {
+	const n = 10000
+	b, err := NewBloomFilter(n, 0.01)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b.Hashes() != 7 || b.Bits() < 95000 || b.Bits() > 96000 {
+		t.Errorf("%d bits and %d hashes for 1%% on %d items", b.Bits(), b.Hashes(), n)
+	}
+	for i := 0; i < n; i++ {
+		b.Add([]byte("in-" + strconv.Itoa(i)))
+	}
+	for i := 0; i < n; i++ {
+		if !b.Test([]byte("in-" + strconv.Itoa(i))) {
+			t.Fatalf("added item %d is not found", i)
+		}
+	}
+	positives := 0
+	for i := 0; i < n; i++ {
+		if b.Test([]byte("out-" + strconv.Itoa(i))) {
+			positives++
+		}
+	}
+	if rate := float64(positives) / n; rate > 0.015 {
+		t.Errorf("false positive rate %v, want about 0.01", rate)
+	}
+
+	data, err := b.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded BloomFilter
+	if err := decoded.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 100; i++ {
+		item := []byte("out-" + strconv.Itoa(i))
+		if decoded.Test(item) != b.Test(item) {
+			t.Fatalf("decoded filter differs on %q", item)
+		}
+	}
+	if err := decoded.UnmarshalBinary(data[:len(data)-1]); !errors.Is(err, ErrInvalidBloomFilter) {
+		t.Errorf("UnmarshalBinary of truncated data error = %v", err)
+	}
+}
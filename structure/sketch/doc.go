@@ -0,0 +1,3 @@
+// Package sketch implements streaming summaries which answer approximate
+// questions about a stream of items using memory independent of its length.
+package sketch
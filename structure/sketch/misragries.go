@@ -0,0 +1,102 @@
+// misragries.go
+// description: Misra-Gries frequent items summary
+// details:
+// The Misra-Gries summary keeps at most k counters. A new item takes a free
+// counter; when none is free every counter is decremented instead, and the
+// ones reaching zero are dropped. Each decrement step cancels k+1 occurrences,
+// so an item's counter underestimates its frequency by at most the number of
+// such steps, which is bounded by n/(k+1) for a stream of n items. Every item
+// occurring more than n/(k+1) times is therefore guaranteed to be kept.
+// Add: O(1) amortized
+// reference: https://en.wikipedia.org/wiki/Misra%E2%80%93Gries_summary
+// see misragries_test.go
+
+package sketch
+
+import (
+	"errors"
+	"sort"
+)
+
+// Counter is the estimated frequency of an item. The true frequency lies in
+// [Count-Error, Count] for SpaceSaving and in [Count, Count+Error] for
+// MisraGries.
+type Counter[T comparable] struct {
+	Item  T
+	Count int
+	Error int
+}
+
+// MisraGries is a deterministic frequent items summary.
+type MisraGries[T comparable] struct {
+	counters   map[T]int
+	k          int
+	n          int
+	decrements int // number of times all counters were decremented
+}
+
+// NewMisraGries creates an empty summary holding at most k counters.
+func NewMisraGries[T comparable](k int) (*MisraGries[T], error) {
+	if k < 1 {
+		return nil, errors.New("number of counters must be positive")
+	}
+	return &MisraGries[T]{counters: make(map[T]int, k), k: k}, nil
+}
+
+// Add records one occurrence of item.
+func (m *MisraGries[T]) Add(item T) {
+	m.n++
+	if _, ok := m.counters[item]; ok || len(m.counters) < m.k {
+		m.counters[item]++
+		return
+	}
+	m.decrements++
+	for key := range m.counters {
+		m.counters[key]--
+		if m.counters[key] == 0 {
+			delete(m.counters, key)
+		}
+	}
+}
+
+// Count returns the number of items added so far.
+func (m *MisraGries[T]) Count() int {
+	return m.n
+}
+
+// ErrorBound returns the largest possible underestimation of any frequency,
+// which never exceeds Count()/(k+1).
+func (m *MisraGries[T]) ErrorBound() int {
+	return m.decrements
+}
+
+// Estimate returns a lower bound of the frequency of item, at most
+// ErrorBound() below the true frequency.
+func (m *MisraGries[T]) Estimate(item T) int {
+	return m.counters[item]
+}
+
+// Top returns up to k tracked items with the highest estimates, in
+// decreasing order of Count. Every item whose true frequency exceeds
+// ErrorBound() is tracked.
+func (m *MisraGries[T]) Top(k int) []Counter[T] {
+	result := make([]Counter[T], 0, len(m.counters))
+	for item, count := range m.counters {
+		result = append(result, Counter[T]{Item: item, Count: count, Error: m.decrements})
+	}
+	return top(result, k)
+}
+
+// top sorts counters by decreasing Count and keeps the first k of them.
+func top[T comparable](counters []Counter[T], k int) []Counter[T] {
+	sort.SliceStable(counters, func(i, j int) bool {
+		return counters[i].Count > counters[j].Count
+	})
+	if k < 0 {
+		k = 0
+	}
+	if k < len(counters) {
+		counters = counters[:k]
+	}
+	return counters
+}
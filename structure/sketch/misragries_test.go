@@ -0,0 +1,59 @@
+package sketch
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// zipfStream returns a skewed stream of n small integers and their true
+// frequencies.
+func zipfStream(n int) ([]uint64, map[uint64]int) {
+	rnd := rand.New(rand.NewSource(1))
+	zipf := rand.NewZipf(rnd, 1.5, 1, 1000)
+	stream := make([]uint64, n)
+	freq := make(map[uint64]int)
+	for i := range stream {
+		stream[i] = zipf.Uint64()
+		freq[stream[i]]++
+	}
+	return stream, freq
+}
+
+func TestMisraGries(t *testing.T) {
+	if _, err := NewMisraGries[int](0); err == nil {
+		t.Errorf("NewMisraGries(0) should fail")
+	}
+
+	const k = 20
+	stream, freq := zipfStream(10000)
+	m, _ := NewMisraGries[uint64](k)
+	for _, x := range stream {
+		m.Add(x)
+	}
+
+	if m.Count() != len(stream) {
+		t.Errorf("Count() = %d, want %d", m.Count(), len(stream))
+	}
+	if bound := m.ErrorBound(); bound > len(stream)/(k+1) {
+		t.Errorf("ErrorBound() = %d, exceeds n/(k+1) = %d", bound, len(stream)/(k+1))
+	}
+	for item, f := range freq {
+		est := m.Estimate(item)
+		if est > f || est < f-m.ErrorBound() {
+			t.Errorf("Estimate(%d) = %d, true frequency %d, bound %d", item, est, f, m.ErrorBound())
+		}
+		if f > m.ErrorBound() && est == 0 {
+			t.Errorf("frequent item %d (%d occurrences) is not tracked", item, f)
+		}
+	}
+
+	top := m.Top(3)
+	if len(top) != 3 || top[0].Item != 0 {
+		t.Errorf("Top(3) = %v, want the most frequent item 0 first", top)
+	}
+	for i := 1; i < len(top); i++ {
+		if top[i-1].Count < top[i].Count {
+			t.Errorf("Top(3) is not sorted: %v", top)
+		}
+	}
+}
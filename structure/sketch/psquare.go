@@ -0,0 +1,127 @@
+// psquare.go
+// description: P² estimator of a quantile of a stream
+// details:
+// The P² algorithm estimates the p-quantile of a stream with five markers:
+// the minimum, the p/2-, p- and (1+p)/2-quantiles and the maximum. Each
+// marker has a height, its estimate, and a position, the number of values
+// seen up to it. Every value moves the positions of the markers above it,
+// and a middle marker drifting one position away from where its quantile
+// should be is moved back, its height being adjusted with a piecewise
+// parabolic interpolation through its neighbours, or a linear one when the
+// parabola would break the order of the heights. The estimate is exact for
+// the first five values and usually within a few tenths of a percent of the
+// rank afterwards, for smooth distributions.
+// Add: O(1)
+// space complexity: O(1)
+// reference: Jain, Chlamtac, "The P² algorithm for dynamic calculation of
+// quantiles and histograms without storing observations", CACM 1985
+// see psquare_test.go
+
+package sketch
+
+import (
+	"errors"
+	"sort"
+)
+
+// PSquare estimates a quantile of a stream of numbers.
+type PSquare struct {
+	p       float64
+	n       int
+	heights [5]float64
+	pos     [5]float64 // actual positions of the markers, counting from 0
+	want    [5]float64 // desired positions of the markers
+	step    [5]float64 // increments of the desired positions per value
+}
+
+// NewPSquare creates an estimator of the p-quantile, p being in (0, 1).
+func NewPSquare(p float64) (*PSquare, error) {
+	if !(p > 0 && p < 1) {
+		return nil, errors.New("quantile must be in (0, 1)")
+	}
+	return &PSquare{
+		p:    p,
+		pos:  [5]float64{0, 1, 2, 3, 4},
+		want: [5]float64{0, 2 * p, 4 * p, 2 + 2*p, 4},
+		step: [5]float64{0, p / 2, p, (1 + p) / 2, 1},
+	}, nil
+}
+
+// Count returns the number of values added.
+func (q *PSquare) Count() int {
+	return q.n
+}
+
+// Add adds x to the stream.
+func (q *PSquare) Add(x float64) {
+	if q.n < 5 {
+		// the first values are kept sorted as the heights
+		q.heights[q.n] = x
+		q.n++
+		sort.Float64s(q.heights[:q.n])
+		return
+	}
+	q.n++
+	// k is the cell [heights[k], heights[k+1]) holding x
+	var k int
+	switch {
+	case x < q.heights[0]:
+		q.heights[0] = x
+		k = 0
+	case x >= q.heights[4]:
+		q.heights[4] = x
+		k = 3
+	default:
+		for k = 0; x >= q.heights[k+1]; k++ {
+		}
+	}
+	for i := k + 1; i < 5; i++ {
+		q.pos[i]++
+	}
+	for i := range q.want {
+		q.want[i] += q.step[i]
+	}
+	for i := 1; i <= 3; i++ {
+		d := q.want[i] - q.pos[i]
+		if d >= 1 && q.pos[i+1]-q.pos[i] > 1 || d <= -1 && q.pos[i-1]-q.pos[i] < -1 {
+			q.move(i, d)
+		}
+	}
+}
+
+// move moves marker i by one position in the direction of d.
+func (q *PSquare) move(i int, d float64) {
+	s := 1.0
+	if d < 0 {
+		s = -1
+	}
+	h, n := q.heights, q.pos
+	parabolic := h[i] + s/(n[i+1]-n[i-1])*
+		((n[i]-n[i-1]+s)*(h[i+1]-h[i])/(n[i+1]-n[i])+
+			(n[i+1]-n[i]-s)*(h[i]-h[i-1])/(n[i]-n[i-1]))
+	if h[i-1] < parabolic && parabolic < h[i+1] {
+		q.heights[i] = parabolic
+	} else {
+		j := i + int(s)
+		q.heights[i] = h[i] + s*(h[j]-h[i])/(n[j]-n[i])
+	}
+	q.pos[i] += s
+}
+
+// Quantile returns the estimate of the quantile, and false when no value was
+// added. Until five values are added, it interpolates between the closest
+// ranks of the values.
+func (q *PSquare) Quantile() (float64, bool) {
+	switch {
+	case q.n == 0:
+		return 0, false
+	case q.n < 5:
+		r := q.p * float64(q.n-1)
+		i := int(r)
+		if i+1 == q.n {
+			return q.heights[i], true
+		}
+		return q.heights[i] + (r-float64(i))*(q.heights[i+1]-q.heights[i]), true
+	}
+	return q.heights[2], true
+}
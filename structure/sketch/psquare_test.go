@@ -0,0 +1,72 @@
+package sketch
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func TestNewPSquareInvalid(t *testing.T) {
+	for _, p := range []float64{0, 1, -0.5, 2, math.NaN()} {
+		if _, err := NewPSquare(p); err == nil {
+			t.Errorf("NewPSquare(%v) should fail", p)
+		}
+	}
+}
+
+func TestPSquareFewValues(t *testing.T) {
+	q, _ := NewPSquare(0.5)
+	if _, ok := q.Quantile(); ok {
+		t.Errorf("Quantile() of no value found one")
+	}
+	for i, x := range []float64{7, 1, 3, 9} {
+		q.Add(x)
+		want := []float64{7, 4, 3, 5}[i]
+		if got, _ := q.Quantile(); got != want {
+			t.Errorf("median of %d values = %v, want %v", i+1, got, want)
+		}
+	}
+}
+
+func TestPSquareAccuracy(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	streams := []struct {
+		name string
+		next func() float64
+	}{
+		{"uniform", rnd.Float64},
+		{"normal", rnd.NormFloat64},
+		{"exponential", rnd.ExpFloat64},
+		{"lognormal", func() float64 { return math.Exp(rnd.NormFloat64()) }},
+	}
+	for _, stream := range streams {
+		name, next := stream.name, stream.next
+		for _, p := range []float64{0.01, 0.1, 0.5, 0.9, 0.99} {
+			q, _ := NewPSquare(p)
+			values := make([]float64, 100000)
+			for i := range values {
+				values[i] = next()
+				q.Add(values[i])
+			}
+			sort.Float64s(values)
+			est, _ := q.Quantile()
+			// the estimate is compared with the exact quantile by rank
+			rank := float64(sort.SearchFloat64s(values, est)) / float64(len(values))
+			if q.Count() != len(values) || math.Abs(rank-p) > 0.002 {
+				t.Errorf("%s %v-quantile: estimate %v has rank %v, exact quantile %v",
+					name, p, est, rank, values[int(p*float64(len(values)))])
+			}
+		}
+	}
+}
+
+func TestPSquareOrderedStream(t *testing.T) {
+	q, _ := NewPSquare(0.75)
+	for i := 0; i < 10000; i++ {
+		q.Add(float64(i))
+	}
+	if got, _ := q.Quantile(); math.Abs(got-7499.25) > 50 {
+		t.Errorf("0.75-quantile of 0..9999 = %v, want about 7499", got)
+	}
+}
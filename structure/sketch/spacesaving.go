@@ -0,0 +1,119 @@
+// spacesaving.go
+// description: Space-Saving frequent items summary
+// details:
+// The Space-Saving summary keeps exactly k counters once k distinct items were
+// seen. An untracked item replaces the item with the smallest counter, inherits
+// that counter plus one, and records the inherited value as its error. Counters
+// thus never underestimate, and overestimate by at most the smallest counter,
+// which is at most n/k for a stream of n items. Every item occurring more than
+// n/k times is guaranteed to be tracked.
+// The counters are kept in a min-heap indexed by item.
+// Add: O(log k)
+// reference: Metwally, Agrawal, El Abbadi, "Efficient Computation of Frequent
+// and Top-k Elements in Data Streams", ICDT 2005
+// see spacesaving_test.go
+
+package sketch
+
+import "errors"
+
+// SpaceSaving is a deterministic frequent items summary.
+type SpaceSaving[T comparable] struct {
+	heap  []Counter[T] // min-heap on Count
+	index map[T]int    // position of every tracked item in heap
+	k     int
+	n     int
+}
+
+// NewSpaceSaving creates an empty summary holding at most k counters.
+func NewSpaceSaving[T comparable](k int) (*SpaceSaving[T], error) {
+	if k < 1 {
+		return nil, errors.New("number of counters must be positive")
+	}
+	return &SpaceSaving[T]{index: make(map[T]int, k), k: k}, nil
+}
+
+// Add records one occurrence of item.
+func (s *SpaceSaving[T]) Add(item T) {
+	s.n++
+	if i, ok := s.index[item]; ok {
+		s.heap[i].Count++
+		s.down(i)
+		return
+	}
+	if len(s.heap) < s.k {
+		s.heap = append(s.heap, Counter[T]{Item: item, Count: 1})
+		s.index[item] = len(s.heap) - 1
+		s.up(len(s.heap) - 1)
+		return
+	}
+	// Evict the item with the smallest counter.
+	evicted := s.heap[0]
+	delete(s.index, evicted.Item)
+	s.heap[0] = Counter[T]{Item: item, Count: evicted.Count + 1, Error: evicted.Count}
+	s.index[item] = 0
+	s.down(0)
+}
+
+// Count returns the number of items added so far.
+func (s *SpaceSaving[T]) Count() int {
+	return s.n
+}
+
+// ErrorBound returns the largest possible overestimation of any frequency,
+// which never exceeds Count()/k.
+func (s *SpaceSaving[T]) ErrorBound() int {
+	if len(s.heap) < s.k {
+		return 0
+	}
+	return s.heap[0].Count
+}
+
+// Estimate returns an upper bound of the frequency of item, at most
+// ErrorBound() above the true frequency.
+func (s *SpaceSaving[T]) Estimate(item T) int {
+	if i, ok := s.index[item]; ok {
+		return s.heap[i].Count
+	}
+	return s.ErrorBound()
+}
+
+// Top returns up to k tracked items with the highest estimates, in
+// decreasing order of Count. An item is certainly among the most frequent
+// ones when Count-Error is at least the Count of the next item.
+func (s *SpaceSaving[T]) Top(k int) []Counter[T] {
+	return top(append([]Counter[T](nil), s.heap...), k)
+}
+
+func (s *SpaceSaving[T]) swap(i, j int) {
+	s.heap[i], s.heap[j] = s.heap[j], s.heap[i]
+	s.index[s.heap[i].Item] = i
+	s.index[s.heap[j].Item] = j
+}
+
+func (s *SpaceSaving[T]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if s.heap[parent].Count <= s.heap[i].Count {
+			return
+		}
+		s.swap(i, parent)
+		i = parent
+	}
+}
+
+func (s *SpaceSaving[T]) down(i int) {
+	for {
+		smallest := i
+		for _, child := range []int{2*i + 1, 2*i + 2} {
+			if child < len(s.heap) && s.heap[child].Count < s.heap[smallest].Count {
+				smallest = child
+			}
+		}
+		if smallest == i {
+			return
+		}
+		s.swap(i, smallest)
+		i = smallest
+	}
+}
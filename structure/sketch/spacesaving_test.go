@@ -0,0 +1,56 @@
+package sketch
+
+import "testing"
+
+func TestSpaceSaving(t *testing.T) {
+	if _, err := NewSpaceSaving[int](-1); err == nil {
+		t.Errorf("NewSpaceSaving(-1) should fail")
+	}
+
+	const k = 20
+	stream, freq := zipfStream(10000)
+	s, _ := NewSpaceSaving[uint64](k)
+	for _, x := range stream {
+		s.Add(x)
+	}
+
+	if bound := s.ErrorBound(); bound > len(stream)/k {
+		t.Errorf("ErrorBound() = %d, exceeds n/k = %d", bound, len(stream)/k)
+	}
+	for item, f := range freq {
+		est := s.Estimate(item)
+		if est < f || est > f+s.ErrorBound() {
+			t.Errorf("Estimate(%d) = %d, true frequency %d, bound %d", item, est, f, s.ErrorBound())
+		}
+	}
+	for _, c := range s.Top(k) {
+		if f := freq[c.Item]; f < c.Count-c.Error || f > c.Count {
+			t.Errorf("counter %v does not bracket true frequency %d", c, f)
+		}
+	}
+
+	top := s.Top(2)
+	if len(top) != 2 || top[0].Item != 0 || top[1].Item != 1 {
+		t.Errorf("Top(2) = %v, want items 0 and 1", top)
+	}
+}
+
+func TestSpaceSavingFewItems(t *testing.T) {
+	s, _ := NewSpaceSaving[string](5)
+	for _, w := range []string{"a", "b", "a", "c", "a", "b"} {
+		s.Add(w)
+	}
+	want := []Counter[string]{{"a", 3, 0}, {"b", 2, 0}, {"c", 1, 0}}
+	got := s.Top(10)
+	if len(got) != len(want) {
+		t.Fatalf("Top(10) = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Top(10)[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+	if s.Estimate("z") != 0 {
+		t.Errorf("Estimate of an unseen item with free counters should be 0")
+	}
+}
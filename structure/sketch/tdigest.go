@@ -0,0 +1,163 @@
+// tdigest.go
+// description: t-digest estimator of the quantiles of a stream
+// details:
+// A t-digest summarizes a stream of numbers as a sorted list of centroids,
+// each the mean and the number of a run of consecutive values. Centroids
+// near the median may hold many values, and those near the ends only a few,
+// so that extreme quantiles stay accurate: the scale function
+// k(q) = compression / (2 pi) * asin(2q - 1) grows steeply at both ends, and
+// a centroid spanning the quantiles q1 to q2 must keep k(q2) - k(q1) <= 1.
+// New values are buffered and merged in batches: the centroids and the
+// buffered values are sorted by mean, and every one is merged into the
+// centroid before it when the result stays within that limit. Quantiles are
+// read by interpolating linearly between the centers of adjacent centroids,
+// and between the smallest and largest values seen and the outer centroids.
+// Two digests merge the same way, which makes them suitable for
+// sliding-window and distributed summaries.
+// Add: O(1) amortized, O(log compression) with the sorting of batches
+// Quantile, Merge: O(compression)
+// space complexity: O(compression)
+// reference: Dunning, Ertl, "Computing Extremely Accurate Quantiles Using t-Digests", 2019
+// see tdigest_test.go
+
+package sketch
+
+import (
+	"errors"
+	"math"
+	"sort"
+)
+
+// centroid is the mean of weight consecutive values.
+type centroid struct {
+	mean, weight float64
+}
+
+// TDigest estimates the quantiles of a stream of numbers.
+type TDigest struct {
+	compression float64
+	centroids   []centroid // sorted by mean
+	buffer      []float64  // values not yet merged into centroids
+	count       float64
+	min, max    float64
+}
+
+// NewTDigest creates a digest keeping about compression centroids; larger
+// values are more accurate. It returns an error unless compression is at
+// least 1.
+func NewTDigest(compression float64) (*TDigest, error) {
+	if !(compression >= 1) {
+		return nil, errors.New("compression must be at least 1")
+	}
+	return &TDigest{compression: compression, min: math.Inf(1), max: math.Inf(-1)}, nil
+}
+
+// Count returns the number of values added.
+func (d *TDigest) Count() int {
+	return int(d.count)
+}
+
+// Add adds x to the stream.
+func (d *TDigest) Add(x float64) {
+	d.buffer = append(d.buffer, x)
+	d.count++
+	d.min = math.Min(d.min, x)
+	d.max = math.Max(d.max, x)
+	if float64(len(d.buffer)) >= 5*d.compression {
+		d.compress()
+	}
+}
+
+// scale returns k(q).
+func (d *TDigest) scale(q float64) float64 {
+	return d.compression / (2 * math.Pi) * math.Asin(2*q-1)
+}
+
+// merged returns the centroids merged into as few as the scale allows.
+func (d *TDigest) merged(all []centroid, total float64) []centroid {
+	sort.Slice(all, func(i, j int) bool { return all[i].mean < all[j].mean })
+	var out []centroid
+	before := 0.0 // the weight of the centroids before the current one
+	for _, c := range all {
+		if len(out) > 0 {
+			cur := &out[len(out)-1]
+			if d.scale((before+cur.weight+c.weight)/total)-d.scale(before/total) <= 1 {
+				cur.mean += (c.mean - cur.mean) * c.weight / (cur.weight + c.weight)
+				cur.weight += c.weight
+				continue
+			}
+			before += cur.weight
+		}
+		out = append(out, c)
+	}
+	return out
+}
+
+// compress merges the buffered values into the centroids.
+func (d *TDigest) compress() {
+	if len(d.buffer) == 0 {
+		return
+	}
+	all := make([]centroid, 0, len(d.centroids)+len(d.buffer))
+	all = append(all, d.centroids...)
+	for _, x := range d.buffer {
+		all = append(all, centroid{x, 1})
+	}
+	d.centroids = d.merged(all, d.count)
+	d.buffer = d.buffer[:0]
+}
+
+// Merge returns a new digest of the values of d and other, with the
+// compression of d, leaving both unchanged.
+func (d *TDigest) Merge(other *TDigest) *TDigest {
+	m := &TDigest{
+		compression: d.compression,
+		count:       d.count + other.count,
+		min:         math.Min(d.min, other.min),
+		max:         math.Max(d.max, other.max),
+	}
+	all := make([]centroid, 0, len(d.centroids)+len(other.centroids)+len(d.buffer)+len(other.buffer))
+	all = append(append(all, d.centroids...), other.centroids...)
+	for _, x := range d.buffer {
+		all = append(all, centroid{x, 1})
+	}
+	for _, x := range other.buffer {
+		all = append(all, centroid{x, 1})
+	}
+	if m.count > 0 {
+		m.centroids = m.merged(all, m.count)
+	}
+	return m
+}
+
+// Quantile returns the estimate of the q-quantile, q being in [0, 1], and
+// false when no value was added.
+func (d *TDigest) Quantile(q float64) (float64, bool) {
+	if d.count == 0 {
+		return 0, false
+	}
+	d.compress()
+	if q <= 0 {
+		return d.min, true
+	}
+	if q >= 1 {
+		return d.max, true
+	}
+	c := d.centroids
+	index := q * d.count
+	// the first half of the first centroid lies between the minimum and its
+	// mean
+	if first := c[0].weight / 2; index < first {
+		return d.min + index/first*(c[0].mean-d.min), true
+	}
+	at := c[0].weight / 2 // the weight up to the center of centroid i
+	for i := 0; i+1 < len(c); i++ {
+		step := (c[i].weight + c[i+1].weight) / 2
+		if at+step > index {
+			return c[i].mean + (index-at)/step*(c[i+1].mean-c[i].mean), true
+		}
+		at += step
+	}
+	last := c[len(c)-1]
+	return last.mean + (index-at)/(last.weight/2)*(d.max-last.mean), true
+}
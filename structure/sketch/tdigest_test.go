@@ -0,0 +1,110 @@
+package sketch
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+// rankError returns how far the rank of estimate in sorted is from q, as a
+// fraction of the values.
+func rankError(sorted []float64, estimate, q float64) float64 {
+	rank := float64(sort.SearchFloat64s(sorted, estimate)) / float64(len(sorted))
+	return math.Abs(rank - q)
+}
+
+func TestNewTDigestInvalid(t *testing.T) {
+	for _, c := range []float64{0, 0.5, -1, math.NaN()} {
+		if _, err := NewTDigest(c); err == nil {
+			t.Errorf("NewTDigest(%v) should fail", c)
+		}
+	}
+}
+
+func TestTDigestFewValues(t *testing.T) {
+	d, _ := NewTDigest(100)
+	if _, ok := d.Quantile(0.5); ok {
+		t.Errorf("Quantile of no value found one")
+	}
+	d.Add(3)
+	for _, q := range []float64{0, 0.3, 1} {
+		if got, _ := d.Quantile(q); got != 3 {
+			t.Errorf("Quantile(%v) of a single value = %v, want 3", q, got)
+		}
+	}
+	for _, x := range []float64{1, 2, 4, 5} {
+		d.Add(x)
+	}
+	if got, _ := d.Quantile(0.5); got != 3 {
+		t.Errorf("median of 1 to 5 = %v, want 3", got)
+	}
+	if lo, _ := d.Quantile(0); lo != 1 {
+		t.Errorf("Quantile(0) = %v, want the minimum 1", lo)
+	}
+	if d.Count() != 5 {
+		t.Errorf("Count() = %d, want 5", d.Count())
+	}
+}
+
+func TestTDigestAccuracy(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	distributions := map[string]func() float64{
+		"uniform":     rnd.Float64,
+		"normal":      rnd.NormFloat64,
+		"exponential": rnd.ExpFloat64,
+	}
+	for name, draw := range distributions {
+		d, _ := NewTDigest(100)
+		values := make([]float64, 100000)
+		for i := range values {
+			values[i] = draw()
+			d.Add(values[i])
+		}
+		sort.Float64s(values)
+		for _, q := range []float64{0.001, 0.01, 0.1, 0.5, 0.9, 0.99, 0.999} {
+			got, _ := d.Quantile(q)
+			// the error allowed shrinks towards the ends
+			if e := rankError(values, got, q); e > 0.005 || e > 0.01*math.Sqrt(q*(1-q))+0.0005 {
+				t.Errorf("%s: Quantile(%v) = %v, off by %v in rank", name, q, got, e)
+			}
+		}
+		if len(d.centroids) > 200 {
+			t.Errorf("%s: the digest holds %d centroids for a compression of 100", name, len(d.centroids))
+		}
+	}
+}
+
+func TestTDigestMerge(t *testing.T) {
+	rnd := rand.New(rand.NewSource(2))
+	a, _ := NewTDigest(100)
+	b, _ := NewTDigest(100)
+	var values []float64
+	for i := 0; i < 20000; i++ {
+		x := rnd.NormFloat64()
+		if i%2 == 0 {
+			// the two halves come from different ranges
+			x += 5
+			a.Add(x)
+		} else {
+			b.Add(x)
+		}
+		values = append(values, x)
+	}
+	countA := a.Count()
+	m := a.Merge(b)
+	if m.Count() != 20000 || a.Count() != countA {
+		t.Fatalf("Merge counts %d, and changed a to %d", m.Count(), a.Count())
+	}
+	sort.Float64s(values)
+	for _, q := range []float64{0.01, 0.25, 0.5, 0.75, 0.99} {
+		got, _ := m.Quantile(q)
+		if e := rankError(values, got, q); e > 0.01 {
+			t.Errorf("merged Quantile(%v) = %v, off by %v in rank", q, got, e)
+		}
+	}
+	empty, _ := NewTDigest(100)
+	if _, ok := empty.Merge(empty).Quantile(0.5); ok {
+		t.Errorf("merging empty digests gave a quantile")
+	}
+}
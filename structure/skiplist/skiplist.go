@@ -0,0 +1,223 @@
+// skiplist.go
+// description: Skip list, a randomized sorted set
+// details:
+// A skip list is a sorted linked list with express lanes: every node is on
+// level 0, and a node on level i is also on level i+1 with probability 1/4.
+// A search starts on the highest level and drops down a level whenever the
+// next node would overshoot, so it skips over most of the nodes. The expected
+// number of levels is O(log n) and a search visits a constant expected
+// number of nodes per level. Unlike balanced trees, insertions and deletions
+// only relink the neighbours of a node, with no rotations. Map keeps a value
+// with every key, and SkipList is the set of the keys of a Map.
+// Insert, Delete, Has, Put, Get: O(log n) expected
+// Min: O(1)
+// reference: https://en.wikipedia.org/wiki/Skip_list
+// see skiplist_test.go
+
+// Package skiplist implements a randomized skip list holding a sorted set or
+// a sorted map.
+package skiplist
+
+import (
+	"math/rand"
+
+	"github.com/TheAlgorithms/Go/constraints"
+	"github.com/TheAlgorithms/Go/structure/iterutil"
+)
+
+// maxLevel bounds the number of levels, enough for 4^maxLevel keys.
+const maxLevel = 24
+
+type node[K constraints.Ordered, V any] struct {
+	key   K
+	value V
+	next  []*node[K, V] // next[i] is the following node on level i
+}
+
+// Map is a sorted map from keys to values.
+type Map[K constraints.Ordered, V any] struct {
+	head   node[K, V] // sentinel whose next pointers start every level
+	levels int        // number of levels in use
+	size   int
+	rnd    *rand.Rand
+}
+
+// NewMap creates an empty map whose node levels are drawn from a random
+// source seeded with seed. Equal seeds and equal operation sequences produce
+// identical lists.
+func NewMap[K constraints.Ordered, V any](seed int64) *Map[K, V] {
+	return &Map[K, V]{
+		head:   node[K, V]{next: make([]*node[K, V], maxLevel)},
+		levels: 1,
+		rnd:    rand.New(rand.NewSource(seed)),
+	}
+}
+
+// Len returns the number of keys in the map.
+func (m *Map[K, V]) Len() int {
+	return m.size
+}
+
+// Empty reports whether the map holds no keys.
+func (m *Map[K, V]) Empty() bool {
+	return m.size == 0
+}
+
+// randomLevel returns the number of levels of a new node.
+func (m *Map[K, V]) randomLevel() int {
+	level := 1
+	for level < maxLevel && m.rnd.Intn(4) == 0 {
+		level++
+	}
+	return level
+}
+
+// search fills update[i] with the last node on level i whose key is less
+// than key, and returns the node following it on level 0.
+func (m *Map[K, V]) search(key K, update []*node[K, V]) *node[K, V] {
+	x := &m.head
+	for i := m.levels - 1; i >= 0; i-- {
+		for x.next[i] != nil && x.next[i].key < key {
+			x = x.next[i]
+		}
+		if update != nil {
+			update[i] = x
+		}
+	}
+	return x.next[0]
+}
+
+// Put maps key to value, replacing the value of a key already present, and
+// reports whether the key was not already there.
+func (m *Map[K, V]) Put(key K, value V) bool {
+	var update [maxLevel]*node[K, V]
+	if n := m.search(key, update[:]); n != nil && n.key == key {
+		n.value = value
+		return false
+	}
+	level := m.randomLevel()
+	for ; m.levels < level; m.levels++ {
+		update[m.levels] = &m.head
+	}
+	n := &node[K, V]{key: key, value: value, next: make([]*node[K, V], level)}
+	for i := 0; i < level; i++ {
+		n.next[i] = update[i].next[i]
+		update[i].next[i] = n
+	}
+	m.size++
+	return true
+}
+
+// Get returns the value of key. The second return value is false when the
+// key is not in the map.
+func (m *Map[K, V]) Get(key K) (V, bool) {
+	if n := m.search(key, nil); n != nil && n.key == key {
+		return n.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Delete removes key from the map and reports whether it was present.
+func (m *Map[K, V]) Delete(key K) bool {
+	var update [maxLevel]*node[K, V]
+	n := m.search(key, update[:])
+	if n == nil || n.key != key {
+		return false
+	}
+	for i := range n.next {
+		update[i].next[i] = n.next[i]
+	}
+	for m.levels > 1 && m.head.next[m.levels-1] == nil {
+		m.levels--
+	}
+	m.size--
+	return true
+}
+
+// Has reports whether key is in the map.
+func (m *Map[K, V]) Has(key K) bool {
+	n := m.search(key, nil)
+	return n != nil && n.key == key
+}
+
+// Min returns the smallest key and its value. The last return value is
+// false when the map is empty.
+func (m *Map[K, V]) Min() (K, V, bool) {
+	if n := m.head.next[0]; n != nil {
+		return n.key, n.value, true
+	}
+	var zeroKey K
+	var zeroValue V
+	return zeroKey, zeroValue, false
+}
+
+// All returns the keys in increasing order with their values as a lazy
+// sequence.
+func (m *Map[K, V]) All() iterutil.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for n := m.head.next[0]; n != nil; n = n.next[0] {
+			if !yield(n.key, n.value) {
+				return
+			}
+		}
+	}
+}
+
+// SkipList is a sorted set of keys.
+type SkipList[T constraints.Ordered] struct {
+	keys *Map[T, struct{}]
+}
+
+// New creates an empty skip list whose node levels are drawn from a random
+// source seeded with seed. Equal seeds and equal operation sequences produce
+// identical lists.
+func New[T constraints.Ordered](seed int64) *SkipList[T] {
+	return &SkipList[T]{keys: NewMap[T, struct{}](seed)}
+}
+
+// Len returns the number of keys in the list.
+func (s *SkipList[T]) Len() int {
+	return s.keys.Len()
+}
+
+// Empty reports whether the list holds no keys.
+func (s *SkipList[T]) Empty() bool {
+	return s.keys.Empty()
+}
+
+// Insert adds key to the list and reports whether it was not already there.
+func (s *SkipList[T]) Insert(key T) bool {
+	return s.keys.Put(key, struct{}{})
+}
+
+// Delete removes key from the list and reports whether it was present.
+func (s *SkipList[T]) Delete(key T) bool {
+	return s.keys.Delete(key)
+}
+
+// Has reports whether key is in the list.
+func (s *SkipList[T]) Has(key T) bool {
+	return s.keys.Has(key)
+}
+
+// Min returns the smallest key. The second return value is false when the
+// list is empty.
+func (s *SkipList[T]) Min() (T, bool) {
+	key, _, ok := s.keys.Min()
+	return key, ok
+}
+
+// All returns the keys in increasing order as a lazy sequence.
+func (s *SkipList[T]) All() iterutil.Seq[T] {
+	return iterutil.Keys(s.keys.All())
+}
+
+// InOrder returns the keys in increasing order.
+func (s *SkipList[T]) InOrder() []T {
+	keys := make([]T, 0, s.Len())
+	for n := s.keys.head.next[0]; n != nil; n = n.next[0] {
+		keys = append(keys, n.key)
+	}
+	return keys
+}
@@ -0,0 +1,122 @@
+package skiplist_test
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/structure/iterutil"
+	"github.com/TheAlgorithms/Go/structure/skiplist"
+)
+
+func TestInsertDelete(t *testing.T) {
+	s := skiplist.New[int](1)
+	if _, ok := s.Min(); ok || !s.Empty() {
+		t.Fatalf("a new list should be empty")
+	}
+	for _, k := range []int{5, 3, 8, 1, 9} {
+		if !s.Insert(k) {
+			t.Errorf("Insert(%d) = false, want true", k)
+		}
+	}
+	if s.Insert(3) {
+		t.Errorf("Insert(3) twice = true, want false")
+	}
+	if got, want := s.InOrder(), []int{1, 3, 5, 8, 9}; !reflect.DeepEqual(got, want) {
+		t.Errorf("InOrder() = %v, want %v", got, want)
+	}
+	if !s.Delete(1) || s.Delete(1) || s.Delete(4) {
+		t.Errorf("Delete should report whether the key was present")
+	}
+	if m, ok := s.Min(); !ok || m != 3 {
+		t.Errorf("Min() = %d, %v, want 3", m, ok)
+	}
+	if s.Has(1) || !s.Has(9) || s.Len() != 4 {
+		t.Errorf("Has or Len disagree with the content")
+	}
+}
+
+func TestMap(t *testing.T) {
+	m := skiplist.NewMap[string, int](2)
+	if _, _, ok := m.Min(); ok || !m.Empty() {
+		t.Fatalf("a new map should be empty")
+	}
+	for i, k := range []string{"pear", "apple", "fig", "kiwi"} {
+		if !m.Put(k, i) {
+			t.Errorf("Put(%q) = false, want true", k)
+		}
+	}
+	if m.Put("fig", 10) {
+		t.Errorf("Put(fig) twice = true, want false")
+	}
+	if v, ok := m.Get("fig"); !ok || v != 10 {
+		t.Errorf("Get(fig) = %d, %v, want the replaced value 10", v, ok)
+	}
+	if _, ok := m.Get("plum"); ok {
+		t.Errorf("Get(plum) found a missing key")
+	}
+	var keys []string
+	var values []int
+	m.All()(func(k string, v int) bool {
+		keys = append(keys, k)
+		values = append(values, v)
+		return true
+	})
+	if !reflect.DeepEqual(keys, []string{"apple", "fig", "kiwi", "pear"}) || !reflect.DeepEqual(values, []int{1, 10, 3, 0}) {
+		t.Errorf("All() = %v, %v", keys, values)
+	}
+	if !m.Delete("apple") || m.Delete("apple") || m.Len() != 3 || m.Has("apple") {
+		t.Errorf("Delete(apple) should remove the key once")
+	}
+	if k, v, ok := m.Min(); !ok || k != "fig" || v != 10 {
+		t.Errorf("Min() = %q, %d, %v, want fig, 10", k, v, ok)
+	}
+}
+
+func TestRandomOperations(t *testing.T) {
+	rnd := rand.New(rand.NewSource(7))
+	s := skiplist.New[int](7)
+	set := map[int]bool{}
+	for i := 0; i < 20000; i++ {
+		k := rnd.Intn(2000)
+		switch rnd.Intn(3) {
+		case 0:
+			if got := s.Insert(k); got == set[k] {
+				t.Fatalf("Insert(%d) = %v with the key present: %v", k, got, set[k])
+			}
+			set[k] = true
+		case 1:
+			if got := s.Delete(k); got != set[k] {
+				t.Fatalf("Delete(%d) = %v, want %v", k, got, set[k])
+			}
+			delete(set, k)
+		default:
+			if got := s.Has(k); got != set[k] {
+				t.Fatalf("Has(%d) = %v, want %v", k, got, set[k])
+			}
+		}
+	}
+	want := make([]int, 0, len(set))
+	for k := range set {
+		want = append(want, k)
+	}
+	sort.Ints(want)
+	if got := s.InOrder(); !reflect.DeepEqual(got, want) || s.Len() != len(want) {
+		t.Errorf("InOrder() has %d keys, want %d", len(got), len(want))
+	}
+	if got := iterutil.Collect(s.All()); !reflect.DeepEqual(got, want) {
+		t.Errorf("All() has %d keys, want %d", len(got), len(want))
+	}
+	if got := iterutil.Collect(iterutil.Take(s.All(), 3)); !reflect.DeepEqual(got, want[:3]) {
+		t.Errorf("taking 3 from All() = %v, want %v", got, want[:3])
+	}
+
+	// deleting every key empties the list
+	for _, k := range want {
+		s.Delete(k)
+	}
+	if !s.Empty() || len(s.InOrder()) != 0 {
+		t.Errorf("list should be empty")
+	}
+}
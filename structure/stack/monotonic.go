@@ -0,0 +1,46 @@
+// Monotonic Stack
+// description: Nearest smaller element to the left and right of every position.
+// details:
+// 	Scanning the values from left to right, a stack keeps the indices of the
+// 	values that can still be the nearest smaller element of a later position;
+// 	their values increase from bottom to top. Before pushing an index, every
+// 	index whose value is not less than the new one is popped, since the new
+// 	value hides it from every later position; the popped indices have found
+// 	their nearest smaller element to the right. Every index is pushed and
+// 	popped once, so the whole scan takes O(n) time.
+// 	All nearest smaller values : https://en.wikipedia.org/wiki/All_nearest_smaller_values
+// see monotonic_test.go
+
+package stack
+
+// NearestLess returns, for every index i, the index of the closest element
+// before i and the closest element after i that are less than values[i]
+// according to less. The index is -1 when there is no such element on the
+// left and len(values) when there is none on the right.
+func NearestLess[T any](values []T, less func(a, b T) bool) (previous, next []int) {
+	previous = make([]int, len(values))
+	next = make([]int, len(values))
+	s := NewStack[int]()
+	for i, v := range values {
+		for !s.IsEmpty() && !less(values[s.Peek()], v) {
+			s.Pop()
+		}
+		previous[i] = -1
+		if !s.IsEmpty() {
+			previous[i] = s.Peek()
+		}
+		s.Push(i)
+	}
+	s = NewStack[int]()
+	for i := len(values) - 1; i >= 0; i-- {
+		for !s.IsEmpty() && !less(values[s.Peek()], values[i]) {
+			s.Pop()
+		}
+		next[i] = len(values)
+		if !s.IsEmpty() {
+			next[i] = s.Peek()
+		}
+		s.Push(i)
+	}
+	return previous, next
+}
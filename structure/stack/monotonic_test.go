@@ -0,0 +1,43 @@
+package stack_test
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/structure/stack"
+)
+
+func TestNearestLess(t *testing.T) {
+	less := func(a, b int) bool { return a < b }
+
+	previous, next := stack.NearestLess([]int{3, 1, 4, 1, 5, 2}, less)
+	if want := []int{-1, -1, 1, -1, 3, 3}; !reflect.DeepEqual(previous, want) {
+		t.Errorf("previous = %v, want %v", previous, want)
+	}
+	if want := []int{1, 6, 3, 6, 5, 6}; !reflect.DeepEqual(next, want) {
+		t.Errorf("next = %v, want %v", next, want)
+	}
+
+	rnd := rand.New(rand.NewSource(7))
+	for round := 0; round < 100; round++ {
+		values := make([]int, rnd.Intn(30))
+		for i := range values {
+			values[i] = rnd.Intn(10)
+		}
+		previous, next := stack.NearestLess(values, less)
+		for i, v := range values {
+			p := i - 1
+			for p >= 0 && values[p] >= v {
+				p--
+			}
+			n := i + 1
+			for n < len(values) && values[n] >= v {
+				n++
+			}
+			if previous[i] != p || next[i] != n {
+				t.Fatalf("NearestLess(%v) at %d = (%d, %d), want (%d, %d)", values, i, previous[i], next[i], p, n)
+			}
+		}
+	}
+}
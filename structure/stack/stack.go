@@ -0,0 +1,95 @@
+// Stack Interface
+// description: The generic LIFO stack interface, matching the shape of queue.Interface.
+// details:
+// 	Push adds an element on top and Pop removes it again; Pop and Peek report
+// 	false when the stack is empty. Array, Stack and SList keep their own Pop
+// 	and Peek signatures and implement it through their AsInterface methods,
+// 	Stack and SList for T any.
+// 	Stack (abstract data type) : https://en.wikipedia.org/wiki/Stack_(abstract_data_type)
+// see stackarray.go, stacklinkedlist.go, stacklinkedlistwithlist.go, stack_test.go
+
+package stack
+
+// Interface is a stack of elements of type T.
+type Interface[T any] interface {
+	// Push adds value on top of the stack.
+	Push(value T)
+	// Pop removes the top element and returns it. The second return value
+	// is false when the stack is empty.
+	Pop() (T, bool)
+	// Peek returns the top element without removing it. The second return
+	// value is false when the stack is empty.
+	Peek() (T, bool)
+	// Length returns the number of elements in the stack.
+	Length() int
+	// IsEmpty reports whether the stack has no elements.
+	IsEmpty() bool
+}
+
+var _ Interface[int] = adapter[int]{}
+
+// AsInterface returns s as an Interface.
+func (s *Array[T]) AsInterface() Interface[T] {
+	return adapter[T]{
+		push:   s.Push,
+		pop:    func() T { return s.Pop() },
+		peek:   func() T { return s.Peek() },
+		length: s.Length,
+	}
+}
+
+// AsInterface returns ll as an Interface.
+func (ll *Stack) AsInterface() Interface[any] {
+	return adapter[any]{
+		push:   ll.Push,
+		pop:    ll.Pop,
+		peek:   ll.Peek,
+		length: ll.Length,
+	}
+}
+
+// AsInterface returns sl as an Interface.
+func (sl *SList) AsInterface() Interface[any] {
+	return adapter[any]{
+		push:   sl.Push,
+		pop:    func() any { v, _ := sl.Pop(); return v },
+		peek:   func() any { v, _ := sl.Peek(); return v },
+		length: sl.Length,
+	}
+}
+
+// adapter implements Interface over the methods of a concrete stack. pop
+// and peek are only called on a non-empty stack.
+type adapter[T any] struct {
+	push      func(T)
+	pop, peek func() T
+	length    func() int
+}
+
+func (a adapter[T]) Push(value T) {
+	a.push(value)
+}
+
+func (a adapter[T]) Pop() (T, bool) {
+	if a.IsEmpty() {
+		var zero T
+		return zero, false
+	}
+	return a.pop(), true
+}
+
+func (a adapter[T]) Peek() (T, bool) {
+	if a.IsEmpty() {
+		var zero T
+		return zero, false
+	}
+	return a.peek(), true
+}
+
+func (a adapter[T]) Length() int {
+	return a.length()
+}
+
+func (a adapter[T]) IsEmpty() bool {
+	return a.length() == 0
+}
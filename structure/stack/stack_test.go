@@ -186,3 +186,35 @@ func TestStackLinkedListWithList(t *testing.T) {
 		}
 	})
 }
+
+// TestInterface runs the stacks through stack.Interface.
+func TestInterface(t *testing.T) {
+	for name, s := range map[string]stack.Interface[any]{
+		"Array": stack.NewStack[any]().AsInterface(),
+		"Stack": (&stack.Stack{}).AsInterface(),
+		"SList": (&stack.SList{}).AsInterface(),
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, ok := s.Pop(); ok {
+				t.Errorf("Pop of an empty stack reported a value")
+			}
+			if _, ok := s.Peek(); ok || !s.IsEmpty() {
+				t.Errorf("Peek of an empty stack reported a value")
+			}
+			for _, v := range []any{1, "two", 3.0} {
+				s.Push(v)
+			}
+			if v, ok := s.Peek(); !ok || v != 3.0 || s.Length() != 3 {
+				t.Errorf("Peek() = %v, %t with Length() = %d, want 3, true with 3", v, ok, s.Length())
+			}
+			for _, want := range []any{3.0, "two", 1} {
+				if v, ok := s.Pop(); !ok || v != want {
+					t.Errorf("Pop() = %v, %t, want %v, true", v, ok, want)
+				}
+			}
+			if !s.IsEmpty() {
+				t.Errorf("IsEmpty() = false after popping everything")
+			}
+		})
+	}
+}
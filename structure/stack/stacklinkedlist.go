@@ -32,8 +32,11 @@ func (ll *Stack) Push(n any) {
 	ll.length++
 }
 
-// pop remove last item as first output
+// pop remove last item as first output, or return nil if the stack is empty
 func (ll *Stack) Pop() any {
+	if ll.IsEmpty() {
+		return nil
+	}
 	result := ll.top.Val
 	if ll.top.Next == nil {
 		ll.top = nil
@@ -55,8 +58,11 @@ func (ll *Stack) Length() int {
 	return ll.length
 }
 
-// peak return last input value
+// peak return last input value, or nil if the stack is empty
 func (ll *Stack) Peek() any {
+	if ll.IsEmpty() {
+		return nil
+	}
 	return ll.top.Val
 }
 
@@ -15,12 +15,16 @@ import (
 )
 
 // SList is our struct that point to stack with container/list.List library
+// Its zero value is an empty stack.
 type SList struct {
 	Stack *list.List
 }
 
 // Push add a value into our stack
 func (sl *SList) Push(val any) {
+	if sl.Stack == nil {
+		sl.Stack = list.New()
+	}
 	sl.Stack.PushFront(val)
 }
 
@@ -49,6 +53,9 @@ func (sl *SList) Pop() (any, error) {
 
 // Length return length of our stack
 func (sl *SList) Length() int {
+	if sl.Stack == nil {
+		return 0
+	}
 	return sl.Stack.Len()
 }
 
@@ -56,5 +63,5 @@ func (sl *SList) Length() int {
 func (sl *SList) IsEmpty() bool {
 	// check our stack is empty or not
 	// if is 0 it means our stack is empty otherwise is not empty
-	return sl.Stack.Len() == 0
+	return sl.Length() == 0
 }
@@ -0,0 +1,138 @@
+// bitvector.go
+// description: Static bit vector with rank and select
+// details:
+// The bits are packed into 64-bit words. Every superblock of 8 words stores
+// the number of ones before it, which costs 1/16 extra space. Rank adds a
+// superblock count and at most 8 popcounts; select binary searches the
+// superblocks and then scans at most 8 words.
+// Rank: O(1)
+// Select: O(log n)
+// reference: https://en.wikipedia.org/wiki/Succinct_data_structure#Succinct_indexable_dictionaries
+// see bitvector_test.go
+
+package succinct
+
+import "math/bits"
+
+// wordsPerBlock is the number of 64-bit words in a superblock.
+const wordsPerBlock = 8
+
+// BitVector is an immutable sequence of bits supporting rank and select.
+type BitVector struct {
+	words  []uint64
+	blocks []int // blocks[i] is the number of ones before superblock i
+	n      int
+	ones   int
+}
+
+// NewBitVector builds a BitVector holding the given bits.
+func NewBitVector(b []bool) *BitVector {
+	v := &BitVector{words: make([]uint64, (len(b)+63)/64), n: len(b)}
+	for i, bit := range b {
+		if bit {
+			v.words[i/64] |= 1 << uint(i%64)
+		}
+	}
+	v.blocks = make([]int, (len(v.words)+wordsPerBlock-1)/wordsPerBlock+1)
+	for i, w := range v.words {
+		if i%wordsPerBlock == 0 {
+			v.blocks[i/wordsPerBlock] = v.ones
+		}
+		v.ones += bits.OnesCount64(w)
+	}
+	v.blocks[len(v.blocks)-1] = v.ones
+	return v
+}
+
+// Len returns the number of bits.
+func (v *BitVector) Len() int {
+	return v.n
+}
+
+// Get returns the bit at position i.
+func (v *BitVector) Get(i int) bool {
+	return v.words[i/64]>>uint(i%64)&1 == 1
+}
+
+// Rank1 returns the number of ones in positions [0, i).
+func (v *BitVector) Rank1(i int) int {
+	if i <= 0 {
+		return 0
+	}
+	if i >= v.n {
+		return v.ones
+	}
+	w := i / 64
+	r := v.blocks[w/wordsPerBlock]
+	for j := w - w%wordsPerBlock; j < w; j++ {
+		r += bits.OnesCount64(v.words[j])
+	}
+	return r + bits.OnesCount64(v.words[w]&(1<<uint(i%64)-1))
+}
+
+// Rank0 returns the number of zeros in positions [0, i).
+func (v *BitVector) Rank0(i int) int {
+	if i <= 0 {
+		return 0
+	}
+	if i > v.n {
+		i = v.n
+	}
+	return i - v.Rank1(i)
+}
+
+// Select1 returns the position of the k-th one, counting from 1, or -1 if
+// there are fewer than k ones.
+func (v *BitVector) Select1(k int) int {
+	return v.selectBit(k, true)
+}
+
+// Select0 returns the position of the k-th zero, counting from 1, or -1 if
+// there are fewer than k zeros.
+func (v *BitVector) Select0(k int) int {
+	return v.selectBit(k, false)
+}
+
+func (v *BitVector) selectBit(k int, one bool) int {
+	total := v.ones
+	if !one {
+		total = v.n - v.ones
+	}
+	if k < 1 || k > total {
+		return -1
+	}
+	// count returns the number of matching bits before superblock b.
+	count := func(b int) int {
+		if one {
+			return v.blocks[b]
+		}
+		return b*wordsPerBlock*64 - v.blocks[b]
+	}
+	// Find the last superblock with fewer than k matching bits before it.
+	lo, hi := 0, len(v.blocks)-2
+	for lo < hi {
+		mid := (lo + hi + 1) / 2
+		if count(mid) < k {
+			lo = mid
+		} else {
+			hi = mid - 1
+		}
+	}
+	k -= count(lo)
+	for w := lo * wordsPerBlock; w < len(v.words); w++ {
+		word := v.words[w]
+		if !one {
+			word = ^word
+		}
+		c := bits.OnesCount64(word)
+		if k > c {
+			k -= c
+			continue
+		}
+		for ; k > 1; k-- {
+			word &= word - 1 // drop the lowest set bit
+		}
+		return w*64 + bits.TrailingZeros64(word)
+	}
+	return -1
+}
@@ -0,0 +1,50 @@
+package succinct
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestBitVector(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 63, 64, 65, 511, 512, 513, 3000} {
+		b := make([]bool, n)
+		for i := range b {
+			b[i] = rnd.Intn(3) == 0
+		}
+		v := NewBitVector(b)
+		if v.Len() != n {
+			t.Fatalf("Len() = %d, want %d", v.Len(), n)
+		}
+
+		ones, zeros := 0, 0
+		for i := 0; i <= n; i++ {
+			if got := v.Rank1(i); got != ones {
+				t.Fatalf("n=%d: Rank1(%d) = %d, want %d", n, i, got, ones)
+			}
+			if got := v.Rank0(i); got != zeros {
+				t.Fatalf("n=%d: Rank0(%d) = %d, want %d", n, i, got, zeros)
+			}
+			if i == n {
+				break
+			}
+			if v.Get(i) != b[i] {
+				t.Fatalf("n=%d: Get(%d) = %v, want %v", n, i, v.Get(i), b[i])
+			}
+			if b[i] {
+				ones++
+				if got := v.Select1(ones); got != i {
+					t.Fatalf("n=%d: Select1(%d) = %d, want %d", n, ones, got, i)
+				}
+			} else {
+				zeros++
+				if got := v.Select0(zeros); got != i {
+					t.Fatalf("n=%d: Select0(%d) = %d, want %d", n, zeros, got, i)
+				}
+			}
+		}
+		if v.Select1(ones+1) != -1 || v.Select0(zeros+1) != -1 || v.Select1(0) != -1 {
+			t.Errorf("n=%d: out of range selects should return -1", n)
+		}
+	}
+}
@@ -0,0 +1,4 @@
+// Package succinct implements succinct data structures, which store their
+// data in space close to the information-theoretic minimum while still
+// supporting fast queries on it.
+package succinct
@@ -0,0 +1,98 @@
+// louds.go
+// description: LOUDS (level-order unary degree sequence) tree
+// details:
+// LOUDS encodes an ordinal tree of n nodes in 2n+1 bits. The nodes are listed
+// in breadth-first order and every node writes its number of children in
+// unary, d ones followed by a zero, after a leading "10" standing for a
+// virtual super-root. A node is identified by its breadth-first index x, and
+// its own one-bit is the (x+1)-th one of the sequence. With rank and select on
+// the bits, parent, first child and next sibling are computed without storing
+// any pointer.
+// reference: https://en.wikipedia.org/wiki/Succinct_data_structure#Examples
+// see louds_test.go, bitvector.go
+
+package succinct
+
+import "errors"
+
+// LOUDS is a static ordinal tree. Nodes are numbered 0 to Len()-1 in
+// breadth-first order, the root being 0.
+type LOUDS struct {
+	bits *BitVector
+}
+
+// NewLOUDS encodes the tree rooted at 0 where children[v] lists the children
+// of node v in order. It returns the tree along with order, where order[x] is
+// the index in children of the LOUDS node x.
+func NewLOUDS(children [][]int) (*LOUDS, []int, error) {
+	if len(children) == 0 {
+		return &LOUDS{bits: NewBitVector(nil)}, nil, nil
+	}
+	b := []bool{true, false}
+	order := []int{0}
+	seen := make([]bool, len(children))
+	seen[0] = true
+	for i := 0; i < len(order); i++ {
+		for _, c := range children[order[i]] {
+			if c < 0 || c >= len(children) || seen[c] {
+				return nil, nil, errors.New("children do not describe a tree rooted at 0")
+			}
+			seen[c] = true
+			order = append(order, c)
+			b = append(b, true)
+		}
+		b = append(b, false)
+	}
+	if len(order) != len(children) {
+		return nil, nil, errors.New("some nodes are not reachable from the root")
+	}
+	return &LOUDS{bits: NewBitVector(b)}, order, nil
+}
+
+// Len returns the number of nodes of the tree.
+func (t *LOUDS) Len() int {
+	// one one-bit per node: the super-root stands for the root
+	return t.bits.Rank1(t.bits.Len())
+}
+
+// Parent returns the parent of x, or -1 for the root.
+func (t *LOUDS) Parent(x int) int {
+	p := t.bits.Select1(x + 1)
+	return t.bits.Rank0(p) - 1
+}
+
+// FirstChild returns the first child of x, or -1 if x is a leaf.
+func (t *LOUDS) FirstChild(x int) int {
+	q := t.bits.Select0(x+1) + 1
+	if q >= t.bits.Len() || !t.bits.Get(q) {
+		return -1
+	}
+	return t.bits.Rank1(q)
+}
+
+// NextSibling returns the sibling following x, or -1 if x is a last child.
+func (t *LOUDS) NextSibling(x int) int {
+	p := t.bits.Select1(x+1) + 1
+	if !t.bits.Get(p) {
+		return -1
+	}
+	return x + 1
+}
+
+// Degree returns the number of children of x.
+func (t *LOUDS) Degree(x int) int {
+	return t.bits.Select0(x+2) - t.bits.Select0(x+1) - 1
+}
+
+// Children returns the children of x in order.
+func (t *LOUDS) Children(x int) []int {
+	first := t.FirstChild(x)
+	if first < 0 {
+		return nil
+	}
+	children := make([]int, t.Degree(x))
+	for i := range children {
+		children[i] = first + i
+	}
+	return children
+}
@@ -0,0 +1,85 @@
+package succinct
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLOUDS(t *testing.T) {
+	//        0
+	//      / | \
+	//     3  1  5
+	//    / \     \
+	//   2   4     6
+	children := [][]int{{3, 1, 5}, {}, {}, {2, 4}, {}, {6}, {}}
+	tree, order, err := NewLOUDS(children)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []int{0, 3, 1, 5, 2, 4, 6}; !reflect.DeepEqual(order, want) {
+		t.Fatalf("order = %v, want %v", order, want)
+	}
+	if tree.Len() != 7 {
+		t.Errorf("Len() = %d, want 7", tree.Len())
+	}
+
+	// position of every original node in the LOUDS numbering
+	id := make([]int, len(order))
+	for x, v := range order {
+		id[v] = x
+	}
+	for v, cs := range children {
+		x := id[v]
+		var got []int
+		for _, c := range tree.Children(x) {
+			got = append(got, order[c])
+		}
+		if len(cs) == 0 {
+			cs = nil
+		}
+		if !reflect.DeepEqual(got, cs) {
+			t.Errorf("Children(%d) = %v, want %v", v, got, cs)
+		}
+		if tree.Degree(x) != len(cs) {
+			t.Errorf("Degree(%d) = %d, want %d", v, tree.Degree(x), len(cs))
+		}
+		for i, c := range cs {
+			if p := tree.Parent(id[c]); p != x {
+				t.Errorf("Parent(%d) = %d, want %d", c, order[p], v)
+			}
+			next := tree.NextSibling(id[c])
+			if i+1 < len(cs) && (next < 0 || order[next] != cs[i+1]) {
+				t.Errorf("NextSibling(%d) = %d, want %d", c, next, cs[i+1])
+			}
+			if i+1 == len(cs) && next != -1 {
+				t.Errorf("NextSibling(%d) = %d, want -1", c, next)
+			}
+		}
+	}
+	if tree.Parent(0) != -1 {
+		t.Errorf("Parent of the root should be -1")
+	}
+	if tree.FirstChild(id[6]) != -1 {
+		t.Errorf("FirstChild of a leaf should be -1")
+	}
+	if tree.NextSibling(0) != -1 {
+		t.Errorf("the root has no sibling")
+	}
+}
+
+func TestLOUDSInvalid(t *testing.T) {
+	for _, children := range [][][]int{
+		{{1}, {0}},   // cycle back to the root
+		{{1, 1}, {}}, // repeated child
+		{{}, {}},     // unreachable node
+		{{2}, {}},    // child out of range
+	} {
+		if _, _, err := NewLOUDS(children); err == nil {
+			t.Errorf("NewLOUDS(%v) should fail", children)
+		}
+	}
+	tree, _, err := NewLOUDS(nil)
+	if err != nil || tree.Len() != 0 {
+		t.Errorf("empty tree: Len() = %d, err = %v", tree.Len(), err)
+	}
+}
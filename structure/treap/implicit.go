@@ -0,0 +1,338 @@
+// implicit.go
+// description: Implicit treap, a sequence with logarithmic edits
+// details:
+// An implicit treap stores a sequence instead of a sorted set: the key of a
+// node is its position, which is never stored but given by the sizes of the
+// subtrees to its left. Splitting after the first k elements and merging two
+// sequences thus take O(log n) expected time, and every other operation is a
+// split into the part of interest, an action on its root, and a merge back:
+// inserting or erasing at any position, or cutting and pasting whole ranges.
+// Every node keeps the aggregate of its subtree under an associative
+// operation, so that the aggregate of any range is the aggregate of the root
+// of the split off range. Reversing a range only flips it at the root and
+// marks it; the mark is pushed down to the children lazily, on the next
+// visit. As the operation does not have to be commutative, the aggregate of
+// the reversed subtree is kept too, and a flip swaps both.
+// Insert, Erase, At, Set, Reverse, Aggregate, Split, Concat: O(log n) expected
+// reference: https://cp-algorithms.com/data_structures/treap.html#implicit-treaps
+// see implicit_test.go
+
+package treap
+
+import (
+	"math/rand"
+
+	"github.com/TheAlgorithms/Go/structure/iterutil"
+)
+
+// implicitNode is a node of an implicit treap.
+type implicitNode[T any] struct {
+	value    T
+	agg      T // aggregate of the subtree in order
+	rev      T // aggregate of the subtree in reverse order
+	priority int64
+	size     int
+	reversed bool // the children are still to be swapped and flipped
+	left     *implicitNode[T]
+	right    *implicitNode[T]
+}
+
+func implicitSize[T any](n *implicitNode[T]) int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+// flip reverses the subtree of n: its aggregates are swapped at once, its
+// children when it is next pushed.
+func flip[T any](n *implicitNode[T]) {
+	if n != nil {
+		n.agg, n.rev = n.rev, n.agg
+		n.reversed = !n.reversed
+	}
+}
+
+// push applies the pending reversal of n to its children.
+func push[T any](n *implicitNode[T]) {
+	if n.reversed {
+		n.left, n.right = n.right, n.left
+		flip(n.left)
+		flip(n.right)
+		n.reversed = false
+	}
+}
+
+// Implicit is a sequence supporting insertion, deletion, reversal and
+// aggregation of ranges at any position.
+type Implicit[T any] struct {
+	root *implicitNode[T]
+	op   func(a, b T) T
+	rnd  *rand.Rand
+}
+
+// NewImplicit creates an empty sequence aggregating its elements with op,
+// which must be associative. Node priorities are drawn from a random source
+// seeded with seed.
+func NewImplicit[T any](op func(a, b T) T, seed int64) *Implicit[T] {
+	return &Implicit[T]{op: op, rnd: rand.New(rand.NewSource(seed))}
+}
+
+// update recomputes the size and aggregates of n from its children, which
+// must have been pushed into place.
+func (s *Implicit[T]) update(n *implicitNode[T]) {
+	n.size = 1 + implicitSize(n.left) + implicitSize(n.right)
+	n.agg, n.rev = n.value, n.value
+	if n.left != nil {
+		n.agg = s.op(n.left.agg, n.agg)
+		n.rev = s.op(n.rev, n.left.rev)
+	}
+	if n.right != nil {
+		n.agg = s.op(n.agg, n.right.agg)
+		n.rev = s.op(n.right.rev, n.rev)
+	}
+}
+
+// split splits n into its first k elements and the rest.
+func (s *Implicit[T]) split(n *implicitNode[T], k int) (*implicitNode[T], *implicitNode[T]) {
+	if n == nil {
+		return nil, nil
+	}
+	push(n)
+	leftSize := implicitSize(n.left)
+	if k <= leftSize {
+		l, r := s.split(n.left, k)
+		n.left = r
+		s.update(n)
+		return l, n
+	}
+	l, r := s.split(n.right, k-leftSize-1)
+	n.right = l
+	s.update(n)
+	return n, r
+}
+
+// merge concatenates the sequences a and b.
+func (s *Implicit[T]) merge(a, b *implicitNode[T]) *implicitNode[T] {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	if a.priority > b.priority {
+		push(a)
+		a.right = s.merge(a.right, b)
+		s.update(a)
+		return a
+	}
+	push(b)
+	b.left = s.merge(a, b.left)
+	s.update(b)
+	return b
+}
+
+// Len returns the number of elements of the sequence.
+func (s *Implicit[T]) Len() int {
+	return implicitSize(s.root)
+}
+
+// Insert inserts v at position i, between 0 and Len() included, and reports
+// whether i is valid.
+func (s *Implicit[T]) Insert(i int, v T) bool {
+	if i < 0 || i > s.Len() {
+		return false
+	}
+	n := &implicitNode[T]{value: v, priority: s.rnd.Int63()}
+	s.update(n)
+	left, right := s.split(s.root, i)
+	s.root = s.merge(s.merge(left, n), right)
+	return true
+}
+
+// Append adds v at the end of the sequence.
+func (s *Implicit[T]) Append(v T) {
+	s.Insert(s.Len(), v)
+}
+
+// Erase removes the element at position i and returns it. The second return
+// value is false when i is outside [0, Len()).
+func (s *Implicit[T]) Erase(i int) (T, bool) {
+	if i < 0 || i >= s.Len() {
+		var zero T
+		return zero, false
+	}
+	left, rest := s.split(s.root, i)
+	mid, right := s.split(rest, 1)
+	s.root = s.merge(left, right)
+	return mid.value, true
+}
+
+// At returns the element at position i. The second return value is false
+// when i is outside [0, Len()).
+func (s *Implicit[T]) At(i int) (T, bool) {
+	if i < 0 || i >= s.Len() {
+		var zero T
+		return zero, false
+	}
+	n := s.root
+	for {
+		push(n)
+		leftSize := implicitSize(n.left)
+		switch {
+		case i < leftSize:
+			n = n.left
+		case i == leftSize:
+			return n.value, true
+		default:
+			i -= leftSize + 1
+			n = n.right
+		}
+	}
+}
+
+// Set replaces the element at position i with v and reports whether i is
+// in [0, Len()).
+func (s *Implicit[T]) Set(i int, v T) bool {
+	if i < 0 || i >= s.Len() {
+		return false
+	}
+	left, rest := s.split(s.root, i)
+	mid, right := s.split(rest, 1)
+	mid.value = v
+	s.update(mid)
+	s.root = s.merge(s.merge(left, mid), right)
+	return true
+}
+
+// validRange reports whether [l, r) is a non-empty range of the sequence.
+func (s *Implicit[T]) validRange(l, r int) bool {
+	return 0 <= l && l < r && r <= s.Len()
+}
+
+// Reverse reverses the elements at positions l to r-1 and reports whether
+// [l, r) is a non-empty range of the sequence.
+func (s *Implicit[T]) Reverse(l, r int) bool {
+	if !s.validRange(l, r) {
+		return false
+	}
+	left, rest := s.split(s.root, l)
+	mid, right := s.split(rest, r-l)
+	flip(mid)
+	s.root = s.merge(s.merge(left, mid), right)
+	return true
+}
+
+// Aggregate returns op applied to the elements at positions l to r-1, in
+// order. The second return value is false when [l, r) is not a non-empty
+// range of the sequence.
+func (s *Implicit[T]) Aggregate(l, r int) (T, bool) {
+	if !s.validRange(l, r) {
+		var zero T
+		return zero, false
+	}
+	left, rest := s.split(s.root, l)
+	mid, right := s.split(rest, r-l)
+	agg := mid.agg
+	s.root = s.merge(s.merge(left, mid), right)
+	return agg, true
+}
+
+// Split cuts the sequence after its first k elements, k being clamped to
+// [0, Len()], and returns both parts. The receiver is left empty. Both
+// results share the operation and the random source of the receiver.
+func (s *Implicit[T]) Split(k int) (*Implicit[T], *Implicit[T]) {
+	if k < 0 {
+		k = 0
+	}
+	left, right := s.split(s.root, k)
+	s.root = nil
+	return &Implicit[T]{root: left, op: s.op, rnd: s.rnd}, &Implicit[T]{root: right, op: s.op, rnd: s.rnd}
+}
+
+// Concat appends the elements of other to s and leaves other empty. Both
+// sequences must use the same operation.
+func (s *Implicit[T]) Concat(other *Implicit[T]) {
+	s.root = s.merge(s.root, other.root)
+	other.root = nil
+}
+
+// Clone returns an independent copy of the sequence with the same
+// operation. Every element is passed through cloneValue, if it is not nil.
+// The copy draws its priorities from a random source of its own, seeded
+// from the receiver's.
+func (s *Implicit[T]) Clone(cloneValue func(T) T) *Implicit[T] {
+	c := &Implicit[T]{op: s.op, rnd: rand.New(rand.NewSource(s.rnd.Int63()))}
+	var clone func(n *implicitNode[T]) *implicitNode[T]
+	clone = func(n *implicitNode[T]) *implicitNode[T] {
+		if n == nil {
+			return nil
+		}
+		// pending reversals are applied so that the aggregates can be
+		// recomputed from the cloned values
+		push(n)
+		m := &implicitNode[T]{value: n.value, priority: n.priority}
+		if cloneValue != nil {
+			m.value = cloneValue(n.value)
+		}
+		m.left, m.right = clone(n.left), clone(n.right)
+		c.update(m)
+		return m
+	}
+	c.root = clone(s.root)
+	return c
+}
+
+// Equal reports whether s and other hold equal elements in the same order,
+// according to eq or, if eq is nil, to == on the elements, which panics
+// like == on interfaces if they are not comparable.
+func (s *Implicit[T]) Equal(other *Implicit[T], eq func(a, b T) bool) bool {
+	a, b := s.Values(), other.Values()
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if eq == nil && any(a[i]) != any(b[i]) || eq != nil && !eq(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Values returns the elements of the sequence in order.
+func (s *Implicit[T]) Values() []T {
+	values := make([]T, 0, s.Len())
+	var walk func(n *implicitNode[T])
+	walk = func(n *implicitNode[T]) {
+		if n == nil {
+			return
+		}
+		push(n)
+		walk(n.left)
+		values = append(values, n.value)
+		walk(n.right)
+	}
+	walk(s.root)
+	return values
+}
+
+// All returns the positions and the elements of the sequence in order as a
+// lazy sequence. The sequence must not be modified during the iteration.
+func (s *Implicit[T]) All() iterutil.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		var walk func(n *implicitNode[T]) bool
+		walk = func(n *implicitNode[T]) bool {
+			if n == nil {
+				return true
+			}
+			push(n)
+			if !walk(n.left) || !yield(i, n.value) {
+				return false
+			}
+			i++
+			return walk(n.right)
+		}
+		walk(s.root)
+	}
+}
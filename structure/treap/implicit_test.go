@@ -0,0 +1,147 @@
+package treap_test
+
+import (
+	"math/rand"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/structure/treap"
+)
+
+func concat(a, b string) string { return a + b }
+
+func TestImplicit(t *testing.T) {
+	s := treap.NewImplicit(concat, 1)
+	for _, v := range []string{"a", "b", "c", "d", "e"} {
+		s.Append(v)
+	}
+	if !s.Insert(2, "X") || s.Insert(7, "Y") || s.Insert(-1, "Y") {
+		t.Errorf("Insert did not check its position")
+	}
+	if got := strings.Join(s.Values(), ""); got != "abXcde" {
+		t.Errorf("Values() = %q, want abXcde", got)
+	}
+	if !s.Reverse(1, 5) {
+		t.Errorf("Reverse(1, 5) = false")
+	}
+	if got, _ := s.Aggregate(0, 6); got != "adcXbe" {
+		t.Errorf("Aggregate(0, 6) = %q after reversal, want adcXbe", got)
+	}
+	if got, _ := s.Aggregate(2, 4); got != "cX" {
+		t.Errorf("Aggregate(2, 4) = %q, want cX", got)
+	}
+	if v, ok := s.Erase(3); !ok || v != "X" {
+		t.Errorf("Erase(3) = %q, %t, want X", v, ok)
+	}
+	if _, ok := s.Aggregate(3, 3); ok {
+		t.Errorf("Aggregate of an empty range reported a value")
+	}
+	if v, ok := s.At(1); !ok || v != "d" {
+		t.Errorf("At(1) = %q, %t, want d", v, ok)
+	}
+
+	left, right := s.Split(2)
+	if s.Len() != 0 || strings.Join(left.Values(), "") != "ad" || strings.Join(right.Values(), "") != "cbe" {
+		t.Fatalf("Split(2) = %v, %v", left.Values(), right.Values())
+	}
+	right.Concat(left)
+	if got := strings.Join(right.Values(), ""); got != "cbead" || left.Len() != 0 {
+		t.Errorf("Concat = %q, want cbead", got)
+	}
+}
+
+func TestImplicitAll(t *testing.T) {
+	s := treap.NewImplicit(concat, 3)
+	for _, v := range []string{"a", "b", "c", "d", "e", "f"} {
+		s.Append(v)
+	}
+	s.Reverse(1, 4)
+	var got []string
+	s.All()(func(i int, v string) bool {
+		if len(got) != i {
+			t.Errorf("All gave position %d for the element %d", i, len(got))
+		}
+		got = append(got, v)
+		return true
+	})
+	if strings.Join(got, "") != "adcbef" {
+		t.Errorf("All gave %v, want adcbef", got)
+	}
+	visited := 0
+	s.All()(func(int, string) bool {
+		visited++
+		return visited < 2
+	})
+	if visited != 2 {
+		t.Errorf("All did not stop, visited %d elements", visited)
+	}
+}
+
+func TestImplicitCloneEqual(t *testing.T) {
+	s := treap.NewImplicit(concat, 2)
+	for _, v := range []string{"a", "b", "c", "d"} {
+		s.Append(v)
+	}
+	s.Reverse(1, 4)
+	upper := s.Clone(strings.ToUpper)
+	if got, _ := upper.Aggregate(0, 4); got != "ADCB" {
+		t.Errorf("Aggregate of the cloned sequence = %q, want ADCB", got)
+	}
+	if s.Equal(upper, nil) || !s.Equal(upper, strings.EqualFold) {
+		t.Errorf("Equal of an upper case clone should only hold with EqualFold")
+	}
+
+	clone := s.Clone(nil)
+	if !s.Equal(clone, nil) {
+		t.Fatalf("a clone should equal its original")
+	}
+	clone.Reverse(0, 4)
+	if got := strings.Join(s.Values(), ""); got != "adcb" || s.Equal(clone, nil) {
+		t.Errorf("reversing a clone changed the original to %q", got)
+	}
+}
+
+func TestImplicitRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	s := treap.NewImplicit(concat, 2)
+	var model []string
+	for i := 0; i < 5000; i++ {
+		n := len(model)
+		switch op := rnd.Intn(6); {
+		case op == 0 || n == 0:
+			pos, v := rnd.Intn(n+1), string(rune('a'+rnd.Intn(26)))
+			s.Insert(pos, v)
+			model = append(model[:pos], append([]string{v}, model[pos:]...)...)
+		case op == 1:
+			pos := rnd.Intn(n)
+			if v, ok := s.Erase(pos); !ok || v != model[pos] {
+				t.Fatalf("Erase(%d) = %q, %t, want %q", pos, v, ok, model[pos])
+			}
+			model = append(model[:pos], model[pos+1:]...)
+		case op == 2:
+			pos, v := rnd.Intn(n), string(rune('A'+rnd.Intn(26)))
+			s.Set(pos, v)
+			model[pos] = v
+		case op == 3:
+			l := rnd.Intn(n)
+			r := l + 1 + rnd.Intn(n-l)
+			s.Reverse(l, r)
+			for a, b := l, r-1; a < b; a, b = a+1, b-1 {
+				model[a], model[b] = model[b], model[a]
+			}
+		default:
+			l := rnd.Intn(n)
+			r := l + 1 + rnd.Intn(n-l)
+			if got, _ := s.Aggregate(l, r); got != strings.Join(model[l:r], "") {
+				t.Fatalf("Aggregate(%d, %d) = %q, want %q", l, r, got, strings.Join(model[l:r], ""))
+			}
+		}
+		if s.Len() != len(model) {
+			t.Fatalf("Len() = %d, want %d", s.Len(), len(model))
+		}
+	}
+	if got := s.Values(); !reflect.DeepEqual(got, model) {
+		t.Errorf("Values() = %v, want %v", got, model)
+	}
+}
@@ -0,0 +1,301 @@
+// treap.go
+// description: Order-statistic treap
+// details:
+// A treap is a binary search tree where every node also carries a random
+// priority and the tree is kept heap-ordered on those priorities. The random
+// priorities keep the expected height at O(log n) without any rebalancing
+// rules. Every node additionally stores the size of its subtree, which makes
+// the treap usable as an indexed sorted sequence: the k-th smallest key and
+// the rank of a key can both be found in O(log n) expected time.
+// All operations are built on top of split and merge.
+// Insert: O(log n) expected
+// Delete: O(log n) expected
+// Kth: O(log n) expected
+// Rank: O(log n) expected
+// Search: O(log n) expected
+// reference: https://en.wikipedia.org/wiki/Treap
+// see treap_test.go
+
+// Package treap implements randomized treaps: an order-statistic treap over
+// sorted keys and an implicit treap over sequences.
+package treap
+
+import (
+	"math/rand"
+
+	"github.com/TheAlgorithms/Go/constraints"
+	"github.com/TheAlgorithms/Go/structure/iterutil"
+)
+
+type node[T constraints.Ordered] struct {
+	key      T
+	priority int64
+	size     int
+	left     *node[T]
+	right    *node[T]
+}
+
+func (n *node[T]) update() {
+	n.size = 1 + size(n.left) + size(n.right)
+}
+
+func size[T constraints.Ordered](n *node[T]) int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
+
+// Treap is a randomized balanced binary search tree which keeps track of
+// subtree sizes. Duplicate keys are allowed, so it behaves as a sorted multiset.
+type Treap[T constraints.Ordered] struct {
+	root *node[T]
+	rnd  *rand.Rand
+}
+
+// New creates an empty treap whose node priorities are drawn from a random
+// source seeded with seed. Equal seeds and equal operation sequences produce
+// identically shaped trees.
+func New[T constraints.Ordered](seed int64) *Treap[T] {
+	return &Treap[T]{rnd: rand.New(rand.NewSource(seed))}
+}
+
+// Len returns the number of keys stored in the treap.
+func (t *Treap[T]) Len() int {
+	return size(t.root)
+}
+
+// Empty reports whether the treap holds no keys.
+func (t *Treap[T]) Empty() bool {
+	return t.root == nil
+}
+
+// Insert adds key to the treap. Inserting a key that is already present
+// stores another copy of it.
+func (t *Treap[T]) Insert(key T) {
+	left, right := splitLess(t.root, key)
+	n := &node[T]{key: key, priority: t.rnd.Int63(), size: 1}
+	t.root = merge(merge(left, n), right)
+}
+
+// Delete removes a single occurrence of key and reports whether it was present.
+func (t *Treap[T]) Delete(key T) bool {
+	left, rest := splitLess(t.root, key)
+	mid, right := splitLessOrEqual(rest, key)
+	found := mid != nil
+	if found {
+		mid = merge(mid.left, mid.right)
+	}
+	t.root = merge(merge(left, mid), right)
+	return found
+}
+
+// Has reports whether key is stored in the treap.
+func (t *Treap[T]) Has(key T) bool {
+	for n := t.root; n != nil; {
+		switch {
+		case key < n.key:
+			n = n.left
+		case n.key < key:
+			n = n.right
+		default:
+			return true
+		}
+	}
+	return false
+}
+
+// Kth returns the k-th smallest key, counting from 1. The second return value
+// is false when k is outside [1, Len()].
+func (t *Treap[T]) Kth(k int) (T, bool) {
+	var zero T
+	if k < 1 || k > t.Len() {
+		return zero, false
+	}
+	n := t.root
+	for {
+		leftSize := size(n.left)
+		switch {
+		case k <= leftSize:
+			n = n.left
+		case k == leftSize+1:
+			return n.key, true
+		default:
+			k -= leftSize + 1
+			n = n.right
+		}
+	}
+}
+
+// Rank returns the number of stored keys strictly smaller than key.
+// For a key present in the treap this is its zero-based position in
+// sorted order.
+func (t *Treap[T]) Rank(key T) int {
+	rank := 0
+	for n := t.root; n != nil; {
+		if n.key < key {
+			rank += size(n.left) + 1
+			n = n.right
+		} else {
+			n = n.left
+		}
+	}
+	return rank
+}
+
+// Search returns the smallest key for which f is true, with false if there
+// is none. As with sort.Search, f must be false for the keys below some key
+// and true from it on.
+func (t *Treap[T]) Search(f func(T) bool) (T, bool) {
+	var found T
+	ok := false
+	for n := t.root; n != nil; {
+		if f(n.key) {
+			found, ok = n.key, true
+			n = n.left
+		} else {
+			n = n.right
+		}
+	}
+	return found, ok
+}
+
+// Split divides the treap into two treaps: the first holds every key strictly
+// smaller than key and the second holds the rest. The receiver is left empty.
+// Both results share the receiver's random source.
+func (t *Treap[T]) Split(key T) (*Treap[T], *Treap[T]) {
+	left, right := splitLess(t.root, key)
+	t.root = nil
+	return &Treap[T]{root: left, rnd: t.rnd}, &Treap[T]{root: right, rnd: t.rnd}
+}
+
+// Merge moves every key of other into t and leaves other empty. All keys in t
+// must be less than or equal to all keys in other; Merge reports false and
+// leaves both treaps untouched otherwise.
+func (t *Treap[T]) Merge(other *Treap[T]) bool {
+	if t.root != nil && other.root != nil {
+		maxKey, _ := t.Kth(t.Len())
+		minKey, _ := other.Kth(1)
+		if minKey < maxKey {
+			return false
+		}
+	}
+	t.root = merge(t.root, other.root)
+	other.root = nil
+	return true
+}
+
+// InOrder returns all keys in ascending order.
+func (t *Treap[T]) InOrder() []T {
+	ret := make([]T, 0, t.Len())
+	var walk func(n *node[T])
+	walk = func(n *node[T]) {
+		if n == nil {
+			return
+		}
+		walk(n.left)
+		ret = append(ret, n.key)
+		walk(n.right)
+	}
+	walk(t.root)
+	return ret
+}
+
+// All returns the keys in increasing order as a lazy sequence. The treap
+// must not be modified during the iteration.
+func (t *Treap[T]) All() iterutil.Seq[T] {
+	return func(yield func(T) bool) {
+		var walk func(n *node[T]) bool
+		walk = func(n *node[T]) bool {
+			return n == nil || walk(n.left) && yield(n.key) && walk(n.right)
+		}
+		walk(t.root)
+	}
+}
+
+// Clone returns an independent copy of the treap with the same shape. Every
+// key is passed through cloneKey, if it is not nil, which must keep the
+// order of the keys. The copy draws its priorities from a random source of
+// its own, seeded from the receiver's.
+func (t *Treap[T]) Clone(cloneKey func(T) T) *Treap[T] {
+	var clone func(n *node[T]) *node[T]
+	clone = func(n *node[T]) *node[T] {
+		if n == nil {
+			return nil
+		}
+		c := &node[T]{key: n.key, priority: n.priority, size: n.size}
+		if cloneKey != nil {
+			c.key = cloneKey(n.key)
+		}
+		c.left, c.right = clone(n.left), clone(n.right)
+		return c
+	}
+	return &Treap[T]{root: clone(t.root), rnd: rand.New(rand.NewSource(t.rnd.Int63()))}
+}
+
+// Equal reports whether t and other hold equal keys, with as many copies of
+// each, according to eq or to == if eq is nil, whatever their shape.
+func (t *Treap[T]) Equal(other *Treap[T], eq func(a, b T) bool) bool {
+	a, b := t.InOrder(), other.InOrder()
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if eq == nil && a[i] != b[i] || eq != nil && !eq(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// splitLess splits n into keys < key and keys >= key.
+func splitLess[T constraints.Ordered](n *node[T], key T) (*node[T], *node[T]) {
+	if n == nil {
+		return nil, nil
+	}
+	if n.key < key {
+		l, r := splitLess(n.right, key)
+		n.right = l
+		n.update()
+		return n, r
+	}
+	l, r := splitLess(n.left, key)
+	n.left = r
+	n.update()
+	return l, n
+}
+
+// splitLessOrEqual splits n into keys <= key and keys > key.
+func splitLessOrEqual[T constraints.Ordered](n *node[T], key T) (*node[T], *node[T]) {
+	if n == nil {
+		return nil, nil
+	}
+	if !(key < n.key) {
+		l, r := splitLessOrEqual(n.right, key)
+		n.right = l
+		n.update()
+		return n, r
+	}
+	l, r := splitLessOrEqual(n.left, key)
+	n.left = r
+	n.update()
+	return l, n
+}
+
+// merge joins two treaps where every key in a is <= every key in b.
+func merge[T constraints.Ordered](a, b *node[T]) *node[T] {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	if a.priority > b.priority {
+		a.right = merge(a.right, b)
+		a.update()
+		return a
+	}
+	b.left = merge(a, b.left)
+	b.update()
+	return b
+}
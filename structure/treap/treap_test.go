@@ -0,0 +1,149 @@
+package treap_test
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/structure/iterutil"
+	"github.com/TheAlgorithms/Go/structure/treap"
+)
+
+func TestInsertDelete(t *testing.T) {
+	tr := treap.New[int](1)
+	for _, k := range []int{5, 3, 8, 3, 1, 9} {
+		tr.Insert(k)
+	}
+
+	if got, want := tr.InOrder(), []int{1, 3, 3, 5, 8, 9}; !reflect.DeepEqual(got, want) {
+		t.Errorf("InOrder() = %v, want %v", got, want)
+	}
+
+	if !tr.Delete(3) {
+		t.Errorf("Delete(3) = false, want true")
+	}
+	if !tr.Has(3) {
+		t.Errorf("Has(3) = false after deleting one of two copies")
+	}
+	if tr.Delete(4) {
+		t.Errorf("Delete(4) = true, want false")
+	}
+	if got, want := tr.InOrder(), []int{1, 3, 5, 8, 9}; !reflect.DeepEqual(got, want) {
+		t.Errorf("InOrder() = %v, want %v", got, want)
+	}
+	if tr.Len() != 5 {
+		t.Errorf("Len() = %d, want 5", tr.Len())
+	}
+}
+
+func TestKthRank(t *testing.T) {
+	rnd := rand.New(rand.NewSource(42))
+	tr := treap.New[int](7)
+	var keys []int
+	for i := 0; i < 500; i++ {
+		k := rnd.Intn(200)
+		keys = append(keys, k)
+		tr.Insert(k)
+	}
+	sort.Ints(keys)
+
+	for i, want := range keys {
+		got, ok := tr.Kth(i + 1)
+		if !ok || got != want {
+			t.Fatalf("Kth(%d) = %d, %v, want %d, true", i+1, got, ok, want)
+		}
+	}
+	if _, ok := tr.Kth(0); ok {
+		t.Errorf("Kth(0) should be out of range")
+	}
+	if _, ok := tr.Kth(len(keys) + 1); ok {
+		t.Errorf("Kth(%d) should be out of range", len(keys)+1)
+	}
+
+	for x := -1; x <= 201; x++ {
+		want := sort.SearchInts(keys, x)
+		if got := tr.Rank(x); got != want {
+			t.Fatalf("Rank(%d) = %d, want %d", x, got, want)
+		}
+		got, ok := tr.Search(func(k int) bool { return k >= x })
+		if ok != (want < len(keys)) || ok && got != keys[want] {
+			t.Fatalf("Search(k >= %d) = %d, %v", x, got, ok)
+		}
+	}
+}
+
+func TestSplitMerge(t *testing.T) {
+	tr := treap.New[string](3)
+	for _, k := range []string{"d", "a", "c", "e", "b"} {
+		tr.Insert(k)
+	}
+
+	left, right := tr.Split("c")
+	if !tr.Empty() {
+		t.Errorf("receiver should be empty after Split")
+	}
+	if got, want := left.InOrder(), []string{"a", "b"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("left = %v, want %v", got, want)
+	}
+	if got, want := right.InOrder(), []string{"c", "d", "e"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("right = %v, want %v", got, want)
+	}
+
+	if right.Merge(left) {
+		t.Errorf("Merge of overlapping ranges should fail")
+	}
+	if !left.Merge(right) {
+		t.Errorf("Merge of ordered ranges should succeed")
+	}
+	if !right.Empty() {
+		t.Errorf("argument should be empty after Merge")
+	}
+	if got, want := left.InOrder(), []string{"a", "b", "c", "d", "e"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("merged = %v, want %v", got, want)
+	}
+}
+
+func TestAll(t *testing.T) {
+	tr := treap.New[int](5)
+	if got := iterutil.Collect(tr.All()); len(got) != 0 {
+		t.Errorf("All of an empty treap = %v", got)
+	}
+	keys := rand.New(rand.NewSource(5)).Perm(200)
+	for _, k := range keys {
+		tr.Insert(k % 50)
+	}
+	if got := iterutil.Collect(tr.All()); !reflect.DeepEqual(got, tr.InOrder()) {
+		t.Errorf("All = %v, want %v", got, tr.InOrder())
+	}
+	if got := iterutil.Collect(iterutil.Take(tr.All(), 6)); !reflect.DeepEqual(got, []int{0, 0, 0, 0, 1, 1}) {
+		t.Errorf("taking 6 from All = %v", got)
+	}
+}
+
+func TestCloneEqual(t *testing.T) {
+	tr := treap.New[int](4)
+	for _, k := range []int{5, 1, 4, 1, 3} {
+		tr.Insert(k)
+	}
+	clone := tr.Clone(nil)
+	if !tr.Equal(clone, nil) {
+		t.Fatalf("a clone should equal its original")
+	}
+	clone.Delete(1)
+	if tr.Equal(clone, nil) || tr.Len() != 5 {
+		t.Errorf("deleting from a clone should leave the original alone")
+	}
+	clone.Insert(1)
+	if !tr.Equal(clone, nil) {
+		t.Errorf("trees with the same keys should be equal whatever their shape")
+	}
+
+	scaled := tr.Clone(func(k int) int { return 10 * k })
+	if tr.Equal(scaled, nil) || !tr.Equal(scaled, func(a, b int) bool { return 10*a == b }) {
+		t.Errorf("Equal of a scaled clone = %v, want it equal only with eq", scaled.InOrder())
+	}
+	if !treap.New[int](1).Equal(treap.New[int](2), nil) {
+		t.Errorf("empty treaps should be equal")
+	}
+}
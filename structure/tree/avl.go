@@ -1,5 +1,15 @@
 // AVL tree is a self-balancing binary search tree.
 //
+// Snapshot returns a read-only view of the tree in O(1), after which the
+// tree becomes copy-on-write: every node belongs to the generation of the
+// tree that created it, and Snapshot starts a new generation, freezing the
+// nodes shared with the view. An update copies the frozen nodes on the path
+// it changes, and the rotations their children, instead of changing them, so
+// the view keeps the keys it had while the tree goes on changing, for
+// O(log n) copied nodes per update. A frozen node keeps the parent link it
+// had when it was frozen, although the tree may have copied that parent
+// since, so the Parent of a node shared with a snapshot is unreliable.
+//
 // For more details check out those link below here:
 // Wikipedia article: https://en.wikipedia.org/wiki/AVL_tree
 // see avl.go
@@ -9,6 +19,7 @@ package tree
 import (
 	"github.com/TheAlgorithms/Go/constraints"
 	"github.com/TheAlgorithms/Go/math/max"
+	"github.com/TheAlgorithms/Go/structure/iterutil"
 )
 
 // Verify Interface Compliance
@@ -21,12 +32,16 @@ type AVLNode[T constraints.Ordered] struct {
 	left   *AVLNode[T]
 	right  *AVLNode[T]
 	height int
+	gen    int // generation of the tree that may change the node
 }
 
 func (n *AVLNode[T]) Key() T {
 	return n.key
 }
 
+// Parent returns the parent of the node. For a node shared between the tree
+// and its snapshots, it is the parent the node had when the first of them
+// was taken, which may not be its parent in the tree or in later snapshots.
 func (n *AVLNode[T]) Parent() Node[T] {
 	return n.parent
 }
@@ -46,8 +61,11 @@ func (n *AVLNode[T]) Height() int {
 // AVL represents a AVL tree.
 // By default, _NIL = nil.
 type AVL[T constraints.Ordered] struct {
-	Root *AVLNode[T]
-	_NIL *AVLNode[T] // a sentinel value for nil
+	Root   *AVLNode[T]
+	_NIL   *AVLNode[T] // a sentinel value for nil
+	gen    int         // generation of the nodes the tree may change
+	frozen bool        // whether the tree is a snapshot
+	visit  func(T)     // called with the nodes walked through, if not nil
 }
 
 // NewAVL creates a novel AVL tree
@@ -64,33 +82,95 @@ func (avl *AVL[T]) Empty() bool {
 }
 
 // Push a chain of Node's into the AVL Tree
+// It panics if the tree is a snapshot.
 func (avl *AVL[T]) Push(keys ...T) {
+	avl.checkWritable()
 	for _, k := range keys {
-		avl.Root = avl.pushHelper(avl.Root, k)
+		// a key already present leaves the frozen nodes uncopied
+		if !avl.Has(k) {
+			avl.Root = avl.pushHelper(avl.Root, k)
+			avl.adoptRoot()
+		}
 	}
 }
 
 // Delete a Node from the AVL Tree
+// It panics if the tree is a snapshot.
 func (avl *AVL[T]) Delete(key T) bool {
+	avl.checkWritable()
 	if !avl.Has(key) {
 		return false
 	}
 
 	avl.Root = avl.deleteHelper(avl.Root, key)
+	avl.adoptRoot()
 	return true
 }
 
+// SetVisit sets a hook called with the key of every node that Has, Get, Push
+// and Delete walk through, or removes it if visit is nil. A node met twice
+// is reported twice: Push and Delete look for the key before updating the
+// tree. Snapshots and clones do not inherit the hook.
+func (avl *AVL[T]) SetVisit(visit func(key T)) {
+	avl.visit = visit
+}
+
 // Get a Node from the AVL Tree
 func (avl *AVL[T]) Get(key T) (Node[T], bool) {
-	return searchTreeHelper[T](avl.Root, avl._NIL, key)
+	return searchTreeHelper[T](avl.Root, avl._NIL, key, avl.visit)
 }
 
 // Has Determines the tree has the node of Key
 func (avl *AVL[T]) Has(key T) bool {
-	_, ok := searchTreeHelper[T](avl.Root, avl._NIL, key)
+	_, ok := searchTreeHelper[T](avl.Root, avl._NIL, key, avl.visit)
 	return ok
 }
 
+// Snapshot returns a read-only view of the keys the tree holds now, which
+// later updates of the tree do not change, in O(1). Updating the view
+// panics. A snapshot may be read while the tree is updated concurrently,
+// but Snapshot itself must not run concurrently with updates. The Parent of
+// the nodes it shares with the tree is unreliable, see AVLNode.Parent.
+func (avl *AVL[T]) Snapshot() *AVL[T] {
+	if !avl.frozen {
+		// the nodes created so far are now shared with the view
+		avl.gen++
+	}
+	return &AVL[T]{Root: avl.Root, _NIL: avl._NIL, frozen: true}
+}
+
+// Frozen reports whether the tree is a snapshot.
+func (avl *AVL[T]) Frozen() bool {
+	return avl.frozen
+}
+
+// Clone returns an independent copy of the tree with the same shape, which
+// may be updated even if the tree is a snapshot. Every key is passed through
+// cloneKey, if it is not nil, which must keep the order of the keys.
+func (avl *AVL[T]) Clone(cloneKey func(T) T) *AVL[T] {
+	c := NewAVL[T]()
+	var clone func(n *AVLNode[T]) *AVLNode[T]
+	clone = func(n *AVLNode[T]) *AVLNode[T] {
+		if n == avl._NIL {
+			return c._NIL
+		}
+		cn := &AVLNode[T]{key: cloneKeyOf(n.key, cloneKey), height: n.height}
+		cn.left = clone(n.left)
+		cn.right = clone(n.right)
+		c.adopt(cn)
+		return cn
+	}
+	c.Root = clone(avl.Root)
+	c.adoptRoot()
+	return c
+}
+
+// Equal reports whether avl and other hold equal keys, according to eq or
+// to == if eq is nil, whatever their shape.
+func (avl *AVL[T]) Equal(other *AVL[T], eq func(a, b T) bool) bool {
+	return equalKeys(avl.InOrder(), other.InOrder(), eq)
+}
+
 // PreOrder Traverses the tree in the following order Root --> Left --> Right
 func (avl *AVL[T]) PreOrder() []T {
 	traversal := make([]T, 0)
@@ -110,6 +190,21 @@ func (avl *AVL[T]) PostOrder() []T {
 	return traversal
 }
 
+// InOrderSeq returns the keys in the order of InOrder as a lazy sequence.
+func (avl *AVL[T]) InOrderSeq() iterutil.Seq[T] {
+	return inOrderSeq[T](avl.Root, avl._NIL)
+}
+
+// PreOrderSeq returns the keys in the order of PreOrder as a lazy sequence.
+func (avl *AVL[T]) PreOrderSeq() iterutil.Seq[T] {
+	return preOrderSeq[T](avl.Root, avl._NIL)
+}
+
+// PostOrderSeq returns the keys in the order of PostOrder as a lazy sequence.
+func (avl *AVL[T]) PostOrderSeq() iterutil.Seq[T] {
+	return postOrderSeq[T](avl.Root, avl._NIL)
+}
+
 // LevelOrder returns the level order traversal of the tree
 func (avl *AVL[T]) LevelOrder() []T {
 	traversal := make([]T, 0)
@@ -139,7 +234,7 @@ func (avl *AVL[T]) Max() (T, bool) {
 
 // Min returns the Min value of the tree
 func (avl *AVL[T]) Min() (T, bool) {
-	ret := minimum[T](avl.Root, avl._NIL)
+	ret := minimum[T](avl.Root, avl._NIL, nil)
 	if ret == avl._NIL {
 		var dft T
 		return dft, false
@@ -151,49 +246,83 @@ func (avl *AVL[T]) Min() (T, bool) {
 // if there is no predecessor, return default value of type T and false
 // otherwise return the Key of predecessor and true
 func (avl *AVL[T]) Predecessor(key T) (T, bool) {
-	node, ok := searchTreeHelper[T](avl.Root, avl._NIL, key)
-	if !ok {
-		var dft T
-		return dft, ok
+	var dft T
+	if !avl.Has(key) {
+		return dft, false
 	}
-	return predecessorHelper[T](node, avl._NIL)
+	return predecessorHelper[T](avl.Root, avl._NIL, key)
 }
 
 // Successor returns the Successor of the node of Key
 // if there is no successor, return default value of type T and false
 // otherwise return the Key of successor and true
 func (avl *AVL[T]) Successor(key T) (T, bool) {
-	node, ok := searchTreeHelper[T](avl.Root, avl._NIL, key)
-	if !ok {
+	if !avl.Has(key) {
 		var dft T
-		return dft, ok
+		return dft, false
+	}
+	return successorHelper[T](avl.Root, avl._NIL, key)
+}
+
+// checkWritable panics if the tree is a snapshot.
+func (avl *AVL[T]) checkWritable() {
+	if avl.frozen {
+		panic("tree: update of an AVL snapshot")
+	}
+}
+
+// mutable returns n if the tree may change it, and otherwise a copy of n
+// that it may change, to be linked in place of n.
+func (avl *AVL[T]) mutable(n *AVLNode[T]) *AVLNode[T] {
+	if n.gen == avl.gen {
+		return n
+	}
+	c := *n
+	c.gen = avl.gen
+	return &c
+}
+
+// adopt links the children of n that the tree may change back to n. Frozen
+// children keep their parent, as they are shared with a snapshot.
+func (avl *AVL[T]) adopt(n *AVLNode[T]) {
+	for _, c := range [...]*AVLNode[T]{n.left, n.right} {
+		if c != avl._NIL && c.gen == avl.gen {
+			c.parent = n
+		}
 	}
-	return successorHelper[T](node, avl._NIL)
 }
 
+// adoptRoot makes the root, if the tree may change it, a node without parent.
+func (avl *AVL[T]) adoptRoot() {
+	if avl.Root != avl._NIL && avl.Root.gen == avl.gen {
+		avl.Root.parent = avl._NIL
+	}
+}
+
+// pushHelper inserts key, which must not be in the tree, below root and
+// returns the new root of the subtree.
 func (avl *AVL[T]) pushHelper(root *AVLNode[T], key T) *AVLNode[T] {
 	if root == avl._NIL {
 		return &AVLNode[T]{
 			key:    key,
 			left:   avl._NIL,
 			right:  avl._NIL,
-			parent: avl._NIL,
 			height: 1,
+			gen:    avl.gen,
 		}
 	}
 
+	visitKey(avl.visit, root.key)
+	root = avl.mutable(root)
 	switch {
 	case key < root.key:
-		tmp := avl.pushHelper(root.left, key)
-		tmp.parent = root
-		root.left = tmp
+		root.left = avl.pushHelper(root.left, key)
 	case key > root.key:
-		tmp := avl.pushHelper(root.right, key)
-		tmp.parent = root
-		root.right = tmp
+		root.right = avl.pushHelper(root.right, key)
 	default:
 		return root
 	}
+	avl.adopt(root)
 
 	// balance the tree
 	root.height = avl.height(root)
@@ -221,51 +350,35 @@ func (avl *AVL[T]) pushHelper(root *AVLNode[T], key T) *AVLNode[T] {
 	return root
 }
 
+// deleteHelper removes key, which must be in the tree, from below root and
+// returns the new root of the subtree.
 func (avl *AVL[T]) deleteHelper(root *AVLNode[T], key T) *AVLNode[T] {
 	if root == avl._NIL {
 		return root
 	}
 
+	visitKey(avl.visit, root.key)
 	switch {
 	case key < root.key:
-		tmp := avl.deleteHelper(root.left, key)
-		root.left = tmp
-		if tmp != avl._NIL {
-			tmp.parent = root
-		}
+		root = avl.mutable(root)
+		root.left = avl.deleteHelper(root.left, key)
 	case key > root.key:
-		tmp := avl.deleteHelper(root.right, key)
-		root.right = tmp
-		if tmp != avl._NIL {
-			tmp.parent = root
-		}
+		root = avl.mutable(root)
+		root.right = avl.deleteHelper(root.right, key)
 	default:
 		if root.left == avl._NIL || root.right == avl._NIL {
-			tmp := root.left
-			if root.right != avl._NIL {
-				tmp = root.right
-			}
-
-			if tmp == avl._NIL {
-				root = avl._NIL
-			} else {
-				tmp.parent = root.parent
-				root = tmp
-			}
-		} else {
-			tmp := minimum[T](root.right, avl._NIL).(*AVLNode[T])
-			root.key = tmp.key
-			del := avl.deleteHelper(root.right, tmp.key)
-			root.right = del
-			if del != avl._NIL {
-				del.parent = root
+			// the remaining child takes the place of root unchanged
+			if root.left != avl._NIL {
+				return root.left
 			}
+			return root.right
 		}
+		root = avl.mutable(root)
+		tmp := minimum[T](root.right, avl._NIL, avl.visit).(*AVLNode[T])
+		root.key = tmp.key
+		root.right = avl.deleteHelper(root.right, tmp.key)
 	}
-
-	if root == avl._NIL {
-		return root
-	}
+	avl.adopt(root)
 
 	// balance the tree
 	root.height = avl.height(root)
@@ -320,37 +433,33 @@ func (avl *AVL[T]) balanceFactor(root *AVLNode[T]) int {
 	return leftHeight - rightHeight
 }
 
+// leftRotate rotates the subtree of root x, copying x and its right child if
+// they are frozen, and returns its new root.
 func (avl *AVL[T]) leftRotate(x *AVLNode[T]) *AVLNode[T] {
-	y := x.right
+	x = avl.mutable(x)
+	y := avl.mutable(x.right)
 	yl := y.left
 	y.left = x
 	x.right = yl
 
-	if yl != avl._NIL {
-		yl.parent = x
-	}
-
-	y.parent = x.parent
-	x.parent = y
-
+	avl.adopt(x)
+	avl.adopt(y)
 	x.height = avl.height(x)
 	y.height = avl.height(y)
 	return y
 }
 
+// rightRotate rotates the subtree of root x, copying x and its left child if
+// they are frozen, and returns its new root.
 func (avl *AVL[T]) rightRotate(x *AVLNode[T]) *AVLNode[T] {
-	y := x.left
+	x = avl.mutable(x)
+	y := avl.mutable(x.left)
 	yr := y.right
 	y.right = x
 	x.left = yr
 
-	if yr != avl._NIL {
-		yr.parent = x
-	}
-
-	y.parent = x.parent
-	x.parent = y
-
+	avl.adopt(x)
+	avl.adopt(y)
 	x.height = avl.height(x)
 	y.height = avl.height(y)
 	return y
@@ -1,11 +1,14 @@
 package tree_test
 
 import (
-	bt "github.com/TheAlgorithms/Go/structure/tree"
 	"math/rand"
+	"reflect"
 	"sort"
+	"sync"
 	"testing"
 	"time"
+
+	bt "github.com/TheAlgorithms/Go/structure/tree"
 )
 
 func TestAVLPush(t *testing.T) {
@@ -319,3 +322,169 @@ func TestAVLDelete(t *testing.T) {
 		}
 	})
 }
+
+// checkAVL fails the test if the subtree of n is not balanced or its heights
+// are wrong, and returns its height.
+func checkAVL(t *testing.T, n *bt.AVLNode[int]) int {
+	t.Helper()
+	if n == nil {
+		return 0
+	}
+	l := checkAVL(t, n.Left().(*bt.AVLNode[int]))
+	r := checkAVL(t, n.Right().(*bt.AVLNode[int]))
+	if l-r > 1 || r-l > 1 {
+		t.Fatalf("node %d has subtrees of heights %d and %d", n.Key(), l, r)
+	}
+	h := 1 + l
+	if r > l {
+		h = 1 + r
+	}
+	if n.Height() != h {
+		t.Fatalf("node %d has height %d, want %d", n.Key(), n.Height(), h)
+	}
+	return h
+}
+
+// checkAVLParents fails the test if a node below n does not link to its
+// parent.
+func checkAVLParents(t *testing.T, n *bt.AVLNode[int]) {
+	t.Helper()
+	for _, c := range []bt.Node[int]{n.Left(), n.Right()} {
+		if c := c.(*bt.AVLNode[int]); c != nil {
+			if c.Parent() != bt.Node[int](n) {
+				t.Fatalf("node %d does not link to its parent %d", c.Key(), n.Key())
+			}
+			checkAVLParents(t, c)
+		}
+	}
+}
+
+func TestAVLParent(t *testing.T) {
+	rnd := rand.New(rand.NewSource(3))
+	tree := bt.NewAVL[int]()
+	for i := 0; i < 2000; i++ {
+		if k := rnd.Intn(300); rnd.Intn(3) == 0 {
+			tree.Delete(k)
+		} else {
+			tree.Push(k)
+		}
+	}
+	if tree.Root.Parent() != bt.Node[int]((*bt.AVLNode[int])(nil)) {
+		t.Fatalf("the root has parent %v", tree.Root.Parent())
+	}
+	checkAVLParents(t, tree.Root)
+
+	view := tree.Snapshot()
+	checkAVLParents(t, view.Root)
+	tree.Push(-1)
+	tree.Delete(tree.InOrder()[len(tree.InOrder())/2])
+	checkAVLParents(t, view.Root)
+	checkAVLParents(t, view.Clone(nil).Root)
+}
+
+func TestAVLSnapshot(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	tree := bt.NewAVL[int]()
+	model := map[int]bool{}
+	type snapshot struct {
+		view *bt.AVL[int]
+		keys []int
+	}
+	var snapshots []snapshot
+	for i := 0; i < 3000; i++ {
+		k := rnd.Intn(200)
+		if rnd.Intn(3) == 0 {
+			if tree.Delete(k) != model[k] {
+				t.Fatalf("Delete(%d) disagrees with the model", k)
+			}
+			delete(model, k)
+		} else {
+			tree.Push(k)
+			model[k] = true
+		}
+		if i%100 == 0 {
+			snapshots = append(snapshots, snapshot{tree.Snapshot(), tree.InOrder()})
+		}
+	}
+	checkAVL(t, tree.Root)
+	keys := make([]int, 0, len(model))
+	for k := range model {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+	if got := tree.InOrder(); !reflect.DeepEqual(got, keys) {
+		t.Fatalf("tree keys = %v, want %v", got, keys)
+	}
+	for i, s := range snapshots {
+		if got := s.view.InOrder(); !reflect.DeepEqual(got, s.keys) {
+			t.Fatalf("snapshot %d changed: %v, want %v", i, got, s.keys)
+		}
+		checkAVL(t, s.view.Root)
+		if len(s.keys) > 1 {
+			if k, ok := s.view.Successor(s.keys[0]); !ok || k != s.keys[1] {
+				t.Errorf("snapshot %d: Successor(%d) = %d, %t", i, s.keys[0], k, ok)
+			}
+		}
+	}
+
+	view := snapshots[0].view
+	if !view.Frozen() || tree.Frozen() || view.Snapshot().Root != view.Root {
+		t.Errorf("Frozen = %t, %t", view.Frozen(), tree.Frozen())
+	}
+	for name, update := range map[string]func(){
+		"Push":   func() { view.Push(1000) },
+		"Delete": func() { view.Delete(snapshots[0].keys[0]) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s on a snapshot did not panic", name)
+				}
+			}()
+			update()
+		}()
+	}
+	clone := view.Clone(nil)
+	clone.Push(1000)
+	if !clone.Has(1000) || view.Has(1000) || clone.Frozen() {
+		t.Errorf("the clone of a snapshot is not independent and writable")
+	}
+}
+
+func TestAVLSnapshotConcurrentReads(t *testing.T) {
+	tree := bt.NewAVL[int]()
+	for k := 0; k < 1000; k++ {
+		tree.Push(k)
+	}
+	view := tree.Snapshot()
+	var wg sync.WaitGroup
+	for r := 0; r < 4; r++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 20; i++ {
+				want := 0
+				view.InOrderSeq()(func(k int) bool {
+					if k != want {
+						t.Errorf("snapshot iteration met %d, want %d", k, want)
+						return false
+					}
+					want++
+					return true
+				})
+				if want != 1000 {
+					t.Errorf("snapshot iteration stopped after %d keys", want)
+				}
+			}
+		}()
+	}
+	// the writer goes on while the snapshot is read
+	for k := 0; k < 1000; k += 2 {
+		tree.Delete(k)
+		tree.Push(k + 1000)
+	}
+	wg.Wait()
+	if n := len(tree.InOrder()); n != 1000 {
+		t.Errorf("the tree holds %d keys, want 1000", n)
+	}
+}
@@ -6,7 +6,10 @@
 
 package tree
 
-import "github.com/TheAlgorithms/Go/constraints"
+import (
+	"github.com/TheAlgorithms/Go/constraints"
+	"github.com/TheAlgorithms/Go/structure/iterutil"
+)
 
 // Verify Interface Compliance
 var _ Node[int] = &BSNode[int]{}
@@ -38,8 +41,9 @@ func (n *BSNode[T]) Right() Node[T] {
 // BinarySearch represents a Binary-Search tree.
 // By default, _NIL = nil.
 type BinarySearch[T constraints.Ordered] struct {
-	Root *BSNode[T]
-	_NIL *BSNode[T] // a sentinel value for nil
+	Root  *BSNode[T]
+	_NIL  *BSNode[T] // a sentinel value for nil
+	visit func(T)    // called with the nodes walked through, if not nil
 }
 
 // NewBinarySearch creates a novel Binary-Search tree
@@ -72,17 +76,49 @@ func (t *BinarySearch[T]) Delete(val T) bool {
 	return true
 }
 
+// SetVisit sets a hook called with the key of every node that Has, Get, Push
+// and Delete walk through, or removes it if visit is nil. Clones do not
+// inherit the hook.
+func (t *BinarySearch[T]) SetVisit(visit func(key T)) {
+	t.visit = visit
+}
+
 // Get a Node from the Binary-Search Tree
 func (t *BinarySearch[T]) Get(key T) (Node[T], bool) {
-	return searchTreeHelper[T](t.Root, t._NIL, key)
+	return searchTreeHelper[T](t.Root, t._NIL, key, t.visit)
 }
 
 // Has Determines the tree has the node of Key
 func (t *BinarySearch[T]) Has(key T) bool {
-	_, ok := searchTreeHelper[T](t.Root, t._NIL, key)
+	_, ok := searchTreeHelper[T](t.Root, t._NIL, key, t.visit)
 	return ok
 }
 
+// Clone returns an independent copy of the tree with the same shape. Every
+// key is passed through cloneKey, if it is not nil, which must keep the
+// order of the keys.
+func (t *BinarySearch[T]) Clone(cloneKey func(T) T) *BinarySearch[T] {
+	var clone func(n, parent *BSNode[T]) *BSNode[T]
+	clone = func(n, parent *BSNode[T]) *BSNode[T] {
+		if n == t._NIL {
+			return nil
+		}
+		c := &BSNode[T]{key: cloneKeyOf(n.key, cloneKey), parent: parent}
+		c.left = clone(n.left, c)
+		c.right = clone(n.right, c)
+		return c
+	}
+	c := NewBinarySearch[T]()
+	c.Root = clone(t.Root, nil)
+	return c
+}
+
+// Equal reports whether t and other hold equal keys, according to eq or to
+// == if eq is nil, whatever their shape.
+func (t *BinarySearch[T]) Equal(other *BinarySearch[T], eq func(a, b T) bool) bool {
+	return equalKeys(t.InOrder(), other.InOrder(), eq)
+}
+
 // PreOrder Traverses the tree in the following order Root --> Left --> Right
 func (t *BinarySearch[T]) PreOrder() []T {
 	traversal := make([]T, 0)
@@ -102,6 +138,21 @@ func (t *BinarySearch[T]) PostOrder() []T {
 	return traversal
 }
 
+// InOrderSeq returns the keys in the order of InOrder as a lazy sequence.
+func (t *BinarySearch[T]) InOrderSeq() iterutil.Seq[T] {
+	return inOrderSeq[T](t.Root, t._NIL)
+}
+
+// PreOrderSeq returns the keys in the order of PreOrder as a lazy sequence.
+func (t *BinarySearch[T]) PreOrderSeq() iterutil.Seq[T] {
+	return preOrderSeq[T](t.Root, t._NIL)
+}
+
+// PostOrderSeq returns the keys in the order of PostOrder as a lazy sequence.
+func (t *BinarySearch[T]) PostOrderSeq() iterutil.Seq[T] {
+	return postOrderSeq[T](t.Root, t._NIL)
+}
+
 // LevelOrder returns the level order traversal of the tree
 func (t *BinarySearch[T]) LevelOrder() []T {
 	traversal := make([]T, 0)
@@ -131,7 +182,7 @@ func (t *BinarySearch[T]) Max() (T, bool) {
 
 // Min returns the Min value of the tree
 func (t *BinarySearch[T]) Min() (T, bool) {
-	ret := minimum[T](t.Root, t._NIL)
+	ret := minimum[T](t.Root, t._NIL, nil)
 	if ret == t._NIL {
 		var dft T
 		return dft, false
@@ -143,29 +194,28 @@ func (t *BinarySearch[T]) Min() (T, bool) {
 // if there is no predecessor, return default value of type T and false
 // otherwise return the Key of predecessor and true
 func (t *BinarySearch[T]) Predecessor(key T) (T, bool) {
-	node, ok := searchTreeHelper[T](t.Root, t._NIL, key)
-	if !ok {
+	if _, ok := searchTreeHelper[T](t.Root, t._NIL, key, nil); !ok {
 		var dft T
-		return dft, ok
+		return dft, false
 	}
-	return predecessorHelper[T](node, t._NIL)
+	return predecessorHelper[T](t.Root, t._NIL, key)
 }
 
 // Successor returns the Successor of the node of Key
 // if there is no successor, return default value of type T and false
 // otherwise return the Key of successor and true
 func (t *BinarySearch[T]) Successor(key T) (T, bool) {
-	node, ok := searchTreeHelper[T](t.Root, t._NIL, key)
-	if !ok {
+	if _, ok := searchTreeHelper[T](t.Root, t._NIL, key, nil); !ok {
 		var dft T
-		return dft, ok
+		return dft, false
 	}
-	return successorHelper[T](node, t._NIL)
+	return successorHelper[T](t.Root, t._NIL, key)
 }
 
 func (t *BinarySearch[T]) pushHelper(x *BSNode[T], val T) {
 	y := t._NIL
 	for x != t._NIL {
+		visitKey(t.visit, x.key)
 		y = x
 		switch {
 		case val < x.Key():
@@ -199,7 +249,7 @@ func (t *BinarySearch[T]) deleteHelper(z *BSNode[T]) {
 	case z.right == t._NIL:
 		t.transplant(z, z.left)
 	default:
-		y := minimum[T](z.right, t._NIL).(*BSNode[T])
+		y := minimum[T](z.right, t._NIL, t.visit).(*BSNode[T])
 		if y.parent != z {
 			t.transplant(y, y.right)
 			y.right = z.right
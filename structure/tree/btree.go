@@ -3,7 +3,10 @@
 
 package tree
 
-import "github.com/TheAlgorithms/Go/constraints"
+import (
+	"github.com/TheAlgorithms/Go/constraints"
+	"github.com/TheAlgorithms/Go/structure/iterutil"
+)
 
 type BTreeNode[T constraints.Ordered] struct {
 	keys     []T
@@ -353,3 +356,84 @@ func (tree *BTree[T]) Delete(key T) {
 		tree.root = tree.root.children[0]
 	}
 }
+
+// Clone returns an independent copy of the subtree rooted at node. Every
+// key is passed through cloneKey, if it is not nil, which must keep the
+// order of the keys.
+func (node *BTreeNode[T]) Clone(cloneKey func(T) T) *BTreeNode[T] {
+	if node == nil {
+		return nil
+	}
+	c := &BTreeNode[T]{
+		keys:     make([]T, len(node.keys)),
+		children: make([]*BTreeNode[T], len(node.children)),
+		numKeys:  node.numKeys,
+		isLeaf:   node.isLeaf,
+	}
+	for i, key := range node.keys[:node.numKeys] {
+		c.keys[i] = cloneKeyOf(key, cloneKey)
+	}
+	if !node.isLeaf {
+		for i := 0; i <= node.numKeys; i++ {
+			c.children[i] = node.children[i].Clone(cloneKey)
+		}
+	}
+	return c
+}
+
+// inOrder appends the keys of the subtree rooted at node to out, in order.
+func (node *BTreeNode[T]) inOrder(out []T) []T {
+	for i := 0; i < node.numKeys; i++ {
+		if !node.isLeaf {
+			out = node.children[i].inOrder(out)
+		}
+		out = append(out, node.keys[i])
+	}
+	if !node.isLeaf {
+		out = node.children[node.numKeys].inOrder(out)
+	}
+	return out
+}
+
+// walk yields the keys of the subtree rooted at node in order, and reports
+// false as soon as yield does.
+func (node *BTreeNode[T]) walk(yield func(T) bool) bool {
+	for i := 0; i < node.numKeys; i++ {
+		if !node.isLeaf && !node.children[i].walk(yield) {
+			return false
+		}
+		if !yield(node.keys[i]) {
+			return false
+		}
+	}
+	return node.isLeaf || node.children[node.numKeys].walk(yield)
+}
+
+// InOrderSeq returns the keys of the tree in increasing order as a lazy
+// sequence.
+func (tree *BTree[T]) InOrderSeq() iterutil.Seq[T] {
+	return func(yield func(T) bool) {
+		if tree.root != nil {
+			tree.root.walk(yield)
+		}
+	}
+}
+
+// Clone returns an independent copy of the tree. Every key is passed
+// through cloneKey, if it is not nil, which must keep the order of the keys.
+func (tree *BTree[T]) Clone(cloneKey func(T) T) *BTree[T] {
+	return &BTree[T]{root: tree.root.Clone(cloneKey), maxKeys: tree.maxKeys}
+}
+
+// Equal reports whether tree and other hold equal keys, according to eq or
+// to == if eq is nil, whatever their shape and node size.
+func (tree *BTree[T]) Equal(other *BTree[T], eq func(a, b T) bool) bool {
+	var a, b []T
+	if tree.root != nil {
+		a = tree.root.inOrder(nil)
+	}
+	if other.root != nil {
+		b = other.root.inOrder(nil)
+	}
+	return equalKeys(a, b, eq)
+}
@@ -1,8 +1,10 @@
 package tree_test
 
 import (
+	"github.com/TheAlgorithms/Go/structure/iterutil"
 	bt "github.com/TheAlgorithms/Go/structure/tree"
 	"math/rand"
+	"reflect"
 	"testing"
 )
 
@@ -113,6 +115,53 @@ func TestBTreeRandom(t *testing.T) {
 	}
 }
 
+func TestBTreeClone(t *testing.T) {
+	rnd := rand.New(rand.NewSource(2))
+	nums := rnd.Perm(500)
+	tree := bt.NewBTree[int](5)
+	for _, num := range nums {
+		tree.Insert(num)
+	}
+	clone := tree.Clone(nil)
+	if !tree.Equal(clone, nil) {
+		t.Fatalf("a clone should equal its original")
+	}
+	for _, num := range nums[:250] {
+		clone.Delete(num)
+	}
+	if tree.Equal(clone, nil) {
+		t.Errorf("trees with different keys should not be equal")
+	}
+	for _, num := range nums {
+		if !tree.Search(num) {
+			t.Fatalf("deleting from a clone removed %d from the original", num)
+		}
+		if clone.Search(num) == contains(nums[:250], num) {
+			t.Fatalf("clone has the wrong keys after deleting")
+		}
+	}
+
+	other := bt.NewBTree[int](3)
+	for _, num := range nums {
+		other.Insert(num)
+	}
+	if !tree.Equal(other, nil) {
+		t.Errorf("trees with the same keys and different node sizes should be equal")
+	}
+	if !bt.NewBTree[int](3).Clone(nil).Equal(bt.NewBTree[int](4), nil) {
+		t.Errorf("empty trees should be equal")
+	}
+}
+
+func contains(nums []int, num int) bool {
+	for _, n := range nums {
+		if n == num {
+			return true
+		}
+	}
+	return false
+}
+
 func TestBTreeDeleteEverything(t *testing.T) {
 	tree := bt.NewBTree[int](4)
 	size := 128
@@ -131,3 +180,27 @@ func TestBTreeDeleteEverything(t *testing.T) {
 		}
 	}
 }
+
+func TestBTreeInOrderSeq(t *testing.T) {
+	tree := bt.NewBTree[int](4)
+	if got := iterutil.Collect(tree.InOrderSeq()); len(got) != 0 {
+		t.Errorf("InOrderSeq of an empty tree = %v", got)
+	}
+	for _, k := range rand.New(rand.NewSource(1)).Perm(500) {
+		tree.Insert(k)
+	}
+	want := 0
+	tree.InOrderSeq()(func(k int) bool {
+		if k != want {
+			t.Fatalf("InOrderSeq yielded %d, want %d", k, want)
+		}
+		want++
+		return true
+	})
+	if want != 500 {
+		t.Errorf("InOrderSeq yielded %d keys, want 500", want)
+	}
+	if got := iterutil.Collect(iterutil.Take(tree.InOrderSeq(), 3)); !reflect.DeepEqual(got, []int{0, 1, 2}) {
+		t.Errorf("taking 3 from InOrderSeq = %v", got)
+	}
+}
@@ -0,0 +1,237 @@
+// encoding.go
+// description: JSON and gob encoding of the trees
+// details:
+// BinarySearch, AVL and RB trees are encoded as a list of keys, a JSON array
+// in JSON. A binary search tree is encoded in pre-order: pushing the keys back
+// in that order rebuilds the very same tree. AVL and Red-Black trees are
+// encoded in order, and decoding pushes the keys into an empty tree, which
+// restores heights, colors and balance; the shape may differ from the
+// encoded tree, but not the keys. A B-tree is encoded as its maximum number of
+// keys per node and its keys in order, {"maxKeys": 3, "keys": [...]}, and is
+// rebuilt by insertion as well. Decoding replaces the content of the tree and
+// keeps its visit hook; decoding into an AVL or Red-Black snapshot fails.
+// see encoding_test.go
+
+package tree
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+
+	"github.com/TheAlgorithms/Go/constraints"
+)
+
+// ErrInvalidMaxKeys is returned when decoding a B-tree whose maximum number
+// of keys per node is less than 3.
+var ErrInvalidMaxKeys = errors.New("B-tree maxKeys must be at least 3")
+
+// ErrFrozen is returned when decoding into a snapshot of an AVL or
+// Red-Black tree, which cannot be updated.
+var ErrFrozen = errors.New("cannot decode into a tree snapshot")
+
+// gobEncode encodes v with gob.
+func gobEncode(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(v); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// gobDecode decodes data encoded by gobEncode into v.
+func gobDecode(data []byte, v any) error {
+	return gob.NewDecoder(bytes.NewReader(data)).Decode(v)
+}
+
+// keyArray returns keys, or an empty slice when it is nil, so that an empty
+// tree is encoded as [] rather than null.
+func keyArray[T constraints.Ordered](keys []T) []T {
+	if keys == nil {
+		return []T{}
+	}
+	return keys
+}
+
+// MarshalJSON encodes the keys of the tree in pre-order as a JSON array.
+func (t *BinarySearch[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(keyArray(t.PreOrder()))
+}
+
+// UnmarshalJSON replaces the tree with the one whose keys in pre-order are
+// the JSON array data.
+func (t *BinarySearch[T]) UnmarshalJSON(data []byte) error {
+	var keys []T
+	if err := json.Unmarshal(data, &keys); err != nil {
+		return err
+	}
+	t.load(keys)
+	return nil
+}
+
+// load replaces the tree with the one whose keys in pre-order are keys,
+// keeping the visit hook.
+func (t *BinarySearch[T]) load(keys []T) {
+	visit := t.visit
+	*t = *NewBinarySearch[T]()
+	t.Push(keys...)
+	t.visit = visit
+}
+
+// GobEncode encodes the keys of the tree in pre-order with gob.
+func (t *BinarySearch[T]) GobEncode() ([]byte, error) {
+	return gobEncode(t.PreOrder())
+}
+
+// GobDecode replaces the tree with the one encoded by GobEncode.
+func (t *BinarySearch[T]) GobDecode(data []byte) error {
+	var keys []T
+	if err := gobDecode(data, &keys); err != nil {
+		return err
+	}
+	t.load(keys)
+	return nil
+}
+
+// MarshalJSON encodes the keys of the tree in order as a JSON array.
+func (avl *AVL[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(keyArray(avl.InOrder()))
+}
+
+// UnmarshalJSON replaces the tree with a balanced tree holding the keys of
+// the JSON array data.
+func (avl *AVL[T]) UnmarshalJSON(data []byte) error {
+	var keys []T
+	if err := json.Unmarshal(data, &keys); err != nil {
+		return err
+	}
+	return avl.load(keys)
+}
+
+// load replaces the tree with a balanced tree holding keys, keeping the
+// visit hook, unless the tree is a snapshot.
+func (avl *AVL[T]) load(keys []T) error {
+	if avl.frozen {
+		return ErrFrozen
+	}
+	visit := avl.visit
+	*avl = *NewAVL[T]()
+	avl.Push(keys...)
+	avl.visit = visit
+	return nil
+}
+
+// GobEncode encodes the keys of the tree in order with gob.
+func (avl *AVL[T]) GobEncode() ([]byte, error) {
+	return gobEncode(avl.InOrder())
+}
+
+// GobDecode replaces the tree with a balanced tree holding the keys encoded
+// by GobEncode.
+func (avl *AVL[T]) GobDecode(data []byte) error {
+	var keys []T
+	if err := gobDecode(data, &keys); err != nil {
+		return err
+	}
+	return avl.load(keys)
+}
+
+// MarshalJSON encodes the keys of the tree in order as a JSON array.
+func (t *RB[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(keyArray(t.InOrder()))
+}
+
+// UnmarshalJSON replaces the tree with a balanced tree holding the keys of
+// the JSON array data.
+func (t *RB[T]) UnmarshalJSON(data []byte) error {
+	var keys []T
+	if err := json.Unmarshal(data, &keys); err != nil {
+		return err
+	}
+	return t.load(keys)
+}
+
+// load replaces the tree with a balanced tree holding keys, keeping the
+// visit hook, unless the tree is a snapshot.
+func (t *RB[T]) load(keys []T) error {
+	if t.frozen {
+		return ErrFrozen
+	}
+	visit := t.visit
+	*t = *NewRB[T]()
+	t.Push(keys...)
+	t.visit = visit
+	return nil
+}
+
+// GobEncode encodes the keys of the tree in order with gob.
+func (t *RB[T]) GobEncode() ([]byte, error) {
+	return gobEncode(t.InOrder())
+}
+
+// GobDecode replaces the tree with a balanced tree holding the keys encoded
+// by GobEncode.
+func (t *RB[T]) GobDecode(data []byte) error {
+	var keys []T
+	if err := gobDecode(data, &keys); err != nil {
+		return err
+	}
+	return t.load(keys)
+}
+
+// bTreeEncoding is the encoded form of a B-tree.
+type bTreeEncoding[T constraints.Ordered] struct {
+	MaxKeys int `json:"maxKeys"`
+	Keys    []T `json:"keys"`
+}
+
+// encoding returns the encoded form of the tree.
+func (tree *BTree[T]) encoding() bTreeEncoding[T] {
+	e := bTreeEncoding[T]{MaxKeys: tree.maxKeys, Keys: []T{}}
+	if tree.root != nil {
+		e.Keys = tree.root.inOrder(e.Keys)
+	}
+	return e
+}
+
+// load replaces the tree with the one described by e.
+func (tree *BTree[T]) load(e bTreeEncoding[T]) error {
+	if e.MaxKeys <= 2 {
+		return ErrInvalidMaxKeys
+	}
+	*tree = *NewBTree[T](e.MaxKeys)
+	for _, key := range e.Keys {
+		tree.Insert(key)
+	}
+	return nil
+}
+
+// MarshalJSON encodes the tree as a JSON object holding its maximum number of
+// keys per node and its keys in order.
+func (tree *BTree[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(tree.encoding())
+}
+
+// UnmarshalJSON replaces the tree with the one encoded by MarshalJSON.
+func (tree *BTree[T]) UnmarshalJSON(data []byte) error {
+	var e bTreeEncoding[T]
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	return tree.load(e)
+}
+
+// GobEncode encodes the tree with gob.
+func (tree *BTree[T]) GobEncode() ([]byte, error) {
+	return gobEncode(tree.encoding())
+}
+
+// GobDecode replaces the tree with the one encoded by GobEncode.
+func (tree *BTree[T]) GobDecode(data []byte) error {
+	var e bTreeEncoding[T]
+	if err := gobDecode(data, &e); err != nil {
+		return err
+	}
+	return tree.load(e)
+}
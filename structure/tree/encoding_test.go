@@ -0,0 +1,169 @@
+package tree_test
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	bt "github.com/TheAlgorithms/Go/structure/tree"
+)
+
+// roundTrip encodes src with JSON and with gob and decodes it into the
+// results of newDst.
+func roundTrip(t *testing.T, src any, newDst func() any) (fromJSON, fromGob any) {
+	t.Helper()
+	data, err := json.Marshal(src)
+	if err != nil {
+		t.Fatalf("json.Marshal: %v", err)
+	}
+	fromJSON = newDst()
+	if err := json.Unmarshal(data, fromJSON); err != nil {
+		t.Fatalf("json.Unmarshal: %v", err)
+	}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(src); err != nil {
+		t.Fatalf("gob encode: %v", err)
+	}
+	fromGob = newDst()
+	if err := gob.NewDecoder(&buf).Decode(fromGob); err != nil {
+		t.Fatalf("gob decode: %v", err)
+	}
+	return fromJSON, fromGob
+}
+
+func TestTreeEncoding(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	keys := rnd.Perm(300)
+
+	t.Run("BinarySearch keeps its shape", func(t *testing.T) {
+		tree := bt.NewBinarySearch[int]()
+		tree.Push(keys...)
+		a, b := roundTrip(t, tree, func() any { return bt.NewBinarySearch[int]() })
+		for _, got := range []*bt.BinarySearch[int]{a.(*bt.BinarySearch[int]), b.(*bt.BinarySearch[int])} {
+			if !reflect.DeepEqual(got.PreOrder(), tree.PreOrder()) || !reflect.DeepEqual(got.InOrder(), tree.InOrder()) {
+				t.Errorf("decoded tree has a different shape")
+			}
+		}
+	})
+
+	t.Run("AVL is rebalanced", func(t *testing.T) {
+		tree := bt.NewAVL[int]()
+		tree.Push(keys...)
+		a, b := roundTrip(t, tree, func() any { return new(bt.AVL[int]) })
+		for _, got := range []*bt.AVL[int]{a.(*bt.AVL[int]), b.(*bt.AVL[int])} {
+			if !got.Equal(tree, nil) {
+				t.Errorf("decoded tree holds %v", got.InOrder())
+			}
+			// 300 keys fit in an AVL tree of depth at most 1.44 log2(300)
+			if d := got.Depth(); d > 11 {
+				t.Errorf("decoded tree has depth %d", d)
+			}
+		}
+	})
+
+	t.Run("RB is rebalanced", func(t *testing.T) {
+		tree := bt.NewRB[int]()
+		tree.Push(keys...)
+		// decoding into a zero value works: the sentinel is recreated
+		a, b := roundTrip(t, tree, func() any { return new(bt.RB[int]) })
+		for _, got := range []*bt.RB[int]{a.(*bt.RB[int]), b.(*bt.RB[int])} {
+			if !got.Equal(tree, nil) {
+				t.Errorf("decoded tree holds %v", got.InOrder())
+			}
+			if d := got.Depth(); d > 2*9 {
+				t.Errorf("decoded tree has depth %d", d)
+			}
+			got.Push(1000)
+			got.Delete(0)
+			if !got.Has(1000) || got.Has(0) {
+				t.Errorf("decoded tree should remain usable")
+			}
+		}
+	})
+
+	t.Run("BTree", func(t *testing.T) {
+		tree := bt.NewBTree[int](5)
+		for _, k := range keys {
+			tree.Insert(k)
+		}
+		data, err := json.Marshal(tree)
+		if err != nil {
+			t.Fatalf("json.Marshal: %v", err)
+		}
+		if !bytes.HasPrefix(data, []byte(`{"maxKeys":5,"keys":[0,1,2,`)) {
+			t.Errorf("unexpected encoding %.40s", data)
+		}
+		a, b := roundTrip(t, tree, func() any { return new(bt.BTree[int]) })
+		for _, got := range []*bt.BTree[int]{a.(*bt.BTree[int]), b.(*bt.BTree[int])} {
+			if !got.Equal(tree, nil) {
+				t.Errorf("decoded tree holds different keys")
+			}
+			got.Delete(7)
+			if got.Search(7) || !got.Search(8) {
+				t.Errorf("decoded tree should remain usable")
+			}
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		data, _ := json.Marshal(bt.NewRB[string]())
+		if string(data) != "[]" {
+			t.Errorf("empty tree encoded as %s, want []", data)
+		}
+		tree := bt.NewAVL[string]()
+		tree.Push("x")
+		if err := json.Unmarshal(data, tree); err != nil || !tree.Empty() {
+			t.Errorf("decoding an empty tree should replace the keys, err %v", err)
+		}
+	})
+}
+
+func TestTreeEncodingErrors(t *testing.T) {
+	if err := json.Unmarshal([]byte(`{"maxKeys":2,"keys":[1]}`), new(bt.BTree[int])); !errors.Is(err, bt.ErrInvalidMaxKeys) {
+		t.Errorf("got %v, want ErrInvalidMaxKeys", err)
+	}
+	if err := json.Unmarshal([]byte(`["a"]`), bt.NewRB[int]()); err == nil {
+		t.Errorf("decoding keys of the wrong type should fail")
+	}
+}
+
+func TestTreeDecodingKeepsState(t *testing.T) {
+	rb := bt.NewRB[int]()
+	rb.Push(1, 2, 3)
+	snapshot := rb.Snapshot()
+	if err := json.Unmarshal([]byte(`[4,5]`), snapshot); !errors.Is(err, bt.ErrFrozen) {
+		t.Errorf("decoding into a Red-Black snapshot: got %v, want ErrFrozen", err)
+	}
+	avl := bt.NewAVL[int]()
+	avl.Push(1, 2, 3)
+	data, _ := avl.GobEncode()
+	if err := avl.Snapshot().GobDecode(data); !errors.Is(err, bt.ErrFrozen) {
+		t.Errorf("decoding into an AVL snapshot: got %v, want ErrFrozen", err)
+	}
+	if !snapshot.Frozen() || !reflect.DeepEqual(snapshot.InOrder(), []int{1, 2, 3}) {
+		t.Errorf("a failed decoding changed the snapshot to %v", snapshot.InOrder())
+	}
+
+	var visited []int
+	visit := func(key int) { visited = append(visited, key) }
+	bst := bt.NewBinarySearch[int]()
+	rb.SetVisit(visit)
+	avl.SetVisit(visit)
+	bst.SetVisit(visit)
+	for name, tree := range map[string]interface {
+		json.Unmarshaler
+		Has(int) bool
+	}{"RB": rb, "AVL": avl, "BinarySearch": bst} {
+		if err := json.Unmarshal([]byte(`[7]`), tree); err != nil {
+			t.Fatal(err)
+		}
+		visited = nil
+		if !tree.Has(7) || !reflect.DeepEqual(visited, []int{7}) {
+			t.Errorf("%s: decoding dropped the visit hook, visited %v", name, visited)
+		}
+	}
+}
@@ -26,11 +26,13 @@ type TestTree[T constraints.Ordered] interface {
 	AccessNodesByLayer() [][]T
 }
 
-// BinarySearch, AVL and RB have completed the `TestTree` interface.
+// BinarySearch, AVL, RB, Splay and Zip have completed the `TestTree` interface.
 var (
 	_ TestTree[int] = (*bt.BinarySearch[int])(nil)
 	_ TestTree[int] = (*bt.AVL[int])(nil)
 	_ TestTree[int] = (*bt.RB[int])(nil)
+	_ TestTree[int] = (*bt.Splay[int])(nil)
+	_ TestTree[int] = (*bt.Zip[int])(nil)
 )
 
 var tree TestTree[int]
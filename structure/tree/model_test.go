@@ -0,0 +1,114 @@
+package tree_test
+
+import (
+	"fmt"
+	"math/bits"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/internal/testutil"
+	bt "github.com/TheAlgorithms/Go/structure/tree"
+)
+
+// modelTrees are the trees checked against a set, with whether they are
+// balanced.
+var modelTrees = map[string]struct {
+	new      func() TestTree[int]
+	balanced bool
+}{
+	"BinarySearch": {func() TestTree[int] { return bt.NewBinarySearch[int]() }, false},
+	"AVL":          {func() TestTree[int] { return bt.NewAVL[int]() }, true},
+	"RB":           {func() TestTree[int] { return bt.NewRB[int]() }, true},
+	"Splay":        {func() TestTree[int] { return bt.NewSplay[int]() }, false},
+	"Zip":          {func() TestTree[int] { return bt.NewZip[int](1) }, false},
+}
+
+// sortedKeys returns the keys of the set in increasing order.
+func sortedKeys(set map[int]bool) []int {
+	keys := make([]int, 0, len(set))
+	for k := range set {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+	return keys
+}
+
+// treeModel checks a tree against a set of keys.
+func treeModel(newTree func() TestTree[int], balanced bool) testutil.Model[TestTree[int], map[int]bool] {
+	type op = testutil.Op[TestTree[int], map[int]bool]
+	return testutil.Model[TestTree[int], map[int]bool]{
+		New: func() (TestTree[int], map[int]bool) { return newTree(), map[int]bool{} },
+		Ops: []op{
+			{Name: "Push", Run: func(src *testutil.Source, tree TestTree[int], set map[int]bool) (string, error) {
+				x := src.Intn(128)
+				tree.Push(x)
+				set[x] = true
+				return fmt.Sprintf("Push(%d)", x), nil
+			}},
+			{Name: "Delete", Run: func(src *testutil.Source, tree TestTree[int], set map[int]bool) (string, error) {
+				x := src.Intn(128)
+				applied := fmt.Sprintf("Delete(%d)", x)
+				if got := tree.Delete(x); got != set[x] {
+					return applied, fmt.Errorf("Delete(%d) = %v, want %v", x, got, set[x])
+				}
+				delete(set, x)
+				return applied, nil
+			}},
+			{Name: "Has", Run: func(src *testutil.Source, tree TestTree[int], set map[int]bool) (string, error) {
+				x := src.Intn(128)
+				applied := fmt.Sprintf("Has(%d)", x)
+				if got := tree.Has(x); got != set[x] {
+					return applied, fmt.Errorf("Has(%d) = %v, want %v", x, got, set[x])
+				}
+				return applied, nil
+			}},
+			{Name: "Successor", Run: func(src *testutil.Source, tree TestTree[int], set map[int]bool) (string, error) {
+				keys := sortedKeys(set)
+				if len(keys) == 0 {
+					return "", nil
+				}
+				i := src.Intn(len(keys))
+				applied := fmt.Sprintf("Successor(%d)", keys[i])
+				got, ok := tree.Successor(keys[i])
+				if want := i+1 < len(keys); ok != want || ok && got != keys[i+1] {
+					return applied, fmt.Errorf("Successor(%d) = %d, %v", keys[i], got, ok)
+				}
+				return applied, nil
+			}},
+		},
+		Invariant: func(tree TestTree[int], set map[int]bool) error {
+			got, want := tree.InOrder(), sortedKeys(set)
+			if len(got) != 0 || len(want) != 0 {
+				if !reflect.DeepEqual(got, want) {
+					return fmt.Errorf("InOrder() = %v, want %v", got, want)
+				}
+			}
+			// a balanced tree of n keys is at most 2 log2(n+1) deep
+			if limit := 2 * bits.Len(uint(len(set))); balanced && tree.Depth() > limit {
+				return fmt.Errorf("Depth() = %d with %d keys", tree.Depth(), len(set))
+			}
+			return nil
+		},
+	}
+}
+
+func TestTreeModel(t *testing.T) {
+	for name, tree := range modelTrees {
+		t.Run(name, func(t *testing.T) {
+			for seed := int64(0); seed < 20; seed++ {
+				treeModel(tree.new, tree.balanced).Check(t, seed, 300)
+			}
+		})
+	}
+}
+
+func FuzzBinarySearch(f *testing.F) {
+	f.Add([]byte{})
+	f.Add([]byte{0, 5, 0, 3, 0, 9, 1, 5, 3, 0, 2, 3})
+	f.Fuzz(func(t *testing.T, data []byte) {
+		for _, tree := range modelTrees {
+			treeModel(tree.new, tree.balanced).Fuzz(t, data)
+		}
+	})
+}
@@ -1,6 +1,17 @@
 // Red-Black Tree is a kind of self-balancing binary search tree.
 // Each node stores "color" ("red" or "black"), used to ensure that the tree remains balanced during insertions and deletions.
 //
+// Snapshot returns a read-only view of the tree in O(1) and makes the tree
+// copy-on-write, as for the AVL tree: every node belongs to the generation
+// of the tree that created it, and an update copies the frozen nodes it
+// changes, with their ancestors, instead of changing them. Unlike the AVL
+// nodes, the nodes keep a link to their parent, which the updates of the
+// tree follow. The copy of a node takes over the parent links of its
+// children, frozen or not: a snapshot only walks down from its root, so it
+// never follows a parent link, and the links stay right for the tree. The
+// Parent of a node of a snapshot is therefore unreliable, and unsafe to call
+// while the tree is updated.
+//
 // For more details check out those links below here:
 // Programiz article : https://www.programiz.com/dsa/red-black-tree
 // Wikipedia article: https://en.wikipedia.org/wiki/Red_black_tree
@@ -9,7 +20,10 @@
 
 package tree
 
-import "github.com/TheAlgorithms/Go/constraints"
+import (
+	"github.com/TheAlgorithms/Go/constraints"
+	"github.com/TheAlgorithms/Go/structure/iterutil"
+)
 
 type Color byte
 
@@ -28,12 +42,17 @@ type RBNode[T constraints.Ordered] struct {
 	left   *RBNode[T]
 	right  *RBNode[T]
 	color  Color
+	gen    int // generation of the tree that may change the node
 }
 
 func (n *RBNode[T]) Key() T {
 	return n.key
 }
 
+// Parent returns the parent of the node in the tree. The updates of a tree
+// relink the nodes it shares with its snapshots to their new parents, so on
+// a node of a snapshot Parent may return a node of the tree instead, and it
+// must not be called while the tree is updated.
 func (n *RBNode[T]) Parent() Node[T] {
 	return n.parent
 }
@@ -49,8 +68,11 @@ func (n *RBNode[T]) Right() Node[T] {
 // RB represents a Red-Black tree.
 // By default, _NIL = leaf, a dummy variable.
 type RB[T constraints.Ordered] struct {
-	Root *RBNode[T]
-	_NIL *RBNode[T] // a sentinel value for nil
+	Root   *RBNode[T]
+	_NIL   *RBNode[T] // a sentinel value for nil
+	gen    int        // generation of the nodes the tree may change
+	frozen bool       // whether the tree is a snapshot
+	visit  func(T)    // called with the nodes walked through, if not nil
 }
 
 // NewRB creates a new Red-Black Tree
@@ -69,7 +91,9 @@ func (t *RB[T]) Empty() bool {
 }
 
 // Push a chain of Node's into the Red-Black Tree
+// It panics if the tree is a snapshot.
 func (t *RB[T]) Push(keys ...T) {
+	t.checkWritable()
 	for _, key := range keys {
 		t.pushHelper(t.Root, key)
 	}
@@ -77,21 +101,113 @@ func (t *RB[T]) Push(keys ...T) {
 
 // Delete a node of Red-Black Tree
 // Returns false if the node does not exist, otherwise returns true.
+// It panics if the tree is a snapshot.
 func (t *RB[T]) Delete(data T) bool {
+	t.checkWritable()
 	return t.deleteHelper(t.Root, data)
 }
 
+// SetVisit sets a hook called with the key of every node that Has, Get, Push
+// and Delete walk through, or removes it if visit is nil. Snapshots and
+// clones do not inherit the hook.
+func (t *RB[T]) SetVisit(visit func(key T)) {
+	t.visit = visit
+}
+
 // Get a Node from the Red-Black Tree
 func (t *RB[T]) Get(key T) (Node[T], bool) {
-	return searchTreeHelper[T](t.Root, t._NIL, key)
+	return searchTreeHelper[T](t.Root, t._NIL, key, t.visit)
 }
 
 // Has Determines the tree has the node of Key
 func (t *RB[T]) Has(key T) bool {
-	_, ok := searchTreeHelper[T](t.Root, t._NIL, key)
+	_, ok := searchTreeHelper[T](t.Root, t._NIL, key, t.visit)
 	return ok
 }
 
+// Snapshot returns a read-only view of the keys the tree holds now, which
+// later updates of the tree do not change, in O(1). Updating the view
+// panics. A snapshot may be read while the tree is updated concurrently,
+// but Snapshot itself must not run concurrently with updates. The nodes of
+// the view are not safe for Parent: the updates of the tree change the
+// parent links of the nodes it shares with the view, so Parent may return a
+// node of the tree rather than of the view, and it races with the updates.
+func (t *RB[T]) Snapshot() *RB[T] {
+	if !t.frozen {
+		// the nodes created so far are now shared with the view
+		t.gen++
+	}
+	return &RB[T]{Root: t.Root, _NIL: t._NIL, frozen: true}
+}
+
+// Frozen reports whether the tree is a snapshot.
+func (t *RB[T]) Frozen() bool {
+	return t.frozen
+}
+
+// checkWritable panics if the tree is a snapshot.
+func (t *RB[T]) checkWritable() {
+	if t.frozen {
+		panic("tree: update of a Red-Black snapshot")
+	}
+}
+
+// own returns n if the tree may change it, and otherwise links a copy of n
+// that it may change in place of n, copying the frozen ancestors of n as
+// well, and returns the copy. Only the parent links of frozen nodes may be
+// changed without owning them: copying the frozen children of every copy
+// would copy whole subtrees, and a snapshot never follows a parent link
+// when reading keys, which is why Parent is unreliable on its nodes.
+func (t *RB[T]) own(n *RBNode[T]) *RBNode[T] {
+	if n == t._NIL || n.gen == t.gen {
+		return n
+	}
+	p := t.own(n.parent)
+	c := *n
+	c.gen = t.gen
+	switch {
+	case p == t._NIL:
+		t.Root = &c
+	case p.left == n:
+		p.left = &c
+	default:
+		p.right = &c
+	}
+	// the sentinel keeps the parent the updates gave it
+	if c.left != t._NIL {
+		c.left.parent = &c
+	}
+	if c.right != t._NIL {
+		c.right.parent = &c
+	}
+	return &c
+}
+
+// Clone returns an independent copy of the tree with the same shape and
+// colors, and a sentinel of its own. Every key is passed through cloneKey,
+// if it is not nil, which must keep the order of the keys.
+func (t *RB[T]) Clone(cloneKey func(T) T) *RB[T] {
+	c := NewRB[T]()
+	var clone func(n, parent *RBNode[T]) *RBNode[T]
+	clone = func(n, parent *RBNode[T]) *RBNode[T] {
+		if n == t._NIL {
+			return c._NIL
+		}
+		m := &RBNode[T]{key: cloneKeyOf(n.key, cloneKey), parent: parent, color: n.color}
+		m.left = clone(n.left, m)
+		m.right = clone(n.right, m)
+		return m
+	}
+	c.Root = clone(t.Root, c._NIL)
+	return c
+}
+
+// Equal reports whether t and other hold equal keys, according to eq or to
+// == if eq is nil, whatever their shape.
+func (t *RB[T]) Equal(other *RB[T], eq func(a, b T) bool) bool {
+	return equalKeys(t.InOrder(), other.InOrder(), eq)
+}
+
 // PreOrder Traverses the tree in the following order Root --> Left --> Right
 func (t *RB[T]) PreOrder() []T {
 	traversal := make([]T, 0)
@@ -111,6 +227,21 @@ func (t *RB[T]) PostOrder() []T {
 	return traversal
 }
 
+// InOrderSeq returns the keys in the order of InOrder as a lazy sequence.
+func (t *RB[T]) InOrderSeq() iterutil.Seq[T] {
+	return inOrderSeq[T](t.Root, t._NIL)
+}
+
+// PreOrderSeq returns the keys in the order of PreOrder as a lazy sequence.
+func (t *RB[T]) PreOrderSeq() iterutil.Seq[T] {
+	return preOrderSeq[T](t.Root, t._NIL)
+}
+
+// PostOrderSeq returns the keys in the order of PostOrder as a lazy sequence.
+func (t *RB[T]) PostOrderSeq() iterutil.Seq[T] {
+	return postOrderSeq[T](t.Root, t._NIL)
+}
+
 // LevelOrder returns the level order traversal of the tree
 func (t *RB[T]) LevelOrder() []T {
 	traversal := make([]T, 0)
@@ -140,7 +271,7 @@ func (t *RB[T]) Max() (T, bool) {
 
 // Min returns the Min value of the tree
 func (t *RB[T]) Min() (T, bool) {
-	ret := minimum[T](t.Root, t._NIL)
+	ret := minimum[T](t.Root, t._NIL, nil)
 	if ret == t._NIL {
 		var dft T
 		return dft, false
@@ -152,29 +283,28 @@ func (t *RB[T]) Min() (T, bool) {
 // if there is no predecessor, return default value of type T and false
 // otherwise return the Key of predecessor and true
 func (t *RB[T]) Predecessor(key T) (T, bool) {
-	node, ok := searchTreeHelper[T](t.Root, t._NIL, key)
-	if !ok {
+	if _, ok := searchTreeHelper[T](t.Root, t._NIL, key, nil); !ok {
 		var dft T
-		return dft, ok
+		return dft, false
 	}
-	return predecessorHelper[T](node, t._NIL)
+	return predecessorHelper[T](t.Root, t._NIL, key)
 }
 
 // Successor returns the Successor of the node of Key
 // if there is no successor, return default value of type T and false
 // otherwise return the Key of successor and true
 func (t *RB[T]) Successor(key T) (T, bool) {
-	node, ok := searchTreeHelper[T](t.Root, t._NIL, key)
-	if !ok {
+	if _, ok := searchTreeHelper[T](t.Root, t._NIL, key, nil); !ok {
 		var dft T
-		return dft, ok
+		return dft, false
 	}
-	return successorHelper[T](node, t._NIL)
+	return successorHelper[T](t.Root, t._NIL, key)
 }
 
 func (t *RB[T]) pushHelper(x *RBNode[T], key T) {
 	y := t._NIL
 	for x != t._NIL {
+		visitKey(t.visit, x.key)
 		y = x
 		switch {
 		case key < x.Key():
@@ -186,12 +316,14 @@ func (t *RB[T]) pushHelper(x *RBNode[T], key T) {
 		}
 	}
 
+	y = t.own(y)
 	node := &RBNode[T]{
 		key:    key,
 		left:   t._NIL,
 		right:  t._NIL,
 		parent: y,
 		color:  Red,
+		gen:    t.gen,
 	}
 	if y == t._NIL {
 		t.Root = node
@@ -213,8 +345,9 @@ func (t *RB[T]) pushHelper(x *RBNode[T], key T) {
 	t.pushFix(node)
 }
 
+// leftRotate rotates the subtree of x, which the tree must own, to the left.
 func (t *RB[T]) leftRotate(x *RBNode[T]) {
-	y := x.right
+	y := t.own(x.right)
 	x.right = y.left
 
 	if y.left != t._NIL {
@@ -234,8 +367,9 @@ func (t *RB[T]) leftRotate(x *RBNode[T]) {
 	x.parent = y
 }
 
+// rightRotate rotates the subtree of x, which the tree must own, to the right.
 func (t *RB[T]) rightRotate(x *RBNode[T]) {
-	y := x.left
+	y := t.own(x.left)
 	x.left = y.right
 	if y.right != t._NIL {
 		y.right.parent = x
@@ -254,10 +388,12 @@ func (t *RB[T]) rightRotate(x *RBNode[T]) {
 	x.parent = y
 }
 
+// pushFix restores the colors above k, a node the tree owns, after its
+// insertion. The nodes on the path to the root are owned already.
 func (t *RB[T]) pushFix(k *RBNode[T]) {
 	for k.parent.color == Red {
 		if k.parent == k.parent.parent.right {
-			u := k.parent.parent.left
+			u := t.own(k.parent.parent.left)
 			if u.color == Red {
 				u.color = Black
 				k.parent.color = Black
@@ -273,7 +409,7 @@ func (t *RB[T]) pushFix(k *RBNode[T]) {
 				t.leftRotate(k.parent.parent)
 			}
 		} else {
-			u := k.parent.parent.right
+			u := t.own(k.parent.parent.right)
 			if u.color == Red {
 				u.color = Black
 				k.parent.color = Black
@@ -300,6 +436,7 @@ func (t *RB[T]) pushFix(k *RBNode[T]) {
 func (t *RB[T]) deleteHelper(node *RBNode[T], key T) bool {
 	z := t._NIL
 	for node != t._NIL {
+		visitKey(t.visit, node.key)
 		switch {
 		case node.key == key:
 			z = node
@@ -315,6 +452,7 @@ func (t *RB[T]) deleteHelper(node *RBNode[T], key T) bool {
 		return false
 	}
 
+	z = t.own(z)
 	var x *RBNode[T]
 	y := z
 	yOriginColor := y.color
@@ -325,7 +463,7 @@ func (t *RB[T]) deleteHelper(node *RBNode[T], key T) bool {
 		x = z.left
 		t.transplant(z, z.left)
 	} else {
-		y = minimum[T](z.right, t._NIL).(*RBNode[T])
+		y = t.own(minimum[T](z.right, t._NIL, t.visit).(*RBNode[T]))
 		yOriginColor = y.color
 		x = y.right
 		if y.parent == z {
@@ -349,16 +487,20 @@ func (t *RB[T]) deleteHelper(node *RBNode[T], key T) bool {
 	return true
 }
 
+// deleteFix restores the colors from x, which may be frozen or the
+// sentinel, up to the root after a deletion.
 func (t *RB[T]) deleteFix(x *RBNode[T]) {
 	var s *RBNode[T]
 	for x != t.Root && x.color == Black {
+		// the sentinel keeps its parent when that is copied
+		x.parent = t.own(x.parent)
 		if x == x.parent.left {
-			s = x.parent.right
+			s = t.own(x.parent.right)
 			if s.color == Red {
 				s.color = Black
 				x.parent.color = Red
 				t.leftRotate(x.parent)
-				s = x.parent.right
+				s = t.own(x.parent.right)
 			}
 
 			if s.left.color == Black && s.right.color == Black {
@@ -366,25 +508,25 @@ func (t *RB[T]) deleteFix(x *RBNode[T]) {
 				x = x.parent
 			} else {
 				if s.right.color == Black {
-					s.left.color = Black
+					t.own(s.left).color = Black
 					s.color = Red
 					t.rightRotate(s)
-					s = x.parent.right
+					s = t.own(x.parent.right)
 				}
 
 				s.color = x.parent.color
 				x.parent.color = Black
-				s.right.color = Black
+				t.own(s.right).color = Black
 				t.leftRotate(x.parent)
 				x = t.Root
 			}
 		} else {
-			s = x.parent.left
+			s = t.own(x.parent.left)
 			if s.color == Red {
 				s.color = Black
 				x.parent.color = Red
 				t.rightRotate(x.parent)
-				s = x.parent.left
+				s = t.own(x.parent.left)
 			}
 
 			if s.right.color == Black && s.left.color == Black {
@@ -392,22 +534,22 @@ func (t *RB[T]) deleteFix(x *RBNode[T]) {
 				x = x.parent
 			} else {
 				if s.left.color == Black {
-					s.right.color = Black
+					t.own(s.right).color = Black
 					s.color = Red
 					t.leftRotate(s)
-					s = x.parent.left
+					s = t.own(x.parent.left)
 				}
 
 				s.color = x.parent.color
 				x.parent.color = Black
-				s.left.color = Black
+				t.own(s.left).color = Black
 				t.rightRotate(x.parent)
 				x = t.Root
 			}
 		}
 	}
 
-	x.color = Black
+	t.own(x).color = Black
 }
 
 func (t *RB[T]) transplant(u, v *RBNode[T]) {
@@ -1,8 +1,11 @@
 package tree_test
 
 import (
+	"math/bits"
 	"math/rand"
+	"reflect"
 	"sort"
+	"sync"
 	"testing"
 	"time"
 
@@ -165,3 +168,134 @@ func TestRBTreeString(t *testing.T) {
 		t.Errorf("Error with Max when T is string")
 	}
 }
+
+// checkRBLinks checks that every key of the tree is below its parent and
+// that the depth of the tree stays within the red-black bound.
+func checkRBLinks(t *testing.T, tree *bt.RB[int]) {
+	t.Helper()
+	keys := tree.InOrder()
+	for _, k := range keys {
+		if k == tree.Root.Key() {
+			continue
+		}
+		n, _ := tree.Get(k)
+		p := n.(*bt.RBNode[int]).Parent()
+		if p.Left() != n && p.Right() != n {
+			t.Fatalf("node %d is not a child of its parent %d", k, p.Key())
+		}
+	}
+	if limit := 2 * bits.Len(uint(len(keys))); tree.Depth() > limit {
+		t.Fatalf("Depth() = %d with %d keys", tree.Depth(), len(keys))
+	}
+}
+
+func TestRBSnapshot(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	tree := bt.NewRB[int]()
+	model := map[int]bool{}
+	type snapshot struct {
+		view *bt.RB[int]
+		keys []int
+	}
+	var snapshots []snapshot
+	for i := 0; i < 3000; i++ {
+		k := rnd.Intn(200)
+		if rnd.Intn(3) == 0 {
+			if tree.Delete(k) != model[k] {
+				t.Fatalf("Delete(%d) disagrees with the model", k)
+			}
+			delete(model, k)
+		} else {
+			tree.Push(k)
+			model[k] = true
+		}
+		if i%100 == 0 {
+			snapshots = append(snapshots, snapshot{tree.Snapshot(), tree.InOrder()})
+		}
+		if i%500 == 0 {
+			checkRBLinks(t, tree)
+		}
+	}
+	checkRBLinks(t, tree)
+	keys := make([]int, 0, len(model))
+	for k := range model {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+	if got := tree.InOrder(); !reflect.DeepEqual(got, keys) {
+		t.Fatalf("tree keys = %v, want %v", got, keys)
+	}
+	for i, s := range snapshots {
+		if got := s.view.InOrder(); !reflect.DeepEqual(got, s.keys) {
+			t.Fatalf("snapshot %d changed: %v, want %v", i, got, s.keys)
+		}
+		if len(s.keys) > 1 {
+			if k, ok := s.view.Successor(s.keys[0]); !ok || k != s.keys[1] {
+				t.Errorf("snapshot %d: Successor(%d) = %d, %t", i, s.keys[0], k, ok)
+			}
+		}
+	}
+
+	view := snapshots[0].view
+	if !view.Frozen() || tree.Frozen() || view.Snapshot().Root != view.Root {
+		t.Errorf("Frozen = %t, %t", view.Frozen(), tree.Frozen())
+	}
+	for name, update := range map[string]func(){
+		"Push":   func() { view.Push(1000) },
+		"Delete": func() { view.Delete(snapshots[0].keys[0]) },
+	} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("%s on a snapshot did not panic", name)
+				}
+			}()
+			update()
+		}()
+	}
+	clone := view.Clone(nil)
+	clone.Push(1000)
+	if !clone.Has(1000) || view.Has(1000) || clone.Frozen() {
+		t.Errorf("the clone of a snapshot is not independent and writable")
+	}
+	checkRBLinks(t, clone)
+}
+
+func TestRBSnapshotConcurrentReads(t *testing.T) {
+	tree := bt.NewRB[int]()
+	for k := 0; k < 1000; k++ {
+		tree.Push(k)
+	}
+	view := tree.Snapshot()
+	var wg sync.WaitGroup
+	for r := 0; r < 4; r++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 20; i++ {
+				want := 0
+				view.InOrderSeq()(func(k int) bool {
+					if k != want {
+						t.Errorf("snapshot iteration met %d, want %d", k, want)
+						return false
+					}
+					want++
+					return true
+				})
+				if want != 1000 {
+					t.Errorf("snapshot iteration stopped after %d keys", want)
+				}
+			}
+		}()
+	}
+	// the writer goes on while the snapshot is read
+	for k := 0; k < 1000; k += 2 {
+		tree.Delete(k)
+		tree.Push(k + 1000)
+	}
+	wg.Wait()
+	if n := len(tree.InOrder()); n != 1000 {
+		t.Errorf("the tree holds %d keys, want 1000", n)
+	}
+	checkRBLinks(t, tree)
+}
@@ -0,0 +1,417 @@
+// Splay tree is a self-adjusting binary search tree: every access moves the
+// accessed node to the root by rotations (splaying), so that keys accessed
+// often or recently stay near the top. No balance information is stored,
+// yet any sequence of m operations on n keys takes O((m + n) log n) time
+// (amortized O(log n) each), and workloads with locality of reference, such
+// as repeated lookups of a few hot keys, run faster than on AVL or Red-Black
+// trees.
+// Splitting at a key and joining two trees whose keys do not interleave are
+// single splays.
+//
+// For more details check out those links below here:
+// Wikipedia article: https://en.wikipedia.org/wiki/Splay_tree
+// Sleator and Tarjan, "Self-Adjusting Binary Search Trees", 1985
+// see splay_test.go
+
+package tree
+
+import (
+	"errors"
+
+	"github.com/TheAlgorithms/Go/constraints"
+	"github.com/TheAlgorithms/Go/structure/iterutil"
+)
+
+// ErrJoinOrder is returned when joining a tree whose keys are not all
+// greater than the keys of the tree it is joined to.
+var ErrJoinOrder = errors.New("joined keys must be greater than the keys of the tree")
+
+// Verify Interface Compliance
+var _ Node[int] = &SplayNode[int]{}
+
+// SplayNode represents a single node in the Splay tree.
+type SplayNode[T constraints.Ordered] struct {
+	key    T
+	parent *SplayNode[T]
+	left   *SplayNode[T]
+	right  *SplayNode[T]
+}
+
+func (n *SplayNode[T]) Key() T {
+	return n.key
+}
+
+func (n *SplayNode[T]) Parent() Node[T] {
+	return n.parent
+}
+
+func (n *SplayNode[T]) Left() Node[T] {
+	return n.left
+}
+
+func (n *SplayNode[T]) Right() Node[T] {
+	return n.right
+}
+
+// SplayStats counts the work done by a Splay tree.
+type SplayStats struct {
+	// Splays is the number of nodes moved to the root.
+	Splays int
+	// Rotations is the number of single rotations done while splaying.
+	Rotations int
+}
+
+// Splay represents a Splay tree.
+// By default, _NIL = nil.
+type Splay[T constraints.Ordered] struct {
+	Root  *SplayNode[T]
+	_NIL  *SplayNode[T] // a sentinel value for nil
+	stats SplayStats
+}
+
+// NewSplay creates a novel Splay tree
+func NewSplay[T constraints.Ordered]() *Splay[T] {
+	return &Splay[T]{
+		Root: nil,
+		_NIL: nil,
+	}
+}
+
+// Empty determines the Splay tree is empty
+func (t *Splay[T]) Empty() bool {
+	return t.Root == t._NIL
+}
+
+// Stats returns the number of splays and rotations done so far.
+func (t *Splay[T]) Stats() SplayStats {
+	return t.stats
+}
+
+// ResetStats sets the counters of Stats back to zero.
+func (t *Splay[T]) ResetStats() {
+	t.stats = SplayStats{}
+}
+
+// Insert adds key to the tree and splays it. It reports false if the key
+// was already present.
+func (t *Splay[T]) Insert(key T) bool {
+	if t.Root == t._NIL {
+		t.Root = &SplayNode[T]{key: key}
+		return true
+	}
+	n := t.find(key)
+	if n.key == key {
+		t.splay(n)
+		return false
+	}
+	node := &SplayNode[T]{key: key, parent: n}
+	if key < n.key {
+		n.left = node
+	} else {
+		n.right = node
+	}
+	t.splay(node)
+	return true
+}
+
+// Push a chain of Node's into the Splay tree
+func (t *Splay[T]) Push(keys ...T) {
+	for _, key := range keys {
+		t.Insert(key)
+	}
+}
+
+// Delete removes the node of key and reports whether it was present. The
+// neighbours of key end up near the root.
+func (t *Splay[T]) Delete(key T) bool {
+	if !t.Has(key) {
+		return false
+	}
+	left, right := t.Root.left, t.Root.right
+	if left != t._NIL {
+		left.parent = nil
+	}
+	if right != t._NIL {
+		right.parent = nil
+	}
+	t.Root = t.join(left, right)
+	return true
+}
+
+// Get a Node from the Splay tree, splaying it if it is found or splaying
+// the last node visited otherwise.
+func (t *Splay[T]) Get(key T) (Node[T], bool) {
+	if t.Root == t._NIL {
+		return t._NIL, false
+	}
+	n := t.find(key)
+	t.splay(n)
+	if n.key != key {
+		return t._NIL, false
+	}
+	return n, true
+}
+
+// Has Determines the tree has the node of Key
+func (t *Splay[T]) Has(key T) bool {
+	_, ok := t.Get(key)
+	return ok
+}
+
+// Split removes the keys greater than or equal to key from t and returns
+// them as a new tree.
+func (t *Splay[T]) Split(key T) *Splay[T] {
+	right := NewSplay[T]()
+	if t.Root == t._NIL {
+		return right
+	}
+	t.splay(t.find(key))
+	if t.Root.key < key {
+		right.Root, t.Root.right = t.Root.right, nil
+	} else {
+		right.Root, t.Root = t.Root, t.Root.left
+		right.Root.left = nil
+	}
+	if right.Root != nil {
+		right.Root.parent = nil
+	}
+	if t.Root != nil {
+		t.Root.parent = nil
+	}
+	return right
+}
+
+// Join moves the keys of other into t, leaving other empty. Every key of
+// other must be greater than every key of t; otherwise ErrJoinOrder is
+// returned and both trees are left as they were.
+func (t *Splay[T]) Join(other *Splay[T]) error {
+	if t == other || other.Root == other._NIL {
+		return nil
+	}
+	if t.Root != t._NIL {
+		largest, _ := t.Max()
+		smallest, _ := other.Min()
+		if smallest <= largest {
+			return ErrJoinOrder
+		}
+	}
+	t.Root = t.join(t.Root, other.Root)
+	other.Root = nil
+	return nil
+}
+
+// Clone returns an independent copy of the tree with the same shape. Every
+// key is passed through cloneKey, if it is not nil, which must keep the
+// order of the keys. The statistics of the copy start from zero.
+func (t *Splay[T]) Clone(cloneKey func(T) T) *Splay[T] {
+	var clone func(n, parent *SplayNode[T]) *SplayNode[T]
+	clone = func(n, parent *SplayNode[T]) *SplayNode[T] {
+		if n == t._NIL {
+			return nil
+		}
+		c := &SplayNode[T]{key: cloneKeyOf(n.key, cloneKey), parent: parent}
+		c.left = clone(n.left, c)
+		c.right = clone(n.right, c)
+		return c
+	}
+	c := NewSplay[T]()
+	c.Root = clone(t.Root, nil)
+	return c
+}
+
+// Equal reports whether t and other hold equal keys, according to eq or to
+// == if eq is nil, whatever their shape.
+func (t *Splay[T]) Equal(other *Splay[T], eq func(a, b T) bool) bool {
+	return equalKeys(t.InOrder(), other.InOrder(), eq)
+}
+
+// PreOrder Traverses the tree in the following order Root --> Left --> Right
+func (t *Splay[T]) PreOrder() []T {
+	traversal := make([]T, 0)
+	preOrderRecursive[T](t.Root, t._NIL, &traversal)
+	return traversal
+}
+
+// InOrder Traverses the tree in the following order Left --> Root --> Right
+func (t *Splay[T]) InOrder() []T {
+	return inOrderHelper[T](t.Root, t._NIL)
+}
+
+// PostOrder traverses the tree in the following order Left --> Right --> Root
+func (t *Splay[T]) PostOrder() []T {
+	traversal := make([]T, 0)
+	postOrderRecursive[T](t.Root, t._NIL, &traversal)
+	return traversal
+}
+
+// InOrderSeq returns the keys in the order of InOrder as a lazy sequence.
+func (t *Splay[T]) InOrderSeq() iterutil.Seq[T] {
+	return inOrderSeq[T](t.Root, t._NIL)
+}
+
+// PreOrderSeq returns the keys in the order of PreOrder as a lazy sequence.
+func (t *Splay[T]) PreOrderSeq() iterutil.Seq[T] {
+	return preOrderSeq[T](t.Root, t._NIL)
+}
+
+// PostOrderSeq returns the keys in the order of PostOrder as a lazy sequence.
+func (t *Splay[T]) PostOrderSeq() iterutil.Seq[T] {
+	return postOrderSeq[T](t.Root, t._NIL)
+}
+
+// LevelOrder returns the level order traversal of the tree
+func (t *Splay[T]) LevelOrder() []T {
+	traversal := make([]T, 0)
+	levelOrderHelper[T](t.Root, t._NIL, &traversal)
+	return traversal
+}
+
+// AccessNodesByLayer accesses nodes layer by layer (2-D array),  instead of printing the results as 1-D array.
+func (t *Splay[T]) AccessNodesByLayer() [][]T {
+	return accessNodeByLayerHelper[T](t.Root, t._NIL)
+}
+
+// Depth returns the calculated depth of the Splay tree
+func (t *Splay[T]) Depth() int {
+	return calculateDepth[T](t.Root, t._NIL, 0)
+}
+
+// Max returns the Max value of the tree, splaying it
+func (t *Splay[T]) Max() (T, bool) {
+	if t.Root == t._NIL {
+		var dft T
+		return dft, false
+	}
+	n := t.Root
+	for n.right != t._NIL {
+		n = n.right
+	}
+	t.splay(n)
+	return n.key, true
+}
+
+// Min returns the Min value of the tree, splaying it
+func (t *Splay[T]) Min() (T, bool) {
+	if t.Root == t._NIL {
+		var dft T
+		return dft, false
+	}
+	n := t.Root
+	for n.left != t._NIL {
+		n = n.left
+	}
+	t.splay(n)
+	return n.key, true
+}
+
+// Predecessor returns the Predecessor of the node of Key
+// if there is no predecessor, return default value of type T and false
+// otherwise return the Key of predecessor and true
+func (t *Splay[T]) Predecessor(key T) (T, bool) {
+	if _, ok := t.Get(key); !ok {
+		var dft T
+		return dft, false
+	}
+	return predecessorHelper[T](t.Root, t._NIL, key)
+}
+
+// Successor returns the Successor of the node of Key
+// if there is no successor, return default value of type T and false
+// otherwise return the Key of successor and true
+func (t *Splay[T]) Successor(key T) (T, bool) {
+	if _, ok := t.Get(key); !ok {
+		var dft T
+		return dft, false
+	}
+	return successorHelper[T](t.Root, t._NIL, key)
+}
+
+// find returns the node of key, or the last node visited looking for it.
+// The tree must not be empty.
+func (t *Splay[T]) find(key T) *SplayNode[T] {
+	n := t.Root
+	for {
+		var next *SplayNode[T]
+		switch {
+		case key < n.key:
+			next = n.left
+		case key > n.key:
+			next = n.right
+		default:
+			return n
+		}
+		if next == t._NIL {
+			return n
+		}
+		n = next
+	}
+}
+
+// join links two trees, every key of left being smaller than every key of
+// right, by splaying the largest key of left, which then has no right child.
+func (t *Splay[T]) join(left, right *SplayNode[T]) *SplayNode[T] {
+	if left == t._NIL {
+		return right
+	}
+	t.Root = left
+	n := left
+	for n.right != t._NIL {
+		n = n.right
+	}
+	t.splay(n)
+	n.right = right
+	if right != t._NIL {
+		right.parent = n
+	}
+	return n
+}
+
+// rotate moves x above its parent, keeping the order of the keys.
+func (t *Splay[T]) rotate(x *SplayNode[T]) {
+	p, g := x.parent, x.parent.parent
+	if x == p.left {
+		p.left = x.right
+		if x.right != t._NIL {
+			x.right.parent = p
+		}
+		x.right = p
+	} else {
+		p.right = x.left
+		if x.left != t._NIL {
+			x.left.parent = p
+		}
+		x.left = p
+	}
+	p.parent = x
+	x.parent = g
+	switch {
+	case g == t._NIL:
+		t.Root = x
+	case g.left == p:
+		g.left = x
+	default:
+		g.right = x
+	}
+	t.stats.Rotations++
+}
+
+// splay moves x to the root: by zig-zig steps when x and its parent are
+// children on the same side, zig-zag steps otherwise, and a last zig when x
+// is a child of the root.
+func (t *Splay[T]) splay(x *SplayNode[T]) {
+	for x.parent != t._NIL {
+		p := x.parent
+		g := p.parent
+		switch {
+		case g == t._NIL:
+			t.rotate(x)
+		case (x == p.left) == (p == g.left):
+			t.rotate(p)
+			t.rotate(x)
+		default:
+			t.rotate(x)
+			t.rotate(x)
+		}
+	}
+	t.stats.Splays++
+}
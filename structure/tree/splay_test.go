@@ -0,0 +1,120 @@
+package tree_test
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	bt "github.com/TheAlgorithms/Go/structure/tree"
+)
+
+func TestSplayAccess(t *testing.T) {
+	tree := bt.NewSplay[int]()
+	if !tree.Insert(5) || !tree.Insert(3) || !tree.Insert(8) || tree.Insert(3) {
+		t.Fatalf("Insert should report new keys only")
+	}
+	for _, key := range []int{1, 4, 9, 6} {
+		tree.Push(key)
+		if tree.Root.Key() != key {
+			t.Errorf("inserted %d but %d is at the root", key, tree.Root.Key())
+		}
+	}
+	if _, ok := tree.Get(4); !ok || tree.Root.Key() != 4 {
+		t.Errorf("Get(4) should bring 4 to the root, got %d", tree.Root.Key())
+	}
+	if _, ok := tree.Get(7); ok {
+		t.Errorf("Get(7) found a missing key")
+	}
+	if root := tree.Root.Key(); root != 6 && root != 8 {
+		t.Errorf("Get(7) should splay a neighbour of 7, got %d", root)
+	}
+	if min, _ := tree.Min(); min != 1 || tree.Root.Key() != 1 {
+		t.Errorf("Min() should splay 1")
+	}
+	if !tree.Delete(5) || tree.Delete(5) || tree.Has(5) {
+		t.Errorf("Delete(5) should remove 5 once")
+	}
+	if got, want := tree.InOrder(), []int{1, 3, 4, 6, 8, 9}; !reflect.DeepEqual(got, want) {
+		t.Errorf("InOrder() = %v, want %v", got, want)
+	}
+}
+
+func TestSplaySplitJoin(t *testing.T) {
+	for _, key := range []int{-1, 0, 3, 10, 11, 20, 99} {
+		tree := bt.NewSplay[int]()
+		for i := 0; i < 20; i++ {
+			tree.Push((i * 7) % 20)
+		}
+		right := tree.Split(key)
+		var wantLeft, wantRight []int
+		for i := 0; i < 20; i++ {
+			if i < key {
+				wantLeft = append(wantLeft, i)
+			} else {
+				wantRight = append(wantRight, i)
+			}
+		}
+		if got := tree.InOrder(); !equalInts(got, wantLeft) {
+			t.Errorf("Split(%d) kept %v, want %v", key, got, wantLeft)
+		}
+		if got := right.InOrder(); !equalInts(got, wantRight) {
+			t.Errorf("Split(%d) returned %v, want %v", key, got, wantRight)
+		}
+		if err := right.Join(tree); len(wantLeft) > 0 && len(wantRight) > 0 && err != bt.ErrJoinOrder {
+			t.Errorf("joining smaller keys: error %v, want %v", err, bt.ErrJoinOrder)
+		}
+		if err := tree.Join(right); err != nil {
+			t.Errorf("Join: %v", err)
+		}
+		if got := tree.InOrder(); len(got) != 20 || !right.Empty() {
+			t.Errorf("Join gave %v and left %v", got, right.InOrder())
+		}
+	}
+}
+
+func equalInts(a, b []int) bool {
+	return len(a) == len(b) && (len(a) == 0 || reflect.DeepEqual(a, b))
+}
+
+func TestSplayStats(t *testing.T) {
+	tree := bt.NewSplay[int]()
+	for i := 0; i < 1000; i++ {
+		tree.Push(i)
+	}
+	// Increasing insertions after the first one rotate once each, leaving a
+	// path.
+	if s := tree.Stats(); s.Splays != 999 || s.Rotations != 999 || tree.Depth() != 1000 {
+		t.Errorf("stats %+v and depth %d after increasing insertions", s, tree.Depth())
+	}
+	tree.ResetStats()
+	// Accessing the deepest key halves the depth of the path.
+	tree.Has(0)
+	if s := tree.Stats(); s.Splays != 1 || s.Rotations != 999 || tree.Depth() > 502 {
+		t.Errorf("stats %+v and depth %d after accessing the deepest key", s, tree.Depth())
+	}
+	// Repeated accesses to a hot key cost nothing once it is at the root.
+	tree.ResetStats()
+	for i := 0; i < 100; i++ {
+		tree.Has(0)
+	}
+	if s := tree.Stats(); s.Rotations != 0 {
+		t.Errorf("accessing the root rotated %d times", s.Rotations)
+	}
+}
+
+func TestSplayAmortized(t *testing.T) {
+	tree := bt.NewSplay[int]()
+	rnd := rand.New(rand.NewSource(1))
+	const n, m = 1 << 12, 1 << 15
+	for i := 0; i < n; i++ {
+		tree.Push(rnd.Intn(4 * n))
+	}
+	tree.ResetStats()
+	for i := 0; i < m; i++ {
+		tree.Has(rnd.Intn(4 * n))
+	}
+	// Rotations are amortized O(log n): 3 log2(n) per access is a loose bound.
+	if s := tree.Stats(); s.Rotations > 3*12*m {
+		t.Errorf("%d rotations for %d accesses", s.Rotations, m)
+	}
+}
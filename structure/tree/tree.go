@@ -10,6 +10,7 @@ package tree
 import (
 	"github.com/TheAlgorithms/Go/constraints"
 	"github.com/TheAlgorithms/Go/math/max"
+	"github.com/TheAlgorithms/Go/structure/iterutil"
 )
 
 type Node[T constraints.Ordered] interface {
@@ -49,18 +50,30 @@ func accessNodeByLayerHelper[T constraints.Ordered](root, nilNode Node[T]) [][]T
 	return res
 }
 
-func searchTreeHelper[T constraints.Ordered](node, nilNode Node[T], key T) (Node[T], bool) {
+// searchTreeHelper looks for key below node, calling visit, if it is not
+// nil, with the key of every node on the way.
+func searchTreeHelper[T constraints.Ordered](node, nilNode Node[T], key T, visit func(T)) (Node[T], bool) {
 	if node == nilNode {
 		return node, false
 	}
 
+	visitKey(visit, node.Key())
 	if key == node.Key() {
 		return node, true
 	}
 	if key < node.Key() {
-		return searchTreeHelper(node.Left(), nilNode, key)
+		return searchTreeHelper(node.Left(), nilNode, key, visit)
+	}
+	return searchTreeHelper(node.Right(), nilNode, key, visit)
+}
+
+// visitKey calls visit with key if visit is not nil. The trees calling it
+// report the nodes that Has, Get, Push and Delete walk through to the hook
+// set with their SetVisit method.
+func visitKey[T constraints.Ordered](visit func(T), key T) {
+	if visit != nil {
+		visit(key)
 	}
-	return searchTreeHelper(node.Right(), nilNode, key)
 }
 
 func inOrderHelper[T constraints.Ordered](node, nilNode Node[T]) []T {
@@ -103,6 +116,77 @@ func postOrderRecursive[T constraints.Ordered](n, nilNode Node[T], traversal *[]
 	*traversal = append(*traversal, n.Key())
 }
 
+// inOrderSeq is the lazy counterpart of inOrderHelper: the keys are yielded
+// one at a time, and the walk stops as soon as yield returns false.
+func inOrderSeq[T constraints.Ordered](root, nilNode Node[T]) iterutil.Seq[T] {
+	return func(yield func(T) bool) {
+		var stack []Node[T]
+		node := root
+		for node != nilNode || len(stack) > 0 {
+			for node != nilNode {
+				stack = append(stack, node)
+				node = node.Left()
+			}
+			node = stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if !yield(node.Key()) {
+				return
+			}
+			node = node.Right()
+		}
+	}
+}
+
+// preOrderSeq is the lazy counterpart of preOrderRecursive.
+func preOrderSeq[T constraints.Ordered](root, nilNode Node[T]) iterutil.Seq[T] {
+	return func(yield func(T) bool) {
+		if root == nilNode {
+			return
+		}
+		stack := []Node[T]{root}
+		for len(stack) > 0 {
+			node := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			if !yield(node.Key()) {
+				return
+			}
+			if node.Right() != nilNode {
+				stack = append(stack, node.Right())
+			}
+			if node.Left() != nilNode {
+				stack = append(stack, node.Left())
+			}
+		}
+	}
+}
+
+// postOrderSeq yields a node once both of its subtrees are done, which is
+// when the walk comes back to it from its right child, or from its left
+// child when it has no right one.
+func postOrderSeq[T constraints.Ordered](root, nilNode Node[T]) iterutil.Seq[T] {
+	return func(yield func(T) bool) {
+		var stack []Node[T]
+		var last Node[T]
+		node := root
+		for node != nilNode || len(stack) > 0 {
+			for node != nilNode {
+				stack = append(stack, node)
+				node = node.Left()
+			}
+			top := stack[len(stack)-1]
+			if right := top.Right(); right != nilNode && right != last {
+				node = right
+				continue
+			}
+			stack = stack[:len(stack)-1]
+			if !yield(top.Key()) {
+				return
+			}
+			last = top
+		}
+	}
+}
+
 func calculateDepth[T constraints.Ordered](n, nilNode Node[T], depth int) int {
 	if n == nilNode {
 		return depth
@@ -111,13 +195,17 @@ func calculateDepth[T constraints.Ordered](n, nilNode Node[T], depth int) int {
 	return max.Int(calculateDepth(n.Left(), nilNode, depth+1), calculateDepth(n.Right(), nilNode, depth+1))
 }
 
-func minimum[T constraints.Ordered](node, nilNode Node[T]) Node[T] {
+// minimum returns the node of the smallest key below node, calling visit,
+// if it is not nil, with the key of every node on the way.
+func minimum[T constraints.Ordered](node, nilNode Node[T], visit func(T)) Node[T] {
 	if node == nilNode {
 		return node
 	}
 
+	visitKey(visit, node.Key())
 	for node.Left() != nilNode {
 		node = node.Left()
+		visitKey(visit, node.Key())
 	}
 	return node
 }
@@ -152,38 +240,60 @@ func levelOrderHelper[T constraints.Ordered](root, nilNode Node[T], traversal *[
 	}
 }
 
-func predecessorHelper[T constraints.Ordered](node, nilNode Node[T]) (T, bool) {
-	if node.Left() != nilNode {
-		return maximum(node.Left(), nilNode).Key(), true
+// predecessorHelper returns the largest key below key in the tree of root,
+// met on the way down from the root.
+func predecessorHelper[T constraints.Ordered](root, nilNode Node[T], key T) (T, bool) {
+	var ret T
+	found := false
+	for n := root; n != nilNode; {
+		if n.Key() < key {
+			ret, found = n.Key(), true
+			n = n.Right()
+		} else {
+			n = n.Left()
+		}
 	}
+	return ret, found
+}
 
-	p := node.Parent()
-	for p != nilNode && node == p.Left() {
-		node = p
-		p = p.Parent()
+// successorHelper returns the smallest key above key in the tree of root,
+// met on the way down from the root.
+func successorHelper[T constraints.Ordered](root, nilNode Node[T], key T) (T, bool) {
+	var ret T
+	found := false
+	for n := root; n != nilNode; {
+		if n.Key() > key {
+			ret, found = n.Key(), true
+			n = n.Left()
+		} else {
+			n = n.Right()
+		}
 	}
+	return ret, found
+}
 
-	if p == nilNode {
-		var dft T
-		return dft, false
+// cloneKeyOf returns key passed through clone, or key itself if clone is
+// nil. It is shared by the Clone methods of the trees.
+func cloneKeyOf[T constraints.Ordered](key T, clone func(T) T) T {
+	if clone == nil {
+		return key
 	}
-	return p.Key(), true
+	return clone(key)
 }
 
-func successorHelper[T constraints.Ordered](node, nilNode Node[T]) (T, bool) {
-	if node.Right() != nilNode {
-		return minimum(node.Right(), nilNode).Key(), true
+// equalKeys reports whether two in-order traversals hold equal keys,
+// according to eq or to == if eq is nil.
+func equalKeys[T constraints.Ordered](a, b []T, eq func(a, b T) bool) bool {
+	if len(a) != len(b) {
+		return false
 	}
-
-	p := node.Parent()
-	for p != nilNode && node == p.Right() {
-		node = p
-		p = p.Parent()
+	if eq == nil {
+		eq = func(x, y T) bool { return x == y }
 	}
-
-	if p == nilNode {
-		var dft T
-		return dft, false
+	for i := range a {
+		if !eq(a[i], b[i]) {
+			return false
+		}
 	}
-	return p.Key(), true
+	return true
 }
@@ -6,6 +6,7 @@ import (
 	"sort"
 	"testing"
 
+	"github.com/TheAlgorithms/Go/structure/iterutil"
 	bt "github.com/TheAlgorithms/Go/structure/tree"
 )
 
@@ -492,6 +493,97 @@ func TestTreePredecessorAndSuccessor(t *testing.T) {
 // Benchmark the comparisons between BST, AVL and RB Tree
 const testNum = 10_000
 
+func TestTreeClone(t *testing.T) {
+	nums := rand.New(rand.NewSource(1)).Perm(200)
+
+	// helper checks that the clone matches the tree and does not share its
+	// nodes: deleting half of the keys from the clone leaves the tree alone.
+	helper := func(tree, clone TestTree[int], equal func() bool) {
+		if !equal() {
+			t.Fatalf("a clone should equal its original")
+		}
+		if !reflect.DeepEqual(tree.PreOrder(), clone.PreOrder()) {
+			t.Errorf("a clone should have the shape of its original")
+		}
+		for _, num := range nums[:100] {
+			clone.Delete(num)
+		}
+		if equal() {
+			t.Errorf("trees with different keys should not be equal")
+		}
+		for _, num := range nums {
+			if !tree.Has(num) {
+				t.Fatalf("deleting from a clone removed %d from the original", num)
+			}
+		}
+		if got := clone.InOrder(); len(got) != 100 || !sort.IntsAreSorted(got) {
+			t.Errorf("clone has keys %v after deleting half of them", got)
+		}
+	}
+
+	t.Run("Test Binary Search Tree", func(t *testing.T) {
+		tree := bt.NewBinarySearch[int]()
+		tree.Push(nums...)
+		clone := tree.Clone(nil)
+		helper(tree, clone, func() bool { return tree.Equal(clone, nil) })
+	})
+
+	t.Run("Test Red-Black Tree", func(t *testing.T) {
+		tree := bt.NewRB[int]()
+		tree.Push(nums...)
+		clone := tree.Clone(nil)
+		helper(tree, clone, func() bool { return tree.Equal(clone, nil) })
+	})
+
+	t.Run("Test AVL Tree", func(t *testing.T) {
+		tree := bt.NewAVL[int]()
+		tree.Push(nums...)
+		clone := tree.Clone(nil)
+		helper(tree, clone, func() bool { return tree.Equal(clone, nil) })
+	})
+
+	t.Run("Test Equal Ignores Shape", func(t *testing.T) {
+		a, b := bt.NewBinarySearch[int](), bt.NewBinarySearch[int]()
+		a.Push(1, 2, 3)
+		b.Push(2, 1, 3)
+		if !a.Equal(b, nil) {
+			t.Errorf("trees with the same keys should be equal")
+		}
+		if !bt.NewRB[int]().Equal(bt.NewRB[int](), nil) {
+			t.Errorf("empty trees should be equal")
+		}
+	})
+
+	t.Run("Test Clone And Equal Callbacks", func(t *testing.T) {
+		// scaling keys by ten keeps their order
+		scale := func(k int) int { return 10 * k }
+		scaled := func(a, b int) bool { return 10*a == b }
+		keys := []int{5, 2, 8, 1, 9}
+
+		bst, rb, avl, splay := bt.NewBinarySearch[int](), bt.NewRB[int](), bt.NewAVL[int](), bt.NewSplay[int]()
+		btree := bt.NewBTree[int](3)
+		bst.Push(keys...)
+		rb.Push(keys...)
+		avl.Push(keys...)
+		for _, k := range keys {
+			splay.Insert(k)
+			btree.Insert(k)
+		}
+		checks := map[string][2]bool{
+			"BinarySearch": {bst.Equal(bst.Clone(scale), nil), bst.Equal(bst.Clone(scale), scaled)},
+			"RB":           {rb.Equal(rb.Clone(scale), nil), rb.Equal(rb.Clone(scale), scaled)},
+			"AVL":          {avl.Equal(avl.Clone(scale), nil), avl.Equal(avl.Clone(scale), scaled)},
+			"Splay":        {splay.Equal(splay.Clone(scale), nil), splay.Equal(splay.Clone(scale), scaled)},
+			"BTree":        {btree.Equal(btree.Clone(scale), nil), btree.Equal(btree.Clone(scale), scaled)},
+		}
+		for name, got := range checks {
+			if got[0] || !got[1] {
+				t.Errorf("%s: Equal with == and with eq of a scaled clone = %v, want [false true]", name, got)
+			}
+		}
+	})
+}
+
 func BenchmarkBSTree_Insert(b *testing.B) {
 	helper := func() {
 		tree := bt.NewBinarySearch[int]()
@@ -633,3 +725,53 @@ func BenchmarkAVLTree_Delete(b *testing.B) {
 		helper()
 	}
 }
+
+// seqTree is the part of the trees exposing their traversals, both as slices
+// and as sequences.
+type seqTree interface {
+	PreOrder() []int
+	InOrder() []int
+	PostOrder() []int
+	PreOrderSeq() iterutil.Seq[int]
+	InOrderSeq() iterutil.Seq[int]
+	PostOrderSeq() iterutil.Seq[int]
+}
+
+func TestTreeSeq(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for _, n := range []int{0, 1, 2, 10, 500} {
+		nums := rnd.Perm(n)
+		bst := bt.NewBinarySearch[int]()
+		bst.Push(nums...)
+		avl := bt.NewAVL[int]()
+		avl.Push(nums...)
+		rb := bt.NewRB[int]()
+		rb.Push(nums...)
+		splay := bt.NewSplay[int]()
+		splay.Push(nums...)
+		zip := bt.NewZip[int](1)
+		zip.Push(nums...)
+		trees := map[string]seqTree{"BinarySearch": bst, "AVL": avl, "RB": rb, "Splay": splay, "Zip": zip}
+		for name, tree := range trees {
+			orders := []struct {
+				order string
+				slice []int
+				seq   iterutil.Seq[int]
+			}{
+				{"PreOrder", tree.PreOrder(), tree.PreOrderSeq()},
+				{"InOrder", tree.InOrder(), tree.InOrderSeq()},
+				{"PostOrder", tree.PostOrder(), tree.PostOrderSeq()},
+			}
+			for _, o := range orders {
+				if got := iterutil.Collect(o.seq); len(got) != len(o.slice) || len(got) > 0 && !reflect.DeepEqual(got, o.slice) {
+					t.Fatalf("%s %sSeq of %d keys = %v, want %v", name, o.order, n, got, o.slice)
+				}
+				// stopping early yields a prefix of the traversal
+				k := n / 3
+				if got := iterutil.Collect(iterutil.Take(o.seq, k)); len(got) != k || k > 0 && !reflect.DeepEqual(got, o.slice[:k]) {
+					t.Fatalf("%s %sSeq taking %d keys = %v, want %v", name, o.order, k, got, o.slice[:k])
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,328 @@
+// Zip tree is a randomized binary search tree in the shape of a skip list.
+// Every node draws a random rank from a geometric distribution, the number
+// of coin flips until the first tail, and the tree is kept heap-ordered by
+// rank, ties going to the smaller key, which fixes its shape for a given set
+// of ranks. Instead of rotations, inserting a node unzips the path below its
+// place into the nodes smaller and greater than its key, which become its
+// left and right subtrees, and deleting a node zips its two subtrees back
+// into one along their inner spines. The expected depth of a node is about
+// 1.5 log2(n), like the height of a search in a skip list, and an insertion
+// or deletion changes O(1) pointers in expectation.
+//
+// For more details check out those links below here:
+// Tarjan, Levy and Timmel, "Zip Trees", 2019: https://arxiv.org/abs/1806.06726
+// Wikipedia article: https://en.wikipedia.org/wiki/Zip_tree
+// see zip_test.go
+
+package tree
+
+import (
+	"math/bits"
+	"math/rand"
+
+	"github.com/TheAlgorithms/Go/constraints"
+	"github.com/TheAlgorithms/Go/structure/iterutil"
+)
+
+// Verify Interface Compliance
+var _ Node[int] = &ZipNode[int]{}
+
+// ZipNode represents a single node in the Zip tree.
+type ZipNode[T constraints.Ordered] struct {
+	key    T
+	rank   int
+	parent *ZipNode[T]
+	left   *ZipNode[T]
+	right  *ZipNode[T]
+}
+
+func (n *ZipNode[T]) Key() T {
+	return n.key
+}
+
+func (n *ZipNode[T]) Parent() Node[T] {
+	return n.parent
+}
+
+func (n *ZipNode[T]) Left() Node[T] {
+	return n.left
+}
+
+func (n *ZipNode[T]) Right() Node[T] {
+	return n.right
+}
+
+// Rank returns the random rank of the node, which is at least the rank of
+// its children.
+func (n *ZipNode[T]) Rank() int {
+	return n.rank
+}
+
+// Zip represents a Zip tree.
+// By default, _NIL = nil.
+type Zip[T constraints.Ordered] struct {
+	Root *ZipNode[T]
+	_NIL *ZipNode[T] // a sentinel value for nil
+	rnd  *rand.Rand
+}
+
+// NewZip creates a novel Zip tree drawing the ranks of its nodes from a
+// generator seeded with seed.
+func NewZip[T constraints.Ordered](seed int64) *Zip[T] {
+	return &Zip[T]{
+		Root: nil,
+		_NIL: nil,
+		rnd:  rand.New(rand.NewSource(seed)),
+	}
+}
+
+// Empty determines the Zip tree is empty
+func (t *Zip[T]) Empty() bool {
+	return t.Root == t._NIL
+}
+
+// above reports whether n stays above a node of the given rank and key.
+func (n *ZipNode[T]) above(rank int, key T) bool {
+	return rank < n.rank || rank == n.rank && key > n.key
+}
+
+// Insert adds key to the tree and reports false if it was already present.
+func (t *Zip[T]) Insert(key T) bool {
+	if t.Has(key) {
+		return false
+	}
+	x := &ZipNode[T]{key: key, rank: bits.TrailingZeros64(t.rnd.Uint64())}
+
+	// go down to the node x takes the place of
+	cur, prev := t.Root, t._NIL
+	for cur != t._NIL && cur.above(x.rank, key) {
+		prev = cur
+		if key < cur.key {
+			cur = cur.left
+		} else {
+			cur = cur.right
+		}
+	}
+	switch {
+	case prev == t._NIL:
+		t.Root = x
+	case key < prev.key:
+		prev.left = x
+	default:
+		prev.right = x
+	}
+	x.parent = prev
+	if cur == t._NIL {
+		return true
+	}
+
+	// unzip the path from cur into the smaller and greater keys
+	if key < cur.key {
+		x.right = cur
+	} else {
+		x.left = cur
+	}
+	cur.parent = x
+	prev = x
+	for cur != t._NIL {
+		fix := prev
+		if cur.key < key {
+			for {
+				prev, cur = cur, cur.right
+				if cur == t._NIL || cur.key > key {
+					break
+				}
+			}
+		} else {
+			for {
+				prev, cur = cur, cur.left
+				if cur == t._NIL || cur.key < key {
+					break
+				}
+			}
+		}
+		if fix.key > key || fix == x && prev.key > key {
+			fix.left = cur
+		} else {
+			fix.right = cur
+		}
+		if cur != t._NIL {
+			cur.parent = fix
+		}
+	}
+	return true
+}
+
+// Push a chain of Node's into the Zip tree
+func (t *Zip[T]) Push(keys ...T) {
+	for _, key := range keys {
+		t.Insert(key)
+	}
+}
+
+// Delete removes the node of key and reports whether it was present.
+func (t *Zip[T]) Delete(key T) bool {
+	node, ok := t.Get(key)
+	if !ok {
+		return false
+	}
+	x := node.(*ZipNode[T])
+	left, right := x.left, x.right
+	var cur *ZipNode[T]
+	switch {
+	case left == t._NIL:
+		cur = right
+	case right == t._NIL:
+		cur = left
+	case left.rank >= right.rank:
+		cur = left
+	default:
+		cur = right
+	}
+	t.replaceChild(x.parent, x, cur)
+
+	// zip the right spine of left with the left spine of right
+	for left != t._NIL && right != t._NIL {
+		var prev *ZipNode[T]
+		if left.rank >= right.rank {
+			for {
+				prev, left = left, left.right
+				if left == t._NIL || left.rank < right.rank {
+					break
+				}
+			}
+			prev.right = right
+			right.parent = prev
+		} else {
+			for {
+				prev, right = right, right.left
+				if right == t._NIL || left.rank >= right.rank {
+					break
+				}
+			}
+			prev.left = left
+			left.parent = prev
+		}
+	}
+	return true
+}
+
+// replaceChild puts n, which may be _NIL, in the place of the child old of
+// parent, or at the root if parent is _NIL.
+func (t *Zip[T]) replaceChild(parent, old, n *ZipNode[T]) {
+	switch {
+	case parent == t._NIL:
+		t.Root = n
+	case parent.left == old:
+		parent.left = n
+	default:
+		parent.right = n
+	}
+	if n != t._NIL {
+		n.parent = parent
+	}
+}
+
+// Get a Node from the Zip tree
+func (t *Zip[T]) Get(key T) (Node[T], bool) {
+	return searchTreeHelper[T](t.Root, t._NIL, key, nil)
+}
+
+// Has Determines the tree has the node of Key
+func (t *Zip[T]) Has(key T) bool {
+	_, ok := searchTreeHelper[T](t.Root, t._NIL, key, nil)
+	return ok
+}
+
+// PreOrder Traverses the tree in the following order Root --> Left --> Right
+func (t *Zip[T]) PreOrder() []T {
+	traversal := make([]T, 0)
+	preOrderRecursive[T](t.Root, t._NIL, &traversal)
+	return traversal
+}
+
+// InOrder Traverses the tree in the following order Left --> Root --> Right
+func (t *Zip[T]) InOrder() []T {
+	return inOrderHelper[T](t.Root, t._NIL)
+}
+
+// PostOrder traverses the tree in the following order Left --> Right --> Root
+func (t *Zip[T]) PostOrder() []T {
+	traversal := make([]T, 0)
+	postOrderRecursive[T](t.Root, t._NIL, &traversal)
+	return traversal
+}
+
+// InOrderSeq returns the keys in the order of InOrder as a lazy sequence.
+func (t *Zip[T]) InOrderSeq() iterutil.Seq[T] {
+	return inOrderSeq[T](t.Root, t._NIL)
+}
+
+// PreOrderSeq returns the keys in the order of PreOrder as a lazy sequence.
+func (t *Zip[T]) PreOrderSeq() iterutil.Seq[T] {
+	return preOrderSeq[T](t.Root, t._NIL)
+}
+
+// PostOrderSeq returns the keys in the order of PostOrder as a lazy sequence.
+func (t *Zip[T]) PostOrderSeq() iterutil.Seq[T] {
+	return postOrderSeq[T](t.Root, t._NIL)
+}
+
+// LevelOrder returns the level order traversal of the tree
+func (t *Zip[T]) LevelOrder() []T {
+	traversal := make([]T, 0)
+	levelOrderHelper[T](t.Root, t._NIL, &traversal)
+	return traversal
+}
+
+// AccessNodesByLayer accesses nodes layer by layer (2-D array),  instead of printing the results as 1-D array.
+func (t *Zip[T]) AccessNodesByLayer() [][]T {
+	return accessNodeByLayerHelper[T](t.Root, t._NIL)
+}
+
+// Depth returns the calculated depth of the Zip tree
+func (t *Zip[T]) Depth() int {
+	return calculateDepth[T](t.Root, t._NIL, 0)
+}
+
+// Max returns the Max value of the tree
+func (t *Zip[T]) Max() (T, bool) {
+	ret := maximum[T](t.Root, t._NIL)
+	if ret == t._NIL {
+		var dft T
+		return dft, false
+	}
+	return ret.Key(), true
+}
+
+// Min returns the Min value of the tree
+func (t *Zip[T]) Min() (T, bool) {
+	ret := minimum[T](t.Root, t._NIL, nil)
+	if ret == t._NIL {
+		var dft T
+		return dft, false
+	}
+	return ret.Key(), true
+}
+
+// Predecessor returns the Predecessor of the node of Key
+// if there is no predecessor, return default value of type T and false
+// otherwise return the Key of predecessor and true
+func (t *Zip[T]) Predecessor(key T) (T, bool) {
+	if _, ok := searchTreeHelper[T](t.Root, t._NIL, key, nil); !ok {
+		var dft T
+		return dft, false
+	}
+	return predecessorHelper[T](t.Root, t._NIL, key)
+}
+
+// Successor returns the Successor of the node of Key
+// if there is no successor, return default value of type T and false
+// otherwise return the Key of successor and true
+func (t *Zip[T]) Successor(key T) (T, bool) {
+	if _, ok := searchTreeHelper[T](t.Root, t._NIL, key, nil); !ok {
+		var dft T
+		return dft, false
+	}
+	return successorHelper[T](t.Root, t._NIL, key)
+}
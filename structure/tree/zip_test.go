@@ -0,0 +1,93 @@
+package tree_test
+
+import (
+	"math/bits"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	bt "github.com/TheAlgorithms/Go/structure/tree"
+)
+
+// checkZip checks that the ranks of the tree are heap-ordered, ties going to
+// the smaller key, and that the parent links match the children.
+func checkZip(t *testing.T, tree *bt.Zip[int]) {
+	t.Helper()
+	var check func(n *bt.ZipNode[int])
+	check = func(n *bt.ZipNode[int]) {
+		for i, child := range []bt.Node[int]{n.Left(), n.Right()} {
+			c, _ := child.(*bt.ZipNode[int])
+			if c == nil {
+				continue
+			}
+			if c.Parent() != bt.Node[int](n) {
+				t.Fatalf("the parent of %d is not %d", c.Key(), n.Key())
+			}
+			// a right child may only tie with its parent, which has the
+			// smaller key
+			if c.Rank() > n.Rank() || i == 0 && c.Rank() == n.Rank() {
+				t.Fatalf("node %d of rank %d is below %d of rank %d", c.Key(), c.Rank(), n.Key(), n.Rank())
+			}
+			check(c)
+		}
+	}
+	if tree.Root != nil {
+		if tree.Root.Parent() != bt.Node[int]((*bt.ZipNode[int])(nil)) {
+			t.Fatalf("the root has a parent")
+		}
+		check(tree.Root)
+	}
+}
+
+func TestZip(t *testing.T) {
+	tree := bt.NewZip[int](1)
+	if !tree.Insert(5) || !tree.Insert(3) || tree.Insert(5) {
+		t.Fatalf("Insert should report new keys only")
+	}
+	tree.Push(8, 1, 4, 9, 6)
+	if got, want := tree.InOrder(), []int{1, 3, 4, 5, 6, 8, 9}; !reflect.DeepEqual(got, want) {
+		t.Errorf("InOrder() = %v, want %v", got, want)
+	}
+	if !tree.Delete(5) || tree.Delete(5) {
+		t.Errorf("Delete should report present keys only")
+	}
+	checkZip(t, tree)
+
+	// the shape only depends on the ranks, so a tree with the same seed and
+	// the same insertions has the same shape
+	other := bt.NewZip[int](1)
+	other.Push(5, 3, 8, 1, 4, 9, 6)
+	other.Delete(5)
+	if !reflect.DeepEqual(tree.PreOrder(), other.PreOrder()) {
+		t.Errorf("PreOrder() = %v and %v for the same seed", tree.PreOrder(), other.PreOrder())
+	}
+}
+
+func TestZipRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	tree := bt.NewZip[int](2)
+	set := map[int]bool{}
+	for i := 0; i < 20000; i++ {
+		x := rnd.Intn(2000)
+		if rnd.Intn(3) == 0 {
+			if got := tree.Delete(x); got != set[x] {
+				t.Fatalf("Delete(%d) = %t, want %t", x, got, set[x])
+			}
+			delete(set, x)
+		} else {
+			tree.Push(x)
+			set[x] = true
+		}
+		if i%1000 == 0 {
+			checkZip(t, tree)
+		}
+	}
+	checkZip(t, tree)
+	if got := len(tree.InOrder()); got != len(set) {
+		t.Fatalf("the tree has %d keys, want %d", got, len(set))
+	}
+	// the expected depth is about 1.5 log2(n); allow some slack
+	if limit := 3 * bits.Len(uint(len(set))); tree.Depth() > limit {
+		t.Errorf("Depth() = %d with %d keys", tree.Depth(), len(set))
+	}
+}
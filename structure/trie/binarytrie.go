@@ -0,0 +1,148 @@
+// binarytrie.go
+// description: Binary (XOR) trie over unsigned integers
+// details:
+// A binary trie stores integers by their bits, from the most significant to the
+// least significant one, so every root-to-leaf path spells out a stored value.
+// Each node counts how many stored values pass through it, which allows
+// duplicates, deletion and counting queries. Walking the trie greedily towards
+// the opposite bit answers maximum-XOR queries in O(bits).
+// reference: https://cp-algorithms.com/data_structures/trie.html
+// see binarytrie_test.go
+
+package trie
+
+import "errors"
+
+// binaryNode is a single node of a BinaryTrie.
+type binaryNode struct {
+	children [2]*binaryNode
+	count    int // number of stored values in this subtree
+}
+
+// BinaryTrie is a trie over the bit representation of unsigned integers.
+type BinaryTrie struct {
+	root *binaryNode
+	bits int
+}
+
+// NewBinaryTrie creates an empty BinaryTrie for values that fit in the given
+// number of bits, which must be between 1 and 64.
+func NewBinaryTrie(bits int) (*BinaryTrie, error) {
+	if bits < 1 || bits > 64 {
+		return nil, errors.New("bits must be between 1 and 64")
+	}
+	return &BinaryTrie{root: &binaryNode{}, bits: bits}, nil
+}
+
+// Len returns the number of values stored, counting duplicates.
+func (t *BinaryTrie) Len() int {
+	return t.root.count
+}
+
+// bit returns the i-th bit of x, counting from the most significant one.
+func (t *BinaryTrie) bit(x uint64, i int) uint64 {
+	return (x >> uint(t.bits-1-i)) & 1
+}
+
+// high returns x with the bits below the configured width cleared.
+func (t *BinaryTrie) high(x uint64) uint64 {
+	if t.bits == 64 {
+		return 0
+	}
+	return x >> uint(t.bits) << uint(t.bits)
+}
+
+// Insert stores x in the trie. Bits above the configured width are ignored.
+func (t *BinaryTrie) Insert(x uint64) {
+	n := t.root
+	n.count++
+	for i := 0; i < t.bits; i++ {
+		b := t.bit(x, i)
+		if n.children[b] == nil {
+			n.children[b] = &binaryNode{}
+		}
+		n = n.children[b]
+		n.count++
+	}
+}
+
+// Has reports whether x is stored in the trie.
+func (t *BinaryTrie) Has(x uint64) bool {
+	n := t.root
+	for i := 0; i < t.bits; i++ {
+		n = n.children[t.bit(x, i)]
+		if n == nil || n.count == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Delete removes one occurrence of x and reports whether x was present.
+// Nodes that become empty are unlinked from the trie.
+func (t *BinaryTrie) Delete(x uint64) bool {
+	if !t.Has(x) {
+		return false
+	}
+	n := t.root
+	n.count--
+	for i := 0; i < t.bits; i++ {
+		b := t.bit(x, i)
+		child := n.children[b]
+		child.count--
+		if child.count == 0 {
+			n.children[b] = nil
+			return true
+		}
+		n = child
+	}
+	return true
+}
+
+// MaxXor returns the maximum value of x XOR y over all stored values y.
+// The bits of x above the configured width are kept in the result, as every
+// stored value has zeros there. The second return value is false when the
+// trie is empty.
+func (t *BinaryTrie) MaxXor(x uint64) (uint64, bool) {
+	if t.root.count == 0 {
+		return 0, false
+	}
+	result := t.high(x)
+	n := t.root
+	for i := 0; i < t.bits; i++ {
+		b := t.bit(x, i)
+		if n.children[b^1] != nil {
+			result |= 1 << uint(t.bits-1-i)
+			n = n.children[b^1]
+		} else {
+			n = n.children[b]
+		}
+	}
+	return result, true
+}
+
+// CountLess returns how many stored values y satisfy (x XOR y) < limit.
+func (t *BinaryTrie) CountLess(x, limit uint64) int {
+	// Above the configured width, x XOR y equals x for every stored y.
+	switch xh, lh := t.high(x), t.high(limit); {
+	case xh < lh:
+		return t.root.count
+	case xh > lh:
+		return 0
+	}
+	count := 0
+	n := t.root
+	for i := 0; i < t.bits && n != nil; i++ {
+		b := t.bit(x, i)
+		if t.bit(limit, i) == 1 {
+			// Values following bit b make this XOR bit 0, below limit's 1.
+			if n.children[b] != nil {
+				count += n.children[b].count
+			}
+			n = n.children[b^1]
+		} else {
+			n = n.children[b]
+		}
+	}
+	return count
+}
@@ -0,0 +1,106 @@
+package trie
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestBinaryTrieMaxXor(t *testing.T) {
+	bt, err := NewBinaryTrie(8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := bt.MaxXor(3); ok {
+		t.Errorf("MaxXor on an empty trie should report false")
+	}
+
+	for _, v := range []uint64{3, 10, 5, 25, 2, 8} {
+		bt.Insert(v)
+	}
+	// 5 ^ 25 = 28 is the best pair for 5.
+	if got, _ := bt.MaxXor(5); got != 28 {
+		t.Errorf("MaxXor(5) = %d, want 28", got)
+	}
+
+	if !bt.Delete(25) {
+		t.Errorf("Delete(25) = false, want true")
+	}
+	if bt.Delete(25) {
+		t.Errorf("Delete(25) twice = true, want false")
+	}
+	if bt.Has(25) {
+		t.Errorf("Has(25) = true after delete")
+	}
+	// without 25 the best partner for 5 is 10: 5 ^ 10 = 15.
+	if got, _ := bt.MaxXor(5); got != 15 {
+		t.Errorf("MaxXor(5) = %d, want 15", got)
+	}
+	if bt.Len() != 5 {
+		t.Errorf("Len() = %d, want 5", bt.Len())
+	}
+}
+
+func TestBinaryTrieHighBits(t *testing.T) {
+	bt, _ := NewBinaryTrie(4)
+	bt.Insert(3)
+	if got, _ := bt.MaxXor(1 << 10); got != 1027 {
+		t.Errorf("MaxXor(1024) = %d, want 1027", got)
+	}
+	if got := bt.CountLess(1<<10, 1028); got != 1 {
+		t.Errorf("CountLess(1024, 1028) = %d, want 1", got)
+	}
+	if got := bt.CountLess(1<<10, 1027); got != 0 {
+		t.Errorf("CountLess(1024, 1027) = %d, want 0", got)
+	}
+	full, _ := NewBinaryTrie(64)
+	full.Insert(1)
+	if got, _ := full.MaxXor(1 << 63); got != 1<<63|1 {
+		t.Errorf("MaxXor(1<<63) = %d, want %d", got, uint64(1<<63|1))
+	}
+}
+
+func TestBinaryTrieInvalidWidth(t *testing.T) {
+	for _, bits := range []int{0, 65} {
+		if _, err := NewBinaryTrie(bits); err == nil {
+			t.Errorf("NewBinaryTrie(%d) should fail", bits)
+		}
+	}
+}
+
+func TestBinaryTrieAgainstBruteForce(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	bt, _ := NewBinaryTrie(10)
+	var values []uint64
+	for i := 0; i < 300; i++ {
+		v := uint64(rnd.Intn(1 << 10))
+		values = append(values, v)
+		bt.Insert(v)
+	}
+	for i := 0; i < 100; i++ {
+		v := values[i]
+		bt.Delete(v)
+	}
+	values = values[100:]
+
+	for q := 0; q < 200; q++ {
+		// x and limit may have bits above the width of the trie
+		x := uint64(rnd.Intn(1 << 12))
+		limit := uint64(rnd.Intn(1<<13 + 1))
+		var best uint64
+		count := 0
+		for _, v := range values {
+			if x^v > best {
+				best = x ^ v
+			}
+			if x^v < limit {
+				count++
+			}
+		}
+		if got, _ := bt.MaxXor(x); got != best {
+			t.Fatalf("MaxXor(%d) = %d, want %d", x, got, best)
+		}
+		if got := bt.CountLess(x, limit); got != count {
+			t.Fatalf("CountLess(%d, %d) = %d, want %d", x, limit, got, count)
+		}
+	}
+}
@@ -0,0 +1,207 @@
+// doublearray.go
+// description: Double-array trie
+// details:
+// A double-array trie stores the transitions of a trie in two integer arrays.
+// A transition from state s on symbol c leads to t = base[s] + c, and is valid
+// only when check[t] == s. Lookups thus cost one addition and one comparison
+// per byte and the whole structure is two flat slices, which is far smaller
+// and more cache friendly than pointer based tries, at the price of being
+// built once from a sorted word list.
+// Each byte b is encoded as symbol b+1 and the end of a word as symbol 0; the
+// state reached through symbol 0 stores the word's index as a negative base.
+// reference: Aoe, J. "An Efficient Digital Search Algorithm by Using a
+// Double-Array Structure", IEEE Transactions on Software Engineering, 1989
+// see doublearray_test.go
+
+package trie
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// DoubleArray is a static trie built from a sorted list of distinct words.
+// Word i of the list is identified by i.
+type DoubleArray struct {
+	base  []int32
+	check []int32 // -1 marks a free slot
+	words int
+	free  int // no free slot below this index, only used while building
+}
+
+// NewDoubleArray builds a DoubleArray from words, which must be sorted in
+// increasing byte order and contain no duplicates.
+func NewDoubleArray(words []string) (*DoubleArray, error) {
+	for i := 1; i < len(words); i++ {
+		if words[i-1] >= words[i] {
+			return nil, errors.New("words must be sorted and distinct")
+		}
+	}
+	d := &DoubleArray{words: len(words)}
+	d.grow(257)
+	d.check[0] = 0
+	if len(words) > 0 {
+		d.insert(0, words, 0, len(words), 0)
+	}
+	// Drop the unused tail.
+	last := len(d.check) - 1
+	for last > 0 && d.check[last] < 0 {
+		last--
+	}
+	d.base, d.check = d.base[:last+1], d.check[:last+1]
+	return d, nil
+}
+
+// grow extends the arrays to at least n slots.
+func (d *DoubleArray) grow(n int) {
+	for len(d.check) < n {
+		d.base = append(d.base, 0)
+		d.check = append(d.check, -1)
+	}
+}
+
+// symbol returns the code of word at depth: 0 at its end, byte+1 otherwise.
+func symbol(word string, depth int) int {
+	if depth == len(word) {
+		return 0
+	}
+	return int(word[depth]) + 1
+}
+
+// insert places the children of state s, which stands for words[lo:hi]
+// sharing their first depth bytes.
+func (d *DoubleArray) insert(s int, words []string, lo, hi, depth int) {
+	// Distinct symbols and the ranges of words they lead to, in order.
+	var symbols, starts []int
+	for i := lo; i < hi; i++ {
+		c := symbol(words[i], depth)
+		if len(symbols) == 0 || symbols[len(symbols)-1] != c {
+			symbols = append(symbols, c)
+			starts = append(starts, i)
+		}
+	}
+	starts = append(starts, hi)
+
+	// Find the first base under which every child slot is free, starting
+	// from the lowest free slot.
+	for d.free < len(d.check) && d.check[d.free] >= 0 {
+		d.free++
+	}
+	b := d.free - symbols[0]
+	if b < 1 {
+		b = 1
+	}
+	for {
+		d.grow(b + symbols[len(symbols)-1] + 1)
+		fits := true
+		for _, c := range symbols {
+			if d.check[b+c] >= 0 {
+				fits = false
+				break
+			}
+		}
+		if fits {
+			break
+		}
+		b++
+	}
+	d.base[s] = int32(b)
+	for _, c := range symbols {
+		d.check[b+c] = int32(s)
+	}
+	for k, c := range symbols {
+		t := b + c
+		if c == 0 {
+			// words[starts[k]] ends here
+			d.base[t] = -int32(starts[k]) - 1
+			continue
+		}
+		d.insert(t, words, starts[k], starts[k+1], depth+1)
+	}
+}
+
+// next follows symbol c from state s and returns the target, or -1.
+func (d *DoubleArray) next(s, c int) int {
+	if d.base[s] <= 0 {
+		return -1
+	}
+	t := int(d.base[s]) + c
+	if t >= len(d.check) || int(d.check[t]) != s {
+		return -1
+	}
+	return t
+}
+
+// Len returns the number of words stored.
+func (d *DoubleArray) Len() int {
+	return d.words
+}
+
+// Find returns the index of word in the list the trie was built from.
+// The second return value is false when word is not stored.
+func (d *DoubleArray) Find(word string) (int, bool) {
+	s := 0
+	for i := 0; i < len(word) && s >= 0; i++ {
+		s = d.next(s, int(word[i])+1)
+	}
+	if s < 0 {
+		return 0, false
+	}
+	if t := d.next(s, 0); t >= 0 {
+		return int(-d.base[t] - 1), true
+	}
+	return 0, false
+}
+
+// CommonPrefixSearch returns the indices of all stored words that are
+// prefixes of text, shortest first.
+func (d *DoubleArray) CommonPrefixSearch(text string) []int {
+	var result []int
+	s := 0
+	for i := 0; ; i++ {
+		if t := d.next(s, 0); t >= 0 {
+			result = append(result, int(-d.base[t]-1))
+		}
+		if i == len(text) {
+			return result
+		}
+		if s = d.next(s, int(text[i])+1); s < 0 {
+			return result
+		}
+	}
+}
+
+// MarshalBinary encodes the trie as the number of words and slots followed by
+// the base and check arrays, all as little-endian 32-bit integers.
+func (d *DoubleArray) MarshalBinary() ([]byte, error) {
+	buf := make([]byte, 8+8*len(d.base))
+	binary.LittleEndian.PutUint32(buf[0:], uint32(d.words))
+	binary.LittleEndian.PutUint32(buf[4:], uint32(len(d.base)))
+	for i := range d.base {
+		binary.LittleEndian.PutUint32(buf[8+8*i:], uint32(d.base[i]))
+		binary.LittleEndian.PutUint32(buf[12+8*i:], uint32(d.check[i]))
+	}
+	return buf, nil
+}
+
+// UnmarshalBinary decodes a trie encoded by MarshalBinary into d.
+func (d *DoubleArray) UnmarshalBinary(data []byte) error {
+	if len(data) < 8 {
+		return errors.New("double-array data is too short")
+	}
+	words := int(binary.LittleEndian.Uint32(data[0:]))
+	n := int(binary.LittleEndian.Uint32(data[4:]))
+	if n < 1 || len(data) != 8+8*n {
+		return errors.New("double-array data has an invalid length")
+	}
+	base, check := make([]int32, n), make([]int32, n)
+	for i := 0; i < n; i++ {
+		base[i] = int32(binary.LittleEndian.Uint32(data[8+8*i:]))
+		check[i] = int32(binary.LittleEndian.Uint32(data[12+8*i:]))
+		if int(check[i]) >= n {
+			return errors.New("double-array data is corrupted")
+		}
+	}
+	d.base, d.check, d.words = base, check, words
+	return nil
+}
@@ -0,0 +1,87 @@
+package trie
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestDoubleArray(t *testing.T) {
+	words := []string{"", "a", "ab", "abc", "b", "bcd", "tea", "ten", "to"}
+	d, err := NewDoubleArray(words)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Len() != len(words) {
+		t.Errorf("Len() = %d, want %d", d.Len(), len(words))
+	}
+	for i, w := range words {
+		if id, ok := d.Find(w); !ok || id != i {
+			t.Errorf("Find(%q) = %d, %v, want %d, true", w, id, ok, i)
+		}
+	}
+	for _, w := range []string{"abcd", "t", "te", "c", "bc"} {
+		if _, ok := d.Find(w); ok {
+			t.Errorf("Find(%q) should fail", w)
+		}
+	}
+
+	tests := []struct {
+		text string
+		want []int
+	}{
+		{"abcde", []int{0, 1, 2, 3}},
+		{"bcdx", []int{0, 4, 5}},
+		{"tent", []int{0, 7}},
+		{"x", []int{0}},
+	}
+	for _, test := range tests {
+		if got := d.CommonPrefixSearch(test.text); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("CommonPrefixSearch(%q) = %v, want %v", test.text, got, test.want)
+		}
+	}
+}
+
+func TestDoubleArrayUnsorted(t *testing.T) {
+	if _, err := NewDoubleArray([]string{"b", "a"}); err == nil {
+		t.Errorf("unsorted words should be rejected")
+	}
+	if _, err := NewDoubleArray([]string{"a", "a"}); err == nil {
+		t.Errorf("duplicate words should be rejected")
+	}
+}
+
+func TestDoubleArraySerialization(t *testing.T) {
+	var words []string
+	for i := 0; i < 2000; i++ {
+		words = append(words, fmt.Sprintf("%x", i*7919))
+	}
+	sort.Strings(words)
+	d, _ := NewDoubleArray(words)
+
+	data, err := d.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded DoubleArray
+	if err := decoded.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	for i, w := range words {
+		if id, ok := decoded.Find(w); !ok || id != i {
+			t.Fatalf("decoded Find(%q) = %d, %v, want %d, true", w, id, ok, i)
+		}
+	}
+	if decoded.Len() != len(words) {
+		t.Errorf("decoded Len() = %d, want %d", decoded.Len(), len(words))
+	}
+	if err := decoded.UnmarshalBinary(data[:len(data)-1]); err == nil {
+		t.Errorf("truncated data should be rejected")
+	}
+
+	empty, _ := NewDoubleArray(nil)
+	if _, ok := empty.Find(""); ok {
+		t.Errorf("empty trie should not contain the empty word")
+	}
+}
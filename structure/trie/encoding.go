@@ -0,0 +1,197 @@
+// encoding.go
+// description: JSON and gob encoding of the tries
+// details:
+// A Node trie is encoded as the sorted list of its words, a JSON array of
+// strings in JSON; decoding inserts the words into a fresh trie, so nodes left
+// over by lazy removals are not carried along. A BinaryTrie is encoded as its
+// width in bits and its values in increasing order, duplicates included,
+// {"bits": 8, "values": [...]}. DoubleArray already implements
+// encoding.BinaryMarshaler, which gob uses.
+// Decoding replaces the content of the trie.
+// see encoding_test.go
+
+package trie
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"errors"
+	"sort"
+
+	"github.com/TheAlgorithms/Go/structure/iterutil"
+)
+
+// Words returns the words of the trie in lexicographic order.
+func (n *Node) Words() []string {
+	words := []string{}
+	var walk func(n *Node, prefix []rune)
+	walk = func(n *Node, prefix []rune) {
+		if n.isLeaf {
+			words = append(words, string(prefix))
+		}
+		for _, c := range n.sortedChildren() {
+			walk(n.children[c], append(prefix, c))
+		}
+	}
+	walk(n, nil)
+	return words
+}
+
+// WordsSeq returns the words of the trie in lexicographic order, as Words
+// does, but as a lazy sequence: the walk stops with the last word consumed.
+func (n *Node) WordsSeq() iterutil.Seq[string] {
+	return func(yield func(string) bool) {
+		type frame struct {
+			node   *Node
+			prefix []rune
+			next   []rune
+		}
+		stack := []frame{{node: n, next: n.sortedChildren()}}
+		if n.isLeaf && !yield("") {
+			return
+		}
+		for len(stack) > 0 {
+			top := &stack[len(stack)-1]
+			if len(top.next) == 0 {
+				stack = stack[:len(stack)-1]
+				continue
+			}
+			c := top.next[0]
+			top.next = top.next[1:]
+			child := top.node.children[c]
+			prefix := append(top.prefix[:len(top.prefix):len(top.prefix)], c)
+			if child.isLeaf && !yield(string(prefix)) {
+				return
+			}
+			stack = append(stack, frame{node: child, prefix: prefix, next: child.sortedChildren()})
+		}
+	}
+}
+
+// sortedChildren returns the runes leading to the children of n in
+// increasing order.
+func (n *Node) sortedChildren() []rune {
+	runes := make([]rune, 0, len(n.children))
+	for c := range n.children {
+		runes = append(runes, c)
+	}
+	sort.Slice(runes, func(i, j int) bool { return runes[i] < runes[j] })
+	return runes
+}
+
+// MarshalJSON encodes the words of the trie as a sorted JSON array.
+func (n *Node) MarshalJSON() ([]byte, error) {
+	return json.Marshal(n.Words())
+}
+
+// UnmarshalJSON replaces the trie with one holding the words of the JSON
+// array data.
+func (n *Node) UnmarshalJSON(data []byte) error {
+	var words []string
+	if err := json.Unmarshal(data, &words); err != nil {
+		return err
+	}
+	*n = *NewNode()
+	n.Insert(words...)
+	return nil
+}
+
+// GobEncode encodes the sorted words of the trie with gob.
+func (n *Node) GobEncode() ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(n.Words()); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode replaces the trie with one holding the words encoded by
+// GobEncode.
+func (n *Node) GobDecode(data []byte) error {
+	var words []string
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&words); err != nil {
+		return err
+	}
+	*n = *NewNode()
+	n.Insert(words...)
+	return nil
+}
+
+// binaryTrieEncoding is the encoded form of a BinaryTrie.
+type binaryTrieEncoding struct {
+	Bits   int      `json:"bits"`
+	Values []uint64 `json:"values"`
+}
+
+// Values returns the values stored in the trie in increasing order, with
+// duplicates repeated.
+func (t *BinaryTrie) Values() []uint64 {
+	values := make([]uint64, 0, t.Len())
+	var walk func(n *binaryNode, depth int, prefix uint64)
+	walk = func(n *binaryNode, depth int, prefix uint64) {
+		if n == nil || n.count == 0 {
+			return
+		}
+		if depth == t.bits {
+			for i := 0; i < n.count; i++ {
+				values = append(values, prefix)
+			}
+			return
+		}
+		walk(n.children[0], depth+1, prefix<<1)
+		walk(n.children[1], depth+1, prefix<<1|1)
+	}
+	walk(t.root, 0, 0)
+	return values
+}
+
+// load replaces the trie with the one described by e.
+func (t *BinaryTrie) load(e binaryTrieEncoding) error {
+	fresh, err := NewBinaryTrie(e.Bits)
+	if err != nil {
+		return err
+	}
+	for _, x := range e.Values {
+		if e.Bits < 64 && x>>e.Bits != 0 {
+			return errors.New("binary trie value does not fit in its bits")
+		}
+		fresh.Insert(x)
+	}
+	*t = *fresh
+	return nil
+}
+
+// MarshalJSON encodes the trie as a JSON object holding its width in bits
+// and its values in increasing order.
+func (t *BinaryTrie) MarshalJSON() ([]byte, error) {
+	return json.Marshal(binaryTrieEncoding{Bits: t.bits, Values: t.Values()})
+}
+
+// UnmarshalJSON replaces the trie with the one encoded by MarshalJSON.
+func (t *BinaryTrie) UnmarshalJSON(data []byte) error {
+	var e binaryTrieEncoding
+	if err := json.Unmarshal(data, &e); err != nil {
+		return err
+	}
+	return t.load(e)
+}
+
+// GobEncode encodes the trie with gob.
+func (t *BinaryTrie) GobEncode() ([]byte, error) {
+	var buf bytes.Buffer
+	err := gob.NewEncoder(&buf).Encode(binaryTrieEncoding{Bits: t.bits, Values: t.Values()})
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode replaces the trie with the one encoded by GobEncode.
+func (t *BinaryTrie) GobDecode(data []byte) error {
+	var e binaryTrieEncoding
+	if err := gob.NewDecoder(bytes.NewReader(data)).Decode(&e); err != nil {
+		return err
+	}
+	return t.load(e)
+}
@@ -0,0 +1,125 @@
+package trie
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/structure/iterutil"
+)
+
+func TestNodeEncoding(t *testing.T) {
+	n := NewNode()
+	n.Insert("tesla", "nikola", "", "tea", "ten", "été", "te")
+	n.Remove("ten")
+
+	data, err := json.Marshal(n)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `["","nikola","te","tea","tesla","été"]`; string(data) != want {
+		t.Errorf("json.Marshal = %s, want %s", data, want)
+	}
+	fromJSON := NewNode()
+	fromJSON.Insert("stale")
+	if err := json.Unmarshal(data, fromJSON); err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(n); err != nil {
+		t.Fatal(err)
+	}
+	fromGob := &Node{}
+	if err := gob.NewDecoder(&buf).Decode(fromGob); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, got := range []*Node{fromJSON, fromGob} {
+		if !reflect.DeepEqual(got.Words(), n.Words()) || got.Find("stale") || got.Find("ten") {
+			t.Errorf("decoded trie holds %q, want %q", got.Words(), n.Words())
+		}
+		// lazily removed nodes are not encoded
+		if got.Capacity() >= n.Capacity() {
+			t.Errorf("decoded trie has %d nodes, original %d", got.Capacity(), n.Capacity())
+		}
+		got.Insert("tent")
+		if !got.Find("tent") {
+			t.Errorf("decoded trie should remain usable")
+		}
+	}
+}
+
+func TestBinaryTrieEncoding(t *testing.T) {
+	bt, _ := NewBinaryTrie(10)
+	rnd := rand.New(rand.NewSource(3))
+	for i := 0; i < 200; i++ {
+		bt.Insert(uint64(rnd.Intn(1 << 10)))
+	}
+	bt.Insert(5)
+	bt.Insert(5)
+
+	data, err := json.Marshal(bt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fromJSON := &BinaryTrie{}
+	if err := json.Unmarshal(data, fromJSON); err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(bt); err != nil {
+		t.Fatal(err)
+	}
+	fromGob := &BinaryTrie{}
+	if err := gob.NewDecoder(&buf).Decode(fromGob); err != nil {
+		t.Fatal(err)
+	}
+
+	values := bt.Values()
+	for i := 1; i < len(values); i++ {
+		if values[i-1] > values[i] {
+			t.Fatalf("Values() not sorted: %v", values)
+		}
+	}
+	for _, got := range []*BinaryTrie{fromJSON, fromGob} {
+		if !reflect.DeepEqual(got.Values(), values) || got.Len() != 202 {
+			t.Errorf("decoded trie holds %v", got.Values())
+		}
+		for x := uint64(0); x < 1<<10; x += 37 {
+			a, _ := got.MaxXor(x)
+			b, _ := bt.MaxXor(x)
+			if a != b {
+				t.Errorf("MaxXor(%d) = %d, want %d", x, a, b)
+			}
+		}
+	}
+
+	for _, bad := range []string{`{"bits":0,"values":[]}`, `{"bits":4,"values":[16]}`, `[1]`} {
+		if err := json.Unmarshal([]byte(bad), &BinaryTrie{}); err == nil {
+			t.Errorf("decoding %s should fail", bad)
+		}
+	}
+}
+
+func TestNodeWordsSeq(t *testing.T) {
+	n := NewNode()
+	n.Insert("tesla", "nikola", "", "tea", "ten", "été", "te", "a", "ab")
+	n.Remove("ten")
+	if got, want := iterutil.Collect(n.WordsSeq()), n.Words(); !reflect.DeepEqual(got, want) {
+		t.Errorf("WordsSeq = %q, want %q", got, want)
+	}
+	if got := iterutil.Collect(iterutil.Take(n.WordsSeq(), 3)); !reflect.DeepEqual(got, []string{"", "a", "ab"}) {
+		t.Errorf("WordsSeq taking 3 = %q", got)
+	}
+	long := iterutil.Filter(n.WordsSeq(), func(w string) bool { return len(w) > 3 })
+	if got := iterutil.Collect(long); !reflect.DeepEqual(got, []string{"nikola", "tesla", "été"}) {
+		t.Errorf("WordsSeq filtered = %q", got)
+	}
+	if got := iterutil.Collect(NewNode().WordsSeq()); len(got) != 0 {
+		t.Errorf("WordsSeq of an empty trie = %q", got)
+	}
+}
@@ -0,0 +1,101 @@
+// fuzzy.go
+// description: Fuzzy dictionary search by intersecting a trie with a Levenshtein automaton
+// details:
+// Both tries are walked depth first while the automaton reads the same runes,
+// so a branch is abandoned as soon as no word below it can be within the
+// allowed distance. Every word reached in an accepting state is reported. The
+// DoubleArray is a byte trie, so bytes are gathered along a path until they
+// form a whole UTF-8 encoded rune before it is given to the automaton.
+// time complexity: O(visited nodes), far below the size of the trie for small distances
+// space complexity: O(longest word)
+// see fuzzy_test.go
+
+package trie
+
+import (
+	"sort"
+	"unicode/utf8"
+
+	"github.com/TheAlgorithms/Go/strings/levenshtein"
+)
+
+// FuzzyMatch is a word found by a fuzzy search along with its edit distance
+// to the query. Index is the word's index in a DoubleArray and -1 for a Node.
+type FuzzyMatch struct {
+	Word     string
+	Index    int
+	Distance int
+}
+
+// FuzzySearch returns the words stored below n that the automaton accepts,
+// in increasing order.
+func (n *Node) FuzzySearch(a *levenshtein.Automaton) []FuzzyMatch {
+	var result []FuzzyMatch
+	var path []rune
+	var walk func(node *Node, state int)
+	walk = func(node *Node, state int) {
+		if node.isLeaf {
+			if d, ok := a.Accepts(state); ok {
+				result = append(result, FuzzyMatch{Word: string(path), Index: -1, Distance: d})
+			}
+		}
+		for r, child := range node.children {
+			if next := a.Step(state, r); next != levenshtein.Dead {
+				path = append(path, r)
+				walk(child, next)
+				path = path[:len(path)-1]
+			}
+		}
+	}
+	walk(n, a.Start())
+	sort.Slice(result, func(i, j int) bool { return result[i].Word < result[j].Word })
+	return result
+}
+
+// FuzzySearch returns the stored words that the automaton accepts, in the
+// order of the list the trie was built from.
+func (d *DoubleArray) FuzzySearch(a *levenshtein.Automaton) []FuzzyMatch {
+	var result []FuzzyMatch
+	var path []byte
+	// pending is the number of trailing bytes of path not yet read by the
+	// automaton because they do not form a whole rune so far.
+	var walk func(s, state, pending int)
+	walk = func(s, state, pending int) {
+		if t := d.next(s, 0); t >= 0 {
+			// Like ranging over a string, every byte of a truncated rune
+			// at the end of a word reads as utf8.RuneError.
+			end := state
+			for i := 0; i < pending; i++ {
+				end = a.Step(end, utf8.RuneError)
+			}
+			if dist, ok := a.Accepts(end); ok {
+				result = append(result, FuzzyMatch{Word: string(path), Index: int(-d.base[t] - 1), Distance: dist})
+			}
+		}
+		for c := 1; c <= 256; c++ {
+			t := d.next(s, c)
+			if t < 0 {
+				continue
+			}
+			path = append(path, byte(c-1))
+			next, rest := state, pending+1
+			for rest > 0 && next != levenshtein.Dead {
+				tail := path[len(path)-rest:]
+				if !utf8.FullRune(tail) {
+					break
+				}
+				// An invalid sequence decodes as one byte at a time.
+				r, size := utf8.DecodeRune(tail)
+				next, rest = a.Step(next, r), rest-size
+			}
+			if next != levenshtein.Dead {
+				walk(t, next, rest)
+			}
+			path = path[:len(path)-1]
+		}
+	}
+	if len(d.base) > 0 {
+		walk(0, a.Start(), 0)
+	}
+	return result
+}
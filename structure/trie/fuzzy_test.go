@@ -0,0 +1,115 @@
+package trie
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/strings/levenshtein"
+)
+
+// runeDistance is the Levenshtein distance between a and b counted in runes.
+func runeDistance(a, b string) int {
+	x, y := []rune(a), []rune(b)
+	prev := make([]int, len(y)+1)
+	curr := make([]int, len(y)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(x); i++ {
+		curr[0] = i
+		for j := 1; j <= len(y); j++ {
+			cost := 1
+			if x[i-1] == y[j-1] {
+				cost = 0
+			}
+			curr[j] = prev[j-1] + cost
+			if prev[j]+1 < curr[j] {
+				curr[j] = prev[j] + 1
+			}
+			if curr[j-1]+1 < curr[j] {
+				curr[j] = curr[j-1] + 1
+			}
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(y)]
+}
+
+func bruteFuzzy(words []string, query string, k int) []FuzzyMatch {
+	var result []FuzzyMatch
+	for i, w := range words {
+		if d := runeDistance(w, query); d <= k {
+			result = append(result, FuzzyMatch{Word: w, Index: i, Distance: d})
+		}
+	}
+	return result
+}
+
+func TestFuzzySearch(t *testing.T) {
+	words := []string{"", "cafe", "café", "car", "cart", "cat", "coat", "dog", "naïve", "naive", "\xe2\x82"}
+	sort.Strings(words)
+	d, err := NewDoubleArray(words)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The rune based Node keeps invalid UTF-8 as utf8.RuneError, so it
+	// only gets the valid words.
+	n := NewNode()
+	n.Insert(words[:len(words)-1]...)
+
+	for _, test := range []struct {
+		query string
+		k     int
+	}{
+		{"cat", 1}, {"caf", 1}, {"cafe", 0}, {"naive", 1}, {"x", 1}, {"", 1}, {"��", 0}, {"dog", 3},
+	} {
+		a, _ := levenshtein.NewAutomaton(test.query, test.k)
+		want := bruteFuzzy(words, test.query, test.k)
+		if got := d.FuzzySearch(a); !reflect.DeepEqual(got, want) {
+			t.Errorf("DoubleArray.FuzzySearch(%q, %d) = %v, want %v", test.query, test.k, got, want)
+		}
+		valid := want[:0]
+		for _, m := range want {
+			if m.Word != "\xe2\x82" {
+				m.Index = -1
+				valid = append(valid, m)
+			}
+		}
+		want = valid
+		sort.Slice(want, func(i, j int) bool { return want[i].Word < want[j].Word })
+		if got := n.FuzzySearch(a); len(got) != len(want) || (len(want) > 0 && !reflect.DeepEqual(got, want)) {
+			t.Errorf("Node.FuzzySearch(%q, %d) = %v, want %v", test.query, test.k, got, want)
+		}
+	}
+}
+
+func TestFuzzySearchRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(3))
+	randomWord := func() string {
+		r := make([]rune, rnd.Intn(7))
+		for i := range r {
+			r[i] = []rune("abcé")[rnd.Intn(4)]
+		}
+		return string(r)
+	}
+	set := map[string]bool{}
+	for i := 0; i < 500; i++ {
+		set[randomWord()] = true
+	}
+	var words []string
+	for w := range set {
+		words = append(words, w)
+	}
+	sort.Strings(words)
+	d, _ := NewDoubleArray(words)
+
+	for q := 0; q < 100; q++ {
+		query, k := randomWord(), rnd.Intn(3)
+		a, _ := levenshtein.NewAutomaton(query, k)
+		if got, want := d.FuzzySearch(a), bruteFuzzy(words, query, k); !reflect.DeepEqual(got, want) {
+			t.Fatalf("FuzzySearch(%q, %d) = %v, want %v", query, k, got, want)
+		}
+	}
+}
@@ -0,0 +1,500 @@
+// merklepatricia.go
+// description: Merkle Patricia trie with root hashes and proofs
+// details:
+// A Merkle Patricia trie is a radix trie over the nibbles (half bytes) of its
+// keys in which every node is stored under the hash of its encoding, and
+// refers to its children by their hashes. It has three kinds of nodes, as in
+// Ethereum: a leaf holds the rest of a key and its value, an extension holds
+// a run of nibbles shared by all the keys below it and the hash of a branch,
+// and a branch has sixteen children, one per nibble, and the value of the key
+// ending there. Nodes are kept in canonical form, so that the hash of the
+// root only depends on the stored keys and values, whatever the order of the
+// updates. The root hash thus authenticates the whole content: a proof that
+// a key has some value, or no value, is the list of the nodes on the path of
+// the key, which anyone can check against the root hash.
+// Nodes are immutable and written to a pluggable NodeStore, so every root
+// hash ever returned stays readable. Unlike Ethereum, nodes are hashed with
+// SHA-256 rather than Keccak-256, encoded with a simple binary format rather
+// than RLP, and never inlined into their parent.
+// time complexity: O(k) node reads and writes for a key of k nibbles
+// space complexity: O(k) new nodes per update
+// reference: https://ethereum.org/en/developers/docs/data-structures-and-encoding/patricia-merkle-trie/
+// see merklepatricia_test.go
+
+package trie
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+
+	"github.com/TheAlgorithms/Go/hashing/sha256"
+)
+
+// MerkleHash is the SHA-256 hash of the encoding of a node. The zero hash
+// stands for no node, and is the root hash of an empty trie.
+type MerkleHash [32]byte
+
+var (
+	// ErrNodeNotFound is returned by a NodeStore that does not have a node.
+	ErrNodeNotFound = errors.New("trie node not found")
+	// ErrCorruptNode is returned when a stored node cannot be decoded.
+	ErrCorruptNode = errors.New("corrupt trie node")
+	// ErrInvalidProof is returned by VerifyProof when a proof does not match
+	// the root hash and the key.
+	ErrInvalidProof = errors.New("invalid proof")
+)
+
+// NodeStore persists the encoded nodes of a MerklePatricia trie under their
+// hashes.
+type NodeStore interface {
+	// Get returns the node of the given hash, or ErrNodeNotFound.
+	Get(hash MerkleHash) ([]byte, error)
+	// Put stores a node under its hash.
+	Put(hash MerkleHash, node []byte) error
+}
+
+// MemoryNodeStore is a NodeStore keeping the nodes in a map.
+type MemoryNodeStore map[MerkleHash][]byte
+
+// Get returns the node of the given hash, or ErrNodeNotFound.
+func (s MemoryNodeStore) Get(hash MerkleHash) ([]byte, error) {
+	node, ok := s[hash]
+	if !ok {
+		return nil, ErrNodeNotFound
+	}
+	return node, nil
+}
+
+// Put stores a node under its hash.
+func (s MemoryNodeStore) Put(hash MerkleHash, node []byte) error {
+	s[hash] = append([]byte(nil), node...)
+	return nil
+}
+
+// kinds of nodes, the first byte of their encoding
+const (
+	leafNode byte = iota
+	extensionNode
+	branchNode
+)
+
+// mptNode is a decoded node. A leaf uses path and value, an extension path
+// and children[0], and a branch children and value, which is empty when no
+// key ends at the branch.
+type mptNode struct {
+	kind     byte
+	path     []byte // nibbles
+	value    []byte
+	children [16]MerkleHash
+}
+
+// encode returns the encoding of the node: its kind, then for a leaf the
+// length of its path, the path and the value, for an extension the length
+// of its path, the path and the hash of its child, and for a branch a
+// bitmap of its children, their hashes and the value.
+func (n *mptNode) encode() []byte {
+	buf := []byte{n.kind}
+	switch n.kind {
+	case leafNode, extensionNode:
+		buf = binary.AppendUvarint(buf, uint64(len(n.path)))
+		buf = append(buf, n.path...)
+		if n.kind == leafNode {
+			return append(buf, n.value...)
+		}
+		return append(buf, n.children[0][:]...)
+	}
+	var bitmap uint16
+	for i, c := range n.children {
+		if c != (MerkleHash{}) {
+			bitmap |= 1 << i
+		}
+	}
+	buf = binary.BigEndian.AppendUint16(buf, bitmap)
+	for _, c := range n.children {
+		if c != (MerkleHash{}) {
+			buf = append(buf, c[:]...)
+		}
+	}
+	return append(buf, n.value...)
+}
+
+// decodeNode decodes the encoding of a node.
+func decodeNode(buf []byte) (*mptNode, error) {
+	if len(buf) == 0 {
+		return nil, ErrCorruptNode
+	}
+	n := &mptNode{kind: buf[0]}
+	buf = buf[1:]
+	switch n.kind {
+	case leafNode, extensionNode:
+		length, k := binary.Uvarint(buf)
+		if k <= 0 || length > uint64(len(buf)-k) {
+			return nil, ErrCorruptNode
+		}
+		n.path = buf[k : k+int(length)]
+		for _, nibble := range n.path {
+			if nibble > 15 {
+				return nil, ErrCorruptNode
+			}
+		}
+		buf = buf[k+int(length):]
+		if n.kind == leafNode {
+			n.value = buf
+			return n, nil
+		}
+		if len(buf) != len(MerkleHash{}) || len(n.path) == 0 {
+			return nil, ErrCorruptNode
+		}
+		copy(n.children[0][:], buf)
+		return n, nil
+	case branchNode:
+		if len(buf) < 2 {
+			return nil, ErrCorruptNode
+		}
+		bitmap := binary.BigEndian.Uint16(buf)
+		buf = buf[2:]
+		for i := range n.children {
+			if bitmap&(1<<i) == 0 {
+				continue
+			}
+			if len(buf) < len(MerkleHash{}) {
+				return nil, ErrCorruptNode
+			}
+			copy(n.children[i][:], buf)
+			buf = buf[len(MerkleHash{}):]
+		}
+		n.value = buf
+		return n, nil
+	}
+	return nil, ErrCorruptNode
+}
+
+// nibbles returns the nibbles of a key, the high one of each byte first.
+func nibbles(key []byte) []byte {
+	path := make([]byte, 0, 2*len(key))
+	for _, b := range key {
+		path = append(path, b>>4, b&15)
+	}
+	return path
+}
+
+// commonPrefix returns the length of the common prefix of a and b.
+func commonPrefix(a, b []byte) int {
+	i := 0
+	for i < len(a) && i < len(b) && a[i] == b[i] {
+		i++
+	}
+	return i
+}
+
+// concat returns a new slice holding a then b.
+func concat(a, b []byte) []byte {
+	return append(append(make([]byte, 0, len(a)+len(b)), a...), b...)
+}
+
+// MerklePatricia is a Merkle Patricia trie mapping byte keys to non-empty
+// byte values.
+type MerklePatricia struct {
+	store NodeStore
+	root  MerkleHash
+}
+
+// NewMerklePatricia returns an empty trie writing its nodes to store.
+func NewMerklePatricia(store NodeStore) *MerklePatricia {
+	return &MerklePatricia{store: store}
+}
+
+// OpenMerklePatricia returns the trie of the given root hash, whose nodes
+// are read from store.
+func OpenMerklePatricia(store NodeStore, root MerkleHash) *MerklePatricia {
+	return &MerklePatricia{store: store, root: root}
+}
+
+// Root returns the root hash of the trie, which is the zero hash when the
+// trie is empty.
+func (t *MerklePatricia) Root() MerkleHash {
+	return t.root
+}
+
+func (t *MerklePatricia) load(hash MerkleHash) (*mptNode, error) {
+	buf, err := t.store.Get(hash)
+	if err != nil {
+		return nil, err
+	}
+	return decodeNode(buf)
+}
+
+func (t *MerklePatricia) save(n *mptNode) (MerkleHash, error) {
+	buf := n.encode()
+	hash := MerkleHash(sha256.Hash(buf))
+	return hash, t.store.Put(hash, buf)
+}
+
+// Get returns the value of key, and false when the key has no value.
+func (t *MerklePatricia) Get(key []byte) ([]byte, bool, error) {
+	path, hash := nibbles(key), t.root
+	for hash != (MerkleHash{}) {
+		n, err := t.load(hash)
+		if err != nil {
+			return nil, false, err
+		}
+		switch n.kind {
+		case leafNode:
+			if bytes.Equal(n.path, path) {
+				return n.value, true, nil
+			}
+			return nil, false, nil
+		case extensionNode:
+			if !bytes.HasPrefix(path, n.path) {
+				return nil, false, nil
+			}
+			path, hash = path[len(n.path):], n.children[0]
+		default:
+			if len(path) == 0 {
+				return n.value, len(n.value) > 0, nil
+			}
+			path, hash = path[1:], n.children[path[0]]
+		}
+	}
+	return nil, false, nil
+}
+
+// Put sets the value of key. An empty value deletes the key.
+func (t *MerklePatricia) Put(key, value []byte) error {
+	if len(value) == 0 {
+		_, err := t.Delete(key)
+		return err
+	}
+	root, err := t.insert(t.root, nibbles(key), append([]byte(nil), value...))
+	if err != nil {
+		return err
+	}
+	t.root = root
+	return nil
+}
+
+// insert sets the value of path in the subtrie of the given hash, and
+// returns the hash of the new subtrie.
+func (t *MerklePatricia) insert(hash MerkleHash, path, value []byte) (MerkleHash, error) {
+	if hash == (MerkleHash{}) {
+		return t.save(&mptNode{kind: leafNode, path: path, value: value})
+	}
+	n, err := t.load(hash)
+	if err != nil {
+		return MerkleHash{}, err
+	}
+	switch n.kind {
+	case branchNode:
+		if len(path) == 0 {
+			n.value = value
+		} else if n.children[path[0]], err = t.insert(n.children[path[0]], path[1:], value); err != nil {
+			return MerkleHash{}, err
+		}
+		return t.save(n)
+	case leafNode:
+		if bytes.Equal(n.path, path) {
+			n.value = value
+			return t.save(n)
+		}
+	case extensionNode:
+		if bytes.HasPrefix(path, n.path) {
+			if n.children[0], err = t.insert(n.children[0], path[len(n.path):], value); err != nil {
+				return MerkleHash{}, err
+			}
+			return t.save(n)
+		}
+	}
+	// the path leaves the leaf or the extension after c nibbles, where a
+	// branch now holds both
+	c := commonPrefix(n.path, path)
+	branch := &mptNode{kind: branchNode}
+	if n.kind == leafNode && len(n.path) == c {
+		branch.value = n.value
+	} else {
+		rest := &mptNode{kind: n.kind, path: n.path[c+1:], value: n.value, children: n.children}
+		child := n.children[0]
+		if n.kind == leafNode || len(rest.path) > 0 {
+			if child, err = t.save(rest); err != nil {
+				return MerkleHash{}, err
+			}
+		}
+		branch.children[n.path[c]] = child
+	}
+	if len(path) == c {
+		branch.value = value
+	} else if branch.children[path[c]], err = t.save(&mptNode{kind: leafNode, path: path[c+1:], value: value}); err != nil {
+		return MerkleHash{}, err
+	}
+	if hash, err = t.save(branch); err != nil || c == 0 {
+		return hash, err
+	}
+	ext := &mptNode{kind: extensionNode, path: path[:c]}
+	ext.children[0] = hash
+	return t.save(ext)
+}
+
+// Delete removes key and reports whether it had a value.
+func (t *MerklePatricia) Delete(key []byte) (bool, error) {
+	root, ok, err := t.remove(t.root, nibbles(key))
+	if err != nil || !ok {
+		return false, err
+	}
+	t.root = root
+	return true, nil
+}
+
+// remove deletes path from the subtrie of the given hash, and returns the
+// hash of the new subtrie and whether path had a value.
+func (t *MerklePatricia) remove(hash MerkleHash, path []byte) (MerkleHash, bool, error) {
+	if hash == (MerkleHash{}) {
+		return hash, false, nil
+	}
+	n, err := t.load(hash)
+	if err != nil {
+		return hash, false, err
+	}
+	switch n.kind {
+	case leafNode:
+		if !bytes.Equal(n.path, path) {
+			return hash, false, nil
+		}
+		return MerkleHash{}, true, nil
+	case extensionNode:
+		if !bytes.HasPrefix(path, n.path) {
+			return hash, false, nil
+		}
+		child, ok, err := t.remove(n.children[0], path[len(n.path):])
+		if err != nil || !ok {
+			return hash, false, err
+		}
+		// the branch below may have collapsed into a leaf or an extension
+		hash, err = t.prepend(n.path, child)
+		return hash, err == nil, err
+	}
+	if len(path) == 0 {
+		if len(n.value) == 0 {
+			return hash, false, nil
+		}
+		n.value = nil
+	} else {
+		child, ok, err := t.remove(n.children[path[0]], path[1:])
+		if err != nil || !ok {
+			return hash, false, err
+		}
+		n.children[path[0]] = child
+	}
+	// a branch left with a single child or only a value collapses
+	only, count := -1, 0
+	for i, c := range n.children {
+		if c != (MerkleHash{}) {
+			only = i
+			count++
+		}
+	}
+	switch {
+	case count == 0:
+		hash, err = t.save(&mptNode{kind: leafNode, path: []byte{}, value: n.value})
+	case count == 1 && len(n.value) == 0:
+		hash, err = t.prepend([]byte{byte(only)}, n.children[only])
+	default:
+		hash, err = t.save(n)
+	}
+	return hash, err == nil, err
+}
+
+// prepend returns the hash of the subtrie of the given hash with all its
+// paths prefixed by prefix, merging prefix into the root when it is a leaf
+// or an extension.
+func (t *MerklePatricia) prepend(prefix []byte, hash MerkleHash) (MerkleHash, error) {
+	if len(prefix) == 0 {
+		return hash, nil
+	}
+	n, err := t.load(hash)
+	if err != nil {
+		return MerkleHash{}, err
+	}
+	if n.kind == branchNode {
+		ext := &mptNode{kind: extensionNode, path: prefix}
+		ext.children[0] = hash
+		return t.save(ext)
+	}
+	n.path = concat(prefix, n.path)
+	return t.save(n)
+}
+
+// Prove returns a proof of the value of key, or of its absence: the
+// encodings of the nodes on the path of the key, from the root.
+func (t *MerklePatricia) Prove(key []byte) ([][]byte, error) {
+	var proof [][]byte
+	path, hash := nibbles(key), t.root
+	for hash != (MerkleHash{}) {
+		buf, err := t.store.Get(hash)
+		if err != nil {
+			return nil, err
+		}
+		n, err := decodeNode(buf)
+		if err != nil {
+			return nil, err
+		}
+		proof = append(proof, buf)
+		switch {
+		case n.kind == leafNode:
+			return proof, nil
+		case n.kind == extensionNode && bytes.HasPrefix(path, n.path):
+			path, hash = path[len(n.path):], n.children[0]
+		case n.kind == branchNode && len(path) > 0:
+			path, hash = path[1:], n.children[path[0]]
+		default:
+			return proof, nil
+		}
+	}
+	return proof, nil
+}
+
+// VerifyProof checks a proof returned by Prove against the root hash of a
+// trie, and returns the value of key it proves, and false when it proves
+// that the key has no value. It returns ErrInvalidProof when the proof does
+// not match the root hash or the key.
+func VerifyProof(root MerkleHash, key []byte, proof [][]byte) ([]byte, bool, error) {
+	path, hash := nibbles(key), root
+	for i := 0; hash != (MerkleHash{}); i++ {
+		if i == len(proof) || MerkleHash(sha256.Hash(proof[i])) != hash {
+			return nil, false, ErrInvalidProof
+		}
+		n, err := decodeNode(proof[i])
+		if err != nil {
+			return nil, false, ErrInvalidProof
+		}
+		last := i == len(proof)-1
+		switch {
+		case n.kind == leafNode:
+			if !last {
+				return nil, false, ErrInvalidProof
+			}
+			if bytes.Equal(n.path, path) {
+				return n.value, true, nil
+			}
+			return nil, false, nil
+		case n.kind == extensionNode && bytes.HasPrefix(path, n.path):
+			path, hash = path[len(n.path):], n.children[0]
+		case n.kind == branchNode && len(path) > 0:
+			path, hash = path[1:], n.children[path[0]]
+		default:
+			if !last {
+				return nil, false, ErrInvalidProof
+			}
+			if n.kind == branchNode {
+				return n.value, len(n.value) > 0, nil
+			}
+			return nil, false, nil
+		}
+		if hash == (MerkleHash{}) && !last {
+			return nil, false, ErrInvalidProof
+		}
+	}
+	if len(proof) > 0 && root == (MerkleHash{}) {
+		return nil, false, ErrInvalidProof
+	}
+	return nil, false, nil
+}
@@ -0,0 +1,187 @@
+package trie
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+func TestMerklePatricia(t *testing.T) {
+	store := MemoryNodeStore{}
+	mpt := NewMerklePatricia(store)
+	if mpt.Root() != (MerkleHash{}) {
+		t.Errorf("root of an empty trie = %x, want zero", mpt.Root())
+	}
+	pairs := [][2]string{{"do", "verb"}, {"dog", "puppy"}, {"doge", "coin"}, {"horse", "stallion"}, {"", "empty key"}}
+	for _, p := range pairs {
+		if err := mpt.Put([]byte(p[0]), []byte(p[1])); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, p := range pairs {
+		if v, ok, err := mpt.Get([]byte(p[0])); err != nil || !ok || string(v) != p[1] {
+			t.Errorf("Get(%q) = %q, %v, %v, want %q", p[0], v, ok, err, p[1])
+		}
+	}
+	for _, key := range []string{"d", "dogs", "horses", "cat"} {
+		if v, ok, err := mpt.Get([]byte(key)); err != nil || ok {
+			t.Errorf("Get(%q) = %q, %v, %v, want no value", key, v, ok, err)
+		}
+	}
+
+	// an old root stays readable after updates
+	old := mpt.Root()
+	if err := mpt.Put([]byte("dog"), []byte("hound")); err != nil {
+		t.Fatal(err)
+	}
+	if v, _, _ := OpenMerklePatricia(store, old).Get([]byte("dog")); string(v) != "puppy" {
+		t.Errorf("Get(dog) at the old root = %q, want puppy", v)
+	}
+	if v, _, _ := mpt.Get([]byte("dog")); string(v) != "hound" {
+		t.Errorf("Get(dog) = %q, want hound", v)
+	}
+
+	// an empty value deletes
+	if err := mpt.Put([]byte("doge"), nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok, _ := mpt.Get([]byte("doge")); ok {
+		t.Errorf("Put with an empty value did not delete the key")
+	}
+	if ok, err := mpt.Delete([]byte("doge")); ok || err != nil {
+		t.Errorf("Delete of a missing key = %v, %v", ok, err)
+	}
+
+	if _, _, err := OpenMerklePatricia(MemoryNodeStore{}, old).Get([]byte("dog")); !errors.Is(err, ErrNodeNotFound) {
+		t.Errorf("Get from a store without the nodes error = %v, want ErrNodeNotFound", err)
+	}
+}
+
+// randomPairs returns n random keys, sharing prefixes, with their values.
+func randomPairs(rnd *rand.Rand, n int) map[string]string {
+	pairs := map[string]string{}
+	for len(pairs) < n {
+		key := make([]byte, rnd.Intn(4))
+		for i := range key {
+			key[i] = byte(rnd.Intn(3) * 0x11)
+		}
+		pairs[string(key)] = fmt.Sprint(rnd.Int())
+	}
+	return pairs
+}
+
+// TestMerklePatriciaCanonical checks that the root hash only depends on the
+// content, whatever the order of the updates.
+func TestMerklePatriciaCanonical(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for iter := 0; iter < 50; iter++ {
+		pairs := randomPairs(rnd, 1+rnd.Intn(30))
+		keys := make([]string, 0, len(pairs))
+		for k := range pairs {
+			keys = append(keys, k)
+		}
+		var roots []MerkleHash
+		for order := 0; order < 3; order++ {
+			rnd.Shuffle(len(keys), func(i, j int) { keys[i], keys[j] = keys[j], keys[i] })
+			mpt := NewMerklePatricia(MemoryNodeStore{})
+			// extra keys inserted and removed leave no trace
+			extra := randomPairs(rnd, 5)
+			for k, v := range extra {
+				if _, ok := pairs[k]; !ok {
+					_ = mpt.Put([]byte(k), []byte(v))
+				}
+			}
+			for _, k := range keys {
+				_ = mpt.Put([]byte(k), []byte(pairs[k]))
+			}
+			for k := range extra {
+				if _, ok := pairs[k]; !ok {
+					if ok, err := mpt.Delete([]byte(k)); !ok || err != nil {
+						t.Fatalf("Delete(%x) = %v, %v", k, ok, err)
+					}
+				}
+			}
+			for k, v := range pairs {
+				if got, ok, err := mpt.Get([]byte(k)); err != nil || !ok || string(got) != v {
+					t.Fatalf("Get(%x) = %q, %v, %v, want %q", k, got, ok, err, v)
+				}
+			}
+			roots = append(roots, mpt.Root())
+		}
+		if roots[0] != roots[1] || roots[1] != roots[2] {
+			t.Fatalf("roots differ with the order of updates: %x", roots)
+		}
+		// removing every key empties the trie
+		mpt := OpenMerklePatricia(MemoryNodeStore{}, MerkleHash{})
+		for _, k := range keys {
+			_ = mpt.Put([]byte(k), []byte(pairs[k]))
+		}
+		for _, k := range keys {
+			_, _ = mpt.Delete([]byte(k))
+		}
+		if mpt.Root() != (MerkleHash{}) {
+			t.Fatalf("root after removing every key = %x", mpt.Root())
+		}
+	}
+}
+
+func TestMerklePatriciaProofs(t *testing.T) {
+	rnd := rand.New(rand.NewSource(2))
+	pairs := randomPairs(rnd, 40)
+	mpt := NewMerklePatricia(MemoryNodeStore{})
+	for k, v := range pairs {
+		_ = mpt.Put([]byte(k), []byte(v))
+	}
+	root := mpt.Root()
+	for i := 0; i < 200; i++ {
+		key := make([]byte, rnd.Intn(5))
+		for j := range key {
+			key[j] = byte(rnd.Intn(3) * 0x11)
+		}
+		proof, err := mpt.Prove(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want, present := pairs[string(key)]
+		v, ok, err := VerifyProof(root, key, proof)
+		if err != nil || ok != present || string(v) != want {
+			t.Fatalf("VerifyProof(%x) = %q, %v, %v, want %q, %v", key, v, ok, err, want, present)
+		}
+		// a proof is only valid for its root
+		if _, _, err := VerifyProof(MerkleHash{1}, key, proof); !errors.Is(err, ErrInvalidProof) {
+			t.Fatalf("VerifyProof against another root error = %v", err)
+		}
+		// and cannot be tampered with
+		if len(proof) > 0 {
+			tampered := append([][]byte(nil), proof...)
+			last := append([]byte(nil), tampered[len(tampered)-1]...)
+			last[len(last)-1] ^= 1
+			tampered[len(tampered)-1] = last
+			if _, _, err := VerifyProof(root, key, tampered); !errors.Is(err, ErrInvalidProof) {
+				t.Fatalf("VerifyProof of a tampered proof error = %v", err)
+			}
+			if _, _, err := VerifyProof(root, key, proof[:len(proof)-1]); len(proof) > 1 && !errors.Is(err, ErrInvalidProof) {
+				t.Fatalf("VerifyProof of a truncated proof error = %v", err)
+			}
+		}
+	}
+	if _, ok, err := VerifyProof(MerkleHash{}, []byte("x"), nil); ok || err != nil {
+		t.Errorf("VerifyProof in an empty trie = %v, %v", ok, err)
+	}
+}
+
+func TestDecodeNodeCorrupt(t *testing.T) {
+	for _, buf := range [][]byte{nil, {7}, {leafNode, 5, 1}, {extensionNode, 1, 3}, {branchNode, 0}, {branchNode, 0, 1, 9}, {leafNode, 1, 16}} {
+		if _, err := decodeNode(buf); !errors.Is(err, ErrCorruptNode) {
+			t.Errorf("decodeNode(%v) error = %v, want ErrCorruptNode", buf, err)
+		}
+	}
+	n := &mptNode{kind: branchNode, value: []byte("v")}
+	n.children[3], n.children[15] = MerkleHash{1}, MerkleHash{2}
+	decoded, err := decodeNode(n.encode())
+	if err != nil || decoded.children != n.children || !bytes.Equal(decoded.value, n.value) {
+		t.Errorf("decodeNode(encode()) = %+v, %v", decoded, err)
+	}
+}
@@ -0,0 +1,68 @@
+package trie
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/internal/testutil"
+)
+
+// randomWord returns a short word over a small alphabet, so that words share
+// prefixes often. Words are not empty: Remove ignores the empty word.
+func randomWord(src *testutil.Source) string {
+	word := make([]byte, 1+src.Intn(4))
+	for i := range word {
+		word[i] = "abc"[src.Intn(3)]
+	}
+	return string(word)
+}
+
+// trieModel checks a trie against a set of words.
+var trieModel = testutil.Model[*Node, map[string]bool]{
+	New: func() (*Node, map[string]bool) { return NewNode(), map[string]bool{} },
+	Ops: []testutil.Op[*Node, map[string]bool]{
+		{Name: "Insert", Run: func(src *testutil.Source, n *Node, set map[string]bool) (string, error) {
+			w := randomWord(src)
+			n.Insert(w)
+			set[w] = true
+			return fmt.Sprintf("Insert(%q)", w), nil
+		}},
+		{Name: "Remove", Run: func(src *testutil.Source, n *Node, set map[string]bool) (string, error) {
+			w := randomWord(src)
+			n.Remove(w)
+			delete(set, w)
+			return fmt.Sprintf("Remove(%q)", w), nil
+		}},
+		{Name: "Find", Run: func(src *testutil.Source, n *Node, set map[string]bool) (string, error) {
+			w := randomWord(src)
+			applied := fmt.Sprintf("Find(%q)", w)
+			if got := n.Find(w); got != set[w] {
+				return applied, fmt.Errorf("Find(%q) = %v, want %v", w, got, set[w])
+			}
+			return applied, nil
+		}},
+		{Name: "Compact", Run: func(src *testutil.Source, n *Node, set map[string]bool) (string, error) {
+			n.Compact()
+			return "", nil
+		}},
+	},
+	Invariant: func(n *Node, set map[string]bool) error {
+		want := []string{}
+		for w := range set {
+			want = append(want, w)
+		}
+		sort.Strings(want)
+		if got := n.Words(); !reflect.DeepEqual(got, want) {
+			return fmt.Errorf("Words() = %q, want %q", got, want)
+		}
+		return nil
+	},
+}
+
+func TestTrieModel(t *testing.T) {
+	for seed := int64(0); seed < 20; seed++ {
+		trieModel.Check(t, seed, 300)
+	}
+}
@@ -105,3 +105,29 @@ func (n *Node) Compact() (remove bool) {
 	}
 	return !n.isLeaf && len(n.children) == 0
 }
+
+// Clone returns an independent copy of the trie with the same nodes,
+// including those left behind by Remove. Words are immutable strings, so
+// no callback is needed to copy them.
+func (n *Node) Clone() *Node {
+	c := &Node{children: make(map[rune]*Node, len(n.children)), isLeaf: n.isLeaf}
+	for r, child := range n.children {
+		c.children[r] = child.Clone()
+	}
+	return c
+}
+
+// Equal reports whether n and other hold the same words, whatever nodes
+// Remove left behind in either.
+func (n *Node) Equal(other *Node) bool {
+	a, b := n.Words(), other.Words()
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
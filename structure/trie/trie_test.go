@@ -155,3 +155,21 @@ func (n *Node) verifySizeCapa(t *testing.T, expectedSize, expectedCapacity int)
 		t.Fatalf("Expected Capacity was %d but got %d", expectedCapacity, got)
 	}
 }
+
+func TestTrieCloneEqual(t *testing.T) {
+	n := NewNode()
+	n.Insert("go", "gopher", "golang")
+	c := n.Clone()
+	if !n.Equal(c) || c.Capacity() != n.Capacity() {
+		t.Fatalf("a clone should equal its original")
+	}
+	c.Remove("gopher")
+	if n.Equal(c) || !n.Find("gopher") {
+		t.Errorf("removing from a clone should leave the original alone")
+	}
+	other := NewNode()
+	other.Insert("golang", "go")
+	if !c.Equal(other) {
+		t.Errorf("tries with the same words should be equal, whatever Remove left behind")
+	}
+}
@@ -0,0 +1,350 @@
+// veb.go
+// description: Van Emde Boas tree, an integer set with fast successor queries
+// details:
+// A van Emde Boas tree stores a set of integers of a universe [0, u) where u
+// is a power of two. A node over a universe of k bits splits a key into its
+// high and low halves: the high half selects one of the clusters, a smaller
+// tree over the low half, and a summary tree records which clusters are not
+// empty. The minimum of a node is kept aside and not stored in a cluster, so
+// that inserting into an empty cluster is O(1) and every operation recurses
+// into a single subtree, halving the number of bits each time: O(log log u).
+// Clusters are created on demand and dropped when they become empty, so the
+// space is proportional to the number of keys and not to the universe.
+// Nodes over at most 64 keys are plain bitmaps answering every operation
+// with a few bit instructions, which also makes a tree over a small universe
+// a single word.
+// time complexity: O(log log u) for Insert, Delete, Has, Successor and
+// Predecessor, O(1) for Min and Max
+// space complexity: O(n log log u) for n keys
+// reference: https://en.wikipedia.org/wiki/Van_Emde_Boas_tree
+// see veb_test.go
+
+// Package veb implements the van Emde Boas tree, a set of integers of a
+// bounded universe.
+package veb
+
+import (
+	"errors"
+	"math/bits"
+)
+
+// leafBits is the number of bits of the largest universe stored as a bitmap.
+const leafBits = 6
+
+// ErrInvalidUniverse is returned by New when the universe is not positive.
+var ErrInvalidUniverse = errors.New("universe must be positive")
+
+// node is a van Emde Boas tree over the keys [0, 1<<k). The fields other than
+// k and bitmap are only used when k > leafBits.
+type node struct {
+	k        uint
+	bitmap   uint64
+	min, max int // -1 when the node is empty
+	summary  *node
+	clusters map[int]*node
+}
+
+func newNode(k uint) *node {
+	n := &node{k: k, min: -1, max: -1}
+	if k > leafBits {
+		n.clusters = make(map[int]*node)
+	}
+	return n
+}
+
+func (n *node) leaf() bool {
+	return n.k <= leafBits
+}
+
+// lowBits is the number of bits of the keys of the clusters.
+func (n *node) lowBits() uint {
+	return n.k / 2
+}
+
+func (n *node) split(x int) (high, low int) {
+	return x >> n.lowBits(), x & (1<<n.lowBits() - 1)
+}
+
+func (n *node) join(high, low int) int {
+	return high<<n.lowBits() | low
+}
+
+func (n *node) minimum() int {
+	if n.leaf() {
+		if n.bitmap == 0 {
+			return -1
+		}
+		return bits.TrailingZeros64(n.bitmap)
+	}
+	return n.min
+}
+
+func (n *node) maximum() int {
+	if n.leaf() {
+		if n.bitmap == 0 {
+			return -1
+		}
+		return 63 - bits.LeadingZeros64(n.bitmap)
+	}
+	return n.max
+}
+
+func (n *node) has(x int) bool {
+	if n.leaf() {
+		return n.bitmap>>uint(x)&1 == 1
+	}
+	if x == n.min || x == n.max {
+		return true
+	}
+	high, low := n.split(x)
+	c := n.clusters[high]
+	return c != nil && c.has(low)
+}
+
+// insert adds x and reports whether it was absent.
+func (n *node) insert(x int) bool {
+	if n.leaf() {
+		if n.has(x) {
+			return false
+		}
+		n.bitmap |= 1 << uint(x)
+		return true
+	}
+	if n.min == -1 {
+		n.min, n.max = x, x
+		return true
+	}
+	if x == n.min {
+		return false
+	}
+	if x < n.min {
+		// the new minimum is kept aside and the old one goes to a cluster
+		x, n.min = n.min, x
+	}
+	high, low := n.split(x)
+	c := n.clusters[high]
+	if c == nil {
+		c = newNode(n.lowBits())
+		n.clusters[high] = c
+		if n.summary == nil {
+			n.summary = newNode(n.k - n.lowBits())
+		}
+		n.summary.insert(high)
+	}
+	if !c.insert(low) {
+		return false
+	}
+	if x > n.max {
+		n.max = x
+	}
+	return true
+}
+
+// delete removes x and reports whether it was present.
+func (n *node) delete(x int) bool {
+	if n.leaf() {
+		if !n.has(x) {
+			return false
+		}
+		n.bitmap &^= 1 << uint(x)
+		return true
+	}
+	if n.min == -1 {
+		return false
+	}
+	if n.min == n.max {
+		if x != n.min {
+			return false
+		}
+		n.min, n.max = -1, -1
+		return true
+	}
+	if x == n.min {
+		// the smallest key of the clusters becomes the minimum
+		high := n.summary.minimum()
+		x = n.join(high, n.clusters[high].minimum())
+		n.min = x
+	}
+	high, low := n.split(x)
+	c := n.clusters[high]
+	if c == nil || !c.delete(low) {
+		return false
+	}
+	if c.minimum() == -1 {
+		delete(n.clusters, high)
+		n.summary.delete(high)
+	}
+	if x == n.max {
+		if high := n.summary.maximum(); high == -1 {
+			n.max = n.min
+		} else {
+			n.max = n.join(high, n.clusters[high].maximum())
+		}
+	}
+	return true
+}
+
+// successor returns the smallest key greater than x, or -1.
+func (n *node) successor(x int) int {
+	if n.leaf() {
+		if x >= 63 {
+			return -1
+		}
+		above := n.bitmap &^ (1<<uint(x+1) - 1)
+		if above == 0 {
+			return -1
+		}
+		return bits.TrailingZeros64(above)
+	}
+	if n.min != -1 && x < n.min {
+		return n.min
+	}
+	high, low := n.split(x)
+	if c := n.clusters[high]; c != nil && low < c.maximum() {
+		return n.join(high, c.successor(low))
+	}
+	if n.summary == nil {
+		return -1
+	}
+	next := n.summary.successor(high)
+	if next == -1 {
+		return -1
+	}
+	return n.join(next, n.clusters[next].minimum())
+}
+
+// predecessor returns the largest key smaller than x, or -1.
+func (n *node) predecessor(x int) int {
+	if n.leaf() {
+		if x <= 0 {
+			return -1
+		}
+		below := n.bitmap & (1<<uint(x) - 1)
+		if below == 0 {
+			return -1
+		}
+		return 63 - bits.LeadingZeros64(below)
+	}
+	if n.max != -1 && x > n.max {
+		return n.max
+	}
+	high, low := n.split(x)
+	if c := n.clusters[high]; c != nil && low > c.minimum() {
+		return n.join(high, c.predecessor(low))
+	}
+	prev := -1
+	if n.summary != nil {
+		prev = n.summary.predecessor(high)
+	}
+	if prev == -1 {
+		if n.min != -1 && x > n.min {
+			return n.min
+		}
+		return -1
+	}
+	return n.join(prev, n.clusters[prev].maximum())
+}
+
+// VEB is a set of the integers of [0, Universe()).
+type VEB struct {
+	root     *node
+	universe int
+	size     int
+}
+
+// New returns an empty set of the integers of [0, universe). The universe
+// is rounded up to a power of two; a universe of at most 64 keys is a single
+// bitmap.
+func New(universe int) (*VEB, error) {
+	if universe <= 0 {
+		return nil, ErrInvalidUniverse
+	}
+	k := uint(bits.Len(uint(universe - 1)))
+	return &VEB{root: newNode(k), universe: 1 << k}, nil
+}
+
+// Universe returns the number of keys the set can hold, all keys being in
+// [0, Universe()).
+func (v *VEB) Universe() int {
+	return v.universe
+}
+
+// Len returns the number of keys in the set.
+func (v *VEB) Len() int {
+	return v.size
+}
+
+func (v *VEB) inUniverse(key int) bool {
+	return key >= 0 && key < v.universe
+}
+
+// Insert adds key to the set and reports whether it was added, which is not
+// the case when it was already there or is out of the universe.
+func (v *VEB) Insert(key int) bool {
+	if !v.inUniverse(key) || !v.root.insert(key) {
+		return false
+	}
+	v.size++
+	return true
+}
+
+// Delete removes key from the set and reports whether it was there.
+func (v *VEB) Delete(key int) bool {
+	if !v.inUniverse(key) || !v.root.delete(key) {
+		return false
+	}
+	v.size--
+	return true
+}
+
+// Has reports whether key is in the set.
+func (v *VEB) Has(key int) bool {
+	return v.inUniverse(key) && v.root.has(key)
+}
+
+// Min returns the smallest key, and false when the set is empty.
+func (v *VEB) Min() (int, bool) {
+	m := v.root.minimum()
+	return m, m != -1
+}
+
+// Max returns the largest key, and false when the set is empty.
+func (v *VEB) Max() (int, bool) {
+	m := v.root.maximum()
+	return m, m != -1
+}
+
+// Successor returns the smallest key greater than x, and false when there
+// is none. x does not have to be in the set or in the universe.
+func (v *VEB) Successor(x int) (int, bool) {
+	if x < 0 {
+		return v.Min()
+	}
+	if x >= v.universe-1 {
+		return -1, false
+	}
+	s := v.root.successor(x)
+	return s, s != -1
+}
+
+// Predecessor returns the largest key smaller than x, and false when there
+// is none. x does not have to be in the set or in the universe.
+func (v *VEB) Predecessor(x int) (int, bool) {
+	if x >= v.universe {
+		return v.Max()
+	}
+	if x <= 0 {
+		return -1, false
+	}
+	p := v.root.predecessor(x)
+	return p, p != -1
+}
+
+// Keys returns the keys of the set in increasing order.
+func (v *VEB) Keys() []int {
+	keys := make([]int, 0, v.size)
+	for k, ok := v.Min(); ok; k, ok = v.Successor(k) {
+		keys = append(keys, k)
+	}
+	return keys
+}
@@ -0,0 +1,157 @@
+package veb_test
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/TheAlgorithms/Go/structure/veb"
+)
+
+func newVEB(t *testing.T, universe int) *veb.VEB {
+	t.Helper()
+	v, err := veb.New(universe)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return v
+}
+
+func TestVEB(t *testing.T) {
+	v := newVEB(t, 1000)
+	if v.Universe() != 1024 {
+		t.Errorf("Universe() = %d, want 1024", v.Universe())
+	}
+	for _, k := range []int{500, 3, 999, 64, 65, 3} {
+		v.Insert(k)
+	}
+	if got, want := v.Keys(), []int{3, 64, 65, 500, 999}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Keys() = %v, want %v", got, want)
+	}
+	if v.Insert(3) || v.Insert(-1) || v.Insert(1024) {
+		t.Errorf("Insert of a present or out of universe key = true")
+	}
+	if s, ok := v.Successor(65); s != 500 || !ok {
+		t.Errorf("Successor(65) = %d, %v, want 500, true", s, ok)
+	}
+	if p, ok := v.Predecessor(64); p != 3 || !ok {
+		t.Errorf("Predecessor(64) = %d, %v, want 3, true", p, ok)
+	}
+	if _, ok := v.Successor(999); ok {
+		t.Errorf("Successor(999) found a key")
+	}
+	if p, ok := v.Predecessor(5000); p != 999 || !ok {
+		t.Errorf("Predecessor(5000) = %d, %v, want 999, true", p, ok)
+	}
+	if !v.Delete(3) || v.Delete(3) || v.Has(3) {
+		t.Errorf("Delete(3) should remove the key once")
+	}
+	if m, ok := v.Min(); m != 64 || !ok {
+		t.Errorf("Min() = %d, %v, want 64, true", m, ok)
+	}
+	if v.Len() != 4 {
+		t.Errorf("Len() = %d, want 4", v.Len())
+	}
+}
+
+func TestNewInvalid(t *testing.T) {
+	for _, u := range []int{0, -8} {
+		if _, err := veb.New(u); !errors.Is(err, veb.ErrInvalidUniverse) {
+			t.Errorf("New(%d) error = %v, want ErrInvalidUniverse", u, err)
+		}
+	}
+}
+
+// bruteSuccessor returns the smallest of the sorted keys greater than x.
+func bruteSuccessor(keys []int, x int) (int, bool) {
+	i := sort.SearchInts(keys, x+1)
+	if i == len(keys) {
+		return -1, false
+	}
+	return keys[i], true
+}
+
+// brutePredecessor returns the largest of the sorted keys smaller than x.
+func brutePredecessor(keys []int, x int) (int, bool) {
+	i := sort.SearchInts(keys, x)
+	if i == 0 {
+		return -1, false
+	}
+	return keys[i-1], true
+}
+
+func TestRandom(t *testing.T) {
+	for _, universe := range []int{1, 5, 64, 65, 1000, 1 << 20, 1 << 30} {
+		rnd := rand.New(rand.NewSource(int64(universe)))
+		v := newVEB(t, universe)
+		set := map[int]bool{}
+		// keys are drawn from a few hundred values so that they collide
+		values := make([]int, 300)
+		for i := range values {
+			values[i] = rnd.Intn(universe+2) - 1
+		}
+		for i := 0; i < 5000; i++ {
+			x := values[rnd.Intn(len(values))]
+			inUniverse := x >= 0 && x < v.Universe()
+			switch rnd.Intn(3) {
+			case 0:
+				if got, want := v.Insert(x), inUniverse && !set[x]; got != want {
+					t.Fatalf("universe %d: Insert(%d) = %v, want %v", universe, x, got, want)
+				}
+				if inUniverse {
+					set[x] = true
+				}
+			case 1:
+				if got := v.Delete(x); got != set[x] {
+					t.Fatalf("universe %d: Delete(%d) = %v, want %v", universe, x, got, set[x])
+				}
+				delete(set, x)
+			case 2:
+				if got := v.Has(x); got != set[x] {
+					t.Fatalf("universe %d: Has(%d) = %v, want %v", universe, x, got, set[x])
+				}
+			}
+			keys := make([]int, 0, len(set))
+			for k := range set {
+				keys = append(keys, k)
+			}
+			sort.Ints(keys)
+			if v.Len() != len(keys) {
+				t.Fatalf("universe %d: Len() = %d, want %d", universe, v.Len(), len(keys))
+			}
+			y := values[rnd.Intn(len(values))]
+			if got, ok := v.Successor(y); !sameKey(got, ok)(bruteSuccessor(keys, y)) {
+				t.Fatalf("universe %d: Successor(%d) = %d, %v with keys %v", universe, y, got, ok, keys)
+			}
+			if got, ok := v.Predecessor(y); !sameKey(got, ok)(brutePredecessor(keys, y)) {
+				t.Fatalf("universe %d: Predecessor(%d) = %d, %v with keys %v", universe, y, got, ok, keys)
+			}
+		}
+		keys := v.Keys()
+		if !sort.IntsAreSorted(keys) || len(keys) != len(set) {
+			t.Errorf("universe %d: Keys() = %v", universe, keys)
+		}
+	}
+}
+
+// sameKey returns a function telling whether a key found or not is the same
+// as the one of its arguments, which only matters when it was found.
+func sameKey(key int, ok bool) func(int, bool) bool {
+	return func(want int, wantOK bool) bool {
+		return ok == wantOK && (!ok || key == want)
+	}
+}
+
+func BenchmarkSuccessor(b *testing.B) {
+	v, _ := veb.New(1 << 30)
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < 1<<16; i++ {
+		v.Insert(rnd.Intn(1 << 30))
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		v.Successor(rnd.Intn(1 << 30))
+	}
+}